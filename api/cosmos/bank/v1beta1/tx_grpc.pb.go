@@ -19,10 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName               = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName          = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_UpdateParams_FullMethodName       = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName     = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_SetDenomCompliance_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetDenomCompliance"
+	Msg_SetFrozen_FullMethodName          = "/cosmos.bank.v1beta1.Msg/SetFrozen"
+	Msg_SetAllowlisted_FullMethodName     = "/cosmos.bank.v1beta1.Msg/SetAllowlisted"
+	Msg_Clawback_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Clawback"
 )
 
 // MsgClient is the client API for Msg service.
@@ -43,6 +47,19 @@ type MsgClient interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// SetDenomCompliance is a governance operation for setting the issuer and
+	// the compliance controls of a denom. An empty issuer removes the compliance
+	// controls of the denom, along with its frozen and allowlisted addresses.
+	SetDenomCompliance(ctx context.Context, in *MsgSetDenomCompliance, opts ...grpc.CallOption) (*MsgSetDenomComplianceResponse, error)
+	// SetFrozen defines a method for the issuer of a denom to freeze or unfreeze
+	// an address. A frozen address can neither send nor receive the denom.
+	SetFrozen(ctx context.Context, in *MsgSetFrozen, opts ...grpc.CallOption) (*MsgSetFrozenResponse, error)
+	// SetAllowlisted defines a method for the issuer of a denom to add or remove
+	// an address from the allowlisted recipients of the denom.
+	SetAllowlisted(ctx context.Context, in *MsgSetAllowlisted, opts ...grpc.CallOption) (*MsgSetAllowlistedResponse, error)
+	// Clawback defines a method for the issuer of a denom to move the spendable
+	// balance of an address into the issuer account.
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
 }
 
 type msgClient struct {
@@ -93,6 +110,46 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) SetDenomCompliance(ctx context.Context, in *MsgSetDenomCompliance, opts ...grpc.CallOption) (*MsgSetDenomComplianceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomComplianceResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomCompliance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFrozen(ctx context.Context, in *MsgSetFrozen, opts ...grpc.CallOption) (*MsgSetFrozenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetFrozenResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFrozen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAllowlisted(ctx context.Context, in *MsgSetAllowlisted, opts ...grpc.CallOption) (*MsgSetAllowlistedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetAllowlistedResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAllowlisted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, Msg_Clawback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -111,6 +168,19 @@ type MsgServer interface {
 	// included. Entries that already exist in the store, but that aren't
 	// included in this message, will be left unchanged.
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SetDenomCompliance is a governance operation for setting the issuer and
+	// the compliance controls of a denom. An empty issuer removes the compliance
+	// controls of the denom, along with its frozen and allowlisted addresses.
+	SetDenomCompliance(context.Context, *MsgSetDenomCompliance) (*MsgSetDenomComplianceResponse, error)
+	// SetFrozen defines a method for the issuer of a denom to freeze or unfreeze
+	// an address. A frozen address can neither send nor receive the denom.
+	SetFrozen(context.Context, *MsgSetFrozen) (*MsgSetFrozenResponse, error)
+	// SetAllowlisted defines a method for the issuer of a denom to add or remove
+	// an address from the allowlisted recipients of the denom.
+	SetAllowlisted(context.Context, *MsgSetAllowlisted) (*MsgSetAllowlistedResponse, error)
+	// Clawback defines a method for the issuer of a denom to move the spendable
+	// balance of an address into the issuer account.
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -133,6 +203,18 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) SetDenomCompliance(context.Context, *MsgSetDenomCompliance) (*MsgSetDenomComplianceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDenomCompliance not implemented")
+}
+func (UnimplementedMsgServer) SetFrozen(context.Context, *MsgSetFrozen) (*MsgSetFrozenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetFrozen not implemented")
+}
+func (UnimplementedMsgServer) SetAllowlisted(context.Context, *MsgSetAllowlisted) (*MsgSetAllowlistedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAllowlisted not implemented")
+}
+func (UnimplementedMsgServer) Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Clawback not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -226,6 +308,78 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetDenomCompliance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomCompliance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomCompliance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomCompliance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomCompliance(ctx, req.(*MsgSetDenomCompliance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFrozen)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFrozen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFrozen(ctx, req.(*MsgSetFrozen))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAllowlisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAllowlisted)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAllowlisted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAllowlisted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAllowlisted(ctx, req.(*MsgSetAllowlisted))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Clawback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -249,6 +403,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "SetDenomCompliance",
+			Handler:    _Msg_SetDenomCompliance_Handler,
+		},
+		{
+			MethodName: "SetFrozen",
+			Handler:    _Msg_SetFrozen_Handler,
+		},
+		{
+			MethodName: "SetAllowlisted",
+			Handler:    _Msg_SetAllowlisted_Handler,
+		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
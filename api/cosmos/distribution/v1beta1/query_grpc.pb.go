@@ -32,6 +32,9 @@ const (
 	Query_ValidatorHistoricalRewards_FullMethodName  = "/cosmos.distribution.v1beta1.Query/ValidatorHistoricalRewards"
 	Query_ValidatorCurrentRewards_FullMethodName     = "/cosmos.distribution.v1beta1.Query/ValidatorCurrentRewards"
 	Query_DelegatorStartingInfo_FullMethodName       = "/cosmos.distribution.v1beta1.Query/DelegatorStartingInfo"
+	Query_DelegatorAutoCompound_FullMethodName       = "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound"
+	Query_AutoCompoundRound_FullMethodName           = "/cosmos.distribution.v1beta1.Query/AutoCompoundRound"
+	Query_CommissionRecipients_FullMethodName        = "/cosmos.distribution.v1beta1.Query/CommissionRecipients"
 )
 
 // QueryClient is the client API for Query service.
@@ -60,6 +63,7 @@ type QueryClient interface {
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
+	//
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// ValidatorHistoricalRewards queries historical rewards for a validator at a specific period.
 	ValidatorHistoricalRewards(ctx context.Context, in *QueryValidatorHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorHistoricalRewardsResponse, error)
@@ -67,6 +71,14 @@ type QueryClient interface {
 	ValidatorCurrentRewards(ctx context.Context, in *QueryValidatorCurrentRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorCurrentRewardsResponse, error)
 	// DelegatorStartingInfo queries the starting info for a delegator.
 	DelegatorStartingInfo(ctx context.Context, in *QueryDelegatorStartingInfoRequest, opts ...grpc.CallOption) (*QueryDelegatorStartingInfoResponse, error)
+	// DelegatorAutoCompound queries the validators to which the delegations of a
+	// delegator are restaked automatically.
+	DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error)
+	// AutoCompoundRound queries the auto-compound round in progress.
+	AutoCompoundRound(ctx context.Context, in *QueryAutoCompoundRoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundRoundResponse, error)
+	// CommissionRecipients queries the addresses the commission of a validator
+	// is split among.
+	CommissionRecipients(ctx context.Context, in *QueryCommissionRecipientsRequest, opts ...grpc.CallOption) (*QueryCommissionRecipientsResponse, error)
 }
 
 type queryClient struct {
@@ -207,6 +219,36 @@ func (c *queryClient) DelegatorStartingInfo(ctx context.Context, in *QueryDelega
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDelegatorAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorAutoCompound_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoCompoundRound(ctx context.Context, in *QueryAutoCompoundRoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundRoundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAutoCompoundRoundResponse)
+	err := c.cc.Invoke(ctx, Query_AutoCompoundRound_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommissionRecipients(ctx context.Context, in *QueryCommissionRecipientsRequest, opts ...grpc.CallOption) (*QueryCommissionRecipientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCommissionRecipientsResponse)
+	err := c.cc.Invoke(ctx, Query_CommissionRecipients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -233,6 +275,7 @@ type QueryServer interface {
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
+	//
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// ValidatorHistoricalRewards queries historical rewards for a validator at a specific period.
 	ValidatorHistoricalRewards(context.Context, *QueryValidatorHistoricalRewardsRequest) (*QueryValidatorHistoricalRewardsResponse, error)
@@ -240,6 +283,14 @@ type QueryServer interface {
 	ValidatorCurrentRewards(context.Context, *QueryValidatorCurrentRewardsRequest) (*QueryValidatorCurrentRewardsResponse, error)
 	// DelegatorStartingInfo queries the starting info for a delegator.
 	DelegatorStartingInfo(context.Context, *QueryDelegatorStartingInfoRequest) (*QueryDelegatorStartingInfoResponse, error)
+	// DelegatorAutoCompound queries the validators to which the delegations of a
+	// delegator are restaked automatically.
+	DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error)
+	// AutoCompoundRound queries the auto-compound round in progress.
+	AutoCompoundRound(context.Context, *QueryAutoCompoundRoundRequest) (*QueryAutoCompoundRoundResponse, error)
+	// CommissionRecipients queries the addresses the commission of a validator
+	// is split among.
+	CommissionRecipients(context.Context, *QueryCommissionRecipientsRequest) (*QueryCommissionRecipientsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -289,6 +340,15 @@ func (UnimplementedQueryServer) ValidatorCurrentRewards(context.Context, *QueryV
 func (UnimplementedQueryServer) DelegatorStartingInfo(context.Context, *QueryDelegatorStartingInfoRequest) (*QueryDelegatorStartingInfoResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DelegatorStartingInfo not implemented")
 }
+func (UnimplementedQueryServer) DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DelegatorAutoCompound not implemented")
+}
+func (UnimplementedQueryServer) AutoCompoundRound(context.Context, *QueryAutoCompoundRoundRequest) (*QueryAutoCompoundRoundResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AutoCompoundRound not implemented")
+}
+func (UnimplementedQueryServer) CommissionRecipients(context.Context, *QueryCommissionRecipientsRequest) (*QueryCommissionRecipientsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CommissionRecipients not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -544,6 +604,60 @@ func _Query_DelegatorStartingInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, req.(*QueryDelegatorAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoCompoundRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AutoCompoundRound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundRound(ctx, req.(*QueryAutoCompoundRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommissionRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommissionRecipientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommissionRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CommissionRecipients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommissionRecipients(ctx, req.(*QueryCommissionRecipientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -603,6 +717,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorStartingInfo",
 			Handler:    _Query_DelegatorStartingInfo_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompound",
+			Handler:    _Query_DelegatorAutoCompound_Handler,
+		},
+		{
+			MethodName: "AutoCompoundRound",
+			Handler:    _Query_AutoCompoundRound_Handler,
+		},
+		{
+			MethodName: "CommissionRecipients",
+			Handler:    _Query_CommissionRecipients_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
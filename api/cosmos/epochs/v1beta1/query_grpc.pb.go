@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_EpochInfos_FullMethodName   = "/cosmos.epochs.v1beta1.Query/EpochInfos"
-	Query_CurrentEpoch_FullMethodName = "/cosmos.epochs.v1beta1.Query/CurrentEpoch"
+	Query_EpochInfos_FullMethodName              = "/cosmos.epochs.v1beta1.Query/EpochInfos"
+	Query_CurrentEpoch_FullMethodName            = "/cosmos.epochs.v1beta1.Query/CurrentEpoch"
+	Query_UpcomingEpochBoundaries_FullMethodName = "/cosmos.epochs.v1beta1.Query/UpcomingEpochBoundaries"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,6 +34,9 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochInfosRequest, opts ...grpc.CallOption) (*QueryEpochInfosResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// UpcomingEpochBoundaries provides the next epoch starts of specified
+	// identifier, with their time and estimated block height
+	UpcomingEpochBoundaries(ctx context.Context, in *QueryUpcomingEpochBoundariesRequest, opts ...grpc.CallOption) (*QueryUpcomingEpochBoundariesResponse, error)
 }
 
 type queryClient struct {
@@ -63,6 +67,16 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) UpcomingEpochBoundaries(ctx context.Context, in *QueryUpcomingEpochBoundariesRequest, opts ...grpc.CallOption) (*QueryUpcomingEpochBoundariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUpcomingEpochBoundariesResponse)
+	err := c.cc.Invoke(ctx, Query_UpcomingEpochBoundaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -73,6 +87,9 @@ type QueryServer interface {
 	EpochInfos(context.Context, *QueryEpochInfosRequest) (*QueryEpochInfosResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// UpcomingEpochBoundaries provides the next epoch starts of specified
+	// identifier, with their time and estimated block height
+	UpcomingEpochBoundaries(context.Context, *QueryUpcomingEpochBoundariesRequest) (*QueryUpcomingEpochBoundariesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -89,6 +106,9 @@ func (UnimplementedQueryServer) EpochInfos(context.Context, *QueryEpochInfosRequ
 func (UnimplementedQueryServer) CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (UnimplementedQueryServer) UpcomingEpochBoundaries(context.Context, *QueryUpcomingEpochBoundariesRequest) (*QueryUpcomingEpochBoundariesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpcomingEpochBoundaries not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -146,6 +166,24 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpcomingEpochBoundaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpcomingEpochBoundariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingEpochBoundaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UpcomingEpochBoundaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingEpochBoundaries(ctx, req.(*QueryUpcomingEpochBoundariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +199,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "UpcomingEpochBoundaries",
+			Handler:    _Query_UpcomingEpochBoundaries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/epochs/v1beta1/query.proto",
@@ -16,9 +16,11 @@ import (
 )
 
 var (
-	md_Minter                   protoreflect.MessageDescriptor
-	fd_Minter_inflation         protoreflect.FieldDescriptor
-	fd_Minter_annual_provisions protoreflect.FieldDescriptor
+	md_Minter                    protoreflect.MessageDescriptor
+	fd_Minter_inflation          protoreflect.FieldDescriptor
+	fd_Minter_annual_provisions  protoreflect.FieldDescriptor
+	fd_Minter_model_start_height protoreflect.FieldDescriptor
+	fd_Minter_epoch_number       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +28,8 @@ func init() {
 	md_Minter = File_cosmos_mint_v1beta1_mint_proto.Messages().ByName("Minter")
 	fd_Minter_inflation = md_Minter.Fields().ByName("inflation")
 	fd_Minter_annual_provisions = md_Minter.Fields().ByName("annual_provisions")
+	fd_Minter_model_start_height = md_Minter.Fields().ByName("model_start_height")
+	fd_Minter_epoch_number = md_Minter.Fields().ByName("epoch_number")
 }
 
 var _ protoreflect.Message = (*fastReflection_Minter)(nil)
@@ -105,6 +109,18 @@ func (x *fastReflection_Minter) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.ModelStartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ModelStartHeight)
+		if !f(fd_Minter_model_start_height, value) {
+			return
+		}
+	}
+	if x.EpochNumber != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EpochNumber)
+		if !f(fd_Minter_epoch_number, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -124,6 +140,10 @@ func (x *fastReflection_Minter) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Inflation != ""
 	case "cosmos.mint.v1beta1.Minter.annual_provisions":
 		return x.AnnualProvisions != ""
+	case "cosmos.mint.v1beta1.Minter.model_start_height":
+		return x.ModelStartHeight != int64(0)
+	case "cosmos.mint.v1beta1.Minter.epoch_number":
+		return x.EpochNumber != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Minter"))
@@ -144,6 +164,10 @@ func (x *fastReflection_Minter) Clear(fd protoreflect.FieldDescriptor) {
 		x.Inflation = ""
 	case "cosmos.mint.v1beta1.Minter.annual_provisions":
 		x.AnnualProvisions = ""
+	case "cosmos.mint.v1beta1.Minter.model_start_height":
+		x.ModelStartHeight = int64(0)
+	case "cosmos.mint.v1beta1.Minter.epoch_number":
+		x.EpochNumber = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Minter"))
@@ -166,6 +190,12 @@ func (x *fastReflection_Minter) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.mint.v1beta1.Minter.annual_provisions":
 		value := x.AnnualProvisions
 		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.v1beta1.Minter.model_start_height":
+		value := x.ModelStartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.mint.v1beta1.Minter.epoch_number":
+		value := x.EpochNumber
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Minter"))
@@ -190,6 +220,10 @@ func (x *fastReflection_Minter) Set(fd protoreflect.FieldDescriptor, value proto
 		x.Inflation = value.Interface().(string)
 	case "cosmos.mint.v1beta1.Minter.annual_provisions":
 		x.AnnualProvisions = value.Interface().(string)
+	case "cosmos.mint.v1beta1.Minter.model_start_height":
+		x.ModelStartHeight = value.Int()
+	case "cosmos.mint.v1beta1.Minter.epoch_number":
+		x.EpochNumber = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Minter"))
@@ -214,6 +248,10 @@ func (x *fastReflection_Minter) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field inflation of message cosmos.mint.v1beta1.Minter is not mutable"))
 	case "cosmos.mint.v1beta1.Minter.annual_provisions":
 		panic(fmt.Errorf("field annual_provisions of message cosmos.mint.v1beta1.Minter is not mutable"))
+	case "cosmos.mint.v1beta1.Minter.model_start_height":
+		panic(fmt.Errorf("field model_start_height of message cosmos.mint.v1beta1.Minter is not mutable"))
+	case "cosmos.mint.v1beta1.Minter.epoch_number":
+		panic(fmt.Errorf("field epoch_number of message cosmos.mint.v1beta1.Minter is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Minter"))
@@ -231,6 +269,10 @@ func (x *fastReflection_Minter) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "cosmos.mint.v1beta1.Minter.annual_provisions":
 		return protoreflect.ValueOfString("")
+	case "cosmos.mint.v1beta1.Minter.model_start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.mint.v1beta1.Minter.epoch_number":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Minter"))
@@ -308,6 +350,12 @@ func (x *fastReflection_Minter) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ModelStartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ModelStartHeight))
+		}
+		if x.EpochNumber != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochNumber))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -337,6 +385,16 @@ func (x *fastReflection_Minter) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.EpochNumber != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochNumber))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ModelStartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ModelStartHeight))
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.AnnualProvisions) > 0 {
 			i -= len(x.AnnualProvisions)
 			copy(dAtA[i:], x.AnnualProvisions)
@@ -464,6 +522,44 @@ func (x *fastReflection_Minter) ProtoMethods() *protoiface.Methods {
 				}
 				x.AnnualProvisions = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModelStartHeight", wireType)
+				}
+				x.ModelStartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ModelStartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				}
+				x.EpochNumber = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochNumber |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -500,37 +596,27 @@ func (x *fastReflection_Minter) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Params                       protoreflect.MessageDescriptor
-	fd_Params_mint_denom            protoreflect.FieldDescriptor
-	fd_Params_inflation_rate_change protoreflect.FieldDescriptor
-	fd_Params_inflation_max         protoreflect.FieldDescriptor
-	fd_Params_inflation_min         protoreflect.FieldDescriptor
-	fd_Params_goal_bonded           protoreflect.FieldDescriptor
-	fd_Params_blocks_per_year       protoreflect.FieldDescriptor
-	fd_Params_max_supply            protoreflect.FieldDescriptor
+	md_MintSegment                 protoreflect.MessageDescriptor
+	fd_MintSegment_start           protoreflect.FieldDescriptor
+	fd_MintSegment_annual_emission protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_mint_v1beta1_mint_proto_init()
-	md_Params = File_cosmos_mint_v1beta1_mint_proto.Messages().ByName("Params")
-	fd_Params_mint_denom = md_Params.Fields().ByName("mint_denom")
-	fd_Params_inflation_rate_change = md_Params.Fields().ByName("inflation_rate_change")
-	fd_Params_inflation_max = md_Params.Fields().ByName("inflation_max")
-	fd_Params_inflation_min = md_Params.Fields().ByName("inflation_min")
-	fd_Params_goal_bonded = md_Params.Fields().ByName("goal_bonded")
-	fd_Params_blocks_per_year = md_Params.Fields().ByName("blocks_per_year")
-	fd_Params_max_supply = md_Params.Fields().ByName("max_supply")
+	md_MintSegment = File_cosmos_mint_v1beta1_mint_proto.Messages().ByName("MintSegment")
+	fd_MintSegment_start = md_MintSegment.Fields().ByName("start")
+	fd_MintSegment_annual_emission = md_MintSegment.Fields().ByName("annual_emission")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_MintSegment)(nil)
 
-type fastReflection_Params Params
+type fastReflection_MintSegment MintSegment
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *MintSegment) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MintSegment)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
+func (x *MintSegment) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,43 +628,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_MintSegment_messageType fastReflection_MintSegment_messageType
+var _ protoreflect.MessageType = fastReflection_MintSegment_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_MintSegment_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_MintSegment_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MintSegment)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_MintSegment_messageType) New() protoreflect.Message {
+	return new(fastReflection_MintSegment)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_MintSegment_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintSegment
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_MintSegment) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintSegment
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_MintSegment) Type() protoreflect.MessageType {
+	return _fastReflection_MintSegment_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_MintSegment) New() protoreflect.Message {
+	return new(fastReflection_MintSegment)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_MintSegment) Interface() protoreflect.ProtoMessage {
+	return (*MintSegment)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -586,46 +672,16 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.MintDenom != "" {
-		value := protoreflect.ValueOfString(x.MintDenom)
-		if !f(fd_Params_mint_denom, value) {
-			return
-		}
-	}
-	if x.InflationRateChange != "" {
-		value := protoreflect.ValueOfString(x.InflationRateChange)
-		if !f(fd_Params_inflation_rate_change, value) {
-			return
-		}
-	}
-	if x.InflationMax != "" {
-		value := protoreflect.ValueOfString(x.InflationMax)
-		if !f(fd_Params_inflation_max, value) {
-			return
-		}
-	}
-	if x.InflationMin != "" {
-		value := protoreflect.ValueOfString(x.InflationMin)
-		if !f(fd_Params_inflation_min, value) {
+func (x *fastReflection_MintSegment) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Start != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Start)
+		if !f(fd_MintSegment_start, value) {
 			return
 		}
 	}
-	if x.GoalBonded != "" {
-		value := protoreflect.ValueOfString(x.GoalBonded)
-		if !f(fd_Params_goal_bonded, value) {
-			return
-		}
-	}
-	if x.BlocksPerYear != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.BlocksPerYear)
-		if !f(fd_Params_blocks_per_year, value) {
-			return
-		}
-	}
-	if x.MaxSupply != "" {
-		value := protoreflect.ValueOfString(x.MaxSupply)
-		if !f(fd_Params_max_supply, value) {
+	if x.AnnualEmission != "" {
+		value := protoreflect.ValueOfString(x.AnnualEmission)
+		if !f(fd_MintSegment_annual_emission, value) {
 			return
 		}
 	}
@@ -642,27 +698,17 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MintSegment) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.mint.v1beta1.Params.mint_denom":
-		return x.MintDenom != ""
-	case "cosmos.mint.v1beta1.Params.inflation_rate_change":
-		return x.InflationRateChange != ""
-	case "cosmos.mint.v1beta1.Params.inflation_max":
-		return x.InflationMax != ""
-	case "cosmos.mint.v1beta1.Params.inflation_min":
-		return x.InflationMin != ""
-	case "cosmos.mint.v1beta1.Params.goal_bonded":
-		return x.GoalBonded != ""
-	case "cosmos.mint.v1beta1.Params.blocks_per_year":
-		return x.BlocksPerYear != uint64(0)
-	case "cosmos.mint.v1beta1.Params.max_supply":
-		return x.MaxSupply != ""
+	case "cosmos.mint.v1beta1.MintSegment.start":
+		return x.Start != uint64(0)
+	case "cosmos.mint.v1beta1.MintSegment.annual_emission":
+		return x.AnnualEmission != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.MintSegment"))
 		}
-		panic(fmt.Errorf("message cosmos.mint.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.MintSegment does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -672,27 +718,17 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MintSegment) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.mint.v1beta1.Params.mint_denom":
-		x.MintDenom = ""
-	case "cosmos.mint.v1beta1.Params.inflation_rate_change":
-		x.InflationRateChange = ""
-	case "cosmos.mint.v1beta1.Params.inflation_max":
-		x.InflationMax = ""
-	case "cosmos.mint.v1beta1.Params.inflation_min":
-		x.InflationMin = ""
-	case "cosmos.mint.v1beta1.Params.goal_bonded":
-		x.GoalBonded = ""
-	case "cosmos.mint.v1beta1.Params.blocks_per_year":
-		x.BlocksPerYear = uint64(0)
-	case "cosmos.mint.v1beta1.Params.max_supply":
-		x.MaxSupply = ""
+	case "cosmos.mint.v1beta1.MintSegment.start":
+		x.Start = uint64(0)
+	case "cosmos.mint.v1beta1.MintSegment.annual_emission":
+		x.AnnualEmission = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.MintSegment"))
 		}
-		panic(fmt.Errorf("message cosmos.mint.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.MintSegment does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -702,34 +738,19 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MintSegment) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.mint.v1beta1.Params.mint_denom":
-		value := x.MintDenom
-		return protoreflect.ValueOfString(value)
-	case "cosmos.mint.v1beta1.Params.inflation_rate_change":
-		value := x.InflationRateChange
-		return protoreflect.ValueOfString(value)
-	case "cosmos.mint.v1beta1.Params.inflation_max":
-		value := x.InflationMax
-		return protoreflect.ValueOfString(value)
-	case "cosmos.mint.v1beta1.Params.inflation_min":
-		value := x.InflationMin
-		return protoreflect.ValueOfString(value)
-	case "cosmos.mint.v1beta1.Params.goal_bonded":
-		value := x.GoalBonded
-		return protoreflect.ValueOfString(value)
-	case "cosmos.mint.v1beta1.Params.blocks_per_year":
-		value := x.BlocksPerYear
+	case "cosmos.mint.v1beta1.MintSegment.start":
+		value := x.Start
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.mint.v1beta1.Params.max_supply":
-		value := x.MaxSupply
+	case "cosmos.mint.v1beta1.MintSegment.annual_emission":
+		value := x.AnnualEmission
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.MintSegment"))
 		}
-		panic(fmt.Errorf("message cosmos.mint.v1beta1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.MintSegment does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -743,27 +764,17 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MintSegment) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.mint.v1beta1.Params.mint_denom":
-		x.MintDenom = value.Interface().(string)
-	case "cosmos.mint.v1beta1.Params.inflation_rate_change":
-		x.InflationRateChange = value.Interface().(string)
-	case "cosmos.mint.v1beta1.Params.inflation_max":
-		x.InflationMax = value.Interface().(string)
-	case "cosmos.mint.v1beta1.Params.inflation_min":
-		x.InflationMin = value.Interface().(string)
-	case "cosmos.mint.v1beta1.Params.goal_bonded":
-		x.GoalBonded = value.Interface().(string)
-	case "cosmos.mint.v1beta1.Params.blocks_per_year":
-		x.BlocksPerYear = value.Uint()
-	case "cosmos.mint.v1beta1.Params.max_supply":
-		x.MaxSupply = value.Interface().(string)
+	case "cosmos.mint.v1beta1.MintSegment.start":
+		x.Start = value.Uint()
+	case "cosmos.mint.v1beta1.MintSegment.annual_emission":
+		x.AnnualEmission = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.MintSegment"))
 		}
-		panic(fmt.Errorf("message cosmos.mint.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.MintSegment does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -777,64 +788,44 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MintSegment) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.mint.v1beta1.Params.mint_denom":
-		panic(fmt.Errorf("field mint_denom of message cosmos.mint.v1beta1.Params is not mutable"))
-	case "cosmos.mint.v1beta1.Params.inflation_rate_change":
-		panic(fmt.Errorf("field inflation_rate_change of message cosmos.mint.v1beta1.Params is not mutable"))
-	case "cosmos.mint.v1beta1.Params.inflation_max":
-		panic(fmt.Errorf("field inflation_max of message cosmos.mint.v1beta1.Params is not mutable"))
-	case "cosmos.mint.v1beta1.Params.inflation_min":
-		panic(fmt.Errorf("field inflation_min of message cosmos.mint.v1beta1.Params is not mutable"))
-	case "cosmos.mint.v1beta1.Params.goal_bonded":
-		panic(fmt.Errorf("field goal_bonded of message cosmos.mint.v1beta1.Params is not mutable"))
-	case "cosmos.mint.v1beta1.Params.blocks_per_year":
-		panic(fmt.Errorf("field blocks_per_year of message cosmos.mint.v1beta1.Params is not mutable"))
-	case "cosmos.mint.v1beta1.Params.max_supply":
-		panic(fmt.Errorf("field max_supply of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.MintSegment.start":
+		panic(fmt.Errorf("field start of message cosmos.mint.v1beta1.MintSegment is not mutable"))
+	case "cosmos.mint.v1beta1.MintSegment.annual_emission":
+		panic(fmt.Errorf("field annual_emission of message cosmos.mint.v1beta1.MintSegment is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.MintSegment"))
 		}
-		panic(fmt.Errorf("message cosmos.mint.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.MintSegment does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MintSegment) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.mint.v1beta1.Params.mint_denom":
-		return protoreflect.ValueOfString("")
-	case "cosmos.mint.v1beta1.Params.inflation_rate_change":
-		return protoreflect.ValueOfString("")
-	case "cosmos.mint.v1beta1.Params.inflation_max":
-		return protoreflect.ValueOfString("")
-	case "cosmos.mint.v1beta1.Params.inflation_min":
-		return protoreflect.ValueOfString("")
-	case "cosmos.mint.v1beta1.Params.goal_bonded":
-		return protoreflect.ValueOfString("")
-	case "cosmos.mint.v1beta1.Params.blocks_per_year":
+	case "cosmos.mint.v1beta1.MintSegment.start":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.mint.v1beta1.Params.max_supply":
+	case "cosmos.mint.v1beta1.MintSegment.annual_emission":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.MintSegment"))
 		}
-		panic(fmt.Errorf("message cosmos.mint.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.MintSegment does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MintSegment) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.mint.v1beta1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.mint.v1beta1.MintSegment", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -842,7 +833,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MintSegment) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -853,7 +844,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MintSegment) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -865,7 +856,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_MintSegment) IsValid() bool {
 	return x != nil
 }
 
@@ -875,9 +866,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MintSegment) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*MintSegment)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -889,30 +880,10 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.MintDenom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.InflationRateChange)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.InflationMax)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.InflationMin)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.GoalBonded)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.BlocksPerYear != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlocksPerYear))
+		if x.Start != 0 {
+			n += 1 + runtime.Sov(uint64(x.Start))
 		}
-		l = len(x.MaxSupply)
+		l = len(x.AnnualEmission)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -926,7 +897,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*MintSegment)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -945,17 +916,897 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MaxSupply) > 0 {
-			i -= len(x.MaxSupply)
-			copy(dAtA[i:], x.MaxSupply)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSupply)))
+		if len(x.AnnualEmission) > 0 {
+			i -= len(x.AnnualEmission)
+			copy(dAtA[i:], x.AnnualEmission)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AnnualEmission)))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x12
 		}
-		if x.BlocksPerYear != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlocksPerYear))
+		if x.Start != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Start))
 			i--
-			dAtA[i] = 0x30
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MintSegment)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintSegment: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				}
+				x.Start = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Start |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnnualEmission", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AnnualEmission = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Params_14_list)(nil)
+
+type _Params_14_list struct {
+	list *[]*MintSegment
+}
+
+func (x *_Params_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintSegment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintSegment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_14_list) AppendMutable() protoreflect.Value {
+	v := new(MintSegment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_14_list) NewElement() protoreflect.Value {
+	v := new(MintSegment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                            protoreflect.MessageDescriptor
+	fd_Params_mint_denom                 protoreflect.FieldDescriptor
+	fd_Params_inflation_rate_change      protoreflect.FieldDescriptor
+	fd_Params_inflation_max              protoreflect.FieldDescriptor
+	fd_Params_inflation_min              protoreflect.FieldDescriptor
+	fd_Params_goal_bonded                protoreflect.FieldDescriptor
+	fd_Params_blocks_per_year            protoreflect.FieldDescriptor
+	fd_Params_max_supply                 protoreflect.FieldDescriptor
+	fd_Params_mint_model                 protoreflect.FieldDescriptor
+	fd_Params_annual_emission            protoreflect.FieldDescriptor
+	fd_Params_halving_interval           protoreflect.FieldDescriptor
+	fd_Params_capped_supply_rate         protoreflect.FieldDescriptor
+	fd_Params_piecewise_basis            protoreflect.FieldDescriptor
+	fd_Params_piecewise_epoch_identifier protoreflect.FieldDescriptor
+	fd_Params_piecewise_segments         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_mint_v1beta1_mint_proto_init()
+	md_Params = File_cosmos_mint_v1beta1_mint_proto.Messages().ByName("Params")
+	fd_Params_mint_denom = md_Params.Fields().ByName("mint_denom")
+	fd_Params_inflation_rate_change = md_Params.Fields().ByName("inflation_rate_change")
+	fd_Params_inflation_max = md_Params.Fields().ByName("inflation_max")
+	fd_Params_inflation_min = md_Params.Fields().ByName("inflation_min")
+	fd_Params_goal_bonded = md_Params.Fields().ByName("goal_bonded")
+	fd_Params_blocks_per_year = md_Params.Fields().ByName("blocks_per_year")
+	fd_Params_max_supply = md_Params.Fields().ByName("max_supply")
+	fd_Params_mint_model = md_Params.Fields().ByName("mint_model")
+	fd_Params_annual_emission = md_Params.Fields().ByName("annual_emission")
+	fd_Params_halving_interval = md_Params.Fields().ByName("halving_interval")
+	fd_Params_capped_supply_rate = md_Params.Fields().ByName("capped_supply_rate")
+	fd_Params_piecewise_basis = md_Params.Fields().ByName("piecewise_basis")
+	fd_Params_piecewise_epoch_identifier = md_Params.Fields().ByName("piecewise_epoch_identifier")
+	fd_Params_piecewise_segments = md_Params.Fields().ByName("piecewise_segments")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MintDenom != "" {
+		value := protoreflect.ValueOfString(x.MintDenom)
+		if !f(fd_Params_mint_denom, value) {
+			return
+		}
+	}
+	if x.InflationRateChange != "" {
+		value := protoreflect.ValueOfString(x.InflationRateChange)
+		if !f(fd_Params_inflation_rate_change, value) {
+			return
+		}
+	}
+	if x.InflationMax != "" {
+		value := protoreflect.ValueOfString(x.InflationMax)
+		if !f(fd_Params_inflation_max, value) {
+			return
+		}
+	}
+	if x.InflationMin != "" {
+		value := protoreflect.ValueOfString(x.InflationMin)
+		if !f(fd_Params_inflation_min, value) {
+			return
+		}
+	}
+	if x.GoalBonded != "" {
+		value := protoreflect.ValueOfString(x.GoalBonded)
+		if !f(fd_Params_goal_bonded, value) {
+			return
+		}
+	}
+	if x.BlocksPerYear != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlocksPerYear)
+		if !f(fd_Params_blocks_per_year, value) {
+			return
+		}
+	}
+	if x.MaxSupply != "" {
+		value := protoreflect.ValueOfString(x.MaxSupply)
+		if !f(fd_Params_max_supply, value) {
+			return
+		}
+	}
+	if x.MintModel != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.MintModel))
+		if !f(fd_Params_mint_model, value) {
+			return
+		}
+	}
+	if x.AnnualEmission != "" {
+		value := protoreflect.ValueOfString(x.AnnualEmission)
+		if !f(fd_Params_annual_emission, value) {
+			return
+		}
+	}
+	if x.HalvingInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HalvingInterval)
+		if !f(fd_Params_halving_interval, value) {
+			return
+		}
+	}
+	if x.CappedSupplyRate != "" {
+		value := protoreflect.ValueOfString(x.CappedSupplyRate)
+		if !f(fd_Params_capped_supply_rate, value) {
+			return
+		}
+	}
+	if x.PiecewiseBasis != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PiecewiseBasis))
+		if !f(fd_Params_piecewise_basis, value) {
+			return
+		}
+	}
+	if x.PiecewiseEpochIdentifier != "" {
+		value := protoreflect.ValueOfString(x.PiecewiseEpochIdentifier)
+		if !f(fd_Params_piecewise_epoch_identifier, value) {
+			return
+		}
+	}
+	if len(x.PiecewiseSegments) != 0 {
+		value := protoreflect.ValueOfList(&_Params_14_list{list: &x.PiecewiseSegments})
+		if !f(fd_Params_piecewise_segments, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.Params.mint_denom":
+		return x.MintDenom != ""
+	case "cosmos.mint.v1beta1.Params.inflation_rate_change":
+		return x.InflationRateChange != ""
+	case "cosmos.mint.v1beta1.Params.inflation_max":
+		return x.InflationMax != ""
+	case "cosmos.mint.v1beta1.Params.inflation_min":
+		return x.InflationMin != ""
+	case "cosmos.mint.v1beta1.Params.goal_bonded":
+		return x.GoalBonded != ""
+	case "cosmos.mint.v1beta1.Params.blocks_per_year":
+		return x.BlocksPerYear != uint64(0)
+	case "cosmos.mint.v1beta1.Params.max_supply":
+		return x.MaxSupply != ""
+	case "cosmos.mint.v1beta1.Params.mint_model":
+		return x.MintModel != 0
+	case "cosmos.mint.v1beta1.Params.annual_emission":
+		return x.AnnualEmission != ""
+	case "cosmos.mint.v1beta1.Params.halving_interval":
+		return x.HalvingInterval != uint64(0)
+	case "cosmos.mint.v1beta1.Params.capped_supply_rate":
+		return x.CappedSupplyRate != ""
+	case "cosmos.mint.v1beta1.Params.piecewise_basis":
+		return x.PiecewiseBasis != 0
+	case "cosmos.mint.v1beta1.Params.piecewise_epoch_identifier":
+		return x.PiecewiseEpochIdentifier != ""
+	case "cosmos.mint.v1beta1.Params.piecewise_segments":
+		return len(x.PiecewiseSegments) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.Params.mint_denom":
+		x.MintDenom = ""
+	case "cosmos.mint.v1beta1.Params.inflation_rate_change":
+		x.InflationRateChange = ""
+	case "cosmos.mint.v1beta1.Params.inflation_max":
+		x.InflationMax = ""
+	case "cosmos.mint.v1beta1.Params.inflation_min":
+		x.InflationMin = ""
+	case "cosmos.mint.v1beta1.Params.goal_bonded":
+		x.GoalBonded = ""
+	case "cosmos.mint.v1beta1.Params.blocks_per_year":
+		x.BlocksPerYear = uint64(0)
+	case "cosmos.mint.v1beta1.Params.max_supply":
+		x.MaxSupply = ""
+	case "cosmos.mint.v1beta1.Params.mint_model":
+		x.MintModel = 0
+	case "cosmos.mint.v1beta1.Params.annual_emission":
+		x.AnnualEmission = ""
+	case "cosmos.mint.v1beta1.Params.halving_interval":
+		x.HalvingInterval = uint64(0)
+	case "cosmos.mint.v1beta1.Params.capped_supply_rate":
+		x.CappedSupplyRate = ""
+	case "cosmos.mint.v1beta1.Params.piecewise_basis":
+		x.PiecewiseBasis = 0
+	case "cosmos.mint.v1beta1.Params.piecewise_epoch_identifier":
+		x.PiecewiseEpochIdentifier = ""
+	case "cosmos.mint.v1beta1.Params.piecewise_segments":
+		x.PiecewiseSegments = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.mint.v1beta1.Params.mint_denom":
+		value := x.MintDenom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.v1beta1.Params.inflation_rate_change":
+		value := x.InflationRateChange
+		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.v1beta1.Params.inflation_max":
+		value := x.InflationMax
+		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.v1beta1.Params.inflation_min":
+		value := x.InflationMin
+		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.v1beta1.Params.goal_bonded":
+		value := x.GoalBonded
+		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.v1beta1.Params.blocks_per_year":
+		value := x.BlocksPerYear
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.mint.v1beta1.Params.max_supply":
+		value := x.MaxSupply
+		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.v1beta1.Params.mint_model":
+		value := x.MintModel
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.mint.v1beta1.Params.annual_emission":
+		value := x.AnnualEmission
+		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.v1beta1.Params.halving_interval":
+		value := x.HalvingInterval
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.mint.v1beta1.Params.capped_supply_rate":
+		value := x.CappedSupplyRate
+		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.v1beta1.Params.piecewise_basis":
+		value := x.PiecewiseBasis
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.mint.v1beta1.Params.piecewise_epoch_identifier":
+		value := x.PiecewiseEpochIdentifier
+		return protoreflect.ValueOfString(value)
+	case "cosmos.mint.v1beta1.Params.piecewise_segments":
+		if len(x.PiecewiseSegments) == 0 {
+			return protoreflect.ValueOfList(&_Params_14_list{})
+		}
+		listValue := &_Params_14_list{list: &x.PiecewiseSegments}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.Params.mint_denom":
+		x.MintDenom = value.Interface().(string)
+	case "cosmos.mint.v1beta1.Params.inflation_rate_change":
+		x.InflationRateChange = value.Interface().(string)
+	case "cosmos.mint.v1beta1.Params.inflation_max":
+		x.InflationMax = value.Interface().(string)
+	case "cosmos.mint.v1beta1.Params.inflation_min":
+		x.InflationMin = value.Interface().(string)
+	case "cosmos.mint.v1beta1.Params.goal_bonded":
+		x.GoalBonded = value.Interface().(string)
+	case "cosmos.mint.v1beta1.Params.blocks_per_year":
+		x.BlocksPerYear = value.Uint()
+	case "cosmos.mint.v1beta1.Params.max_supply":
+		x.MaxSupply = value.Interface().(string)
+	case "cosmos.mint.v1beta1.Params.mint_model":
+		x.MintModel = (MintModel)(value.Enum())
+	case "cosmos.mint.v1beta1.Params.annual_emission":
+		x.AnnualEmission = value.Interface().(string)
+	case "cosmos.mint.v1beta1.Params.halving_interval":
+		x.HalvingInterval = value.Uint()
+	case "cosmos.mint.v1beta1.Params.capped_supply_rate":
+		x.CappedSupplyRate = value.Interface().(string)
+	case "cosmos.mint.v1beta1.Params.piecewise_basis":
+		x.PiecewiseBasis = (PiecewiseBasis)(value.Enum())
+	case "cosmos.mint.v1beta1.Params.piecewise_epoch_identifier":
+		x.PiecewiseEpochIdentifier = value.Interface().(string)
+	case "cosmos.mint.v1beta1.Params.piecewise_segments":
+		lv := value.List()
+		clv := lv.(*_Params_14_list)
+		x.PiecewiseSegments = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.Params.piecewise_segments":
+		if x.PiecewiseSegments == nil {
+			x.PiecewiseSegments = []*MintSegment{}
+		}
+		value := &_Params_14_list{list: &x.PiecewiseSegments}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.mint.v1beta1.Params.mint_denom":
+		panic(fmt.Errorf("field mint_denom of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.Params.inflation_rate_change":
+		panic(fmt.Errorf("field inflation_rate_change of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.Params.inflation_max":
+		panic(fmt.Errorf("field inflation_max of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.Params.inflation_min":
+		panic(fmt.Errorf("field inflation_min of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.Params.goal_bonded":
+		panic(fmt.Errorf("field goal_bonded of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.Params.blocks_per_year":
+		panic(fmt.Errorf("field blocks_per_year of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.Params.max_supply":
+		panic(fmt.Errorf("field max_supply of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.Params.mint_model":
+		panic(fmt.Errorf("field mint_model of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.Params.annual_emission":
+		panic(fmt.Errorf("field annual_emission of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.Params.halving_interval":
+		panic(fmt.Errorf("field halving_interval of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.Params.capped_supply_rate":
+		panic(fmt.Errorf("field capped_supply_rate of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.Params.piecewise_basis":
+		panic(fmt.Errorf("field piecewise_basis of message cosmos.mint.v1beta1.Params is not mutable"))
+	case "cosmos.mint.v1beta1.Params.piecewise_epoch_identifier":
+		panic(fmt.Errorf("field piecewise_epoch_identifier of message cosmos.mint.v1beta1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.mint.v1beta1.Params.mint_denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.mint.v1beta1.Params.inflation_rate_change":
+		return protoreflect.ValueOfString("")
+	case "cosmos.mint.v1beta1.Params.inflation_max":
+		return protoreflect.ValueOfString("")
+	case "cosmos.mint.v1beta1.Params.inflation_min":
+		return protoreflect.ValueOfString("")
+	case "cosmos.mint.v1beta1.Params.goal_bonded":
+		return protoreflect.ValueOfString("")
+	case "cosmos.mint.v1beta1.Params.blocks_per_year":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.mint.v1beta1.Params.max_supply":
+		return protoreflect.ValueOfString("")
+	case "cosmos.mint.v1beta1.Params.mint_model":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.mint.v1beta1.Params.annual_emission":
+		return protoreflect.ValueOfString("")
+	case "cosmos.mint.v1beta1.Params.halving_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.mint.v1beta1.Params.capped_supply_rate":
+		return protoreflect.ValueOfString("")
+	case "cosmos.mint.v1beta1.Params.piecewise_basis":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.mint.v1beta1.Params.piecewise_epoch_identifier":
+		return protoreflect.ValueOfString("")
+	case "cosmos.mint.v1beta1.Params.piecewise_segments":
+		list := []*MintSegment{}
+		return protoreflect.ValueOfList(&_Params_14_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.mint.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.mint.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.mint.v1beta1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MintDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InflationRateChange)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InflationMax)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InflationMin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.GoalBonded)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlocksPerYear != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlocksPerYear))
+		}
+		l = len(x.MaxSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MintModel != 0 {
+			n += 1 + runtime.Sov(uint64(x.MintModel))
+		}
+		l = len(x.AnnualEmission)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.HalvingInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.HalvingInterval))
+		}
+		l = len(x.CappedSupplyRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PiecewiseBasis != 0 {
+			n += 1 + runtime.Sov(uint64(x.PiecewiseBasis))
+		}
+		l = len(x.PiecewiseEpochIdentifier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PiecewiseSegments) > 0 {
+			for _, e := range x.PiecewiseSegments {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PiecewiseSegments) > 0 {
+			for iNdEx := len(x.PiecewiseSegments) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PiecewiseSegments[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.PiecewiseEpochIdentifier) > 0 {
+			i -= len(x.PiecewiseEpochIdentifier)
+			copy(dAtA[i:], x.PiecewiseEpochIdentifier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PiecewiseEpochIdentifier)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.PiecewiseBasis != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PiecewiseBasis))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.CappedSupplyRate) > 0 {
+			i -= len(x.CappedSupplyRate)
+			copy(dAtA[i:], x.CappedSupplyRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CappedSupplyRate)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.HalvingInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HalvingInterval))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.AnnualEmission) > 0 {
+			i -= len(x.AnnualEmission)
+			copy(dAtA[i:], x.AnnualEmission)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AnnualEmission)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.MintModel != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MintModel))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.MaxSupply) > 0 {
+			i -= len(x.MaxSupply)
+			copy(dAtA[i:], x.MaxSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSupply)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.BlocksPerYear != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlocksPerYear))
+			i--
+			dAtA[i] = 0x30
 		}
 		if len(x.GoalBonded) > 0 {
 			i -= len(x.GoalBonded)
@@ -1025,25 +1876,121 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationRateChange = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				x.InflationMax = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1071,11 +2018,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MintDenom = string(dAtA[iNdEx:postIndex])
+				x.InflationMin = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1103,11 +2050,30 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InflationRateChange = string(dAtA[iNdEx:postIndex])
+				x.GoalBonded = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+				}
+				x.BlocksPerYear = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlocksPerYear |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1135,11 +2101,30 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InflationMax = string(dAtA[iNdEx:postIndex])
+				x.MaxSupply = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintModel", wireType)
+				}
+				x.MintModel = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MintModel |= MintModel(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnnualEmission", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1167,11 +2152,30 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.InflationMin = string(dAtA[iNdEx:postIndex])
+				x.AnnualEmission = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+				}
+				x.HalvingInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HalvingInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CappedSupplyRate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1199,13 +2203,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.GoalBonded = string(dAtA[iNdEx:postIndex])
+				x.CappedSupplyRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
+			case 12:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PiecewiseBasis", wireType)
 				}
-				x.BlocksPerYear = 0
+				x.PiecewiseBasis = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1215,14 +2219,14 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.BlocksPerYear |= uint64(b&0x7F) << shift
+					x.PiecewiseBasis |= PiecewiseBasis(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 7:
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PiecewiseEpochIdentifier", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1250,7 +2254,41 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MaxSupply = string(dAtA[iNdEx:postIndex])
+				x.PiecewiseEpochIdentifier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PiecewiseSegments", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PiecewiseSegments = append(x.PiecewiseSegments, &MintSegment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PiecewiseSegments[len(x.PiecewiseSegments)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1300,6 +2338,121 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// MintModel defines the model computing the annual provisions.
+type MintModel int32
+
+const (
+	// MINT_MODEL_BONDED_RATIO_UNSPECIFIED adjusts the inflation rate towards the
+	// goal bonded ratio within the min and max inflation.
+	MintModel_MINT_MODEL_BONDED_RATIO_UNSPECIFIED MintModel = 0
+	// MINT_MODEL_FIXED_EMISSION mints annual_emission per year.
+	MintModel_MINT_MODEL_FIXED_EMISSION MintModel = 1
+	// MINT_MODEL_HALVING mints annual_emission per year, halved every
+	// halving_interval blocks.
+	MintModel_MINT_MODEL_HALVING MintModel = 2
+	// MINT_MODEL_CAPPED_SUPPLY mints per year capped_supply_rate of the supply
+	// remaining below max_supply.
+	MintModel_MINT_MODEL_CAPPED_SUPPLY MintModel = 3
+	// MINT_MODEL_PIECEWISE mints per year the annual emission of the segment the
+	// current height or epoch falls in.
+	MintModel_MINT_MODEL_PIECEWISE MintModel = 4
+)
+
+// Enum value maps for MintModel.
+var (
+	MintModel_name = map[int32]string{
+		0: "MINT_MODEL_BONDED_RATIO_UNSPECIFIED",
+		1: "MINT_MODEL_FIXED_EMISSION",
+		2: "MINT_MODEL_HALVING",
+		3: "MINT_MODEL_CAPPED_SUPPLY",
+		4: "MINT_MODEL_PIECEWISE",
+	}
+	MintModel_value = map[string]int32{
+		"MINT_MODEL_BONDED_RATIO_UNSPECIFIED": 0,
+		"MINT_MODEL_FIXED_EMISSION":           1,
+		"MINT_MODEL_HALVING":                  2,
+		"MINT_MODEL_CAPPED_SUPPLY":            3,
+		"MINT_MODEL_PIECEWISE":                4,
+	}
+)
+
+func (x MintModel) Enum() *MintModel {
+	p := new(MintModel)
+	*p = x
+	return p
+}
+
+func (x MintModel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MintModel) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_mint_v1beta1_mint_proto_enumTypes[0].Descriptor()
+}
+
+func (MintModel) Type() protoreflect.EnumType {
+	return &file_cosmos_mint_v1beta1_mint_proto_enumTypes[0]
+}
+
+func (x MintModel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MintModel.Descriptor instead.
+func (MintModel) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{0}
+}
+
+// PiecewiseBasis defines what the segments of the piecewise model start at.
+type PiecewiseBasis int32
+
+const (
+	// PIECEWISE_BASIS_HEIGHT_UNSPECIFIED starts the segments at block heights.
+	PiecewiseBasis_PIECEWISE_BASIS_HEIGHT_UNSPECIFIED PiecewiseBasis = 0
+	// PIECEWISE_BASIS_EPOCH starts the segments at epoch numbers of the
+	// piecewise epoch identifier.
+	PiecewiseBasis_PIECEWISE_BASIS_EPOCH PiecewiseBasis = 1
+)
+
+// Enum value maps for PiecewiseBasis.
+var (
+	PiecewiseBasis_name = map[int32]string{
+		0: "PIECEWISE_BASIS_HEIGHT_UNSPECIFIED",
+		1: "PIECEWISE_BASIS_EPOCH",
+	}
+	PiecewiseBasis_value = map[string]int32{
+		"PIECEWISE_BASIS_HEIGHT_UNSPECIFIED": 0,
+		"PIECEWISE_BASIS_EPOCH":              1,
+	}
+)
+
+func (x PiecewiseBasis) Enum() *PiecewiseBasis {
+	p := new(PiecewiseBasis)
+	*p = x
+	return p
+}
+
+func (x PiecewiseBasis) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PiecewiseBasis) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_mint_v1beta1_mint_proto_enumTypes[1].Descriptor()
+}
+
+func (PiecewiseBasis) Type() protoreflect.EnumType {
+	return &file_cosmos_mint_v1beta1_mint_proto_enumTypes[1]
+}
+
+func (x PiecewiseBasis) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PiecewiseBasis.Descriptor instead.
+func (PiecewiseBasis) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{1}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	state         protoimpl.MessageState
@@ -1310,6 +2463,12 @@ type Minter struct {
 	Inflation string `protobuf:"bytes,1,opt,name=inflation,proto3" json:"inflation,omitempty"`
 	// current annual expected provisions
 	AnnualProvisions string `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3" json:"annual_provisions,omitempty"`
+	// height at which the current mint model was selected, the halving model
+	// counts its halvings from it.
+	ModelStartHeight int64 `protobuf:"varint,3,opt,name=model_start_height,json=modelStartHeight,proto3" json:"model_start_height,omitempty"`
+	// number of the last ended epoch of the piecewise epoch identifier, the
+	// piecewise model by epoch selects its segment from it.
+	EpochNumber int64 `protobuf:"varint,4,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 }
 
 func (x *Minter) Reset() {
@@ -1346,6 +2505,66 @@ func (x *Minter) GetAnnualProvisions() string {
 	return ""
 }
 
+func (x *Minter) GetModelStartHeight() int64 {
+	if x != nil {
+		return x.ModelStartHeight
+	}
+	return 0
+}
+
+func (x *Minter) GetEpochNumber() int64 {
+	if x != nil {
+		return x.EpochNumber
+	}
+	return 0
+}
+
+// MintSegment defines a segment of the piecewise model.
+type MintSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height or epoch number the segment starts at.
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// amount minted per year within the segment.
+	AnnualEmission string `protobuf:"bytes,2,opt,name=annual_emission,json=annualEmission,proto3" json:"annual_emission,omitempty"`
+}
+
+func (x *MintSegment) Reset() {
+	*x = MintSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintSegment) ProtoMessage() {}
+
+// Deprecated: Use MintSegment.ProtoReflect.Descriptor instead.
+func (*MintSegment) Descriptor() ([]byte, []int) {
+	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MintSegment) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *MintSegment) GetAnnualEmission() string {
+	if x != nil {
+		return x.AnnualEmission
+	}
+	return ""
+}
+
 // Params defines the parameters for the x/mint module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -1368,12 +2587,28 @@ type Params struct {
 	//
 	// A value of "0" indicates an unlimited (infinite) maximum supply.
 	MaxSupply string `protobuf:"bytes,7,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// model computing the annual provisions.
+	MintModel MintModel `protobuf:"varint,8,opt,name=mint_model,json=mintModel,proto3,enum=cosmos.mint.v1beta1.MintModel" json:"mint_model,omitempty"`
+	// amount minted per year by the fixed emission model, and before the first
+	// halving by the halving model.
+	AnnualEmission string `protobuf:"bytes,9,opt,name=annual_emission,json=annualEmission,proto3" json:"annual_emission,omitempty"`
+	// number of blocks after which the halving model halves the annual emission.
+	HalvingInterval uint64 `protobuf:"varint,10,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+	// share of the supply remaining below max_supply minted per year by the
+	// capped supply model.
+	CappedSupplyRate string `protobuf:"bytes,11,opt,name=capped_supply_rate,json=cappedSupplyRate,proto3" json:"capped_supply_rate,omitempty"`
+	// what the segments of the piecewise model start at.
+	PiecewiseBasis PiecewiseBasis `protobuf:"varint,12,opt,name=piecewise_basis,json=piecewiseBasis,proto3,enum=cosmos.mint.v1beta1.PiecewiseBasis" json:"piecewise_basis,omitempty"`
+	// epoch identifier the piecewise model by epoch counts the epochs of.
+	PiecewiseEpochIdentifier string `protobuf:"bytes,13,opt,name=piecewise_epoch_identifier,json=piecewiseEpochIdentifier,proto3" json:"piecewise_epoch_identifier,omitempty"`
+	// segments of the piecewise model, sorted by start.
+	PiecewiseSegments []*MintSegment `protobuf:"bytes,14,rep,name=piecewise_segments,json=piecewiseSegments,proto3" json:"piecewise_segments,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[1]
+		mi := &file_cosmos_mint_v1beta1_mint_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1387,7 +2622,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Params) GetMintDenom() string {
@@ -1439,6 +2674,55 @@ func (x *Params) GetMaxSupply() string {
 	return ""
 }
 
+func (x *Params) GetMintModel() MintModel {
+	if x != nil {
+		return x.MintModel
+	}
+	return MintModel_MINT_MODEL_BONDED_RATIO_UNSPECIFIED
+}
+
+func (x *Params) GetAnnualEmission() string {
+	if x != nil {
+		return x.AnnualEmission
+	}
+	return ""
+}
+
+func (x *Params) GetHalvingInterval() uint64 {
+	if x != nil {
+		return x.HalvingInterval
+	}
+	return 0
+}
+
+func (x *Params) GetCappedSupplyRate() string {
+	if x != nil {
+		return x.CappedSupplyRate
+	}
+	return ""
+}
+
+func (x *Params) GetPiecewiseBasis() PiecewiseBasis {
+	if x != nil {
+		return x.PiecewiseBasis
+	}
+	return PiecewiseBasis_PIECEWISE_BASIS_HEIGHT_UNSPECIFIED
+}
+
+func (x *Params) GetPiecewiseEpochIdentifier() string {
+	if x != nil {
+		return x.PiecewiseEpochIdentifier
+	}
+	return ""
+}
+
+func (x *Params) GetPiecewiseSegments() []*MintSegment {
+	if x != nil {
+		return x.PiecewiseSegments
+	}
+	return nil
+}
+
 var File_cosmos_mint_v1beta1_mint_proto protoreflect.FileDescriptor
 
 var file_cosmos_mint_v1beta1_mint_proto_rawDesc = []byte{
@@ -1449,7 +2733,7 @@ var file_cosmos_mint_v1beta1_mint_proto_rawDesc = []byte{
 	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d,
-	0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x01, 0x0a, 0x06, 0x4d, 0x69,
+	0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x02, 0x0a, 0x06, 0x4d, 0x69,
 	0x6e, 0x74, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x09, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
 	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
@@ -1461,56 +2745,130 @@ var file_cosmos_mint_v1beta1_mint_proto_rawDesc = []byte{
 	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
 	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x44, 0x65, 0x63, 0x52, 0x10, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xbe, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
-	0x6a, 0x0a, 0x15, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
-	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
-	0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x5b, 0x0a, 0x0d, 0x69,
-	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
-	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x12, 0x5b, 0x0a, 0x0d, 0x69, 0x6e, 0x66, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x7e, 0x0a, 0x0b, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x59, 0x0a, 0x0f, 0x61,
+	0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd1, 0x08, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x6a, 0x0a, 0x15, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
 	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
 	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
-	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x12, 0x57, 0x0a, 0x0b, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x62, 0x6f,
-	0x6e, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
-	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x26,
-	0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x79, 0x65, 0x61,
-	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x50,
-	0x65, 0x72, 0x59, 0x65, 0x61, 0x72, 0x12, 0x4f, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75,
-	0x70, 0x70, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6d, 0x61,
-	0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x5b, 0x0a, 0x0d,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x12, 0x5b, 0x0a, 0x0d, 0x69, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x12, 0x57, 0x0a, 0x0b, 0x67, 0x6f, 0x61, 0x6c, 0x5f, 0x62,
+	0x6f, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x67, 0x6f, 0x61, 0x6c, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x12,
+	0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x79, 0x65,
+	0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x50, 0x65, 0x72, 0x59, 0x65, 0x61, 0x72, 0x12, 0x4f, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6d,
+	0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x3d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x09, 0x6d, 0x69,
+	0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x59, 0x0a, 0x0f, 0x61, 0x6e, 0x6e, 0x75, 0x61,
+	0x6c, 0x5f, 0x65, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0e, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x45, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x68, 0x61,
+	0x6c, 0x76, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x64, 0x0a,
+	0x12, 0x63, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x10, 0x63, 0x61, 0x70, 0x70, 0x65, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65,
+	0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x42, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x6d, 0x69, 0x6e, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0xa2, 0x02, 0x03, 0x43, 0x4d, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4d, 0x69, 0x6e,
-	0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a,
-	0x4d, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x31, 0x2e, 0x50, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65, 0x42, 0x61, 0x73, 0x69,
+	0x73, 0x52, 0x0e, 0x70, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65, 0x42, 0x61, 0x73, 0x69,
+	0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x70, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65, 0x5f, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x70, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x5a, 0x0a, 0x12, 0x70, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x70, 0x69, 0x65, 0x63, 0x65, 0x77,
+	0x69, 0x73, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x6d,
+	0x69, 0x6e, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2a, 0xa8, 0x02, 0x0a, 0x09, 0x4d,
+	0x69, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x41, 0x0a, 0x23, 0x4d, 0x49, 0x4e, 0x54,
+	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x42, 0x4f, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x4d, 0x69, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x39, 0x0a, 0x19, 0x4d,
+	0x49, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x46, 0x49, 0x58, 0x45, 0x44, 0x5f,
+	0x45, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16,
+	0x4d, 0x69, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x69, 0x78, 0x65, 0x64, 0x45, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x4d, 0x49, 0x4e, 0x54, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x48, 0x41, 0x4c, 0x56, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a, 0x14,
+	0x8a, 0x9d, 0x20, 0x10, 0x4d, 0x69, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x48, 0x61, 0x6c,
+	0x76, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x18, 0x4d, 0x49, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x4c, 0x5f, 0x43, 0x41, 0x50, 0x50, 0x45, 0x44, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4c, 0x59,
+	0x10, 0x03, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x4d, 0x69, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x43, 0x61, 0x70, 0x70, 0x65, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a,
+	0x14, 0x4d, 0x49, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x50, 0x49, 0x45, 0x43,
+	0x45, 0x57, 0x49, 0x53, 0x45, 0x10, 0x04, 0x1a, 0x16, 0x8a, 0x9d, 0x20, 0x12, 0x4d, 0x69, 0x6e,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65, 0x1a,
+	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0x8c, 0x01, 0x0a, 0x0e, 0x50, 0x69, 0x65, 0x63, 0x65, 0x77,
+	0x69, 0x73, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x40, 0x0a, 0x22, 0x50, 0x49, 0x45, 0x43,
+	0x45, 0x57, 0x49, 0x53, 0x45, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x53, 0x5f, 0x48, 0x45, 0x49, 0x47,
+	0x48, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x50, 0x69, 0x65, 0x63, 0x65, 0x77, 0x69, 0x73, 0x65, 0x42,
+	0x61, 0x73, 0x69, 0x73, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x50, 0x49,
+	0x45, 0x43, 0x45, 0x57, 0x49, 0x53, 0x45, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x53, 0x5f, 0x45, 0x50,
+	0x4f, 0x43, 0x48, 0x10, 0x01, 0x1a, 0x17, 0x8a, 0x9d, 0x20, 0x13, 0x50, 0x69, 0x65, 0x63, 0x65,
+	0x77, 0x69, 0x73, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x1a, 0x04,
+	0x88, 0xa3, 0x1e, 0x00, 0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x42, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x3b, 0x6d, 0x69, 0x6e, 0x74, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2,
+	0x02, 0x03, 0x43, 0x4d, 0x58, 0xaa, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x4d,
+	0x69, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x13, 0x43, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4d, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0xe2, 0x02, 0x1f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4d, 0x69, 0x6e, 0x74, 0x5c,
+	0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x4d, 0x69,
+	0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1525,17 +2883,24 @@ func file_cosmos_mint_v1beta1_mint_proto_rawDescGZIP() []byte {
 	return file_cosmos_mint_v1beta1_mint_proto_rawDescData
 }
 
-var file_cosmos_mint_v1beta1_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cosmos_mint_v1beta1_mint_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cosmos_mint_v1beta1_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_cosmos_mint_v1beta1_mint_proto_goTypes = []interface{}{
-	(*Minter)(nil), // 0: cosmos.mint.v1beta1.Minter
-	(*Params)(nil), // 1: cosmos.mint.v1beta1.Params
+	(MintModel)(0),      // 0: cosmos.mint.v1beta1.MintModel
+	(PiecewiseBasis)(0), // 1: cosmos.mint.v1beta1.PiecewiseBasis
+	(*Minter)(nil),      // 2: cosmos.mint.v1beta1.Minter
+	(*MintSegment)(nil), // 3: cosmos.mint.v1beta1.MintSegment
+	(*Params)(nil),      // 4: cosmos.mint.v1beta1.Params
 }
 var file_cosmos_mint_v1beta1_mint_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: cosmos.mint.v1beta1.Params.mint_model:type_name -> cosmos.mint.v1beta1.MintModel
+	1, // 1: cosmos.mint.v1beta1.Params.piecewise_basis:type_name -> cosmos.mint.v1beta1.PiecewiseBasis
+	3, // 2: cosmos.mint.v1beta1.Params.piecewise_segments:type_name -> cosmos.mint.v1beta1.MintSegment
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_mint_v1beta1_mint_proto_init() }
@@ -1557,6 +2922,18 @@ func file_cosmos_mint_v1beta1_mint_proto_init() {
 			}
 		}
 		file_cosmos_mint_v1beta1_mint_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_mint_v1beta1_mint_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
@@ -1574,13 +2951,14 @@ func file_cosmos_mint_v1beta1_mint_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_mint_v1beta1_mint_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_cosmos_mint_v1beta1_mint_proto_goTypes,
 		DependencyIndexes: file_cosmos_mint_v1beta1_mint_proto_depIdxs,
+		EnumInfos:         file_cosmos_mint_v1beta1_mint_proto_enumTypes,
 		MessageInfos:      file_cosmos_mint_v1beta1_mint_proto_msgTypes,
 	}.Build()
 	File_cosmos_mint_v1beta1_mint_proto = out.File
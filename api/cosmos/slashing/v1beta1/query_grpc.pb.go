@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName             = "/cosmos.slashing.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName        = "/cosmos.slashing.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName       = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_AutoUnjail_FullMethodName         = "/cosmos.slashing.v1beta1.Query/AutoUnjail"
+	Query_MaintenanceWindow_FullMethodName  = "/cosmos.slashing.v1beta1.Query/MaintenanceWindow"
+	Query_MaintenanceWindows_FullMethodName = "/cosmos.slashing.v1beta1.Query/MaintenanceWindows"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +39,12 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// AutoUnjail queries the automatic unjailing status of a validator
+	AutoUnjail(ctx context.Context, in *QueryAutoUnjailRequest, opts ...grpc.CallOption) (*QueryAutoUnjailResponse, error)
+	// MaintenanceWindow queries the maintenance window of a validator
+	MaintenanceWindow(ctx context.Context, in *QueryMaintenanceWindowRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowResponse, error)
+	// MaintenanceWindows queries the maintenance windows of all validators
+	MaintenanceWindows(ctx context.Context, in *QueryMaintenanceWindowsRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowsResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +85,36 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) AutoUnjail(ctx context.Context, in *QueryAutoUnjailRequest, opts ...grpc.CallOption) (*QueryAutoUnjailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAutoUnjailResponse)
+	err := c.cc.Invoke(ctx, Query_AutoUnjail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaintenanceWindow(ctx context.Context, in *QueryMaintenanceWindowRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, Query_MaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaintenanceWindows(ctx context.Context, in *QueryMaintenanceWindowsRequest, opts ...grpc.CallOption) (*QueryMaintenanceWindowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, Query_MaintenanceWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -88,6 +127,12 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// AutoUnjail queries the automatic unjailing status of a validator
+	AutoUnjail(context.Context, *QueryAutoUnjailRequest) (*QueryAutoUnjailResponse, error)
+	// MaintenanceWindow queries the maintenance window of a validator
+	MaintenanceWindow(context.Context, *QueryMaintenanceWindowRequest) (*QueryMaintenanceWindowResponse, error)
+	// MaintenanceWindows queries the maintenance windows of all validators
+	MaintenanceWindows(context.Context, *QueryMaintenanceWindowsRequest) (*QueryMaintenanceWindowsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +152,15 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) AutoUnjail(context.Context, *QueryAutoUnjailRequest) (*QueryAutoUnjailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AutoUnjail not implemented")
+}
+func (UnimplementedQueryServer) MaintenanceWindow(context.Context, *QueryMaintenanceWindowRequest) (*QueryMaintenanceWindowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MaintenanceWindow not implemented")
+}
+func (UnimplementedQueryServer) MaintenanceWindows(context.Context, *QueryMaintenanceWindowsRequest) (*QueryMaintenanceWindowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MaintenanceWindows not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -182,6 +236,60 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoUnjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoUnjailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoUnjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AutoUnjail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoUnjail(ctx, req.(*QueryAutoUnjailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaintenanceWindow(ctx, req.(*QueryMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaintenanceWindows(ctx, req.(*QueryMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +309,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "AutoUnjail",
+			Handler:    _Query_AutoUnjail_Handler,
+		},
+		{
+			MethodName: "MaintenanceWindow",
+			Handler:    _Query_MaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "MaintenanceWindows",
+			Handler:    _Query_MaintenanceWindows_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
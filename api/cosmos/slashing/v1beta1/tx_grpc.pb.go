@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_Unjail_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/Unjail"
-	Msg_UpdateParams_FullMethodName = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_Unjail_FullMethodName               = "/cosmos.slashing.v1beta1.Msg/Unjail"
+	Msg_UpdateParams_FullMethodName         = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_SetAutoUnjail_FullMethodName        = "/cosmos.slashing.v1beta1.Msg/SetAutoUnjail"
+	Msg_SetMaintenanceWindow_FullMethodName = "/cosmos.slashing.v1beta1.Msg/SetMaintenanceWindow"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +38,13 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetAutoUnjail defines a method for a validator to opt in or out of the
+	// automatic unjailing after a downtime jailing.
+	SetAutoUnjail(ctx context.Context, in *MsgSetAutoUnjail, opts ...grpc.CallOption) (*MsgSetAutoUnjailResponse, error)
+	// SetMaintenanceWindow defines a governance operation for pausing the
+	// downtime accounting of a validator for a bounded period. The authority
+	// defaults to the x/gov module account.
+	SetMaintenanceWindow(ctx context.Context, in *MsgSetMaintenanceWindow, opts ...grpc.CallOption) (*MsgSetMaintenanceWindowResponse, error)
 }
 
 type msgClient struct {
@@ -66,6 +75,26 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetAutoUnjail(ctx context.Context, in *MsgSetAutoUnjail, opts ...grpc.CallOption) (*MsgSetAutoUnjailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetAutoUnjailResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAutoUnjail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaintenanceWindow(ctx context.Context, in *MsgSetMaintenanceWindow, opts ...grpc.CallOption) (*MsgSetMaintenanceWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -79,6 +108,13 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetAutoUnjail defines a method for a validator to opt in or out of the
+	// automatic unjailing after a downtime jailing.
+	SetAutoUnjail(context.Context, *MsgSetAutoUnjail) (*MsgSetAutoUnjailResponse, error)
+	// SetMaintenanceWindow defines a governance operation for pausing the
+	// downtime accounting of a validator for a bounded period. The authority
+	// defaults to the x/gov module account.
+	SetMaintenanceWindow(context.Context, *MsgSetMaintenanceWindow) (*MsgSetMaintenanceWindowResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -95,6 +131,12 @@ func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailRes
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SetAutoUnjail(context.Context, *MsgSetAutoUnjail) (*MsgSetAutoUnjailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetAutoUnjail not implemented")
+}
+func (UnimplementedMsgServer) SetMaintenanceWindow(context.Context, *MsgSetMaintenanceWindow) (*MsgSetMaintenanceWindowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetMaintenanceWindow not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -152,6 +194,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoUnjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoUnjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAutoUnjail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoUnjail(ctx, req.(*MsgSetAutoUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaintenanceWindow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaintenanceWindow(ctx, req.(*MsgSetMaintenanceWindow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -167,6 +245,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetAutoUnjail",
+			Handler:    _Msg_SetAutoUnjail_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceWindow",
+			Handler:    _Msg_SetMaintenanceWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
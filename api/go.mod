@@ -7,7 +7,7 @@ require (
 	github.com/cosmos/gogoproto v1.7.2
 	google.golang.org/genproto/googleapis/api v0.0.0-20260511170946-3700d4141b60
 	google.golang.org/grpc v1.79.3
-	google.golang.org/protobuf v1.36.12
+	google.golang.org/protobuf v1.36.11
 )
 
 require (
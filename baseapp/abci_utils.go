@@ -267,6 +267,12 @@ func (h *DefaultProposalHandler) SetSignerExtractionAdapter(signerExtAdapter mem
 // is used in both steps, and applications must ensure that this is the case in
 // non-default handlers.
 //
+// - A sdk.BundleTx is selected as a whole or not at all. With the default
+// SignerExtractionAdapter, the sequences of the signers of all its bundled
+// transactions are checked against the transactions already selected, so that
+// a bundle and a standalone copy of one of its transactions are never both
+// included.
+//
 // - If no mempool is set or if the mempool is a no-op mempool, the transactions
 // requested from CometBFT will simply be returned, which, by default, are in
 // FIFO order.
@@ -321,6 +327,9 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			unorderedTx, ok := memTx.Tx.(sdk.TxWithUnordered)
 			isUnordered := ok && unorderedTx.GetUnordered()
 			txSignersSeqs := make(map[string]uint64)
+			// the lowest sequence of each signer in the tx, a bundle may carry
+			// several transactions of the same signer
+			txSignersFirstSeqs := make(map[string]uint64)
 
 			// if the tx is unordered, we don't need to check the sequence, we just add it
 			if !isUnordered {
@@ -335,9 +344,15 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 				// so we add them and continue given that we don't need to check the sequence.
 				shouldAdd := true
 				for _, signer := range signerData {
-					seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
+					// A signer may sign several transactions of a bundle, each of them
+					// must follow the previous one.
+					seq, ok := txSignersSeqs[signer.Signer.String()]
+					if !ok {
+						seq, ok = selectedTxsSignersSeqs[signer.Signer.String()]
+					}
 					if !ok {
 						txSignersSeqs[signer.Signer.String()] = signer.Sequence
+						txSignersFirstSeqs[signer.Signer.String()] = signer.Sequence
 						continue
 					}
 
@@ -380,9 +395,10 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 						} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
 							// The transaction hasn't been added but it passed the
 							// verification, so we know that the sequence is correct.
-							// So we set this sender's sequence to seq-1, in order
-							// to avoid unnecessary calls to PrepareProposalVerifyTx.
-							selectedTxsSignersSeqs[sender] = seq - 1
+							// So we set this sender's sequence to the one preceding
+							// its first sequence in the tx, in order to avoid
+							// unnecessary calls to PrepareProposalVerifyTx.
+							selectedTxsSignersSeqs[sender] = txSignersFirstSeqs[sender] - 1
 						}
 					}
 				}
@@ -703,6 +703,45 @@ func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_PriorityNonceMempoolTxSe
 	}
 }
 
+func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_BundleSequences() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+	secret := []byte("secret1")
+
+	// a bundle carrying two txs of the same signer, too big for the proposal
+	bundleTx, err := authtx.NewTxBundle(txConfig.TxEncoder(),
+		buildMsg(s.T(), txConfig, []byte(`0`), [][]byte{secret}, []uint64{3}),
+		buildMsg(s.T(), txConfig, []byte(`1`), [][]byte{secret}, []uint64{4}),
+	)
+	s.Require().NoError(err)
+	bundleBz, err := authtx.BundleTxEncoder(txConfig.TxEncoder())(bundleTx)
+	s.Require().NoError(err)
+
+	// a tx reusing the sequence of the second tx of the bundle
+	tx := buildMsg(s.T(), txConfig, []byte(`2`), [][]byte{secret}, []uint64{4})
+	bz, err := txConfig.TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	app := mock.NewMockProposalTxVerifier(gomock.NewController(s.T()))
+	app.EXPECT().PrepareProposalVerifyTx(bundleTx).Return(bundleBz, nil).AnyTimes()
+	app.EXPECT().PrepareProposalVerifyTx(tx).Return(bz, nil).AnyTimes()
+
+	mp := mempool.NewPriorityMempool(mempool.DefaultPriorityNonceMempoolConfig())
+	s.Require().NoError(mp.Insert(s.ctx.WithPriority(10), bundleTx, mempool.InsertOption{}))
+	s.Require().NoError(mp.Insert(s.ctx.WithPriority(10), tx, mempool.InsertOption{}))
+
+	// the skipped bundle leaves the next expected sequence at its first tx, so
+	// the tx is not selected, as its sequence does not follow
+	ph := baseapp.NewDefaultProposalHandler(mp, app)
+	resp, err := ph.PrepareProposalHandler()(s.ctx, &abci.RequestPrepareProposal{
+		Txs:        [][]byte{bundleBz, bz},
+		MaxTxBytes: int64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{bz})),
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.Txs)
+}
+
 func (s *ABCIUtilsTestSuite) buildSignedTx(declaredGas uint64) (sdk.Tx, []byte) {
 	s.T().Helper()
 	cdc := codectestutil.CodecOptions{}.NewCodec()
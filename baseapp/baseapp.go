@@ -863,6 +863,63 @@ func (app *BaseApp) RunTx(mode sdk.ExecMode, txBytes []byte, tx sdk.Tx, txIndex
 		}
 	}
 
+	if bundleTx, ok := tx.(sdk.BundleTx); ok {
+		// Each bundled transaction runs with the gas meter set up by its own
+		// AnteHandler, the gas they consume is accumulated in the tx gas meter.
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+		priority, anteCache, msgCache, bundleResult, bundleAnteEvents, err := app.runBundle(ctx, mode, bundleTx, &gasWanted)
+		if err != nil {
+			// A failed bundle still takes block space, the fees and sequences of
+			// its transactions which passed the AnteHandler are committed.
+			if mode == execModeFinalize && anteCache != nil {
+				anteCache.Write()
+			}
+
+			if mode == execModeReCheck {
+				errMempool := mempool.RemoveWithReason(ctx, app.mempool, tx, mempool.RemoveReason{
+					Caller: mempool.CallerRunTxRecheck,
+					Error:  err,
+				})
+				if errMempool != nil {
+					return gInfo, nil, bundleAnteEvents, errors.Join(err, errMempool)
+				}
+			}
+
+			return gInfo, nil, bundleAnteEvents, err
+		}
+
+		if mode == execModeFinalize {
+			// When block gas exceeds, it'll panic and won't commit the cached store.
+			consumeBlockGas()
+		}
+
+		// The message branch only holds AnteHandler state changes outside of
+		// block finalization and simulation.
+		msgCache.Write()
+		anteCache.Write()
+
+		switch mode {
+		case execModeCheck:
+			err = app.mempool.Insert(ctx.WithPriority(priority), tx, mempool.InsertOption{GasWanted: gasWanted})
+			if err != nil {
+				return gInfo, nil, bundleAnteEvents, err
+			}
+		case execModeFinalize:
+			reason := mempool.RemoveReason{Caller: mempool.CallerRunTxFinalize}
+			err = mempool.RemoveWithReason(ctx, app.mempool, tx, reason)
+			if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				return gInfo, nil, bundleAnteEvents, fmt.Errorf("failed to remove tx from mempool: %w", err)
+			}
+
+			if inst != nil {
+				inst.TxCount.Add(ctx, 1)
+			}
+		}
+
+		return gInfo, bundleResult, bundleAnteEvents, nil
+	}
+
 	if app.anteHandler != nil {
 		var (
 			anteCtx sdk.Context
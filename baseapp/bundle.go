@@ -0,0 +1,160 @@
+package baseapp
+
+import (
+	"math"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// runBundle executes the transactions of a bundle in two steps. The AnteHandler
+// of every bundled transaction first runs in order within anteCache, so that the
+// fees and sequences of the bundled transactions can be committed even if the
+// bundle fails, as it still takes block space. The messages and PostHandler of
+// every bundled transaction then run in order within msgCache, a branch of
+// anteCache which is to be written only if all of them succeed. The gas each
+// bundled transaction consumes is charged to the gas meter of ctx. The returned
+// priority is the lowest priority of the bundled transactions.
+//
+// On failure, anteCache holds the AnteHandler state changes of the bundled
+// transactions which passed it, and msgCache is nil.
+func (app *BaseApp) runBundle(ctx sdk.Context, mode sdk.ExecMode, bundleTx sdk.BundleTx, gasWanted *uint64) (
+	priority int64, anteCache, msgCache storetypes.CacheMultiStore, result *sdk.Result, anteEvents []abci.Event, err error,
+) {
+	txs := bundleTx.GetTxs()
+	txBytes := bundleTx.GetTxBytes()
+	if len(txs) == 0 || len(txs) != len(txBytes) {
+		return 0, nil, nil, nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "a bundle must contain at least one transaction")
+	}
+
+	// The AnteHandler sets up the gas meter of each bundled transaction. Whatever
+	// they consume, even when panicking, is charged to the gas meter of the bundle.
+	bundleGasMeter := ctx.GasMeter()
+	var gasMeters []storetypes.GasMeter
+	defer func() {
+		for _, gasMeter := range gasMeters {
+			bundleGasMeter.ConsumeGas(gasMeter.GasConsumedToLimit(), "bundled tx")
+		}
+	}()
+
+	anteCtx, anteCache := app.cacheTxContext(ctx)
+	txCtxs := make([]sdk.Context, len(txs))
+	txAnteEvents := make([][]abci.Event, len(txs))
+
+	for i, tx := range txs {
+		// each bundled transaction is charged for its own bytes only
+		txCtx := anteCtx.WithTxBytes(txBytes[i]).WithEventManager(sdk.NewEventManager())
+		txCtxs[i], txAnteEvents[i], err = app.runBundledAnte(txCtx, mode, tx)
+
+		// GasMeter expected to be set in AnteHandler
+		if gasMeter := txCtxs[i].GasMeter(); gasMeter != bundleGasMeter {
+			gasMeters = append(gasMeters, gasMeter)
+			*gasWanted += gasMeter.Limit()
+		}
+
+		if err != nil {
+			return 0, anteCache, nil, nil, anteEvents, errorsmod.Wrapf(err, "failed to execute bundled tx; tx index: %d", i)
+		}
+
+		anteEvents = append(anteEvents, txAnteEvents[i]...)
+	}
+
+	msgCtx, msgCache := app.cacheTxContext(anteCtx)
+	result = &sdk.Result{}
+	priority = math.MaxInt64
+
+	for i, tx := range txs {
+		txCtx := txCtxs[i].WithMultiStore(msgCtx.MultiStore())
+		txResult, err := app.runBundledMsgs(txCtx, mode, tx, i, txAnteEvents[i])
+		if err != nil {
+			return 0, anteCache, nil, nil, anteEvents, errorsmod.Wrapf(err, "failed to execute bundled tx; tx index: %d", i)
+		}
+
+		priority = min(priority, txCtx.Priority())
+		result.Events = append(result.Events, txResult.Events...)
+		result.MsgResponses = append(result.MsgResponses, txResult.MsgResponses...)
+	}
+
+	result.Data, err = makeABCIData(result.MsgResponses)
+	if err != nil {
+		return 0, anteCache, nil, nil, anteEvents, errorsmod.Wrap(err, "failed to marshal tx data")
+	}
+
+	return priority, anteCache, msgCache, result, anteEvents, nil
+}
+
+// runBundledAnte runs the AnteHandler of a bundled transaction on top of the
+// multistore of ctx, to which its state changes are written on success, so that
+// the next bundled transaction observes them. It returns the context set up by
+// the AnteHandler, which the messages of the transaction run with.
+func (app *BaseApp) runBundledAnte(ctx sdk.Context, mode sdk.ExecMode, tx sdk.Tx) (sdk.Context, []abci.Event, error) {
+	if app.anteHandler == nil {
+		return ctx, nil, nil
+	}
+
+	ms := ctx.MultiStore()
+	anteCtx, anteCache := app.cacheTxContext(ctx)
+	anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
+	newCtx, err := app.anteHandler(anteCtx, tx, mode == execModeSimulate)
+	if !newCtx.IsZero() {
+		// At this point, newCtx.MultiStore() is a store branch, or something else
+		// replaced by the AnteHandler. We want the original multistore.
+		ctx = newCtx.WithMultiStore(ms)
+	}
+
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	anteCache.Write()
+	return ctx, ctx.EventManager().Events().ToABCIEvents(), nil
+}
+
+// runBundledMsgs runs the messages and the PostHandler of a bundled transaction
+// on top of the multistore of ctx, to which its state changes are written on
+// success, so that the next bundled transaction observes them. The events of the
+// transaction are tagged with its index in the bundle.
+func (app *BaseApp) runBundledMsgs(ctx sdk.Context, mode sdk.ExecMode, tx sdk.Tx, txIndex int, anteEvents []abci.Event) (*sdk.Result, error) {
+	runMsgCtx, msgCache := app.cacheTxContext(ctx)
+
+	msgsV2, err := tx.GetMsgsV2()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := app.runMsgs(runMsgCtx, tx.GetMsgs(), msgsV2, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	if app.postHandler != nil {
+		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
+
+		newCtx, err := app.postHandler(postCtx, tx, mode == execModeSimulate, true)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "postHandler")
+		}
+
+		result.Events = append(result.Events, newCtx.EventManager().ABCIEvents()...)
+	}
+
+	// Messages only run when finalizing a block or simulating, the next bundled
+	// transactions must observe their state changes.
+	if mode == execModeFinalize || mode == execModeSimulate {
+		msgCache.Write()
+		result.Events = append(anteEvents, result.Events...)
+	}
+
+	indexAttr := abci.EventAttribute{Key: sdk.AttributeKeyBundleTxIndex, Value: strconv.Itoa(txIndex)}
+	for i := range result.Events {
+		result.Events[i].Attributes = append(result.Events[i].Attributes, indexAttr)
+	}
+
+	return result, nil
+}
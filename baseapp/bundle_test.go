@@ -0,0 +1,172 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func TestABCI_FinalizeBlock_TxBundle(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+	suite.baseApp.SetTxDecoder(authtx.BundleTxDecoder(suite.cdc, suite.txConfig.TxDecoder()))
+	suite.baseApp.SetTxEncoder(authtx.BundleTxEncoder(suite.txConfig.TxEncoder()))
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	encodeBundle := func(txs ...sdk.Tx) []byte {
+		t.Helper()
+		bundleTx, err := authtx.NewTxBundle(suite.txConfig.TxEncoder(), txs...)
+		require.NoError(t, err)
+		bz, err := authtx.BundleTxEncoder(suite.txConfig.TxEncoder())(bundleTx)
+		require.NoError(t, err)
+		return bz
+	}
+
+	// the bundled txs are executed in order, each of them going through the
+	// ante handler
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: 1,
+		Txs: [][]byte{encodeBundle(
+			newTxCounter(t, suite.txConfig, 0, 0),
+			newTxCounter(t, suite.txConfig, 1, 1, 2),
+		)},
+	})
+	require.NoError(t, err)
+	require.True(t, res.TxResults[0].IsOK(), res.TxResults[0].Log)
+
+	indexes := map[string]bool{}
+	for _, ev := range res.TxResults[0].Events {
+		for _, attr := range ev.Attributes {
+			if attr.Key == sdk.AttributeKeyBundleTxIndex {
+				indexes[attr.Value] = true
+			}
+		}
+	}
+	require.Equal(t, map[string]bool{"0": true, "1": true}, indexes)
+
+	var txMsgData sdk.TxMsgData
+	require.NoError(t, suite.cdc.Unmarshal(res.TxResults[0].Data, &txMsgData))
+	require.Len(t, txMsgData.MsgResponses, 3)
+
+	store := getFinalizeBlockStateCtx(suite.baseApp).KVStore(capKey1)
+	require.Equal(t, int64(2), getIntFromStore(t, store, anteKey))
+	require.Equal(t, int64(3), getIntFromStore(t, store, deliverKey))
+
+	// a failing message reverts the messages of the whole bundle, but the ante
+	// handler state changes of its txs are committed, as the bundle takes block
+	// space
+	res, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: 1,
+		Txs: [][]byte{
+			encodeBundle(
+				newTxCounter(t, suite.txConfig, 2, 3),
+				setFailOnHandler(suite.txConfig, newTxCounter(t, suite.txConfig, 3, 4), true),
+			),
+			encodeBundle(
+				newTxCounter(t, suite.txConfig, 4, 3),
+				setFailOnAnte(t, suite.txConfig, newTxCounter(t, suite.txConfig, 5, 4), true),
+			),
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, res.TxResults[0].IsOK())
+	require.Contains(t, res.TxResults[0].Log, "tx index: 1")
+	require.False(t, res.TxResults[1].IsOK())
+	require.Contains(t, res.TxResults[1].Log, "tx index: 1")
+
+	// the ante handler of the first tx of the second bundle passed before the
+	// second one failed
+	store = getFinalizeBlockStateCtx(suite.baseApp).KVStore(capKey1)
+	require.Equal(t, int64(5), getIntFromStore(t, store, anteKey))
+	require.Equal(t, int64(3), getIntFromStore(t, store, deliverKey))
+
+	_, err = suite.baseApp.Commit()
+	require.NoError(t, err)
+}
+
+func TestABCI_CheckTx_TxBundle(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+	suite.baseApp.SetTxDecoder(authtx.BundleTxDecoder(suite.cdc, suite.txConfig.TxDecoder()))
+
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, []byte("deliver-key")})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	bundleTx, err := authtx.NewTxBundle(suite.txConfig.TxEncoder(),
+		newTxCounter(t, suite.txConfig, 0, 0),
+		newTxCounter(t, suite.txConfig, 1, 1),
+	)
+	require.NoError(t, err)
+	txBytes, err := authtx.BundleTxEncoder(suite.txConfig.TxEncoder())(bundleTx)
+	require.NoError(t, err)
+
+	r, err := suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: txBytes})
+	require.NoError(t, err)
+	require.True(t, r.IsOK(), r.Log)
+
+	// the ante handler state changes of all the bundled txs are persisted in
+	// the check state
+	store := getCheckStateCtx(suite.baseApp).KVStore(capKey1)
+	require.Equal(t, int64(2), getIntFromStore(t, store, anteKey))
+}
+
+func TestABCI_FinalizeBlock_TxBundleTxBytes(t *testing.T) {
+	// the ante handler charges the size of the tx bytes, as ConsumeTxSizeGas
+	var sizes []int
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			sizes = append(sizes, len(ctx.TxBytes()))
+			return ctx, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt)
+	suite.baseApp.SetTxDecoder(authtx.BundleTxDecoder(suite.cdc, suite.txConfig.TxDecoder()))
+
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, []byte("deliver-key")})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	bundleTx, err := authtx.NewTxBundle(suite.txConfig.TxEncoder(),
+		newTxCounter(t, suite.txConfig, 0, 0),
+		newTxCounter(t, suite.txConfig, 1, 1),
+	)
+	require.NoError(t, err)
+	txBytes, err := authtx.BundleTxEncoder(suite.txConfig.TxEncoder())(bundleTx)
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Txs: [][]byte{txBytes}})
+	require.NoError(t, err)
+	require.True(t, res.TxResults[0].IsOK(), res.TxResults[0].Log)
+
+	// each bundled tx is charged for its own bytes, so that the bundle bytes
+	// are charged once
+	require.Equal(t, []int{len(bundleTx.GetTxBytes()[0]), len(bundleTx.GetTxBytes()[1])}, sizes)
+	require.Less(t, sizes[0]+sizes[1], len(txBytes))
+}
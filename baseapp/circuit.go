@@ -1,8 +1,24 @@
 package baseapp
 
-import "context"
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // CircuitBreaker is an interface that defines the methods for a circuit breaker.
 type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
+
+// MsgCircuitBreaker is a CircuitBreaker that inspects the message itself rather
+// than only its type URL, e.g. to enforce rate limits or to exempt some signers
+// from a trip. When set on the MsgServiceRouter, AllowMsg is called instead of
+// IsAllowed.
+type MsgCircuitBreaker interface {
+	CircuitBreaker
+
+	// AllowMsg returns an error if msg must not be executed. It is called right
+	// before msg is executed and may record the execution.
+	AllowMsg(ctx context.Context, msg sdk.Msg) error
+}
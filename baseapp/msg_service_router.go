@@ -103,6 +103,12 @@ func (msr *MsgServiceRouter) registerHybridHandler(sd *grpc.ServiceDesc, method
 	}
 	// decorate the hybrid handler with the circuit breaker
 	circuitBreakerHybridHandler := func(ctx context.Context, req, resp protoiface.MessageV1) error {
+		if cb, ok := msr.circuitBreaker.(MsgCircuitBreaker); ok {
+			if err := cb.AllowMsg(ctx, req); err != nil {
+				return err
+			}
+			return hybridHandler(ctx, req, resp)
+		}
 		messageName := codectypes.MsgTypeURL(req)
 		allowed, err := msr.circuitBreaker.IsAllowed(ctx, messageName)
 		if err != nil {
@@ -181,7 +187,11 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 			}
 		}
 
-		if msr.circuitBreaker != nil {
+		if cb, ok := msr.circuitBreaker.(MsgCircuitBreaker); ok {
+			if err := cb.AllowMsg(ctx, msg); err != nil {
+				return nil, err
+			}
+		} else if msr.circuitBreaker != nil {
 			msgURL := sdk.MsgTypeURL(msg)
 			isAllowed, err := msr.circuitBreaker.IsAllowed(ctx, msgURL)
 			if err != nil {
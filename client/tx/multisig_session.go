@@ -0,0 +1,470 @@
+package tx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	txsigning "github.com/cosmos/cosmos-sdk/x/tx/signing"
+)
+
+// MultisigSessionSignMode is the sign mode used by the members of a multisig session.
+// It is the only sign mode whose sign bytes do not depend on the signer infos, which
+// lets every member sign independently of the others.
+const MultisigSessionSignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+// MultisigSessionStatus describes the progress of a multisig session.
+type MultisigSessionStatus struct {
+	Address   string   `json:"address" yaml:"address"`
+	Threshold uint32   `json:"threshold" yaml:"threshold"`
+	Signed    []string `json:"signed" yaml:"signed"`
+	Missing   []string `json:"missing" yaml:"missing"`
+	Ready     bool     `json:"ready" yaml:"ready"`
+}
+
+// NewMultisigSession starts a signing session on behalf of a multisig account for the
+// given unsigned transaction. The multisig account must be the only signer of the transaction.
+func NewMultisigSession(
+	clientCtx client.Context, unsignedTx sdk.Tx, chainID string, accNum, seq uint64, multisigPub *kmultisig.LegacyAminoPubKey,
+) (*tx.MultisigSession, error) {
+	if chainID == "" {
+		return nil, sdkerrors.ErrInvalidChainID.Wrap("chain id cannot be empty")
+	}
+
+	sigTx, ok := unsignedTx.(authsigning.Tx)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (authsigning.Tx)(nil), unsignedTx)
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+
+	multisigAddr := multisigPub.Address().Bytes()
+	if len(signers) != 1 || !bytes.Equal(signers[0], multisigAddr) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("the multisig account %s must be the only signer of the transaction", sdk.AccAddress(multisigAddr))
+	}
+
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// signatures are collected in the session, not in the transaction
+	if err := txBuilder.SetSignatures(); err != nil {
+		return nil, err
+	}
+
+	protoTx, err := toProtoTx(clientCtx, txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(multisigPub)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tx.MultisigSession{
+		Tx:             protoTx,
+		ChainId:        chainID,
+		AccountNumber:  accNum,
+		Sequence:       seq,
+		MultisigPubKey: pkAny,
+	}, nil
+}
+
+// SignMultisigSession signs the session transaction with the named key of the factory's
+// keyring, which must be a member of the multisig, and adds the signature to the session.
+// A previous signature of the same member is replaced.
+func SignMultisigSession(ctx context.Context, clientCtx client.Context, txf Factory, name string, session *tx.MultisigSession) error {
+	if txf.keybase == nil {
+		return errors.New("keybase must be set prior to signing a multisig session")
+	}
+
+	k, err := txf.keybase.Key(name)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	multisigPub, err := session.GetLegacyAminoPubKey()
+	if err != nil {
+		return err
+	}
+
+	if memberIndex(multisigPub, pubKey) < 0 {
+		return sdkerrors.ErrInvalidPubKey.Wrapf("key %s is not a member of the multisig account %s", name, sdk.AccAddress(multisigPub.Address()))
+	}
+
+	sigTx, err := fromProtoTx(clientCtx, session.Tx)
+	if err != nil {
+		return err
+	}
+
+	signBytes, err := authsigning.GetSignBytesAdapter(
+		ctx, clientCtx.TxConfig.SignModeHandler(), MultisigSessionSignMode, multisigSessionSignerData(session, pubKey), sigTx)
+	if err != nil {
+		return err
+	}
+
+	sigBytes, _, err := txf.keybase.Sign(name, signBytes, MultisigSessionSignMode)
+	if err != nil {
+		return err
+	}
+
+	return setMultisigSessionSignature(session, multisigPub, signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: MultisigSessionSignMode, Signature: sigBytes},
+		Sequence: session.Sequence,
+	})
+}
+
+// AddMultisigSessionSignatures verifies the given member signatures against the session
+// transaction and adds them to the session. A previous signature of the same member is replaced.
+func AddMultisigSessionSignatures(ctx context.Context, clientCtx client.Context, session *tx.MultisigSession, sigs ...signing.SignatureV2) error {
+	multisigPub, err := session.GetLegacyAminoPubKey()
+	if err != nil {
+		return err
+	}
+
+	sigTx, err := fromProtoTx(clientCtx, session.Tx)
+	if err != nil {
+		return err
+	}
+
+	for _, sig := range sigs {
+		if err := verifyMultisigSessionSignature(ctx, clientCtx, session, multisigPub, sigTx, sig); err != nil {
+			return err
+		}
+
+		if err := setMultisigSessionSignature(session, multisigPub, sig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MergeMultisigSessions merges sessions signing the same transaction into a new session
+// holding the signatures of all of them. The result does not depend on the order of the
+// given sessions.
+func MergeMultisigSessions(ctx context.Context, clientCtx client.Context, sessions ...*tx.MultisigSession) (*tx.MultisigSession, error) {
+	if len(sessions) == 0 {
+		return nil, errors.New("no multisig session to merge")
+	}
+
+	base := sessions[0]
+	merged := &tx.MultisigSession{
+		Tx:             base.Tx,
+		ChainId:        base.ChainId,
+		AccountNumber:  base.AccountNumber,
+		Sequence:       base.Sequence,
+		MultisigPubKey: base.MultisigPubKey,
+	}
+
+	baseTx, err := clientCtx.Codec.Marshal(base.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, session := range sessions {
+		if err := session.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid multisig session %d: %w", i, err)
+		}
+
+		sessionTx, err := clientCtx.Codec.Marshal(session.Tx)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case !bytes.Equal(baseTx, sessionTx):
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("multisig session %d signs a different transaction", i)
+		case session.ChainId != base.ChainId:
+			return nil, sdkerrors.ErrInvalidChainID.Wrapf("multisig session %d is for chain %s, expected %s", i, session.ChainId, base.ChainId)
+		case session.AccountNumber != base.AccountNumber:
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("multisig session %d has account number %d, expected %d", i, session.AccountNumber, base.AccountNumber)
+		case session.Sequence != base.Sequence:
+			return nil, sdkerrors.ErrWrongSequence.Wrapf("multisig session %d has sequence %d, expected %d", i, session.Sequence, base.Sequence)
+		case !bytes.Equal(session.MultisigPubKey.Value, base.MultisigPubKey.Value):
+			return nil, sdkerrors.ErrInvalidPubKey.Wrapf("multisig session %d is for a different multisig account", i)
+		}
+
+		sigs, err := session.GetSignaturesV2()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := AddMultisigSessionSignatures(ctx, clientCtx, merged, sigs...); err != nil {
+			return nil, err
+		}
+	}
+
+	return merged, nil
+}
+
+// GetMultisigSessionStatus returns which members of the multisig already signed the session
+// and which ones are still missing.
+func GetMultisigSessionStatus(session *tx.MultisigSession) (MultisigSessionStatus, error) {
+	multisigPub, err := session.GetLegacyAminoPubKey()
+	if err != nil {
+		return MultisigSessionStatus{}, err
+	}
+
+	sigs, err := session.GetSignaturesV2()
+	if err != nil {
+		return MultisigSessionStatus{}, err
+	}
+
+	status := MultisigSessionStatus{
+		Address:   sdk.AccAddress(multisigPub.Address()).String(),
+		Threshold: multisigPub.Threshold,
+		Signed:    []string{},
+		Missing:   []string{},
+	}
+
+	for _, member := range multisigPub.GetPubKeys() {
+		signed := false
+		for _, sig := range sigs {
+			if member.Equals(sig.PubKey) {
+				signed = true
+				break
+			}
+		}
+
+		addr := sdk.AccAddress(member.Address()).String()
+		if signed {
+			status.Signed = append(status.Signed, addr)
+		} else {
+			status.Missing = append(status.Missing, addr)
+		}
+	}
+
+	status.Ready = len(status.Signed) >= int(multisigPub.Threshold)
+	return status, nil
+}
+
+// FinalizeMultisigSession verifies all the signatures of the session, aggregates them into
+// the multisig signature and returns the signed transaction.
+func FinalizeMultisigSession(ctx context.Context, clientCtx client.Context, session *tx.MultisigSession) (client.TxBuilder, error) {
+	status, err := GetMultisigSessionStatus(session)
+	if err != nil {
+		return nil, err
+	}
+
+	if !status.Ready {
+		return nil, sdkerrors.ErrNoSignatures.Wrapf("multisig session has %d signatures, %d required", len(status.Signed), status.Threshold)
+	}
+
+	multisigPub, err := session.GetLegacyAminoPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	sigTx, err := fromProtoTx(clientCtx, session.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs, err := session.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+	for _, sig := range sigs {
+		if err := verifyMultisigSessionSignature(ctx, clientCtx, session, multisigPub, sigTx, sig); err != nil {
+			return nil, err
+		}
+
+		if err := multisig.AddSignatureV2(multisigSig, sig, multisigPub.GetPubKeys()); err != nil {
+			return nil, err
+		}
+	}
+
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(sigTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   multisigPub,
+		Data:     multisigSig,
+		Sequence: session.Sequence,
+	}); err != nil {
+		return nil, err
+	}
+
+	return txBuilder, nil
+}
+
+// ValidateMultisigSessionAccount checks that the account number and sequence of the session
+// match the current state of the multisig account on chain.
+func ValidateMultisigSessionAccount(clientCtx client.Context, session *tx.MultisigSession) error {
+	multisigPub, err := session.GetLegacyAminoPubKey()
+	if err != nil {
+		return err
+	}
+
+	accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, sdk.AccAddress(multisigPub.Address()))
+	if err != nil {
+		return err
+	}
+
+	if accNum != session.AccountNumber {
+		return sdkerrors.ErrInvalidRequest.Wrapf("session account number %d does not match on-chain account number %d", session.AccountNumber, accNum)
+	}
+
+	if seq != session.Sequence {
+		return sdkerrors.ErrWrongSequence.Wrapf("session sequence %d does not match on-chain sequence %d", session.Sequence, seq)
+	}
+
+	return nil
+}
+
+func verifyMultisigSessionSignature(
+	ctx context.Context, clientCtx client.Context, session *tx.MultisigSession,
+	multisigPub *kmultisig.LegacyAminoPubKey, sigTx authsigning.Tx, sig signing.SignatureV2,
+) error {
+	addr := sdk.AccAddress(sig.PubKey.Address())
+	if memberIndex(multisigPub, sig.PubKey) < 0 {
+		return sdkerrors.ErrInvalidPubKey.Wrapf("%s is not a member of the multisig account %s", addr, sdk.AccAddress(multisigPub.Address()))
+	}
+
+	if sig.Sequence != session.Sequence {
+		return sdkerrors.ErrWrongSequence.Wrapf("signature of %s is for sequence %d, expected %d", addr, sig.Sequence, session.Sequence)
+	}
+
+	adaptableTx, ok := sigTx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected Tx to be signing.V2AdaptableTx, got %T", sigTx)
+	}
+
+	anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+	if err != nil {
+		return err
+	}
+
+	signerData := txsigning.SignerData{
+		ChainID:       session.ChainId,
+		AccountNumber: session.AccountNumber,
+		Sequence:      session.Sequence,
+		Address:       addr.String(),
+		PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+	}
+
+	if err := authsigning.VerifySignature(ctx, sig.PubKey, signerData, sig.Data, clientCtx.TxConfig.SignModeHandler(), adaptableTx.GetSigningTxData()); err != nil {
+		return sdkerrors.ErrUnauthorized.Wrapf("couldn't verify signature of %s: %s", addr, err)
+	}
+
+	return nil
+}
+
+// setMultisigSessionSignature adds or replaces the signature of a member, keeping the
+// signatures sorted in the order of the multisig members.
+func setMultisigSessionSignature(session *tx.MultisigSession, multisigPub *kmultisig.LegacyAminoPubKey, sig signing.SignatureV2) error {
+	pkAny, err := codectypes.NewAnyWithValue(sig.PubKey)
+	if err != nil {
+		return err
+	}
+
+	desc := &signing.SignatureDescriptor{
+		PublicKey: pkAny,
+		Data:      signing.SignatureDataToProto(sig.Data),
+		Sequence:  sig.Sequence,
+	}
+
+	sigs := make([]*signing.SignatureDescriptor, 0, len(session.Signatures)+1)
+	for _, existing := range session.Signatures {
+		if !bytes.Equal(existing.PublicKey.Value, pkAny.Value) {
+			sigs = append(sigs, existing)
+		}
+	}
+	sigs = append(sigs, desc)
+
+	sort.SliceStable(sigs, func(i, j int) bool {
+		return memberIndex(multisigPub, sigs[i].PublicKey.GetCachedValue().(cryptotypes.PubKey)) <
+			memberIndex(multisigPub, sigs[j].PublicKey.GetCachedValue().(cryptotypes.PubKey))
+	})
+
+	session.Signatures = sigs
+	return nil
+}
+
+func multisigSessionSignerData(session *tx.MultisigSession, pubKey cryptotypes.PubKey) authsigning.SignerData {
+	return authsigning.SignerData{
+		ChainID:       session.ChainId,
+		AccountNumber: session.AccountNumber,
+		Sequence:      session.Sequence,
+		PubKey:        pubKey,
+		Address:       sdk.AccAddress(pubKey.Address()).String(),
+	}
+}
+
+func memberIndex(multisigPub *kmultisig.LegacyAminoPubKey, pubKey cryptotypes.PubKey) int {
+	for i, member := range multisigPub.GetPubKeys() {
+		if member.Equals(pubKey) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func toProtoTx(clientCtx client.Context, sigTx sdk.Tx) (*tx.Tx, error) {
+	bz, err := clientCtx.TxConfig.TxEncoder()(sigTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the raw encoding of a transaction is also a valid encoding of tx.Tx
+	var protoTx tx.Tx
+	if err := clientCtx.Codec.Unmarshal(bz, &protoTx); err != nil {
+		return nil, err
+	}
+
+	return &protoTx, nil
+}
+
+func fromProtoTx(clientCtx client.Context, protoTx *tx.Tx) (authsigning.Tx, error) {
+	if protoTx == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("session transaction cannot be empty")
+	}
+
+	bz, err := clientCtx.Codec.Marshal(protoTx)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := clientCtx.TxConfig.TxDecoder()(bz)
+	if err != nil {
+		return nil, err
+	}
+
+	sigTx, ok := decoded.(authsigning.Tx)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (authsigning.Tx)(nil), decoded)
+	}
+
+	return sigTx, nil
+}
@@ -0,0 +1,127 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMultisigSession(t *testing.T) {
+	txConfig, cdc := newTestTxConfig()
+	banktypes.RegisterInterfaces(cdc.InterfaceRegistry())
+	requireT := require.New(t)
+	ctx := context.Background()
+
+	kb := keyring.NewInMemory(cdc)
+	names := []string{"k1", "k2", "k3"}
+	pubKeys := make([]cryptotypes.PubKey, len(names))
+	for i, name := range names {
+		k, _, err := kb.NewMnemonic(name, keyring.English, hd.CreateHDPath(118, 0, 0).String(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		requireT.NoError(err)
+		pubKeys[i], err = k.GetPubKey()
+		requireT.NoError(err)
+	}
+	outsider, _, err := kb.NewMnemonic("outsider", keyring.English, hd.CreateHDPath(118, 0, 0).String(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	requireT.NoError(err)
+
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+	clientCtx := client.Context{}.WithTxConfig(txConfig).WithCodec(cdc).WithInterfaceRegistry(cdc.InterfaceRegistry())
+
+	txf := mockTxFactory(txConfig).WithKeybase(kb).WithSignMode(MultisigSessionSignMode)
+	txb, err := txf.BuildUnsignedTx(banktypes.NewMsgSend(multisigAddr, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	requireT.NoError(err)
+
+	// the multisig must be the only signer
+	_, err = NewMultisigSession(clientCtx, txb.GetTx(), txf.ChainID(), txf.AccountNumber(), txf.Sequence(), kmultisig.NewLegacyAminoPubKey(1, pubKeys[:2]))
+	requireT.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	session, err := NewMultisigSession(clientCtx, txb.GetTx(), txf.ChainID(), txf.AccountNumber(), txf.Sequence(), multisigPub)
+	requireT.NoError(err)
+	requireT.NoError(session.ValidateBasic())
+
+	// the session survives a JSON round trip
+	bz, err := cdc.MarshalJSON(session)
+	requireT.NoError(err)
+	var decoded txtypes.MultisigSession
+	requireT.NoError(cdc.UnmarshalJSON(bz, &decoded))
+	requireT.NoError(decoded.ValidateBasic())
+
+	status, err := GetMultisigSessionStatus(&decoded)
+	requireT.NoError(err)
+	requireT.Equal(multisigAddr.String(), status.Address)
+	requireT.Equal(uint32(2), status.Threshold)
+	requireT.Empty(status.Signed)
+	requireT.Len(status.Missing, 3)
+	requireT.False(status.Ready)
+
+	_, err = FinalizeMultisigSession(ctx, clientCtx, &decoded)
+	requireT.ErrorIs(err, sdkerrors.ErrNoSignatures)
+
+	// only members can sign
+	err = SignMultisigSession(ctx, clientCtx, txf, outsider.Name, &decoded)
+	requireT.ErrorIs(err, sdkerrors.ErrInvalidPubKey)
+
+	// members sign their own copy of the session
+	sessions := make([]*txtypes.MultisigSession, 2)
+	for i, name := range []string{"k3", "k1"} {
+		var s txtypes.MultisigSession
+		requireT.NoError(cdc.UnmarshalJSON(bz, &s))
+		requireT.NoError(SignMultisigSession(ctx, clientCtx, txf, name, &s))
+		// signing twice replaces the previous signature
+		requireT.NoError(SignMultisigSession(ctx, clientCtx, txf, name, &s))
+		requireT.Len(s.Signatures, 1)
+		sessions[i] = &s
+	}
+
+	merged, err := MergeMultisigSessions(ctx, clientCtx, sessions[0], sessions[1])
+	requireT.NoError(err)
+	reversed, err := MergeMultisigSessions(ctx, clientCtx, sessions[1], sessions[0])
+	requireT.NoError(err)
+	mergedBz, err := cdc.MarshalJSON(merged)
+	requireT.NoError(err)
+	reversedBz, err := cdc.MarshalJSON(reversed)
+	requireT.NoError(err)
+	requireT.Equal(mergedBz, reversedBz)
+
+	status, err = GetMultisigSessionStatus(merged)
+	requireT.NoError(err)
+	requireT.ElementsMatch([]string{sdk.AccAddress(pubKeys[0].Address()).String(), sdk.AccAddress(pubKeys[2].Address()).String()}, status.Signed)
+	requireT.Equal([]string{sdk.AccAddress(pubKeys[1].Address()).String()}, status.Missing)
+	requireT.True(status.Ready)
+
+	signedTxb, err := FinalizeMultisigSession(ctx, clientCtx, merged)
+	requireT.NoError(err)
+	sigTx, ok := signedTxb.GetTx().(signing.SigVerifiableTx)
+	requireT.True(ok)
+	sigs, err := sigTx.GetSignaturesV2()
+	requireT.NoError(err)
+	requireT.Len(sigs, 1)
+	requireT.True(multisigPub.Equals(sigs[0].PubKey))
+	requireT.Equal(txf.Sequence(), sigs[0].Sequence)
+
+	// sessions signing with a different sequence cannot be merged
+	other, err := NewMultisigSession(clientCtx, txb.GetTx(), txf.ChainID(), txf.AccountNumber(), txf.Sequence()+1, multisigPub)
+	requireT.NoError(err)
+	_, err = MergeMultisigSessions(ctx, clientCtx, merged, other)
+	requireT.ErrorIs(err, sdkerrors.ErrWrongSequence)
+
+	// nor can their signatures be added to one another
+	sigsV2, err := merged.GetSignaturesV2()
+	requireT.NoError(err)
+	err = AddMultisigSessionSignatures(ctx, clientCtx, other, sigsV2...)
+	requireT.Error(err)
+	requireT.Empty(other.Signatures)
+}
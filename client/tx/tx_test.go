@@ -3,11 +3,13 @@ package tx
 import (
 	"context"
 	"fmt"
+	"net"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -366,6 +368,39 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignWithRemoteKeyring(t *testing.T) {
+	txConfig, cdc := newTestTxConfig()
+	requireT := require.New(t)
+
+	signerKr := keyring.NewInMemory(cdc)
+	k, _, err := signerKr.NewMnemonic("remote_key", keyring.English, hd.CreateHDPath(118, 0, 0).String(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	requireT.NoError(err)
+	pubKey, err := k.GetPubKey()
+	requireT.NoError(err)
+
+	srv := grpc.NewServer()
+	keyring.RegisterRemoteSignerServer(srv, keyring.NewRemoteSignerServer(signerKr))
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	requireT.NoError(err)
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	requireT.NoError(err)
+	defer conn.Close()
+	kb := keyring.NewRemote(keyring.NewRemoteSignerClient(conn), cdc, 0)
+
+	msg := banktypes.NewMsgSend(sdk.AccAddress(pubKey.Address()), sdk.AccAddress("to"), nil)
+	for _, signMode := range []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON} {
+		txf := mockTxFactory(txConfig).WithKeybase(kb).WithSignMode(signMode)
+		txb, err := txf.BuildUnsignedTx(msg)
+		requireT.NoError(err)
+
+		requireT.NoError(Sign(context.TODO(), txf, "remote_key", txb, true))
+		testSigners(requireT, txb.GetTx(), pubKey)
+	}
+}
+
 func TestPreprocessHook(t *testing.T) {
 	_, _, addr2 := testdata.KeyTestPubAddr()
 
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.5.1
+// - protoc-gen-go-grpc v1.6.1
 // - protoc             (unknown)
 // source: cosmos/circuit/v1/query.proto
 
@@ -19,9 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Account_FullMethodName      = "/cosmos.circuit.v1.Query/Account"
-	Query_Accounts_FullMethodName     = "/cosmos.circuit.v1.Query/Accounts"
-	Query_DisabledList_FullMethodName = "/cosmos.circuit.v1.Query/DisabledList"
+	Query_Account_FullMethodName        = "/cosmos.circuit.v1.Query/Account"
+	Query_Accounts_FullMethodName       = "/cosmos.circuit.v1.Query/Accounts"
+	Query_DisabledList_FullMethodName   = "/cosmos.circuit.v1.Query/DisabledList"
+	Query_RateLimits_FullMethodName     = "/cosmos.circuit.v1.Query/RateLimits"
+	Query_OutflowLimits_FullMethodName  = "/cosmos.circuit.v1.Query/OutflowLimits"
+	Query_TripExemptions_FullMethodName = "/cosmos.circuit.v1.Query/TripExemptions"
+	Query_TripExpiries_FullMethodName   = "/cosmos.circuit.v1.Query/TripExpiries"
+	Query_TripHistory_FullMethodName    = "/cosmos.circuit.v1.Query/TripHistory"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +41,16 @@ type QueryClient interface {
 	Accounts(ctx context.Context, in *QueryAccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(ctx context.Context, in *QueryDisabledListRequest, opts ...grpc.CallOption) (*DisabledListResponse, error)
+	// RateLimits returns the configured per block Msg execution limits.
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// OutflowLimits returns the configured per denom outflow limits.
+	OutflowLimits(ctx context.Context, in *QueryOutflowLimitsRequest, opts ...grpc.CallOption) (*QueryOutflowLimitsResponse, error)
+	// TripExemptions returns the configured trip exemptions.
+	TripExemptions(ctx context.Context, in *QueryTripExemptionsRequest, opts ...grpc.CallOption) (*QueryTripExemptionsResponse, error)
+	// TripExpiries returns the expiries of the tripped Msg type URLs.
+	TripExpiries(ctx context.Context, in *QueryTripExpiriesRequest, opts ...grpc.CallOption) (*QueryTripExpiriesResponse, error)
+	// TripHistory returns the history of trips and resets.
+	TripHistory(ctx context.Context, in *QueryTripHistoryRequest, opts ...grpc.CallOption) (*QueryTripHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +91,56 @@ func (c *queryClient) DisabledList(ctx context.Context, in *QueryDisabledListReq
 	return out, nil
 }
 
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutflowLimits(ctx context.Context, in *QueryOutflowLimitsRequest, opts ...grpc.CallOption) (*QueryOutflowLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryOutflowLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_OutflowLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripExemptions(ctx context.Context, in *QueryTripExemptionsRequest, opts ...grpc.CallOption) (*QueryTripExemptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTripExemptionsResponse)
+	err := c.cc.Invoke(ctx, Query_TripExemptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripExpiries(ctx context.Context, in *QueryTripExpiriesRequest, opts ...grpc.CallOption) (*QueryTripExpiriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTripExpiriesResponse)
+	err := c.cc.Invoke(ctx, Query_TripExpiries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TripHistory(ctx context.Context, in *QueryTripHistoryRequest, opts ...grpc.CallOption) (*QueryTripHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTripHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_TripHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -88,6 +153,16 @@ type QueryServer interface {
 	Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error)
 	// DisabledList returns a list of disabled message urls
 	DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error)
+	// RateLimits returns the configured per block Msg execution limits.
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// OutflowLimits returns the configured per denom outflow limits.
+	OutflowLimits(context.Context, *QueryOutflowLimitsRequest) (*QueryOutflowLimitsResponse, error)
+	// TripExemptions returns the configured trip exemptions.
+	TripExemptions(context.Context, *QueryTripExemptionsRequest) (*QueryTripExemptionsResponse, error)
+	// TripExpiries returns the expiries of the tripped Msg type URLs.
+	TripExpiries(context.Context, *QueryTripExpiriesRequest) (*QueryTripExpiriesResponse, error)
+	// TripHistory returns the history of trips and resets.
+	TripHistory(context.Context, *QueryTripHistoryRequest) (*QueryTripHistoryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -99,13 +174,28 @@ type QueryServer interface {
 type UnimplementedQueryServer struct{}
 
 func (UnimplementedQueryServer) Account(context.Context, *QueryAccountRequest) (*AccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Account not implemented")
+	return nil, status.Error(codes.Unimplemented, "method Account not implemented")
 }
 func (UnimplementedQueryServer) Accounts(context.Context, *QueryAccountsRequest) (*AccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+	return nil, status.Error(codes.Unimplemented, "method Accounts not implemented")
 }
 func (UnimplementedQueryServer) DisabledList(context.Context, *QueryDisabledListRequest) (*DisabledListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisabledList not implemented")
+	return nil, status.Error(codes.Unimplemented, "method DisabledList not implemented")
+}
+func (UnimplementedQueryServer) RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (UnimplementedQueryServer) OutflowLimits(context.Context, *QueryOutflowLimitsRequest) (*QueryOutflowLimitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OutflowLimits not implemented")
+}
+func (UnimplementedQueryServer) TripExemptions(context.Context, *QueryTripExemptionsRequest) (*QueryTripExemptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TripExemptions not implemented")
+}
+func (UnimplementedQueryServer) TripExpiries(context.Context, *QueryTripExpiriesRequest) (*QueryTripExpiriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TripExpiries not implemented")
+}
+func (UnimplementedQueryServer) TripHistory(context.Context, *QueryTripHistoryRequest) (*QueryTripHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TripHistory not implemented")
 }
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
@@ -118,7 +208,7 @@ type UnsafeQueryServer interface {
 }
 
 func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
-	// If the following call pancis, it indicates UnimplementedQueryServer was
+	// If the following call panics, it indicates UnimplementedQueryServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
@@ -182,6 +272,96 @@ func _Query_DisabledList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutflowLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutflowLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutflowLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OutflowLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutflowLimits(ctx, req.(*QueryOutflowLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripExemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripExemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TripExemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripExemptions(ctx, req.(*QueryTripExemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripExpiries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripExpiriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripExpiries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TripExpiries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripExpiries(ctx, req.(*QueryTripExpiriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TripHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTripHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TripHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TripHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TripHistory(ctx, req.(*QueryTripHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +381,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisabledList",
 			Handler:    _Query_DisabledList_Handler,
 		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "OutflowLimits",
+			Handler:    _Query_OutflowLimits_Handler,
+		},
+		{
+			MethodName: "TripExemptions",
+			Handler:    _Query_TripExemptions_Handler,
+		},
+		{
+			MethodName: "TripExpiries",
+			Handler:    _Query_TripExpiries_Handler,
+		},
+		{
+			MethodName: "TripHistory",
+			Handler:    _Query_TripHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/query.proto",
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.5.1
+// - protoc-gen-go-grpc v1.6.1
 // - protoc             (unknown)
 // source: cosmos/circuit/v1/tx.proto
 
@@ -22,6 +22,10 @@ const (
 	Msg_AuthorizeCircuitBreaker_FullMethodName = "/cosmos.circuit.v1.Msg/AuthorizeCircuitBreaker"
 	Msg_TripCircuitBreaker_FullMethodName      = "/cosmos.circuit.v1.Msg/TripCircuitBreaker"
 	Msg_ResetCircuitBreaker_FullMethodName     = "/cosmos.circuit.v1.Msg/ResetCircuitBreaker"
+	Msg_ExtendCircuitBreaker_FullMethodName    = "/cosmos.circuit.v1.Msg/ExtendCircuitBreaker"
+	Msg_SetRateLimit_FullMethodName            = "/cosmos.circuit.v1.Msg/SetRateLimit"
+	Msg_SetOutflowLimit_FullMethodName         = "/cosmos.circuit.v1.Msg/SetOutflowLimit"
+	Msg_SetTripExemption_FullMethodName        = "/cosmos.circuit.v1.Msg/SetTripExemption"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,8 +40,20 @@ type MsgClient interface {
 	// TripCircuitBreaker pauses processing of Msg's in the state machine.
 	TripCircuitBreaker(ctx context.Context, in *MsgTripCircuitBreaker, opts ...grpc.CallOption) (*MsgTripCircuitBreakerResponse, error)
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
-	// have been been paused using TripCircuitBreaker.
+	// have been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	// ExtendCircuitBreaker changes the expiry of tripped Msg type URLs. It can
+	// only be called by the module authority (defaults to governance).
+	ExtendCircuitBreaker(ctx context.Context, in *MsgExtendCircuitBreaker, opts ...grpc.CallOption) (*MsgExtendCircuitBreakerResponse, error)
+	// SetRateLimit sets or removes the per block execution limit of a Msg type
+	// URL.
+	SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
+	// SetOutflowLimit sets or removes the limit on the amount of a denom that
+	// can be sent via bank sends within a time window.
+	SetOutflowLimit(ctx context.Context, in *MsgSetOutflowLimit, opts ...grpc.CallOption) (*MsgSetOutflowLimitResponse, error)
+	// SetTripExemption sets or removes the accounts which may keep executing a
+	// tripped Msg type URL.
+	SetTripExemption(ctx context.Context, in *MsgSetTripExemption, opts ...grpc.CallOption) (*MsgSetTripExemptionResponse, error)
 }
 
 type msgClient struct {
@@ -78,6 +94,46 @@ func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuit
 	return out, nil
 }
 
+func (c *msgClient) ExtendCircuitBreaker(ctx context.Context, in *MsgExtendCircuitBreaker, opts ...grpc.CallOption) (*MsgExtendCircuitBreakerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgExtendCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Msg_ExtendCircuitBreaker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOutflowLimit(ctx context.Context, in *MsgSetOutflowLimit, opts ...grpc.CallOption) (*MsgSetOutflowLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetOutflowLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetOutflowLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTripExemption(ctx context.Context, in *MsgSetTripExemption, opts ...grpc.CallOption) (*MsgSetTripExemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetTripExemptionResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTripExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -90,8 +146,20 @@ type MsgServer interface {
 	// TripCircuitBreaker pauses processing of Msg's in the state machine.
 	TripCircuitBreaker(context.Context, *MsgTripCircuitBreaker) (*MsgTripCircuitBreakerResponse, error)
 	// ResetCircuitBreaker resumes processing of Msg's in the state machine that
-	// have been been paused using TripCircuitBreaker.
+	// have been paused using TripCircuitBreaker.
 	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	// ExtendCircuitBreaker changes the expiry of tripped Msg type URLs. It can
+	// only be called by the module authority (defaults to governance).
+	ExtendCircuitBreaker(context.Context, *MsgExtendCircuitBreaker) (*MsgExtendCircuitBreakerResponse, error)
+	// SetRateLimit sets or removes the per block execution limit of a Msg type
+	// URL.
+	SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
+	// SetOutflowLimit sets or removes the limit on the amount of a denom that
+	// can be sent via bank sends within a time window.
+	SetOutflowLimit(context.Context, *MsgSetOutflowLimit) (*MsgSetOutflowLimitResponse, error)
+	// SetTripExemption sets or removes the accounts which may keep executing a
+	// tripped Msg type URL.
+	SetTripExemption(context.Context, *MsgSetTripExemption) (*MsgSetTripExemptionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -103,13 +171,25 @@ type MsgServer interface {
 type UnimplementedMsgServer struct{}
 
 func (UnimplementedMsgServer) AuthorizeCircuitBreaker(context.Context, *MsgAuthorizeCircuitBreaker) (*MsgAuthorizeCircuitBreakerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeCircuitBreaker not implemented")
+	return nil, status.Error(codes.Unimplemented, "method AuthorizeCircuitBreaker not implemented")
 }
 func (UnimplementedMsgServer) TripCircuitBreaker(context.Context, *MsgTripCircuitBreaker) (*MsgTripCircuitBreakerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TripCircuitBreaker not implemented")
+	return nil, status.Error(codes.Unimplemented, "method TripCircuitBreaker not implemented")
 }
 func (UnimplementedMsgServer) ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
+	return nil, status.Error(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
+}
+func (UnimplementedMsgServer) ExtendCircuitBreaker(context.Context, *MsgExtendCircuitBreaker) (*MsgExtendCircuitBreakerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExtendCircuitBreaker not implemented")
+}
+func (UnimplementedMsgServer) SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRateLimit not implemented")
+}
+func (UnimplementedMsgServer) SetOutflowLimit(context.Context, *MsgSetOutflowLimit) (*MsgSetOutflowLimitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetOutflowLimit not implemented")
+}
+func (UnimplementedMsgServer) SetTripExemption(context.Context, *MsgSetTripExemption) (*MsgSetTripExemptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTripExemption not implemented")
 }
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
@@ -122,7 +202,7 @@ type UnsafeMsgServer interface {
 }
 
 func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
-	// If the following call pancis, it indicates UnimplementedMsgServer was
+	// If the following call panics, it indicates UnimplementedMsgServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
@@ -186,6 +266,78 @@ func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExtendCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExtendCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendCircuitBreaker(ctx, req.(*MsgExtendCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimit(ctx, req.(*MsgSetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOutflowLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOutflowLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOutflowLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetOutflowLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOutflowLimit(ctx, req.(*MsgSetOutflowLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTripExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTripExemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTripExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTripExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTripExemption(ctx, req.(*MsgSetTripExemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -205,6 +357,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetCircuitBreaker",
 			Handler:    _Msg_ResetCircuitBreaker_Handler,
 		},
+		{
+			MethodName: "ExtendCircuitBreaker",
+			Handler:    _Msg_ExtendCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Msg_SetRateLimit_Handler,
+		},
+		{
+			MethodName: "SetOutflowLimit",
+			Handler:    _Msg_SetOutflowLimit_Handler,
+		},
+		{
+			MethodName: "SetTripExemption",
+			Handler:    _Msg_SetTripExemption_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/circuit/v1/tx.proto",
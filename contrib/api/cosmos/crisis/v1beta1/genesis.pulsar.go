@@ -16,14 +16,16 @@ import (
 )
 
 var (
-	md_GenesisState              protoreflect.MessageDescriptor
-	fd_GenesisState_constant_fee protoreflect.FieldDescriptor
+	md_GenesisState                    protoreflect.MessageDescriptor
+	fd_GenesisState_constant_fee       protoreflect.FieldDescriptor
+	fd_GenesisState_incremental_params protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_crisis_v1beta1_genesis_proto_init()
 	md_GenesisState = File_cosmos_crisis_v1beta1_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_constant_fee = md_GenesisState.Fields().ByName("constant_fee")
+	fd_GenesisState_incremental_params = md_GenesisState.Fields().ByName("incremental_params")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -97,6 +99,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.IncrementalParams != nil {
+		value := protoreflect.ValueOfMessage(x.IncrementalParams.ProtoReflect())
+		if !f(fd_GenesisState_incremental_params, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -114,6 +122,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "cosmos.crisis.v1beta1.GenesisState.constant_fee":
 		return x.ConstantFee != nil
+	case "cosmos.crisis.v1beta1.GenesisState.incremental_params":
+		return x.IncrementalParams != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.GenesisState"))
@@ -132,6 +142,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "cosmos.crisis.v1beta1.GenesisState.constant_fee":
 		x.ConstantFee = nil
+	case "cosmos.crisis.v1beta1.GenesisState.incremental_params":
+		x.IncrementalParams = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.GenesisState"))
@@ -151,6 +163,9 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "cosmos.crisis.v1beta1.GenesisState.constant_fee":
 		value := x.ConstantFee
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.crisis.v1beta1.GenesisState.incremental_params":
+		value := x.IncrementalParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.GenesisState"))
@@ -173,6 +188,8 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 	switch fd.FullName() {
 	case "cosmos.crisis.v1beta1.GenesisState.constant_fee":
 		x.ConstantFee = value.Message().Interface().(*v1beta1.Coin)
+	case "cosmos.crisis.v1beta1.GenesisState.incremental_params":
+		x.IncrementalParams = value.Message().Interface().(*IncrementalParams)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.GenesisState"))
@@ -198,6 +215,11 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.ConstantFee = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.ConstantFee.ProtoReflect())
+	case "cosmos.crisis.v1beta1.GenesisState.incremental_params":
+		if x.IncrementalParams == nil {
+			x.IncrementalParams = new(IncrementalParams)
+		}
+		return protoreflect.ValueOfMessage(x.IncrementalParams.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.GenesisState"))
@@ -214,6 +236,9 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "cosmos.crisis.v1beta1.GenesisState.constant_fee":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.crisis.v1beta1.GenesisState.incremental_params":
+		m := new(IncrementalParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.GenesisState"))
@@ -287,6 +312,10 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.ConstantFee)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.IncrementalParams != nil {
+			l = options.Size(x.IncrementalParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -316,6 +345,20 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.IncrementalParams != nil {
+			encoded, err := options.Marshal(x.IncrementalParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if x.ConstantFee != nil {
 			encoded, err := options.Marshal(x.ConstantFee)
 			if err != nil {
@@ -415,6 +458,42 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IncrementalParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.IncrementalParams == nil {
+					x.IncrementalParams = &IncrementalParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IncrementalParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -450,124 +529,1533 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/crisis/v1beta1/genesis.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_IncrementalParams             protoreflect.MessageDescriptor
+	fd_IncrementalParams_gas_budget  protoreflect.FieldDescriptor
+	fd_IncrementalParams_halt_policy protoreflect.FieldDescriptor
 )
 
-// GenesisState defines the crisis module's genesis state.
-type GenesisState struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_cosmos_crisis_v1beta1_genesis_proto_init()
+	md_IncrementalParams = File_cosmos_crisis_v1beta1_genesis_proto.Messages().ByName("IncrementalParams")
+	fd_IncrementalParams_gas_budget = md_IncrementalParams.Fields().ByName("gas_budget")
+	fd_IncrementalParams_halt_policy = md_IncrementalParams.Fields().ByName("halt_policy")
+}
 
-	// constant_fee is the fee used to verify the invariant in the crisis
-	// module.
-	ConstantFee *v1beta1.Coin `protobuf:"bytes,3,opt,name=constant_fee,json=constantFee,proto3" json:"constant_fee,omitempty"`
+var _ protoreflect.Message = (*fastReflection_IncrementalParams)(nil)
+
+type fastReflection_IncrementalParams IncrementalParams
+
+func (x *IncrementalParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_IncrementalParams)(x)
 }
 
-func (x *GenesisState) Reset() {
-	*x = GenesisState{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_crisis_v1beta1_genesis_proto_msgTypes[0]
+func (x *IncrementalParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crisis_v1beta1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *GenesisState) String() string {
-	return protoimpl.X.MessageStringOf(x)
+var _fastReflection_IncrementalParams_messageType fastReflection_IncrementalParams_messageType
+var _ protoreflect.MessageType = fastReflection_IncrementalParams_messageType{}
+
+type fastReflection_IncrementalParams_messageType struct{}
+
+func (x fastReflection_IncrementalParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_IncrementalParams)(nil)
+}
+func (x fastReflection_IncrementalParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_IncrementalParams)
+}
+func (x fastReflection_IncrementalParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_IncrementalParams
 }
 
-func (*GenesisState) ProtoMessage() {}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_IncrementalParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_IncrementalParams
+}
 
-// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_cosmos_crisis_v1beta1_genesis_proto_rawDescGZIP(), []int{0}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_IncrementalParams) Type() protoreflect.MessageType {
+	return _fastReflection_IncrementalParams_messageType
 }
 
-func (x *GenesisState) GetConstantFee() *v1beta1.Coin {
-	if x != nil {
-		return x.ConstantFee
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_IncrementalParams) New() protoreflect.Message {
+	return new(fastReflection_IncrementalParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_IncrementalParams) Interface() protoreflect.ProtoMessage {
+	return (*IncrementalParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_IncrementalParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GasBudget != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasBudget)
+		if !f(fd_IncrementalParams_gas_budget, value) {
+			return
+		}
+	}
+	if x.HaltPolicy != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.HaltPolicy))
+		if !f(fd_IncrementalParams_halt_policy, value) {
+			return
+		}
 	}
-	return nil
 }
 
-var File_cosmos_crisis_v1beta1_genesis_proto protoreflect.FileDescriptor
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_IncrementalParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crisis.v1beta1.IncrementalParams.gas_budget":
+		return x.GasBudget != uint64(0)
+	case "cosmos.crisis.v1beta1.IncrementalParams.halt_policy":
+		return x.HaltPolicy != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.IncrementalParams"))
+		}
+		panic(fmt.Errorf("message cosmos.crisis.v1beta1.IncrementalParams does not contain field %s", fd.FullName()))
+	}
+}
 
-var file_cosmos_crisis_v1beta1_genesis_proto_rawDesc = []byte{
-	0x0a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x69, 0x73, 0x69, 0x73, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72,
-	0x69, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f,
-	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x57, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x42, 0xd5,
-	0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72,
-	0x69, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x69, 0x73, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x3b, 0x63, 0x72, 0x69, 0x73, 0x69, 0x73, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x43, 0x72, 0x69, 0x73, 0x69, 0x73, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0xca, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x69, 0x73, 0x69, 0x73,
-	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x21, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x43, 0x72, 0x69, 0x73, 0x69, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x72, 0x69, 0x73, 0x69, 0x73, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IncrementalParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crisis.v1beta1.IncrementalParams.gas_budget":
+		x.GasBudget = uint64(0)
+	case "cosmos.crisis.v1beta1.IncrementalParams.halt_policy":
+		x.HaltPolicy = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.IncrementalParams"))
+		}
+		panic(fmt.Errorf("message cosmos.crisis.v1beta1.IncrementalParams does not contain field %s", fd.FullName()))
+	}
 }
 
-var (
-	file_cosmos_crisis_v1beta1_genesis_proto_rawDescOnce sync.Once
-	file_cosmos_crisis_v1beta1_genesis_proto_rawDescData = file_cosmos_crisis_v1beta1_genesis_proto_rawDesc
-)
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_IncrementalParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crisis.v1beta1.IncrementalParams.gas_budget":
+		value := x.GasBudget
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.crisis.v1beta1.IncrementalParams.halt_policy":
+		value := x.HaltPolicy
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.IncrementalParams"))
+		}
+		panic(fmt.Errorf("message cosmos.crisis.v1beta1.IncrementalParams does not contain field %s", descriptor.FullName()))
+	}
+}
 
-func file_cosmos_crisis_v1beta1_genesis_proto_rawDescGZIP() []byte {
-	file_cosmos_crisis_v1beta1_genesis_proto_rawDescOnce.Do(func() {
-		file_cosmos_crisis_v1beta1_genesis_proto_rawDescData = protoimpl.X.CompressGZIP(file_cosmos_crisis_v1beta1_genesis_proto_rawDescData)
-	})
-	return file_cosmos_crisis_v1beta1_genesis_proto_rawDescData
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IncrementalParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crisis.v1beta1.IncrementalParams.gas_budget":
+		x.GasBudget = value.Uint()
+	case "cosmos.crisis.v1beta1.IncrementalParams.halt_policy":
+		x.HaltPolicy = (HaltPolicy)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.IncrementalParams"))
+		}
+		panic(fmt.Errorf("message cosmos.crisis.v1beta1.IncrementalParams does not contain field %s", fd.FullName()))
+	}
 }
 
-var file_cosmos_crisis_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_cosmos_crisis_v1beta1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: cosmos.crisis.v1beta1.GenesisState
-	(*v1beta1.Coin)(nil), // 1: cosmos.base.v1beta1.Coin
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IncrementalParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crisis.v1beta1.IncrementalParams.gas_budget":
+		panic(fmt.Errorf("field gas_budget of message cosmos.crisis.v1beta1.IncrementalParams is not mutable"))
+	case "cosmos.crisis.v1beta1.IncrementalParams.halt_policy":
+		panic(fmt.Errorf("field halt_policy of message cosmos.crisis.v1beta1.IncrementalParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.IncrementalParams"))
+		}
+		panic(fmt.Errorf("message cosmos.crisis.v1beta1.IncrementalParams does not contain field %s", fd.FullName()))
+	}
 }
-var file_cosmos_crisis_v1beta1_genesis_proto_depIdxs = []int32{
-	1, // 0: cosmos.crisis.v1beta1.GenesisState.constant_fee:type_name -> cosmos.base.v1beta1.Coin
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_IncrementalParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crisis.v1beta1.IncrementalParams.gas_budget":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.crisis.v1beta1.IncrementalParams.halt_policy":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.IncrementalParams"))
+		}
+		panic(fmt.Errorf("message cosmos.crisis.v1beta1.IncrementalParams does not contain field %s", fd.FullName()))
+	}
 }
 
-func init() { file_cosmos_crisis_v1beta1_genesis_proto_init() }
-func file_cosmos_crisis_v1beta1_genesis_proto_init() {
-	if File_cosmos_crisis_v1beta1_genesis_proto != nil {
-		return
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_IncrementalParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crisis.v1beta1.IncrementalParams", d.FullName()))
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_cosmos_crisis_v1beta1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenesisState); i {
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_IncrementalParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_IncrementalParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_IncrementalParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_IncrementalParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*IncrementalParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.GasBudget != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasBudget))
+		}
+		if x.HaltPolicy != 0 {
+			n += 1 + runtime.Sov(uint64(x.HaltPolicy))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*IncrementalParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.HaltPolicy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HaltPolicy))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.GasBudget != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasBudget))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*IncrementalParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IncrementalParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: IncrementalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasBudget", wireType)
+				}
+				x.GasBudget = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasBudget |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HaltPolicy", wireType)
+				}
+				x.HaltPolicy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HaltPolicy |= HaltPolicy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InvariantProgress                       protoreflect.MessageDescriptor
+	fd_InvariantProgress_cursor                protoreflect.FieldDescriptor
+	fd_InvariantProgress_passes                protoreflect.FieldDescriptor
+	fd_InvariantProgress_last_pass_height      protoreflect.FieldDescriptor
+	fd_InvariantProgress_last_violation        protoreflect.FieldDescriptor
+	fd_InvariantProgress_last_violation_height protoreflect.FieldDescriptor
+	fd_InvariantProgress_state                 protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_crisis_v1beta1_genesis_proto_init()
+	md_InvariantProgress = File_cosmos_crisis_v1beta1_genesis_proto.Messages().ByName("InvariantProgress")
+	fd_InvariantProgress_cursor = md_InvariantProgress.Fields().ByName("cursor")
+	fd_InvariantProgress_passes = md_InvariantProgress.Fields().ByName("passes")
+	fd_InvariantProgress_last_pass_height = md_InvariantProgress.Fields().ByName("last_pass_height")
+	fd_InvariantProgress_last_violation = md_InvariantProgress.Fields().ByName("last_violation")
+	fd_InvariantProgress_last_violation_height = md_InvariantProgress.Fields().ByName("last_violation_height")
+	fd_InvariantProgress_state = md_InvariantProgress.Fields().ByName("state")
+}
+
+var _ protoreflect.Message = (*fastReflection_InvariantProgress)(nil)
+
+type fastReflection_InvariantProgress InvariantProgress
+
+func (x *InvariantProgress) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InvariantProgress)(x)
+}
+
+func (x *InvariantProgress) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_crisis_v1beta1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InvariantProgress_messageType fastReflection_InvariantProgress_messageType
+var _ protoreflect.MessageType = fastReflection_InvariantProgress_messageType{}
+
+type fastReflection_InvariantProgress_messageType struct{}
+
+func (x fastReflection_InvariantProgress_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InvariantProgress)(nil)
+}
+func (x fastReflection_InvariantProgress_messageType) New() protoreflect.Message {
+	return new(fastReflection_InvariantProgress)
+}
+func (x fastReflection_InvariantProgress_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InvariantProgress
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InvariantProgress) Descriptor() protoreflect.MessageDescriptor {
+	return md_InvariantProgress
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InvariantProgress) Type() protoreflect.MessageType {
+	return _fastReflection_InvariantProgress_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InvariantProgress) New() protoreflect.Message {
+	return new(fastReflection_InvariantProgress)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InvariantProgress) Interface() protoreflect.ProtoMessage {
+	return (*InvariantProgress)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InvariantProgress) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Cursor) != 0 {
+		value := protoreflect.ValueOfBytes(x.Cursor)
+		if !f(fd_InvariantProgress_cursor, value) {
+			return
+		}
+	}
+	if x.Passes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Passes)
+		if !f(fd_InvariantProgress_passes, value) {
+			return
+		}
+	}
+	if x.LastPassHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastPassHeight)
+		if !f(fd_InvariantProgress_last_pass_height, value) {
+			return
+		}
+	}
+	if x.LastViolation != "" {
+		value := protoreflect.ValueOfString(x.LastViolation)
+		if !f(fd_InvariantProgress_last_violation, value) {
+			return
+		}
+	}
+	if x.LastViolationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastViolationHeight)
+		if !f(fd_InvariantProgress_last_violation_height, value) {
+			return
+		}
+	}
+	if len(x.State) != 0 {
+		value := protoreflect.ValueOfBytes(x.State)
+		if !f(fd_InvariantProgress_state, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InvariantProgress) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.crisis.v1beta1.InvariantProgress.cursor":
+		return len(x.Cursor) != 0
+	case "cosmos.crisis.v1beta1.InvariantProgress.passes":
+		return x.Passes != uint64(0)
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_pass_height":
+		return x.LastPassHeight != int64(0)
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_violation":
+		return x.LastViolation != ""
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_violation_height":
+		return x.LastViolationHeight != int64(0)
+	case "cosmos.crisis.v1beta1.InvariantProgress.state":
+		return len(x.State) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.InvariantProgress"))
+		}
+		panic(fmt.Errorf("message cosmos.crisis.v1beta1.InvariantProgress does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvariantProgress) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.crisis.v1beta1.InvariantProgress.cursor":
+		x.Cursor = nil
+	case "cosmos.crisis.v1beta1.InvariantProgress.passes":
+		x.Passes = uint64(0)
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_pass_height":
+		x.LastPassHeight = int64(0)
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_violation":
+		x.LastViolation = ""
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_violation_height":
+		x.LastViolationHeight = int64(0)
+	case "cosmos.crisis.v1beta1.InvariantProgress.state":
+		x.State = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.InvariantProgress"))
+		}
+		panic(fmt.Errorf("message cosmos.crisis.v1beta1.InvariantProgress does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InvariantProgress) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.crisis.v1beta1.InvariantProgress.cursor":
+		value := x.Cursor
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.crisis.v1beta1.InvariantProgress.passes":
+		value := x.Passes
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_pass_height":
+		value := x.LastPassHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_violation":
+		value := x.LastViolation
+		return protoreflect.ValueOfString(value)
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_violation_height":
+		value := x.LastViolationHeight
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.crisis.v1beta1.InvariantProgress.state":
+		value := x.State
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.InvariantProgress"))
+		}
+		panic(fmt.Errorf("message cosmos.crisis.v1beta1.InvariantProgress does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvariantProgress) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.crisis.v1beta1.InvariantProgress.cursor":
+		x.Cursor = value.Bytes()
+	case "cosmos.crisis.v1beta1.InvariantProgress.passes":
+		x.Passes = value.Uint()
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_pass_height":
+		x.LastPassHeight = value.Int()
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_violation":
+		x.LastViolation = value.Interface().(string)
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_violation_height":
+		x.LastViolationHeight = value.Int()
+	case "cosmos.crisis.v1beta1.InvariantProgress.state":
+		x.State = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.InvariantProgress"))
+		}
+		panic(fmt.Errorf("message cosmos.crisis.v1beta1.InvariantProgress does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvariantProgress) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crisis.v1beta1.InvariantProgress.cursor":
+		panic(fmt.Errorf("field cursor of message cosmos.crisis.v1beta1.InvariantProgress is not mutable"))
+	case "cosmos.crisis.v1beta1.InvariantProgress.passes":
+		panic(fmt.Errorf("field passes of message cosmos.crisis.v1beta1.InvariantProgress is not mutable"))
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_pass_height":
+		panic(fmt.Errorf("field last_pass_height of message cosmos.crisis.v1beta1.InvariantProgress is not mutable"))
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_violation":
+		panic(fmt.Errorf("field last_violation of message cosmos.crisis.v1beta1.InvariantProgress is not mutable"))
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_violation_height":
+		panic(fmt.Errorf("field last_violation_height of message cosmos.crisis.v1beta1.InvariantProgress is not mutable"))
+	case "cosmos.crisis.v1beta1.InvariantProgress.state":
+		panic(fmt.Errorf("field state of message cosmos.crisis.v1beta1.InvariantProgress is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.InvariantProgress"))
+		}
+		panic(fmt.Errorf("message cosmos.crisis.v1beta1.InvariantProgress does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InvariantProgress) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.crisis.v1beta1.InvariantProgress.cursor":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.crisis.v1beta1.InvariantProgress.passes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_pass_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_violation":
+		return protoreflect.ValueOfString("")
+	case "cosmos.crisis.v1beta1.InvariantProgress.last_violation_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.crisis.v1beta1.InvariantProgress.state":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.crisis.v1beta1.InvariantProgress"))
+		}
+		panic(fmt.Errorf("message cosmos.crisis.v1beta1.InvariantProgress does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InvariantProgress) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.crisis.v1beta1.InvariantProgress", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InvariantProgress) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InvariantProgress) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InvariantProgress) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InvariantProgress) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InvariantProgress)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Cursor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Passes != 0 {
+			n += 1 + runtime.Sov(uint64(x.Passes))
+		}
+		if x.LastPassHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastPassHeight))
+		}
+		l = len(x.LastViolation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LastViolationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastViolationHeight))
+		}
+		l = len(x.State)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InvariantProgress)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.State) > 0 {
+			i -= len(x.State)
+			copy(dAtA[i:], x.State)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.State)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.LastViolationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastViolationHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.LastViolation) > 0 {
+			i -= len(x.LastViolation)
+			copy(dAtA[i:], x.LastViolation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastViolation)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.LastPassHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastPassHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Passes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Passes))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Cursor) > 0 {
+			i -= len(x.Cursor)
+			copy(dAtA[i:], x.Cursor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Cursor)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InvariantProgress)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InvariantProgress: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InvariantProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Cursor = append(x.Cursor[:0], dAtA[iNdEx:postIndex]...)
+				if x.Cursor == nil {
+					x.Cursor = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Passes", wireType)
+				}
+				x.Passes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Passes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastPassHeight", wireType)
+				}
+				x.LastPassHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastPassHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastViolation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastViolation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastViolationHeight", wireType)
+				}
+				x.LastViolationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastViolationHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.State = append(x.State[:0], dAtA[iNdEx:postIndex]...)
+				if x.State == nil {
+					x.State = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/crisis/v1beta1/genesis.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// HaltPolicy defines how the chain reacts to a broken incremental invariant.
+type HaltPolicy int32
+
+const (
+	// HALT_POLICY_UNSPECIFIED defaults to HALT_POLICY_EVENT.
+	HaltPolicy_HALT_POLICY_UNSPECIFIED HaltPolicy = 0
+	// HALT_POLICY_EVENT reports the violation through an event and keeps the
+	// chain running.
+	HaltPolicy_HALT_POLICY_EVENT HaltPolicy = 1
+	// HALT_POLICY_HALT reports the violation through an event and halts the chain
+	// if the pass finding it started in the same block. The violations found by
+	// a pass spanning several blocks can be transient and never halt the chain.
+	HaltPolicy_HALT_POLICY_HALT HaltPolicy = 2
+)
+
+// Enum value maps for HaltPolicy.
+var (
+	HaltPolicy_name = map[int32]string{
+		0: "HALT_POLICY_UNSPECIFIED",
+		1: "HALT_POLICY_EVENT",
+		2: "HALT_POLICY_HALT",
+	}
+	HaltPolicy_value = map[string]int32{
+		"HALT_POLICY_UNSPECIFIED": 0,
+		"HALT_POLICY_EVENT":       1,
+		"HALT_POLICY_HALT":        2,
+	}
+)
+
+func (x HaltPolicy) Enum() *HaltPolicy {
+	p := new(HaltPolicy)
+	*p = x
+	return p
+}
+
+func (x HaltPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HaltPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_crisis_v1beta1_genesis_proto_enumTypes[0].Descriptor()
+}
+
+func (HaltPolicy) Type() protoreflect.EnumType {
+	return &file_cosmos_crisis_v1beta1_genesis_proto_enumTypes[0]
+}
+
+func (x HaltPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HaltPolicy.Descriptor instead.
+func (HaltPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_crisis_v1beta1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+// GenesisState defines the crisis module's genesis state.
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// constant_fee is the fee used to verify the invariant in the crisis
+	// module.
+	ConstantFee *v1beta1.Coin `protobuf:"bytes,3,opt,name=constant_fee,json=constantFee,proto3" json:"constant_fee,omitempty"`
+	// incremental_params are the parameters of the incremental invariant checks.
+	IncrementalParams *IncrementalParams `protobuf:"bytes,4,opt,name=incremental_params,json=incrementalParams,proto3" json:"incremental_params,omitempty"`
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crisis_v1beta1_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_cosmos_crisis_v1beta1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenesisState) GetConstantFee() *v1beta1.Coin {
+	if x != nil {
+		return x.ConstantFee
+	}
+	return nil
+}
+
+func (x *GenesisState) GetIncrementalParams() *IncrementalParams {
+	if x != nil {
+		return x.IncrementalParams
+	}
+	return nil
+}
+
+// IncrementalParams defines the parameters of the incremental invariant checks.
+type IncrementalParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// gas_budget is the gas that incremental invariants can consume per block.
+	// The budget is checked between shards, so a block can exceed it by the gas
+	// of a single shard. Zero disables incremental invariant checks.
+	GasBudget uint64 `protobuf:"varint,1,opt,name=gas_budget,json=gasBudget,proto3" json:"gas_budget,omitempty"`
+	// halt_policy defines how the chain reacts to a broken incremental invariant.
+	HaltPolicy HaltPolicy `protobuf:"varint,2,opt,name=halt_policy,json=haltPolicy,proto3,enum=cosmos.crisis.v1beta1.HaltPolicy" json:"halt_policy,omitempty"`
+}
+
+func (x *IncrementalParams) Reset() {
+	*x = IncrementalParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crisis_v1beta1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncrementalParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementalParams) ProtoMessage() {}
+
+// Deprecated: Use IncrementalParams.ProtoReflect.Descriptor instead.
+func (*IncrementalParams) Descriptor() ([]byte, []int) {
+	return file_cosmos_crisis_v1beta1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IncrementalParams) GetGasBudget() uint64 {
+	if x != nil {
+		return x.GasBudget
+	}
+	return 0
+}
+
+func (x *IncrementalParams) GetHaltPolicy() HaltPolicy {
+	if x != nil {
+		return x.HaltPolicy
+	}
+	return HaltPolicy_HALT_POLICY_UNSPECIFIED
+}
+
+// InvariantProgress is the progress of an incremental invariant.
+type InvariantProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cursor is the cursor of the next shard to check. It is empty at the start
+	// of a pass.
+	Cursor []byte `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// passes is the number of completed passes over the whole keyspace.
+	Passes uint64 `protobuf:"varint,2,opt,name=passes,proto3" json:"passes,omitempty"`
+	// last_pass_height is the height at which the last pass completed.
+	LastPassHeight int64 `protobuf:"varint,3,opt,name=last_pass_height,json=lastPassHeight,proto3" json:"last_pass_height,omitempty"`
+	// last_violation describes the last violation of the invariant, if any.
+	LastViolation string `protobuf:"bytes,4,opt,name=last_violation,json=lastViolation,proto3" json:"last_violation,omitempty"`
+	// last_violation_height is the height at which the last violation was found.
+	LastViolationHeight int64 `protobuf:"varint,5,opt,name=last_violation_height,json=lastViolationHeight,proto3" json:"last_violation_height,omitempty"`
+	// state is the state carried by the invariant from one shard to the next,
+	// such as partial sums. It is empty at the start of a pass.
+	State []byte `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *InvariantProgress) Reset() {
+	*x = InvariantProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_crisis_v1beta1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvariantProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvariantProgress) ProtoMessage() {}
+
+// Deprecated: Use InvariantProgress.ProtoReflect.Descriptor instead.
+func (*InvariantProgress) Descriptor() ([]byte, []int) {
+	return file_cosmos_crisis_v1beta1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InvariantProgress) GetCursor() []byte {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *InvariantProgress) GetPasses() uint64 {
+	if x != nil {
+		return x.Passes
+	}
+	return 0
+}
+
+func (x *InvariantProgress) GetLastPassHeight() int64 {
+	if x != nil {
+		return x.LastPassHeight
+	}
+	return 0
+}
+
+func (x *InvariantProgress) GetLastViolation() string {
+	if x != nil {
+		return x.LastViolation
+	}
+	return ""
+}
+
+func (x *InvariantProgress) GetLastViolationHeight() int64 {
+	if x != nil {
+		return x.LastViolationHeight
+	}
+	return 0
+}
+
+func (x *InvariantProgress) GetState() []byte {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+var File_cosmos_crisis_v1beta1_genesis_proto protoreflect.FileDescriptor
+
+var file_cosmos_crisis_v1beta1_genesis_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x69, 0x73, 0x69, 0x73, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72,
+	0x69, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x12,
+	0x62, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x5f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x11, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x22, 0x76, 0x0a, 0x11, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67, 0x61,
+	0x73, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x42, 0x0a, 0x0b, 0x68, 0x61, 0x6c, 0x74, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x48, 0x61, 0x6c, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x0a, 0x68, 0x61, 0x6c, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xde, 0x01, 0x0a, 0x11,
+	0x49, 0x6e, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2a, 0x56, 0x0a, 0x0a,
+	0x48, 0x61, 0x6c, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x17, 0x48, 0x41,
+	0x4c, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x41, 0x4c, 0x54, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x14,
+	0x0a, 0x10, 0x48, 0x41, 0x4c, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x48, 0x41,
+	0x4c, 0x54, 0x10, 0x02, 0x42, 0xd5, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x63, 0x72, 0x69, 0x73, 0x69, 0x73, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x34, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x72, 0x69, 0x73,
+	0x69, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x63, 0x72, 0x69, 0x73, 0x69,
+	0x73, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02,
+	0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x72, 0x69, 0x73, 0x69, 0x73, 0x2e, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x15, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
+	0x43, 0x72, 0x69, 0x73, 0x69, 0x73, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02,
+	0x21, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x43, 0x72, 0x69, 0x73, 0x69, 0x73, 0x5c, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x43, 0x72, 0x69,
+	0x73, 0x69, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cosmos_crisis_v1beta1_genesis_proto_rawDescOnce sync.Once
+	file_cosmos_crisis_v1beta1_genesis_proto_rawDescData = file_cosmos_crisis_v1beta1_genesis_proto_rawDesc
+)
+
+func file_cosmos_crisis_v1beta1_genesis_proto_rawDescGZIP() []byte {
+	file_cosmos_crisis_v1beta1_genesis_proto_rawDescOnce.Do(func() {
+		file_cosmos_crisis_v1beta1_genesis_proto_rawDescData = protoimpl.X.CompressGZIP(file_cosmos_crisis_v1beta1_genesis_proto_rawDescData)
+	})
+	return file_cosmos_crisis_v1beta1_genesis_proto_rawDescData
+}
+
+var file_cosmos_crisis_v1beta1_genesis_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cosmos_crisis_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cosmos_crisis_v1beta1_genesis_proto_goTypes = []interface{}{
+	(HaltPolicy)(0),           // 0: cosmos.crisis.v1beta1.HaltPolicy
+	(*GenesisState)(nil),      // 1: cosmos.crisis.v1beta1.GenesisState
+	(*IncrementalParams)(nil), // 2: cosmos.crisis.v1beta1.IncrementalParams
+	(*InvariantProgress)(nil), // 3: cosmos.crisis.v1beta1.InvariantProgress
+	(*v1beta1.Coin)(nil),      // 4: cosmos.base.v1beta1.Coin
+}
+var file_cosmos_crisis_v1beta1_genesis_proto_depIdxs = []int32{
+	4, // 0: cosmos.crisis.v1beta1.GenesisState.constant_fee:type_name -> cosmos.base.v1beta1.Coin
+	2, // 1: cosmos.crisis.v1beta1.GenesisState.incremental_params:type_name -> cosmos.crisis.v1beta1.IncrementalParams
+	0, // 2: cosmos.crisis.v1beta1.IncrementalParams.halt_policy:type_name -> cosmos.crisis.v1beta1.HaltPolicy
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_cosmos_crisis_v1beta1_genesis_proto_init() }
+func file_cosmos_crisis_v1beta1_genesis_proto_init() {
+	if File_cosmos_crisis_v1beta1_genesis_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cosmos_crisis_v1beta1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crisis_v1beta1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncrementalParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_crisis_v1beta1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvariantProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -584,13 +2072,14 @@ func file_cosmos_crisis_v1beta1_genesis_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_crisis_v1beta1_genesis_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_cosmos_crisis_v1beta1_genesis_proto_goTypes,
 		DependencyIndexes: file_cosmos_crisis_v1beta1_genesis_proto_depIdxs,
+		EnumInfos:         file_cosmos_crisis_v1beta1_genesis_proto_enumTypes,
 		MessageInfos:      file_cosmos_crisis_v1beta1_genesis_proto_msgTypes,
 	}.Build()
 	File_cosmos_crisis_v1beta1_genesis_proto = out.File
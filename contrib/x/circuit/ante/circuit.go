@@ -13,6 +13,14 @@ type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
 
+// MsgCircuitBreaker is implemented by circuit breakers that inspect the message
+// itself, e.g. to enforce rate and outflow limits or to exempt some signers
+// from a trip. If the circuit breaker implements it, IsMsgAllowed is used
+// instead of IsAllowed.
+type MsgCircuitBreaker interface {
+	IsMsgAllowed(ctx context.Context, msg sdk.Msg) error
+}
+
 // CircuitBreakerDecorator is an AnteDecorator that checks if the transaction type is allowed to enter the mempool or be executed
 type CircuitBreakerDecorator struct {
 	circuitKeeper CircuitBreaker
@@ -32,6 +40,13 @@ func NewCircuitBreakerDecorator(ck CircuitBreaker) CircuitBreakerDecorator {
 func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	// loop through all the messages and check if the message type is allowed
 	for _, msg := range tx.GetMsgs() {
+		if mcb, ok := cbd.circuitKeeper.(MsgCircuitBreaker); ok {
+			if err := mcb.IsMsgAllowed(ctx, msg); err != nil {
+				return ctx, err
+			}
+			continue
+		}
+
 		isAllowed, err := cbd.circuitKeeper.IsAllowed(ctx, sdk.MsgTypeURL(msg))
 		if err != nil {
 			return ctx, err
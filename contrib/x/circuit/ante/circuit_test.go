@@ -37,6 +37,17 @@ func (m MockCircuitBreaker) IsAllowed(ctx context.Context, typeURL string) (bool
 	return typeURL == "/cosmos.circuit.v1.MsgAuthorizeCircuitBreaker", nil
 }
 
+type MockMsgCircuitBreaker struct {
+	MockCircuitBreaker
+}
+
+func (m MockMsgCircuitBreaker) IsMsgAllowed(ctx context.Context, msg sdk.Msg) error {
+	if _, ok := msg.(*cbtypes.MsgTripCircuitBreaker); ok {
+		return nil
+	}
+	return cbtypes.ErrRateLimitExceeded
+}
+
 func initFixture(t *testing.T) *fixture {
 	t.Helper()
 
@@ -94,3 +105,41 @@ func TestCircuitBreakerDecorator(t *testing.T) {
 		}
 	}
 }
+
+func TestCircuitBreakerDecoratorMsgCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+
+	testcases := []struct {
+		msg     sdk.Msg
+		allowed bool
+	}{
+		{msg: &cbtypes.MsgTripCircuitBreaker{Authority: "cosmos1abcde"}, allowed: true},
+		// IsMsgAllowed takes precedence over IsAllowed
+		{msg: &cbtypes.MsgAuthorizeCircuitBreaker{
+			Grantee: "cosmos1fghij",
+			Granter: "cosmos1abcde",
+		}, allowed: false},
+		{msg: testdata.NewTestMsg(addr1), allowed: false},
+	}
+
+	for _, tc := range testcases {
+		decorator := ante.NewCircuitBreakerDecorator(MockMsgCircuitBreaker{})
+
+		require.NoError(t, f.txBuilder.SetMsgs(tc.msg))
+		tx := f.txBuilder.GetTx()
+
+		sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+		_, err := decorator.AnteHandle(sdkCtx, tx, false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			return ctx, nil
+		})
+
+		if tc.allowed {
+			require.NoError(t, err)
+		} else {
+			require.ErrorIs(t, err, cbtypes.ErrRateLimitExceeded)
+		}
+	}
+}
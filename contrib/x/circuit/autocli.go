@@ -30,6 +30,32 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "disabled-list",
 					Short:     "Query a list of all disabled message types",
 				},
+				{
+					RpcMethod: "RateLimits",
+					Use:       "rate-limits",
+					Short:     "Query the per block execution limits of message types",
+				},
+				{
+					RpcMethod: "OutflowLimits",
+					Use:       "outflow-limits",
+					Short:     "Query the bank send outflow limits of denoms",
+				},
+				{
+					RpcMethod: "TripExemptions",
+					Use:       "trip-exemptions",
+					Short:     "Query the accounts exempt from message type trips",
+				},
+				{
+					RpcMethod: "TripExpiries",
+					Use:       "trip-expiries",
+					Short:     "Query the expiries of disabled message types",
+				},
+				{
+					RpcMethod:      "TripHistory",
+					Use:            "trip-history [msg_type_url]",
+					Short:          "Query the history of trips and resets, optionally of a single message type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "msg_type_url", Optional: true}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -51,7 +77,8 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "TripCircuitBreaker",
 					Use:       "disable [msg_type_urls]",
 					Short:     "Disable a message from being executed",
-					Example:   fmt.Sprintf(`%s circuit disable "/cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
+					Long:      `Disable a message from being executed. Use --expires-at-height or --expires-at to automatically re-enable the message at a given block height or time.`,
+					Example:   fmt.Sprintf(`%s circuit disable "/cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend" --expires-at-height 1000`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "msg_type_urls", Varargs: true},
 					},
@@ -65,6 +92,46 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "msg_type_urls", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "ExtendCircuitBreaker",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetRateLimit",
+					Use:       "set-rate-limit [msg_type_url] [max_executions_per_block] [expires_at]",
+					Short:     "Limit the number of times a message can be executed per block",
+					Long:      `Limit the number of times a message can be executed per block. A limit of 0 removes the rate limit. If no expiry time is given, the limit never expires.`,
+					Example:   fmt.Sprintf(`%s tx circuit set-rate-limit "/cosmos.bank.v1beta1.MsgSend" 100 2025-01-01T00:00:00Z`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "rate_limit.msg_type_url"},
+						{ProtoField: "rate_limit.max_executions_per_block"},
+						{ProtoField: "rate_limit.expires_at", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "SetOutflowLimit",
+					Use:       "set-outflow-limit [denom] [amount] [window] [expires_at]",
+					Short:     "Limit the amount of a denom that can be sent within a time window",
+					Long:      `Limit the amount of a denom that can be sent via bank sends within a time window. An amount of 0 removes the outflow limit. If no expiry time is given, the limit never expires.`,
+					Example:   fmt.Sprintf(`%s tx circuit set-outflow-limit stake 1000000 1h`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "outflow_limit.denom"},
+						{ProtoField: "outflow_limit.amount"},
+						{ProtoField: "outflow_limit.window"},
+						{ProtoField: "outflow_limit.expires_at", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "SetTripExemption",
+					Use:       "set-trip-exemption [msg_type_url] [accounts]",
+					Short:     "Allow accounts to keep executing a disabled message",
+					Long:      `Allow accounts to keep executing a disabled message. An empty list of accounts removes the exemption.`,
+					Example:   fmt.Sprintf(`%s tx circuit set-trip-exemption "/cosmos.bank.v1beta1.MsgSend" [address1] [address2]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "trip_exemption.msg_type_url"},
+						{ProtoField: "trip_exemption.accounts", Varargs: true},
+					},
+				},
 			},
 		},
 	}
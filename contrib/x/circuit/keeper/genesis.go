@@ -37,9 +37,59 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
+	var rateLimits []*types.RateLimit
+	err = k.RateLimits.Walk(ctx, nil, func(_ string, limit types.RateLimit) (stop bool, err error) {
+		rateLimits = append(rateLimits, &limit)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var outflowLimits []*types.OutflowLimit
+	err = k.OutflowLimits.Walk(ctx, nil, func(_ string, limit types.OutflowLimit) (stop bool, err error) {
+		outflowLimits = append(outflowLimits, &limit)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var exemptions []*types.TripExemption
+	err = k.TripExemptions.Walk(ctx, nil, func(_ string, exemption types.TripExemption) (stop bool, err error) {
+		exemptions = append(exemptions, &exemption)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var expiries []*types.TripExpiry
+	err = k.TripExpiries.Walk(ctx, nil, func(_ string, expiry types.TripExpiry) (stop bool, err error) {
+		expiries = append(expiries, &expiry)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var history []*types.TripRecord
+	err = k.TripHistory.Walk(ctx, nil, func(_ uint64, record types.TripRecord) (stop bool, err error) {
+		history = append(history, &record)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		AccountPermissions: permissions,
 		DisabledTypeUrls:   disabledMsgs,
+		RateLimits:         rateLimits,
+		OutflowLimits:      outflowLimits,
+		TripExemptions:     exemptions,
+		TripExpiries:       expiries,
+		TripHistory:        history,
 	}
 }
 
@@ -62,4 +112,36 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState)
 			panic(err)
 		}
 	}
+	for _, limit := range genState.RateLimits {
+		if err := k.RateLimits.Set(ctx, limit.MsgTypeUrl, *limit); err != nil {
+			panic(err)
+		}
+	}
+	for _, limit := range genState.OutflowLimits {
+		if err := k.OutflowLimits.Set(ctx, limit.Denom, *limit); err != nil {
+			panic(err)
+		}
+	}
+	for _, exemption := range genState.TripExemptions {
+		if err := k.TripExemptions.Set(ctx, exemption.MsgTypeUrl, *exemption); err != nil {
+			panic(err)
+		}
+	}
+	for _, expiry := range genState.TripExpiries {
+		if err := k.TripExpiries.Set(ctx, expiry.MsgTypeUrl, *expiry); err != nil {
+			panic(err)
+		}
+	}
+	var nextRecordID uint64
+	for _, record := range genState.TripHistory {
+		if err := k.TripHistory.Set(ctx, record.Id, *record); err != nil {
+			panic(err)
+		}
+		if record.Id >= nextRecordID {
+			nextRecordID = record.Id + 1
+		}
+	}
+	if err := k.TripHistorySeq.Set(ctx, nextRecordID); err != nil {
+		panic(err)
+	}
 }
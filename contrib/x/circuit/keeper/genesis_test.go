@@ -3,9 +3,12 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/contrib/x/circuit"
@@ -67,9 +70,26 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	url := "test_url"
 
+	expiry := time.Unix(1_700_000_000, 0).UTC()
+
 	genesisState := &types.GenesisState{
 		AccountPermissions: accounts,
 		DisabledTypeUrls:   []string{url},
+		RateLimits: []*types.RateLimit{
+			{MsgTypeUrl: url, MaxExecutionsPerBlock: 10, ExpiresAt: &expiry},
+		},
+		OutflowLimits: []*types.OutflowLimit{
+			{Denom: "stake", Amount: math.NewInt(1000), Window: time.Hour},
+		},
+		TripExemptions: []*types.TripExemption{
+			{MsgTypeUrl: url, Accounts: []string{genAccsPerms.Address}, ExpiresAt: &expiry},
+		},
+		TripExpiries: []*types.TripExpiry{
+			{MsgTypeUrl: url, ExpiresAtHeight: 100},
+		},
+		TripHistory: []*types.TripRecord{
+			{Id: 4, MsgTypeUrl: url, Action: types.TripRecord_ACTION_TRIP, Authority: genAccsPerms.Address, Height: 3, Time: expiry, ExpiresAtHeight: 100},
+		},
 	}
 
 	s.keeper.InitGenesis(s.ctx, genesisState)
@@ -84,4 +104,14 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	s.Require().Equal(genesisState.AccountPermissions, exportedGenesisState.AccountPermissions)
 	s.Require().Equal(genesisState.DisabledTypeUrls, exportedGenesisState.DisabledTypeUrls)
+	s.Require().Equal(genesisState.RateLimits, exportedGenesisState.RateLimits)
+	s.Require().Equal(genesisState.OutflowLimits, exportedGenesisState.OutflowLimits)
+	s.Require().Equal(genesisState.TripExemptions, exportedGenesisState.TripExemptions)
+	s.Require().Equal(genesisState.TripExpiries, exportedGenesisState.TripExpiries)
+	s.Require().Equal(genesisState.TripHistory, exportedGenesisState.TripHistory)
+
+	// the history sequence continues after the imported records
+	nextID, err := s.keeper.TripHistorySeq.Peek(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), nextID)
 }
@@ -13,7 +13,7 @@ import (
 
 // Keeper defines the circuit module's keeper.
 type Keeper struct {
-	cdc          codec.BinaryCodec
+	cdc          codec.Codec
 	storeService store.KVStoreService
 
 	authority []byte
@@ -25,10 +25,27 @@ type Keeper struct {
 	Permissions collections.Map[[]byte, types.Permissions]
 	// DisableList contains the message URLs that are disabled
 	DisableList collections.KeySet[string]
+	// RateLimits contains the per block execution limits keyed by message URL
+	RateLimits collections.Map[string, types.RateLimit]
+	// BlockExecutions contains the number of executions of rate limited
+	// message URLs in the latest block they were executed in
+	BlockExecutions collections.Map[string, types.BlockExecutions]
+	// OutflowLimits contains the bank send outflow limits keyed by denom
+	OutflowLimits collections.Map[string, types.OutflowLimit]
+	// OutflowUsage contains the outflow of limited denoms in their current window
+	OutflowUsage collections.Map[string, types.OutflowUsage]
+	// TripExemptions contains the accounts exempt from a trip keyed by message URL
+	TripExemptions collections.Map[string, types.TripExemption]
+	// TripExpiries contains the expiries of tripped message URLs
+	TripExpiries collections.Map[string, types.TripExpiry]
+	// TripHistory contains the history of trips and resets keyed by sequence
+	TripHistory collections.Map[uint64, types.TripRecord]
+	// TripHistorySeq is the sequence of trip history records
+	TripHistorySeq collections.Sequence
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
-func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authority string, addressCodec address.Codec) Keeper {
+func NewKeeper(cdc codec.Codec, storeService store.KVStoreService, authority string, addressCodec address.Codec) Keeper {
 	auth, err := addressCodec.StringToBytes(authority)
 	if err != nil {
 		panic(err)
@@ -54,6 +71,60 @@ func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, authori
 			"disable_list",
 			collections.StringKey,
 		),
+		RateLimits: collections.NewMap(
+			sb,
+			types.RateLimitPrefix,
+			"rate_limits",
+			collections.StringKey,
+			codec.CollValue[types.RateLimit](cdc),
+		),
+		BlockExecutions: collections.NewMap(
+			sb,
+			types.BlockExecutionsPrefix,
+			"block_executions",
+			collections.StringKey,
+			codec.CollValue[types.BlockExecutions](cdc),
+		),
+		OutflowLimits: collections.NewMap(
+			sb,
+			types.OutflowLimitPrefix,
+			"outflow_limits",
+			collections.StringKey,
+			codec.CollValue[types.OutflowLimit](cdc),
+		),
+		OutflowUsage: collections.NewMap(
+			sb,
+			types.OutflowUsagePrefix,
+			"outflow_usage",
+			collections.StringKey,
+			codec.CollValue[types.OutflowUsage](cdc),
+		),
+		TripExemptions: collections.NewMap(
+			sb,
+			types.TripExemptionPrefix,
+			"trip_exemptions",
+			collections.StringKey,
+			codec.CollValue[types.TripExemption](cdc),
+		),
+		TripExpiries: collections.NewMap(
+			sb,
+			types.TripExpiryPrefix,
+			"trip_expiries",
+			collections.StringKey,
+			codec.CollValue[types.TripExpiry](cdc),
+		),
+		TripHistory: collections.NewMap(
+			sb,
+			types.TripHistoryPrefix,
+			"trip_history",
+			collections.Uint64Key,
+			codec.CollValue[types.TripRecord](cdc),
+		),
+		TripHistorySeq: collections.NewSequence(
+			sb,
+			types.TripHistorySeqPrefix,
+			"trip_history_seq",
+		),
 	}
 
 	schema, err := sb.Build()
@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/contrib/x/circuit/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// IsMsgAllowed returns an error if msg must not be executed, either because its
+// type URL is disabled and its signers are not exempt from the trip, or because
+// executing it would exceed a rate or outflow limit. The execution is not
+// recorded against the limits.
+func (k *Keeper) IsMsgAllowed(ctx context.Context, msg sdk.Msg) error {
+	return k.checkMsg(ctx, msg, false)
+}
+
+// AllowMsg performs the same checks as IsMsgAllowed and records the execution
+// of msg against the rate and outflow limits. It is called by baseapp's
+// MsgServiceRouter right before msg is executed.
+func (k *Keeper) AllowMsg(ctx context.Context, msg sdk.Msg) error {
+	return k.checkMsg(ctx, msg, true)
+}
+
+func (k *Keeper) checkMsg(ctx context.Context, msg sdk.Msg, record bool) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	msgURL := sdk.MsgTypeURL(msg)
+
+	if err := k.checkTrip(ctx, msgURL, msg, sdkCtx.BlockTime()); err != nil {
+		return err
+	}
+
+	// during CheckTx the block height is the one of the last committed block, so
+	// execution counts only apply to blocks being executed
+	if record || !sdkCtx.IsCheckTx() {
+		if err := k.checkRateLimit(ctx, msgURL, sdkCtx.BlockHeight(), sdkCtx.BlockTime(), record); err != nil {
+			return err
+		}
+	}
+
+	return k.checkOutflow(ctx, msg, sdkCtx.BlockTime(), record)
+}
+
+// checkTrip returns an error if msgURL is disabled, unless all signers of msg
+// are exempt from the trip.
+func (k *Keeper) checkTrip(ctx context.Context, msgURL string, msg sdk.Msg, blockTime time.Time) error {
+	disabled, err := k.DisableList.Has(ctx, msgURL)
+	if err != nil || !disabled {
+		return err
+	}
+
+	exempt, err := k.isExempt(ctx, msgURL, msg, blockTime)
+	if err != nil {
+		return err
+	}
+	if !exempt {
+		return errorsmod.Wrap(types.ErrMsgDisabled, msgURL)
+	}
+
+	return nil
+}
+
+func (k *Keeper) isExempt(ctx context.Context, msgURL string, msg sdk.Msg, blockTime time.Time) (bool, error) {
+	exemption, err := k.TripExemptions.Get(ctx, msgURL)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if !exemption.IsActive(blockTime) {
+		return false, nil
+	}
+
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return false, err
+	}
+	if len(signers) == 0 {
+		return false, nil
+	}
+
+	for _, signer := range signers {
+		addr, err := k.addressCodec.BytesToString(signer)
+		if err != nil {
+			return false, err
+		}
+		if !slices.Contains(exemption.Accounts, addr) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// checkRateLimit returns an error if msgURL has already been executed the
+// maximum number of times allowed in the block at height.
+func (k *Keeper) checkRateLimit(ctx context.Context, msgURL string, height int64, blockTime time.Time, record bool) error {
+	limit, err := k.RateLimits.Get(ctx, msgURL)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if !limit.IsActive(blockTime) {
+		return nil
+	}
+
+	executions, err := k.BlockExecutions.Get(ctx, msgURL)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if executions.Height != height {
+		executions = types.BlockExecutions{Height: height}
+	}
+
+	if executions.Count >= limit.MaxExecutionsPerBlock {
+		return errorsmod.Wrapf(types.ErrRateLimitExceeded, "%s is limited to %d executions per block", msgURL, limit.MaxExecutionsPerBlock)
+	}
+
+	if !record {
+		return nil
+	}
+
+	executions.Count++
+	return k.BlockExecutions.Set(ctx, msgURL, executions)
+}
+
+// checkOutflow returns an error if the coins sent by msg would exceed the
+// outflow limit of any of their denoms in the current window.
+func (k *Keeper) checkOutflow(ctx context.Context, msg sdk.Msg, blockTime time.Time, record bool) error {
+	coins := bankOutflow(msg)
+	if coins.Empty() {
+		return nil
+	}
+
+	usages := make(map[string]types.OutflowUsage, len(coins))
+	for _, coin := range coins {
+		limit, err := k.OutflowLimits.Get(ctx, coin.Denom)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		if !limit.IsActive(blockTime) {
+			continue
+		}
+
+		usage, err := k.OutflowUsage.Get(ctx, coin.Denom)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		// start a new window if there is none or the current one has elapsed
+		if errors.Is(err, collections.ErrNotFound) || !blockTime.Before(usage.WindowStart.Add(limit.Window)) {
+			usage = types.OutflowUsage{WindowStart: blockTime, Amount: math.ZeroInt()}
+		}
+
+		usage.Amount = usage.Amount.Add(coin.Amount)
+		if usage.Amount.GT(limit.Amount) {
+			return errorsmod.Wrapf(types.ErrOutflowLimitExceeded, "sending %s would exceed the limit of %s%s per %s", coin, limit.Amount, limit.Denom, limit.Window)
+		}
+
+		usages[coin.Denom] = usage
+	}
+
+	if !record {
+		return nil
+	}
+
+	// iterate over coins rather than the map to keep writes deterministic
+	for _, coin := range coins {
+		usage, ok := usages[coin.Denom]
+		if !ok {
+			continue
+		}
+		if err := k.OutflowUsage.Set(ctx, coin.Denom, usage); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bankOutflow returns the coins sent by msg if it is a bank send.
+func bankOutflow(msg sdk.Msg) sdk.Coins {
+	switch msg := msg.(type) {
+	case *banktypes.MsgSend:
+		return msg.Amount
+	case *banktypes.MsgMultiSend:
+		var coins sdk.Coins
+		for _, input := range msg.Inputs {
+			coins = coins.Add(input.Coins...)
+		}
+		return coins
+	default:
+		return nil
+	}
+}
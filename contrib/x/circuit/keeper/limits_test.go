@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/contrib/x/circuit/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const bankSendURL = "/cosmos.bank.v1beta1.MsgSend"
+
+func newMsgSend(from string, amount int64) *banktypes.MsgSend {
+	return &banktypes.MsgSend{
+		FromAddress: from,
+		ToAddress:   addresses[4],
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", amount)),
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10).WithBlockTime(now)
+	expiry := now.Add(time.Hour)
+
+	require.NoError(t, f.keeper.RateLimits.Set(ctx, bankSendURL, types.RateLimit{
+		MsgTypeUrl:            bankSendURL,
+		MaxExecutionsPerBlock: 2,
+		ExpiresAt:             &expiry,
+	}))
+
+	msg := newMsgSend(addresses[0], 1)
+	require.NoError(t, f.keeper.AllowMsg(ctx, msg))
+	require.NoError(t, f.keeper.AllowMsg(ctx, msg))
+	require.ErrorIs(t, f.keeper.IsMsgAllowed(ctx, msg), types.ErrRateLimitExceeded)
+	require.ErrorIs(t, f.keeper.AllowMsg(ctx, msg), types.ErrRateLimitExceeded)
+
+	// other message types are not limited
+	require.NoError(t, f.keeper.AllowMsg(ctx, &types.MsgTripCircuitBreaker{Authority: addresses[0]}))
+
+	// the count is reset in the next block
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, f.keeper.AllowMsg(ctx, msg))
+
+	// the limit is no longer enforced once expired
+	ctx = ctx.WithBlockTime(expiry)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, f.keeper.AllowMsg(ctx, msg))
+	}
+}
+
+func TestOutflowLimit(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10).WithBlockTime(now)
+
+	require.NoError(t, f.keeper.OutflowLimits.Set(ctx, "stake", types.OutflowLimit{
+		Denom:  "stake",
+		Amount: math.NewInt(100),
+		Window: time.Hour,
+	}))
+
+	require.NoError(t, f.keeper.AllowMsg(ctx, newMsgSend(addresses[0], 60)))
+
+	// checking without recording does not consume the limit
+	require.NoError(t, f.keeper.IsMsgAllowed(ctx, newMsgSend(addresses[0], 40)))
+	require.NoError(t, f.keeper.IsMsgAllowed(ctx, newMsgSend(addresses[0], 40)))
+
+	require.ErrorIs(t, f.keeper.AllowMsg(ctx, newMsgSend(addresses[1], 41)), types.ErrOutflowLimitExceeded)
+
+	// multi sends count against the same limit
+	multiSend := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{banktypes.NewInput(sdk.MustAccAddressFromBech32(addresses[1]), sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))},
+		Outputs: []banktypes.Output{banktypes.NewOutput(sdk.MustAccAddressFromBech32(addresses[2]), sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))},
+	}
+	require.NoError(t, f.keeper.AllowMsg(ctx, multiSend))
+	require.ErrorIs(t, f.keeper.AllowMsg(ctx, newMsgSend(addresses[0], 1)), types.ErrOutflowLimitExceeded)
+
+	usage, err := f.keeper.OutflowUsage.Get(ctx, "stake")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), usage.Amount)
+	require.Equal(t, now, usage.WindowStart)
+
+	// a new window starts once the current one has elapsed
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	require.NoError(t, f.keeper.AllowMsg(ctx, newMsgSend(addresses[0], 100)))
+}
+
+func TestTripExemption(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10).WithBlockTime(now)
+	expiry := now.Add(time.Hour)
+
+	require.NoError(t, f.keeper.DisableList.Set(ctx, bankSendURL))
+	require.ErrorIs(t, f.keeper.AllowMsg(ctx, newMsgSend(addresses[0], 1)), types.ErrMsgDisabled)
+
+	require.NoError(t, f.keeper.TripExemptions.Set(ctx, bankSendURL, types.TripExemption{
+		MsgTypeUrl: bankSendURL,
+		Accounts:   []string{addresses[0]},
+		ExpiresAt:  &expiry,
+	}))
+
+	require.NoError(t, f.keeper.IsMsgAllowed(ctx, newMsgSend(addresses[0], 1)))
+	require.NoError(t, f.keeper.AllowMsg(ctx, newMsgSend(addresses[0], 1)))
+	require.ErrorIs(t, f.keeper.AllowMsg(ctx, newMsgSend(addresses[1], 1)), types.ErrMsgDisabled)
+
+	// the exemption no longer applies once expired
+	ctx = ctx.WithBlockTime(expiry)
+	require.ErrorIs(t, f.keeper.AllowMsg(ctx, newMsgSend(addresses[0], 1)), types.ErrMsgDisabled)
+}
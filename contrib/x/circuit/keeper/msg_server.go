@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/contrib/x/circuit/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 type msgServer struct {
@@ -90,6 +92,10 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 		return nil, err
 	}
 
+	if err := checkTripExpiry(ctx, msg.ExpiresAtHeight, msg.ExpiresAt); err != nil {
+		return nil, err
+	}
+
 	for _, msgTypeURL := range msg.MsgTypeUrls {
 		// check if the message is in the list of allowed messages
 		isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
@@ -117,6 +123,19 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 			return nil, err
 		}
 
+		if err = srv.setTripExpiry(ctx, msgTypeURL, msg.ExpiresAtHeight, msg.ExpiresAt); err != nil {
+			return nil, err
+		}
+
+		if err = srv.recordTripAction(ctx, types.TripRecord{
+			MsgTypeUrl:      msgTypeURL,
+			Action:          types.TripRecord_ACTION_TRIP,
+			Authority:       msg.Authority,
+			ExpiresAtHeight: msg.ExpiresAtHeight,
+			ExpiresAt:       msg.ExpiresAt,
+		}); err != nil {
+			return nil, err
+		}
 	}
 
 	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
@@ -125,8 +144,10 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 	sdkCtx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			"trip_circuit_breaker",
-			sdk.NewAttribute("authority", msg.Authority),
-			sdk.NewAttribute("msg_url", urls),
+			append([]sdk.Attribute{
+				sdk.NewAttribute("authority", msg.Authority),
+				sdk.NewAttribute("msg_url", urls),
+			}, tripExpiryAttributes(msg.ExpiresAtHeight, msg.ExpiresAt)...)...,
 		),
 	})
 
@@ -176,6 +197,18 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 		if err = srv.DisableList.Remove(ctx, msgTypeURL); err != nil {
 			return nil, err
 		}
+
+		if err = srv.TripExpiries.Remove(ctx, msgTypeURL); err != nil {
+			return nil, err
+		}
+
+		if err = srv.recordTripAction(ctx, types.TripRecord{
+			MsgTypeUrl: msgTypeURL,
+			Action:     types.TripRecord_ACTION_RESET,
+			Authority:  msg.Authority,
+		}); err != nil {
+			return nil, err
+		}
 	}
 
 	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
@@ -192,6 +225,254 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
 }
 
+// ExtendCircuitBreaker changes the expiry of tripped Msg type URLs. It can only
+// be called by the module authority.
+func (srv msgServer) ExtendCircuitBreaker(ctx context.Context, msg *types.MsgExtendCircuitBreaker) (*types.MsgExtendCircuitBreakerResponse, error) {
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(address, srv.GetAuthority()) {
+		expected, err := srv.addressCodec.BytesToString(srv.GetAuthority())
+		if err != nil {
+			return nil, err
+		}
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", expected, msg.Authority)
+	}
+
+	if len(msg.MsgTypeUrls) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type urls cannot be empty")
+	}
+
+	if err := checkTripExpiry(ctx, msg.ExpiresAtHeight, msg.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		disabled, err := srv.DisableList.Has(ctx, msgTypeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if !disabled {
+			return nil, fmt.Errorf("message %s is not disabled", msgTypeURL)
+		}
+
+		if err = srv.setTripExpiry(ctx, msgTypeURL, msg.ExpiresAtHeight, msg.ExpiresAt); err != nil {
+			return nil, err
+		}
+
+		if err = srv.recordTripAction(ctx, types.TripRecord{
+			MsgTypeUrl:      msgTypeURL,
+			Action:          types.TripRecord_ACTION_EXTEND,
+			Authority:       msg.Authority,
+			ExpiresAtHeight: msg.ExpiresAtHeight,
+			ExpiresAt:       msg.ExpiresAt,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"extend_circuit_breaker",
+			append([]sdk.Attribute{
+				sdk.NewAttribute("authority", msg.Authority),
+				sdk.NewAttribute("msg_url", strings.Join(msg.MsgTypeUrls, ",")),
+			}, tripExpiryAttributes(msg.ExpiresAtHeight, msg.ExpiresAt)...)...,
+		),
+	})
+
+	return &types.MsgExtendCircuitBreakerResponse{}, nil
+}
+
+// SetRateLimit sets or removes the per block execution limit of a Msg type URL.
+func (srv msgServer) SetRateLimit(ctx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if msg.RateLimit == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "rate limit cannot be nil")
+	}
+	limit := *msg.RateLimit
+
+	if err := srv.checkLimitPermission(ctx, msg.Authority, limit.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	if limit.MaxExecutionsPerBlock == 0 {
+		if err := srv.RateLimits.Remove(ctx, limit.MsgTypeUrl); err != nil {
+			return nil, err
+		}
+		if err := srv.BlockExecutions.Remove(ctx, limit.MsgTypeUrl); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := limit.Validate(); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if err := checkExpiry(ctx, limit.ExpiresAt); err != nil {
+			return nil, err
+		}
+		if err := srv.RateLimits.Set(ctx, limit.MsgTypeUrl, limit); err != nil {
+			return nil, err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"set_rate_limit",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", limit.MsgTypeUrl),
+			sdk.NewAttribute("max_executions_per_block", fmt.Sprintf("%d", limit.MaxExecutionsPerBlock)),
+		),
+	})
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// SetOutflowLimit sets or removes the limit on the amount of a denom that can
+// be sent via bank sends within a time window.
+func (srv msgServer) SetOutflowLimit(ctx context.Context, msg *types.MsgSetOutflowLimit) (*types.MsgSetOutflowLimitResponse, error) {
+	if msg.OutflowLimit == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "outflow limit cannot be nil")
+	}
+	limit := *msg.OutflowLimit
+
+	// outflow limits restrict bank sends, so the authority must be able to trip them
+	for _, msgTypeURL := range []string{sdk.MsgTypeURL(&banktypes.MsgSend{}), sdk.MsgTypeURL(&banktypes.MsgMultiSend{})} {
+		if err := srv.checkLimitPermission(ctx, msg.Authority, msgTypeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	if limit.Denom == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "outflow limit denom cannot be empty")
+	}
+
+	if limit.Amount.IsNil() || limit.Amount.IsZero() {
+		if err := srv.OutflowLimits.Remove(ctx, limit.Denom); err != nil {
+			return nil, err
+		}
+		if err := srv.OutflowUsage.Remove(ctx, limit.Denom); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := limit.Validate(); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if err := checkExpiry(ctx, limit.ExpiresAt); err != nil {
+			return nil, err
+		}
+		if err := srv.OutflowLimits.Set(ctx, limit.Denom, limit); err != nil {
+			return nil, err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"set_outflow_limit",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("denom", limit.Denom),
+			sdk.NewAttribute("amount", limit.Amount.String()),
+			sdk.NewAttribute("window", limit.Window.String()),
+		),
+	})
+
+	return &types.MsgSetOutflowLimitResponse{}, nil
+}
+
+// SetTripExemption sets or removes the accounts which may keep executing a
+// tripped Msg type URL.
+func (srv msgServer) SetTripExemption(ctx context.Context, msg *types.MsgSetTripExemption) (*types.MsgSetTripExemptionResponse, error) {
+	if msg.TripExemption == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "trip exemption cannot be nil")
+	}
+	exemption := *msg.TripExemption
+
+	if err := srv.checkLimitPermission(ctx, msg.Authority, exemption.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	if len(exemption.Accounts) == 0 {
+		if err := srv.TripExemptions.Remove(ctx, exemption.MsgTypeUrl); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := exemption.Validate(); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		for _, account := range exemption.Accounts {
+			if _, err := srv.addressCodec.StringToBytes(account); err != nil {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid trip exemption account %s: %s", account, err)
+			}
+		}
+		if err := checkExpiry(ctx, exemption.ExpiresAt); err != nil {
+			return nil, err
+		}
+		if err := srv.TripExemptions.Set(ctx, exemption.MsgTypeUrl, exemption); err != nil {
+			return nil, err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			"set_trip_exemption",
+			sdk.NewAttribute("authority", msg.Authority),
+			sdk.NewAttribute("msg_url", exemption.MsgTypeUrl),
+			sdk.NewAttribute("accounts", strings.Join(exemption.Accounts, ",")),
+		),
+	})
+
+	return &types.MsgSetTripExemptionResponse{}, nil
+}
+
+// checkLimitPermission returns an error if the authority is not allowed to
+// configure limits for the message. Accounts that can trip a message can also
+// configure its limits.
+func (srv msgServer) checkLimitPermission(ctx context.Context, authority, msgTypeURL string) error {
+	if msgTypeURL == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type url cannot be empty")
+	}
+
+	address, err := srv.addressCodec.StringToBytes(authority)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(address, srv.GetAuthority()) {
+		return nil
+	}
+
+	perms, err := srv.Permissions.Get(ctx, address)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+
+	switch perms.Level {
+	case types.Permissions_LEVEL_SUPER_ADMIN, types.Permissions_LEVEL_ALL_MSGS:
+		return nil
+	case types.Permissions_LEVEL_SOME_MSGS:
+		if hasPermissionForMsg(perms, msgTypeURL) {
+			return nil
+		}
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to configure circuit breaker limits for message %s", msgTypeURL)
+	default:
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to configure circuit breaker limits")
+	}
+}
+
+// checkExpiry returns an error if expiresAt is set and not after the current
+// block time.
+func checkExpiry(ctx context.Context, expiresAt *time.Time) error {
+	if expiresAt != nil && !expiresAt.After(sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry %s must be after the current block time", expiresAt)
+	}
+	return nil
+}
+
 // hasPermissionForMsg returns true if the account can trip or reset the message.
 func hasPermissionForMsg(perms types.Permissions, msg string) bool {
 	for _, msgurl := range perms.LimitTypeUrls {
@@ -3,14 +3,17 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/contrib/x/circuit/keeper"
 	"github.com/cosmos/cosmos-sdk/contrib/x/circuit/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const msgSend = "cosmos.bank.v1beta1.MsgSend"
@@ -373,3 +376,133 @@ func TestResetCircuitBreakerSomeMsgs(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, allowed, "circuit breaker should be reset")
 }
+
+func TestSetRateLimit(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithBlockTime(now)
+
+	// an account allowed to trip MsgSend can limit it
+	somemsgs := types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{bankSendURL}}
+	_, err = srv.AuthorizeCircuitBreaker(ctx, &types.MsgAuthorizeCircuitBreaker{Granter: authority, Grantee: addresses[1], Permissions: &somemsgs})
+	require.NoError(t, err)
+
+	expiry := now.Add(time.Hour)
+	limit := types.RateLimit{MsgTypeUrl: bankSendURL, MaxExecutionsPerBlock: 5, ExpiresAt: &expiry}
+	_, err = srv.SetRateLimit(ctx, &types.MsgSetRateLimit{Authority: addresses[1], RateLimit: &limit})
+	require.NoError(t, err)
+
+	stored, err := ft.keeper.RateLimits.Get(ctx, bankSendURL)
+	require.NoError(t, err)
+	require.Equal(t, limit, stored)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"set_rate_limit",
+			sdk.NewAttribute("authority", addresses[1]),
+			sdk.NewAttribute("msg_url", bankSendURL),
+			sdk.NewAttribute("max_executions_per_block", "5"),
+		),
+		lastEvent(ctx),
+	)
+
+	// but not other messages
+	other := types.RateLimit{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgMultiSend", MaxExecutionsPerBlock: 5}
+	_, err = srv.SetRateLimit(ctx, &types.MsgSetRateLimit{Authority: addresses[1], RateLimit: &other})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// accounts without permissions cannot set limits
+	_, err = srv.SetRateLimit(ctx, &types.MsgSetRateLimit{Authority: addresses[2], RateLimit: &limit})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// expiry must be in the future
+	past := now.Add(-time.Hour)
+	_, err = srv.SetRateLimit(ctx, &types.MsgSetRateLimit{Authority: authority, RateLimit: &types.RateLimit{MsgTypeUrl: bankSendURL, MaxExecutionsPerBlock: 1, ExpiresAt: &past}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// a limit of zero removes the rate limit
+	_, err = srv.SetRateLimit(ctx, &types.MsgSetRateLimit{Authority: authority, RateLimit: &types.RateLimit{MsgTypeUrl: bankSendURL}})
+	require.NoError(t, err)
+	has, err := ft.keeper.RateLimits.Has(ctx, bankSendURL)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestSetOutflowLimit(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithBlockTime(now)
+
+	limit := types.OutflowLimit{Denom: "stake", Amount: math.NewInt(1000), Window: time.Hour}
+	_, err = srv.SetOutflowLimit(ctx, &types.MsgSetOutflowLimit{Authority: authority, OutflowLimit: &limit})
+	require.NoError(t, err)
+
+	stored, err := ft.keeper.OutflowLimits.Get(ctx, "stake")
+	require.NoError(t, err)
+	require.Equal(t, limit, stored)
+
+	// only accounts allowed to trip all bank sends can set outflow limits
+	somemsgs := types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{bankSendURL}}
+	_, err = srv.AuthorizeCircuitBreaker(ctx, &types.MsgAuthorizeCircuitBreaker{Granter: authority, Grantee: addresses[1], Permissions: &somemsgs})
+	require.NoError(t, err)
+	_, err = srv.SetOutflowLimit(ctx, &types.MsgSetOutflowLimit{Authority: addresses[1], OutflowLimit: &limit})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the window must be positive
+	invalid := types.OutflowLimit{Denom: "stake", Amount: math.NewInt(1000)}
+	_, err = srv.SetOutflowLimit(ctx, &types.MsgSetOutflowLimit{Authority: authority, OutflowLimit: &invalid})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// an amount of zero removes the limit and its usage
+	require.NoError(t, ft.keeper.AllowMsg(ctx, newMsgSend(addresses[0], 10)))
+	_, err = srv.SetOutflowLimit(ctx, &types.MsgSetOutflowLimit{Authority: authority, OutflowLimit: &types.OutflowLimit{Denom: "stake", Amount: math.ZeroInt()}})
+	require.NoError(t, err)
+	has, err := ft.keeper.OutflowLimits.Has(ctx, "stake")
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = ft.keeper.OutflowUsage.Has(ctx, "stake")
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestSetTripExemption(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithBlockTime(now)
+
+	exemption := types.TripExemption{MsgTypeUrl: bankSendURL, Accounts: []string{addresses[0], addresses[1]}}
+	_, err = srv.SetTripExemption(ctx, &types.MsgSetTripExemption{Authority: authority, TripExemption: &exemption})
+	require.NoError(t, err)
+
+	stored, err := ft.keeper.TripExemptions.Get(ctx, bankSendURL)
+	require.NoError(t, err)
+	require.Equal(t, exemption, stored)
+
+	// accounts must be valid addresses
+	invalid := types.TripExemption{MsgTypeUrl: bankSendURL, Accounts: []string{"invalid"}}
+	_, err = srv.SetTripExemption(ctx, &types.MsgSetTripExemption{Authority: authority, TripExemption: &invalid})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+	// accounts without permissions cannot set exemptions
+	_, err = srv.SetTripExemption(ctx, &types.MsgSetTripExemption{Authority: addresses[2], TripExemption: &exemption})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// an empty list of accounts removes the exemption
+	_, err = srv.SetTripExemption(ctx, &types.MsgSetTripExemption{Authority: authority, TripExemption: &types.TripExemption{MsgTypeUrl: bankSendURL}})
+	require.NoError(t, err)
+	has, err := ft.keeper.TripExemptions.Has(ctx, bankSendURL)
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -72,3 +72,92 @@ func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabled
 
 	return &types.DisabledListResponse{DisabledList: msgs}, nil
 }
+
+// RateLimits returns the configured per block Msg execution limits.
+func (qs QueryServer) RateLimits(ctx context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.RateLimits,
+		req.Pagination,
+		func(_ string, value types.RateLimit) (*types.RateLimit, error) {
+			return &value, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: results, Pagination: pageRes}, nil
+}
+
+// OutflowLimits returns the configured per denom outflow limits.
+func (qs QueryServer) OutflowLimits(ctx context.Context, req *types.QueryOutflowLimitsRequest) (*types.QueryOutflowLimitsResponse, error) {
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.OutflowLimits,
+		req.Pagination,
+		func(_ string, value types.OutflowLimit) (*types.OutflowLimit, error) {
+			return &value, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOutflowLimitsResponse{OutflowLimits: results, Pagination: pageRes}, nil
+}
+
+// TripExemptions returns the configured trip exemptions.
+func (qs QueryServer) TripExemptions(ctx context.Context, req *types.QueryTripExemptionsRequest) (*types.QueryTripExemptionsResponse, error) {
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.TripExemptions,
+		req.Pagination,
+		func(_ string, value types.TripExemption) (*types.TripExemption, error) {
+			return &value, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTripExemptionsResponse{TripExemptions: results, Pagination: pageRes}, nil
+}
+
+// TripExpiries returns the expiries of the tripped Msg type URLs.
+func (qs QueryServer) TripExpiries(ctx context.Context, req *types.QueryTripExpiriesRequest) (*types.QueryTripExpiriesResponse, error) {
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.TripExpiries,
+		req.Pagination,
+		func(_ string, value types.TripExpiry) (*types.TripExpiry, error) {
+			return &value, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTripExpiriesResponse{TripExpiries: results, Pagination: pageRes}, nil
+}
+
+// TripHistory returns the history of trips and resets, optionally filtered by
+// Msg type URL.
+func (qs QueryServer) TripHistory(ctx context.Context, req *types.QueryTripHistoryRequest) (*types.QueryTripHistoryResponse, error) {
+	results, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		qs.keeper.TripHistory,
+		req.Pagination,
+		func(_ uint64, record types.TripRecord) (bool, error) {
+			return req.MsgTypeUrl == "" || record.MsgTypeUrl == req.MsgTypeUrl, nil
+		},
+		func(_ uint64, record types.TripRecord) (*types.TripRecord, error) {
+			return &record, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTripHistoryResponse{Records: results, Pagination: pageRes}, nil
+}
@@ -2,9 +2,12 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/contrib/x/circuit/keeper"
 	"github.com/cosmos/cosmos-sdk/contrib/x/circuit/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -97,3 +100,57 @@ func TestQueryDisabledList(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []string{f.mockMsgURL}, disabledList.DisabledList)
 }
+
+func TestQueryLimits(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	qs := keeper.NewQueryServer(f.keeper)
+
+	rateLimit := types.RateLimit{MsgTypeUrl: f.mockMsgURL, MaxExecutionsPerBlock: 10}
+	require.NoError(t, f.keeper.RateLimits.Set(f.ctx, f.mockMsgURL, rateLimit))
+	rateLimits, err := qs.RateLimits(f.ctx, &types.QueryRateLimitsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.RateLimit{&rateLimit}, rateLimits.RateLimits)
+
+	outflowLimit := types.OutflowLimit{Denom: "stake", Amount: math.NewInt(1000), Window: time.Hour}
+	require.NoError(t, f.keeper.OutflowLimits.Set(f.ctx, "stake", outflowLimit))
+	outflowLimits, err := qs.OutflowLimits(f.ctx, &types.QueryOutflowLimitsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.OutflowLimit{&outflowLimit}, outflowLimits.OutflowLimits)
+
+	exemption := types.TripExemption{MsgTypeUrl: f.mockMsgURL, Accounts: []string{addresses[0]}}
+	require.NoError(t, f.keeper.TripExemptions.Set(f.ctx, f.mockMsgURL, exemption))
+	exemptions, err := qs.TripExemptions(f.ctx, &types.QueryTripExemptionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.TripExemption{&exemption}, exemptions.TripExemptions)
+}
+
+func TestQueryTripHistory(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	qs := keeper.NewQueryServer(f.keeper)
+
+	records := []types.TripRecord{
+		{Id: 0, MsgTypeUrl: f.mockMsgURL, Action: types.TripRecord_ACTION_TRIP, Authority: addresses[0], ExpiresAtHeight: 10},
+		{Id: 1, MsgTypeUrl: "other_url", Action: types.TripRecord_ACTION_TRIP, Authority: addresses[0]},
+		{Id: 2, MsgTypeUrl: f.mockMsgURL, Action: types.TripRecord_ACTION_EXPIRE},
+	}
+	for _, record := range records {
+		require.NoError(t, f.keeper.TripHistory.Set(f.ctx, record.Id, record))
+	}
+	require.NoError(t, f.keeper.TripExpiries.Set(f.ctx, f.mockMsgURL, types.TripExpiry{MsgTypeUrl: f.mockMsgURL, ExpiresAtHeight: 10}))
+
+	history, err := qs.TripHistory(f.ctx, &types.QueryTripHistoryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.TripRecord{&records[0], &records[1], &records[2]}, history.Records)
+
+	history, err = qs.TripHistory(f.ctx, &types.QueryTripHistoryRequest{MsgTypeUrl: f.mockMsgURL})
+	require.NoError(t, err)
+	require.Equal(t, []*types.TripRecord{&records[0], &records[2]}, history.Records)
+
+	expiries, err := qs.TripExpiries(f.ctx, &types.QueryTripExpiriesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.TripExpiry{{MsgTypeUrl: f.mockMsgURL, ExpiresAtHeight: 10}}, expiries.TripExpiries)
+}
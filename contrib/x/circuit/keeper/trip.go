@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/contrib/x/circuit/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ResetExpiredTrips resets the tripped message URLs whose trip has expired at
+// the current block height or time. It is called in the BeginBlocker.
+func (k *Keeper) ResetExpiredTrips(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var expired []string
+	err := k.TripExpiries.Walk(ctx, nil, func(msgURL string, expiry types.TripExpiry) (bool, error) {
+		if expiry.IsExpired(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+			expired = append(expired, msgURL)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, msgURL := range expired {
+		if err := k.DisableList.Remove(ctx, msgURL); err != nil {
+			return err
+		}
+		if err := k.TripExpiries.Remove(ctx, msgURL); err != nil {
+			return err
+		}
+		if err := k.recordTripAction(ctx, types.TripRecord{MsgTypeUrl: msgURL, Action: types.TripRecord_ACTION_EXPIRE}); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"circuit_breaker_expired",
+				sdk.NewAttribute("msg_url", msgURL),
+			),
+		)
+	}
+
+	return nil
+}
+
+// setTripExpiry sets the expiry of a tripped message URL, or removes it if
+// neither an expiry height nor time is given.
+func (k *Keeper) setTripExpiry(ctx context.Context, msgURL string, expiresAtHeight uint64, expiresAt *time.Time) error {
+	if expiresAtHeight == 0 && expiresAt == nil {
+		return k.TripExpiries.Remove(ctx, msgURL)
+	}
+
+	return k.TripExpiries.Set(ctx, msgURL, types.TripExpiry{
+		MsgTypeUrl:      msgURL,
+		ExpiresAtHeight: expiresAtHeight,
+		ExpiresAt:       expiresAt,
+	})
+}
+
+// recordTripAction appends record to the trip history at the current block
+// height and time.
+func (k *Keeper) recordTripAction(ctx context.Context, record types.TripRecord) error {
+	id, err := k.TripHistorySeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	record.Id = id
+	record.Height = sdkCtx.BlockHeight()
+	record.Time = sdkCtx.BlockTime()
+
+	return k.TripHistory.Set(ctx, id, record)
+}
+
+// checkTripExpiry returns an error if the expiry height or time of a trip is
+// set and already reached.
+func checkTripExpiry(ctx context.Context, expiresAtHeight uint64, expiresAt *time.Time) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if expiresAtHeight != 0 && height > 0 && expiresAtHeight <= uint64(height) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry height %d must be after the current block height %d", expiresAtHeight, height)
+	}
+
+	return checkExpiry(ctx, expiresAt)
+}
+
+// tripExpiryAttributes returns the event attributes describing a trip expiry,
+// if any.
+func tripExpiryAttributes(expiresAtHeight uint64, expiresAt *time.Time) []sdk.Attribute {
+	var attrs []sdk.Attribute
+	if expiresAtHeight != 0 {
+		attrs = append(attrs, sdk.NewAttribute("expires_at_height", fmt.Sprintf("%d", expiresAtHeight)))
+	}
+	if expiresAt != nil {
+		attrs = append(attrs, sdk.NewAttribute("expires_at", expiresAt.Format(time.RFC3339Nano)))
+	}
+	return attrs
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/contrib/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/contrib/x/circuit/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestTripExpiry(t *testing.T) {
+	ft := initFixture(t)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	now := time.Unix(1_700_000_000, 0).UTC()
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithBlockHeight(10).WithBlockTime(now)
+	expiry := now.Add(time.Hour)
+
+	// the expiry must be in the future
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiresAtHeight: 10})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiresAtHeight: 20})
+	require.NoError(t, err)
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{bankSendURL}, ExpiresAt: &expiry})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"trip_circuit_breaker",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", bankSendURL),
+			sdk.NewAttribute("expires_at", expiry.Format(time.RFC3339Nano)),
+		),
+		lastEvent(ctx),
+	)
+
+	// nothing expires before the expiry height or time
+	require.NoError(t, ft.keeper.ResetExpiredTrips(ctx.WithBlockHeight(19)))
+	allowed, err := ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// the trip expiring at height 20 is reset
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, ft.keeper.ResetExpiredTrips(ctx))
+	allowed, err = ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	allowed, err = ft.keeper.IsAllowed(ctx, bankSendURL)
+	require.NoError(t, err)
+	require.False(t, allowed)
+	require.Equal(t, sdk.NewEvent("circuit_breaker_expired", sdk.NewAttribute("msg_url", msgSend)), lastEvent(ctx))
+
+	// governance extends the other trip indefinitely
+	_, err = srv.ExtendCircuitBreaker(ctx, &types.MsgExtendCircuitBreaker{Authority: addresses[1], MsgTypeUrls: []string{bankSendURL}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ExtendCircuitBreaker(ctx, &types.MsgExtendCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}})
+	require.Error(t, err, "cannot extend a message which is not disabled")
+	_, err = srv.ExtendCircuitBreaker(ctx, &types.MsgExtendCircuitBreaker{Authority: authority, MsgTypeUrls: []string{bankSendURL}})
+	require.NoError(t, err)
+
+	has, err := ft.keeper.TripExpiries.Has(ctx, bankSendURL)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, ft.keeper.ResetExpiredTrips(ctx.WithBlockTime(expiry)))
+	allowed, err = ft.keeper.IsAllowed(ctx, bankSendURL)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// a reset removes the expiry of the trip
+	_, err = srv.ExtendCircuitBreaker(ctx, &types.MsgExtendCircuitBreaker{Authority: authority, MsgTypeUrls: []string{bankSendURL}, ExpiresAtHeight: 100})
+	require.NoError(t, err)
+	_, err = srv.ResetCircuitBreaker(ctx, &types.MsgResetCircuitBreaker{Authority: authority, MsgTypeUrls: []string{bankSendURL}})
+	require.NoError(t, err)
+	has, err = ft.keeper.TripExpiries.Has(ctx, bankSendURL)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// every action is recorded in the history
+	var actions []types.TripRecord_Action
+	err = ft.keeper.TripHistory.Walk(ctx, nil, func(id uint64, record types.TripRecord) (bool, error) {
+		actions = append(actions, record.Action)
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.TripRecord_Action{
+		types.TripRecord_ACTION_TRIP,
+		types.TripRecord_ACTION_TRIP,
+		types.TripRecord_ACTION_EXPIRE,
+		types.TripRecord_ACTION_EXTEND,
+		types.TripRecord_ACTION_EXTEND,
+		types.TripRecord_ACTION_RESET,
+	}, actions)
+
+	expired, err := ft.keeper.TripHistory.Get(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, types.TripRecord{Id: 2, MsgTypeUrl: msgSend, Action: types.TripRecord_ACTION_EXPIRE, Height: 20, Time: now}, expired)
+}
@@ -12,6 +12,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAuthorizeCircuitBreaker{},
 		&MsgResetCircuitBreaker{},
 		&MsgTripCircuitBreaker{},
+		&MsgExtendCircuitBreaker{},
+		&MsgSetRateLimit{},
+		&MsgSetOutflowLimit{},
+		&MsgSetTripExemption{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/circuit module sentinel errors
+var (
+	ErrMsgDisabled          = errors.Register(ModuleName, 2, "circuit breaker disables execution of this message")
+	ErrRateLimitExceeded    = errors.Register(ModuleName, 3, "message execution rate limit exceeded")
+	ErrOutflowLimitExceeded = errors.Register(ModuleName, 4, "outflow limit exceeded")
+)
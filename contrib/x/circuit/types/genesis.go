@@ -20,6 +20,84 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	rateLimits := make(map[string]struct{}, len(gs.RateLimits))
+	for _, limit := range gs.RateLimits {
+		if limit == nil {
+			return fmt.Errorf("rate limit cannot be nil")
+		}
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		if _, ok := rateLimits[limit.MsgTypeUrl]; ok {
+			return fmt.Errorf("duplicate rate limit for %s", limit.MsgTypeUrl)
+		}
+		rateLimits[limit.MsgTypeUrl] = struct{}{}
+	}
+
+	outflowLimits := make(map[string]struct{}, len(gs.OutflowLimits))
+	for _, limit := range gs.OutflowLimits {
+		if limit == nil {
+			return fmt.Errorf("outflow limit cannot be nil")
+		}
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		if _, ok := outflowLimits[limit.Denom]; ok {
+			return fmt.Errorf("duplicate outflow limit for %s", limit.Denom)
+		}
+		outflowLimits[limit.Denom] = struct{}{}
+	}
+
+	exemptions := make(map[string]struct{}, len(gs.TripExemptions))
+	for _, exemption := range gs.TripExemptions {
+		if exemption == nil {
+			return fmt.Errorf("trip exemption cannot be nil")
+		}
+		if err := exemption.Validate(); err != nil {
+			return err
+		}
+		if _, ok := exemptions[exemption.MsgTypeUrl]; ok {
+			return fmt.Errorf("duplicate trip exemption for %s", exemption.MsgTypeUrl)
+		}
+		exemptions[exemption.MsgTypeUrl] = struct{}{}
+	}
+
+	disabled := make(map[string]struct{}, len(gs.DisabledTypeUrls))
+	for _, url := range gs.DisabledTypeUrls {
+		disabled[url] = struct{}{}
+	}
+
+	expiries := make(map[string]struct{}, len(gs.TripExpiries))
+	for _, expiry := range gs.TripExpiries {
+		if expiry == nil {
+			return fmt.Errorf("trip expiry cannot be nil")
+		}
+		if err := expiry.Validate(); err != nil {
+			return err
+		}
+		if _, ok := disabled[expiry.MsgTypeUrl]; !ok {
+			return fmt.Errorf("trip expiry for %s which is not disabled", expiry.MsgTypeUrl)
+		}
+		if _, ok := expiries[expiry.MsgTypeUrl]; ok {
+			return fmt.Errorf("duplicate trip expiry for %s", expiry.MsgTypeUrl)
+		}
+		expiries[expiry.MsgTypeUrl] = struct{}{}
+	}
+
+	records := make(map[uint64]struct{}, len(gs.TripHistory))
+	for _, record := range gs.TripHistory {
+		if record == nil {
+			return fmt.Errorf("trip record cannot be nil")
+		}
+		if record.MsgTypeUrl == "" {
+			return fmt.Errorf("trip record %d msg type url cannot be empty", record.Id)
+		}
+		if _, ok := records[record.Id]; ok {
+			return fmt.Errorf("duplicate trip record %d", record.Id)
+		}
+		records[record.Id] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -17,4 +17,12 @@ const (
 var (
 	AccountPermissionPrefix = collections.NewPrefix(1)
 	DisableListPrefix       = collections.NewPrefix(2)
+	RateLimitPrefix         = collections.NewPrefix(3)
+	BlockExecutionsPrefix   = collections.NewPrefix(4)
+	OutflowLimitPrefix      = collections.NewPrefix(5)
+	OutflowUsagePrefix      = collections.NewPrefix(6)
+	TripExemptionPrefix     = collections.NewPrefix(7)
+	TripExpiryPrefix        = collections.NewPrefix(8)
+	TripHistoryPrefix       = collections.NewPrefix(9)
+	TripHistorySeqPrefix    = collections.NewPrefix(10)
 )
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// IsActive returns true if the rate limit is enforced at the given block time.
+func (r RateLimit) IsActive(blockTime time.Time) bool {
+	return r.ExpiresAt == nil || blockTime.Before(*r.ExpiresAt)
+}
+
+// Validate performs a stateless validation of the rate limit.
+func (r RateLimit) Validate() error {
+	if r.MsgTypeUrl == "" {
+		return errors.New("rate limit msg type url cannot be empty")
+	}
+	if r.MaxExecutionsPerBlock == 0 {
+		return fmt.Errorf("rate limit for %s must allow at least one execution per block", r.MsgTypeUrl)
+	}
+
+	return nil
+}
+
+// IsActive returns true if the outflow limit is enforced at the given block time.
+func (o OutflowLimit) IsActive(blockTime time.Time) bool {
+	return o.ExpiresAt == nil || blockTime.Before(*o.ExpiresAt)
+}
+
+// Validate performs a stateless validation of the outflow limit.
+func (o OutflowLimit) Validate() error {
+	if o.Denom == "" {
+		return errors.New("outflow limit denom cannot be empty")
+	}
+	if o.Amount.IsNil() || !o.Amount.IsPositive() {
+		return fmt.Errorf("outflow limit amount for %s must be positive", o.Denom)
+	}
+	if o.Window <= 0 {
+		return fmt.Errorf("outflow limit window for %s must be positive", o.Denom)
+	}
+
+	return nil
+}
+
+// IsActive returns true if the trip exemption applies at the given block time.
+func (e TripExemption) IsActive(blockTime time.Time) bool {
+	return e.ExpiresAt == nil || blockTime.Before(*e.ExpiresAt)
+}
+
+// Validate performs a stateless validation of the trip exemption.
+func (e TripExemption) Validate() error {
+	if e.MsgTypeUrl == "" {
+		return errors.New("trip exemption msg type url cannot be empty")
+	}
+	if len(e.Accounts) == 0 {
+		return fmt.Errorf("trip exemption for %s must list at least one account", e.MsgTypeUrl)
+	}
+
+	seen := make(map[string]struct{}, len(e.Accounts))
+	for _, account := range e.Accounts {
+		if account == "" {
+			return fmt.Errorf("trip exemption account for %s cannot be empty", e.MsgTypeUrl)
+		}
+		if _, ok := seen[account]; ok {
+			return fmt.Errorf("duplicate trip exemption account %s for %s", account, e.MsgTypeUrl)
+		}
+		seen[account] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the trip has expired at the given block height and
+// time.
+func (e TripExpiry) IsExpired(height int64, blockTime time.Time) bool {
+	if e.ExpiresAtHeight != 0 && height > 0 && uint64(height) >= e.ExpiresAtHeight {
+		return true
+	}
+
+	return e.ExpiresAt != nil && !blockTime.Before(*e.ExpiresAt)
+}
+
+// Validate performs a stateless validation of the trip expiry.
+func (e TripExpiry) Validate() error {
+	if e.MsgTypeUrl == "" {
+		return errors.New("trip expiry msg type url cannot be empty")
+	}
+	if e.ExpiresAtHeight == 0 && e.ExpiresAt == nil {
+		return fmt.Errorf("trip expiry for %s must set an expiry height or time", e.MsgTypeUrl)
+	}
+
+	return nil
+}
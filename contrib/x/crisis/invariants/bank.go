@@ -0,0 +1,105 @@
+package invariants
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/contrib/x/crisis/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ types.IncrementalInvariant = TotalSupplyInvariant{}
+
+// TotalSupplyInvariant checks that the total supply of each denom equals the
+// sum of its balances. Each shard sums a page of the balances of a denom, and
+// the sum is compared with the supply once all its balances are summed.
+type TotalSupplyInvariant struct {
+	bk BankKeeper
+}
+
+// NewTotalSupplyInvariant returns the incremental total supply invariant of
+// x/bank.
+func NewTotalSupplyInvariant(bk BankKeeper) TotalSupplyInvariant {
+	return TotalSupplyInvariant{bk: bk}
+}
+
+// CheckShard implements types.IncrementalInvariant. The cursor is the supply
+// pagination key of the denom being summed, prefixed with its length, followed
+// by the denom owners pagination key of the page to sum. The state is the sum
+// of the previous pages of the denom.
+func (inv TotalSupplyInvariant) CheckShard(ctx sdk.Context, cursor, state []byte) (types.ShardResult, error) {
+	supplyKey, ownersKey, err := splitSupplyCursor(cursor)
+	if err != nil {
+		return types.ShardResult{}, err
+	}
+
+	supply, pageRes, err := inv.bk.GetPaginatedTotalSupply(ctx, &query.PageRequest{Key: supplyKey, Limit: 1})
+	if err != nil {
+		return types.ShardResult{}, err
+	}
+	if supply.Empty() {
+		return types.ShardResult{Done: true}, nil
+	}
+
+	sums, err := unmarshalSums(state, 1)
+	if err != nil {
+		return types.ShardResult{}, err
+	}
+
+	expected := supply[0]
+	owners, err := inv.bk.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+		Denom:      expected.Denom,
+		Pagination: &query.PageRequest{Key: ownersKey, Limit: pageSize},
+	})
+	if err != nil {
+		return types.ShardResult{}, err
+	}
+
+	sum := sums[0]
+	for _, owner := range owners.DenomOwners {
+		sum = sum.Add(owner.Balance.Amount)
+	}
+
+	// more balances to sum for this denom
+	if owners.Pagination != nil && len(owners.Pagination.NextKey) > 0 {
+		state, err := marshalSums(sum)
+		if err != nil {
+			return types.ShardResult{}, err
+		}
+		return types.ShardResult{Next: supplyCursor(supplyKey, owners.Pagination.NextKey), State: state}, nil
+	}
+
+	res := types.ShardResult{Done: true}
+	if pageRes != nil && len(pageRes.NextKey) > 0 {
+		res = types.ShardResult{Next: supplyCursor(pageRes.NextKey, nil)}
+	}
+
+	if !sum.Equal(expected.Amount) {
+		res.Broken = true
+		res.Msg = fmt.Sprintf("total supply of %s is %s but the sum of its balances is %s", expected.Denom, expected.Amount, sum)
+	}
+
+	return res, nil
+}
+
+// supplyCursor returns the cursor of the page of balances of a denom.
+func supplyCursor(supplyKey, ownersKey []byte) []byte {
+	cursor := binary.AppendUvarint(nil, uint64(len(supplyKey)))
+	cursor = append(cursor, supplyKey...)
+	return append(cursor, ownersKey...)
+}
+
+// splitSupplyCursor splits a cursor returned by supplyCursor.
+func splitSupplyCursor(cursor []byte) (supplyKey, ownersKey []byte, err error) {
+	if len(cursor) == 0 {
+		return nil, nil, nil
+	}
+
+	l, read := binary.Uvarint(cursor)
+	if read <= 0 || uint64(len(cursor)-read) < l {
+		return nil, nil, fmt.Errorf("invalid total supply cursor")
+	}
+	return cursor[read : read+int(l)], cursor[read+int(l):], nil
+}
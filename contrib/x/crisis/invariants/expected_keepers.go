@@ -0,0 +1,26 @@
+package invariants
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// BankKeeper defines the expected bank keeper (noalias)
+type BankKeeper interface {
+	GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+	DenomOwners(ctx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// StakingKeeper defines the expected staking keeper (noalias)
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
+	GetBondedPool(ctx context.Context) sdk.ModuleAccountI
+	GetNotBondedPool(ctx context.Context) sdk.ModuleAccountI
+	IterateValidatorsFrom(ctx context.Context, start []byte, fn func(key []byte, validator stakingtypes.ValidatorI) (stop bool)) error
+	IterateUnbondingDelegationsFrom(ctx context.Context, start []byte, fn func(key []byte, ubd stakingtypes.UnbondingDelegation) (stop bool)) error
+}
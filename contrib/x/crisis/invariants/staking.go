@@ -0,0 +1,131 @@
+package invariants
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/contrib/x/crisis/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// The phases of a pass of ModuleAccountsInvariant, stored in the first byte of
+// the cursor.
+const (
+	validatorsPhase byte = iota
+	unbondingDelegationsPhase
+)
+
+var _ types.IncrementalInvariant = ModuleAccountsInvariant{}
+
+// ModuleAccountsInvariant checks that the bonded and not bonded pools hold the
+// tokens of the validators and unbonding delegations. Each shard sums a page
+// of the validators or of the unbonding delegations, and the sums are compared
+// with the pool balances once all of them are summed.
+type ModuleAccountsInvariant struct {
+	sk StakingKeeper
+	bk BankKeeper
+}
+
+// NewModuleAccountsInvariant returns the incremental staking pools invariant of
+// x/staking.
+func NewModuleAccountsInvariant(sk StakingKeeper, bk BankKeeper) ModuleAccountsInvariant {
+	return ModuleAccountsInvariant{sk: sk, bk: bk}
+}
+
+// CheckShard implements types.IncrementalInvariant. The cursor is the phase of
+// the pass followed by the store key of the first validator or unbonding
+// delegation to sum. The state is the bonded and not bonded sums of the
+// previous shards.
+func (inv ModuleAccountsInvariant) CheckShard(ctx sdk.Context, cursor, state []byte) (types.ShardResult, error) {
+	phase, start := validatorsPhase, []byte(nil)
+	if len(cursor) > 0 {
+		phase, start = cursor[0], cursor[1:]
+	}
+
+	sums, err := unmarshalSums(state, 2)
+	if err != nil {
+		return types.ShardResult{}, err
+	}
+	bonded, notBonded := sums[0], sums[1]
+
+	var next []byte
+	switch phase {
+	case validatorsPhase:
+		n := 0
+		err = inv.sk.IterateValidatorsFrom(ctx, start, func(key []byte, validator stakingtypes.ValidatorI) bool {
+			if n == pageSize {
+				next = append([]byte{validatorsPhase}, key...)
+				return true
+			}
+			n++
+
+			switch validator.GetStatus() {
+			case stakingtypes.Bonded:
+				bonded = bonded.Add(validator.GetTokens())
+			case stakingtypes.Unbonding, stakingtypes.Unbonded:
+				notBonded = notBonded.Add(validator.GetTokens())
+			}
+			return false
+		})
+		if next == nil {
+			next = []byte{unbondingDelegationsPhase}
+		}
+
+	case unbondingDelegationsPhase:
+		n := 0
+		err = inv.sk.IterateUnbondingDelegationsFrom(ctx, start, func(key []byte, ubd stakingtypes.UnbondingDelegation) bool {
+			if n == pageSize {
+				next = append([]byte{unbondingDelegationsPhase}, key...)
+				return true
+			}
+			n++
+
+			for _, entry := range ubd.Entries {
+				notBonded = notBonded.Add(entry.Balance)
+			}
+			return false
+		})
+
+	default:
+		return types.ShardResult{}, fmt.Errorf("invalid module accounts cursor phase %d", phase)
+	}
+	if err != nil {
+		return types.ShardResult{}, err
+	}
+
+	if next != nil {
+		state, err := marshalSums(bonded, notBonded)
+		if err != nil {
+			return types.ShardResult{}, err
+		}
+		return types.ShardResult{Next: next, State: state}, nil
+	}
+
+	return inv.checkPools(ctx, bonded, notBonded)
+}
+
+// checkPools checks that the bonded pool holds the tokens of the bonded
+// validators, and that the not bonded pool holds the tokens of the unbonding
+// and unbonded validators and of the unbonding delegations.
+func (inv ModuleAccountsInvariant) checkPools(ctx sdk.Context, bonded, notBonded math.Int) (types.ShardResult, error) {
+	bondDenom, err := inv.sk.BondDenom(ctx)
+	if err != nil {
+		return types.ShardResult{}, err
+	}
+
+	res := types.ShardResult{Done: true}
+	bondedBalance := inv.bk.GetBalance(ctx, inv.sk.GetBondedPool(ctx).GetAddress(), bondDenom)
+	notBondedBalance := inv.bk.GetBalance(ctx, inv.sk.GetNotBondedPool(ctx).GetAddress(), bondDenom)
+	switch {
+	case !bondedBalance.Amount.Equal(bonded):
+		res.Broken = true
+		res.Msg = fmt.Sprintf("bonded pool balance is %s but the bonded validators hold %s%s", bondedBalance, bonded, bondDenom)
+	case !notBondedBalance.Amount.Equal(notBonded):
+		res.Broken = true
+		res.Msg = fmt.Sprintf("not bonded pool balance is %s but the unbonding and unbonded validators and unbonding delegations hold %s%s", notBondedBalance, notBonded, bondDenom)
+	}
+
+	return res, nil
+}
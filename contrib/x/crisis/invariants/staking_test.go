@@ -0,0 +1,110 @@
+package invariants_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/contrib/x/crisis/invariants"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ invariants.StakingKeeper = (*stakingkeeper.Keeper)(nil)
+
+// fakeStakingKeeper keys the validators and unbonding delegations by their
+// index.
+type fakeStakingKeeper struct {
+	validators []stakingtypes.Validator
+	ubds       []stakingtypes.UnbondingDelegation
+}
+
+func indexKey(i int) []byte {
+	return binary.BigEndian.AppendUint16(nil, uint16(i))
+}
+
+func startIndex(start []byte) int {
+	if len(start) == 0 {
+		return 0
+	}
+	return int(binary.BigEndian.Uint16(start))
+}
+
+func (fakeStakingKeeper) BondDenom(context.Context) (string, error) {
+	return sdk.DefaultBondDenom, nil
+}
+
+func (fakeStakingKeeper) GetBondedPool(context.Context) sdk.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(stakingtypes.BondedPoolName)
+}
+
+func (fakeStakingKeeper) GetNotBondedPool(context.Context) sdk.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(stakingtypes.NotBondedPoolName)
+}
+
+func (sk fakeStakingKeeper) IterateValidatorsFrom(_ context.Context, start []byte, fn func(key []byte, validator stakingtypes.ValidatorI) bool) error {
+	for i := startIndex(start); i < len(sk.validators); i++ {
+		if fn(indexKey(i), sk.validators[i]) {
+			break
+		}
+	}
+	return nil
+}
+
+func (sk fakeStakingKeeper) IterateUnbondingDelegationsFrom(_ context.Context, start []byte, fn func(key []byte, ubd stakingtypes.UnbondingDelegation) bool) error {
+	for i := startIndex(start); i < len(sk.ubds); i++ {
+		if fn(indexKey(i), sk.ubds[i]) {
+			break
+		}
+	}
+	return nil
+}
+
+func TestModuleAccountsInvariant(t *testing.T) {
+	sk := fakeStakingKeeper{}
+	for i := 0; i < 150; i++ {
+		sk.validators = append(sk.validators, stakingtypes.Validator{Status: stakingtypes.Bonded, Tokens: math.NewInt(1)})
+	}
+	sk.validators = append(sk.validators, stakingtypes.Validator{Status: stakingtypes.Unbonded, Tokens: math.NewInt(5)})
+	for i := 0; i < 120; i++ {
+		sk.ubds = append(sk.ubds, stakingtypes.UnbondingDelegation{
+			Entries: []stakingtypes.UnbondingDelegationEntry{{Balance: math.NewInt(1)}},
+		})
+	}
+
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String()
+	notBondedPool := authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName).String()
+
+	// checkPass runs a whole pass and returns the number of shards and the
+	// result of the last one
+	checkPass := func(inv invariants.ModuleAccountsInvariant) (int, string) {
+		var cursor, state []byte
+		for shards := 1; ; shards++ {
+			res, err := inv.CheckShard(sdk.Context{}, cursor, state)
+			require.NoError(t, err)
+			if res.Done {
+				return shards, res.Msg
+			}
+			require.False(t, res.Broken)
+			cursor, state = res.Next, res.State
+		}
+	}
+
+	bk := fakeBankKeeper{modules: map[string]sdk.Coin{
+		bondedPool:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 150),
+		notBondedPool: sdk.NewInt64Coin(sdk.DefaultBondDenom, 125),
+	}}
+	shards, msg := checkPass(invariants.NewModuleAccountsInvariant(sk, bk))
+	require.Equal(t, 4, shards)
+	require.Empty(t, msg)
+
+	bk.modules[notBondedPool] = sdk.NewInt64Coin(sdk.DefaultBondDenom, 124)
+	_, msg = checkPass(invariants.NewModuleAccountsInvariant(sk, bk))
+	require.Equal(t, "not bonded pool balance is 124stake but the unbonding and unbonded validators and unbonding delegations hold 125stake", msg)
+}
@@ -0,0 +1,48 @@
+package invariants
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// pageSize is the number of entries read by a shard.
+const pageSize = 100
+
+// marshalSums encodes the partial sums of a pass, each prefixed with its
+// length.
+func marshalSums(sums ...math.Int) ([]byte, error) {
+	var state []byte
+	for _, sum := range sums {
+		bz, err := sum.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		state = binary.AppendUvarint(state, uint64(len(bz)))
+		state = append(state, bz...)
+	}
+	return state, nil
+}
+
+// unmarshalSums decodes n partial sums encoded by marshalSums. An empty state
+// decodes to zero sums.
+func unmarshalSums(state []byte, n int) ([]math.Int, error) {
+	sums := make([]math.Int, n)
+	for i := range sums {
+		sums[i] = math.ZeroInt()
+		if len(state) == 0 {
+			continue
+		}
+
+		l, read := binary.Uvarint(state)
+		if read <= 0 || uint64(len(state)-read) < l {
+			return nil, fmt.Errorf("invalid invariant state")
+		}
+		if err := sums[i].Unmarshal(state[read : read+int(l)]); err != nil {
+			return nil, err
+		}
+		state = state[read+int(l):]
+	}
+	return sums, nil
+}
@@ -10,6 +10,9 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data *types2.GenesisState) {
 	if err := k.ConstantFee.Set(ctx, data.ConstantFee); err != nil {
 		panic(err)
 	}
+	if err := k.IncrementalParams.Set(ctx, data.IncrementalParams); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -18,5 +21,11 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types2.GenesisState {
 	if err != nil {
 		panic(err)
 	}
-	return types2.NewGenesisState(constantFee)
+	incrementalParams, err := k.GetIncrementalParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	gs := types2.NewGenesisState(constantFee)
+	gs.IncrementalParams = incrementalParams
+	return gs
 }
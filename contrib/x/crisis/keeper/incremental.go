@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	types2 "github.com/cosmos/cosmos-sdk/contrib/x/crisis/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterIncrementalRoute registers an incremental invariant.
+func (k *Keeper) RegisterIncrementalRoute(moduleName, route string, invar types2.IncrementalInvariant) {
+	k.incrRoutes = append(k.incrRoutes, types2.NewIncrementalInvarRoute(moduleName, route, invar))
+}
+
+// IncrementalRoutes returns the routes of the registered incremental invariants.
+func (k *Keeper) IncrementalRoutes() []types2.IncrementalInvarRoute {
+	return k.incrRoutes
+}
+
+// GetIncrementalParams returns the parameters of the incremental invariant
+// checks, defaulting to disabled checks if they were never set.
+func (k *Keeper) GetIncrementalParams(ctx sdk.Context) (types2.IncrementalParams, error) {
+	params, err := k.IncrementalParams.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types2.DefaultIncrementalParams(), nil
+	}
+	return params, err
+}
+
+// CheckIncrementalInvariants checks the next shards of the registered
+// incremental invariants until the gas budget of the block is consumed. The
+// invariants are checked in turn, one pass at most per block, starting with
+// the one that ran out of budget in the previous block.
+func (k *Keeper) CheckIncrementalInvariants(ctx sdk.Context) error {
+	params, err := k.GetIncrementalParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	n := uint64(len(k.incrRoutes))
+	if params.GasBudget == 0 || n == 0 {
+		return nil
+	}
+
+	start, err := k.NextIncrementalInvariant.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	// shards are checked in a cached context with their own gas meter, so that
+	// they neither write to the store nor consume the block gas
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	invCtx, _ := ctx.CacheContext()
+	invCtx = invCtx.WithGasMeter(gasMeter)
+
+	next := start % n
+	for i := uint64(0); i < n && gasMeter.GasConsumed() < params.GasBudget; i++ {
+		idx := (start + i) % n
+		done, err := k.checkIncrementalInvariant(ctx, invCtx, k.incrRoutes[idx], gasMeter, params)
+		if err != nil {
+			return err
+		}
+
+		// resume with this invariant in the next block if it ran out of budget
+		next = idx
+		if done {
+			next = (idx + 1) % n
+		}
+	}
+
+	return k.NextIncrementalInvariant.Set(ctx, next)
+}
+
+// checkIncrementalInvariant checks the shards of an incremental invariant
+// until its pass completes or the gas budget is consumed. It returns true if
+// the pass completed.
+func (k *Keeper) checkIncrementalInvariant(
+	ctx, invCtx sdk.Context, route types2.IncrementalInvarRoute, gasMeter storetypes.GasMeter, params types2.IncrementalParams,
+) (bool, error) {
+	fullRoute := route.FullRoute()
+	progress, err := k.InvariantProgress.Get(ctx, fullRoute)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, err
+	}
+
+	// A pass starting in this block reads the state of this block only, until
+	// it runs out of budget. A pass resumed from a previous block may aggregate
+	// the state of several blocks and report transient violations.
+	singleBlock := len(progress.Cursor) == 0
+
+	done := false
+	for !done && gasMeter.GasConsumed() < params.GasBudget {
+		res, err := route.Invar.CheckShard(invCtx, progress.Cursor, progress.State)
+		if err != nil {
+			// an invariant failing to run is not a violation, retry the shard later
+			k.Logger(ctx).Error("failed to check incremental invariant", "route", fullRoute, "err", err)
+			break
+		}
+
+		if res.Broken {
+			progress.LastViolation = res.Msg
+			progress.LastViolationHeight = ctx.BlockHeight()
+			k.reportViolation(ctx, route, res.Msg, params.HaltPolicy, singleBlock)
+		}
+
+		progress.Cursor = res.Next
+		progress.State = res.State
+		if res.Done {
+			done = true
+			progress.Cursor = nil
+			progress.State = nil
+			progress.Passes++
+			progress.LastPassHeight = ctx.BlockHeight()
+		}
+	}
+
+	return done, k.InvariantProgress.Set(ctx, fullRoute, progress)
+}
+
+// reportViolation reports a broken incremental invariant through an event and
+// halts the chain if required by the halt policy. Only violations found by a
+// pass within a single block halt the chain, as the violations found by a pass
+// spanning several blocks can be transient.
+func (k *Keeper) reportViolation(ctx sdk.Context, route types2.IncrementalInvarRoute, msg string, policy types2.HaltPolicy, singleBlock bool) {
+	k.Logger(ctx).Error("incremental invariant broken", "route", route.FullRoute(), "violation", msg, "single_block", singleBlock)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types2.EventTypeInvariantBroken,
+			sdk.NewAttribute(types2.AttributeKeyRoute, route.FullRoute()),
+			sdk.NewAttribute(types2.AttributeKeyViolation, msg),
+		),
+	)
+
+	if policy == types2.HaltPolicy_HALT_POLICY_HALT && singleBlock {
+		panic(fmt.Errorf("invariant broken: %s\n"+
+			"\tCRITICAL please submit the following transaction:\n"+
+			"\t\t tx crisis invariant-broken %s %s", msg, route.ModuleName, route.Route))
+	}
+}
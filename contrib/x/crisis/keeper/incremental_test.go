@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/contrib/x/crisis"
+	"github.com/cosmos/cosmos-sdk/contrib/x/crisis/keeper"
+	crisistestutil "github.com/cosmos/cosmos-sdk/contrib/x/crisis/testutil"
+	"github.com/cosmos/cosmos-sdk/contrib/x/crisis/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// shardedInvariant is an incremental invariant with a fixed number of shards,
+// each consuming 100 gas. Its state lists the shards checked in the pass.
+type shardedInvariant struct {
+	shards  byte
+	broken  []byte
+	checked *[]byte
+}
+
+func (inv shardedInvariant) CheckShard(ctx sdk.Context, cursor, state []byte) (types.ShardResult, error) {
+	var shard byte
+	if len(cursor) > 0 {
+		shard = cursor[0]
+	}
+	ctx.GasMeter().ConsumeGas(100, "shard")
+	*inv.checked = append(*inv.checked, shard)
+
+	res := types.ShardResult{
+		Next:  []byte{shard + 1},
+		State: append(append([]byte{}, state...), shard),
+		Done:  shard+1 == inv.shards,
+	}
+	if slices.Contains(inv.broken, shard) {
+		res.Broken = true
+		res.Msg = "broken shard"
+	}
+	return res, nil
+}
+
+func TestCheckIncrementalInvariants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
+	k := keeper.NewKeeper(encCfg.Codec, storeService, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+
+	var checkedA, checkedB []byte
+	k.RegisterIncrementalRoute("testModule", "a", shardedInvariant{shards: 3, checked: &checkedA})
+	k.RegisterIncrementalRoute("testModule", "b", shardedInvariant{shards: 1, broken: []byte{0}, checked: &checkedB})
+
+	// disabled by default
+	ctx := testCtx.Ctx.WithBlockHeight(1)
+	require.NoError(t, k.CheckIncrementalInvariants(ctx))
+	require.Empty(t, checkedA)
+
+	require.NoError(t, k.IncrementalParams.Set(ctx, types.IncrementalParams{GasBudget: 150, HaltPolicy: types.HaltPolicy_HALT_POLICY_EVENT}))
+
+	// the budget allows two shards per block
+	require.NoError(t, k.CheckIncrementalInvariants(ctx))
+	require.Equal(t, []byte{0, 1}, checkedA)
+	require.Empty(t, checkedB)
+
+	progress, err := k.InvariantProgress.Get(ctx, "testModule/a")
+	require.NoError(t, err)
+	require.Equal(t, types.InvariantProgress{Cursor: []byte{2}, State: []byte{0, 1}}, progress)
+
+	// the pass of a completes and b is checked within the same block
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.CheckIncrementalInvariants(ctx))
+	require.Equal(t, []byte{0, 1, 2}, checkedA)
+	require.Equal(t, []byte{0}, checkedB)
+
+	progress, err = k.InvariantProgress.Get(ctx, "testModule/a")
+	require.NoError(t, err)
+	require.Equal(t, types.InvariantProgress{Passes: 1, LastPassHeight: 2}, progress)
+
+	progress, err = k.InvariantProgress.Get(ctx, "testModule/b")
+	require.NoError(t, err)
+	require.Equal(t, types.InvariantProgress{Passes: 1, LastPassHeight: 2, LastViolation: "broken shard", LastViolationHeight: 2}, progress)
+
+	require.Equal(t, sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeInvariantBroken,
+			sdk.NewAttribute(types.AttributeKeyRoute, "testModule/b"),
+			sdk.NewAttribute(types.AttributeKeyViolation, "broken shard"),
+		),
+	}, ctx.EventManager().Events())
+
+	// a starts a new pass in the next block
+	ctx = ctx.WithBlockHeight(3)
+	require.NoError(t, k.CheckIncrementalInvariants(ctx))
+	require.Equal(t, []byte{0, 1, 2, 0, 1}, checkedA)
+
+	// the halt policy halts the chain on violations
+	require.NoError(t, k.IncrementalParams.Set(ctx, types.IncrementalParams{GasBudget: 1000, HaltPolicy: types.HaltPolicy_HALT_POLICY_HALT}))
+	require.Panics(t, func() { _ = k.CheckIncrementalInvariants(ctx.WithBlockHeight(4)) })
+}
+
+func TestCheckIncrementalInvariantsHaltPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	supplyKeeper := crisistestutil.NewMockSupplyKeeper(ctrl)
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(crisis.AppModuleBasic{})
+	k := keeper.NewKeeper(encCfg.Codec, storeService, 5, supplyKeeper, "", "", addresscodec.NewBech32Codec("cosmos"))
+
+	var checked []byte
+	k.RegisterIncrementalRoute("testModule", "a", shardedInvariant{shards: 3, broken: []byte{2}, checked: &checked})
+
+	ctx := testCtx.Ctx.WithBlockHeight(1)
+	require.NoError(t, k.IncrementalParams.Set(ctx, types.IncrementalParams{GasBudget: 150, HaltPolicy: types.HaltPolicy_HALT_POLICY_HALT}))
+	require.NoError(t, k.CheckIncrementalInvariants(ctx))
+	require.Equal(t, []byte{0, 1}, checked)
+
+	// the violation is found by a pass spanning two blocks, it may be transient
+	// and is only reported
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { require.NoError(t, k.CheckIncrementalInvariants(ctx)) })
+	require.Equal(t, []byte{0, 1, 2}, checked)
+	require.Len(t, ctx.EventManager().Events(), 1)
+
+	progress, err := k.InvariantProgress.Get(ctx, "testModule/a")
+	require.NoError(t, err)
+	require.Equal(t, types.InvariantProgress{Passes: 1, LastPassHeight: 2, LastViolation: "broken shard", LastViolationHeight: 2}, progress)
+
+	// the violation is found by a pass within a single block
+	require.NoError(t, k.IncrementalParams.Set(ctx, types.IncrementalParams{GasBudget: 1000, HaltPolicy: types.HaltPolicy_HALT_POLICY_HALT}))
+	require.Panics(t, func() { _ = k.CheckIncrementalInvariants(ctx.WithBlockHeight(3)) })
+}
@@ -20,6 +20,7 @@ import (
 // Deprecated: the crisis keeper is deprecated and will be removed in the next Cosmos SDK major release.
 type Keeper struct {
 	routes         []types2.InvarRoute
+	incrRoutes     []types2.IncrementalInvarRoute
 	invCheckPeriod uint
 	storeService   storetypes.KVStoreService
 	cdc            codec.BinaryCodec
@@ -36,6 +37,14 @@ type Keeper struct {
 
 	Schema      collections.Schema
 	ConstantFee collections.Item[sdk.Coin]
+	// IncrementalParams are the parameters of the incremental invariant checks
+	IncrementalParams collections.Item[types2.IncrementalParams]
+	// InvariantProgress contains the progress of each incremental invariant
+	// keyed by its full route
+	InvariantProgress collections.Map[string, types2.InvariantProgress]
+	// NextIncrementalInvariant is the index of the incremental invariant to
+	// check first in the next block
+	NextIncrementalInvariant collections.Item[uint64]
 }
 
 // NewKeeper creates a new Keeper object
@@ -56,7 +65,10 @@ func NewKeeper(
 		authority:        authority,
 		addressCodec:     ac,
 
-		ConstantFee: collections.NewItem(sb, types2.ConstantFeeKey, "constant_fee", codec.CollValue[sdk.Coin](cdc)),
+		ConstantFee:              collections.NewItem(sb, types2.ConstantFeeKey, "constant_fee", codec.CollValue[sdk.Coin](cdc)),
+		IncrementalParams:        collections.NewItem(sb, types2.IncrementalParamsKey, "incremental_params", codec.CollValue[types2.IncrementalParams](cdc)),
+		InvariantProgress:        collections.NewMap(sb, types2.InvariantProgressPrefix, "invariant_progress", collections.StringKey, codec.CollValue[types2.InvariantProgress](cdc)),
+		NextIncrementalInvariant: collections.NewItem(sb, types2.NextIncrementalInvariantKey, "next_incremental_invariant", collections.Uint64Value),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -89,9 +89,17 @@ func (k *Keeper) UpdateParams(ctx context.Context, msg *types2.MsgUpdateParams)
 		return nil, errors.Wrap(sdkerrors.ErrInvalidCoins, "negative constant fee")
 	}
 
+	if err := msg.IncrementalParams.Validate(); err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	if err := k.ConstantFee.Set(ctx, msg.ConstantFee); err != nil {
 		return nil, err
 	}
 
+	if err := k.IncrementalParams.Set(ctx, msg.IncrementalParams); err != nil {
+		return nil, err
+	}
+
 	return &types2.MsgUpdateParamsResponse{}, nil
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	modulev1 "github.com/cosmos/cosmos-sdk/contrib/api/cosmos/crisis/module/v1"
+	"github.com/cosmos/cosmos-sdk/contrib/x/crisis/invariants"
 	"github.com/cosmos/cosmos-sdk/contrib/x/crisis/keeper"
 	"github.com/cosmos/cosmos-sdk/contrib/x/crisis/types"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -25,7 +26,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // ConsensusVersion defines the current x/crisis module consensus version.
@@ -156,11 +159,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
-// EndBlock returns the end blocker for the crisis module. It returns no validator
-// updates.
+// EndBlock returns the end blocker for the crisis module. It runs the invariants
+// every InvCheckPeriod blocks and checks the next shards of the incremental
+// invariants. It returns no validator updates.
 func (am AppModule) EndBlock(ctx context.Context) error {
 	EndBlocker(ctx, *am.keeper)
-	return nil
+	return am.keeper.CheckIncrementalInvariants(sdk.UnwrapSDKContext(ctx))
 }
 
 // App Wiring Setup
@@ -180,8 +184,9 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AppOpts      servertypes.AppOptions `optional:"true"`
 
-	BankKeeper   types.SupplyKeeper
-	AddressCodec address.Codec
+	BankKeeper    types.SupplyKeeper
+	StakingKeeper invariants.StakingKeeper `optional:"true"`
+	AddressCodec  address.Codec
 }
 
 type ModuleOutputs struct {
@@ -218,6 +223,15 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AddressCodec,
 	)
 
+	// register the reference incremental invariants, which only run once a gas
+	// budget is set in the incremental params
+	if bk, ok := in.BankKeeper.(invariants.BankKeeper); ok {
+		k.RegisterIncrementalRoute(banktypes.ModuleName, "total-supply", invariants.NewTotalSupplyInvariant(bk))
+		if in.StakingKeeper != nil {
+			k.RegisterIncrementalRoute(stakingtypes.ModuleName, "module-accounts", invariants.NewModuleAccountsInvariant(in.StakingKeeper, bk))
+		}
+	}
+
 	var skipGenesisInvariants bool
 	if in.AppOpts != nil {
 		skipGenesisInvariants = cast.ToBool(in.AppOpts.Get(FlagSkipGenesisInvariants))
@@ -2,7 +2,9 @@ package types
 
 // crisis module event types
 const (
-	EventTypeInvariant = "invariant"
+	EventTypeInvariant       = "invariant"
+	EventTypeInvariantBroken = "invariant_broken"
 
-	AttributeKeyRoute = "route"
+	AttributeKeyRoute     = "route"
+	AttributeKeyViolation = "violation"
 )
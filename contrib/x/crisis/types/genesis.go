@@ -11,14 +11,16 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(constantFee sdk.Coin) *GenesisState {
 	return &GenesisState{
-		ConstantFee: constantFee,
+		ConstantFee:       constantFee,
+		IncrementalParams: DefaultIncrementalParams(),
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		ConstantFee: sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)),
+		ConstantFee:       sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)),
+		IncrementalParams: DefaultIncrementalParams(),
 	}
 }
 
@@ -30,5 +32,5 @@ func ValidateGenesis(data *GenesisState) error {
 	if !data.ConstantFee.IsPositive() {
 		return fmt.Errorf("constant fee must be positive: %s", data.ConstantFee)
 	}
-	return nil
+	return data.IncrementalParams.Validate()
 }
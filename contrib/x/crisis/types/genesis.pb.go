@@ -5,14 +5,13 @@ package types
 
 import (
 	fmt "fmt"
-	io "io"
-	math "math"
-	math_bits "math/bits"
-
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -26,11 +25,48 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// HaltPolicy defines how the chain reacts to a broken incremental invariant.
+type HaltPolicy int32
+
+const (
+	// HALT_POLICY_UNSPECIFIED defaults to HALT_POLICY_EVENT.
+	HaltPolicy_HALT_POLICY_UNSPECIFIED HaltPolicy = 0
+	// HALT_POLICY_EVENT reports the violation through an event and keeps the
+	// chain running.
+	HaltPolicy_HALT_POLICY_EVENT HaltPolicy = 1
+	// HALT_POLICY_HALT reports the violation through an event and halts the chain
+	// if the pass finding it started in the same block. The violations found by
+	// a pass spanning several blocks can be transient and never halt the chain.
+	HaltPolicy_HALT_POLICY_HALT HaltPolicy = 2
+)
+
+var HaltPolicy_name = map[int32]string{
+	0: "HALT_POLICY_UNSPECIFIED",
+	1: "HALT_POLICY_EVENT",
+	2: "HALT_POLICY_HALT",
+}
+
+var HaltPolicy_value = map[string]int32{
+	"HALT_POLICY_UNSPECIFIED": 0,
+	"HALT_POLICY_EVENT":       1,
+	"HALT_POLICY_HALT":        2,
+}
+
+func (x HaltPolicy) String() string {
+	return proto.EnumName(HaltPolicy_name, int32(x))
+}
+
+func (HaltPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7a9c2781aa8a27ae, []int{0}
+}
+
 // GenesisState defines the crisis module's genesis state.
 type GenesisState struct {
 	// constant_fee is the fee used to verify the invariant in the crisis
 	// module.
 	ConstantFee types.Coin `protobuf:"bytes,3,opt,name=constant_fee,json=constantFee,proto3" json:"constant_fee"`
+	// incremental_params are the parameters of the incremental invariant checks.
+	IncrementalParams IncrementalParams `protobuf:"bytes,4,opt,name=incremental_params,json=incrementalParams,proto3" json:"incremental_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -73,8 +109,168 @@ func (m *GenesisState) GetConstantFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *GenesisState) GetIncrementalParams() IncrementalParams {
+	if m != nil {
+		return m.IncrementalParams
+	}
+	return IncrementalParams{}
+}
+
+// IncrementalParams defines the parameters of the incremental invariant checks.
+type IncrementalParams struct {
+	// gas_budget is the gas that incremental invariants can consume per block.
+	// The budget is checked between shards, so a block can exceed it by the gas
+	// of a single shard. Zero disables incremental invariant checks.
+	GasBudget uint64 `protobuf:"varint,1,opt,name=gas_budget,json=gasBudget,proto3" json:"gas_budget,omitempty"`
+	// halt_policy defines how the chain reacts to a broken incremental invariant.
+	HaltPolicy HaltPolicy `protobuf:"varint,2,opt,name=halt_policy,json=haltPolicy,proto3,enum=cosmos.crisis.v1beta1.HaltPolicy" json:"halt_policy,omitempty"`
+}
+
+func (m *IncrementalParams) Reset()         { *m = IncrementalParams{} }
+func (m *IncrementalParams) String() string { return proto.CompactTextString(m) }
+func (*IncrementalParams) ProtoMessage()    {}
+func (*IncrementalParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9c2781aa8a27ae, []int{1}
+}
+func (m *IncrementalParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncrementalParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncrementalParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncrementalParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncrementalParams.Merge(m, src)
+}
+func (m *IncrementalParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncrementalParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncrementalParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncrementalParams proto.InternalMessageInfo
+
+func (m *IncrementalParams) GetGasBudget() uint64 {
+	if m != nil {
+		return m.GasBudget
+	}
+	return 0
+}
+
+func (m *IncrementalParams) GetHaltPolicy() HaltPolicy {
+	if m != nil {
+		return m.HaltPolicy
+	}
+	return HaltPolicy_HALT_POLICY_UNSPECIFIED
+}
+
+// InvariantProgress is the progress of an incremental invariant.
+type InvariantProgress struct {
+	// cursor is the cursor of the next shard to check. It is empty at the start
+	// of a pass.
+	Cursor []byte `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// passes is the number of completed passes over the whole keyspace.
+	Passes uint64 `protobuf:"varint,2,opt,name=passes,proto3" json:"passes,omitempty"`
+	// last_pass_height is the height at which the last pass completed.
+	LastPassHeight int64 `protobuf:"varint,3,opt,name=last_pass_height,json=lastPassHeight,proto3" json:"last_pass_height,omitempty"`
+	// last_violation describes the last violation of the invariant, if any.
+	LastViolation string `protobuf:"bytes,4,opt,name=last_violation,json=lastViolation,proto3" json:"last_violation,omitempty"`
+	// last_violation_height is the height at which the last violation was found.
+	LastViolationHeight int64 `protobuf:"varint,5,opt,name=last_violation_height,json=lastViolationHeight,proto3" json:"last_violation_height,omitempty"`
+	// state is the state carried by the invariant from one shard to the next,
+	// such as partial sums. It is empty at the start of a pass.
+	State []byte `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (m *InvariantProgress) Reset()         { *m = InvariantProgress{} }
+func (m *InvariantProgress) String() string { return proto.CompactTextString(m) }
+func (*InvariantProgress) ProtoMessage()    {}
+func (*InvariantProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a9c2781aa8a27ae, []int{2}
+}
+func (m *InvariantProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantProgress.Merge(m, src)
+}
+func (m *InvariantProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantProgress proto.InternalMessageInfo
+
+func (m *InvariantProgress) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *InvariantProgress) GetPasses() uint64 {
+	if m != nil {
+		return m.Passes
+	}
+	return 0
+}
+
+func (m *InvariantProgress) GetLastPassHeight() int64 {
+	if m != nil {
+		return m.LastPassHeight
+	}
+	return 0
+}
+
+func (m *InvariantProgress) GetLastViolation() string {
+	if m != nil {
+		return m.LastViolation
+	}
+	return ""
+}
+
+func (m *InvariantProgress) GetLastViolationHeight() int64 {
+	if m != nil {
+		return m.LastViolationHeight
+	}
+	return 0
+}
+
+func (m *InvariantProgress) GetState() []byte {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.crisis.v1beta1.HaltPolicy", HaltPolicy_name, HaltPolicy_value)
 	proto.RegisterType((*GenesisState)(nil), "cosmos.crisis.v1beta1.GenesisState")
+	proto.RegisterType((*IncrementalParams)(nil), "cosmos.crisis.v1beta1.IncrementalParams")
+	proto.RegisterType((*InvariantProgress)(nil), "cosmos.crisis.v1beta1.InvariantProgress")
 }
 
 func init() {
@@ -82,23 +278,40 @@ func init() {
 }
 
 var fileDescriptor_7a9c2781aa8a27ae = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xca, 0x2c, 0xce, 0x2c, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x85, 0x28, 0xd2, 0x83, 0x28, 0xd2, 0x83, 0x2a, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0xe4, 0xa0, 0x26, 0x26, 0x25, 0x16, 0xa7, 0xc2, 0xcd,
-	0x4b, 0xce, 0xcf, 0xcc, 0x83, 0xca, 0x0b, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88,
-	0x90, 0x52, 0x38, 0x17, 0x8f, 0x3b, 0xc4, 0xc2, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x77, 0x2e,
-	0x9e, 0xe4, 0xfc, 0xbc, 0xe2, 0x92, 0xc4, 0xbc, 0x92, 0xf8, 0xb4, 0xd4, 0x54, 0x09, 0x66, 0x05,
-	0x46, 0x0d, 0x6e, 0x23, 0x49, 0x3d, 0xa8, 0x33, 0x40, 0x26, 0xc3, 0x1c, 0xa1, 0xe7, 0x9c, 0x9f,
-	0x99, 0xe7, 0xc4, 0x79, 0xe2, 0x9e, 0x3c, 0xc3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xb8, 0x61,
-	0x3a, 0xdd, 0x52, 0x53, 0x9d, 0x5c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
-	0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21,
-	0x4a, 0x3b, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x16, 0x04, 0x60,
-	0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0x02, 0x16, 0x1e, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c,
-	0x60, 0x67, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x98, 0x45, 0xfd, 0x02, 0x2d, 0x01, 0x00,
-	0x00,
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xdf, 0x6e, 0xd3, 0x3e,
+	0x14, 0xc7, 0xeb, 0xad, 0xab, 0x54, 0xb7, 0xbf, 0xa9, 0xf5, 0xaf, 0x85, 0x32, 0x44, 0x28, 0x45,
+	0x48, 0xd5, 0x10, 0x89, 0x56, 0x9e, 0x80, 0x96, 0x6e, 0xad, 0x34, 0x8d, 0x28, 0x1b, 0x95, 0xe0,
+	0x26, 0x72, 0x32, 0x93, 0x5a, 0x24, 0x76, 0x94, 0xe3, 0x56, 0xec, 0x2d, 0x78, 0x0c, 0x2e, 0xb9,
+	0xe7, 0x05, 0x76, 0xb9, 0x4b, 0xae, 0x26, 0xd4, 0x5e, 0xf0, 0x1a, 0x28, 0x4e, 0xb2, 0x75, 0xfc,
+	0xb9, 0x49, 0x7c, 0xbe, 0xe7, 0x73, 0xbe, 0xe7, 0xd8, 0x89, 0xf1, 0x53, 0x5f, 0x42, 0x24, 0xc1,
+	0xf2, 0x13, 0x0e, 0x1c, 0xac, 0xe5, 0x81, 0xc7, 0x14, 0x3d, 0xb0, 0x02, 0x26, 0x18, 0x70, 0x30,
+	0xe3, 0x44, 0x2a, 0x49, 0xda, 0x19, 0x64, 0x66, 0x90, 0x99, 0x43, 0x7b, 0xad, 0x40, 0x06, 0x52,
+	0x13, 0x56, 0xba, 0xca, 0xe0, 0x3d, 0x23, 0x77, 0xf4, 0x28, 0xb0, 0x1b, 0x3f, 0x5f, 0x72, 0x91,
+	0xe7, 0x9b, 0x34, 0xe2, 0x42, 0x5a, 0xfa, 0x99, 0x49, 0xbd, 0x6f, 0x08, 0xd7, 0x8f, 0xb2, 0x8e,
+	0xa7, 0x8a, 0x2a, 0x46, 0x8e, 0x70, 0xdd, 0x97, 0x02, 0x14, 0x15, 0xca, 0xfd, 0xc0, 0x58, 0x67,
+	0xbb, 0x8b, 0xfa, 0xb5, 0xc1, 0x03, 0x33, 0x9f, 0x23, 0xb5, 0x2e, 0xa6, 0x30, 0x47, 0x92, 0x8b,
+	0x61, 0xf5, 0xf2, 0xfa, 0x71, 0xe9, 0xcb, 0xcf, 0xaf, 0xfb, 0xc8, 0xa9, 0x15, 0x95, 0x87, 0x8c,
+	0x11, 0x0f, 0x13, 0x2e, 0xfc, 0x84, 0x45, 0x4c, 0x28, 0x1a, 0xba, 0x31, 0x4d, 0x68, 0x04, 0x9d,
+	0xb2, 0xb6, 0xeb, 0x9b, 0x7f, 0xdd, 0x96, 0x39, 0xbd, 0x2d, 0xb0, 0x35, 0xbf, 0xe9, 0xde, 0xe4,
+	0xbf, 0x67, 0x7b, 0x4b, 0xdc, 0xfc, 0xa3, 0x84, 0x3c, 0xc2, 0x38, 0xa0, 0xe0, 0x7a, 0x8b, 0xf3,
+	0x80, 0xa9, 0x0e, 0xea, 0xa2, 0x7e, 0xd9, 0xa9, 0x06, 0x14, 0x86, 0x5a, 0x20, 0x43, 0x5c, 0x9b,
+	0xd3, 0x50, 0xb9, 0xb1, 0x0c, 0xb9, 0x7f, 0xd1, 0xd9, 0xea, 0xa2, 0xfe, 0xee, 0xe0, 0xc9, 0x3f,
+	0x06, 0x9a, 0xd0, 0x50, 0xd9, 0x1a, 0x74, 0xf0, 0xfc, 0x66, 0xdd, 0xbb, 0x46, 0x69, 0xe3, 0x25,
+	0x4d, 0x38, 0x15, 0xca, 0x4e, 0x64, 0x90, 0x30, 0x00, 0x72, 0x0f, 0x57, 0xfc, 0x45, 0x02, 0x32,
+	0xd1, 0x4d, 0xeb, 0x4e, 0x1e, 0xa5, 0x7a, 0x4c, 0x01, 0x18, 0xe8, 0x66, 0x65, 0x27, 0x8f, 0x48,
+	0x1f, 0x37, 0x42, 0x0a, 0xca, 0x4d, 0x43, 0x77, 0xce, 0x78, 0x30, 0x57, 0xfa, 0xb8, 0xb7, 0x9d,
+	0xdd, 0x54, 0xb7, 0x29, 0xc0, 0x44, 0xab, 0xe4, 0x19, 0xd6, 0x8a, 0xbb, 0xe4, 0x32, 0xa4, 0x8a,
+	0x4b, 0xa1, 0xcf, 0xb1, 0xea, 0xfc, 0x97, 0xaa, 0xb3, 0x42, 0x24, 0x03, 0xdc, 0xbe, 0x8b, 0x15,
+	0xae, 0x3b, 0xda, 0xf5, 0xff, 0x3b, 0x74, 0x6e, 0xdd, 0xc2, 0x3b, 0x90, 0x7e, 0xf8, 0x4e, 0x45,
+	0xcf, 0x9c, 0x05, 0xfb, 0x33, 0x8c, 0x6f, 0xb7, 0x4e, 0x1e, 0xe2, 0xfb, 0x93, 0x57, 0xc7, 0x67,
+	0xae, 0xfd, 0xe6, 0x78, 0x3a, 0x7a, 0xe7, 0xbe, 0x3d, 0x39, 0xb5, 0xc7, 0xa3, 0xe9, 0xe1, 0x74,
+	0xfc, 0xba, 0x51, 0x22, 0x6d, 0xdc, 0xdc, 0x4c, 0x8e, 0x67, 0xe3, 0x93, 0xb3, 0x06, 0x22, 0x2d,
+	0xdc, 0xd8, 0x94, 0xd3, 0x75, 0x63, 0x6b, 0x38, 0xbe, 0x5c, 0x19, 0xe8, 0x6a, 0x65, 0xa0, 0x1f,
+	0x2b, 0x03, 0x7d, 0x5e, 0x1b, 0xa5, 0xab, 0xb5, 0x51, 0xfa, 0xbe, 0x36, 0x4a, 0xef, 0x9f, 0x07,
+	0x5c, 0xcd, 0x17, 0x9e, 0xe9, 0xcb, 0xc8, 0x2a, 0x2e, 0x86, 0x7e, 0xbd, 0x80, 0xf3, 0x8f, 0xd6,
+	0xa7, 0xe2, 0x96, 0xa8, 0x8b, 0x98, 0x81, 0x57, 0xd1, 0x3f, 0xef, 0xcb, 0x5f, 0x03, 0x00, 0x3f,
+	0x8d, 0x25, 0xc5, 0x43, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -121,6 +334,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.IncrementalParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size, err := m.ConstantFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -134,6 +357,98 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IncrementalParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncrementalParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncrementalParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HaltPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HaltPolicy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GasBudget != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasBudget))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastViolationHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastViolationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.LastViolation) > 0 {
+		i -= len(m.LastViolation)
+		copy(dAtA[i:], m.LastViolation)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LastViolation)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastPassHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastPassHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Passes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Passes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -153,6 +468,53 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.ConstantFee.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.IncrementalParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *IncrementalParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasBudget != 0 {
+		n += 1 + sovGenesis(uint64(m.GasBudget))
+	}
+	if m.HaltPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.HaltPolicy))
+	}
+	return n
+}
+
+func (m *InvariantProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Passes != 0 {
+		n += 1 + sovGenesis(uint64(m.Passes))
+	}
+	if m.LastPassHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastPassHeight))
+	}
+	l = len(m.LastViolation)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.LastViolationHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastViolationHeight))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -224,6 +586,334 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncrementalParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncrementalParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncrementalParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncrementalParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncrementalParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasBudget", wireType)
+			}
+			m.GasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltPolicy", wireType)
+			}
+			m.HaltPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltPolicy |= HaltPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvariantProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passes", wireType)
+			}
+			m.Passes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Passes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPassHeight", wireType)
+			}
+			m.LastPassHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPassHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastViolation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastViolation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastViolationHeight", wireType)
+			}
+			m.LastViolationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastViolationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = append(m.State[:0], dAtA[iNdEx:postIndex]...)
+			if m.State == nil {
+				m.State = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
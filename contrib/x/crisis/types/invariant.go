@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IncrementalInvariant is an invariant checked over several blocks, one shard
+// of its keyspace at a time, instead of all at once.
+//
+// Each shard reads a bounded part of the keyspace. Aggregates spanning several
+// shards, such as partial sums, are carried from one shard to the next in the
+// state of the pass, which is stored along with the cursor. Such aggregates
+// may mix the state of different blocks, so invariants comparing them across
+// blocks can report transient violations on a chain with activity.
+type IncrementalInvariant interface {
+	// CheckShard checks the shard starting at cursor, or the first shard if
+	// cursor is empty, given the state returned by the previous shard of the
+	// pass. It must not write to the store.
+	CheckShard(ctx sdk.Context, cursor, state []byte) (ShardResult, error)
+}
+
+// ShardResult is the result of checking a shard of an incremental invariant.
+type ShardResult struct {
+	// Next is the cursor of the next shard. It is ignored if Done is set.
+	Next []byte
+	// State is the state passed to the next shard. It is ignored if Done is
+	// set.
+	State []byte
+	// Done reports that the checked shard was the last one of the keyspace.
+	Done bool
+	// Broken reports that the invariant is broken in the checked shard.
+	Broken bool
+	// Msg describes the violation if Broken is set.
+	Msg string
+}
+
+// DefaultIncrementalParams returns the default parameters of the incremental
+// invariant checks, which are disabled.
+func DefaultIncrementalParams() IncrementalParams {
+	return IncrementalParams{
+		GasBudget:  0,
+		HaltPolicy: HaltPolicy_HALT_POLICY_EVENT,
+	}
+}
+
+// Validate validates the parameters of the incremental invariant checks.
+func (p IncrementalParams) Validate() error {
+	if _, ok := HaltPolicy_name[int32(p.HaltPolicy)]; !ok {
+		return fmt.Errorf("invalid halt policy: %d", p.HaltPolicy)
+	}
+	return nil
+}
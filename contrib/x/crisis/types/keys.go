@@ -9,4 +9,9 @@ const (
 	StoreKey = ModuleName
 )
 
-var ConstantFeeKey = collections.NewPrefix(1)
+var (
+	ConstantFeeKey              = collections.NewPrefix(1)
+	IncrementalParamsKey        = collections.NewPrefix(2)
+	InvariantProgressPrefix     = collections.NewPrefix(3)
+	NextIncrementalInvariantKey = collections.NewPrefix(4)
+)
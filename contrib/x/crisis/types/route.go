@@ -24,3 +24,24 @@ func NewInvarRoute(moduleName, route string, invar sdk.Invariant) InvarRoute {
 func (i InvarRoute) FullRoute() string {
 	return i.ModuleName + "/" + i.Route
 }
+
+// IncrementalInvarRoute is the route of an incremental invariant.
+type IncrementalInvarRoute struct {
+	ModuleName string
+	Route      string
+	Invar      IncrementalInvariant
+}
+
+// NewIncrementalInvarRoute creates an IncrementalInvarRoute object.
+func NewIncrementalInvarRoute(moduleName, route string, invar IncrementalInvariant) IncrementalInvarRoute {
+	return IncrementalInvarRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invar:      invar,
+	}
+}
+
+// FullRoute returns the full route of the incremental invariant.
+func (i IncrementalInvarRoute) FullRoute() string {
+	return i.ModuleName + "/" + i.Route
+}
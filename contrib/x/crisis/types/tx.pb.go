@@ -6,10 +6,6 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	io "io"
-	math "math"
-	math_bits "math/bits"
-
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -20,6 +16,9 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -119,6 +118,9 @@ type MsgUpdateParams struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// constant_fee defines the x/crisis parameter.
 	ConstantFee types.Coin `protobuf:"bytes,2,opt,name=constant_fee,json=constantFee,proto3" json:"constant_fee"`
+	// incremental_params defines the parameters of the incremental invariant
+	// checks.
+	IncrementalParams IncrementalParams `protobuf:"bytes,3,opt,name=incremental_params,json=incrementalParams,proto3" json:"incremental_params"`
 }
 
 func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
@@ -168,6 +170,13 @@ func (m *MsgUpdateParams) GetConstantFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgUpdateParams) GetIncrementalParams() IncrementalParams {
+	if m != nil {
+		return m.IncrementalParams
+	}
+	return IncrementalParams{}
+}
+
 // MsgUpdateParamsResponse defines the response structure for executing a
 // MsgUpdateParams message.
 type MsgUpdateParamsResponse struct {
@@ -216,41 +225,43 @@ func init() {
 func init() { proto.RegisterFile("cosmos/crisis/v1beta1/tx.proto", fileDescriptor_61276163172fe867) }
 
 var fileDescriptor_61276163172fe867 = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x77, 0x2d, 0x16, 0x32, 0x2d, 0x06, 0x37, 0x2d, 0x4d, 0x17, 0xdd, 0x94, 0x08, 0xfe,
-	0x88, 0x64, 0xb6, 0x89, 0xbf, 0x20, 0x37, 0x23, 0x2a, 0x3d, 0x44, 0x24, 0xa2, 0x07, 0x2f, 0x61,
-	0xb2, 0x3b, 0xdd, 0x0e, 0xba, 0x33, 0x61, 0xde, 0x24, 0x34, 0x78, 0x11, 0x4f, 0xe2, 0xc9, 0x3f,
-	0xa1, 0x47, 0x8f, 0x39, 0xf4, 0x8f, 0x28, 0x9e, 0x4a, 0x4f, 0x22, 0x28, 0x92, 0x1c, 0xe2, 0xdf,
-	0xe0, 0x49, 0x76, 0x77, 0x36, 0xa9, 0x49, 0xa4, 0xbd, 0x24, 0xcb, 0xfb, 0x7e, 0xdf, 0xbc, 0xf7,
-	0x99, 0xf7, 0x06, 0x39, 0x9e, 0x80, 0x50, 0x80, 0xeb, 0x49, 0x06, 0x0c, 0xdc, 0x5e, 0xa5, 0x4d,
-	0x15, 0xa9, 0xb8, 0x6a, 0x1f, 0x77, 0xa4, 0x50, 0xc2, 0x5a, 0x4f, 0x74, 0x9c, 0xe8, 0x58, 0xeb,
-	0xf6, 0x5a, 0x20, 0x02, 0x11, 0x3b, 0xdc, 0xe8, 0x2b, 0x31, 0xdb, 0x9b, 0x89, 0xb9, 0x95, 0x08,
-	0x3a, 0x33, 0x91, 0x36, 0x74, 0x9d, 0x10, 0x02, 0xb7, 0x57, 0x89, 0xfe, 0xb4, 0x70, 0x99, 0x84,
-	0x8c, 0x0b, 0x37, 0xfe, 0xd5, 0xa1, 0xb4, 0xa7, 0x36, 0x01, 0x3a, 0xe9, 0xc8, 0x13, 0x8c, 0x27,
-	0x7a, 0xf1, 0xbb, 0x89, 0xac, 0x06, 0x04, 0xaf, 0xa8, 0x64, 0xbb, 0xfd, 0x1d, 0xde, 0x23, 0x92,
-	0x11, 0xae, 0xac, 0x6d, 0xb4, 0x0c, 0x94, 0xfb, 0x54, 0xe6, 0xcd, 0x2d, 0xf3, 0x66, 0xa6, 0x9e,
-	0x3f, 0x39, 0x2c, 0xaf, 0xe9, 0x26, 0x1e, 0xfa, 0xbe, 0xa4, 0x00, 0x2f, 0x94, 0x64, 0x3c, 0x68,
-	0x6a, 0x9f, 0x55, 0x45, 0xeb, 0x2c, 0x4d, 0x6f, 0x85, 0xc2, 0xef, 0xbe, 0xa5, 0x2d, 0x4e, 0x42,
-	0x9a, 0xbf, 0x10, 0x1d, 0xd0, 0xcc, 0x4d, 0xc4, 0x46, 0xac, 0x3d, 0x23, 0x21, 0xb5, 0x6e, 0xa0,
-	0xec, 0x34, 0x47, 0x8a, 0xae, 0xa2, 0xf9, 0xa5, 0xd8, 0x7d, 0x69, 0x12, 0x6e, 0x46, 0xd1, 0xda,
-	0xbd, 0x8f, 0x07, 0x05, 0xe3, 0xf7, 0x41, 0xc1, 0xf8, 0x30, 0x1e, 0x94, 0x74, 0xc5, 0x4f, 0xe3,
-	0x41, 0xe9, 0x6a, 0xd2, 0x52, 0x19, 0xfc, 0x37, 0xee, 0x3c, 0x45, 0xf1, 0x0a, 0xb2, 0xe7, 0xa3,
-	0x4d, 0x0a, 0x1d, 0xc1, 0x81, 0x16, 0x7f, 0x98, 0x28, 0xdb, 0x80, 0xe0, 0x65, 0xc7, 0x27, 0x8a,
-	0x3e, 0x27, 0x92, 0x84, 0x60, 0xdd, 0x47, 0x19, 0xd2, 0x55, 0x7b, 0x42, 0x32, 0xd5, 0x3f, 0x13,
-	0x7d, 0x6a, 0xb5, 0x9e, 0xa2, 0x55, 0x4f, 0x70, 0x50, 0x11, 0xc8, 0x2e, 0x4d, 0xa0, 0x57, 0xaa,
-	0x9b, 0x58, 0xe7, 0x45, 0xb7, 0x9f, 0xce, 0x1b, 0x3f, 0x12, 0x8c, 0xd7, 0x33, 0x47, 0x3f, 0x0b,
-	0xc6, 0x97, 0xf1, 0xa0, 0x64, 0x36, 0x57, 0xd2, 0xcc, 0x27, 0x94, 0xd6, 0x76, 0x4e, 0x0e, 0xcb,
-	0xd9, 0x29, 0xd5, 0xd6, 0x36, 0xbe, 0xfb, 0x20, 0x82, 0x9e, 0xd6, 0x8a, 0xb8, 0xaf, 0x9d, 0xe2,
-	0xde, 0x4f, 0xf7, 0x6d, 0x86, 0xa5, 0x88, 0xd1, 0xc6, 0x4c, 0x28, 0x45, 0xaf, 0xe5, 0x16, 0x54,
-	0xa9, 0xfe, 0x31, 0xd1, 0x52, 0x03, 0x02, 0x4b, 0xa0, 0xec, 0xec, 0x3a, 0xdc, 0xc2, 0x0b, 0x57,
-	0x17, 0xcf, 0xdf, 0xae, 0x5d, 0x39, 0xb7, 0x35, 0xed, 0xc6, 0x7a, 0x87, 0x56, 0xff, 0x19, 0xc2,
-	0xf5, 0xff, 0x1f, 0x71, 0xda, 0x67, 0xe3, 0xf3, 0xf9, 0x26, 0x03, 0xcf, 0x7d, 0x9d, 0xa7, 0xb6,
-	0x2f, 0xbe, 0x8f, 0x86, 0x50, 0x7f, 0x7c, 0x34, 0x74, 0xcc, 0xe3, 0xa1, 0x63, 0xfe, 0x1a, 0x3a,
-	0xe6, 0xe7, 0x91, 0x63, 0x1c, 0x8f, 0x1c, 0xe3, 0xdb, 0xc8, 0x31, 0x5e, 0xdf, 0x0e, 0x98, 0xda,
-	0xeb, 0xb6, 0xb1, 0x27, 0x42, 0x37, 0x7d, 0xe0, 0x0b, 0x6e, 0x5f, 0xf5, 0x3b, 0x14, 0xda, 0xcb,
-	0xf1, 0xab, 0xba, 0xf3, 0x37, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x40, 0x65, 0xd0, 0x0b, 0x04, 0x00,
-	0x00,
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6f, 0x12, 0x41,
+	0x18, 0xdd, 0x6d, 0x63, 0x13, 0x86, 0x46, 0xd2, 0xa1, 0x4d, 0xe9, 0x46, 0x97, 0x86, 0x26, 0x5a,
+	0x31, 0xcc, 0x16, 0xfc, 0x95, 0x70, 0x13, 0xa3, 0x86, 0x03, 0xc6, 0x60, 0xf4, 0xe0, 0x85, 0x0c,
+	0xbb, 0xd3, 0xed, 0xc4, 0xee, 0x0c, 0x99, 0x19, 0x48, 0x89, 0x17, 0xe3, 0xc9, 0x78, 0xf2, 0x4f,
+	0xe8, 0xd1, 0x23, 0x87, 0xfe, 0x03, 0xde, 0x1a, 0x4f, 0x4d, 0x4f, 0xc6, 0x83, 0x31, 0x70, 0xc0,
+	0xbf, 0xc1, 0x93, 0xd9, 0x5f, 0x2c, 0x02, 0x4d, 0x7b, 0x81, 0xcd, 0xf7, 0xde, 0x37, 0xf3, 0xde,
+	0xfb, 0xbe, 0x01, 0xa6, 0xcd, 0xa5, 0xc7, 0xa5, 0x65, 0x0b, 0x2a, 0xa9, 0xb4, 0x7a, 0xe5, 0x36,
+	0x51, 0xb8, 0x6c, 0xa9, 0x23, 0xd4, 0x11, 0x5c, 0x71, 0xb8, 0x11, 0xe2, 0x28, 0xc4, 0x51, 0x84,
+	0x1b, 0xeb, 0x2e, 0x77, 0x79, 0xc0, 0xb0, 0xfc, 0xaf, 0x90, 0x6c, 0x6c, 0x85, 0xe4, 0x56, 0x08,
+	0x44, 0x9d, 0x21, 0xb4, 0x19, 0xdd, 0xe3, 0x49, 0xd7, 0xea, 0x95, 0xfd, 0xbf, 0x08, 0x58, 0xc3,
+	0x1e, 0x65, 0xdc, 0x0a, 0x7e, 0xa3, 0x52, 0xac, 0xa9, 0x8d, 0x25, 0x99, 0x28, 0xb2, 0x39, 0x65,
+	0x11, 0xbe, 0xb3, 0x58, 0xb3, 0x4b, 0x18, 0xf1, 0x35, 0x06, 0xa4, 0xc2, 0x4f, 0x1d, 0xc0, 0x86,
+	0x74, 0xdf, 0x10, 0x41, 0xf7, 0xfb, 0x75, 0xd6, 0xc3, 0x82, 0x62, 0xa6, 0xe0, 0x1e, 0x58, 0x91,
+	0x84, 0x39, 0x44, 0xe4, 0xf4, 0x6d, 0x7d, 0x37, 0x55, 0xcb, 0x9d, 0x9f, 0x94, 0xd6, 0x23, 0xa5,
+	0x8f, 0x1d, 0x47, 0x10, 0x29, 0x5f, 0x29, 0x41, 0x99, 0xdb, 0x8c, 0x78, 0xb0, 0x02, 0x36, 0x68,
+	0xdc, 0xde, 0xf2, 0xb8, 0xd3, 0x3d, 0x24, 0x2d, 0x86, 0x3d, 0x92, 0x5b, 0xf2, 0x0f, 0x68, 0x66,
+	0x27, 0x60, 0x23, 0xc0, 0x5e, 0x60, 0x8f, 0xc0, 0xdb, 0x20, 0x93, 0xf4, 0x08, 0xde, 0x55, 0x24,
+	0xb7, 0x1c, 0xb0, 0xaf, 0x4f, 0xca, 0x4d, 0xbf, 0x5a, 0x7d, 0xf0, 0xe9, 0x38, 0xaf, 0xfd, 0x39,
+	0xce, 0x6b, 0x1f, 0xc7, 0x83, 0x62, 0x74, 0xe3, 0xe7, 0xf1, 0xa0, 0x78, 0x33, 0x94, 0x54, 0x92,
+	0xce, 0x3b, 0x6b, 0xde, 0x45, 0xe1, 0x06, 0x30, 0xe6, 0xab, 0x4d, 0x22, 0x3b, 0x9c, 0x49, 0x52,
+	0xf8, 0xb6, 0x04, 0x32, 0x0d, 0xe9, 0xbe, 0xee, 0x38, 0x58, 0x91, 0x97, 0x58, 0x60, 0x4f, 0xc2,
+	0x87, 0x20, 0x85, 0xbb, 0xea, 0x80, 0x0b, 0xaa, 0xfa, 0x97, 0x5a, 0x4f, 0xa8, 0xf0, 0x39, 0x58,
+	0xb5, 0x39, 0x93, 0xca, 0x37, 0xb2, 0x4f, 0x42, 0xd3, 0xe9, 0xca, 0x16, 0x8a, 0xfa, 0xfc, 0x11,
+	0xc5, 0x4b, 0x81, 0x9e, 0x70, 0xca, 0x6a, 0xa9, 0xd3, 0x5f, 0x79, 0xed, 0xeb, 0x78, 0x50, 0xd4,
+	0x9b, 0xe9, 0xb8, 0xf3, 0x19, 0x21, 0xb0, 0x0d, 0x20, 0x65, 0xb6, 0x20, 0x1e, 0x61, 0x0a, 0x1f,
+	0xb6, 0x3a, 0x81, 0xac, 0x20, 0x95, 0x74, 0x65, 0x17, 0x2d, 0xdc, 0x32, 0x54, 0x4f, 0x1a, 0x42,
+	0x1b, 0xd3, 0xa7, 0xaf, 0xd1, 0x59, 0xb4, 0x5a, 0x3f, 0x3f, 0x29, 0x65, 0x92, 0xe4, 0xb6, 0xf7,
+	0xd0, 0xfd, 0x47, 0x7e, 0xb0, 0x89, 0x1f, 0x3f, 0xdb, 0x9d, 0xa9, 0x6c, 0x8f, 0xe2, 0x25, 0x9a,
+	0xc9, 0xab, 0x80, 0xc0, 0xe6, 0x4c, 0x29, 0x8e, 0xb7, 0x9a, 0x5d, 0x70, 0x4b, 0xe5, 0xaf, 0x0e,
+	0x96, 0x1b, 0xd2, 0x85, 0x1c, 0x64, 0x66, 0x57, 0xee, 0xce, 0x05, 0xee, 0xe6, 0x27, 0x68, 0x94,
+	0xaf, 0x4c, 0x8d, 0xd5, 0xc0, 0xf7, 0x60, 0xf5, 0xbf, 0x41, 0xdf, 0xba, 0xf8, 0x88, 0x69, 0x9e,
+	0x81, 0xae, 0xc6, 0x9b, 0x2c, 0x55, 0xf6, 0xfb, 0xbc, 0x6b, 0xe3, 0xda, 0x07, 0x7f, 0x14, 0xb5,
+	0xa7, 0xa7, 0x43, 0x53, 0x3f, 0x1b, 0x9a, 0xfa, 0xef, 0xa1, 0xa9, 0x7f, 0x19, 0x99, 0xda, 0xd9,
+	0xc8, 0xd4, 0x7e, 0x8c, 0x4c, 0xed, 0xed, 0x5d, 0x97, 0xaa, 0x83, 0x6e, 0x1b, 0xd9, 0xdc, 0xb3,
+	0xe2, 0x57, 0xbb, 0x20, 0x7d, 0xd5, 0xef, 0x10, 0xd9, 0x5e, 0x09, 0x5e, 0xee, 0xbd, 0x7f, 0x03,
+	0x00, 0x8b, 0x9d, 0x77, 0x25, 0x94, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -463,6 +474,16 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.IncrementalParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.ConstantFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -559,6 +580,8 @@ func (m *MsgUpdateParams) Size() (n int) {
 	}
 	l = m.ConstantFee.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = m.IncrementalParams.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -867,6 +890,39 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncrementalParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncrementalParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,74 @@
+package nft
+
+import (
+	"slices"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the class config has a valid owner, mint policy and royalty.
+func (c ClassConfig) Validate(ac address.Codec) error {
+	if len(c.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if _, err := ac.StringToBytes(c.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", c.Owner)
+	}
+
+	if _, ok := MintPolicy_name[int32(c.MintPolicy)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid mint policy %s", c.MintPolicy)
+	}
+
+	if len(c.Minters) > 0 && c.MintPolicy != MintPolicy_MINT_POLICY_ALLOWLIST {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "minters can only be set with the allowlist mint policy")
+	}
+
+	seen := make(map[string]bool, len(c.Minters))
+	for _, minter := range c.Minters {
+		if _, err := ac.StringToBytes(minter); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address (%s)", minter)
+		}
+		if seen[minter] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate minter %s", minter)
+		}
+		seen[minter] = true
+	}
+
+	if c.Royalty != nil {
+		return c.Royalty.Validate(ac)
+	}
+	return nil
+}
+
+// CanMint returns true if the mint policy of the class allows the given address to mint.
+func (c ClassConfig) CanMint(minter string) bool {
+	switch c.MintPolicy {
+	case MintPolicy_MINT_POLICY_OPEN:
+		return true
+	case MintPolicy_MINT_POLICY_ALLOWLIST:
+		return minter == c.Owner || slices.Contains(c.Minters, minter)
+	default:
+		return minter == c.Owner
+	}
+}
+
+// Validate checks that the royalty has a valid receiver and a rate in (0, 1].
+func (r RoyaltyInfo) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(r.Receiver); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRoyalty, "invalid receiver address (%s)", r.Receiver)
+	}
+
+	rate, err := math.LegacyNewDecFromStr(r.Rate)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidRoyalty, "invalid rate: %s", err)
+	}
+	if !rate.IsPositive() || rate.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidRoyalty, "rate must be greater than 0 and at most 1: %s", rate)
+	}
+	return nil
+}
@@ -21,6 +21,11 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 			}
 		}
 	}
+	for _, config := range data.ClassConfigs {
+		if err := config.Validate(ac); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -75,3 +75,46 @@ func (k Keeper) HasClass(ctx context.Context, classID string) bool {
 	}
 	return has
 }
+
+// SetClassConfig defines a method for saving the config of a class created with Msg/CreateClass
+func (k Keeper) SetClassConfig(ctx context.Context, config nft.ClassConfig) error {
+	if !k.HasClass(ctx, config.ClassId) {
+		return errors.Wrap(nft.ErrClassNotExists, config.ClassId)
+	}
+	bz, err := k.cdc.Marshal(&config)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.ClassConfig failed")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(classConfigStoreKey(config.ClassId), bz)
+}
+
+// GetClassConfig defines a method for returning the config of the specified class, if it was created with Msg/CreateClass
+func (k Keeper) GetClassConfig(ctx context.Context, classID string) (nft.ClassConfig, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	var config nft.ClassConfig
+
+	bz, err := store.Get(classConfigStoreKey(classID))
+	if err != nil {
+		return config, false
+	}
+
+	if len(bz) == 0 {
+		return config, false
+	}
+	k.cdc.MustUnmarshal(bz, &config)
+	return config, true
+}
+
+// GetClassConfigs defines a method for returning the configs of all classes created with Msg/CreateClass
+func (k Keeper) GetClassConfigs(ctx context.Context) (configs []*nft.ClassConfig) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ClassConfigKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var config nft.ClassConfig
+		k.cdc.MustUnmarshal(iterator.Value(), &config)
+		configs = append(configs, &config)
+	}
+	return configs
+}
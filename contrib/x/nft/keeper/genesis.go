@@ -27,6 +27,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			}
 		}
 	}
+	for _, config := range data.ClassConfigs {
+		if err := k.SetClassConfig(ctx, *config); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -63,7 +68,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:      classes,
+		Entries:      entries,
+		ClassConfigs: k.GetClassConfigs(ctx),
 	}
 }
@@ -201,3 +201,40 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// ClassConfig return the owner, mint policy and royalty of a class created with Msg/CreateClass
+func (k Keeper) ClassConfig(goCtx context.Context, r *nft.QueryClassConfigRequest) (*nft.QueryClassConfigResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	config, has := k.GetClassConfig(ctx, r.ClassId)
+	if !has {
+		return nil, nft.ErrNoClassConfig.Wrapf("not found class config: %s", r.ClassId)
+	}
+	return &nft.QueryClassConfigResponse{ClassConfig: &config}, nil
+}
+
+// Royalty return the royalty of a class, similar to royaltyInfo in ERC2981
+func (k Keeper) Royalty(goCtx context.Context, r *nft.QueryRoyaltyRequest) (*nft.QueryRoyaltyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasClass(ctx, r.ClassId) {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	config, _ := k.GetClassConfig(ctx, r.ClassId)
+	return &nft.QueryRoyaltyResponse{Royalty: config.Royalty}, nil
+}
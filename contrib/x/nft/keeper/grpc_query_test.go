@@ -592,3 +592,65 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestClassConfig() {
+	royalty := &nft.RoyaltyInfo{Receiver: s.encodedAddrs[2], Rate: "0.025"}
+	_, err := s.nftKeeper.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Owner:      s.encodedAddrs[0],
+		ClassId:    testClassID,
+		MintPolicy: nft.MintPolicy_MINT_POLICY_ALLOWLIST,
+		Minters:    []string{s.encodedAddrs[1]},
+		Royalty:    royalty,
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "module-class"}))
+
+	_, err = s.queryClient.ClassConfig(gocontext.Background(), &nft.QueryClassConfigRequest{})
+	s.Require().ErrorContains(err, nft.ErrEmptyClassID.Error())
+
+	_, err = s.queryClient.ClassConfig(gocontext.Background(), &nft.QueryClassConfigRequest{ClassId: "module-class"})
+	s.Require().ErrorContains(err, "not found class config")
+
+	res, err := s.queryClient.ClassConfig(gocontext.Background(), &nft.QueryClassConfigRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.ClassConfig{
+		ClassId:    testClassID,
+		Owner:      s.encodedAddrs[0],
+		MintPolicy: nft.MintPolicy_MINT_POLICY_ALLOWLIST,
+		Minters:    []string{s.encodedAddrs[1]},
+		Royalty:    royalty,
+	}, res.ClassConfig)
+}
+
+func (s *TestSuite) TestRoyalty() {
+	royalty := &nft.RoyaltyInfo{Receiver: s.encodedAddrs[2], Rate: "0.025"}
+	_, err := s.nftKeeper.CreateClass(s.ctx, &nft.MsgCreateClass{Owner: s.encodedAddrs[0], ClassId: testClassID, Royalty: royalty})
+	s.Require().NoError(err)
+	_, err = s.nftKeeper.CreateClass(s.ctx, &nft.MsgCreateClass{Owner: s.encodedAddrs[0], ClassId: "no-royalty"})
+	s.Require().NoError(err)
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "module-class"}))
+
+	testCases := []struct {
+		msg        string
+		classID    string
+		expError   string
+		expRoyalty *nft.RoyaltyInfo
+	}{
+		{"fail empty ClassId", "", nft.ErrEmptyClassID.Error(), nil},
+		{"fail ClassId not exist", "kitty2", "not found class", nil},
+		{"success without class config", "module-class", "", nil},
+		{"success without royalty", "no-royalty", "", nil},
+		{"success", testClassID, "", royalty},
+	}
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := s.queryClient.Royalty(gocontext.Background(), &nft.QueryRoyaltyRequest{ClassId: tc.classID})
+			if tc.expError != "" {
+				s.Require().ErrorContains(err, tc.expError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expRoyalty, res.Royalty)
+		})
+	}
+}
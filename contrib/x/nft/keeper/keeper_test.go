@@ -362,6 +362,30 @@ func (s *TestSuite) TestExportGenesis() {
 	s.Require().Equal(expGenesis, genesis)
 }
 
+func (s *TestSuite) TestExportGenesisClassConfigs() {
+	_, err := s.nftKeeper.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Owner:      s.encodedAddrs[0],
+		ClassId:    testClassID,
+		MintPolicy: nft.MintPolicy_MINT_POLICY_OPEN,
+		Royalty:    &nft.RoyaltyInfo{Receiver: s.encodedAddrs[1], Rate: "0.1"},
+	})
+	s.Require().NoError(err)
+	_, err = s.nftKeeper.MintNFT(s.ctx, &nft.MsgMintNFT{Minter: s.encodedAddrs[2], ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+
+	genesis := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().Len(genesis.ClassConfigs, 1)
+	s.Require().NoError(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()))
+
+	s.SetupTest() // reset
+	s.nftKeeper.InitGenesis(s.ctx, genesis)
+	s.Require().Equal(genesis, s.nftKeeper.ExportGenesis(s.ctx))
+
+	config, has := s.nftKeeper.GetClassConfig(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(nft.MintPolicy_MINT_POLICY_OPEN, config.MintPolicy)
+}
+
 func (s *TestSuite) TestInitGenesis() {
 	expClass := nft.Class{
 		Id:          testClassID,
@@ -15,6 +15,7 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	ClassConfigKey       = []byte{0x06}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -31,6 +32,14 @@ func classStoreKey(classID string) []byte {
 	return key
 }
 
+// classConfigStoreKey returns the byte representation of the nft class config key
+func classConfigStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassConfigKey)+len(classID))
+	copy(key, ClassConfigKey)
+	copy(key[len(ClassConfigKey):], classID)
+	return key
+}
+
 // nftStoreKey returns the byte representation of the nft
 func nftStoreKey(classID string) []byte {
 	key := make([]byte, len(NFTKey)+len(classID)+len(Delimiter))
@@ -51,3 +51,164 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, err
 }
+
+// CreateClass implements CreateClass method of the types.MsgServer.
+func (k Keeper) CreateClass(goCtx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	config := nft.ClassConfig{
+		ClassId:    msg.ClassId,
+		Owner:      msg.Owner,
+		MintPolicy: msg.MintPolicy,
+		Minters:    msg.Minters,
+		Royalty:    msg.Royalty,
+	}
+	if config.MintPolicy == nft.MintPolicy_MINT_POLICY_UNSPECIFIED {
+		config.MintPolicy = nft.MintPolicy_MINT_POLICY_OWNER_ONLY
+	}
+	if err := config.Validate(k.ac); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class := nft.Class{
+		Id:          msg.ClassId,
+		Name:        msg.Name,
+		Symbol:      msg.Symbol,
+		Description: msg.Description,
+		Uri:         msg.Uri,
+		UriHash:     msg.UriHash,
+		Data:        msg.Data,
+	}
+	if err := k.SaveClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetClassConfig(ctx, config); err != nil {
+		return nil, err
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&nft.EventCreateClass{
+		ClassId: msg.ClassId,
+		Owner:   msg.Owner,
+	})
+	return &nft.MsgCreateClassResponse{}, err
+}
+
+// MintNFT implements MintNFT method of the types.MsgServer.
+func (k Keeper) MintNFT(goCtx context.Context, msg *nft.MsgMintNFT) (*nft.MsgMintNFTResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	if _, err := k.ac.StringToBytes(msg.Minter); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", msg.Minter)
+	}
+
+	receiverStr := msg.Receiver
+	if len(receiverStr) == 0 {
+		receiverStr = msg.Minter
+	}
+	receiver, err := k.ac.StringToBytes(receiverStr)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", receiverStr)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	config, found := k.GetClassConfig(ctx, msg.ClassId)
+	if !found {
+		return nil, errorsmod.Wrap(nft.ErrNoClassConfig, msg.ClassId)
+	}
+
+	if !config.CanMint(msg.Minter) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to mint nft of class %s", msg.Minter, msg.ClassId)
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Mint(ctx, token, receiver); err != nil {
+		return nil, err
+	}
+	return &nft.MsgMintNFTResponse{}, nil
+}
+
+// BurnNFT implements BurnNFT method of the types.MsgServer.
+func (k Keeper) BurnNFT(goCtx context.Context, msg *nft.MsgBurnNFT) (*nft.MsgBurnNFTResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", msg.Owner)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetClassConfig(ctx, msg.ClassId); !found {
+		return nil, errorsmod.Wrap(nft.ErrNoClassConfig, msg.ClassId)
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !bytes.Equal(owner, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Owner, msg.Id)
+	}
+
+	if err := k.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+	return &nft.MsgBurnNFTResponse{}, nil
+}
+
+// UpdateNFT implements UpdateNFT method of the types.MsgServer.
+func (k Keeper) UpdateNFT(goCtx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	if _, err := k.ac.StringToBytes(msg.Sender); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", msg.Sender)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	config, found := k.GetClassConfig(ctx, msg.ClassId)
+	if !found {
+		return nil, errorsmod.Wrap(nft.ErrNoClassConfig, msg.ClassId)
+	}
+
+	if msg.Sender != config.Owner {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of class %s", msg.Sender, msg.ClassId)
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Update(ctx, token); err != nil {
+		return nil, err
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&nft.EventUpdateNFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Sender:  msg.Sender,
+	})
+	return &nft.MsgUpdateNFTResponse{}, err
+}
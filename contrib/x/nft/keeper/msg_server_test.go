@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	nft "github.com/cosmos/cosmos-sdk/contrib/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -130,3 +131,310 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestCreateClass() {
+	royalty := &nft.RoyaltyInfo{Receiver: s.encodedAddrs[2], Rate: "0.05"}
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgCreateClass
+		expErr bool
+		errMsg string
+	}{
+		{
+			name:   "empty class id",
+			req:    &nft.MsgCreateClass{Owner: s.encodedAddrs[0]},
+			expErr: true,
+			errMsg: "empty class id",
+		},
+		{
+			name:   "invalid owner",
+			req:    &nft.MsgCreateClass{Owner: "invalid", ClassId: testClassID},
+			expErr: true,
+			errMsg: "invalid owner address",
+		},
+		{
+			name: "minters without allowlist policy",
+			req: &nft.MsgCreateClass{
+				Owner:      s.encodedAddrs[0],
+				ClassId:    testClassID,
+				MintPolicy: nft.MintPolicy_MINT_POLICY_OPEN,
+				Minters:    []string{s.encodedAddrs[1]},
+			},
+			expErr: true,
+			errMsg: "minters can only be set with the allowlist mint policy",
+		},
+		{
+			name: "duplicate minters",
+			req: &nft.MsgCreateClass{
+				Owner:      s.encodedAddrs[0],
+				ClassId:    testClassID,
+				MintPolicy: nft.MintPolicy_MINT_POLICY_ALLOWLIST,
+				Minters:    []string{s.encodedAddrs[1], s.encodedAddrs[1]},
+			},
+			expErr: true,
+			errMsg: "duplicate minter",
+		},
+		{
+			name: "royalty rate above one",
+			req: &nft.MsgCreateClass{
+				Owner:   s.encodedAddrs[0],
+				ClassId: testClassID,
+				Royalty: &nft.RoyaltyInfo{Receiver: s.encodedAddrs[2], Rate: "1.1"},
+			},
+			expErr: true,
+			errMsg: "invalid royalty",
+		},
+		{
+			name: "zero royalty rate",
+			req: &nft.MsgCreateClass{
+				Owner:   s.encodedAddrs[0],
+				ClassId: testClassID,
+				Royalty: &nft.RoyaltyInfo{Receiver: s.encodedAddrs[2], Rate: "0"},
+			},
+			expErr: true,
+			errMsg: "invalid royalty",
+		},
+		{
+			name: "valid transaction",
+			req: &nft.MsgCreateClass{
+				Owner:   s.encodedAddrs[0],
+				ClassId: testClassID,
+				Name:    testClassName,
+				Symbol:  testClassSymbol,
+				Uri:     testClassURI,
+				Royalty: royalty,
+			},
+			expErr: false,
+		},
+		{
+			name: "class already exists",
+			req: &nft.MsgCreateClass{
+				Owner:   s.encodedAddrs[1],
+				ClassId: testClassID,
+			},
+			expErr: true,
+			errMsg: "nft class already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.CreateClass(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	class, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(nft.Class{Id: testClassID, Name: testClassName, Symbol: testClassSymbol, Uri: testClassURI}, class)
+
+	config, has := s.nftKeeper.GetClassConfig(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(nft.ClassConfig{
+		ClassId:    testClassID,
+		Owner:      s.encodedAddrs[0],
+		MintPolicy: nft.MintPolicy_MINT_POLICY_OWNER_ONLY,
+		Royalty:    royalty,
+	}, config)
+}
+
+func (s *TestSuite) TestMintNFT() {
+	_, err := s.nftKeeper.CreateClass(s.ctx, &nft.MsgCreateClass{Owner: s.encodedAddrs[0], ClassId: "owner-only"})
+	s.Require().NoError(err)
+	_, err = s.nftKeeper.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Owner:      s.encodedAddrs[0],
+		ClassId:    "allowlist",
+		MintPolicy: nft.MintPolicy_MINT_POLICY_ALLOWLIST,
+		Minters:    []string{s.encodedAddrs[1]},
+	})
+	s.Require().NoError(err)
+	_, err = s.nftKeeper.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Owner:      s.encodedAddrs[0],
+		ClassId:    "open",
+		MintPolicy: nft.MintPolicy_MINT_POLICY_OPEN,
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, ExpClass))
+
+	testCases := []struct {
+		name     string
+		req      *nft.MsgMintNFT
+		expErr   bool
+		errMsg   string
+		expOwner sdk.AccAddress
+	}{
+		{
+			name:   "empty nft id",
+			req:    &nft.MsgMintNFT{Minter: s.encodedAddrs[0], ClassId: "owner-only"},
+			expErr: true,
+			errMsg: "empty nft id",
+		},
+		{
+			name:   "class not created with Msg/CreateClass",
+			req:    &nft.MsgMintNFT{Minter: s.encodedAddrs[0], ClassId: testClassID, Id: testID},
+			expErr: true,
+			errMsg: "nft class was not created with Msg/CreateClass",
+		},
+		{
+			name:   "owner only, not the owner",
+			req:    &nft.MsgMintNFT{Minter: s.encodedAddrs[1], ClassId: "owner-only", Id: testID},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not allowed to mint nft of class owner-only", s.encodedAddrs[1]),
+		},
+		{
+			name:     "owner only, owner mints to a receiver",
+			req:      &nft.MsgMintNFT{Minter: s.encodedAddrs[0], ClassId: "owner-only", Id: testID, Uri: testURI, Receiver: s.encodedAddrs[2]},
+			expOwner: s.addrs[2],
+		},
+		{
+			name:   "nft already exists",
+			req:    &nft.MsgMintNFT{Minter: s.encodedAddrs[0], ClassId: "owner-only", Id: testID},
+			expErr: true,
+			errMsg: "nft already exists",
+		},
+		{
+			name:   "allowlist, not a minter",
+			req:    &nft.MsgMintNFT{Minter: s.encodedAddrs[2], ClassId: "allowlist", Id: testID},
+			expErr: true,
+			errMsg: "is not allowed to mint",
+		},
+		{
+			name:     "allowlist, minter",
+			req:      &nft.MsgMintNFT{Minter: s.encodedAddrs[1], ClassId: "allowlist", Id: testID},
+			expOwner: s.addrs[1],
+		},
+		{
+			name:     "allowlist, owner",
+			req:      &nft.MsgMintNFT{Minter: s.encodedAddrs[0], ClassId: "allowlist", Id: "kitty2"},
+			expOwner: s.addrs[0],
+		},
+		{
+			name:     "open",
+			req:      &nft.MsgMintNFT{Minter: s.encodedAddrs[2], ClassId: "open", Id: testID},
+			expOwner: s.addrs[2],
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.MintNFT(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expOwner, s.nftKeeper.GetOwner(s.ctx, tc.req.ClassId, tc.req.Id))
+
+			token, has := s.nftKeeper.GetNFT(s.ctx, tc.req.ClassId, tc.req.Id)
+			s.Require().True(has)
+			s.Require().Equal(tc.req.Uri, token.Uri)
+		})
+	}
+}
+
+func (s *TestSuite) TestBurnNFT() {
+	_, err := s.nftKeeper.CreateClass(s.ctx, &nft.MsgCreateClass{Owner: s.encodedAddrs[0], ClassId: testClassID})
+	s.Require().NoError(err)
+	_, err = s.nftKeeper.MintNFT(s.ctx, &nft.MsgMintNFT{Minter: s.encodedAddrs[0], ClassId: testClassID, Id: testID, Receiver: s.encodedAddrs[1]})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgBurnNFT
+		expErr bool
+		errMsg string
+	}{
+		{
+			name:   "empty class id",
+			req:    &nft.MsgBurnNFT{Owner: s.encodedAddrs[1], Id: testID},
+			expErr: true,
+			errMsg: "empty class id",
+		},
+		{
+			name:   "class owner is not the nft owner",
+			req:    &nft.MsgBurnNFT{Owner: s.encodedAddrs[0], ClassId: testClassID, Id: testID},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not the owner of nft %s", s.encodedAddrs[0], testID),
+		},
+		{
+			name: "valid transaction",
+			req:  &nft.MsgBurnNFT{Owner: s.encodedAddrs[1], ClassId: testClassID, Id: testID},
+		},
+		{
+			name:   "nft already burned",
+			req:    &nft.MsgBurnNFT{Owner: s.encodedAddrs[1], ClassId: testClassID, Id: testID},
+			expErr: true,
+			errMsg: "unauthorized",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.BurnNFT(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+	s.Require().Zero(s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+}
+
+func (s *TestSuite) TestUpdateNFT() {
+	_, err := s.nftKeeper.CreateClass(s.ctx, &nft.MsgCreateClass{Owner: s.encodedAddrs[0], ClassId: testClassID})
+	s.Require().NoError(err)
+	_, err = s.nftKeeper.MintNFT(s.ctx, &nft.MsgMintNFT{Minter: s.encodedAddrs[0], ClassId: testClassID, Id: testID, Uri: testURI, Receiver: s.encodedAddrs[1]})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgUpdateNFT
+		expErr bool
+		errMsg string
+	}{
+		{
+			name:   "nft owner is not the class owner",
+			req:    &nft.MsgUpdateNFT{Sender: s.encodedAddrs[1], ClassId: testClassID, Id: testID, Uri: "new uri"},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not the owner of class %s", s.encodedAddrs[1], testClassID),
+		},
+		{
+			name:   "nft does not exist",
+			req:    &nft.MsgUpdateNFT{Sender: s.encodedAddrs[0], ClassId: testClassID, Id: "kitty2", Uri: "new uri"},
+			expErr: true,
+			errMsg: "nft does not exist",
+		},
+		{
+			name: "valid transaction",
+			req:  &nft.MsgUpdateNFT{Sender: s.encodedAddrs[0], ClassId: testClassID, Id: testID, Uri: "new uri", UriHash: testURIHash},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.nftKeeper.UpdateNFT(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	token, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(nft.NFT{ClassId: testClassID, Id: testID, Uri: "new uri", UriHash: testURIHash}, token)
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+}
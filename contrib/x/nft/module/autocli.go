@@ -79,6 +79,24 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all NFT classes.",
 					Example:   fmt.Sprintf(`%s query %s classes`, version.AppName, nft.ModuleName),
 				},
+				{
+					RpcMethod: "ClassConfig",
+					Use:       "class-config [class-id]",
+					Short:     "Query the owner, mint policy and royalty of an NFT class created with create-class",
+					Example:   fmt.Sprintf(`%s query %s class-config <class-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
+				{
+					RpcMethod: "Royalty",
+					Use:       "royalty [class-id]",
+					Short:     "Query the royalty of an NFT class",
+					Example:   fmt.Sprintf(`%s query %s royalty <class-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -95,6 +113,45 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					// Sender is the signer of the transaction and is automatically added as from flag by AutoCLI.
 				},
+				{
+					RpcMethod: "CreateClass",
+					Use:       "create-class [class-id] --from [owner]",
+					Short:     "Create an NFT class owned by the sender",
+					Example: fmt.Sprintf(`%s tx %s create-class <class-id> --name <name> --mint-policy MINT_POLICY_ALLOWLIST --minters <minter1>,<minter2> --royalty '{"receiver":"<receiver>","rate":"0.05"}' --from <owner>`,
+						version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
+				{
+					RpcMethod: "MintNFT",
+					Use:       "mint [class-id] [nft-id] --from [minter]",
+					Short:     "Mint an NFT of a class created with create-class",
+					Example:   fmt.Sprintf(`%s tx %s mint <class-id> <nft-id> --uri <uri> --receiver <receiver> --from <minter>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "BurnNFT",
+					Use:       "burn [class-id] [nft-id] --from [owner]",
+					Short:     "Burn an NFT of a class created with create-class",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "UpdateNFT",
+					Use:       "update [class-id] [nft-id] --from [class-owner]",
+					Short:     "Update the uri and data of an NFT of a class created with create-class",
+					Example:   fmt.Sprintf(`%s tx %s update <class-id> <nft-id> --uri <uri> --uri-hash <uri-hash> --from <class-owner>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
 			},
 		},
 	}
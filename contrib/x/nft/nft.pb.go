@@ -5,6 +5,7 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
 	io "io"
@@ -23,6 +24,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MintPolicy defines who can mint the NFTs of a class created with Msg/CreateClass.
+type MintPolicy int32
+
+const (
+	// MINT_POLICY_UNSPECIFIED defaults to MINT_POLICY_OWNER_ONLY.
+	MintPolicy_MINT_POLICY_UNSPECIFIED MintPolicy = 0
+	// MINT_POLICY_OWNER_ONLY allows only the class owner to mint.
+	MintPolicy_MINT_POLICY_OWNER_ONLY MintPolicy = 1
+	// MINT_POLICY_ALLOWLIST allows the class owner and the class minters to mint.
+	MintPolicy_MINT_POLICY_ALLOWLIST MintPolicy = 2
+	// MINT_POLICY_OPEN allows anyone to mint.
+	MintPolicy_MINT_POLICY_OPEN MintPolicy = 3
+)
+
+var MintPolicy_name = map[int32]string{
+	0: "MINT_POLICY_UNSPECIFIED",
+	1: "MINT_POLICY_OWNER_ONLY",
+	2: "MINT_POLICY_ALLOWLIST",
+	3: "MINT_POLICY_OPEN",
+}
+
+var MintPolicy_value = map[string]int32{
+	"MINT_POLICY_UNSPECIFIED": 0,
+	"MINT_POLICY_OWNER_ONLY":  1,
+	"MINT_POLICY_ALLOWLIST":   2,
+	"MINT_POLICY_OPEN":        3,
+}
+
+func (x MintPolicy) String() string {
+	return proto.EnumName(MintPolicy_name, int32(x))
+}
+
+func (MintPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{0}
+}
+
 // Class defines the class of the nft type.
 type Class struct {
 	// id defines the unique identifier of the NFT classification, similar to the contract address of ERC721
@@ -205,36 +242,192 @@ func (m *NFT) GetData() *any.Any {
 	return nil
 }
 
+// ClassConfig defines the owner, mint policy and royalty of a class created with Msg/CreateClass.
+type ClassConfig struct {
+	// class_id associated with the config
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// owner is the address allowed to update the NFTs of the class
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// mint_policy defines who can mint the NFTs of the class
+	MintPolicy MintPolicy `protobuf:"varint,3,opt,name=mint_policy,json=mintPolicy,proto3,enum=cosmos.nft.v1beta1.MintPolicy" json:"mint_policy,omitempty"`
+	// minters are the addresses allowed to mint under MINT_POLICY_ALLOWLIST
+	Minters []string `protobuf:"bytes,4,rep,name=minters,proto3" json:"minters,omitempty"`
+	// royalty is the royalty paid on secondary sales of the NFTs of the class. Optional
+	Royalty *RoyaltyInfo `protobuf:"bytes,5,opt,name=royalty,proto3" json:"royalty,omitempty"`
+}
+
+func (m *ClassConfig) Reset()         { *m = ClassConfig{} }
+func (m *ClassConfig) String() string { return proto.CompactTextString(m) }
+func (*ClassConfig) ProtoMessage()    {}
+func (*ClassConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *ClassConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassConfig.Merge(m, src)
+}
+func (m *ClassConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassConfig proto.InternalMessageInfo
+
+func (m *ClassConfig) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassConfig) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ClassConfig) GetMintPolicy() MintPolicy {
+	if m != nil {
+		return m.MintPolicy
+	}
+	return MintPolicy_MINT_POLICY_UNSPECIFIED
+}
+
+func (m *ClassConfig) GetMinters() []string {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *ClassConfig) GetRoyalty() *RoyaltyInfo {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+// RoyaltyInfo defines the royalty paid on secondary sales of the NFTs of a class, similar to ERC2981.
+// It is informational: marketplaces are expected to pay it.
+type RoyaltyInfo struct {
+	// receiver is the address receiving the royalty
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// rate is the fraction of the sale price paid as royalty, greater than 0 and at most 1
+	Rate string `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (m *RoyaltyInfo) Reset()         { *m = RoyaltyInfo{} }
+func (m *RoyaltyInfo) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyInfo) ProtoMessage()    {}
+func (*RoyaltyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{3}
+}
+func (m *RoyaltyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoyaltyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoyaltyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoyaltyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyInfo.Merge(m, src)
+}
+func (m *RoyaltyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoyaltyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoyaltyInfo proto.InternalMessageInfo
+
+func (m *RoyaltyInfo) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *RoyaltyInfo) GetRate() string {
+	if m != nil {
+		return m.Rate
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.nft.v1beta1.MintPolicy", MintPolicy_name, MintPolicy_value)
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*ClassConfig)(nil), "cosmos.nft.v1beta1.ClassConfig")
+	proto.RegisterType((*RoyaltyInfo)(nil), "cosmos.nft.v1beta1.RoyaltyInfo")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcf, 0x4a, 0x33, 0x31,
-	0x14, 0xc5, 0x9b, 0x99, 0x69, 0xfb, 0x7d, 0x29, 0x88, 0x04, 0x91, 0x54, 0x64, 0x28, 0x5d, 0x15,
-	0xc1, 0x09, 0xd5, 0x27, 0xf0, 0x0f, 0xa2, 0x1b, 0x17, 0xc5, 0x95, 0x9b, 0x92, 0x4c, 0xa6, 0x9d,
-	0x60, 0x27, 0x29, 0x49, 0x46, 0xec, 0x13, 0xb8, 0xf5, 0x81, 0x7c, 0x00, 0x97, 0x5d, 0xba, 0x94,
-	0xce, 0x8b, 0x48, 0x32, 0x63, 0x71, 0x51, 0x70, 0x95, 0x9b, 0x73, 0x0e, 0x97, 0xdf, 0xe1, 0xc2,
-	0xe3, 0x54, 0x99, 0x42, 0x19, 0x22, 0x67, 0x96, 0x3c, 0x8f, 0x59, 0x66, 0xe9, 0xd8, 0xcd, 0xc9,
-	0x52, 0x2b, 0xab, 0x10, 0xaa, 0xdd, 0xc4, 0x29, 0x8d, 0x7b, 0xd4, 0x9f, 0x2b, 0x35, 0x5f, 0x64,
-	0xc4, 0x27, 0x58, 0x39, 0x23, 0x54, 0xae, 0xea, 0xf8, 0xf0, 0x1d, 0xc0, 0xf6, 0xd5, 0x82, 0x1a,
-	0x83, 0xf6, 0x60, 0x20, 0x38, 0x06, 0x03, 0x30, 0xfa, 0x3f, 0x09, 0x04, 0x47, 0x08, 0x46, 0x92,
-	0x16, 0x19, 0x0e, 0xbc, 0xe2, 0x67, 0x74, 0x08, 0x3b, 0x66, 0x55, 0x30, 0xb5, 0xc0, 0xa1, 0x57,
-	0x9b, 0x1f, 0x1a, 0xc0, 0x1e, 0xcf, 0x4c, 0xaa, 0xc5, 0xd2, 0x0a, 0x25, 0x71, 0xe4, 0xcd, 0xdf,
-	0x12, 0xda, 0x87, 0x61, 0xa9, 0x05, 0x6e, 0x7b, 0xc7, 0x8d, 0xa8, 0x0f, 0xff, 0x95, 0x5a, 0x4c,
-	0x73, 0x6a, 0x72, 0xdc, 0xf1, 0x72, 0xb7, 0xd4, 0xe2, 0x96, 0x9a, 0x1c, 0x8d, 0x60, 0xc4, 0xa9,
-	0xa5, 0xb8, 0x3b, 0x00, 0xa3, 0xde, 0xd9, 0x41, 0x52, 0xe3, 0x27, 0x3f, 0xf8, 0xc9, 0x85, 0x5c,
-	0x4d, 0x7c, 0x62, 0xf8, 0x0a, 0x60, 0x78, 0x7f, 0xf3, 0xe0, 0x96, 0xa5, 0xae, 0xc5, 0x74, 0x5b,
-	0xa1, 0xeb, 0xff, 0x77, 0xbc, 0xe9, 0x15, 0x6c, 0x7b, 0x35, 0x24, 0xe1, 0x6e, 0x92, 0x68, 0x37,
-	0x09, 0xfc, 0x8b, 0xe4, 0xf2, 0xfa, 0x63, 0x13, 0x83, 0xf5, 0x26, 0x06, 0x5f, 0x9b, 0x18, 0xbc,
-	0x55, 0x71, 0x6b, 0x5d, 0xc5, 0xad, 0xcf, 0x2a, 0x6e, 0x3d, 0x9e, 0xcc, 0x85, 0xcd, 0x4b, 0x96,
-	0xa4, 0xaa, 0x20, 0xcd, 0xe9, 0xea, 0xe7, 0xd4, 0xf0, 0x27, 0x92, 0x2a, 0x69, 0xb5, 0x60, 0xe4,
-	0xc5, 0xdd, 0x90, 0x75, 0xfc, 0xe6, 0xf3, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7e, 0xdd, 0xc8,
-	0xbf, 0xe4, 0x01, 0x00, 0x00,
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcd, 0x4e, 0xdb, 0x40,
+	0x18, 0x8c, 0x63, 0x43, 0xe8, 0x67, 0x09, 0x59, 0x2b, 0x4a, 0x1d, 0x5a, 0xb9, 0x51, 0x4e, 0x08,
+	0x09, 0x5b, 0xa4, 0xbd, 0xf4, 0x54, 0x41, 0x08, 0xaa, 0xa5, 0xe0, 0x44, 0x86, 0x0a, 0xd1, 0x8b,
+	0xe5, 0xbf, 0x38, 0xab, 0xc6, 0xbb, 0x68, 0x77, 0x43, 0xeb, 0x27, 0x68, 0x8f, 0x7d, 0x18, 0x8e,
+	0x7d, 0x80, 0x1e, 0x11, 0xa7, 0x1e, 0x2b, 0x78, 0x91, 0xca, 0x6b, 0x93, 0xa6, 0x2a, 0xa2, 0x27,
+	0x7f, 0xdf, 0xcc, 0xac, 0x77, 0x66, 0xf7, 0x5b, 0x78, 0x11, 0x53, 0x9e, 0x53, 0xee, 0x90, 0x89,
+	0x70, 0x2e, 0xf7, 0xa2, 0x54, 0x84, 0x7b, 0x65, 0x6d, 0x5f, 0x30, 0x2a, 0x28, 0x42, 0x15, 0x6b,
+	0x97, 0x48, 0xcd, 0x6e, 0xb5, 0x33, 0x4a, 0xb3, 0x59, 0xea, 0x48, 0x45, 0x34, 0x9f, 0x38, 0x21,
+	0x29, 0x2a, 0xf9, 0x56, 0xbb, 0x92, 0x07, 0xb2, 0x73, 0xea, 0xb5, 0xb2, 0xe9, 0x7e, 0x57, 0x60,
+	0xa5, 0x3f, 0x0b, 0x39, 0x47, 0xeb, 0xd0, 0xc4, 0x89, 0xa9, 0x74, 0x94, 0xed, 0x27, 0x7e, 0x13,
+	0x27, 0x08, 0x81, 0x46, 0xc2, 0x3c, 0x35, 0x9b, 0x12, 0x91, 0x35, 0xda, 0x84, 0x55, 0x5e, 0xe4,
+	0x11, 0x9d, 0x99, 0xaa, 0x44, 0xeb, 0x0e, 0x75, 0x40, 0x4f, 0x52, 0x1e, 0x33, 0x7c, 0x21, 0x30,
+	0x25, 0xa6, 0x26, 0xc9, 0x65, 0x08, 0x19, 0xa0, 0xce, 0x19, 0x36, 0x57, 0x24, 0x53, 0x96, 0xa8,
+	0x0d, 0x6b, 0x73, 0x86, 0x83, 0x69, 0xc8, 0xa7, 0xe6, 0xaa, 0x84, 0x5b, 0x73, 0x86, 0xdf, 0x85,
+	0x7c, 0x8a, 0xb6, 0x41, 0x4b, 0x42, 0x11, 0x9a, 0xad, 0x8e, 0xb2, 0xad, 0xf7, 0x36, 0xec, 0x2a,
+	0x99, 0x7d, 0x9f, 0xcc, 0xde, 0x27, 0x85, 0x2f, 0x15, 0xdd, 0x2f, 0x0a, 0xa8, 0xde, 0xd1, 0x69,
+	0xf9, 0xb3, 0xb8, 0x4c, 0x11, 0x2c, 0x22, 0xb4, 0x64, 0xef, 0x26, 0x75, 0xae, 0xe6, 0x22, 0x57,
+	0xed, 0x44, 0x7d, 0xd8, 0x89, 0xf6, 0xb0, 0x13, 0xf8, 0xaf, 0x93, 0xaf, 0x4d, 0xd0, 0xe5, 0x41,
+	0xf6, 0x29, 0x99, 0xe0, 0xec, 0x31, 0x47, 0x36, 0xac, 0xd0, 0x4f, 0x24, 0x65, 0x95, 0xa9, 0x03,
+	0xf3, 0xe6, 0x6a, 0x77, 0xa3, 0xbe, 0x94, 0xfd, 0x24, 0x61, 0x29, 0xe7, 0x27, 0x82, 0x61, 0x92,
+	0xf9, 0x95, 0x0c, 0xbd, 0x05, 0x3d, 0xc7, 0x44, 0x04, 0x17, 0x74, 0x86, 0xe3, 0x42, 0x3a, 0x5f,
+	0xef, 0x59, 0xf6, 0xbf, 0x33, 0x60, 0x1f, 0x63, 0x22, 0xc6, 0x52, 0xe5, 0x43, 0xbe, 0xa8, 0x51,
+	0x0f, 0x5a, 0x65, 0x97, 0x32, 0x6e, 0x6a, 0x1d, 0xf5, 0xd1, 0x2d, 0xef, 0x85, 0xe8, 0x0d, 0xb4,
+	0x18, 0x2d, 0xc2, 0x99, 0x28, 0xe4, 0xa5, 0xe9, 0xbd, 0x97, 0x0f, 0x6d, 0xe8, 0x57, 0x12, 0x97,
+	0x4c, 0xa8, 0x7f, 0xaf, 0xef, 0x66, 0xa0, 0x2f, 0xe1, 0xe8, 0x35, 0xac, 0xb1, 0x34, 0x4e, 0xf1,
+	0x65, 0xca, 0xaa, 0x93, 0x78, 0x64, 0xfb, 0x85, 0x12, 0x75, 0x41, 0x63, 0xa1, 0xa8, 0xc7, 0xef,
+	0x60, 0xfd, 0xe6, 0x6a, 0x17, 0xea, 0x15, 0x87, 0x69, 0xec, 0x4b, 0x6e, 0xe7, 0x12, 0xe0, 0x4f,
+	0x62, 0xf4, 0x1c, 0x9e, 0x1d, 0xbb, 0xde, 0x69, 0x30, 0x1e, 0x0d, 0xdd, 0xfe, 0x79, 0xf0, 0xde,
+	0x3b, 0x19, 0x0f, 0xfa, 0xee, 0x91, 0x3b, 0x38, 0x34, 0x1a, 0x68, 0x0b, 0x36, 0x97, 0xc9, 0xd1,
+	0x99, 0x37, 0xf0, 0x83, 0x91, 0x37, 0x3c, 0x37, 0x14, 0xd4, 0x86, 0xa7, 0xcb, 0xdc, 0xfe, 0x70,
+	0x38, 0x3a, 0x1b, 0xba, 0x27, 0xa7, 0x46, 0x13, 0x6d, 0x80, 0xf1, 0xd7, 0xb2, 0xf1, 0xc0, 0x33,
+	0xd4, 0x83, 0xc3, 0x1f, 0xb7, 0x96, 0x72, 0x7d, 0x6b, 0x29, 0xbf, 0x6e, 0x2d, 0xe5, 0xdb, 0x9d,
+	0xd5, 0xb8, 0xbe, 0xb3, 0x1a, 0x3f, 0xef, 0xac, 0xc6, 0x87, 0x9d, 0x0c, 0x8b, 0xe9, 0x3c, 0xb2,
+	0x63, 0x9a, 0xd7, 0xef, 0xac, 0xfe, 0xec, 0xf2, 0xe4, 0xa3, 0x13, 0x53, 0x22, 0x18, 0x8e, 0x9c,
+	0xcf, 0xe5, 0x53, 0x8e, 0x56, 0xe5, 0x14, 0xbd, 0xfa, 0x3d, 0x00, 0x22, 0x65, 0xc2, 0xdb, 0xeb,
+	0x03, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -377,6 +570,106 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Minters[iNdEx])
+			copy(dAtA[i:], m.Minters[iNdEx])
+			i = encodeVarintNft(dAtA, i, uint64(len(m.Minters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MintPolicy != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MintPolicy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoyaltyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoyaltyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoyaltyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rate) > 0 {
+		i -= len(m.Rate)
+		copy(dAtA[i:], m.Rate)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Rate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -454,6 +747,53 @@ func (m *NFT) Size() (n int) {
 	return n
 }
 
+func (m *ClassConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.MintPolicy != 0 {
+		n += 1 + sovNft(uint64(m.MintPolicy))
+	}
+	if len(m.Minters) > 0 {
+		for _, s := range m.Minters {
+			l = len(s)
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *RoyaltyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Rate)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
 func sovNft(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -952,6 +1292,321 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClassConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPolicy", wireType)
+			}
+			m.MintPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintPolicy |= MintPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &RoyaltyInfo{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoyaltyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoyaltyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoyaltyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNft(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -736,6 +736,190 @@ func (m *QueryClassesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryClassConfigRequest is the request type for the Query/ClassConfig RPC method
+type QueryClassConfigRequest struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *QueryClassConfigRequest) Reset()         { *m = QueryClassConfigRequest{} }
+func (m *QueryClassConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassConfigRequest) ProtoMessage()    {}
+func (*QueryClassConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{14}
+}
+func (m *QueryClassConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassConfigRequest.Merge(m, src)
+}
+func (m *QueryClassConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassConfigRequest proto.InternalMessageInfo
+
+func (m *QueryClassConfigRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+// QueryClassConfigResponse is the response type for the Query/ClassConfig RPC method
+type QueryClassConfigResponse struct {
+	// class_config defines the owner, mint policy and royalty of the class.
+	ClassConfig *ClassConfig `protobuf:"bytes,1,opt,name=class_config,json=classConfig,proto3" json:"class_config,omitempty"`
+}
+
+func (m *QueryClassConfigResponse) Reset()         { *m = QueryClassConfigResponse{} }
+func (m *QueryClassConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassConfigResponse) ProtoMessage()    {}
+func (*QueryClassConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{15}
+}
+func (m *QueryClassConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassConfigResponse.Merge(m, src)
+}
+func (m *QueryClassConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassConfigResponse proto.InternalMessageInfo
+
+func (m *QueryClassConfigResponse) GetClassConfig() *ClassConfig {
+	if m != nil {
+		return m.ClassConfig
+	}
+	return nil
+}
+
+// QueryRoyaltyRequest is the request type for the Query/Royalty RPC method
+type QueryRoyaltyRequest struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *QueryRoyaltyRequest) Reset()         { *m = QueryRoyaltyRequest{} }
+func (m *QueryRoyaltyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyRequest) ProtoMessage()    {}
+func (*QueryRoyaltyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{16}
+}
+func (m *QueryRoyaltyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyRequest.Merge(m, src)
+}
+func (m *QueryRoyaltyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyRequest proto.InternalMessageInfo
+
+func (m *QueryRoyaltyRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+// QueryRoyaltyResponse is the response type for the Query/Royalty RPC method
+type QueryRoyaltyResponse struct {
+	// royalty is the royalty of the class, empty if the class has none.
+	Royalty *RoyaltyInfo `protobuf:"bytes,1,opt,name=royalty,proto3" json:"royalty,omitempty"`
+}
+
+func (m *QueryRoyaltyResponse) Reset()         { *m = QueryRoyaltyResponse{} }
+func (m *QueryRoyaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyResponse) ProtoMessage()    {}
+func (*QueryRoyaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{17}
+}
+func (m *QueryRoyaltyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyResponse.Merge(m, src)
+}
+func (m *QueryRoyaltyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyResponse proto.InternalMessageInfo
+
+func (m *QueryRoyaltyResponse) GetRoyalty() *RoyaltyInfo {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.nft.v1beta1.QueryBalanceResponse")
@@ -751,58 +935,70 @@ func init() {
 	proto.RegisterType((*QueryClassResponse)(nil), "cosmos.nft.v1beta1.QueryClassResponse")
 	proto.RegisterType((*QueryClassesRequest)(nil), "cosmos.nft.v1beta1.QueryClassesRequest")
 	proto.RegisterType((*QueryClassesResponse)(nil), "cosmos.nft.v1beta1.QueryClassesResponse")
+	proto.RegisterType((*QueryClassConfigRequest)(nil), "cosmos.nft.v1beta1.QueryClassConfigRequest")
+	proto.RegisterType((*QueryClassConfigResponse)(nil), "cosmos.nft.v1beta1.QueryClassConfigResponse")
+	proto.RegisterType((*QueryRoyaltyRequest)(nil), "cosmos.nft.v1beta1.QueryRoyaltyRequest")
+	proto.RegisterType((*QueryRoyaltyResponse)(nil), "cosmos.nft.v1beta1.QueryRoyaltyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/query.proto", fileDescriptor_0d24e0db697b0f9d) }
 
 var fileDescriptor_0d24e0db697b0f9d = []byte{
-	// 735 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4d, 0x4f, 0x13, 0x41,
-	0x18, 0xc7, 0x99, 0x96, 0x52, 0x1d, 0x12, 0x5f, 0x46, 0xa2, 0x65, 0xd5, 0x4d, 0xb3, 0xbc, 0xb4,
-	0x94, 0x30, 0xc3, 0x4b, 0xe2, 0x49, 0x3d, 0xa0, 0xd6, 0x78, 0x41, 0xad, 0x9c, 0x4c, 0x8c, 0xd9,
-	0xb6, 0xdb, 0xba, 0xb1, 0xcc, 0x94, 0xce, 0x56, 0x25, 0x84, 0x83, 0x1c, 0x8c, 0xc4, 0x8b, 0x89,
-	0x7c, 0x1e, 0xcf, 0x1e, 0x49, 0xbc, 0x78, 0x34, 0xe0, 0x07, 0x31, 0x3b, 0xf3, 0x6c, 0xd9, 0x95,
-	0xed, 0x2e, 0x69, 0x3c, 0x91, 0xdd, 0xf9, 0x3f, 0xcf, 0xff, 0xb7, 0xf3, 0xbc, 0x50, 0x6c, 0x36,
-	0x84, 0xdc, 0x12, 0x92, 0xf1, 0x96, 0xc7, 0xde, 0xad, 0xd4, 0x1d, 0xcf, 0x5e, 0x61, 0xdb, 0x7d,
-	0xa7, 0xb7, 0x43, 0xbb, 0x3d, 0xe1, 0x09, 0x42, 0xf4, 0x39, 0xe5, 0x2d, 0x8f, 0xc2, 0xb9, 0x51,
-	0x81, 0x98, 0xba, 0x2d, 0x1d, 0x2d, 0x1e, 0x84, 0x76, 0xed, 0xb6, 0xcb, 0x6d, 0xcf, 0x15, 0x5c,
-	0xc7, 0x1b, 0xb7, 0xda, 0x42, 0xb4, 0x3b, 0x0e, 0xb3, 0xbb, 0x2e, 0xb3, 0x39, 0x17, 0x9e, 0x3a,
-	0x94, 0xc1, 0x69, 0x8c, 0xbb, 0xef, 0xa4, 0x4e, 0xad, 0x2a, 0xbe, 0xf6, 0xdc, 0xcf, 0xbe, 0x6e,
-	0x77, 0x6c, 0xde, 0x70, 0x6a, 0xce, 0x76, 0xdf, 0x91, 0x1e, 0x99, 0xc6, 0x17, 0x1a, 0x1d, 0x5b,
-	0xca, 0xd7, 0x6e, 0xb3, 0x80, 0x8a, 0xa8, 0x7c, 0xb1, 0x96, 0x57, 0xcf, 0x4f, 0x9a, 0x64, 0x0a,
-	0xe7, 0xc4, 0x7b, 0xee, 0xf4, 0x0a, 0x19, 0xf5, 0x5e, 0x3f, 0x58, 0x14, 0x4f, 0x45, 0xf3, 0xc8,
-	0xae, 0xe0, 0xd2, 0x21, 0xd7, 0xf1, 0x84, 0xbd, 0x25, 0xfa, 0xdc, 0x53, 0x69, 0xc6, 0x6b, 0xf0,
-	0x64, 0xdd, 0xc7, 0x57, 0x95, 0xfe, 0xa9, 0x1f, 0x7d, 0x0e, 0xd7, 0x4b, 0x38, 0xe3, 0x36, 0xc1,
-	0x32, 0xe3, 0x36, 0xad, 0x0a, 0x26, 0xe1, 0x78, 0x70, 0x1b, 0xb0, 0xa1, 0x30, 0x1b, 0x03, 0xed,
-	0x8b, 0x7e, 0xb7, 0xdb, 0xd9, 0x49, 0x37, 0xb3, 0x96, 0xe0, 0x52, 0x82, 0x80, 0x94, 0x6f, 0xf9,
-	0x82, 0xf0, 0x15, 0xa5, 0xdf, 0xa8, 0x6e, 0xca, 0x51, 0x6f, 0x90, 0x54, 0x31, 0x3e, 0xad, 0x6c,
-	0x21, 0x5b, 0x44, 0xe5, 0xc9, 0xd5, 0x79, 0x0a, 0xad, 0xe1, 0xb7, 0x01, 0xd5, 0x3d, 0x03, 0x35,
-	0xa4, 0xcf, 0xec, 0x76, 0x50, 0xae, 0x5a, 0x28, 0xd2, 0x3a, 0x40, 0x70, 0xb5, 0x9a, 0x06, 0xd8,
-	0x17, 0xf1, 0x38, 0x6f, 0x79, 0xb2, 0x80, 0x8a, 0xd9, 0xf2, 0xe4, 0xea, 0x0d, 0x7a, 0xb6, 0xe5,
-	0xe8, 0x46, 0x75, 0xb3, 0xa6, 0x44, 0xe4, 0x71, 0x04, 0x25, 0xa3, 0x50, 0x4a, 0xa9, 0x28, 0xda,
-	0x29, 0xc2, 0x72, 0x17, 0x5f, 0x0e, 0x50, 0x46, 0xa8, 0xf1, 0xbd, 0xd3, 0x6b, 0x1d, 0x7c, 0xc7,
-	0x02, 0xce, 0xf2, 0x96, 0x2e, 0x40, 0xc2, 0x67, 0xf8, 0x1a, 0x8b, 0xc2, 0x3d, 0x3c, 0xf0, 0xd3,
-	0x9f, 0xa3, 0xea, 0x8f, 0xa0, 0x4d, 0x40, 0x0f, 0x86, 0x0c, 0xe7, 0x94, 0x00, 0x2c, 0xa7, 0xe3,
-	0x2c, 0x75, 0x84, 0xd6, 0x59, 0xaf, 0xa0, 0x79, 0xd4, 0x4b, 0x67, 0x60, 0x1c, 0x2d, 0x2f, 0x1a,
-	0xb9, 0xbc, 0x87, 0x08, 0x26, 0x6d, 0x90, 0x1f, 0x40, 0xd7, 0xb0, 0xfe, 0x12, 0x27, 0x28, 0x72,
-	0x02, 0x6a, 0xa0, 0xfc, 0x6f, 0x95, 0x5e, 0xfd, 0x9e, 0xc7, 0x39, 0x85, 0x45, 0x0e, 0x11, 0xce,
-	0xc3, 0x16, 0x20, 0xa5, 0x38, 0x84, 0x98, 0x7d, 0x63, 0x94, 0xd3, 0x85, 0xda, 0xd4, 0xba, 0xb3,
-	0xff, 0xf3, 0xcf, 0xb7, 0xcc, 0x32, 0xa1, 0x2c, 0x66, 0xaf, 0xd5, 0xb5, 0x98, 0xed, 0xaa, 0x91,
-	0xda, 0x63, 0xbb, 0x41, 0xad, 0xf7, 0xc8, 0x01, 0xc2, 0x39, 0xb5, 0x2c, 0xc8, 0xdc, 0x50, 0xaf,
-	0xf0, 0x32, 0x32, 0xe6, 0xd3, 0x64, 0x00, 0xb4, 0xa2, 0x80, 0x16, 0xc9, 0x42, 0x1c, 0x90, 0xe2,
-	0x08, 0x61, 0xb0, 0x5d, 0x9f, 0xe5, 0x33, 0xc2, 0x13, 0x7a, 0xb7, 0x90, 0xe1, 0x2e, 0x91, 0x6d,
-	0x65, 0x94, 0x52, 0x75, 0x80, 0xb3, 0xa4, 0x70, 0x4a, 0x64, 0x2e, 0x0e, 0x47, 0x2a, 0x6d, 0xf8,
-	0x5a, 0xfa, 0x78, 0xdc, 0xdf, 0x13, 0x64, 0x76, 0x68, 0xfe, 0xd0, 0x52, 0x33, 0xe6, 0x52, 0x54,
-	0xc0, 0x50, 0x54, 0x0c, 0x06, 0x29, 0xb0, 0xf8, 0xff, 0x3d, 0x92, 0xec, 0x23, 0x9c, 0xdd, 0xa8,
-	0x6e, 0x92, 0x99, 0xa4, 0x84, 0x81, 0xeb, 0x6c, 0xb2, 0x08, 0x4c, 0x97, 0x95, 0x69, 0x85, 0x94,
-	0x87, 0x99, 0x9e, 0x29, 0xc3, 0x27, 0x84, 0x73, 0x6a, 0x1e, 0x12, 0x5a, 0x22, 0xbc, 0x3c, 0x12,
-	0x5a, 0x22, 0xb2, 0x33, 0x2c, 0xaa, 0x50, 0xca, 0x64, 0x3e, 0x0e, 0x05, 0x46, 0x2f, 0x5c, 0x84,
-	0x8f, 0x08, 0xe7, 0x61, 0x9c, 0x13, 0x46, 0x26, 0xba, 0x50, 0x12, 0x46, 0xe6, 0x9f, 0xcd, 0x60,
-	0xcd, 0x28, 0x9c, 0xdb, 0xe4, 0x66, 0x02, 0xce, 0xfa, 0xc3, 0x1f, 0xc7, 0x26, 0x3a, 0x3a, 0x36,
-	0xd1, 0xef, 0x63, 0x13, 0x7d, 0x3d, 0x31, 0xc7, 0x8e, 0x4e, 0xcc, 0xb1, 0x5f, 0x27, 0xe6, 0xd8,
-	0xcb, 0x4a, 0xdb, 0xf5, 0xde, 0xf4, 0xeb, 0xb4, 0x21, 0xb6, 0x82, 0x04, 0xfa, 0xcf, 0x92, 0x6c,
-	0xbe, 0x65, 0x0d, 0xc1, 0xbd, 0x9e, 0x5b, 0x67, 0x1f, 0xfc, 0xac, 0xf5, 0x09, 0xf5, 0xab, 0x62,
-	0xed, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xff, 0x5c, 0x36, 0x8e, 0xf3, 0x08, 0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdf, 0x4f, 0xd3, 0x5e,
+	0x18, 0xc6, 0x39, 0x1b, 0x63, 0x5f, 0xde, 0x7d, 0xa3, 0x72, 0x58, 0x64, 0x54, 0x9d, 0x4b, 0x81,
+	0xad, 0x0c, 0xd7, 0xf2, 0x43, 0x4d, 0x4c, 0xd4, 0x0b, 0xd0, 0x19, 0x6e, 0x50, 0x26, 0x57, 0x26,
+	0x6a, 0xba, 0xad, 0x9b, 0x8d, 0xa3, 0x1d, 0x6b, 0xa7, 0x12, 0xc2, 0x85, 0x5c, 0x18, 0x89, 0x5e,
+	0x98, 0xc8, 0xbd, 0xff, 0x8e, 0x97, 0x24, 0xde, 0x78, 0x69, 0xc0, 0x7b, 0xff, 0x05, 0xd3, 0x73,
+	0xde, 0x6e, 0x6d, 0xe8, 0xda, 0x85, 0x78, 0x45, 0xda, 0xf3, 0xbc, 0xef, 0xf3, 0x39, 0xe7, 0x3d,
+	0x7d, 0x18, 0x64, 0x6b, 0xa6, 0xb5, 0x6d, 0x5a, 0x8a, 0xd1, 0xb0, 0x95, 0x37, 0x4b, 0x55, 0xcd,
+	0x56, 0x97, 0x94, 0x9d, 0xae, 0xd6, 0xd9, 0x95, 0xdb, 0x1d, 0xd3, 0x36, 0x29, 0xe5, 0xeb, 0xb2,
+	0xd1, 0xb0, 0x65, 0x5c, 0x17, 0x8a, 0x58, 0x53, 0x55, 0x2d, 0x8d, 0x8b, 0x7b, 0xa5, 0x6d, 0xb5,
+	0xa9, 0x1b, 0xaa, 0xad, 0x9b, 0x06, 0xaf, 0x17, 0xae, 0x36, 0x4d, 0xb3, 0xd9, 0xd2, 0x14, 0xb5,
+	0xad, 0x2b, 0xaa, 0x61, 0x98, 0x36, 0x5b, 0xb4, 0xdc, 0xd5, 0x00, 0x77, 0xc7, 0x89, 0xad, 0x8a,
+	0x65, 0x98, 0xdc, 0x74, 0xba, 0xaf, 0xaa, 0x2d, 0xd5, 0xa8, 0x69, 0x15, 0x6d, 0xa7, 0xab, 0x59,
+	0x36, 0x9d, 0x86, 0xff, 0x6a, 0x2d, 0xd5, 0xb2, 0x5e, 0xea, 0xf5, 0x0c, 0xc9, 0x11, 0x69, 0xbc,
+	0x92, 0x64, 0xcf, 0xeb, 0x75, 0x9a, 0x86, 0x84, 0xf9, 0xd6, 0xd0, 0x3a, 0x99, 0x18, 0x7b, 0xcf,
+	0x1f, 0x44, 0x19, 0xd2, 0xfe, 0x3e, 0x56, 0xdb, 0x34, 0x2c, 0x8d, 0x5e, 0x86, 0x31, 0x75, 0xdb,
+	0xec, 0x1a, 0x36, 0x6b, 0x33, 0x5a, 0xc1, 0x27, 0xf1, 0x3e, 0x4c, 0x30, 0xfd, 0x63, 0xa7, 0x7a,
+	0x08, 0xd7, 0x0b, 0x10, 0xd3, 0xeb, 0x68, 0x19, 0xd3, 0xeb, 0x62, 0x11, 0xa8, 0xb7, 0x1e, 0xdd,
+	0x7a, 0x6c, 0xc4, 0xcb, 0xa6, 0xa0, 0xf6, 0x69, 0xb7, 0xdd, 0x6e, 0xed, 0x46, 0x9b, 0x89, 0x25,
+	0x98, 0xf4, 0x15, 0x44, 0xec, 0xe5, 0x13, 0x81, 0x4b, 0x4c, 0xbf, 0x51, 0xde, 0xb2, 0xce, 0x7b,
+	0x82, 0xb4, 0x0c, 0xd0, 0x9f, 0x6c, 0x26, 0x9e, 0x23, 0x52, 0x6a, 0x39, 0x2f, 0xe3, 0xd5, 0x70,
+	0xae, 0x81, 0xcc, 0xef, 0x0c, 0xce, 0x50, 0x7e, 0xa2, 0x36, 0xdd, 0x71, 0x55, 0x3c, 0x95, 0xe2,
+	0x21, 0x81, 0x09, 0x0f, 0x0d, 0xb2, 0x2f, 0xc0, 0xa8, 0xd1, 0xb0, 0xad, 0x0c, 0xc9, 0xc5, 0xa5,
+	0xd4, 0xf2, 0x94, 0x7c, 0xf6, 0xca, 0xc9, 0x1b, 0xe5, 0xad, 0x0a, 0x13, 0xd1, 0x47, 0x3e, 0x94,
+	0x18, 0x43, 0x29, 0x44, 0xa2, 0x70, 0x27, 0x1f, 0xcb, 0x5d, 0xb8, 0xe8, 0xa2, 0x9c, 0x63, 0xc6,
+	0xf7, 0xfa, 0xc7, 0xda, 0xdb, 0xc7, 0x3c, 0xc4, 0x8d, 0x06, 0x1f, 0x40, 0xc8, 0x36, 0x1c, 0x8d,
+	0x28, 0xe3, 0x39, 0xac, 0x39, 0xed, 0x87, 0x98, 0xfa, 0x43, 0xa0, 0x5e, 0x3d, 0x1a, 0x2a, 0x90,
+	0x60, 0x02, 0xb4, 0x9c, 0x0e, 0xb2, 0xe4, 0x15, 0x5c, 0x27, 0x3e, 0xc7, 0xcb, 0xc3, 0x5e, 0x6a,
+	0x3d, 0x63, 0xff, 0x78, 0xc9, 0xb9, 0xc7, 0x7b, 0x44, 0x20, 0xed, 0xef, 0x8f, 0xa0, 0x2b, 0xc0,
+	0x77, 0xa2, 0xb9, 0x43, 0x0e, 0x41, 0x75, 0x95, 0xff, 0x6e, 0xd2, 0x37, 0x61, 0xaa, 0x4f, 0xb5,
+	0x66, 0x1a, 0x0d, 0xbd, 0x39, 0xc4, 0x91, 0xbf, 0x80, 0xcc, 0xd9, 0x2a, 0xdc, 0xcf, 0x2a, 0xfc,
+	0xcf, 0xcb, 0x6a, 0xec, 0x3d, 0x1e, 0xd9, 0xf5, 0x81, 0x9b, 0xc2, 0xf2, 0x54, 0xad, 0xff, 0x20,
+	0x2e, 0xe2, 0x2c, 0x2a, 0xe6, 0xae, 0xda, 0xb2, 0x87, 0xf9, 0xf4, 0x37, 0x21, 0xed, 0xaf, 0x40,
+	0x9a, 0x3b, 0x90, 0xec, 0xf0, 0x57, 0x61, 0x20, 0x58, 0xb5, 0x6e, 0x34, 0xcc, 0x8a, 0xab, 0x5f,
+	0xfe, 0x33, 0x0e, 0x09, 0xd6, 0x93, 0x1e, 0x11, 0x48, 0x62, 0x40, 0xd2, 0x42, 0x50, 0x7d, 0x40,
+	0x14, 0x0b, 0x52, 0xb4, 0x90, 0x33, 0x8a, 0xb7, 0x0f, 0x7e, 0xfc, 0xfe, 0x1a, 0x5b, 0xa4, 0xb2,
+	0x12, 0x10, 0xf9, 0x55, 0x2e, 0x56, 0xf6, 0x58, 0xda, 0xec, 0x2b, 0x7b, 0xee, 0x09, 0xec, 0xd3,
+	0x43, 0x02, 0x09, 0x96, 0xa3, 0x74, 0x6e, 0xa0, 0x97, 0x37, 0xa7, 0x85, 0x7c, 0x94, 0x0c, 0x81,
+	0x96, 0x18, 0xd0, 0x02, 0x9d, 0x0f, 0x02, 0x62, 0x1c, 0x1e, 0x0c, 0x65, 0xcf, 0x61, 0xf9, 0x48,
+	0x60, 0x8c, 0xc7, 0x2e, 0x1d, 0xec, 0xe2, 0x0b, 0x72, 0xa1, 0x10, 0xa9, 0x43, 0x9c, 0x12, 0xc3,
+	0x29, 0xd0, 0xb9, 0x20, 0x1c, 0x8b, 0x69, 0xbd, 0xc7, 0xd2, 0x85, 0x51, 0x27, 0x42, 0xe9, 0xec,
+	0xc0, 0xfe, 0x9e, 0xbc, 0x17, 0xe6, 0x22, 0x54, 0xc8, 0x90, 0x63, 0x0c, 0x02, 0xcd, 0x28, 0xc1,
+	0xff, 0x96, 0x2d, 0x7a, 0x40, 0x20, 0xbe, 0x51, 0xde, 0xa2, 0x33, 0x61, 0x0d, 0x5d, 0xd7, 0xd9,
+	0x70, 0x11, 0x9a, 0x2e, 0x32, 0xd3, 0x22, 0x95, 0x06, 0x99, 0x9e, 0x19, 0xc3, 0x07, 0x02, 0x09,
+	0xf6, 0x55, 0x85, 0x5c, 0x09, 0x6f, 0xae, 0x0a, 0xf9, 0x28, 0x19, 0xa2, 0xc8, 0x0c, 0x45, 0xa2,
+	0xf9, 0x20, 0x14, 0x4c, 0x25, 0xef, 0x10, 0xde, 0x13, 0x48, 0x62, 0xd2, 0x85, 0x7c, 0x32, 0xfe,
+	0xac, 0x15, 0xa4, 0x68, 0x21, 0xe2, 0xcc, 0x30, 0x9c, 0x6b, 0xf4, 0x4a, 0x08, 0x0e, 0xfd, 0x46,
+	0x20, 0xe5, 0x89, 0x18, 0xba, 0x10, 0xde, 0xde, 0x97, 0x7e, 0xc2, 0x8d, 0xe1, 0xc4, 0xc8, 0x73,
+	0x8b, 0xf1, 0x28, 0xb4, 0x34, 0xdc, 0xf1, 0x28, 0x3c, 0x1b, 0xe9, 0x67, 0x02, 0x49, 0xcc, 0x9e,
+	0x90, 0x53, 0xf2, 0xa7, 0xa0, 0x20, 0x45, 0x0b, 0x87, 0x19, 0x1a, 0xc6, 0x9c, 0x87, 0x6a, 0xf5,
+	0xc1, 0xf7, 0x93, 0x2c, 0x39, 0x3e, 0xc9, 0x92, 0x5f, 0x27, 0x59, 0xf2, 0xe5, 0x34, 0x3b, 0x72,
+	0x7c, 0x9a, 0x1d, 0xf9, 0x79, 0x9a, 0x1d, 0x79, 0x56, 0x6c, 0xea, 0xf6, 0xab, 0x6e, 0x55, 0xae,
+	0x99, 0xdb, 0x6e, 0x2f, 0xfe, 0xa7, 0x64, 0xd5, 0x5f, 0x3b, 0xbb, 0xb1, 0x3b, 0x7a, 0x55, 0x79,
+	0xe7, 0x18, 0x54, 0xc7, 0xd8, 0x2f, 0xd4, 0x95, 0xbf, 0x03, 0x00, 0x56, 0xbc, 0x47, 0x1f, 0x3f,
+	0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -832,6 +1028,10 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// ClassConfig queries the owner, mint policy and royalty of a class created with Msg/CreateClass
+	ClassConfig(ctx context.Context, in *QueryClassConfigRequest, opts ...grpc.CallOption) (*QueryClassConfigResponse, error)
+	// Royalty queries the royalty of a class, similar to royaltyInfo in ERC2981
+	Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error)
 }
 
 type queryClient struct {
@@ -905,6 +1105,24 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ClassConfig(ctx context.Context, in *QueryClassConfigRequest, opts ...grpc.CallOption) (*QueryClassConfigResponse, error) {
+	out := new(QueryClassConfigResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/ClassConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error) {
+	out := new(QueryRoyaltyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/Royalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the number of NFTs of a given class owned by the owner, same as balanceOf in ERC721
@@ -922,6 +1140,10 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// ClassConfig queries the owner, mint policy and royalty of a class created with Msg/CreateClass
+	ClassConfig(context.Context, *QueryClassConfigRequest) (*QueryClassConfigResponse, error)
+	// Royalty queries the royalty of a class, similar to royaltyInfo in ERC2981
+	Royalty(context.Context, *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -949,6 +1171,12 @@ func (*UnimplementedQueryServer) Class(ctx context.Context, req *QueryClassReque
 func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (*UnimplementedQueryServer) ClassConfig(ctx context.Context, req *QueryClassConfigRequest) (*QueryClassConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassConfig not implemented")
+}
+func (*UnimplementedQueryServer) Royalty(ctx context.Context, req *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Royalty not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1080,6 +1308,42 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClassConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/ClassConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassConfig(ctx, req.(*QueryClassConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Royalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Royalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/Royalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Royalty(ctx, req.(*QueryRoyaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.nft.v1beta1.Query",
@@ -1113,6 +1377,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "ClassConfig",
+			Handler:    _Query_ClassConfig_Handler,
+		},
+		{
+			MethodName: "Royalty",
+			Handler:    _Query_Royalty_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
@@ -1627,87 +1899,217 @@ func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryClassConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryClassConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryClassConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		n += 1 + sovQuery(uint64(m.Amount))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryClassConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryClassConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryClassConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ClassConfig != nil {
+		{
+			size, err := m.ClassConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoyaltyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoyaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovQuery(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *QueryOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QuerySupplyResponse) Size() (n int) {
@@ -1850,6 +2252,58 @@ func (m *QueryClassesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryClassConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassConfig != nil {
+		l = m.ClassConfig.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoyaltyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoyaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3230,6 +3684,342 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryClassConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClassConfig == nil {
+				m.ClassConfig = &ClassConfig{}
+			}
+			if err := m.ClassConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoyaltyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoyaltyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &RoyaltyInfo{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
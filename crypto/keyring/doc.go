@@ -39,4 +39,10 @@
 //		be unlocked and it should be used only for testing purposes.
 //	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 //		are discarded when the process terminates or the type instance is garbage collected.
+//	remote	This backend does not hold any private key. Key listing and signing are delegated
+//		over mutually authenticated gRPC to a signing service implementing the
+//		cosmos.crypto.keyring.v1.RemoteSigner protocol, e.g. a frontend to a HSM. The
+//		connection settings are taken from the RemoteSigner option or, when unset, from
+//		the remote_signer.toml file in the keyring-remote directory. See NewRemoteSignerServer
+//		for a reference signer.
 package keyring
@@ -35,4 +35,8 @@ var (
 	ErrLegacyToRecord = errors.New("unable to convert LegacyInfo to Record")
 	// ErrUnknownLegacyType is raised when a LegacyInfo type is unknown.
 	ErrUnknownLegacyType = errors.New("unknown LegacyInfo type")
+	// ErrRemoteUnsupported is raised when an operation requiring local key material is attempted on the remote backend.
+	ErrRemoteUnsupported = errors.New("operation not supported by the remote keyring backend")
+	// ErrRemoteSigner is raised when the remote signer fails to serve a request.
+	ErrRemoteSigner = errors.New("remote signer error")
 )
@@ -38,6 +38,8 @@ type Options struct {
 	// LedgerSigSkipDERConv indicates whether Ledger should skip DER Conversion on signature,
 	// depending on which format (DER or BER) the Ledger app returns signatures
 	LedgerSigSkipDERConv bool
+	// RemoteSigner defines the connection settings of the signer used by the remote backend
+	RemoteSigner *RemoteSignerConfig
 	// KeyctlScope defines the scope of the keyctl's keyring.
 	KeyctlScope string
 }
@@ -60,7 +62,7 @@ func newKeyctlBackendConfig(appName, _ string, _ io.Reader, opts ...Option) keyr
 
 // New creates a new instance of a keyring.
 // Keyring options can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "keyctl".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "test", "remote", "keyctl".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, cdc codec.Codec, opts ...Option,
 ) (Keyring, error) {
@@ -0,0 +1,418 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	keyringRemoteDirName   = "keyring-remote"
+	remoteSignerConfigName = "remote_signer.toml"
+
+	// DefaultRemoteSignerTimeout is the default timeout of a single call to the remote signer.
+	DefaultRemoteSignerTimeout = 10 * time.Second
+)
+
+var _ Keyring = &remoteKeystore{}
+
+// RemoteSignerConfig defines how the remote backend connects to its signer.
+// The connection is always secured with mutual TLS.
+type RemoteSignerConfig struct {
+	// Address is the gRPC endpoint of the signer, e.g. "signer.internal:9191".
+	Address string `mapstructure:"address"`
+	// CAFile is the PEM encoded CA bundle used to verify the signer's certificate.
+	CAFile string `mapstructure:"ca-file"`
+	// CertFile is the PEM encoded certificate presented to the signer.
+	CertFile string `mapstructure:"cert-file"`
+	// KeyFile is the PEM encoded private key of CertFile.
+	KeyFile string `mapstructure:"key-file"`
+	// ServerName overrides the name used to verify the signer's certificate.
+	ServerName string `mapstructure:"server-name"`
+	// Timeout bounds every call made to the signer, DefaultRemoteSignerTimeout when zero.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// WithRemoteSigner sets the connection settings of the remote backend.
+func WithRemoteSigner(cfg RemoteSignerConfig) Option {
+	return func(options *Options) {
+		options.RemoteSigner = &cfg
+	}
+}
+
+// Validate checks that the config contains everything needed to dial the signer.
+func (cfg RemoteSignerConfig) Validate() error {
+	if cfg.Address == "" {
+		return errors.New("remote signer address cannot be empty")
+	}
+	if cfg.CAFile == "" || cfg.CertFile == "" || cfg.KeyFile == "" {
+		return errors.New("remote signer requires a CA file, a certificate file and a key file")
+	}
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("remote signer timeout cannot be negative: %s", cfg.Timeout)
+	}
+
+	return nil
+}
+
+// ClientTLSConfig returns the mutual TLS configuration used to dial the signer.
+func (cfg RemoteSignerConfig) ClientTLSConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load remote signer client certificate")
+	}
+
+	pool, err := loadCertPool(cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ServerName:   cfg.ServerName,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	bz, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to read remote signer CA file")
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bz) {
+		return nil, fmt.Errorf("no certificate found in %s", caFile)
+	}
+
+	return pool, nil
+}
+
+// loadRemoteSignerConfig reads the remote signer settings stored in the keyring directory.
+func loadRemoteSignerConfig(rootDir string) (RemoteSignerConfig, error) {
+	var cfg RemoteSignerConfig
+
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(rootDir, keyringRemoteDirName, remoteSignerConfigName))
+	v.SetConfigType("toml")
+	if err := v.ReadInConfig(); err != nil {
+		return cfg, errorsmod.Wrap(err, "failed to read remote signer config")
+	}
+
+	if err := v.Unmarshal(&cfg); err != nil {
+		return cfg, errorsmod.Wrap(err, "failed to parse remote signer config")
+	}
+
+	return cfg, nil
+}
+
+// remoteKeystore is a Keyring delegating key listing and signing to a remote signer.
+// It never holds private keys, hence every operation creating, importing, exporting or
+// removing keys is rejected.
+type remoteKeystore struct {
+	client  RemoteSignerClient
+	cdc     codec.Codec
+	timeout time.Duration
+	options Options
+}
+
+func newRemoteKeyring(rootDir string, cdc codec.Codec, opts ...Option) (Keyring, error) {
+	var options Options
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	var cfg RemoteSignerConfig
+	if options.RemoteSigner != nil {
+		cfg = *options.RemoteSigner
+	} else {
+		var err error
+		if cfg, err = loadRemoteSignerConfig(rootDir); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	tlsCfg, err := cfg.ClientTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(cfg.Address, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to dial remote signer")
+	}
+
+	return NewRemote(NewRemoteSignerClient(conn), cdc, cfg.Timeout, opts...), nil
+}
+
+// NewRemote creates a Keyring backed by the given remote signer client.
+// A zero timeout defaults to DefaultRemoteSignerTimeout.
+func NewRemote(client RemoteSignerClient, cdc codec.Codec, timeout time.Duration, opts ...Option) Keyring {
+	options := Options{
+		SupportedAlgos: SigningAlgoList{},
+	}
+
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	if timeout == 0 {
+		timeout = DefaultRemoteSignerTimeout
+	}
+
+	return remoteKeystore{
+		client:  client,
+		cdc:     cdc,
+		timeout: timeout,
+		options: options,
+	}
+}
+
+// Backend returns the keyring backend option used in the config
+func (ks remoteKeystore) Backend() string {
+	return BackendRemote
+}
+
+// List returns all the keys exposed by the remote signer.
+func (ks remoteKeystore) List() ([]*Record, error) {
+	ctx, cancel := ks.context()
+	defer cancel()
+
+	res, err := ks.client.ListKeys(ctx, &ListKeysRequest{})
+	if err != nil {
+		return nil, wrapRemoteErr(err, "failed to list keys")
+	}
+
+	records := make([]*Record, 0, len(res.Keys))
+	for _, key := range res.Keys {
+		k, err := ks.toRecord(key)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, k)
+	}
+
+	return records, nil
+}
+
+// SupportedAlgorithms returns the keystore Options' supported signing algorithm.
+// The remote backend cannot derive keys, so both lists are empty unless set by options.
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (*Record, error) {
+	ctx, cancel := ks.context()
+	defer cancel()
+
+	res, err := ks.client.GetKey(ctx, &GetKeyRequest{Name: uid})
+	if err != nil {
+		return nil, wrapRemoteErr(err, fmt.Sprintf("key %s", uid))
+	}
+
+	return ks.toRecord(res.Key)
+}
+
+func (ks remoteKeystore) KeyByAddress(address sdk.Address) (*Record, error) {
+	ctx, cancel := ks.context()
+	defer cancel()
+
+	res, err := ks.client.GetKey(ctx, &GetKeyRequest{Address: address.Bytes()})
+	if err != nil {
+		return nil, wrapRemoteErr(err, fmt.Sprintf("key with address %s", address))
+	}
+
+	return ks.toRecord(res.Key)
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	return ks.sign(&SignRequest{Name: uid, Msg: msg, SignMode: signMode}, fmt.Sprintf("key %s", uid))
+}
+
+func (ks remoteKeystore) SignByAddress(address sdk.Address, msg []byte, signMode signing.SignMode) ([]byte, types.PubKey, error) {
+	return ks.sign(&SignRequest{Address: address.Bytes(), Msg: msg, SignMode: signMode}, fmt.Sprintf("key with address %s", address))
+}
+
+func (ks remoteKeystore) sign(req *SignRequest, desc string) ([]byte, types.PubKey, error) {
+	ctx, cancel := ks.context()
+	defer cancel()
+
+	res, err := ks.client.Sign(ctx, req)
+	if err != nil {
+		return nil, nil, wrapRemoteErr(err, desc)
+	}
+
+	pk, err := ks.unpackPubKey(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !pk.VerifySignature(req.Msg, res.Signature) {
+		return nil, nil, fmt.Errorf("remote signer returned an invalid signature for %s", desc)
+	}
+
+	return res.Signature, pk, nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	k, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return armorRecordPubKey(ks.cdc, k)
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(address sdk.Address) (string, error) {
+	k, err := ks.KeyByAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	return armorRecordPubKey(ks.cdc, k)
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "delete")
+}
+
+func (ks remoteKeystore) DeleteByAddress(sdk.Address) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "delete")
+}
+
+func (ks remoteKeystore) Rename(string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "rename")
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, string, SignatureAlgo) (*Record, string, error) {
+	return nil, "", errorsmod.Wrap(ErrRemoteUnsupported, "key generation")
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "key generation")
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "ledger keys")
+}
+
+func (ks remoteKeystore) SaveOfflineKey(string, types.PubKey) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "offline keys")
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (*Record, error) {
+	return nil, errorsmod.Wrap(ErrRemoteUnsupported, "multisig keys")
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import")
+}
+
+func (ks remoteKeystore) ImportPrivKeyHex(string, string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import")
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return errorsmod.Wrap(ErrRemoteUnsupported, "import")
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", errorsmod.Wrap(ErrRemoteUnsupported, "private key export")
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", errorsmod.Wrap(ErrRemoteUnsupported, "private key export")
+}
+
+// MigrateAll is a no-op for the remote backend: keys are never stored locally.
+func (ks remoteKeystore) MigrateAll() ([]*Record, error) {
+	return ks.List()
+}
+
+func (ks remoteKeystore) context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), ks.timeout)
+}
+
+func (ks remoteKeystore) toRecord(key *RemoteKey) (*Record, error) {
+	if key == nil {
+		return nil, errors.New("remote signer returned an empty key")
+	}
+
+	pk, err := ks.unpackPubKey(key.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRemoteRecord(key.Name, pk)
+}
+
+func (ks remoteKeystore) unpackPubKey(any *codectypes.Any) (types.PubKey, error) {
+	if any == nil {
+		return nil, errors.New("remote signer returned an empty public key")
+	}
+
+	var pk types.PubKey
+	if err := ks.cdc.InterfaceRegistry().UnpackAny(any, &pk); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to unpack remote signer public key")
+	}
+
+	return pk, nil
+}
+
+func armorRecordPubKey(cdc codec.Codec, k *Record) (string, error) {
+	key, err := k.GetPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := cdc.MarshalInterface(key)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(bz, key.Type()), nil
+}
+
+// wrapRemoteErr maps the gRPC status returned by the signer to the errors expected
+// from a Keyring, most notably sdkerrors.ErrKeyNotFound.
+func wrapRemoteErr(err error, desc string) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return errorsmod.Wrap(err, desc)
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "%s not found", desc)
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s: %s", desc, st.Message())
+	default:
+		return errorsmod.Wrapf(ErrRemoteSigner, "%s: %s", desc, st.Message())
+	}
+}
@@ -0,0 +1,238 @@
+package keyring
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	crtFile string
+	keyFile string
+}
+
+// newTestCert issues a certificate for localhost, self-signed when parent is nil.
+func newTestCert(t *testing.T, dir, name string, parent *testCert) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	signerCert, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+	} else {
+		signerCert, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signerCert, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	tc := &testCert{
+		cert:    cert,
+		key:     key,
+		crtFile: filepath.Join(dir, name+".crt"),
+		keyFile: filepath.Join(dir, name+".key"),
+	}
+	require.NoError(t, os.WriteFile(tc.crtFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(tc.keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+
+	return tc
+}
+
+// setupRemoteSigner starts a mutual TLS signer serving the keys of an in-memory keyring
+// and returns the signer's keyring along with the client side config.
+func setupRemoteSigner(t *testing.T) (Keyring, RemoteSignerConfig) {
+	t.Helper()
+
+	dir := t.TempDir()
+	ca := newTestCert(t, dir, "ca", nil)
+	server := newTestCert(t, dir, "server", ca)
+	client := newTestCert(t, dir, "client", ca)
+
+	tlsCfg, err := NewRemoteSignerServerTLSConfig(ca.crtFile, server.crtFile, server.keyFile)
+	require.NoError(t, err)
+
+	signerKr := NewInMemory(getCodec())
+	srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsCfg)))
+	RegisterRemoteSignerServer(srv, NewRemoteSignerServer(signerKr))
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	return signerKr, RemoteSignerConfig{
+		Address:  lis.Addr().String(),
+		CAFile:   ca.crtFile,
+		CertFile: client.crtFile,
+		KeyFile:  client.keyFile,
+	}
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	signerKr, cfg := setupRemoteSigner(t)
+
+	k1, _, err := signerKr.NewMnemonic("key1", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, err = signerKr.SaveOfflineKey("offline", secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+
+	kr, err := New(t.Name(), BackendRemote, t.TempDir(), nil, getCodec(), WithRemoteSigner(cfg))
+	require.NoError(t, err)
+	require.Equal(t, BackendRemote, kr.Backend())
+
+	list, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	for _, k := range list {
+		require.Equal(t, TypeRemote, k.GetType())
+	}
+
+	k, err := kr.Key("key1")
+	require.NoError(t, err)
+	require.Equal(t, "key1", k.Name)
+	addr1, err := k1.GetAddress()
+	require.NoError(t, err)
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, addr1, addr)
+
+	k, err = kr.KeyByAddress(addr1)
+	require.NoError(t, err)
+	require.Equal(t, "key1", k.Name)
+
+	_, err = kr.Key("unknown")
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = kr.KeyByAddress(sdk.AccAddress("unknown"))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	msg := []byte("hello remote signer")
+	pk1, err := k1.GetPubKey()
+	require.NoError(t, err)
+
+	sig, pk, err := kr.Sign("key1", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, pk1.Equals(pk))
+	require.True(t, pk1.VerifySignature(msg, sig))
+
+	sig, pk, err = kr.SignByAddress(addr1, msg, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.NoError(t, err)
+	require.True(t, pk1.Equals(pk))
+	require.True(t, pk1.VerifySignature(msg, sig))
+
+	_, _, err = kr.Sign("offline", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrRemoteSigner)
+	_, _, err = kr.Sign("unknown", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	armor, err := kr.ExportPubKeyArmor("key1")
+	require.NoError(t, err)
+	require.NotEmpty(t, armor)
+
+	// operations requiring local key material are rejected
+	_, _, err = kr.NewMnemonic("key2", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+	require.ErrorIs(t, kr.Delete("key1"), ErrRemoteUnsupported)
+	require.ErrorIs(t, kr.Rename("key1", "key2"), ErrRemoteUnsupported)
+	_, err = kr.ExportPrivKeyArmor("key1", "passphrase")
+	require.ErrorIs(t, err, ErrRemoteUnsupported)
+
+	// the autocli adapter works on top of the remote backend
+	acKr, err := NewAutoCLIKeyring(kr)
+	require.NoError(t, err)
+	names, err := acKr.List()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"key1", "offline"}, names)
+	bz, err := acKr.LookupAddressByKeyName("key1")
+	require.NoError(t, err)
+	require.Equal(t, addr1.Bytes(), bz)
+}
+
+func TestRemoteKeyringConfigFile(t *testing.T) {
+	signerKr, cfg := setupRemoteSigner(t)
+	_, _, err := signerKr.NewMnemonic("key1", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+
+	// no config
+	_, err = New(t.Name(), BackendRemote, dir, nil, getCodec())
+	require.Error(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, keyringRemoteDirName), 0o700))
+	content := "address = \"" + cfg.Address + "\"\n" +
+		"ca-file = \"" + cfg.CAFile + "\"\n" +
+		"cert-file = \"" + cfg.CertFile + "\"\n" +
+		"key-file = \"" + cfg.KeyFile + "\"\n" +
+		"timeout = \"5s\"\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, keyringRemoteDirName, remoteSignerConfigName), []byte(content), 0o600))
+
+	kr, err := New(t.Name(), BackendRemote, dir, nil, getCodec())
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, kr.(remoteKeystore).timeout)
+
+	list, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
+
+func TestRemoteKeyringRequiresMutualTLS(t *testing.T) {
+	_, cfg := setupRemoteSigner(t)
+
+	noCert := cfg
+	noCert.CertFile, noCert.KeyFile = "", ""
+	_, err := New(t.Name(), BackendRemote, t.TempDir(), nil, getCodec(), WithRemoteSigner(noCert))
+	require.Error(t, err)
+
+	// a client certificate not issued by the signer's CA is rejected
+	dir := t.TempDir()
+	otherCA := newTestCert(t, dir, "other-ca", nil)
+	other := newTestCert(t, dir, "other", otherCA)
+	untrusted := cfg
+	untrusted.CertFile, untrusted.KeyFile = other.crtFile, other.keyFile
+	untrusted.Timeout = 2 * time.Second
+
+	kr, err := New(t.Name(), BackendRemote, t.TempDir(), nil, getCodec(), WithRemoteSigner(untrusted))
+	require.NoError(t, err)
+	_, err = kr.List()
+	require.Error(t, err)
+}
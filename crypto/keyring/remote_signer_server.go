@@ -0,0 +1,136 @@
+package keyring
+
+import (
+	"context"
+	"crypto/tls"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ RemoteSignerServer = remoteSignerServer{}
+
+// remoteSignerServer is a reference RemoteSigner implementation serving the keys
+// of a local Keyring. It is meant for tests and as a starting point for signers
+// fronting a HSM.
+type remoteSignerServer struct {
+	kr Keyring
+}
+
+// NewRemoteSignerServer returns a RemoteSignerServer exposing the keys of the given Keyring.
+// Keys that cannot sign (offline, multisig) are listed but signing with them fails.
+func NewRemoteSignerServer(kr Keyring) RemoteSignerServer {
+	return remoteSignerServer{kr: kr}
+}
+
+// NewRemoteSignerServerTLSConfig returns the TLS configuration of a signer requiring
+// clients to present a certificate issued by the given CA.
+func NewRemoteSignerServerTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load remote signer server certificate")
+	}
+
+	pool, err := loadCertPool(caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// ListKeys implements RemoteSignerServer.
+func (s remoteSignerServer) ListKeys(_ context.Context, _ *ListKeysRequest) (*ListKeysResponse, error) {
+	records, err := s.kr.List()
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	keys := make([]*RemoteKey, 0, len(records))
+	for _, k := range records {
+		keys = append(keys, &RemoteKey{Name: k.Name, PubKey: k.PubKey})
+	}
+
+	return &ListKeysResponse{Keys: keys}, nil
+}
+
+// GetKey implements RemoteSignerServer.
+func (s remoteSignerServer) GetKey(_ context.Context, req *GetKeyRequest) (*GetKeyResponse, error) {
+	k, err := s.lookup(req.Name, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetKeyResponse{Key: &RemoteKey{Name: k.Name, PubKey: k.PubKey}}, nil
+}
+
+// Sign implements RemoteSignerServer.
+func (s remoteSignerServer) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	if len(req.Msg) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "msg cannot be empty")
+	}
+
+	k, err := s.lookup(req.Name, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, pk, err := s.kr.Sign(k.Name, req.Msg, req.SignMode)
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SignResponse{Signature: sig, PubKey: pkAny}, nil
+}
+
+func (s remoteSignerServer) lookup(name string, address []byte) (*Record, error) {
+	var (
+		k   *Record
+		err error
+	)
+
+	switch {
+	case name != "" && len(address) != 0:
+		return nil, status.Error(codes.InvalidArgument, "only one of name or address can be set")
+	case name != "":
+		k, err = s.kr.Key(name)
+	case len(address) != 0:
+		k, err = s.kr.KeyByAddress(sdk.AccAddress(address))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either name or address must be set")
+	}
+
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+
+	return k, nil
+}
+
+func toStatusErr(err error) error {
+	switch {
+	case errors.Is(err, sdkerrors.ErrKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrOfflineSign):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -258,6 +258,7 @@ func NewSimApp(
 		legacyAmino,
 		runtime.NewKVStoreService(storeKeys[slashingtypes.StoreKey]),
 		app.StakingKeeper,
+		app.BankKeeper,
 		authority,
 	)
 
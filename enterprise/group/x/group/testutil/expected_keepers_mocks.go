@@ -147,6 +147,21 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// Clawback mocks base method.
+func (m *MockBankKeeper) Clawback(arg0 context.Context, arg1 *types0.MsgClawback) (*types0.MsgClawbackResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clawback", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgClawbackResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Clawback indicates an expected call of Clawback.
+func (mr *MockBankKeeperMockRecorder) Clawback(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clawback", reflect.TypeOf((*MockBankKeeper)(nil).Clawback), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types.AccAddress) types.Coins {
 	m.ctrl.T.Helper()
@@ -219,6 +234,51 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SetAllowlisted mocks base method.
+func (m *MockBankKeeper) SetAllowlisted(arg0 context.Context, arg1 *types0.MsgSetAllowlisted) (*types0.MsgSetAllowlistedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAllowlisted", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetAllowlistedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAllowlisted indicates an expected call of SetAllowlisted.
+func (mr *MockBankKeeperMockRecorder) SetAllowlisted(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllowlisted", reflect.TypeOf((*MockBankKeeper)(nil).SetAllowlisted), arg0, arg1)
+}
+
+// SetDenomCompliance mocks base method.
+func (m *MockBankKeeper) SetDenomCompliance(arg0 context.Context, arg1 *types0.MsgSetDenomCompliance) (*types0.MsgSetDenomComplianceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomCompliance", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetDenomComplianceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomCompliance indicates an expected call of SetDenomCompliance.
+func (mr *MockBankKeeperMockRecorder) SetDenomCompliance(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomCompliance", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomCompliance), arg0, arg1)
+}
+
+// SetFrozen mocks base method.
+func (m *MockBankKeeper) SetFrozen(arg0 context.Context, arg1 *types0.MsgSetFrozen) (*types0.MsgSetFrozenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFrozen", arg0, arg1)
+	ret0, _ := ret[0].(*types0.MsgSetFrozenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFrozen indicates an expected call of SetFrozen.
+func (mr *MockBankKeeperMockRecorder) SetFrozen(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFrozen", reflect.TypeOf((*MockBankKeeper)(nil).SetFrozen), arg0, arg1)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(arg0 context.Context, arg1 *types0.MsgSetSendEnabled) (*types0.MsgSetSendEnabledResponse, error) {
 	m.ctrl.T.Helper()
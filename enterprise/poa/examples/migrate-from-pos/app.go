@@ -265,6 +265,7 @@ func NewSimApp(
 		legacyAmino,
 		runtime.NewKVStoreService(storeKeys[slashingtypes.StoreKey]),
 		app.StakingKeeper,
+		app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.StakingKeeper.SetHooks(
@@ -3,7 +3,7 @@ module github.com/cosmos/cosmos-sdk/enterprise/poa/examples/migrate-from-pos
 go 1.26.6
 
 require (
-	cosmossdk.io/api v1.1.0
+	cosmossdk.io/api v1.1.1-0.20261019140216-ef5ab496fbd2
 	cosmossdk.io/client/v2 v2.11.0
 	cosmossdk.io/collections v1.4.0
 	cosmossdk.io/core v1.1.0
@@ -11,7 +11,7 @@ require (
 )
 
 require (
-	cosmossdk.io/api v1.1.0 // indirect
+	cosmossdk.io/api v1.1.1-0.20261019140216-ef5ab496fbd2 // indirect
 	cosmossdk.io/collections v1.4.0 // indirect
 	cosmossdk.io/core v1.1.0 // indirect
 	cosmossdk.io/depinject v1.2.1 // indirect
@@ -11,6 +11,9 @@ $mockgen_cmd -package mock -destination testutil/mock/grpc_server.go github.com/
 $mockgen_cmd -package mock -destination testutil/mock/logger.go cosmossdk.io/log/v2 Logger
 $mockgen_cmd -source=x/feegrant/expected_keepers.go -package testutil -destination x/feegrant/testutil/expected_keepers_mocks.go
 $mockgen_cmd -source=x/mint/types/expected_keepers.go -package testutil -destination x/mint/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/scheduler/types/expected_keepers.go -package testutil -destination x/scheduler/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/feeabs/types/expected_keepers.go -package testutil -destination x/feeabs/testutil/expected_keepers_mocks.go
+$mockgen_cmd -source=x/tokenfactory/types/expected_keepers.go -package testutil -destination x/tokenfactory/testutil/expected_keepers_mocks.go
 $mockgen_cmd -source=x/auth/tx/config/expected_keepers.go -package testutil -destination x/auth/tx/testutil/expected_keepers_mocks.go
 $mockgen_cmd -source=x/auth/types/expected_keepers.go -package testutil -destination x/auth/testutil/expected_keepers_mocks.go
 $mockgen_cmd -source=x/auth/ante/expected_keepers.go -package testutil -destination x/auth/ante/testutil/expected_keepers_mocks.go
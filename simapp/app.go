@@ -66,6 +66,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+	feeabskeeper "github.com/cosmos/cosmos-sdk/x/feeabs/keeper"
+	feeabstypes "github.com/cosmos/cosmos-sdk/x/feeabs/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
@@ -79,12 +82,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+	schedulerkeeper "github.com/cosmos/cosmos-sdk/x/scheduler/keeper"
+	schedulertypes "github.com/cosmos/cosmos-sdk/x/scheduler/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	tokenfactorykeeper "github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
 	"github.com/cosmos/cosmos-sdk/x/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
@@ -99,13 +108,16 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:          nil,
-		distrtypes.ModuleName:               nil,
-		minttypes.ModuleName:                {authtypes.Minter},
-		stakingtypes.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
-		stakingtypes.KeyRotationFeePoolName: {authtypes.Burner},
-		govtypes.ModuleName:                 {authtypes.Burner},
+		authtypes.FeeCollectorName:           nil,
+		distrtypes.ModuleName:                nil,
+		minttypes.ModuleName:                 {authtypes.Minter},
+		stakingtypes.BondedPoolName:          {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:       {authtypes.Burner, authtypes.Staking},
+		stakingtypes.KeyRotationFeePoolName:  {authtypes.Burner},
+		stakingtypes.TokenizedSharesPoolName: {authtypes.Minter, authtypes.Burner},
+		govtypes.ModuleName:                  {authtypes.Burner},
+		schedulertypes.ModuleName:            nil,
+		tokenfactorytypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
 	}
 )
 
@@ -140,9 +152,12 @@ type SimApp struct {
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
 	// supplementary keepers
-	FeeGrantKeeper feegrantkeeper.Keeper
-	AuthzKeeper    authzkeeper.Keeper
-	EpochsKeeper   *epochskeeper.Keeper
+	FeeGrantKeeper     feegrantkeeper.Keeper
+	AuthzKeeper        authzkeeper.Keeper
+	EpochsKeeper       *epochskeeper.Keeper
+	SchedulerKeeper    schedulerkeeper.Keeper
+	FeeAbsKeeper       feeabskeeper.Keeper
+	TokenFactoryKeeper tokenfactorykeeper.Keeper
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -184,7 +199,13 @@ func NewSimApp(
 	})
 	appCodec := codec.NewProtoCodec(interfaceRegistry)
 	legacyAmino := codec.NewLegacyAmino()
-	txConfig := authtx.NewTxConfig(appCodec, authtx.DefaultSignModes)
+	txConfig, err := authtx.NewTxConfigWithOptions(appCodec, authtx.ConfigOptions{
+		EnabledSignModes: authtx.DefaultSignModes,
+		EnableTxBundles:  true,
+	})
+	if err != nil {
+		panic(err)
+	}
 
 	if err := interfaceRegistry.SigningContext().Validate(); err != nil {
 		panic(err)
@@ -245,6 +266,9 @@ func NewSimApp(
 		evidencetypes.StoreKey,
 		authzkeeper.StoreKey,
 		epochstypes.StoreKey,
+		schedulertypes.StoreKey,
+		feeabstypes.StoreKey,
+		tokenfactorytypes.StoreKey,
 	)
 
 	stores := make([]storetypes.StoreKey, 0, len(keys))
@@ -299,9 +323,11 @@ func NewSimApp(
 		logger,
 	)
 
-	txConfig, err := authtx.NewTxConfigWithOptions(
+	txConfig, err = authtx.NewTxConfigWithOptions(
 		appCodec,
-		authtx.ConfigOptions{},
+		authtx.ConfigOptions{
+			EnableTxBundles: true,
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -343,6 +369,7 @@ func NewSimApp(
 		legacyAmino,
 		runtime.NewKVStoreService(keys[slashingtypes.StoreKey]),
 		app.StakingKeeper,
+		app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -424,6 +451,7 @@ func NewSimApp(
 		app.SlashingKeeper,
 		app.AccountKeeper.AddressCodec(),
 		runtime.ProvideCometInfoService(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
@@ -431,13 +459,46 @@ func NewSimApp(
 	epochsKeeper := epochskeeper.NewKeeper(
 		runtime.NewKVStoreService(keys[epochstypes.StoreKey]),
 		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.EpochsKeeper = &epochsKeeper
 
+	app.SchedulerKeeper = schedulerkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[schedulertypes.StoreKey]),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.EpochsKeeper,
+		app.MsgServiceRouter(),
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.FeeAbsKeeper = feeabskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[feeabstypes.StoreKey]),
+		app.AccountKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[tokenfactorytypes.StoreKey]),
+		app.AccountKeeper,
+		app.BankKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	// run the send hooks of the factory denoms on every transfer
+	app.BankKeeper.AppendSendRestriction(app.TokenFactoryKeeper.SendRestriction)
+
 	app.EpochsKeeper.SetHooks(
 		epochstypes.NewMultiEpochHooks(
-		// insert epoch hooks receivers here
+			// insert epoch hooks receivers here
+			app.SchedulerKeeper.Hooks(),
+			app.DistrKeeper.EpochHooks(),
+			app.MintKeeper.EpochHooks(),
 		),
 	)
 
@@ -464,6 +525,9 @@ func NewSimApp(
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
 		epochs.NewAppModule(app.EpochsKeeper),
+		scheduler.NewAppModule(app.SchedulerKeeper),
+		feeabs.NewAppModule(app.FeeAbsKeeper),
+		tokenfactory.NewAppModule(app.TokenFactoryKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -506,11 +570,14 @@ func NewSimApp(
 		stakingtypes.ModuleName,
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
+		schedulertypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	// NOTE: The genutils module must also occur after auth so that it can access the params from auth.
+	// NOTE: The genutils module must also occur after feeabs, whose params are used by the ante handler
+	// to check the fees of the genesis transactions.
 	genesisModuleOrder := []string{
 		authtypes.ModuleName,
 		banktypes.ModuleName,
@@ -519,6 +586,7 @@ func NewSimApp(
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
+		feeabstypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
@@ -527,6 +595,8 @@ func NewSimApp(
 		vestingtypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		epochstypes.ModuleName,
+		schedulertypes.ModuleName,
+		tokenfactorytypes.ModuleName,
 	}
 
 	exportModuleOrder := []string{
@@ -545,6 +615,9 @@ func NewSimApp(
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		epochstypes.ModuleName,
+		schedulertypes.ModuleName,
+		feeabstypes.ModuleName,
+		tokenfactorytypes.ModuleName,
 	}
 
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
@@ -627,6 +700,8 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 			SignModeHandler: txConfig.SignModeHandler(),
 			FeegrantKeeper:  app.FeeGrantKeeper,
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			// accept fees in the alternate fee denoms whitelisted in x/feeabs.
+			TxFeeChecker: app.FeeAbsKeeper.CheckTxFee,
 			SigVerifyOptions: []ante.SigVerificationDecoratorOption{
 				// change below as needed.
 				ante.WithUnorderedTxGasCost(ante.DefaultUnorderedTxGasCost),
@@ -745,6 +820,13 @@ func (a *SimApp) DefaultGenesis() map[string]json.RawMessage {
 	return a.BasicModuleManager.DefaultGenesis(a.appCodec)
 }
 
+// GetUpgradeKeeper returns the upgrade keeper of the app.
+//
+// NOTE: This is used by the upgrade dry-run command.
+func (app *SimApp) GetUpgradeKeeper() *upgradekeeper.Keeper {
+	return app.UpgradeKeeper
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -44,6 +44,8 @@ import (
 	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+	feeabstypes "github.com/cosmos/cosmos-sdk/x/feeabs/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
@@ -52,10 +54,14 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+	schedulertypes "github.com/cosmos/cosmos-sdk/x/scheduler/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
@@ -401,20 +407,23 @@ func TestRunMigrations(t *testing.T) {
 			_, err = app.ModuleManager.RunMigrations(
 				app.NewContextLegacy(true, cmtproto.Header{Height: app.LastBlockHeight()}), configurator,
 				module.VersionMap{
-					banktypes.ModuleName:     1,
-					authtypes.ModuleName:     auth.AppModule{}.ConsensusVersion(),
-					authz.ModuleName:         authzmodule.AppModule{}.ConsensusVersion(),
-					stakingtypes.ModuleName:  staking.AppModule{}.ConsensusVersion(),
-					minttypes.ModuleName:     mint.AppModule{}.ConsensusVersion(),
-					distrtypes.ModuleName:    distribution.AppModule{}.ConsensusVersion(),
-					slashingtypes.ModuleName: slashing.AppModule{}.ConsensusVersion(),
-					govtypes.ModuleName:      gov.AppModule{}.ConsensusVersion(),
-					upgradetypes.ModuleName:  upgrade.AppModule{}.ConsensusVersion(),
-					vestingtypes.ModuleName:  vesting.AppModule{}.ConsensusVersion(),
-					feegrant.ModuleName:      feegrantmodule.AppModule{}.ConsensusVersion(),
-					evidencetypes.ModuleName: evidence.AppModule{}.ConsensusVersion(),
-					genutiltypes.ModuleName:  genutil.AppModule{}.ConsensusVersion(),
-					epochstypes.ModuleName:   epochs.AppModule{}.ConsensusVersion(),
+					banktypes.ModuleName:         1,
+					authtypes.ModuleName:         auth.AppModule{}.ConsensusVersion(),
+					authz.ModuleName:             authzmodule.AppModule{}.ConsensusVersion(),
+					stakingtypes.ModuleName:      staking.AppModule{}.ConsensusVersion(),
+					minttypes.ModuleName:         mint.AppModule{}.ConsensusVersion(),
+					distrtypes.ModuleName:        distribution.AppModule{}.ConsensusVersion(),
+					slashingtypes.ModuleName:     slashing.AppModule{}.ConsensusVersion(),
+					govtypes.ModuleName:          gov.AppModule{}.ConsensusVersion(),
+					upgradetypes.ModuleName:      upgrade.AppModule{}.ConsensusVersion(),
+					vestingtypes.ModuleName:      vesting.AppModule{}.ConsensusVersion(),
+					feegrant.ModuleName:          feegrantmodule.AppModule{}.ConsensusVersion(),
+					evidencetypes.ModuleName:     evidence.AppModule{}.ConsensusVersion(),
+					genutiltypes.ModuleName:      genutil.AppModule{}.ConsensusVersion(),
+					epochstypes.ModuleName:       epochs.AppModule{}.ConsensusVersion(),
+					schedulertypes.ModuleName:    scheduler.AppModule{}.ConsensusVersion(),
+					feeabstypes.ModuleName:       feeabs.AppModule{}.ConsensusVersion(),
+					tokenfactorytypes.ModuleName: tokenfactory.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -6,6 +6,9 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	feeabstypes "github.com/cosmos/cosmos-sdk/x/feeabs/types"
+	schedulertypes "github.com/cosmos/cosmos-sdk/x/scheduler/types"
+	tokenfactorytypes "github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -33,7 +36,7 @@ func (app SimApp) RegisterUpgradeHandlers() {
 
 	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
 		storeUpgrades := storetypes.StoreUpgrades{
-			Added:   []string{},
+			Added:   []string{schedulertypes.StoreKey, feeabstypes.StoreKey, tokenfactorytypes.StoreKey},
 			Deleted: []string{"protocolpool"},
 		}
 
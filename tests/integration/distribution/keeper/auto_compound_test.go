@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+)
+
+func TestAutoCompound(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	assert.NilError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, types.InitialFeePool()))
+	assert.NilError(t, f.distrKeeper.Params.Set(f.sdkCtx, types.DefaultParams()))
+
+	msgServer := distrkeeper.NewMsgServerImpl(f.distrKeeper)
+	querier := distrkeeper.NewQuerier(f.distrKeeper)
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	funds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+	assert.NilError(t, f.bankKeeper.MintCoins(f.sdkCtx, types.ModuleName, funds.Add(funds...)))
+	assert.NilError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, types.ModuleName, sdk.AccAddress(f.valAddr), funds))
+	assert.NilError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, types.ModuleName, delAddr, funds))
+
+	tstaking := stakingtestutil.NewHelper(t, f.sdkCtx, f.stakingKeeper)
+	tstaking.CreateValidator(f.valAddr, valConsPk0, math.NewInt(100), true)
+	tstaking.Delegate(delAddr, f.valAddr, math.NewInt(100))
+
+	// auto-compound can only be enabled for existing delegations
+	_, err := msgServer.SetAutoCompound(f.sdkCtx, types.NewMsgSetAutoCompound(sdk.AccAddress(PKS[2].Address()).String(), f.valAddr.String(), true))
+	assert.ErrorIs(t, err, types.ErrNoDelegationExists)
+
+	for _, addr := range []sdk.AccAddress{sdk.AccAddress(f.valAddr), delAddr} {
+		_, err = msgServer.SetAutoCompound(f.sdkCtx, types.NewMsgSetAutoCompound(addr.String(), f.valAddr.String(), true))
+		assert.NilError(t, err)
+	}
+
+	// rewards accrue from the next block
+	ctx := f.sdkCtx.WithBlockHeight(f.sdkCtx.BlockHeight() + 1)
+
+	res, err := querier.DelegatorAutoCompound(ctx, &types.QueryDelegatorAutoCompoundRequest{DelegatorAddress: delAddr.String()})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{f.valAddr.String()}, res.Validators)
+
+	allocate := func(amount int64) {
+		t.Helper()
+		val, err := f.stakingKeeper.Validator(ctx, f.valAddr)
+		assert.NilError(t, err)
+		coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+		assert.NilError(t, f.bankKeeper.MintCoins(ctx, types.ModuleName, coins))
+		assert.NilError(t, f.distrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoinsFromCoins(coins...)))
+	}
+	shares := func(addr sdk.AccAddress) math.Int {
+		t.Helper()
+		del, err := f.stakingKeeper.GetDelegation(ctx, addr, f.valAddr)
+		assert.NilError(t, err)
+		return del.Shares.TruncateInt()
+	}
+
+	// the rewards below the min amount are not compounded
+	allocate(20)
+	params, err := f.distrKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	params.AutoCompoundMinAmount = math.NewInt(20)
+	assert.NilError(t, f.distrKeeper.Params.Set(ctx, params))
+
+	assert.NilError(t, f.distrKeeper.StartAutoCompoundRound(ctx))
+	assert.NilError(t, f.distrKeeper.ProcessAutoCompoundRound(ctx))
+	assert.Assert(math.IntEq(t, math.NewInt(100), shares(delAddr)))
+	assert.Assert(math.IntEq(t, math.NewInt(100), shares(sdk.AccAddress(f.valAddr))))
+
+	// the round resumes in the next block once the gas budget is spent
+	allocate(20)
+	params.AutoCompoundGasBudget = 1
+	assert.NilError(t, f.distrKeeper.Params.Set(ctx, params))
+
+	assert.NilError(t, f.distrKeeper.StartAutoCompoundRound(ctx))
+	assert.NilError(t, f.distrKeeper.ProcessAutoCompoundRound(ctx))
+
+	round, err := querier.AutoCompoundRound(ctx, &types.QueryAutoCompoundRoundRequest{})
+	assert.NilError(t, err)
+	assert.Assert(t, round.Round != nil)
+	compounded := 0
+	for _, addr := range []sdk.AccAddress{sdk.AccAddress(f.valAddr), delAddr} {
+		if shares(addr).Equal(math.NewInt(120)) {
+			compounded++
+		}
+	}
+	assert.Equal(t, 1, compounded)
+
+	assert.NilError(t, f.distrKeeper.ProcessAutoCompoundRound(ctx))
+	round, err = querier.AutoCompoundRound(ctx, &types.QueryAutoCompoundRoundRequest{})
+	assert.NilError(t, err)
+	assert.Assert(t, round.Round == nil)
+	assert.Assert(math.IntEq(t, math.NewInt(120), shares(delAddr)))
+	assert.Assert(math.IntEq(t, math.NewInt(120), shares(sdk.AccAddress(f.valAddr))))
+
+	// disabling and removing the delegation drop it from the auto-compound set
+	_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound(sdk.AccAddress(f.valAddr).String(), f.valAddr.String(), false))
+	assert.NilError(t, err)
+	_, _, err = f.stakingKeeper.Undelegate(ctx, delAddr, f.valAddr, math.LegacyNewDec(120))
+	assert.NilError(t, err)
+
+	res, err = querier.DelegatorAutoCompound(ctx, &types.QueryDelegatorAutoCompoundRequest{DelegatorAddress: delAddr.String()})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Validators))
+
+	assert.NilError(t, f.distrKeeper.StartAutoCompoundRound(ctx))
+	round, err = querier.AutoCompoundRound(ctx, &types.QueryAutoCompoundRoundRequest{})
+	assert.NilError(t, err)
+	assert.Assert(t, round.Round == nil)
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+)
+
+func TestCommissionRecipients(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	assert.NilError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, types.InitialFeePool()))
+	assert.NilError(t, f.distrKeeper.Params.Set(f.sdkCtx, types.DefaultParams()))
+
+	msgServer := distrkeeper.NewMsgServerImpl(f.distrKeeper)
+	querier := distrkeeper.NewQuerier(f.distrKeeper)
+
+	funds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+	assert.NilError(t, f.bankKeeper.MintCoins(f.sdkCtx, types.ModuleName, funds))
+	assert.NilError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, types.ModuleName, sdk.AccAddress(f.valAddr), funds))
+
+	tstaking := stakingtestutil.NewHelper(t, f.sdkCtx, f.stakingKeeper)
+	tstaking.Commission = stakingtestutil.ZeroCommission()
+	tstaking.CreateValidator(f.valAddr, valConsPk0, math.NewInt(100), true)
+
+	treasury, ops := sdk.AccAddress(PKS[1].Address()), sdk.AccAddress(PKS[2].Address())
+	recipients := []types.CommissionRecipient{
+		{Address: treasury.String(), Weight: math.LegacyNewDecWithPrec(7, 1)},
+		{Address: ops.String(), Weight: math.LegacyNewDecWithPrec(3, 1)},
+	}
+
+	// only validators can set commission recipients
+	_, err := msgServer.SetCommissionRecipients(f.sdkCtx, types.NewMsgSetCommissionRecipients(sdk.ValAddress(treasury).String(), recipients))
+	assert.Assert(t, err != nil)
+
+	// the recipients must be distinct
+	_, err = msgServer.SetCommissionRecipients(f.sdkCtx, types.NewMsgSetCommissionRecipients(f.valAddr.String(), []types.CommissionRecipient{
+		{Address: treasury.String(), Weight: math.LegacyNewDecWithPrec(5, 1)},
+		{Address: treasury.String(), Weight: math.LegacyNewDecWithPrec(5, 1)},
+	}))
+	assert.ErrorIs(t, err, types.ErrInvalidRecipients)
+
+	_, err = msgServer.SetCommissionRecipients(f.sdkCtx, types.NewMsgSetCommissionRecipients(f.valAddr.String(), recipients))
+	assert.NilError(t, err)
+
+	res, err := querier.CommissionRecipients(f.sdkCtx, &types.QueryCommissionRecipientsRequest{ValidatorAddress: f.valAddr.String()})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, recipients, res.Recipients)
+
+	// accumulate commission and withdraw it
+	commission := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 101))
+	assert.NilError(t, f.bankKeeper.MintCoins(f.sdkCtx, types.ModuleName, commission))
+	assert.NilError(t, f.distrKeeper.SetValidatorOutstandingRewards(f.sdkCtx, f.valAddr, types.ValidatorOutstandingRewards{Rewards: sdk.NewDecCoinsFromCoins(commission...)}))
+	assert.NilError(t, f.distrKeeper.SetValidatorAccumulatedCommission(f.sdkCtx, f.valAddr, types.ValidatorAccumulatedCommission{Commission: sdk.NewDecCoinsFromCoins(commission...)}))
+
+	operatorBalance := f.bankKeeper.GetBalance(f.sdkCtx, sdk.AccAddress(f.valAddr), sdk.DefaultBondDenom)
+	_, err = msgServer.WithdrawValidatorCommission(f.sdkCtx, types.NewMsgWithdrawValidatorCommission(f.valAddr.String()))
+	assert.NilError(t, err)
+
+	assert.Assert(math.IntEq(t, math.NewInt(70), f.bankKeeper.GetBalance(f.sdkCtx, treasury, sdk.DefaultBondDenom).Amount))
+	assert.Assert(math.IntEq(t, math.NewInt(31), f.bankKeeper.GetBalance(f.sdkCtx, ops, sdk.DefaultBondDenom).Amount))
+	assert.DeepEqual(t, operatorBalance, f.bankKeeper.GetBalance(f.sdkCtx, sdk.AccAddress(f.valAddr), sdk.DefaultBondDenom))
+
+	// clearing the recipients pays the commission back to the operator
+	_, err = msgServer.SetCommissionRecipients(f.sdkCtx, types.NewMsgSetCommissionRecipients(f.valAddr.String(), nil))
+	assert.NilError(t, err)
+
+	res, err = querier.CommissionRecipients(f.sdkCtx, &types.QueryCommissionRecipientsRequest{ValidatorAddress: f.valAddr.String()})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Recipients))
+}
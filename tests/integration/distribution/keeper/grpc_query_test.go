@@ -46,10 +46,13 @@ func TestGRPCParams(t *testing.T) {
 			name: "valid request",
 			malleate: func() {
 				params = types.Params{
-					CommunityTax:        math.LegacyNewDecWithPrec(3, 1),
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: true,
+					CommunityTax:          math.LegacyNewDecWithPrec(3, 1),
+					BaseProposerReward:    math.LegacyZeroDec(),
+					BonusProposerReward:   math.LegacyZeroDec(),
+					WithdrawAddrEnabled:   true,
+					AutoCompoundInterval:  10,
+					AutoCompoundMinAmount: math.NewInt(100),
+					AutoCompoundGasBudget: 1_000_000,
 				}
 
 				assert.NilError(t, f.distrKeeper.Params.Set(f.sdkCtx, params))
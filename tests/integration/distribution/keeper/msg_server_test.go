@@ -768,10 +768,13 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: true,
+					CommunityTax:          communityTax,
+					BaseProposerReward:    math.LegacyZeroDec(),
+					BonusProposerReward:   math.LegacyZeroDec(),
+					WithdrawAddrEnabled:   true,
+					AutoCompoundInterval:  distrtypes.DefaultAutoCompoundInterval,
+					AutoCompoundMinAmount: distrtypes.DefaultAutoCompoundMinAmount,
+					AutoCompoundGasBudget: distrtypes.DefaultAutoCompoundGasBudget,
 				},
 			},
 			expErr: false,
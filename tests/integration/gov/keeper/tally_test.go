@@ -522,3 +522,124 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	assert.Assert(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyMessageBasedParams(t *testing.T) {
+	t.Parallel()
+
+	f := initFixture(t)
+
+	ctx := f.ctx
+
+	valAccAddrs, _ := createValidators(t, f, []int64{6, 4, 0})
+
+	params, err := f.govKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	params.MessageBasedParams = []v1.MessageBasedParams{{
+		MsgUrl:    sdk.MsgTypeURL(&v1.MsgExecLegacyContent{}),
+		Threshold: "0.75",
+	}}
+	assert.NilError(t, f.govKeeper.Params.Set(ctx, params))
+
+	tp := TestProposal
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	assert.NilError(t, f.govKeeper.SetProposal(ctx, proposal))
+
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	proposal, err = f.govKeeper.Proposals.Get(ctx, proposalID)
+	assert.NilError(t, err)
+	passes, burnDeposits, _, err := f.govKeeper.Tally(ctx, proposal)
+	assert.NilError(t, err)
+
+	// 60% of yes votes pass the default threshold but not the 75% one of the
+	// proposal messages
+	assert.Assert(t, passes == false)
+	assert.Assert(t, burnDeposits == false)
+}
+
+func TestTallyMultipleChoice(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		outcome       v1.MultipleChoiceOutcome
+		powers        []int64
+		votes         []v1.VoteOption
+		expPass       bool
+		expWinner     v1.VoteOption
+		expWinnerVote int64
+	}{
+		{
+			name:          "plurality winner",
+			outcome:       v1.MultipleChoiceOutcome_MULTIPLE_CHOICE_OUTCOME_PLURALITY,
+			powers:        []int64{5, 4, 3},
+			votes:         []v1.VoteOption{v1.OptionTwo, v1.OptionThree, v1.OptionThree},
+			expPass:       true,
+			expWinner:     v1.OptionThree,
+			expWinnerVote: 7,
+		},
+		{
+			name:    "plurality tie",
+			outcome: v1.MultipleChoiceOutcome_MULTIPLE_CHOICE_OUTCOME_PLURALITY,
+			powers:  []int64{5, 5, 0},
+			votes:   []v1.VoteOption{v1.OptionOne, v1.OptionTwo},
+			expPass: false,
+		},
+		{
+			name:          "threshold reached",
+			outcome:       v1.MultipleChoiceOutcome_MULTIPLE_CHOICE_OUTCOME_THRESHOLD,
+			powers:        []int64{6, 3, 1},
+			votes:         []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree},
+			expPass:       true,
+			expWinner:     v1.OptionOne,
+			expWinnerVote: 6,
+		},
+		{
+			name:    "threshold not reached",
+			outcome: v1.MultipleChoiceOutcome_MULTIPLE_CHOICE_OUTCOME_THRESHOLD,
+			powers:  []int64{4, 3, 3},
+			votes:   []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+
+			ctx := f.ctx
+
+			valAccAddrs, _ := createValidators(t, f, tc.powers)
+
+			multipleChoice := v1.MultipleChoice{Options: []string{"a", "b", "c"}, Outcome: tc.outcome}
+			proposal, err := f.govKeeper.SubmitMultipleChoiceProposal(ctx, "", "test", "description", valAccAddrs[0], multipleChoice)
+			assert.NilError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			assert.NilError(t, f.govKeeper.SetProposal(ctx, proposal))
+
+			for i, option := range tc.votes {
+				assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[i], v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			proposal, err = f.govKeeper.Proposals.Get(ctx, proposalID)
+			assert.NilError(t, err)
+			passes, burnDeposits, tallyResults, err := f.govKeeper.Tally(ctx, proposal)
+			assert.NilError(t, err)
+
+			assert.Equal(t, tc.expPass, passes)
+			assert.Assert(t, burnDeposits == false)
+			assert.Equal(t, 3, len(tallyResults.OptionCounts))
+			if tc.expPass {
+				winner, count, found := tallyResults.WinningOption()
+				assert.Assert(t, found)
+				assert.Equal(t, tc.expWinner, winner)
+				assert.Assert(t, count.Equal(f.stakingKeeper.TokensFromConsensusPower(ctx, tc.expWinnerVote)))
+			}
+		})
+	}
+}
@@ -111,11 +111,12 @@ func initFixture(tb testing.TB) *fixture {
 	authority := authtypes.NewModuleAddress("gov")
 
 	maccPerms := map[string][]string{
-		minttypes.ModuleName:         {authtypes.Minter},
-		types.ModuleName:             {authtypes.Minter},
-		types.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
-		types.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
-		types.KeyRotationFeePoolName: {authtypes.Burner},
+		minttypes.ModuleName:          {authtypes.Minter},
+		types.ModuleName:              {authtypes.Minter},
+		types.BondedPoolName:          {authtypes.Burner, authtypes.Staking},
+		types.NotBondedPoolName:       {authtypes.Burner, authtypes.Staking},
+		types.KeyRotationFeePoolName:  {authtypes.Burner},
+		types.TokenizedSharesPoolName: {authtypes.Minter, authtypes.Burner},
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -433,7 +433,7 @@ func TestGRPCValidatorDelegations(t *testing.T) {
 		ValidatorAddr: validator.OperatorAddress,
 	}
 
-	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.ValidatorDelegations, 14637, false)
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.ValidatorDelegations, 15069, false)
 }
 
 func TestGRPCValidatorUnbondingDelegations(t *testing.T) {
@@ -513,7 +513,7 @@ func TestGRPCDelegation(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Delegation, 4689, false)
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.Delegation, 4833, false)
 }
 
 func TestGRPCUnbondingDelegation(t *testing.T) {
@@ -588,7 +588,7 @@ func TestGRPCDelegatorDelegations(t *testing.T) {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.DelegatorDelegations, 4292, false)
+	testdata.DeterministicIterations(f.ctx, t, req, f.queryClient.DelegatorDelegations, 4436, false)
 }
 
 func TestGRPCDelegatorValidator(t *testing.T) {
@@ -768,7 +768,7 @@ func TestGRPCPool(t *testing.T) {
 
 	f = initDeterministicFixture(t) // reset
 	getStaticValidator(t, f)
-	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6356, false)
+	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryPoolRequest{}, f.queryClient.Pool, 6500, false)
 }
 
 func TestGRPCRedelegations(t *testing.T) {
@@ -843,13 +843,16 @@ func TestGRPCParams(t *testing.T) {
 	rapid.Check(t, func(rt *rapid.T) {
 		bondDenom := rapid.StringMatching(sdk.DefaultCoinDenomRegex()).Draw(rt, "bond-denom")
 		params := stakingtypes.Params{
-			BondDenom:         bondDenom,
-			UnbondingTime:     durationGenerator().Draw(rt, "duration"),
-			MaxValidators:     rapid.Uint32Min(1).Draw(rt, "max-validators"),
-			MaxEntries:        rapid.Uint32Min(1).Draw(rt, "max-entries"),
-			HistoricalEntries: rapid.Uint32Min(1).Draw(rt, "historical-entries"),
-			MinCommissionRate: math.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "commission"), 2),
-			KeyRotationFee:    sdk.NewCoin(bondDenom, math.NewInt(rapid.Int64Range(1, 1000000).Draw(rt, "key-rotation-fee"))),
+			BondDenom:                 bondDenom,
+			UnbondingTime:             durationGenerator().Draw(rt, "duration"),
+			MaxValidators:             rapid.Uint32Min(1).Draw(rt, "max-validators"),
+			MaxEntries:                rapid.Uint32Min(1).Draw(rt, "max-entries"),
+			HistoricalEntries:         rapid.Uint32Min(1).Draw(rt, "historical-entries"),
+			MinCommissionRate:         math.LegacyNewDecWithPrec(rapid.Int64Range(0, 100).Draw(rt, "commission"), 2),
+			KeyRotationFee:            sdk.NewCoin(bondDenom, math.NewInt(rapid.Int64Range(1, 1000000).Draw(rt, "key-rotation-fee"))),
+			GlobalLiquidStakingCap:    stakingtypes.DefaultGlobalLiquidStakingCap,
+			ValidatorLiquidStakingCap: stakingtypes.DefaultValidatorLiquidStakingCap,
+			MinCommissionChangeNotice: durationGenerator().Draw(rt, "commission-change-notice"),
 		}
 
 		err := f.stakingKeeper.SetParams(f.ctx, params)
@@ -859,17 +862,20 @@ func TestGRPCParams(t *testing.T) {
 	})
 
 	params := stakingtypes.Params{
-		BondDenom:         "denom",
-		UnbondingTime:     time.Hour,
-		MaxValidators:     85,
-		MaxEntries:        5,
-		HistoricalEntries: 5,
-		MinCommissionRate: math.LegacyNewDecWithPrec(5, 2),
-		KeyRotationFee:    sdk.NewInt64Coin("denom", 1000000),
+		BondDenom:                 "denom",
+		UnbondingTime:             time.Hour,
+		MaxValidators:             85,
+		MaxEntries:                5,
+		HistoricalEntries:         5,
+		MinCommissionRate:         math.LegacyNewDecWithPrec(5, 2),
+		KeyRotationFee:            sdk.NewInt64Coin("denom", 1000000),
+		GlobalLiquidStakingCap:    stakingtypes.DefaultGlobalLiquidStakingCap,
+		ValidatorLiquidStakingCap: stakingtypes.DefaultValidatorLiquidStakingCap,
+		MinCommissionChangeNotice: time.Hour,
 	}
 
 	err := f.stakingKeeper.SetParams(f.ctx, params)
 	assert.NilError(t, err)
 
-	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1168, false)
+	testdata.DeterministicIterations(f.ctx, t, &stakingtypes.QueryParamsRequest{}, f.queryClient.Params, 1309, false)
 }
@@ -156,11 +156,13 @@ func TestInitGenesis_PoolsBalanceMismatch(t *testing.T) {
 	}
 
 	params := types.Params{
-		UnbondingTime:  10000,
-		MaxValidators:  1,
-		MaxEntries:     10,
-		BondDenom:      "stake",
-		KeyRotationFee: sdk.NewInt64Coin("stake", 1000000),
+		UnbondingTime:             10000,
+		MaxValidators:             1,
+		MaxEntries:                10,
+		BondDenom:                 "stake",
+		KeyRotationFee:            sdk.NewInt64Coin("stake", 1000000),
+		GlobalLiquidStakingCap:    types.DefaultGlobalLiquidStakingCap,
+		ValidatorLiquidStakingCap: types.DefaultValidatorLiquidStakingCap,
 	}
 
 	require.Panics(t, func() {
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := f.sdkCtx
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{100, 100, 50})
+	querier := keeper.NewQuerier(f.stakingKeeper)
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	amount := f.stakingKeeper.TokensFromConsensusPower(ctx, 20)
+	denom := types.TokenizedShareDenom(valAddrs[1].String())
+	custody := types.TokenizedShareCustodyAddress(valAddrs[1])
+
+	shareTokens, err := f.stakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[1], amount)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewCoin(denom, amount), shareTokens)
+	assert.DeepEqual(t, shareTokens, f.bankKeeper.GetBalance(ctx, addrs[0], denom))
+
+	delegation, err := f.stakingKeeper.GetDelegation(ctx, addrs[0], valAddrs[1])
+	assert.NilError(t, err)
+	assert.Assert(math.IntEq(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 30), delegation.Shares.TruncateInt()))
+
+	res, err := querier.TokenizedShares(ctx, &types.QueryTokenizedSharesRequest{ValidatorAddr: valAddrs[1].String()})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, shareTokens, res.Supply)
+	assert.Assert(math.IntEq(t, amount, res.Tokens))
+
+	total, err := f.stakingKeeper.TotalLiquidStaked(ctx)
+	assert.NilError(t, err)
+	assert.Assert(math.IntEq(t, amount, total))
+
+	// rewards accrued by the custody address are compounded into the
+	// delegation backing the share tokens
+	rewards := f.stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	assert.NilError(t, banktestutil.FundAccount(ctx, f.bankKeeper, custody, sdk.NewCoins(sdk.NewCoin(bondDenom, rewards), sdk.NewInt64Coin("reward", 100))))
+
+	half := sdk.NewCoin(denom, amount.QuoRaw(2))
+	assert.NilError(t, f.bankKeeper.SendCoins(ctx, addrs[0], addrs[2], sdk.NewCoins(half)))
+
+	redeemed, err := f.stakingKeeper.RedeemTokensForShares(ctx, addrs[2], half)
+	assert.NilError(t, err)
+	assert.Assert(math.IntEq(t, amount.Add(rewards).QuoRaw(2), redeemed.Amount))
+	assert.Assert(math.IntEq(t, math.NewInt(50), f.bankKeeper.GetBalance(ctx, addrs[2], "reward").Amount))
+	assert.Assert(t, f.bankKeeper.GetBalance(ctx, custody, bondDenom).IsZero())
+
+	delegation, err = f.stakingKeeper.GetDelegation(ctx, addrs[2], valAddrs[1])
+	assert.NilError(t, err)
+	assert.Assert(math.IntEq(t, redeemed.Amount, delegation.Shares.TruncateInt()))
+
+	// redeeming the remaining supply removes the custody delegation
+	_, err = f.stakingKeeper.RedeemTokensForShares(ctx, addrs[0], half)
+	assert.NilError(t, err)
+	assert.Assert(t, f.bankKeeper.GetSupply(ctx, denom).IsZero())
+
+	_, err = f.stakingKeeper.GetDelegation(ctx, custody, valAddrs[1])
+	assert.ErrorIs(t, err, types.ErrNoDelegation)
+
+	total, err = f.stakingKeeper.TotalLiquidStaked(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, total.IsZero())
+
+	res, err = querier.TokenizedShares(ctx, &types.QueryTokenizedSharesRequest{ValidatorAddr: valAddrs[1].String()})
+	assert.NilError(t, err)
+	assert.Assert(t, res.Supply.IsZero())
+	assert.Assert(t, res.Shares.IsZero())
+}
+
+func TestTokenizeSharesRestrictions(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := f.sdkCtx
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{100, 100, 50})
+	amount := f.stakingKeeper.TokensFromConsensusPower(ctx, 10)
+
+	// the validator self-delegation cannot be tokenized
+	_, err := f.stakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], amount)
+	assert.ErrorIs(t, err, types.ErrTokenizeSharesDisabled)
+
+	// more than the delegation
+	_, err = f.stakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[1], f.stakingKeeper.TokensFromConsensusPower(ctx, 51))
+	assert.ErrorContains(t, err, "invalid shares amount")
+
+	params, err := f.stakingKeeper.GetParams(ctx)
+	assert.NilError(t, err)
+
+	// 10 out of the 150 tokens of the validator
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 2)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+	_, err = f.stakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[1], amount)
+	assert.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// 10 out of the 150 bonded tokens
+	params.ValidatorLiquidStakingCap = math.LegacyOneDec()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(3, 2)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+	_, err = f.stakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[1], amount)
+	assert.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(1, 1)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+	_, err = f.stakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[1], amount)
+	assert.NilError(t, err)
+
+	// the previously tokenized tokens count towards the cap
+	_, err = f.stakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[1], amount)
+	assert.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	_, err = f.stakingKeeper.RedeemTokensForShares(ctx, addrs[0], sdk.NewCoin("stake", amount))
+	assert.ErrorIs(t, err, types.ErrInvalidShareDenom)
+}
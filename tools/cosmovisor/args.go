@@ -1,6 +1,8 @@
 package cosmovisor
 
 import (
+	"crypto/ed25519"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -40,6 +42,12 @@ const (
 	EnvTimeFormatLogs           = "COSMOVISOR_TIMEFORMAT_LOGS"
 	EnvCustomPreupgrade         = "COSMOVISOR_CUSTOM_PREUPGRADE"
 	EnvDisableRecase            = "COSMOVISOR_DISABLE_RECASE"
+	EnvUpgradeManifest          = "COSMOVISOR_UPGRADE_MANIFEST"
+	EnvUpgradeManifestPubKey    = "COSMOVISOR_UPGRADE_MANIFEST_PUBKEY"
+	EnvManifestChainID          = "COSMOVISOR_MANIFEST_CHAIN_ID"
+	EnvHealthCheckBlocks        = "COSMOVISOR_HEALTH_CHECK_BLOCKS"
+	EnvHealthCheckWindow        = "COSMOVISOR_HEALTH_CHECK_WINDOW"
+	EnvHealthCheckRPCAddress    = "COSMOVISOR_HEALTH_CHECK_RPC_ADDRESS"
 )
 
 const (
@@ -50,6 +58,10 @@ const (
 
 	cfgFileName  = "config"
 	cfgExtension = "toml"
+
+	healthCheckFileName    = "upgrade-health-check.json"
+	upgradeFailureFileName = "upgrade-failure.json"
+	manifestCacheFileName  = "upgrade-manifest.json"
 )
 
 // Config is the information passed in to control the daemon
@@ -71,6 +83,12 @@ type Config struct {
 	TimeFormatLogs           string        `toml:"cosmovisor_timeformat_logs" mapstructure:"cosmovisor_timeformat_logs" default:"kitchen"`
 	CustomPreUpgrade         string        `toml:"cosmovisor_custom_preupgrade" mapstructure:"cosmovisor_custom_preupgrade" default:""`
 	DisableRecase            bool          `toml:"cosmovisor_disable_recase" mapstructure:"cosmovisor_disable_recase" default:"false"`
+	UpgradeManifest          string        `toml:"cosmovisor_upgrade_manifest" mapstructure:"cosmovisor_upgrade_manifest" default:""`
+	UpgradeManifestPubKey    string        `toml:"cosmovisor_upgrade_manifest_pubkey" mapstructure:"cosmovisor_upgrade_manifest_pubkey" default:""`
+	ManifestChainID          string        `toml:"cosmovisor_manifest_chain_id" mapstructure:"cosmovisor_manifest_chain_id" default:""`
+	HealthCheckBlocks        int           `toml:"cosmovisor_health_check_blocks" mapstructure:"cosmovisor_health_check_blocks" default:"0"`
+	HealthCheckWindow        time.Duration `toml:"cosmovisor_health_check_window" mapstructure:"cosmovisor_health_check_window" default:"10m"`
+	HealthCheckRPCAddress    string        `toml:"cosmovisor_health_check_rpc_address" mapstructure:"cosmovisor_health_check_rpc_address" default:"http://localhost:26657"`
 
 	// currently running upgrade
 	currentUpgrade upgradetypes.Plan
@@ -117,6 +135,25 @@ func (cfg *Config) UpgradeInfoBatchFilePath() string {
 	return cfg.UpgradeInfoFilePath() + ".batch"
 }
 
+// HealthCheckFilePath is the file holding the health check of the last upgrade,
+// until it passes or fails.
+func (cfg *Config) HealthCheckFilePath() string {
+	return filepath.Join(cfg.Root(), healthCheckFileName)
+}
+
+// UpgradeFailureFilePath is the report of the last upgrade which failed its
+// health check and was rolled back.
+func (cfg *Config) UpgradeFailureFilePath() string {
+	return filepath.Join(cfg.Root(), upgradeFailureFileName)
+}
+
+// UpgradeManifestCacheFilePath is the copy of the upgrade manifest verified by
+// the last prefetch, used at upgrade time. Its signature, if any, is cached
+// along with it with a .sig suffix.
+func (cfg *Config) UpgradeManifestCacheFilePath() string {
+	return filepath.Join(cfg.Root(), manifestCacheFileName)
+}
+
 // SymLinkToGenesis creates a symbolic link from "./current" to the genesis directory.
 func (cfg *Config) SymLinkToGenesis() (string, error) {
 	// workdir is set to cosmovisor directory so relative
@@ -217,10 +254,13 @@ func GetConfigFromFile(filePath string) (*Config, error) {
 func GetConfigFromEnv(skipValidate bool) (*Config, error) {
 	var errs []error
 	cfg := &Config{
-		Home:             os.Getenv(EnvHome),
-		Name:             os.Getenv(EnvName),
-		DataBackupPath:   os.Getenv(EnvDataBackupPath),
-		CustomPreUpgrade: os.Getenv(EnvCustomPreupgrade),
+		Home:                  os.Getenv(EnvHome),
+		Name:                  os.Getenv(EnvName),
+		DataBackupPath:        os.Getenv(EnvDataBackupPath),
+		CustomPreUpgrade:      os.Getenv(EnvCustomPreupgrade),
+		UpgradeManifest:       os.Getenv(EnvUpgradeManifest),
+		UpgradeManifestPubKey: os.Getenv(EnvUpgradeManifestPubKey),
+		ManifestChainID:       os.Getenv(EnvManifestChainID),
 	}
 
 	if cfg.DataBackupPath == "" {
@@ -297,6 +337,26 @@ func GetConfigFromEnv(skipValidate bool) (*Config, error) {
 		cfg.GRPCAddress = "localhost:9090"
 	}
 
+	envHealthCheckBlocksVal := os.Getenv(EnvHealthCheckBlocks)
+	if cfg.HealthCheckBlocks, err = strconv.Atoi(envHealthCheckBlocksVal); err != nil && envHealthCheckBlocksVal != "" {
+		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvHealthCheckBlocks, err))
+	}
+
+	cfg.HealthCheckWindow = 10 * time.Minute
+	if healthCheckWindow := os.Getenv(EnvHealthCheckWindow); healthCheckWindow != "" {
+		val, err := parseEnvDuration(healthCheckWindow)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid: %s: %w", EnvHealthCheckWindow, err))
+		} else {
+			cfg.HealthCheckWindow = val
+		}
+	}
+
+	cfg.HealthCheckRPCAddress = os.Getenv(EnvHealthCheckRPCAddress)
+	if cfg.HealthCheckRPCAddress == "" {
+		cfg.HealthCheckRPCAddress = "http://localhost:26657"
+	}
+
 	if !skipValidate {
 		errs = append(errs, cfg.validate()...)
 		if len(errs) > 0 {
@@ -360,6 +420,29 @@ func (cfg *Config) validate() []error {
 		}
 	}
 
+	// validate the upgrade manifest public key
+	if cfg.UpgradeManifestPubKey != "" {
+		if cfg.UpgradeManifest == "" {
+			errs = append(errs, fmt.Errorf("%s is set but %s is not", EnvUpgradeManifestPubKey, EnvUpgradeManifest))
+		}
+		if pk, err := base64.StdEncoding.DecodeString(cfg.UpgradeManifestPubKey); err != nil || len(pk) != ed25519.PublicKeySize {
+			errs = append(errs, fmt.Errorf("%s must be a base64 encoded ed25519 public key", EnvUpgradeManifestPubKey))
+		}
+	}
+
+	// validate the upgrade health check
+	if cfg.HealthCheckBlocks < 0 {
+		errs = append(errs, fmt.Errorf("%s must not be negative", EnvHealthCheckBlocks))
+	}
+	if cfg.HealthCheckBlocks > 0 {
+		if cfg.HealthCheckWindow <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be greater than 0", EnvHealthCheckWindow))
+		}
+		if cfg.UnsafeSkipBackup {
+			errs = append(errs, fmt.Errorf("%s requires a data backup to roll back failed upgrades, %s must be false", EnvHealthCheckBlocks, EnvSkipBackup))
+		}
+	}
+
 	// check the DataBackupPath
 	if cfg.UnsafeSkipBackup {
 		return errs
@@ -562,6 +645,12 @@ func (cfg Config) DetailString() string {
 		{EnvTimeFormatLogs, cfg.TimeFormatLogs},
 		{EnvCustomPreupgrade, cfg.CustomPreUpgrade},
 		{EnvDisableRecase, fmt.Sprintf("%t", cfg.DisableRecase)},
+		{EnvUpgradeManifest, cfg.UpgradeManifest},
+		{EnvUpgradeManifestPubKey, cfg.UpgradeManifestPubKey},
+		{EnvManifestChainID, cfg.ManifestChainID},
+		{EnvHealthCheckBlocks, fmt.Sprintf("%d", cfg.HealthCheckBlocks)},
+		{EnvHealthCheckWindow, cfg.HealthCheckWindow.String()},
+		{EnvHealthCheckRPCAddress, cfg.HealthCheckRPCAddress},
 	}
 
 	derivedEntries := []struct{ name, value string }{
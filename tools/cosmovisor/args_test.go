@@ -261,6 +261,22 @@ func (s *argsTestSuite) TestValidate() {
 			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: relPath},
 			valid: false,
 		},
+		"happy with health check": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: absPath, HealthCheckBlocks: 3, HealthCheckWindow: time.Minute},
+			valid: true,
+		},
+		"negative health check blocks": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: absPath, HealthCheckBlocks: -1},
+			valid: false,
+		},
+		"health check without window": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: absPath, HealthCheckBlocks: 3},
+			valid: false,
+		},
+		"health check with skip data backup": {
+			cfg:   Config{Home: absPath, Name: "bind", UnsafeSkipBackup: true, HealthCheckBlocks: 3, HealthCheckWindow: time.Minute},
+			valid: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -479,6 +495,8 @@ var newConfig = func(
 		CustomPreUpgrade:         customPreUpgrade,
 		DisableRecase:            disableRecase,
 		ShutdownGrace:            time.Duration(shutdownGrace),
+		HealthCheckWindow:        10 * time.Minute,
+		HealthCheckRPCAddress:    "http://localhost:26657",
 	}
 }
 
@@ -45,6 +45,23 @@ func addUpgrade(cfg *cosmovisor.Config, force bool, upgradeHeight int64, upgrade
 		return fmt.Errorf("failed to load executable path: %w", err)
 	}
 
+	// refuse binaries which are not listed in the upgrade manifest
+	if cfg.UpgradeManifest != "" {
+		manifest, err := cosmovisor.LoadManifest(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to load upgrade manifest: %w", err)
+		}
+
+		binary, err := manifest.Binary(cfg.ManifestChainID, upgradeName)
+		if err != nil {
+			return err
+		}
+
+		if err := binary.VerifyExecutable(executablePath); err != nil {
+			return fmt.Errorf("refusing unverified upgrade binary: %w", err)
+		}
+	}
+
 	// create upgrade dir
 	upgradeLocation := cfg.UpgradeDir(upgradeName)
 	if err := os.MkdirAll(path.Join(upgradeLocation, "bin"), 0o755); err != nil {
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+func NewPrefetchUpgradesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prefetch-upgrades",
+		Short: "Download and verify the binaries of the upgrades listed in the upgrade manifest",
+		Long: `Download and verify the binaries of all the upgrades of the chain listed in the upgrade manifest.
+The manifest is read from COSMOVISOR_UPGRADE_MANIFEST and, if COSMOVISOR_UPGRADE_MANIFEST_PUBKEY is set,
+its detached signature is verified. Binaries already present are verified against the manifest checksums.`,
+		RunE:         prefetchUpgradesHandler,
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+	}
+
+	return cmd
+}
+
+func prefetchUpgradesHandler(cmd *cobra.Command, _ []string) error {
+	cfg, err := getConfigFromCmd(cmd)
+	if err != nil {
+		return err
+	}
+
+	return cosmovisor.PrefetchUpgrades(cfg.Logger(cmd.OutOrStdout()), cfg)
+}
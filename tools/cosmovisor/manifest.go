@@ -0,0 +1,486 @@
+package cosmovisor
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	neturl "net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-getter"
+
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/x/upgrade/plan"
+)
+
+const (
+	// manifestSignatureSuffix is appended to the manifest location to get the
+	// location of its detached signature.
+	manifestSignatureSuffix = ".sig"
+
+	// checksumPrefix is the prefix of the checksums of the manifest binaries.
+	checksumPrefix = "sha256:"
+
+	manifestFetchTimeout = 30 * time.Second
+
+	// maxManifestSize is the maximum size of a fetched manifest or signature.
+	maxManifestSize = 10 << 20
+)
+
+// Manifest lists the binaries of the planned upgrades, possibly of several chains.
+type Manifest struct {
+	Upgrades []ManifestUpgrade `json:"upgrades"`
+}
+
+// ManifestUpgrade is a planned upgrade of a chain.
+type ManifestUpgrade struct {
+	ChainID string `json:"chain_id,omitempty"`
+	Name    string `json:"name"`
+	Height  int64  `json:"height,omitempty"`
+	// Binaries maps os/arch strings, or "any", to the binary of the upgrade.
+	Binaries map[string]ManifestBinary `json:"binaries"`
+}
+
+// ManifestBinary is the binary of an upgrade for an os/arch.
+type ManifestBinary struct {
+	URL string `json:"url"`
+	// Checksum is the sha256 checksum of the file at URL, as "sha256:<hex>".
+	Checksum string `json:"checksum"`
+	// ExecutableChecksum is the sha256 checksum of the executable, as
+	// "sha256:<hex>", if URL is an archive.
+	ExecutableChecksum string `json:"executable_checksum,omitempty"`
+}
+
+// LoadManifest loads the upgrade manifest configured in cfg. If a public key
+// is configured, the detached signature of the manifest is verified.
+func LoadManifest(cfg *Config) (*Manifest, error) {
+	bz, _, err := fetchManifest(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseManifest(bz)
+}
+
+// fetchManifest fetches the upgrade manifest configured in cfg and, if a public
+// key is configured, its detached signature, which is verified.
+func fetchManifest(cfg *Config) (manifest, sig []byte, err error) {
+	manifest, err = fetch(cfg.UpgradeManifest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch upgrade manifest: %w", err)
+	}
+
+	if cfg.UpgradeManifestPubKey == "" {
+		return manifest, nil, nil
+	}
+
+	sigLocation, err := signatureLocation(cfg.UpgradeManifest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sig, err = fetch(sigLocation)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch upgrade manifest signature: %w", err)
+	}
+
+	if err := VerifyManifestSignature(cfg.UpgradeManifestPubKey, manifest, sig); err != nil {
+		return nil, nil, err
+	}
+
+	return manifest, sig, nil
+}
+
+// LoadCachedManifest loads the copy of the upgrade manifest cached by
+// PrefetchUpgrades. If a public key is configured, the cached signature of the
+// manifest is verified. It returns nil if there is no cached manifest.
+func LoadCachedManifest(cfg *Config) (*Manifest, error) {
+	path := cfg.UpgradeManifestCacheFilePath()
+	bz, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error while reading %s: %w", path, err)
+	}
+
+	if cfg.UpgradeManifestPubKey != "" {
+		sig, err := os.ReadFile(path + manifestSignatureSuffix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cached upgrade manifest signature: %w", err)
+		}
+
+		if err := VerifyManifestSignature(cfg.UpgradeManifestPubKey, bz, sig); err != nil {
+			return nil, fmt.Errorf("cached upgrade manifest: %w", err)
+		}
+	}
+
+	return ParseManifest(bz)
+}
+
+// cacheManifest caches a verified upgrade manifest and its signature, if any.
+func cacheManifest(cfg *Config, manifest, sig []byte) error {
+	path := cfg.UpgradeManifestCacheFilePath()
+	if sig == nil {
+		if err := os.Remove(path + manifestSignatureSuffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	} else if err := os.WriteFile(path+manifestSignatureSuffix, sig, 0o600); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, manifest, 0o600)
+}
+
+// ParseManifest parses and validates a manifest.
+func ParseManifest(bz []byte) (*Manifest, error) {
+	var m Manifest
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("could not parse upgrade manifest: %w", err)
+	}
+
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// Validate checks that the upgrades are unique per chain and that all the
+// binaries have checksums.
+func (m Manifest) Validate() error {
+	seen := make(map[string]bool, len(m.Upgrades))
+	for _, u := range m.Upgrades {
+		if u.Name == "" {
+			return errors.New("upgrade name cannot be empty")
+		}
+
+		key := u.ChainID + "/" + u.Name
+		if seen[key] {
+			return fmt.Errorf("duplicate upgrade %q for chain %q", u.Name, u.ChainID)
+		}
+		seen[key] = true
+
+		if len(u.Binaries) == 0 {
+			return fmt.Errorf("upgrade %q has no binaries", u.Name)
+		}
+
+		for osArch, b := range u.Binaries {
+			if err := b.validate(); err != nil {
+				return fmt.Errorf("invalid %s binary of upgrade %q: %w", osArch, u.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ChainUpgrades returns the upgrades of the given chain.
+func (m Manifest) ChainUpgrades(chainID string) []ManifestUpgrade {
+	var upgrades []ManifestUpgrade
+	for _, u := range m.Upgrades {
+		if u.ChainID == chainID {
+			upgrades = append(upgrades, u)
+		}
+	}
+
+	return upgrades
+}
+
+// Binary returns the binary of the named upgrade of the chain for the os/arch
+// of the host.
+func (m Manifest) Binary(chainID, upgradeName string) (ManifestBinary, error) {
+	for _, u := range m.ChainUpgrades(chainID) {
+		if u.Name == upgradeName {
+			return u.Binary()
+		}
+	}
+
+	return ManifestBinary{}, fmt.Errorf("upgrade %q of chain %q not found in the upgrade manifest", upgradeName, chainID)
+}
+
+// Binary returns the binary of the upgrade for the os/arch of the host.
+func (u ManifestUpgrade) Binary() (ManifestBinary, error) {
+	b, ok := u.Binaries[OSArch()]
+	if !ok {
+		b, ok = u.Binaries["any"]
+	}
+	if !ok {
+		return ManifestBinary{}, fmt.Errorf("cannot find binary of upgrade %q for os/arch: neither %s, nor any", u.Name, OSArch())
+	}
+
+	return b, nil
+}
+
+func (b ManifestBinary) validate() error {
+	if _, err := neturl.ParseRequestURI(b.URL); err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+
+	if _, err := parseChecksum(b.Checksum); err != nil {
+		return err
+	}
+
+	if b.ExecutableChecksum == "" {
+		if isArchive(b.URL) {
+			return fmt.Errorf("executable checksum is required for archive url %s", b.URL)
+		}
+		return nil
+	}
+
+	if _, err := parseChecksum(b.ExecutableChecksum); err != nil {
+		return fmt.Errorf("invalid executable checksum: %w", err)
+	}
+
+	return nil
+}
+
+// isArchive reports whether the file at url is unpacked once downloaded, in
+// which case its checksum is not the checksum of the executable. As go-getter,
+// it honors the archive query parameter and otherwise matches the extension of
+// the url against the supported decompressors.
+func isArchive(url string) bool {
+	u, err := neturl.Parse(url)
+	if err != nil {
+		return false
+	}
+
+	if archive := u.Query().Get("archive"); archive != "" {
+		b, err := strconv.ParseBool(archive)
+		return err != nil || b
+	}
+
+	for ext := range getter.Decompressors {
+		if strings.HasSuffix(u.Path, "."+ext) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DownloadURL returns the URL of the binary with its checksum, so that the
+// download fails if the checksum does not match.
+func (b ManifestBinary) DownloadURL() (string, error) {
+	url, err := neturl.Parse(b.URL)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Query()
+	query.Set("checksum", b.Checksum)
+	url.RawQuery = query.Encode()
+
+	return url.String(), nil
+}
+
+// VerifyExecutable checks that the executable at path is the binary of the
+// manifest. The checksum of the file at URL is only used if URL is not an
+// archive.
+func (b ManifestBinary) VerifyExecutable(path string) error {
+	expected := b.ExecutableChecksum
+	if expected == "" {
+		if isArchive(b.URL) {
+			return fmt.Errorf("executable checksum is required for archive url %s", b.URL)
+		}
+		expected = b.Checksum
+	}
+
+	want, err := parseChecksum(expected)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+
+	if got := h.Sum(nil); !bytes.Equal(got, want) {
+		return fmt.Errorf("checksum of %s is %s%x, expected %s", path, checksumPrefix, got, expected)
+	}
+
+	return nil
+}
+
+// Download downloads the binary into the upgrade directory and verifies the
+// downloaded executable.
+func (b ManifestBinary) Download(cfg *Config, upgradeName string) error {
+	url, err := b.DownloadURL()
+	if err != nil {
+		return err
+	}
+
+	if err := plan.DownloadUpgrade(cfg.UpgradeDir(upgradeName), url, cfg.Name); err != nil {
+		return fmt.Errorf("cannot download binary: %w", err)
+	}
+
+	return b.VerifyExecutable(cfg.UpgradeBin(upgradeName))
+}
+
+// VerifyManifestSignature verifies the detached ed25519 signature of a
+// manifest. The public key and the signature are base64 encoded.
+func VerifyManifestSignature(pubKey string, manifest, sig []byte) error {
+	pk, err := base64.StdEncoding.DecodeString(strings.TrimSpace(pubKey))
+	if err != nil || len(pk) != ed25519.PublicKeySize {
+		return errors.New("invalid upgrade manifest public key: expected a base64 encoded ed25519 public key")
+	}
+
+	sigBz, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sig)))
+	if err != nil {
+		return fmt.Errorf("invalid upgrade manifest signature: %w", err)
+	}
+
+	if !ed25519.Verify(pk, manifest, sigBz) {
+		return errors.New("upgrade manifest signature verification failed")
+	}
+
+	return nil
+}
+
+// parseChecksum parses a "sha256:<hex>" checksum.
+func parseChecksum(checksum string) ([]byte, error) {
+	hexSum, ok := strings.CutPrefix(checksum, checksumPrefix)
+	if !ok {
+		return nil, fmt.Errorf("checksum %q must start with %q", checksum, checksumPrefix)
+	}
+
+	sum, err := hex.DecodeString(hexSum)
+	if err != nil || len(sum) != sha256.Size {
+		return nil, fmt.Errorf("invalid sha256 checksum %q", checksum)
+	}
+
+	return sum, nil
+}
+
+// signatureLocation returns the location of the detached signature of the
+// manifest at location.
+func signatureLocation(location string) (string, error) {
+	if !isURL(location) {
+		return location + manifestSignatureSuffix, nil
+	}
+
+	url, err := neturl.Parse(location)
+	if err != nil {
+		return "", err
+	}
+	url.Path += manifestSignatureSuffix
+
+	return url.String(), nil
+}
+
+// fetch returns the content of the file or http(s) URL at location. The
+// content of a URL is limited to maxManifestSize.
+func fetch(location string) ([]byte, error) {
+	if !isURL(location) {
+		return os.ReadFile(location)
+	}
+
+	client := &http.Client{Timeout: manifestFetchTimeout}
+	resp, err := client.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", location, resp.Status)
+	}
+
+	bz, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) > maxManifestSize {
+		return nil, fmt.Errorf("GET %s: content exceeds %d bytes", location, maxManifestSize)
+	}
+
+	return bz, nil
+}
+
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+// PrefetchUpgrades downloads the binaries of all the upgrades of the chain
+// listed in the upgrade manifest, and verifies the binaries already present.
+// The verified manifest is cached, so that the upgrades do not fetch it.
+func PrefetchUpgrades(logger log.Logger, cfg *Config) error {
+	if cfg.UpgradeManifest == "" {
+		return fmt.Errorf("%s is not set", EnvUpgradeManifest)
+	}
+
+	bz, sig, err := fetchManifest(cfg)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := ParseManifest(bz)
+	if err != nil {
+		return err
+	}
+
+	if err := cacheManifest(cfg, bz, sig); err != nil {
+		return fmt.Errorf("failed to cache upgrade manifest: %w", err)
+	}
+	logger.Info("upgrade manifest cached", "path", cfg.UpgradeManifestCacheFilePath())
+
+	upgrades := manifest.ChainUpgrades(cfg.ManifestChainID)
+	if len(upgrades) == 0 {
+		logger.Info("no upgrades found in the upgrade manifest", "chain_id", cfg.ManifestChainID)
+		return nil
+	}
+
+	var errs []error
+	for _, u := range upgrades {
+		if err := prefetchUpgrade(logger, cfg, u); err != nil {
+			errs = append(errs, fmt.Errorf("upgrade %q: %w", u.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// prefetchUpgrade downloads the binary of the upgrade if it is not present, and
+// verifies it.
+func prefetchUpgrade(logger log.Logger, cfg *Config, u ManifestUpgrade) error {
+	binary, err := u.Binary()
+	if err != nil {
+		return err
+	}
+
+	if err := plan.EnsureBinary(cfg.UpgradeBin(u.Name)); err == nil {
+		if err := binary.VerifyExecutable(cfg.UpgradeBin(u.Name)); err != nil {
+			return fmt.Errorf("unverified upgrade binary: %w", err)
+		}
+
+		logger.Info("upgrade binary already present and verified", "name", u.Name)
+		return nil
+	}
+
+	logger.Info("downloading upgrade binary", "name", u.Name, "height", u.Height, "url", binary.URL)
+	if err := binary.Download(cfg, u.Name); err != nil {
+		return err
+	}
+
+	logger.Info("upgrade binary downloaded and verified", "name", u.Name)
+	return nil
+}
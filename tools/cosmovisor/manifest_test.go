@@ -0,0 +1,335 @@
+//go:build darwin || linux
+
+package cosmovisor_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+	"cosmossdk.io/tools/cosmovisor"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	// sha256sum ./testdata/repo/raw_binary/autod
+	rawBinaryChecksum = "sha256:e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d"
+	// sha256sum ./testdata/repo/chain3-zip_dir/autod.zip
+	zipDirChecksum = "sha256:8951f52a0aea8617de0ae459a20daf704c29d259c425e60d520e363df0f166b4"
+	// sha256sum ./testdata/repo/chain3-zip_dir/bin/autod
+	zipDirExecutableChecksum = "sha256:2fd4b7e56d3112e4eec41361c555e361a3b70a9d34ce3562603038140ff7599d"
+	invalidChecksum          = "sha256:73e2bd6cbb99261733caf137015d5cc58e3f96248d8b01da68be8564989dd906"
+)
+
+func TestParseManifest(t *testing.T) {
+	cases := map[string]struct {
+		manifest string
+		expErr   string
+	}{
+		"valid": {
+			manifest: `{"upgrades":[{"chain_id":"test","name":"v2","height":100,"binaries":{"any":{"url":"https://example.com/v2","checksum":"` + rawBinaryChecksum + `"}}}]}`,
+		},
+		"same upgrade on two chains": {
+			manifest: `{"upgrades":[
+				{"chain_id":"a","name":"v2","binaries":{"any":{"url":"https://example.com/v2","checksum":"` + rawBinaryChecksum + `"}}},
+				{"chain_id":"b","name":"v2","binaries":{"any":{"url":"https://example.com/v2","checksum":"` + rawBinaryChecksum + `"}}}
+			]}`,
+		},
+		"unknown field": {
+			manifest: `{"upgrades":[],"foo":1}`,
+			expErr:   "unknown field",
+		},
+		"missing name": {
+			manifest: `{"upgrades":[{"binaries":{"any":{"url":"https://example.com/v2","checksum":"` + rawBinaryChecksum + `"}}}]}`,
+			expErr:   "upgrade name cannot be empty",
+		},
+		"duplicate upgrade": {
+			manifest: `{"upgrades":[
+				{"name":"v2","binaries":{"any":{"url":"https://example.com/v2","checksum":"` + rawBinaryChecksum + `"}}},
+				{"name":"v2","binaries":{"any":{"url":"https://example.com/v2","checksum":"` + rawBinaryChecksum + `"}}}
+			]}`,
+			expErr: "duplicate upgrade",
+		},
+		"no binaries": {
+			manifest: `{"upgrades":[{"name":"v2"}]}`,
+			expErr:   "has no binaries",
+		},
+		"missing checksum": {
+			manifest: `{"upgrades":[{"name":"v2","binaries":{"any":{"url":"https://example.com/v2"}}}]}`,
+			expErr:   "must start with",
+		},
+		"invalid checksum": {
+			manifest: `{"upgrades":[{"name":"v2","binaries":{"any":{"url":"https://example.com/v2","checksum":"sha256:abcd"}}}]}`,
+			expErr:   "invalid sha256 checksum",
+		},
+		"invalid executable checksum": {
+			manifest: `{"upgrades":[{"name":"v2","binaries":{"any":{"url":"https://example.com/v2","checksum":"` + rawBinaryChecksum + `","executable_checksum":"abcd"}}}]}`,
+			expErr:   "invalid executable checksum",
+		},
+		"archive without executable checksum": {
+			manifest: `{"upgrades":[{"name":"v2","binaries":{"any":{"url":"https://example.com/v2.tar.gz","checksum":"` + rawBinaryChecksum + `"}}}]}`,
+			expErr:   "executable checksum is required",
+		},
+		"archive query without executable checksum": {
+			manifest: `{"upgrades":[{"name":"v2","binaries":{"any":{"url":"https://example.com/v2?archive=zip","checksum":"` + rawBinaryChecksum + `"}}}]}`,
+			expErr:   "executable checksum is required",
+		},
+		"archive with executable checksum": {
+			manifest: `{"upgrades":[{"name":"v2","binaries":{"any":{"url":"https://example.com/v2.zip","checksum":"` + zipDirChecksum + `","executable_checksum":"` + zipDirExecutableChecksum + `"}}}]}`,
+		},
+		"raw binary with archive disabled": {
+			manifest: `{"upgrades":[{"name":"v2","binaries":{"any":{"url":"https://example.com/v2.zip?archive=false","checksum":"` + rawBinaryChecksum + `"}}}]}`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := cosmovisor.ParseManifest([]byte(tc.manifest))
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManifestBinary(t *testing.T) {
+	m, err := cosmovisor.ParseManifest([]byte(fmt.Sprintf(`{"upgrades":[
+		{"chain_id":"a","name":"v2","binaries":{"%s":{"url":"https://example.com/a","checksum":"%s"},"any":{"url":"https://example.com/any","checksum":"%s"}}},
+		{"chain_id":"b","name":"v2","binaries":{"any":{"url":"https://example.com/b","checksum":"%s"}}}
+	]}`, cosmovisor.OSArch(), rawBinaryChecksum, rawBinaryChecksum, rawBinaryChecksum)))
+	require.NoError(t, err)
+
+	b, err := m.Binary("a", "v2")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/a", b.URL)
+
+	b, err = m.Binary("b", "v2")
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/b", b.URL)
+
+	url, err := b.DownloadURL()
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/b?checksum=sha256%3Ae6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d", url)
+
+	_, err = m.Binary("c", "v2")
+	require.ErrorContains(t, err, "not found in the upgrade manifest")
+
+	require.NoError(t, b.VerifyExecutable(filepath.Join(workDir, "testdata/repo/raw_binary/autod")))
+	require.ErrorContains(t, b.VerifyExecutable(filepath.Join(workDir, "testdata/repo/chain3-zip_dir/bin/autod")), "expected "+rawBinaryChecksum)
+}
+
+func TestLoadManifest(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPubKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	manifest := []byte(`{"upgrades":[{"name":"v2","binaries":{"any":{"url":"https://example.com/v2","checksum":"` + rawBinaryChecksum + `"}}}]}`)
+	sig := base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, manifest))
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "manifest.json")
+	require.NoError(t, os.WriteFile(path, manifest, 0o600))
+
+	// the signature is not checked without a public key
+	m, err := cosmovisor.LoadManifest(&cosmovisor.Config{UpgradeManifest: path})
+	require.NoError(t, err)
+	require.Len(t, m.Upgrades, 1)
+
+	cfg := &cosmovisor.Config{
+		UpgradeManifest:       path,
+		UpgradeManifestPubKey: base64.StdEncoding.EncodeToString(pubKey),
+	}
+	_, err = cosmovisor.LoadManifest(cfg)
+	require.ErrorContains(t, err, "failed to fetch upgrade manifest signature")
+
+	require.NoError(t, os.WriteFile(path+".sig", []byte(sig+"\n"), 0o600))
+	_, err = cosmovisor.LoadManifest(cfg)
+	require.NoError(t, err)
+
+	cfg.UpgradeManifestPubKey = base64.StdEncoding.EncodeToString(otherPubKey)
+	_, err = cosmovisor.LoadManifest(cfg)
+	require.ErrorContains(t, err, "signature verification failed")
+
+	// a tampered manifest is refused
+	cfg.UpgradeManifestPubKey = base64.StdEncoding.EncodeToString(pubKey)
+	require.NoError(t, os.WriteFile(path, append(manifest, ' '), 0o600))
+	_, err = cosmovisor.LoadManifest(cfg)
+	require.ErrorContains(t, err, "signature verification failed")
+
+	// an oversized manifest is refused
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(make([]byte, 10<<20+1))
+	}))
+	defer srv.Close()
+
+	_, err = cosmovisor.LoadManifest(&cosmovisor.Config{UpgradeManifest: srv.URL + "/manifest.json"})
+	require.ErrorContains(t, err, "content exceeds")
+}
+
+func TestUpgradeBinaryFromManifest(t *testing.T) {
+	logger := log.NewNopLogger()
+
+	cases := map[string]struct {
+		binary  string
+		upgrade string
+		expErr  string
+	}{
+		"raw binary": {
+			binary:  fmt.Sprintf(`{"url":"%s/testdata/repo/raw_binary/autod","checksum":"%s"}`, workDir, rawBinaryChecksum),
+			upgrade: "amazonas",
+		},
+		"zipped directory": {
+			binary:  fmt.Sprintf(`{"url":"%s/testdata/repo/chain3-zip_dir/autod.zip","checksum":"%s","executable_checksum":"%s"}`, workDir, zipDirChecksum, zipDirExecutableChecksum),
+			upgrade: "amazonas",
+		},
+		"invalid checksum": {
+			binary:  fmt.Sprintf(`{"url":"%s/testdata/repo/raw_binary/autod","checksum":"%s"}`, workDir, invalidChecksum),
+			upgrade: "amazonas",
+			expErr:  "cannot download binary",
+		},
+		"invalid executable checksum": {
+			binary:  fmt.Sprintf(`{"url":"%s/testdata/repo/chain3-zip_dir/autod.zip","checksum":"%s","executable_checksum":"%s"}`, workDir, zipDirChecksum, invalidChecksum),
+			upgrade: "amazonas",
+			expErr:  "expected " + invalidChecksum,
+		},
+		"upgrade not in manifest": {
+			binary:  fmt.Sprintf(`{"url":"%s/testdata/repo/raw_binary/autod","checksum":"%s"}`, workDir, rawBinaryChecksum),
+			upgrade: "other",
+			expErr:  "not found in the upgrade manifest",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			manifestPath := filepath.Join(t.TempDir(), "manifest.json")
+			manifest := fmt.Sprintf(`{"upgrades":[{"chain_id":"test","name":"amazonas","binaries":{"any":%s}}]}`, tc.binary)
+			require.NoError(t, os.WriteFile(manifestPath, []byte(manifest), 0o600))
+
+			cfg := prepareConfig(
+				t,
+				fmt.Sprintf("%s/%s", workDir, "testdata/download"),
+				cosmovisor.Config{
+					Name:                  "autod",
+					AllowDownloadBinaries: true,
+					UpgradeManifest:       manifestPath,
+					ManifestChainID:       "test",
+				},
+			)
+
+			err := cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: tc.upgrade})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			currentBin, err := cfg.CurrentBin()
+			require.NoError(t, err)
+			require.Equal(t, cfg.UpgradeBin(tc.upgrade), currentBin)
+
+			// prefetching verifies the binary already present
+			require.NoError(t, cosmovisor.PrefetchUpgrades(logger, cfg))
+		})
+	}
+}
+
+func TestPrefetchUpgrades(t *testing.T) {
+	logger := log.NewNopLogger()
+	manifestPath := filepath.Join(t.TempDir(), "manifest.json")
+	manifest := fmt.Sprintf(`{"upgrades":[
+		{"chain_id":"test","name":"v2","height":100,"binaries":{"any":{"url":"%[1]s/testdata/repo/raw_binary/autod","checksum":"%[2]s"}}},
+		{"chain_id":"test","name":"v3","height":200,"binaries":{"any":{"url":"%[1]s/testdata/repo/raw_binary/autod","checksum":"%[3]s"}}},
+		{"chain_id":"other","name":"v4","binaries":{"any":{"url":"%[1]s/testdata/repo/raw_binary/autod","checksum":"%[2]s"}}}
+	]}`, workDir, rawBinaryChecksum, invalidChecksum)
+	require.NoError(t, os.WriteFile(manifestPath, []byte(manifest), 0o600))
+
+	cfg := prepareConfig(
+		t,
+		fmt.Sprintf("%s/%s", workDir, "testdata/download"),
+		cosmovisor.Config{
+			Name:            "autod",
+			UpgradeManifest: manifestPath,
+			ManifestChainID: "test",
+		},
+	)
+
+	err := cosmovisor.PrefetchUpgrades(logger, cfg)
+	require.ErrorContains(t, err, `upgrade "v3"`)
+	require.NotContains(t, err.Error(), `upgrade "v2"`)
+
+	_, err = os.Stat(cfg.UpgradeBin("v2"))
+	require.NoError(t, err)
+	_, err = os.Stat(cfg.UpgradeBin("v4"))
+	require.True(t, os.IsNotExist(err))
+
+	// a binary that no longer matches the manifest is reported
+	require.NoError(t, os.Remove(cfg.UpgradeBin("v2")))
+	require.NoError(t, os.WriteFile(cfg.UpgradeBin("v2"), []byte("#!/bin/sh\n"), 0o755))
+	err = cosmovisor.PrefetchUpgrades(logger, cfg)
+	require.ErrorContains(t, err, "unverified upgrade binary")
+}
+
+func TestUpgradeBinaryFromCachedManifest(t *testing.T) {
+	logger := log.NewNopLogger()
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	manifestPath := filepath.Join(t.TempDir(), "manifest.json")
+	manifest := []byte(fmt.Sprintf(`{"upgrades":[{"chain_id":"test","name":"amazonas","binaries":{"any":{"url":"%s/testdata/repo/raw_binary/autod","checksum":"%s"}}}]}`, workDir, rawBinaryChecksum))
+	require.NoError(t, os.WriteFile(manifestPath, manifest, 0o600))
+	sig := base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, manifest))
+	require.NoError(t, os.WriteFile(manifestPath+".sig", []byte(sig), 0o600))
+
+	cfg := prepareConfig(
+		t,
+		fmt.Sprintf("%s/%s", workDir, "testdata/download"),
+		cosmovisor.Config{
+			Name:                  "autod",
+			UpgradeManifest:       manifestPath,
+			UpgradeManifestPubKey: base64.StdEncoding.EncodeToString(pubKey),
+			ManifestChainID:       "test",
+		},
+	)
+
+	m, err := cosmovisor.LoadCachedManifest(cfg)
+	require.NoError(t, err)
+	require.Nil(t, m)
+
+	require.NoError(t, cosmovisor.PrefetchUpgrades(logger, cfg))
+	m, err = cosmovisor.LoadCachedManifest(cfg)
+	require.NoError(t, err)
+	require.Len(t, m.Upgrades, 1)
+
+	// a tampered cached manifest is refused
+	cached, err := os.ReadFile(cfg.UpgradeManifestCacheFilePath())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cfg.UpgradeManifestCacheFilePath(), append(cached, ' '), 0o600))
+	err = cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "amazonas"})
+	require.ErrorContains(t, err, "cached upgrade manifest: upgrade manifest signature verification failed")
+	require.NoError(t, os.WriteFile(cfg.UpgradeManifestCacheFilePath(), cached, 0o600))
+
+	// the upgrade does not fetch the manifest
+	require.NoError(t, os.Remove(manifestPath))
+	require.NoError(t, cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "amazonas"}))
+
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(t, err)
+	require.Equal(t, cfg.UpgradeBin("amazonas"), currentBin)
+
+	// the manifest is fetched for an upgrade the cached manifest does not list
+	err = cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "other"})
+	require.ErrorContains(t, err, "failed to fetch upgrade manifest")
+}
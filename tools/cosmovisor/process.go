@@ -195,11 +195,25 @@ func (l Launcher) Run(args []string, stdin io.Reader, stdout, stderr io.Writer)
 		return false, fmt.Errorf("current binary is invalid: %w", err)
 	}
 
+	// the node is health checked after an upgrade
+	hc, err := loadHealthCheck(l.cfg)
+	if err != nil {
+		return false, err
+	}
+
 	l.logger.Info("running app", "path", bin, "args", args)
 	cmd := exec.Command(bin, args...)
 	cmd.Stdin = stdin
 	cmd.Stdout = stdout
 	cmd.Stderr = stderr
+
+	var output *tailWriter
+	if hc != nil && isStartCommand(args) {
+		output = newTailWriter(healthCheckOutputSize)
+		cmd.Stdout = teeOutput(stdout, output)
+		cmd.Stderr = teeOutput(stderr, output)
+	}
+
 	if err := cmd.Start(); err != nil {
 		return false, fmt.Errorf("launching process %s %s failed: %w", bin, strings.Join(args, " "), err)
 	}
@@ -224,14 +238,34 @@ func (l Launcher) Run(args []string, stdin io.Reader, stdout, stderr io.Writer)
 		}
 	}()
 
-	if needsUpdate, err := l.WaitForUpgradeOrExit(cmd); err != nil || !needsUpdate {
+	var watchdog *upgradeWatchdog
+	if output != nil {
+		watchdog = l.startWatchdog(ctx, *hc, cmd, output)
+	}
+
+	needsUpdate, err := l.WaitForUpgradeOrExit(cmd)
+	if watchdog != nil {
+		if reason := watchdog.stop(ctx, needsUpdate, err); reason != "" {
+			cancel()
+			wg.Wait()
+			return false, l.rollback(watchdog, reason)
+		}
+	}
+
+	if err != nil || !needsUpdate {
 		return false, err
 	}
 
 	if !IsSkipUpgradeHeight(args, l.fw.currentInfo) {
+		// an upgrade which was rolled back is not applied again automatically
+		if err := checkUpgradeFailure(l.cfg, l.fw.currentInfo); err != nil {
+			return false, err
+		}
+
 		l.cfg.WaitRestartDelay()
 
-		if err := l.doBackup(); err != nil {
+		backup, err := l.doBackup()
+		if err != nil {
 			return false, err
 		}
 
@@ -239,6 +273,11 @@ func (l Launcher) Run(args []string, stdin io.Reader, stdout, stderr io.Writer)
 			return false, err
 		}
 
+		previousLink, err := os.Readlink(filepath.Join(l.cfg.Root(), currentLink))
+		if err != nil {
+			return false, fmt.Errorf("error while reading the current link: %w", err)
+		}
+
 		if err := UpgradeBinary(l.logger, l.cfg, l.fw.currentInfo); err != nil {
 			return false, err
 		}
@@ -247,6 +286,13 @@ func (l Launcher) Run(args []string, stdin io.Reader, stdout, stderr io.Writer)
 			return false, err
 		}
 
+		if l.cfg.HealthCheckBlocks > 0 {
+			hc := healthCheck{Upgrade: l.fw.currentInfo, PreviousLink: previousLink, Backup: backup}
+			if err := saveHealthCheck(l.cfg, hc); err != nil {
+				return false, fmt.Errorf("error while scheduling the upgrade health check: %w", err)
+			}
+		}
+
 		return true, nil
 	}
 
@@ -326,22 +372,24 @@ func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 	return true, nil
 }
 
-func (l Launcher) doBackup() error {
+// doBackup backs up the data directory, unless `UNSAFE_SKIP_BACKUP` is set, and
+// returns the backup directory.
+func (l Launcher) doBackup() (string, error) {
 	// take backup if `UNSAFE_SKIP_BACKUP` is not set.
 	if !l.cfg.UnsafeSkipBackup {
 		// check if upgrade-info.json is not empty.
 		var uInfo upgradetypes.Plan
 		upgradeInfoFile, err := os.ReadFile(l.cfg.UpgradeInfoFilePath())
 		if err != nil {
-			return fmt.Errorf("error while reading upgrade-info.json: %w", err)
+			return "", fmt.Errorf("error while reading upgrade-info.json: %w", err)
 		}
 
 		if err = json.Unmarshal(upgradeInfoFile, &uInfo); err != nil {
-			return err
+			return "", err
 		}
 
 		if uInfo.Name == "" {
-			return errors.New("upgrade-info.json is empty")
+			return "", errors.New("upgrade-info.json is empty")
 		}
 
 		// a destination directory, Format YYYY-MM-DD
@@ -353,15 +401,17 @@ func (l Launcher) doBackup() error {
 
 		// copy the $DAEMON_HOME/data to a backup dir
 		if err = copy.Copy(filepath.Join(l.cfg.Home, "data"), dst); err != nil {
-			return fmt.Errorf("error while taking data backup: %w", err)
+			return "", fmt.Errorf("error while taking data backup: %w", err)
 		}
 
 		// backup is done, lets check endtime to calculate total time taken for the backup process
 		et := time.Now()
 		l.logger.Info("backup completed", "backup saved at", dst, "backup completion time", et, "time taken to complete backup", et.Sub(st))
+
+		return dst, nil
 	}
 
-	return nil
+	return "", nil
 }
 
 // doCustomPreUpgrade executes the custom preupgrade script if provided.
@@ -16,6 +16,14 @@ import (
 // We can now make any changes to the underlying directory without interference and leave it
 // in a state, so we can make a proper restart
 func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
+	if err := checkUpgradeFailure(cfg, p); err != nil {
+		return err
+	}
+
+	if cfg.UpgradeManifest != "" {
+		return upgradeBinaryFromManifest(logger, cfg, p)
+	}
+
 	// simplest case is to switch the link
 	err := plan.EnsureBinary(cfg.UpgradeBin(p.Name))
 	if err == nil {
@@ -29,15 +37,8 @@ func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
 	}
 
 	// if the dir is there already, don't download either
-	switch fi, err := os.Stat(cfg.UpgradeDir(p.Name)); {
-	case fi != nil: // The directory exists, do not overwrite.
-		return errors.New("upgrade dir already exists, won't overwrite")
-
-	case os.IsNotExist(err): // In this case the directory doesn't exist, continue below.
-		// Do nothing and we shall download the binary down below.
-
-	default: // Otherwise an unexpected error
-		return fmt.Errorf("unhandled error: %w", err)
+	if err := ensureNoUpgradeDir(cfg, p.Name); err != nil {
+		return err
 	}
 
 	upgradeInfo, err := plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(cfg.DownloadMustHaveChecksum))
@@ -65,6 +66,73 @@ func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
 	return cfg.SetCurrentUpgrade(p)
 }
 
+// upgradeBinaryFromManifest switches to the binary of the upgrade listed in
+// the upgrade manifest, downloading it if allowed. Binaries which are not
+// listed in the manifest, or do not match its checksums, are refused.
+//
+// The manifest cached by the last prefetch is used. It is only fetched if it
+// was not cached, or if the cached copy does not list the upgrade.
+func upgradeBinaryFromManifest(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
+	manifest, err := LoadCachedManifest(cfg)
+	if err != nil {
+		return fmt.Errorf("cannot load cached upgrade manifest: %w", err)
+	}
+
+	var binary ManifestBinary
+	if manifest != nil {
+		binary, err = manifest.Binary(cfg.ManifestChainID, p.Name)
+	}
+	if manifest == nil || err != nil {
+		logger.Warn("upgrade not found in the cached upgrade manifest, fetching the manifest", "name", p.Name, "cache", cfg.UpgradeManifestCacheFilePath())
+		manifest, err = LoadManifest(cfg)
+		if err != nil {
+			return fmt.Errorf("cannot load upgrade manifest: %w", err)
+		}
+
+		binary, err = manifest.Binary(cfg.ManifestChainID, p.Name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := plan.EnsureBinary(cfg.UpgradeBin(p.Name)); err != nil {
+		if !cfg.AllowDownloadBinaries {
+			return fmt.Errorf("binary not present, downloading disabled: %w", err)
+		}
+
+		if err := ensureNoUpgradeDir(cfg, p.Name); err != nil {
+			return err
+		}
+
+		logger.Info("no upgrade binary found, beginning to download it from the upgrade manifest")
+		if err := binary.Download(cfg, p.Name); err != nil {
+			return err
+		}
+		logger.Info("downloading binary complete")
+	}
+
+	if err := binary.VerifyExecutable(cfg.UpgradeBin(p.Name)); err != nil {
+		return fmt.Errorf("refusing unverified upgrade binary: %w", err)
+	}
+
+	return cfg.SetCurrentUpgrade(p)
+}
+
+// ensureNoUpgradeDir returns an error if the directory of the upgrade exists,
+// so that it is not overwritten by a download.
+func ensureNoUpgradeDir(cfg *Config, upgradeName string) error {
+	switch fi, err := os.Stat(cfg.UpgradeDir(upgradeName)); {
+	case fi != nil: // The directory exists, do not overwrite.
+		return errors.New("upgrade dir already exists, won't overwrite")
+
+	case os.IsNotExist(err): // In this case the directory doesn't exist, continue below.
+		return nil
+
+	default: // Otherwise an unexpected error
+		return fmt.Errorf("unhandled error: %w", err)
+	}
+}
+
 func GetBinaryURL(binaries plan.BinaryDownloadURLMap) (string, error) {
 	url, ok := binaries[OSArch()]
 	if !ok {
@@ -0,0 +1,394 @@
+package cosmovisor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/otiai10/copy"
+
+	"cosmossdk.io/log/v2"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	// healthCheckOutputSize is the number of bytes of the app output kept for
+	// the upgrade failure report.
+	healthCheckOutputSize = 8 * 1024
+
+	nodeStatusTimeout = 5 * time.Second
+)
+
+// healthCheck is the pending health check of an upgrade. It is persisted so
+// that the check resumes if cosmovisor is restarted before it completes.
+type healthCheck struct {
+	Upgrade upgradetypes.Plan `json:"upgrade"`
+	// PreviousLink is the target of the current link before the upgrade.
+	PreviousLink string `json:"previous_link"`
+	// Backup is the data backup taken before the upgrade.
+	Backup string `json:"backup"`
+}
+
+// UpgradeFailure is the report of an upgrade which failed its health check.
+type UpgradeFailure struct {
+	Upgrade        upgradetypes.Plan `json:"upgrade"`
+	Reason         string            `json:"reason"`
+	StartedAt      time.Time         `json:"started_at"`
+	FailedAt       time.Time         `json:"failed_at"`
+	LastHeight     int64             `json:"last_height"`
+	RequiredHeight int64             `json:"required_height"`
+	// OutputTail is the end of the output of the app.
+	OutputTail string `json:"output_tail,omitempty"`
+	// FailedData is where the data directory of the failed upgrade was moved.
+	FailedData     string `json:"failed_data,omitempty"`
+	RestoredBackup string `json:"restored_backup,omitempty"`
+	RestoredBinary string `json:"restored_binary,omitempty"`
+	RollbackError  string `json:"rollback_error,omitempty"`
+}
+
+// saveHealthCheck schedules the health check of the upgrade which was just
+// applied.
+func saveHealthCheck(cfg *Config, hc healthCheck) error {
+	bz, err := json.Marshal(hc)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(cfg.HealthCheckFilePath(), bz, 0o600)
+}
+
+// loadHealthCheck returns the pending health check, or nil if there is none.
+func loadHealthCheck(cfg *Config) (*healthCheck, error) {
+	bz, err := os.ReadFile(cfg.HealthCheckFilePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error while reading %s: %w", cfg.HealthCheckFilePath(), err)
+	}
+
+	var hc healthCheck
+	if err := json.Unmarshal(bz, &hc); err != nil {
+		return nil, fmt.Errorf("error while parsing %s: %w", cfg.HealthCheckFilePath(), err)
+	}
+
+	return &hc, nil
+}
+
+// loadUpgradeFailure returns the report of the upgrade which failed its health
+// check, or nil if there is none.
+func loadUpgradeFailure(cfg *Config) (*UpgradeFailure, error) {
+	bz, err := os.ReadFile(cfg.UpgradeFailureFilePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error while reading %s: %w", cfg.UpgradeFailureFilePath(), err)
+	}
+
+	var failure UpgradeFailure
+	if err := json.Unmarshal(bz, &failure); err != nil {
+		return nil, fmt.Errorf("error while parsing %s: %w", cfg.UpgradeFailureFilePath(), err)
+	}
+
+	return &failure, nil
+}
+
+// checkUpgradeFailure returns an error if the upgrade failed its health check
+// and was rolled back. The upgrade is not applied again until an operator
+// removes the upgrade failure report.
+func checkUpgradeFailure(cfg *Config, p upgradetypes.Plan) error {
+	failure, err := loadUpgradeFailure(cfg)
+	if err != nil {
+		return err
+	}
+
+	if failure != nil && failure.Upgrade.Name == p.Name && failure.Upgrade.Height == p.Height {
+		return fmt.Errorf("upgrade %q at height %d failed its health check and was rolled back: %s; remove %s to apply it again",
+			p.Name, p.Height, failure.Reason, cfg.UpgradeFailureFilePath())
+	}
+
+	return nil
+}
+
+// isStartCommand returns true if args start the node. Only the node is health
+// checked, not short-lived commands.
+func isStartCommand(args []string) bool {
+	return len(args) > 0 && args[0] == "start"
+}
+
+// upgradeWatchdog checks that the node commits HealthCheckBlocks blocks from
+// the upgrade height within HealthCheckWindow, and kills the app otherwise.
+type upgradeWatchdog struct {
+	logger log.Logger
+	cfg    *Config
+	check  healthCheck
+	output *tailWriter
+
+	startedAt      time.Time
+	requiredHeight int64
+	cancel         context.CancelFunc
+	done           chan struct{}
+
+	// set by run, read once done is closed
+	lastHeight int64
+	passed     bool
+	timedOut   bool
+}
+
+// startWatchdog starts the health check of the app run by cmd.
+func (l Launcher) startWatchdog(ctx context.Context, hc healthCheck, cmd *exec.Cmd, output *tailWriter) *upgradeWatchdog {
+	ctx, cancel := context.WithCancel(ctx)
+	w := &upgradeWatchdog{
+		logger:         l.logger,
+		cfg:            l.cfg,
+		check:          hc,
+		output:         output,
+		startedAt:      time.Now(),
+		requiredHeight: hc.Upgrade.Height + int64(l.cfg.HealthCheckBlocks) - 1,
+		cancel:         cancel,
+		done:           make(chan struct{}),
+	}
+
+	l.logger.Info("checking upgrade health", "name", hc.Upgrade.Name, "required height", w.requiredHeight, "window", l.cfg.HealthCheckWindow)
+	go w.run(ctx, cmd)
+
+	return w
+}
+
+func (w *upgradeWatchdog) run(ctx context.Context, cmd *exec.Cmd) {
+	defer close(w.done)
+
+	deadline := time.NewTimer(w.cfg.HealthCheckWindow)
+	defer deadline.Stop()
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-deadline.C:
+			w.timedOut = true
+			w.logger.Error("upgrade health check timed out, killing app", "name", w.check.Upgrade.Name, "last height", w.lastHeight, "required height", w.requiredHeight)
+			_ = cmd.Process.Kill()
+			return
+		case <-ticker.C:
+			height, err := nodeHeight(ctx, w.cfg.HealthCheckRPCAddress)
+			if err != nil {
+				// the node may still be starting
+				continue
+			}
+
+			w.lastHeight = height
+			if height >= w.requiredHeight {
+				w.pass()
+				return
+			}
+		}
+	}
+}
+
+// pass marks the health check of the upgrade as passed.
+func (w *upgradeWatchdog) pass() {
+	w.passed = true
+	if err := os.Remove(w.cfg.HealthCheckFilePath()); err != nil && !os.IsNotExist(err) {
+		w.logger.Warn("error removing the upgrade health check", "error", err)
+	}
+
+	w.logger.Info("upgrade health check passed", "name", w.check.Upgrade.Name, "height", w.lastHeight, "time taken", time.Since(w.startedAt))
+}
+
+// stop stops the watchdog once the app exited, and returns why the upgrade
+// failed its health check, if it did.
+func (w *upgradeWatchdog) stop(ctx context.Context, needsUpdate bool, exitErr error) string {
+	w.cancel()
+	<-w.done
+
+	switch {
+	case w.passed:
+		return ""
+	case w.timedOut:
+		return fmt.Sprintf("%d blocks were not committed within %s", w.cfg.HealthCheckBlocks, w.cfg.HealthCheckWindow)
+	case ctx.Err() != nil:
+		// cosmovisor was stopped, the health check resumes on restart
+		return ""
+	case needsUpdate:
+		// the node reached the next upgrade
+		w.pass()
+		return ""
+	case exitErr != nil:
+		return fmt.Sprintf("app exited before committing %d blocks: %v", w.cfg.HealthCheckBlocks, exitErr)
+	default:
+		return fmt.Sprintf("app exited before committing %d blocks", w.cfg.HealthCheckBlocks)
+	}
+}
+
+// rollback restores the data backup and the binary of before the upgrade
+// which failed its health check, and reports the failure.
+func (l Launcher) rollback(w *upgradeWatchdog, reason string) error {
+	failure := UpgradeFailure{
+		Upgrade:        w.check.Upgrade,
+		Reason:         reason,
+		StartedAt:      w.startedAt,
+		FailedAt:       time.Now(),
+		LastHeight:     w.lastHeight,
+		RequiredHeight: w.requiredHeight,
+		OutputTail:     w.output.String(),
+	}
+
+	l.logger.Error("upgrade failed its health check, rolling back", "name", w.check.Upgrade.Name, "height", w.check.Upgrade.Height, "reason", reason)
+
+	rollbackErr := l.restore(w.check, &failure)
+	if rollbackErr != nil {
+		failure.RollbackError = rollbackErr.Error()
+	}
+
+	if bz, err := json.MarshalIndent(failure, "", "  "); err != nil {
+		l.logger.Error("error marshaling the upgrade failure report", "error", err)
+	} else if err := os.WriteFile(l.cfg.UpgradeFailureFilePath(), bz, 0o600); err != nil {
+		l.logger.Error("error writing the upgrade failure report", "error", err)
+	}
+
+	l.logger.Error("upgrade failure",
+		"name", failure.Upgrade.Name,
+		"reason", failure.Reason,
+		"last height", failure.LastHeight,
+		"required height", failure.RequiredHeight,
+		"failed data", failure.FailedData,
+		"restored backup", failure.RestoredBackup,
+		"restored binary", failure.RestoredBinary,
+		"report", l.cfg.UpgradeFailureFilePath(),
+	)
+
+	if rollbackErr != nil {
+		return fmt.Errorf("upgrade %q failed its health check: %s; rollback failed: %w", w.check.Upgrade.Name, reason, rollbackErr)
+	}
+
+	return fmt.Errorf("upgrade %q failed its health check and was rolled back: %s; see %s", w.check.Upgrade.Name, reason, l.cfg.UpgradeFailureFilePath())
+}
+
+// restore moves the data directory of the failed upgrade aside, restores the
+// data backup and points the current link back to the previous binary.
+func (l Launcher) restore(hc healthCheck, failure *UpgradeFailure) error {
+	if hc.Backup == "" {
+		return errors.New("no data backup to restore")
+	}
+
+	dataDir := filepath.Join(l.cfg.Home, "data")
+	failedData := fmt.Sprintf("%s-failed-%s-%d", dataDir, url.PathEscape(hc.Upgrade.Name), failure.FailedAt.Unix())
+	if err := os.Rename(dataDir, failedData); err != nil {
+		return fmt.Errorf("error while moving the data directory aside: %w", err)
+	}
+	failure.FailedData = failedData
+
+	if err := copy.Copy(hc.Backup, dataDir); err != nil {
+		return fmt.Errorf("error while restoring the data backup: %w", err)
+	}
+	failure.RestoredBackup = hc.Backup
+
+	link := filepath.Join(l.cfg.Root(), currentLink)
+	if err := os.Remove(link); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove existing link: %w", err)
+	}
+	if err := os.Symlink(hc.PreviousLink, link); err != nil {
+		return fmt.Errorf("creating current symlink: %w", err)
+	}
+	l.cfg.currentUpgrade = upgradetypes.Plan{}
+
+	previousDir := hc.PreviousLink
+	if !filepath.IsAbs(previousDir) {
+		previousDir = filepath.Join(l.cfg.Root(), previousDir)
+	}
+	failure.RestoredBinary = filepath.Join(previousDir, "bin", l.cfg.Name)
+
+	return os.Remove(l.cfg.HealthCheckFilePath())
+}
+
+// nodeHeight returns the latest block height of the node, queried from the
+// status endpoint of its CometBFT RPC.
+func nodeHeight(ctx context.Context, rpcAddress string) (int64, error) {
+	if addr, ok := strings.CutPrefix(rpcAddress, "tcp://"); ok {
+		rpcAddress = "http://" + addr
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, nodeStatusTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(rpcAddress, "/")+"/status", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("GET %s: %s", req.URL, resp.Status)
+	}
+
+	var status struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return 0, fmt.Errorf("could not parse node status: %w", err)
+	}
+
+	return strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
+
+// tailWriter keeps the last bytes written to it.
+type tailWriter struct {
+	mu   sync.Mutex
+	size int
+	buf  []byte
+}
+
+func newTailWriter(size int) *tailWriter {
+	return &tailWriter{size: size}
+}
+
+func (t *tailWriter) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.buf = append(t.buf, p...)
+	if extra := len(t.buf) - t.size; extra > 0 {
+		t.buf = append(t.buf[:0], t.buf[extra:]...)
+	}
+
+	return len(p), nil
+}
+
+func (t *tailWriter) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return string(t.buf)
+}
+
+// teeOutput returns a writer writing to w, if not nil, and to tail.
+func teeOutput(w io.Writer, tail *tailWriter) io.Writer {
+	if w == nil {
+		return tail
+	}
+
+	return io.MultiWriter(w, tail)
+}
@@ -0,0 +1,229 @@
+//go:build linux || darwin
+
+package cosmovisor_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log/v2"
+	"cosmossdk.io/tools/cosmovisor"
+)
+
+// newStatusServer returns a CometBFT RPC server reporting the given height.
+func newStatusServer(t *testing.T, height *atomic.Int64) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/status" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":-1,"result":{"sync_info":{"latest_block_height":"%d"}}}`, height.Load())
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// upgradeWithHealthCheck runs the genesis binary of testdata/validate until
+// it upgrades to chain2 at height 49, scheduling the health check of chain2.
+func upgradeWithHealthCheck(t *testing.T, cfg *cosmovisor.Config) cosmovisor.Launcher {
+	t.Helper()
+
+	launcher, err := cosmovisor.NewLauncher(log.NewTestLogger(t), cfg)
+	require.NoError(t, err)
+
+	upgradeFile := cfg.UpgradeInfoFilePath()
+	doUpgrade, err := launcher.Run([]string{"start", "--home", cfg.Home, upgradeFile}, nil, newBuffer(), newBuffer())
+	require.NoError(t, err)
+	require.True(t, doUpgrade)
+
+	_, err = os.Stat(cfg.HealthCheckFilePath())
+	require.NoError(t, err)
+
+	// the new binary writes to the data directory
+	require.NoError(t, os.WriteFile(filepath.Join(cfg.Home, "data", "chain2"), []byte("state"), 0o600))
+
+	return launcher
+}
+
+func TestUpgradeHealthCheck(t *testing.T) {
+	var height atomic.Int64
+	height.Store(48)
+	srv := newStatusServer(t, &height)
+
+	cfg := prepareConfig(
+		t,
+		fmt.Sprintf("%s/%s", workDir, "testdata/validate"),
+		cosmovisor.Config{
+			Name:                  "dummyd",
+			PollInterval:          20 * time.Millisecond,
+			HealthCheckBlocks:     3,
+			HealthCheckWindow:     5 * time.Second,
+			HealthCheckRPCAddress: srv.URL,
+		},
+	)
+	cfg.DataBackupPath = cfg.Home
+
+	launcher := upgradeWithHealthCheck(t, cfg)
+
+	// short-lived commands are not health checked
+	doUpgrade, err := launcher.Run([]string{"version"}, nil, newBuffer(), newBuffer())
+	require.NoError(t, err)
+	require.False(t, doUpgrade)
+	_, err = os.Stat(cfg.HealthCheckFilePath())
+	require.NoError(t, err)
+
+	// the chain commits blocks 49, 50 and 51 with the new binary
+	height.Store(51)
+	stdout := newBuffer()
+	doUpgrade, err = launcher.Run([]string{"start", "--home", cfg.Home}, nil, stdout, newBuffer())
+	require.NoError(t, err)
+	require.False(t, doUpgrade)
+	require.Contains(t, stdout.String(), "Chain 2 is live!")
+
+	_, err = os.Stat(cfg.HealthCheckFilePath())
+	require.True(t, os.IsNotExist(err))
+
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(t, err)
+	rPath, err := filepath.EvalSymlinks(cfg.UpgradeBin("chain2"))
+	require.NoError(t, err)
+	require.Equal(t, rPath, currentBin)
+}
+
+func TestUpgradeHealthCheckRollback(t *testing.T) {
+	cases := map[string]struct {
+		window    time.Duration
+		expReason string
+	}{
+		"app exits": {
+			window:    5 * time.Second,
+			expReason: "app exited before committing 3 blocks",
+		},
+		"timeout": {
+			window:    200 * time.Millisecond,
+			expReason: "3 blocks were not committed within 200ms",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var height atomic.Int64
+			height.Store(50)
+			srv := newStatusServer(t, &height)
+
+			cfg := prepareConfig(
+				t,
+				fmt.Sprintf("%s/%s", workDir, "testdata/validate"),
+				cosmovisor.Config{
+					Name:                  "dummyd",
+					PollInterval:          20 * time.Millisecond,
+					HealthCheckBlocks:     3,
+					HealthCheckWindow:     tc.window,
+					HealthCheckRPCAddress: srv.URL,
+				},
+			)
+			cfg.DataBackupPath = cfg.Home
+
+			launcher := upgradeWithHealthCheck(t, cfg)
+
+			_, err := launcher.Run([]string{"start", "--home", cfg.Home}, nil, newBuffer(), newBuffer())
+			require.ErrorContains(t, err, `upgrade "chain2" failed its health check and was rolled back: `+tc.expReason)
+
+			// the binary and the data of before the upgrade are restored
+			currentBin, err := cfg.CurrentBin()
+			require.NoError(t, err)
+			rPath, err := filepath.EvalSymlinks(cfg.GenesisBin())
+			require.NoError(t, err)
+			require.Equal(t, rPath, currentBin)
+
+			_, err = os.Stat(filepath.Join(cfg.Home, "data", "chain2"))
+			require.True(t, os.IsNotExist(err))
+			_, err = os.Stat(cfg.HealthCheckFilePath())
+			require.True(t, os.IsNotExist(err))
+
+			bz, err := os.ReadFile(cfg.UpgradeFailureFilePath())
+			require.NoError(t, err)
+			var failure cosmovisor.UpgradeFailure
+			require.NoError(t, json.Unmarshal(bz, &failure))
+
+			require.Equal(t, "chain2", failure.Upgrade.Name)
+			require.Contains(t, failure.Reason, tc.expReason)
+			require.Equal(t, int64(50), failure.LastHeight)
+			require.Equal(t, int64(51), failure.RequiredHeight)
+			require.Contains(t, failure.OutputTail, "Chain 2 is live!")
+			require.Empty(t, failure.RollbackError)
+			require.Equal(t, filepath.Join(cfg.Root(), "genesis", "bin", cfg.Name), failure.RestoredBinary)
+
+			// the data of the failed upgrade is kept
+			_, err = os.Stat(filepath.Join(failure.FailedData, "chain2"))
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpgradeHealthCheckRestartAfterRollback(t *testing.T) {
+	var height atomic.Int64
+	height.Store(50)
+	srv := newStatusServer(t, &height)
+
+	cfg := prepareConfig(
+		t,
+		fmt.Sprintf("%s/%s", workDir, "testdata/validate"),
+		cosmovisor.Config{
+			Name:                  "dummyd",
+			PollInterval:          20 * time.Millisecond,
+			HealthCheckBlocks:     3,
+			HealthCheckWindow:     5 * time.Second,
+			HealthCheckRPCAddress: srv.URL,
+		},
+	)
+	cfg.DataBackupPath = cfg.Home
+
+	launcher := upgradeWithHealthCheck(t, cfg)
+	_, err := launcher.Run([]string{"start", "--home", cfg.Home}, nil, newBuffer(), newBuffer())
+	require.ErrorContains(t, err, `upgrade "chain2" failed its health check and was rolled back`)
+
+	// on restart, the previous binary halts at the upgrade height again, and the
+	// upgrade which was rolled back is not applied again
+	launcher, err = cosmovisor.NewLauncher(log.NewTestLogger(t), cfg)
+	require.NoError(t, err)
+	upgradeFile := cfg.UpgradeInfoFilePath()
+	doUpgrade, err := launcher.Run([]string{"start", "--home", cfg.Home, upgradeFile}, nil, newBuffer(), newBuffer())
+	require.ErrorContains(t, err, `upgrade "chain2" at height 49 failed its health check and was rolled back`)
+	require.ErrorContains(t, err, "remove "+cfg.UpgradeFailureFilePath()+" to apply it again")
+	require.False(t, doUpgrade)
+
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(t, err)
+	rPath, err := filepath.EvalSymlinks(cfg.GenesisBin())
+	require.NoError(t, err)
+	require.Equal(t, rPath, currentBin)
+	_, err = os.Stat(cfg.HealthCheckFilePath())
+	require.True(t, os.IsNotExist(err))
+
+	// the upgrade is applied again once the operator removes the report
+	require.NoError(t, os.Remove(cfg.UpgradeFailureFilePath()))
+	launcher, err = cosmovisor.NewLauncher(log.NewTestLogger(t), cfg)
+	require.NoError(t, err)
+	doUpgrade, err = launcher.Run([]string{"start", "--home", cfg.Home, upgradeFile}, nil, newBuffer(), newBuffer())
+	require.NoError(t, err)
+	require.True(t, doUpgrade)
+
+	currentBin, err = cfg.CurrentBin()
+	require.NoError(t, err)
+	rPath, err = filepath.EvalSymlinks(cfg.UpgradeBin("chain2"))
+	require.NoError(t, err)
+	require.Equal(t, rPath, currentBin)
+}
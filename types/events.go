@@ -252,6 +252,7 @@ const (
 	AttributeKeySignature       = "signature"
 	AttributeKeyFee             = "fee"
 	AttributeKeyFeePayer        = "fee_payer"
+	AttributeKeyBundleTxIndex   = "bundle_tx_index"
 
 	EventTypeMessage = "message"
 
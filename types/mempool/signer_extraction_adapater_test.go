@@ -24,6 +24,19 @@ func (n nonVerifiableTx) GetMsgsV2() ([]proto.Message, error) {
 	panic("not implemented")
 }
 
+type testBundleTx struct {
+	nonVerifiableTx
+	txs []sdk.Tx
+}
+
+func (b testBundleTx) GetTxs() []sdk.Tx {
+	return b.txs
+}
+
+func (b testBundleTx) GetTxBytes() [][]byte {
+	panic("not implemented")
+}
+
 func TestDefaultSignerExtractor(t *testing.T) {
 	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
 	sa := accounts[0].Address
@@ -56,3 +69,24 @@ func TestDefaultSignerExtractor(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultSignerExtractorBundle(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+	ext := mempool.NewDefaultSignerExtractionAdapter()
+
+	bundle := testBundleTx{txs: []sdk.Tx{
+		testTx{id: 0, nonce: 3, address: sa},
+		testTx{id: 1, nonce: 7, address: sb},
+	}}
+	sigs, err := ext.GetSigners(bundle)
+	require.NoError(t, err)
+	require.Equal(t, []mempool.SignerData{
+		mempool.NewSignerData(sa, 3),
+		mempool.NewSignerData(sb, 7),
+	}, sigs)
+
+	bundle.txs = append(bundle.txs, nonVerifiableTx{})
+	_, err = ext.GetSigners(bundle)
+	require.ErrorContains(t, err, "bundled tx 2")
+}
@@ -35,7 +35,8 @@ type SignerExtractionAdapter interface {
 var _ SignerExtractionAdapter = DefaultSignerExtractionAdapter{}
 
 // DefaultSignerExtractionAdapter is the default implementation of SignerExtractionAdapter. It extracts the signers
-// from a cosmos-sdk tx via GetSignaturesV2.
+// from a cosmos-sdk tx via GetSignaturesV2. The signers of a sdk.BundleTx are the signers of all its bundled
+// transactions, in order, so that a bundle is ordered by the first signer of its first transaction.
 type DefaultSignerExtractionAdapter struct{}
 
 // NewDefaultSignerExtractionAdapter constructs a new DefaultSignerExtractionAdapter instance
@@ -44,7 +45,21 @@ func NewDefaultSignerExtractionAdapter() DefaultSignerExtractionAdapter {
 }
 
 // GetSigners implements the Adapter interface
-func (DefaultSignerExtractionAdapter) GetSigners(tx sdk.Tx) ([]SignerData, error) {
+func (a DefaultSignerExtractionAdapter) GetSigners(tx sdk.Tx) ([]SignerData, error) {
+	if bundleTx, ok := tx.(sdk.BundleTx); ok {
+		var signers []SignerData
+		for i, bundledTx := range bundleTx.GetTxs() {
+			bundledSigners, err := a.GetSigners(bundledTx)
+			if err != nil {
+				return nil, fmt.Errorf("bundled tx %d: %w", i, err)
+			}
+
+			signers = append(signers, bundledSigners...)
+		}
+
+		return signers, nil
+	}
+
 	sigTx, ok := tx.(signing.SigVerifiableTx)
 	if !ok {
 		return nil, fmt.Errorf("tx of type %T does not implement SigVerifiableTx", tx)
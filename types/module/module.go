@@ -649,6 +649,20 @@ type MigrationHandler func(sdk.Context) error
 // VersionMap is a map of moduleName -> version
 type VersionMap map[string]uint64
 
+// MigrationWrapper wraps the migration of a single module run by RunMigrations.
+// It must call migrate, with ctx or a context derived from it, to run the
+// migration. fromVersion is 0 for a new module, which is migrated by running
+// its InitGenesis.
+type MigrationWrapper func(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64, migrate func(sdk.Context) error) error
+
+type migrationWrapperKey struct{}
+
+// WithMigrationWrapper returns a context in which RunMigrations calls the
+// given wrapper around each module migration, e.g. to measure them.
+func WithMigrationWrapper(ctx sdk.Context, wrapper MigrationWrapper) sdk.Context {
+	return ctx.WithValue(migrationWrapperKey{}, wrapper)
+}
+
 // RunMigrations performs in-place store migrations for all modules. This
 // function MUST be called inside an x/upgrade UpgradeHandler.
 //
@@ -728,13 +742,12 @@ func (m Manager) RunMigrations(ctx context.Context, cfg Configurator, fromVM Ver
 		// empty genesis state.
 		// 2. An existing chain is upgrading from version < 0.43 to v0.43+ for the first time.
 		// In this case, all modules have yet to be added to x/upgrade's VersionMap store.
-		if exists {
-			sdkCtx.Logger().Info(fmt.Sprintf("running migrations for module: %s", moduleName))
-			err := c.runModuleMigrations(sdkCtx, moduleName, fromVersion, toVersion)
-			if err != nil {
-				return nil, err
+		migrate := func(sdkCtx sdk.Context) error {
+			if exists {
+				sdkCtx.Logger().Info(fmt.Sprintf("running migrations for module: %s", moduleName))
+				return c.runModuleMigrations(sdkCtx, moduleName, fromVersion, toVersion)
 			}
-		} else {
+
 			sdkCtx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
 			if module, ok := m.Modules[moduleName].(HasGenesis); ok {
 				module.InitGenesis(sdkCtx, c.cdc, module.DefaultGenesis(c.cdc))
@@ -744,9 +757,20 @@ func (m Manager) RunMigrations(ctx context.Context, cfg Configurator, fromVM Ver
 				// The module manager assumes only one module will update the
 				// validator set, and it can't be a new module.
 				if len(moduleValUpdates) > 0 {
-					return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis update is already set by another module")
+					return errorsmod.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis update is already set by another module")
 				}
 			}
+			return nil
+		}
+
+		var err error
+		if wrapper, ok := sdkCtx.Value(migrationWrapperKey{}).(MigrationWrapper); ok && (!exists || fromVersion != toVersion) {
+			err = wrapper(sdkCtx, moduleName, fromVersion, toVersion, migrate)
+		} else {
+			err = migrate(sdkCtx)
+		}
+		if err != nil {
+			return nil, err
 		}
 
 		updatedVM[moduleName] = toVersion
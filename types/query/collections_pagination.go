@@ -20,6 +20,15 @@ func WithCollectionPaginationPairPrefix[K1, K2 any](prefix K1) func(o *Collectio
 	}
 }
 
+// WithCollectionPaginationTripleSuperPrefix applies a super prefix to a collection, whose key is a
+// collection.Triple, being paginated that needs prefixing.
+func WithCollectionPaginationTripleSuperPrefix[K1, K2, K3 any](k1 K1, k2 K2) func(o *CollectionsPaginateOptions[collections.Triple[K1, K2, K3]]) {
+	return func(o *CollectionsPaginateOptions[collections.Triple[K1, K2, K3]]) {
+		prefix := collections.TripleSuperPrefix[K1, K2, K3](k1, k2)
+		o.Prefix = &prefix
+	}
+}
+
 // CollectionsPaginateOptions provides extra options for pagination in collections.
 type CollectionsPaginateOptions[K any] struct {
 	// Prefix allows to optionally set a prefix for the pagination.
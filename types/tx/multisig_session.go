@@ -0,0 +1,87 @@
+package tx
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &MultisigSession{}
+
+// ValidateBasic performs stateless validation of the multisig session.
+func (s *MultisigSession) ValidateBasic() error {
+	if s.Tx == nil || s.Tx.Body == nil || s.Tx.AuthInfo == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("session transaction cannot be empty")
+	}
+
+	if s.ChainId == "" {
+		return sdkerrors.ErrInvalidChainID.Wrap("chain id cannot be empty")
+	}
+
+	if _, err := s.GetLegacyAminoPubKey(); err != nil {
+		return err
+	}
+
+	_, err := s.GetSignaturesV2()
+	return err
+}
+
+// GetLegacyAminoPubKey returns the threshold public key of the multisig account.
+func (s *MultisigSession) GetLegacyAminoPubKey() (*kmultisig.LegacyAminoPubKey, error) {
+	if s.MultisigPubKey == nil {
+		return nil, sdkerrors.ErrInvalidPubKey.Wrap("multisig public key cannot be empty")
+	}
+
+	pk, ok := s.MultisigPubKey.GetCachedValue().(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidPubKey.Wrapf("expected %T, got %T", (*kmultisig.LegacyAminoPubKey)(nil), s.MultisigPubKey.GetCachedValue())
+	}
+
+	return pk, nil
+}
+
+// GetSignaturesV2 returns the member signatures collected in the session.
+func (s *MultisigSession) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	sigs := make([]signing.SignatureV2, len(s.Signatures))
+	for i, desc := range s.Signatures {
+		if desc.PublicKey == nil || desc.Data == nil {
+			return nil, sdkerrors.ErrNoSignatures.Wrapf("signature %d is incomplete", i)
+		}
+
+		pk, ok := desc.PublicKey.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidPubKey.Wrapf("expected %T, got %T", (cryptotypes.PubKey)(nil), desc.PublicKey.GetCachedValue())
+		}
+
+		sigs[i] = signing.SignatureV2{
+			PubKey:   pk,
+			Data:     signing.SignatureDataFromProto(desc.Data),
+			Sequence: desc.Sequence,
+		}
+	}
+
+	return sigs, nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (s *MultisigSession) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if s.Tx != nil {
+		if err := s.Tx.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	if err := unpacker.UnpackAny(s.MultisigPubKey, new(cryptotypes.PubKey)); err != nil {
+		return err
+	}
+
+	for _, sig := range s.Signatures {
+		if err := unpacker.UnpackAny(sig.PublicKey, new(cryptotypes.PubKey)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
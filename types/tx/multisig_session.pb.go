@@ -0,0 +1,595 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/tx/v1beta1/multisig_session.proto
+
+package tx
+
+import (
+	fmt "fmt"
+	signing "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MultisigSession is the intermediary format exchanged between the members of
+// a multisig account while they sign a transaction offline. Each member adds
+// its own signature to the session, and sessions signing the same transaction
+// can be merged in any order. Once the threshold of the multisig is reached,
+// the session is finalized into a signed transaction. A MultisigSession is not a
+// valid tx in itself, and will be rejected by the node if sent directly as-is.
+type MultisigSession struct {
+	// tx is the unsigned transaction to be signed by the multisig account.
+	Tx *Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// chain_id is the chain the transaction is signed for.
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// account_number is the account number of the multisig account.
+	AccountNumber uint64 `protobuf:"varint,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	// sequence is the sequence of the multisig account the transaction is signed with.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// multisig_pub_key is the threshold public key of the multisig account.
+	MultisigPubKey *any.Any `protobuf:"bytes,5,opt,name=multisig_pub_key,json=multisigPubKey,proto3" json:"multisig_pub_key,omitempty"`
+	// signatures are the signatures collected so far, at most one per member key.
+	Signatures []*signing.SignatureDescriptor `protobuf:"bytes,6,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (m *MultisigSession) Reset()         { *m = MultisigSession{} }
+func (m *MultisigSession) String() string { return proto.CompactTextString(m) }
+func (*MultisigSession) ProtoMessage()    {}
+func (*MultisigSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d3ea31382b30ef4, []int{0}
+}
+func (m *MultisigSession) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultisigSession.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultisigSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigSession.Merge(m, src)
+}
+func (m *MultisigSession) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigSession proto.InternalMessageInfo
+
+func (m *MultisigSession) GetTx() *Tx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *MultisigSession) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MultisigSession) GetAccountNumber() uint64 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *MultisigSession) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *MultisigSession) GetMultisigPubKey() *any.Any {
+	if m != nil {
+		return m.MultisigPubKey
+	}
+	return nil
+}
+
+func (m *MultisigSession) GetSignatures() []*signing.SignatureDescriptor {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MultisigSession)(nil), "cosmos.tx.v1beta1.MultisigSession")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/tx/v1beta1/multisig_session.proto", fileDescriptor_1d3ea31382b30ef4)
+}
+
+var fileDescriptor_1d3ea31382b30ef4 = []byte{
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
+	0x18, 0xc5, 0x9b, 0xb4, 0xb7, 0xb7, 0x77, 0xca, 0xad, 0x1a, 0x14, 0xd2, 0x2c, 0x42, 0x10, 0x8a,
+	0xd9, 0x38, 0xa1, 0x75, 0xaf, 0x28, 0x6e, 0x44, 0x2c, 0x92, 0xba, 0x72, 0x13, 0x32, 0xd3, 0x31,
+	0x1d, 0xda, 0xcc, 0xc4, 0xcc, 0x8c, 0x24, 0x6f, 0xe1, 0x53, 0xf8, 0x2c, 0x2e, 0xbb, 0x74, 0x29,
+	0xed, 0x8b, 0x48, 0xf3, 0xa7, 0x16, 0xba, 0x0a, 0xe7, 0xfb, 0x7e, 0x19, 0xce, 0x39, 0x1f, 0x70,
+	0x31, 0x17, 0x31, 0x17, 0x9e, 0xcc, 0xbc, 0xb7, 0x21, 0x22, 0x32, 0x1c, 0x7a, 0xb1, 0x5a, 0x48,
+	0x2a, 0x68, 0x14, 0x08, 0x22, 0x04, 0xe5, 0x0c, 0x26, 0x29, 0x97, 0xdc, 0x38, 0x2a, 0x49, 0x28,
+	0x33, 0x58, 0x91, 0x96, 0xb5, 0xff, 0xb3, 0xcc, 0x4a, 0xdc, 0x3a, 0xfb, 0xdd, 0x09, 0x1a, 0x31,
+	0xca, 0xa2, 0x2d, 0x53, 0xe9, 0x0a, 0xec, 0x47, 0x9c, 0x47, 0x0b, 0xe2, 0x15, 0x0a, 0xa9, 0x17,
+	0x2f, 0x64, 0x79, 0xb9, 0x3a, 0xfd, 0xd0, 0xc1, 0xc1, 0x43, 0xe5, 0x66, 0x52, 0x9a, 0x31, 0x06,
+	0x40, 0x97, 0x99, 0xa9, 0x39, 0x9a, 0xdb, 0x1d, 0x9d, 0xc0, 0x3d, 0x4f, 0xf0, 0x29, 0xf3, 0x75,
+	0x99, 0x19, 0x7d, 0xd0, 0xc1, 0xb3, 0x90, 0xb2, 0x80, 0x4e, 0x4d, 0xdd, 0xd1, 0xdc, 0x7f, 0xfe,
+	0xdf, 0x42, 0xdf, 0x4d, 0x8d, 0x01, 0xe8, 0x85, 0x18, 0x73, 0xc5, 0x64, 0xc0, 0x54, 0x8c, 0x48,
+	0x6a, 0x36, 0x1d, 0xcd, 0x6d, 0xf9, 0xff, 0xab, 0xe9, 0xb8, 0x18, 0x1a, 0x16, 0xe8, 0x08, 0xf2,
+	0xaa, 0x08, 0xc3, 0xc4, 0x6c, 0x15, 0xc0, 0x56, 0x1b, 0x97, 0xe0, 0x70, 0xdb, 0x52, 0xa2, 0x50,
+	0x30, 0x27, 0xb9, 0xf9, 0xa7, 0xb0, 0x74, 0x0c, 0xcb, 0x38, 0xb0, 0x8e, 0x03, 0xaf, 0x59, 0xee,
+	0xf7, 0x6a, 0xfa, 0x51, 0xa1, 0x7b, 0x92, 0x1b, 0x63, 0x00, 0x36, 0x25, 0x84, 0x52, 0xa5, 0x44,
+	0x98, 0x6d, 0xa7, 0xe9, 0x76, 0x47, 0x70, 0x27, 0x4c, 0xdd, 0x50, 0x1d, 0x6a, 0x52, 0xc3, 0xb7,
+	0x44, 0xe0, 0x94, 0x26, 0x92, 0xa7, 0xfe, 0xce, 0x0b, 0x37, 0x57, 0x9f, 0x2b, 0x5b, 0x5b, 0xae,
+	0x6c, 0xed, 0x7b, 0x65, 0x6b, 0xef, 0x6b, 0xbb, 0xb1, 0x5c, 0xdb, 0x8d, 0xaf, 0xb5, 0xdd, 0x78,
+	0x1e, 0x44, 0x54, 0xce, 0x14, 0x82, 0x98, 0xc7, 0x5e, 0x75, 0x91, 0xf2, 0x73, 0x2e, 0xa6, 0x73,
+	0x4f, 0xe6, 0x09, 0xd9, 0x9c, 0x08, 0xb5, 0x0b, 0xbb, 0x17, 0x3f, 0x03, 0x00, 0x17, 0x6e, 0x95,
+	0x15, 0x0f, 0x02, 0x00, 0x00,
+}
+
+func (m *MultisigSession) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigSession) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigSession) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultisigSession(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MultisigPubKey != nil {
+		{
+			size, err := m.MultisigPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultisigSession(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintMultisigSession(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintMultisigSession(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMultisigSession(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultisigSession(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMultisigSession(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMultisigSession(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MultisigSession) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovMultisigSession(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMultisigSession(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovMultisigSession(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovMultisigSession(uint64(m.Sequence))
+	}
+	if m.MultisigPubKey != nil {
+		l = m.MultisigPubKey.Size()
+		n += 1 + l + sovMultisigSession(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovMultisigSession(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMultisigSession(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMultisigSession(x uint64) (n int) {
+	return sovMultisigSession(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MultisigSession) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisigSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigSession: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigSession: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisigSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisigSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisigSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisigSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisigSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisigSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisigSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisigSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisigSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisigSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisigSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MultisigPubKey == nil {
+				m.MultisigPubKey = &any.Any{}
+			}
+			if err := m.MultisigPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisigSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisigSession
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisigSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &signing.SignatureDescriptor{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisigSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisigSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMultisigSession(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMultisigSession
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultisigSession
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultisigSession
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMultisigSession
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMultisigSession
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMultisigSession
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMultisigSession        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMultisigSession          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMultisigSession = fmt.Errorf("proto: unexpected end of group")
+)
@@ -98,6 +98,22 @@ type (
 		GetUnordered() bool
 	}
 
+	// BundleTx extends the Tx interface for transactions carrying several
+	// independently signed transactions. BaseApp executes the bundled
+	// transactions atomically and in order, each of them going through the
+	// AnteHandler and PostHandler on its own. GetMsgs returns the messages of
+	// all the bundled transactions.
+	BundleTx interface {
+		Tx
+
+		// GetTxs returns the bundled transactions in execution order.
+		GetTxs() []Tx
+
+		// GetTxBytes returns the raw bytes of the bundled transactions, in the
+		// same order as GetTxs.
+		GetTxBytes() [][]byte
+	}
+
 	// HasValidateBasic defines a type that has a ValidateBasic method.
 	// ValidateBasic is deprecated and now facultative.
 	// Prefer validating messages directly in the msg server.
@@ -21,6 +21,11 @@ var FeeRecipientModule string
 // the effective fee should be deducted later, and the priority should be returned in abci response.
 type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error)
 
+// FeeConversionHook is called once the fees have been deducted and sent to the fee recipient module.
+// It allows to convert fees collected in alternate denoms to the native denom, e.g. through a swap.
+// An error returned by the hook fails the transaction.
+type FeeConversionHook func(ctx sdk.Context, feeRecipientModule string, fees sdk.Coins) error
+
 // DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee granter (if specified) or first signer of the tx.
 // If the fee payer does not have the funds to pay for the fees, return an InsufficientFunds error.
 // Call next AnteHandler if fees successfully deducted.
@@ -31,6 +36,7 @@ type DeductFeeDecorator struct {
 	feegrantKeeper     FeegrantKeeper
 	txFeeChecker       TxFeeChecker
 	feeRecipientModule string
+	feeConversionHook  FeeConversionHook
 }
 
 func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) DeductFeeDecorator {
@@ -61,6 +67,13 @@ func (dfd DeductFeeDecorator) WithFeeRecipientModule(moduleName string) DeductFe
 	return dfd
 }
 
+// WithFeeConversionHook sets a hook called with the deducted fees once they have
+// been sent to the fee recipient module. A nil hook disables the conversion.
+func (dfd DeductFeeDecorator) WithFeeConversionHook(hook FeeConversionHook) DeductFeeDecorator {
+	dfd.feeConversionHook = hook
+	return dfd
+}
+
 func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -134,6 +147,12 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 		if err != nil {
 			return err
 		}
+
+		if dfd.feeConversionHook != nil {
+			if err := dfd.feeConversionHook(ctx, dfd.feeRecipientModule, fee); err != nil {
+				return errorsmod.Wrap(err, "failed to convert fees")
+			}
+		}
 	}
 
 	events := sdk.Events{
@@ -196,3 +196,48 @@ func TestDeductFees_WithFeeRecipientModule(t *testing.T) {
 		})
 	}
 }
+
+func TestDeductFees_WithFeeConversionHook(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	accs := s.CreateTestAccounts(1)
+
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	var (
+		hookRecipient string
+		hookFees      sdk.Coins
+	)
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, nil, nil).
+		WithFeeConversionHook(func(_ sdk.Context, feeRecipientModule string, fees sdk.Coins) error {
+			hookRecipient, hookFees = feeRecipientModule, fees
+			return nil
+		})
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil).Times(2)
+
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, authtypes.FeeCollectorName, hookRecipient)
+	require.Equal(t, feeAmount, hookFees)
+
+	// an error returned by the hook fails the tx
+	dfd = dfd.WithFeeConversionHook(func(sdk.Context, string, sdk.Coins) error {
+		return sdkerrors.ErrInvalidRequest
+	})
+	antehandler = sdk.ChainAnteDecorators(dfd)
+
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
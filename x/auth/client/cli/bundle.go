@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// GetBundleCommand returns the tx bundle command.
+func GetBundleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle [file_path] [file_path]...",
+		Short: "Bundle transactions signed offline and broadcast them to be executed atomically",
+		Long: strings.TrimSpace(`Bundle transactions signed with the sign command, possibly by
+different parties, and broadcast the bundle to a node. The transactions are executed
+in the given order, and either all of them succeed or none of them is applied. Each
+transaction pays its own fees. The chain must have enabled transaction bundles.
+
+With --generate-only, the bundle is printed as base64 instead of being broadcast.
+
+$ <appd> tx bundle ./alice_signed.json ./bob_signed.json
+`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txs := make([]sdk.Tx, len(args))
+			for i, file := range args {
+				if txs[i], err = authclient.ReadTxFromFile(clientCtx, file); err != nil {
+					return err
+				}
+			}
+
+			bundleTx, err := authtx.NewTxBundle(clientCtx.TxConfig.TxEncoder(), txs...)
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := authtx.BundleTxEncoder(clientCtx.TxConfig.TxEncoder())(bundleTx)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.GenerateOnly {
+				return clientCtx.PrintString(base64.StdEncoding.EncodeToString(txBytes) + "\n")
+			}
+
+			if clientCtx.Offline {
+				return errors.New("cannot broadcast tx during offline mode")
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
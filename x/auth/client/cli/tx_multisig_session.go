@@ -0,0 +1,365 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+const flagSignatures = "signatures"
+
+// GetMultisigSessionCommand returns the multisig-session command and its subcommands,
+// which coordinate the offline signing of a transaction by the members of a multisig account.
+func GetMultisigSessionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig-session",
+		Short: "Coordinate the offline signing of a transaction by the members of a multisig account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`A multisig session is a document shared between the members of a multisig
+account. It holds the transaction to sign, the account number and sequence it is signed
+with, and the signatures collected so far. Members sign the session independently and
+sessions can be merged in any order. Once the threshold is reached, the session is
+finalized into a signed transaction and broadcast.
+
+Example:
+$ %[1]s tx multisig-session init unsigned.json multisig > session.json
+$ %[1]s tx multisig-session sign session.json --from k1 > session_k1.json
+$ %[1]s tx multisig-session sign session.json --from k2 > session_k2.json
+$ %[1]s tx multisig-session merge session_k1.json session_k2.json > session.json
+$ %[1]s tx multisig-session status session.json
+$ %[1]s tx multisig-session finalize session.json
+
+Members sign with the amino-json sign mode.
+`, version.AppName),
+		),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		getMultisigSessionInitCmd(),
+		getMultisigSessionSignCmd(),
+		getMultisigSessionMergeCmd(),
+		getMultisigSessionStatusCmd(),
+		getMultisigSessionFinalizeCmd(),
+	)
+
+	return cmd
+}
+
+func getMultisigSessionInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init [file] [multisig]",
+		Short: "Start a multisig session for a transaction generated offline",
+		Long: `Start a multisig session for the unsigned transaction read from [file], on behalf
+of the multisig key [multisig] (name or address) of the keyring.
+
+The account number and sequence of the multisig account are queried from the chain,
+unless the --offline flag is set in which case --account-number and --sequence are required.
+`,
+		PreRun: preSignCmd,
+		Args:   cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			unsignedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			addr, name, _, err := client.GetFromFields(clientCtx, clientCtx.Keyring, args[1])
+			if err != nil {
+				return fmt.Errorf("error getting multisig account from keybase: %w", err)
+			}
+
+			k, err := getMultisigRecord(clientCtx, name)
+			if err != nil {
+				return err
+			}
+
+			pubKey, err := k.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+			if !ok {
+				return fmt.Errorf("key %s is not a multisig key", name)
+			}
+
+			if !clientCtx.Offline {
+				accNum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+				if err != nil {
+					return err
+				}
+
+				txFactory = txFactory.WithAccountNumber(accNum).WithSequence(seq)
+			}
+
+			session, err := tx.NewMultisigSession(clientCtx, unsignedTx, txFactory.ChainID(), txFactory.AccountNumber(), txFactory.Sequence(), multisigPub)
+			if err != nil {
+				return err
+			}
+
+			return printMultisigSession(cmd, clientCtx, session)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.Flags().MarkHidden(flags.FlagOutput)
+
+	return cmd
+}
+
+func getMultisigSessionSignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [session-file]",
+		Short: "Add signatures to a multisig session",
+		Long: `Add signatures to the multisig session read from [session-file] and print the updated session.
+
+The --from key signs the session if it is a member of the multisig. Signatures generated with
+"tx sign --multisig --signature-only" can be added instead with the --signatures flag.
+
+Unless the --offline flag is set, the account number and sequence of the session are checked
+against the chain before signing.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readMultisigSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			if !clientCtx.Offline {
+				if err := tx.ValidateMultisigSessionAccount(clientCtx, session); err != nil {
+					return err
+				}
+			}
+
+			sigFiles, _ := cmd.Flags().GetStringSlice(flagSignatures)
+			for _, sigFile := range sigFiles {
+				sigs, err := unmarshalSignatureJSON(clientCtx, sigFile)
+				if err != nil {
+					return err
+				}
+
+				if err := tx.AddMultisigSessionSignatures(cmd.Context(), clientCtx, session, sigs...); err != nil {
+					return err
+				}
+			}
+
+			if clientCtx.FromName != "" {
+				txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+				if err != nil {
+					return err
+				}
+
+				if err := tx.SignMultisigSession(cmd.Context(), clientCtx, txFactory, clientCtx.FromName, session); err != nil {
+					return err
+				}
+			} else if len(sigFiles) == 0 {
+				return fmt.Errorf("either --%s or --%s must be set", flags.FlagFrom, flagSignatures)
+			}
+
+			return printMultisigSession(cmd, clientCtx, session)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagSignatures, nil, "Signature files generated with \"tx sign --multisig --signature-only\" to add to the session")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.Flags().MarkHidden(flags.FlagOutput)
+
+	return cmd
+}
+
+func getMultisigSessionMergeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge [session-file] [session-file]...",
+		Short: "Merge the signatures of several multisig sessions signing the same transaction",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sessions := make([]*txtypes.MultisigSession, len(args))
+			for i, file := range args {
+				if sessions[i], err = readMultisigSession(clientCtx, file); err != nil {
+					return err
+				}
+			}
+
+			merged, err := tx.MergeMultisigSessions(cmd.Context(), clientCtx, sessions...)
+			if err != nil {
+				return err
+			}
+
+			return printMultisigSession(cmd, clientCtx, merged)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+func getMultisigSessionStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [session-file]",
+		Short: "Show which members of the multisig signed the session and which ones are missing",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readMultisigSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			status, err := tx.GetMultisigSessionStatus(session)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(status)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getMultisigSessionFinalizeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize [session-file]",
+		Short: "Assemble the multisig signature of a session and broadcast the signed transaction",
+		Long: `Verify the signatures of the multisig session read from [session-file], assemble them
+into the multisig signature and broadcast the signed transaction.
+
+Unless the --offline flag is set, the account number and sequence of the session are checked
+against the chain first. With --generate-only or --offline, the signed transaction is printed
+instead of being broadcast.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			session, err := readMultisigSession(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			if !clientCtx.Offline {
+				if err := tx.ValidateMultisigSessionAccount(clientCtx, session); err != nil {
+					return err
+				}
+			}
+
+			txBuilder, err := tx.FinalizeMultisigSession(cmd.Context(), clientCtx, session)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.GenerateOnly || clientCtx.Offline {
+				json, err := marshalSignatureJSON(clientCtx.TxConfig, txBuilder, false)
+				if err != nil {
+					return err
+				}
+
+				closeFunc, err := setOutputFile(cmd)
+				if err != nil {
+					return err
+				}
+				defer closeFunc()
+
+				cmd.Printf("%s\n", json)
+				return nil
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The signed transaction is written to the given file instead of STDOUT, along with --generate-only")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func readMultisigSession(clientCtx client.Context, filename string) (*txtypes.MultisigSession, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var session txtypes.MultisigSession
+	if err := clientCtx.Codec.UnmarshalJSON(bz, &session); err != nil {
+		return nil, fmt.Errorf("failed to decode multisig session %s: %w", filename, err)
+	}
+
+	if err := session.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid multisig session %s: %w", filename, err)
+	}
+
+	return &session, nil
+}
+
+func printMultisigSession(cmd *cobra.Command, clientCtx client.Context, session *txtypes.MultisigSession) error {
+	json, err := clientCtx.Codec.MarshalJSON(session)
+	if err != nil {
+		return err
+	}
+
+	closeFunc, err := setOutputFile(cmd)
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+
+	cmd.Printf("%s\n", json)
+	return nil
+}
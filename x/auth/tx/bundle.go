@@ -0,0 +1,183 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+	protov2 "google.golang.org/protobuf/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// bundleTxsFieldNumber is the field number of TxBundle.txs. It is used to tell
+// the bytes of a bundle apart from the bytes of a TxRaw without decoding them.
+const bundleTxsFieldNumber protowire.Number = 4
+
+// bundle is the sdk.BundleTx implementation of the protobuf TxBundle. It retains
+// the raw bytes of the bundled transactions along with their decoded form.
+type bundle struct {
+	txs     []sdk.Tx
+	txBytes [][]byte
+}
+
+var (
+	_ sdk.BundleTx = &bundle{}
+	_ sdk.GasTx    = &bundle{}
+)
+
+// NewTxBundle returns a bundle of the given signed transactions, to be executed
+// atomically and in order. The transactions are encoded with the given TxEncoder,
+// and bundles cannot be nested.
+func NewTxBundle(txEncoder sdk.TxEncoder, txs ...sdk.Tx) (sdk.BundleTx, error) {
+	if len(txs) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "a bundle must contain at least one transaction")
+	}
+
+	b := &bundle{
+		txs:     txs,
+		txBytes: make([][]byte, len(txs)),
+	}
+
+	for i, bundledTx := range txs {
+		if _, ok := bundledTx.(sdk.BundleTx); ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bundled tx %d: bundles cannot be nested", i)
+		}
+
+		bz, err := txEncoder(bundledTx)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "bundled tx %d", i)
+		}
+
+		b.txBytes[i] = bz
+	}
+
+	return b, nil
+}
+
+// GetTxs implements sdk.BundleTx.
+func (b *bundle) GetTxs() []sdk.Tx {
+	return b.txs
+}
+
+// GetTxBytes implements sdk.BundleTx.
+func (b *bundle) GetTxBytes() [][]byte {
+	return b.txBytes
+}
+
+// GetMsgs implements sdk.Tx, returning the messages of all the bundled transactions.
+func (b *bundle) GetMsgs() []sdk.Msg {
+	var msgs []sdk.Msg
+	for _, bundledTx := range b.txs {
+		msgs = append(msgs, bundledTx.GetMsgs()...)
+	}
+
+	return msgs
+}
+
+// GetMsgsV2 implements sdk.Tx, returning the messages of all the bundled transactions.
+func (b *bundle) GetMsgsV2() ([]protov2.Message, error) {
+	var msgs []protov2.Message
+	for _, bundledTx := range b.txs {
+		bundledMsgs, err := bundledTx.GetMsgsV2()
+		if err != nil {
+			return nil, err
+		}
+
+		msgs = append(msgs, bundledMsgs...)
+	}
+
+	return msgs, nil
+}
+
+// GetGas implements sdk.GasTx, returning the sum of the gas limits of the bundled
+// transactions.
+func (b *bundle) GetGas() uint64 {
+	var gas uint64
+	for _, bundledTx := range b.txs {
+		if gasTx, ok := bundledTx.(sdk.GasTx); ok {
+			gas += gasTx.GetGas()
+		}
+	}
+
+	return gas
+}
+
+// BundleTxDecoder returns a TxDecoder decoding TxBundle bytes into a sdk.BundleTx,
+// using txDecoder for the bundled transactions. Any other bytes are decoded with
+// txDecoder.
+func BundleTxDecoder(cdc codec.Codec, txDecoder sdk.TxDecoder) sdk.TxDecoder {
+	return func(txBytes []byte) (sdk.Tx, error) {
+		if !isTxBundle(txBytes) {
+			return txDecoder(txBytes)
+		}
+
+		var raw tx.TxBundle
+
+		// reject all unknown proto fields in the root TxBundle
+		err := unknownproto.RejectUnknownFieldsStrict(txBytes, &raw, cdc.InterfaceRegistry())
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		if err := cdc.Unmarshal(txBytes, &raw); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		if len(raw.Txs) == 0 {
+			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "a bundle must contain at least one transaction")
+		}
+
+		b := &bundle{
+			txs:     make([]sdk.Tx, len(raw.Txs)),
+			txBytes: raw.Txs,
+		}
+
+		for i, bz := range raw.Txs {
+			if isTxBundle(bz) {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "bundled tx %d: bundles cannot be nested", i)
+			}
+
+			b.txs[i], err = txDecoder(bz)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "bundled tx %d", i)
+			}
+
+			if _, ok := b.txs[i].(sdk.BundleTx); ok {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "bundled tx %d: bundles cannot be nested", i)
+			}
+		}
+
+		return b, nil
+	}
+}
+
+// BundleTxEncoder returns a TxEncoder encoding a sdk.BundleTx created by NewTxBundle
+// or BundleTxDecoder into TxBundle bytes. Any other transaction is encoded with txEncoder.
+func BundleTxEncoder(txEncoder sdk.TxEncoder) sdk.TxEncoder {
+	return func(theTx sdk.Tx) ([]byte, error) {
+		b, ok := theTx.(*bundle)
+		if !ok {
+			if _, isBundle := theTx.(sdk.BundleTx); isBundle {
+				return nil, fmt.Errorf("expected %T, got %T", &bundle{}, theTx)
+			}
+
+			return txEncoder(theTx)
+		}
+
+		return proto.Marshal(&tx.TxBundle{Txs: b.txBytes})
+	}
+}
+
+// isTxBundle reports whether txBytes start with the TxBundle.txs field, which no
+// TxRaw encoding does.
+func isTxBundle(txBytes []byte) bool {
+	tagNum, wireType, n := protowire.ConsumeTag(txBytes)
+	return n > 0 && tagNum == bundleTxsFieldNumber && wireType == protowire.BytesType
+}
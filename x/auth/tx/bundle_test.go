@@ -0,0 +1,84 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestTxBundleEncodeDecode(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	txCfg, err := NewTxConfigWithOptions(cdc, ConfigOptions{EnableTxBundles: true})
+	require.NoError(t, err)
+
+	newTx := func(memo string, gas uint64) sdk.Tx {
+		builder := txCfg.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(testdata.NewTestMsg()))
+		builder.SetMemo(memo)
+		builder.SetGasLimit(gas)
+		return builder.GetTx()
+	}
+	tx1, tx2 := newTx("first", 100), newTx("second", 200)
+
+	_, err = NewTxBundle(txCfg.TxEncoder())
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	bundleTx, err := NewTxBundle(txCfg.TxEncoder(), tx1, tx2)
+	require.NoError(t, err)
+	require.Len(t, bundleTx.GetMsgs(), 2)
+	require.Equal(t, uint64(300), bundleTx.(sdk.GasTx).GetGas())
+
+	_, err = NewTxBundle(txCfg.TxEncoder(), bundleTx)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	bz, err := txCfg.TxEncoder()(bundleTx)
+	require.NoError(t, err)
+
+	decoded, err := txCfg.TxDecoder()(bz)
+	require.NoError(t, err)
+	decodedBundle, ok := decoded.(sdk.BundleTx)
+	require.True(t, ok)
+	require.Len(t, decodedBundle.GetTxs(), 2)
+	require.Equal(t, "first", decodedBundle.GetTxs()[0].(sdk.TxWithMemo).GetMemo())
+	require.Equal(t, "second", decodedBundle.GetTxs()[1].(sdk.TxWithMemo).GetMemo())
+
+	reencoded, err := txCfg.TxEncoder()(decoded)
+	require.NoError(t, err)
+	require.Equal(t, bz, reencoded)
+
+	// regular transactions are still decoded
+	txBz, err := txCfg.TxEncoder()(tx1)
+	require.NoError(t, err)
+	decoded, err = txCfg.TxDecoder()(txBz)
+	require.NoError(t, err)
+	_, ok = decoded.(sdk.BundleTx)
+	require.False(t, ok)
+
+	// the bytes of a bundle are never a valid TxRaw
+	_, err = DefaultTxDecoder(cdc)(bz)
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+
+	// bundles cannot be nested
+	nestedBz, err := proto.Marshal(&tx.TxBundle{Txs: [][]byte{txBz, bz}})
+	require.NoError(t, err)
+	_, err = txCfg.TxDecoder()(nestedBz)
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+
+	// bundles are rejected when not enabled
+	defaultCfg := NewTxConfig(cdc, DefaultSignModes)
+	_, err = defaultCfg.TxDecoder()(bz)
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+	_, err = defaultCfg.TxEncoder()(bundleTx)
+	require.Error(t, err)
+}
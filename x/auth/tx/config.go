@@ -55,6 +55,10 @@ type ConfigOptions struct {
 	JSONDecoder sdk.TxDecoder
 	// JSONEncoder is the encoder that will be used to encode json transactions.
 	JSONEncoder sdk.TxEncoder
+	// EnableTxBundles wraps the protobuf decoder and encoder with BundleTxDecoder and
+	// BundleTxEncoder, making the TxConfig accept bundles of independently signed
+	// transactions executed atomically by BaseApp.
+	EnableTxBundles bool
 }
 
 // DefaultSignModes are the default sign modes enabled for protobuf transactions.
@@ -173,6 +177,10 @@ func NewTxConfigWithOptions(protoCodec codec.Codec, configOptions ConfigOptions)
 	if configOptions.JSONEncoder == nil {
 		txConfig.jsonEncoder = DefaultJSONTxEncoder(protoCodec)
 	}
+	if configOptions.EnableTxBundles {
+		txConfig.decoder = BundleTxDecoder(protoCodec, txConfig.decoder)
+		txConfig.encoder = BundleTxEncoder(txConfig.encoder)
+	}
 
 	var err error
 	if configOptions.SigningContext == nil {
@@ -23,3 +23,19 @@ func (k MockBankKeeper) UpdateParams(goCtx context.Context, req *bank.MsgUpdateP
 func (k MockBankKeeper) SetSendEnabled(goCtx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) SetDenomCompliance(goCtx context.Context, req *bank.MsgSetDenomCompliance) (*bank.MsgSetDenomComplianceResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetFrozen(goCtx context.Context, req *bank.MsgSetFrozen) (*bank.MsgSetFrozenResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetAllowlisted(goCtx context.Context, req *bank.MsgSetAllowlisted) (*bank.MsgSetAllowlistedResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) Clawback(goCtx context.Context, req *bank.MsgClawback) (*bank.MsgClawbackResponse, error) {
+	return nil, nil
+}
@@ -87,6 +87,37 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms", Varargs: true}},
 				},
+				{
+					RpcMethod:      "DenomCompliance",
+					Use:            "denom-compliance [denom]",
+					Short:          "Query the compliance controls of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "FrozenAddresses",
+					Use:            "frozen-addresses [denom]",
+					Short:          "Query the addresses frozen by the issuer of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "AllowlistedAddresses",
+					Use:            "allowlisted-addresses [denom]",
+					Short:          "Query the addresses allowlisted by the issuer of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "BalanceHistory",
+					Use:            "balance-history [address] [denom]",
+					Short:          "Query the balance of an account for a denom at every height where it changed",
+					Example:        fmt.Sprintf("%s query bank balance-history [address] [denom] --start-height 100 --end-height 200", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "SupplyHistory",
+					Use:            "supply-history [denom]",
+					Short:          "Query the supply of a denom at every height where it changed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -122,6 +153,35 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "SetDenomCompliance",
+					Use:            "set-denom-compliance-proposal [compliance]",
+					Short:          "Submit a proposal to set the issuer and the compliance controls of a denom",
+					Example:        fmt.Sprintf(`%s tx bank set-denom-compliance-proposal '{"denom":"uusd","issuer":"cosmos1...","allowlist_enabled":true}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "compliance"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SetFrozen",
+					Use:            "set-frozen [denom] [address] [frozen]",
+					Short:          "Freeze or unfreeze an address for a denom, as its issuer",
+					Example:        fmt.Sprintf(`%s tx bank set-frozen uusd cosmos1... true --from issuer`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}, {ProtoField: "frozen"}},
+				},
+				{
+					RpcMethod:      "SetAllowlisted",
+					Use:            "set-allowlisted [denom] [address] [allowlisted]",
+					Short:          "Add or remove an address from the allowlist of a denom, as its issuer",
+					Example:        fmt.Sprintf(`%s tx bank set-allowlisted uusd cosmos1... true --from issuer`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}, {ProtoField: "allowlisted"}},
+				},
+				{
+					RpcMethod:      "Clawback",
+					Use:            "clawback [address] [amount]",
+					Short:          "Claw back an amount of a denom from an address into the issuer account",
+					Example:        fmt.Sprintf(`%s tx bank clawback cosmos1... 1000uusd --from issuer`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "amount"}},
+				},
 			},
 		},
 	}
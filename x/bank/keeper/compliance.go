@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// GetDenomCompliance returns the compliance controls of a denom. The second
+// return argument is true iff the denom has an issuer.
+func (k BaseSendKeeper) GetDenomCompliance(ctx context.Context, denom string) (types.DenomCompliance, bool) {
+	compliance, err := k.Compliance.Get(ctx, denom)
+	if err != nil {
+		return types.DenomCompliance{}, false
+	}
+
+	return compliance, true
+}
+
+// GetAllDenomCompliance returns the compliance controls of all the denoms
+// having an issuer.
+func (k BaseSendKeeper) GetAllDenomCompliance(ctx context.Context) []types.DenomCompliance {
+	iter, err := k.Compliance.Iterate(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	compliance, err := iter.Values()
+	if err != nil {
+		panic(err)
+	}
+
+	return compliance
+}
+
+// SetDenomCompliance sets the compliance controls of a denom. An empty issuer
+// removes the compliance controls of the denom, along with its frozen and
+// allowlisted addresses.
+func (k BaseSendKeeper) SetDenomCompliance(ctx context.Context, compliance types.DenomCompliance) error {
+	if err := compliance.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if compliance.Issuer == "" {
+		if err := k.Compliance.Remove(ctx, compliance.Denom); err != nil {
+			return err
+		}
+		if err := k.FrozenAddrs.Clear(ctx, collections.NewPrefixedPairRange[string, sdk.AccAddress](compliance.Denom)); err != nil {
+			return err
+		}
+		if err := k.AllowlistedAddrs.Clear(ctx, collections.NewPrefixedPairRange[string, sdk.AccAddress](compliance.Denom)); err != nil {
+			return err
+		}
+	} else {
+		if _, err := k.ak.AddressCodec().StringToBytes(compliance.Issuer); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid issuer address: %s", err)
+		}
+		if err := k.Compliance.Set(ctx, compliance.Denom, compliance); err != nil {
+			return err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomCompliance,
+			sdk.NewAttribute(types.AttributeKeyDenom, compliance.Denom),
+			sdk.NewAttribute(types.AttributeKeyIssuer, compliance.Issuer),
+			sdk.NewAttribute(types.AttributeKeyAllowlistEnabled, strconv.FormatBool(compliance.AllowlistEnabled)),
+		),
+	)
+
+	return nil
+}
+
+// IsFrozen returns whether an address is frozen for a denom.
+func (k BaseSendKeeper) IsFrozen(ctx context.Context, denom string, addr sdk.AccAddress) bool {
+	has, err := k.FrozenAddrs.Has(ctx, collections.Join(denom, addr))
+	return err == nil && has
+}
+
+// SetFrozen freezes or unfreezes an address for a denom having an issuer. The
+// issuer itself cannot be frozen.
+func (k BaseSendKeeper) SetFrozen(ctx context.Context, denom string, addr sdk.AccAddress, frozen bool) error {
+	compliance, found := k.GetDenomCompliance(ctx, denom)
+	if !found {
+		return types.ErrNoDenomCompliance.Wrap(denom)
+	}
+
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	if frozen {
+		if addrStr == compliance.Issuer {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot freeze the issuer")
+		}
+		err = k.FrozenAddrs.Set(ctx, collections.Join(denom, addr))
+	} else {
+		err = k.FrozenAddrs.Remove(ctx, collections.Join(denom, addr))
+	}
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFrozen,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAddress, addrStr),
+			sdk.NewAttribute(types.AttributeKeyFrozen, strconv.FormatBool(frozen)),
+		),
+	)
+
+	return nil
+}
+
+// IsAllowlisted returns whether an address is allowlisted for a denom.
+func (k BaseSendKeeper) IsAllowlisted(ctx context.Context, denom string, addr sdk.AccAddress) bool {
+	has, err := k.AllowlistedAddrs.Has(ctx, collections.Join(denom, addr))
+	return err == nil && has
+}
+
+// SetAllowlisted adds or removes an address from the allowlist of a denom
+// having an issuer.
+func (k BaseSendKeeper) SetAllowlisted(ctx context.Context, denom string, addr sdk.AccAddress, allowlisted bool) error {
+	if _, found := k.GetDenomCompliance(ctx, denom); !found {
+		return types.ErrNoDenomCompliance.Wrap(denom)
+	}
+
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	if allowlisted {
+		err = k.AllowlistedAddrs.Set(ctx, collections.Join(denom, addr))
+	} else {
+		err = k.AllowlistedAddrs.Remove(ctx, collections.Join(denom, addr))
+	}
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAllowlisted,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAddress, addrStr),
+			sdk.NewAttribute(types.AttributeKeyAllowlisted, strconv.FormatBool(allowlisted)),
+		),
+	)
+
+	return nil
+}
+
+// Clawback moves an amount of a denom having an issuer from the spendable
+// balance of an address into the issuer account. The frozen addresses, the
+// allowlist and the send restrictions do not apply. Module accounts cannot be
+// clawed back from.
+func (k BaseSendKeeper) Clawback(ctx context.Context, addr sdk.AccAddress, amt sdk.Coin) error {
+	if !amt.IsValid() || !amt.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	compliance, found := k.GetDenomCompliance(ctx, amt.Denom)
+	if !found {
+		return types.ErrNoDenomCompliance.Wrap(amt.Denom)
+	}
+
+	issuer, err := k.ak.AddressCodec().StringToBytes(compliance.Issuer)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI); ok {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "cannot claw back from a module account")
+	}
+
+	coins := sdk.NewCoins(amt)
+	if err := k.subUnlockedCoins(ctx, addr, coins); err != nil {
+		return err
+	}
+
+	if err := k.addCoins(ctx, issuer, coins); err != nil {
+		return err
+	}
+
+	k.ensureAccountCreated(ctx, issuer)
+	if err := k.emitSendCoinsEvents(ctx, addr, issuer, coins); err != nil {
+		return err
+	}
+
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyIssuer, compliance.Issuer),
+			sdk.NewAttribute(types.AttributeKeyAddress, addrStr),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+	)
+
+	return nil
+}
+
+// checkCompliance returns an error if a transfer breaks the compliance
+// controls of one of the transferred denoms: neither the sender nor the
+// recipient may be frozen, and the recipient must be allowlisted or the issuer
+// if the allowlist of the denom is enabled.
+func (k BaseSendKeeper) checkCompliance(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		found, err := k.hasDenomCompliance(ctx, coin.Denom)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		compliance, err := k.Compliance.Get(ctx, coin.Denom)
+		if err != nil {
+			return err
+		}
+
+		if k.IsFrozen(ctx, coin.Denom, fromAddr) {
+			return types.ErrAddressFrozen.Wrapf("sender is frozen for %s", coin.Denom)
+		}
+		if k.IsFrozen(ctx, coin.Denom, toAddr) {
+			return types.ErrAddressFrozen.Wrapf("recipient is frozen for %s", coin.Denom)
+		}
+
+		if compliance.AllowlistEnabled && !k.IsAllowlisted(ctx, coin.Denom, toAddr) {
+			toAddrStr, err := k.ak.AddressCodec().BytesToString(toAddr)
+			if err != nil {
+				return err
+			}
+			if toAddrStr != compliance.Issuer {
+				return types.ErrNotAllowlisted.Wrapf("recipient %s is not allowlisted for %s", toAddrStr, coin.Denom)
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasDenomCompliance returns whether a denom has compliance controls. The check
+// is not metered so that transfers of denoms without controls, e.g. every fee
+// payment, do not pay for the feature.
+func (k BaseSendKeeper) hasDenomCompliance(ctx context.Context, denom string) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	return k.Compliance.Has(sdkCtx, denom)
+}
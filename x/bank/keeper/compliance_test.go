@@ -0,0 +1,259 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// setFooCompliance sets accAddrs[3] as the issuer of the foo denom.
+func (suite *KeeperTestSuite) setFooCompliance(allowlistEnabled bool) sdk.AccAddress {
+	issuer := accAddrs[3]
+	suite.Require().NoError(suite.bankKeeper.SetDenomCompliance(suite.ctx, banktypes.NewDenomCompliance(fooDenom, issuer.String(), allowlistEnabled)))
+	return issuer
+}
+
+func (suite *KeeperTestSuite) TestDenomCompliance_Freeze() {
+	ctx := suite.ctx
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(100))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	// denoms without compliance controls cannot be frozen
+	require.ErrorIs(suite.bankKeeper.SetFrozen(ctx, barDenom, accAddrs[1], true), banktypes.ErrNoDenomCompliance)
+
+	issuer := suite.setFooCompliance(false)
+	require.Error(suite.bankKeeper.SetFrozen(ctx, fooDenom, issuer, true))
+
+	// the recipient is frozen
+	require.NoError(suite.bankKeeper.SetFrozen(ctx, fooDenom, accAddrs[1], true))
+	require.True(suite.bankKeeper.IsFrozen(ctx, fooDenom, accAddrs[1]))
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))), banktypes.ErrAddressFrozen)
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10), newBarCoin(10))), banktypes.ErrAddressFrozen)
+
+	// other denoms are not affected
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(10))))
+
+	// the sender is frozen
+	require.NoError(suite.bankKeeper.SetFrozen(ctx, fooDenom, accAddrs[1], false))
+	require.NoError(suite.bankKeeper.SetFrozen(ctx, fooDenom, accAddrs[0], true))
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))), banktypes.ErrAddressFrozen)
+
+	input := banktypes.NewInput(accAddrs[0], sdk.NewCoins(newFooCoin(10)))
+	outputs := []banktypes.Output{banktypes.NewOutput(accAddrs[1], sdk.NewCoins(newFooCoin(10)))}
+	require.ErrorIs(suite.bankKeeper.InputOutputCoins(ctx, input, outputs), banktypes.ErrAddressFrozen)
+
+	require.NoError(suite.bankKeeper.SetFrozen(ctx, fooDenom, accAddrs[0], false))
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, []sdk.AccAddress{accAddrs[1]})
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+	require.Equal(newFooCoin(10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom))
+}
+
+func (suite *KeeperTestSuite) TestDenomCompliance_ModuleToAccount() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	suite.mockMintCoins(mintAcc)
+	require.NoError(suite.bankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(newFooCoin(100))))
+
+	suite.setFooCompliance(false)
+	require.NoError(suite.bankKeeper.SetFrozen(ctx, fooDenom, accAddrs[1], true))
+
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress())
+	require.ErrorIs(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddrs[1], sdk.NewCoins(newFooCoin(10))), banktypes.ErrAddressFrozen)
+
+	// the virtual send paths are restricted as well
+	suite.authKeeper.EXPECT().GetAccount(ctx, mintAcc.GetAddress()).Return(mintAcc).Times(2)
+	require.ErrorIs(suite.bankKeeper.SendCoinsToVirtual(ctx, mintAcc.GetAddress(), accAddrs[1], sdk.NewCoins(newFooCoin(10))), banktypes.ErrAddressFrozen)
+	require.NoError(suite.bankKeeper.SendCoinsToVirtual(ctx, mintAcc.GetAddress(), mintAcc.GetAddress(), sdk.NewCoins(newFooCoin(10))))
+
+	suite.authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress())
+	require.ErrorIs(suite.bankKeeper.SendCoinsFromModuleToAccountVirtual(ctx, minttypes.ModuleName, accAddrs[1], sdk.NewCoins(newFooCoin(10))), banktypes.ErrAddressFrozen)
+
+	suite.mockSendCoinsFromModuleToAccount(mintAcc, accAddrs[2])
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddrs[2], sdk.NewCoins(newFooCoin(10))))
+}
+
+func (suite *KeeperTestSuite) TestDenomCompliance_Allowlist() {
+	ctx := suite.ctx
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	issuer := suite.setFooCompliance(true)
+
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))), banktypes.ErrNotAllowlisted)
+
+	// the issuer can always receive the denom
+	suite.mockSendCoins(ctx, acc0, issuer)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], issuer, sdk.NewCoins(newFooCoin(10))))
+
+	require.NoError(suite.bankKeeper.SetAllowlisted(ctx, fooDenom, accAddrs[1], true))
+	require.True(suite.bankKeeper.IsAllowlisted(ctx, fooDenom, accAddrs[1]))
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+
+	require.NoError(suite.bankKeeper.SetAllowlisted(ctx, fooDenom, accAddrs[1], false))
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))), banktypes.ErrNotAllowlisted)
+
+	// disabling the allowlist lifts the restriction
+	suite.setFooCompliance(false)
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+}
+
+func (suite *KeeperTestSuite) TestMsgDenomCompliance() {
+	ctx := suite.ctx
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	authority := authtypes.NewModuleAddress("gov").String()
+	issuer := accAddrs[3].String()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	// only the authority sets the compliance controls
+	_, err := suite.msgServer.SetDenomCompliance(ctx, banktypes.NewMsgSetDenomCompliance(issuer, banktypes.NewDenomCompliance(fooDenom, issuer, false)))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.msgServer.SetDenomCompliance(ctx, banktypes.NewMsgSetDenomCompliance(authority, banktypes.NewDenomCompliance(fooDenom, "invalid", false)))
+	require.Error(err)
+
+	_, err = suite.msgServer.SetDenomCompliance(ctx, banktypes.NewMsgSetDenomCompliance(authority, banktypes.NewDenomCompliance(fooDenom, issuer, false)))
+	require.NoError(err)
+
+	// only the issuer manages the denom
+	_, err = suite.msgServer.SetFrozen(ctx, banktypes.NewMsgSetFrozen(accAddrs[1].String(), fooDenom, accAddrs[0].String(), true))
+	require.ErrorIs(err, banktypes.ErrNotIssuer)
+
+	_, err = suite.msgServer.SetFrozen(ctx, banktypes.NewMsgSetFrozen(issuer, barDenom, accAddrs[0].String(), true))
+	require.ErrorIs(err, banktypes.ErrNoDenomCompliance)
+
+	_, err = suite.msgServer.SetFrozen(ctx, banktypes.NewMsgSetFrozen(issuer, fooDenom, accAddrs[0].String(), true))
+	require.NoError(err)
+
+	_, err = suite.msgServer.SetAllowlisted(ctx, banktypes.NewMsgSetAllowlisted(issuer, fooDenom, accAddrs[1].String(), true))
+	require.NoError(err)
+
+	// a frozen address can be clawed back from
+	_, err = suite.msgServer.Clawback(ctx, banktypes.NewMsgClawback(accAddrs[1].String(), accAddrs[0].String(), newFooCoin(40)))
+	require.ErrorIs(err, banktypes.ErrNotIssuer)
+
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).Times(2)
+	suite.authKeeper.EXPECT().HasAccount(ctx, accAddrs[3]).Return(true)
+	_, err = suite.msgServer.Clawback(ctx, banktypes.NewMsgClawback(issuer, accAddrs[0].String(), newFooCoin(40)))
+	require.NoError(err)
+	require.Equal(newFooCoin(60), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom))
+	require.Equal(newFooCoin(40), suite.bankKeeper.GetBalance(ctx, accAddrs[3], fooDenom))
+
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).Times(2)
+	_, err = suite.msgServer.Clawback(ctx, banktypes.NewMsgClawback(issuer, accAddrs[0].String(), newFooCoin(100)))
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// module accounts cannot be clawed back from
+	suite.authKeeper.EXPECT().GetAccount(ctx, mintAcc.GetAddress()).Return(mintAcc)
+	_, err = suite.msgServer.Clawback(ctx, banktypes.NewMsgClawback(issuer, mintAcc.GetAddress().String(), newFooCoin(1)))
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// removing the issuer removes the frozen and allowlisted addresses
+	_, err = suite.msgServer.SetDenomCompliance(ctx, banktypes.NewMsgSetDenomCompliance(authority, banktypes.NewDenomCompliance(fooDenom, "", false)))
+	require.NoError(err)
+
+	_, found := suite.bankKeeper.GetDenomCompliance(ctx, fooDenom)
+	require.False(found)
+	require.False(suite.bankKeeper.IsFrozen(ctx, fooDenom, accAddrs[0]))
+	require.False(suite.bankKeeper.IsAllowlisted(ctx, fooDenom, accAddrs[1]))
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomCompliance() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	_, err := suite.queryClient.DenomCompliance(ctx, &banktypes.QueryDenomComplianceRequest{Denom: fooDenom})
+	require.Error(err)
+
+	issuer := suite.setFooCompliance(true)
+	for _, addr := range accAddrs[:3] {
+		require.NoError(suite.bankKeeper.SetFrozen(ctx, fooDenom, addr, true))
+	}
+	require.NoError(suite.bankKeeper.SetAllowlisted(ctx, fooDenom, accAddrs[4], true))
+
+	res, err := suite.queryClient.DenomCompliance(ctx, &banktypes.QueryDenomComplianceRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Equal(banktypes.NewDenomCompliance(fooDenom, issuer.String(), true), res.Compliance)
+
+	frozen, err := suite.queryClient.FrozenAddresses(ctx, &banktypes.QueryFrozenAddressesRequest{
+		Denom:      fooDenom,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(frozen.Addresses, 2)
+	require.Equal(uint64(3), frozen.Pagination.Total)
+
+	frozen, err = suite.queryClient.FrozenAddresses(ctx, &banktypes.QueryFrozenAddressesRequest{
+		Denom:      fooDenom,
+		Pagination: &query.PageRequest{Key: frozen.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Len(frozen.Addresses, 1)
+
+	allowlisted, err := suite.queryClient.AllowlistedAddresses(ctx, &banktypes.QueryAllowlistedAddressesRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Equal([]string{accAddrs[4].String()}, allowlisted.Addresses)
+
+	allowlisted, err = suite.queryClient.AllowlistedAddresses(ctx, &banktypes.QueryAllowlistedAddressesRequest{Denom: barDenom})
+	require.NoError(err)
+	require.Empty(allowlisted.Addresses)
+}
+
+func (suite *KeeperTestSuite) TestDenomComplianceGenesis() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	issuer := suite.setFooCompliance(true)
+	require.NoError(suite.bankKeeper.SetDenomCompliance(ctx, banktypes.NewDenomCompliance(barDenom, issuer.String(), false)))
+	require.NoError(suite.bankKeeper.SetFrozen(ctx, fooDenom, accAddrs[0], true))
+	require.NoError(suite.bankKeeper.SetFrozen(ctx, fooDenom, accAddrs[1], true))
+	require.NoError(suite.bankKeeper.SetFrozen(ctx, barDenom, accAddrs[2], true))
+	require.NoError(suite.bankKeeper.SetAllowlisted(ctx, fooDenom, accAddrs[1], true))
+
+	exported := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(exported.Validate())
+	require.Len(exported.DenomCompliance, 2)
+	require.Equal([]banktypes.DenomAddresses{
+		{Denom: barDenom, Addresses: []string{accAddrs[2].String()}},
+		{Denom: fooDenom, Addresses: []string{accAddrs[0].String(), accAddrs[1].String()}},
+	}, exported.FrozenAddresses)
+	require.Equal([]banktypes.DenomAddresses{
+		{Denom: fooDenom, Addresses: []string{accAddrs[1].String()}},
+	}, exported.AllowlistedAddresses)
+
+	suite.SetupTest()
+	suite.bankKeeper.InitGenesis(suite.ctx, exported)
+	require.Equal(exported, suite.bankKeeper.ExportGenesis(suite.ctx))
+	require.True(suite.bankKeeper.IsFrozen(suite.ctx, barDenom, accAddrs[2]))
+
+	// addresses are checked against the address codec of the keeper
+	invalidIssuer := *exported
+	invalidIssuer.DenomCompliance = append([]banktypes.DenomCompliance{}, exported.DenomCompliance...)
+	invalidIssuer.DenomCompliance[0].Issuer = "invalid"
+	require.NoError(invalidIssuer.Validate())
+	suite.SetupTest()
+	require.Panics(func() { suite.bankKeeper.InitGenesis(suite.ctx, &invalidIssuer) })
+
+	invalidFrozen := *exported
+	invalidFrozen.FrozenAddresses = []banktypes.DenomAddresses{{Denom: fooDenom, Addresses: []string{"invalid"}}}
+	require.NoError(invalidFrozen.Validate())
+	suite.SetupTest()
+	require.Panics(func() { suite.bankKeeper.InitGenesis(suite.ctx, &invalidFrozen) })
+}
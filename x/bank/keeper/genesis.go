@@ -48,12 +48,68 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	}
 
 	for _, supply := range totalSupply {
-		k.setSupply(ctx, supply)
+		if err := k.setSupply(ctx, supply); err != nil {
+			panic(err)
+		}
 	}
 
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, compliance := range genState.DenomCompliance {
+		if _, err := k.ak.AddressCodec().StringToBytes(compliance.Issuer); err != nil {
+			panic(fmt.Errorf("invalid issuer address for denom %s: %w", compliance.Denom, err))
+		}
+		if err := k.Compliance.Set(ctx, compliance.Denom, compliance); err != nil {
+			panic(err)
+		}
+	}
+
+	k.initDenomAddresses(ctx, k.FrozenAddrs, genState.FrozenAddresses)
+	k.initDenomAddresses(ctx, k.AllowlistedAddrs, genState.AllowlistedAddresses)
+}
+
+// initDenomAddresses stores the frozen or allowlisted addresses of the genesis
+// state.
+func (k BaseKeeper) initDenomAddresses(ctx context.Context, set collections.KeySet[collections.Pair[string, sdk.AccAddress]], entries []types.DenomAddresses) {
+	for _, entry := range entries {
+		for _, addr := range entry.Addresses {
+			bz, err := k.ak.AddressCodec().StringToBytes(addr)
+			if err != nil {
+				panic(fmt.Errorf("invalid address for denom %s: %w", entry.Denom, err))
+			}
+
+			if err := set.Set(ctx, collections.Join(entry.Denom, sdk.AccAddress(bz))); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// exportDenomAddresses returns the frozen or allowlisted addresses grouped by
+// denom.
+func (k BaseKeeper) exportDenomAddresses(ctx context.Context, set collections.KeySet[collections.Pair[string, sdk.AccAddress]]) []types.DenomAddresses {
+	entries := []types.DenomAddresses{}
+	err := set.Walk(ctx, nil, func(key collections.Pair[string, sdk.AccAddress]) (bool, error) {
+		addr, err := k.ak.AddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		if n := len(entries); n > 0 && entries[n-1].Denom == key.K1() {
+			entries[n-1].Addresses = append(entries[n-1].Addresses, addr)
+		} else {
+			entries = append(entries, types.DenomAddresses{Denom: key.K1(), Addresses: []string{addr}})
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return entries
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -70,5 +126,8 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+	rv.DenomCompliance = k.GetAllDenomCompliance(ctx)
+	rv.FrozenAddresses = k.exportDenomAddresses(ctx, k.FrozenAddrs)
+	rv.AllowlistedAddresses = k.exportDenomAddresses(ctx, k.AllowlistedAddrs)
 	return rv
 }
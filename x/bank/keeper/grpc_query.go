@@ -316,3 +316,154 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// DenomCompliance implements the Query/DenomCompliance gRPC method
+func (k BaseKeeper) DenomCompliance(ctx context.Context, req *types.QueryDenomComplianceRequest) (*types.QueryDenomComplianceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	compliance, found := k.GetDenomCompliance(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no compliance controls for denom: %s", req.Denom)
+	}
+
+	return &types.QueryDenomComplianceResponse{Compliance: compliance}, nil
+}
+
+// FrozenAddresses implements the Query/FrozenAddresses gRPC method
+func (k BaseKeeper) FrozenAddresses(ctx context.Context, req *types.QueryFrozenAddressesRequest) (*types.QueryFrozenAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addresses, pageRes, err := k.paginateDenomAddresses(ctx, k.FrozenAddrs, req.Denom, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFrozenAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+// AllowlistedAddresses implements the Query/AllowlistedAddresses gRPC method
+func (k BaseKeeper) AllowlistedAddresses(ctx context.Context, req *types.QueryAllowlistedAddressesRequest) (*types.QueryAllowlistedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addresses, pageRes, err := k.paginateDenomAddresses(ctx, k.AllowlistedAddrs, req.Denom, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllowlistedAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+// paginateDenomAddresses returns a page of the addresses of a denom stored in
+// a set of (denom, address) pairs.
+func (k BaseKeeper) paginateDenomAddresses(
+	ctx context.Context,
+	set collections.KeySet[collections.Pair[string, sdk.AccAddress]],
+	denom string,
+	pagination *query.PageRequest,
+) ([]string, *query.PageResponse, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addresses, pageRes, err := query.CollectionPaginate(
+		ctx,
+		set,
+		pagination,
+		func(key collections.Pair[string, sdk.AccAddress], _ collections.NoValue) (string, error) {
+			return k.ak.AddressCodec().BytesToString(key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, sdk.AccAddress](denom),
+	)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return addresses, pageRes, nil
+}
+
+// BalanceHistory implements the Query/BalanceHistory gRPC method
+func (k BaseKeeper) BalanceHistory(ctx context.Context, req *types.QueryBalanceHistoryRequest) (*types.QueryBalanceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	var end *collections.Triple[sdk.AccAddress, string, int64]
+	if req.EndHeight != 0 {
+		endKey := collections.Join3(sdk.AccAddress(addr), req.Denom, req.EndHeight)
+		end = &endKey
+	}
+	balanceLog, err := newHeightBoundedLog(k.BalanceLog, collections.Join3(sdk.AccAddress(addr), req.Denom, req.StartHeight), end)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	balances, pageRes, err := query.CollectionPaginate(
+		ctx,
+		balanceLog,
+		req.Pagination,
+		func(key collections.Triple[sdk.AccAddress, string, int64], amount math.Int) (types.HistoricalCoin, error) {
+			return types.HistoricalCoin{Height: key.K3(), Coin: sdk.NewCoin(req.Denom, amount)}, nil
+		},
+		query.WithCollectionPaginationTripleSuperPrefix[sdk.AccAddress, string, int64](addr, req.Denom),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryBalanceHistoryResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+// SupplyHistory implements the Query/SupplyHistory gRPC method
+func (k BaseKeeper) SupplyHistory(ctx context.Context, req *types.QuerySupplyHistoryRequest) (*types.QuerySupplyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var end *collections.Pair[string, int64]
+	if req.EndHeight != 0 {
+		endKey := collections.Join(req.Denom, req.EndHeight)
+		end = &endKey
+	}
+	supplyLog, err := newHeightBoundedLog(k.SupplyLog, collections.Join(req.Denom, req.StartHeight), end)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	supply, pageRes, err := query.CollectionPaginate(
+		ctx,
+		supplyLog,
+		req.Pagination,
+		func(key collections.Pair[string, int64], amount math.Int) (types.HistoricalCoin, error) {
+			return types.HistoricalCoin{Height: key.K2(), Coin: sdk.NewCoin(req.Denom, amount)}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, int64](req.Denom),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QuerySupplyHistoryResponse{Supply: supply, Pagination: pageRes}, nil
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/collections"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// recordBalance records the balance of an account for a denom at the current
+// height in the balance history, if the balance history is enabled. A balance
+// set several times within a block is recorded once, with its latest value.
+func (k BaseSendKeeper) recordBalance(ctx context.Context, addr sdk.AccAddress, balance sdk.Coin) error {
+	enabled, err := k.isHistoryEnabled(ctx)
+	if err != nil || !enabled {
+		return err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if err := k.BalanceLog.Set(ctx, collections.Join3(addr, balance.Denom, height), balance.Amount); err != nil {
+		return err
+	}
+
+	return k.BalanceLogByHeight.Set(ctx, collections.Join3(height, addr, balance.Denom))
+}
+
+// recordSupply records the supply of a denom at the current height in the
+// supply history, if the balance history is enabled.
+func (k BaseSendKeeper) recordSupply(ctx context.Context, supply sdk.Coin) error {
+	enabled, err := k.isHistoryEnabled(ctx)
+	if err != nil || !enabled {
+		return err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if err := k.SupplyLog.Set(ctx, collections.Join(supply.Denom, height), supply.Amount); err != nil {
+		return err
+	}
+
+	return k.SupplyLogByHeight.Set(ctx, collections.Join(height, supply.Denom))
+}
+
+// isHistoryEnabled returns whether the balance history is enabled. The check is
+// not metered so that chains which do not enable the history do not pay for it
+// on every balance and supply write.
+func (k BaseSendKeeper) isHistoryEnabled(ctx context.Context) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	return k.HistoryEnabled.Has(sdkCtx)
+}
+
+// PruneBalanceHistory removes the balance and supply history entries that fall
+// out of the retention window. It is called in the EndBlocker, after the
+// virtual accounts were credited. Nothing is pruned while the balance history
+// is disabled.
+func (k BaseSendKeeper) PruneBalanceHistory(ctx context.Context) error {
+	retention := k.GetParams(ctx).BalanceHistoryRetention
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if retention == 0 || retention >= uint64(height) {
+		return nil
+	}
+
+	// entries strictly after the cutoff height are kept
+	cutoff := height - int64(retention)
+
+	balanceRng := new(collections.Range[collections.Triple[int64, sdk.AccAddress, string]]).
+		EndExclusive(collections.TriplePrefix[int64, sdk.AccAddress, string](cutoff + 1))
+	iter, err := k.BalanceLogByHeight.Iterate(ctx, balanceRng)
+	if err != nil {
+		return err
+	}
+	staleBalances, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range staleBalances {
+		if err := k.BalanceLog.Remove(ctx, collections.Join3(key.K2(), key.K3(), key.K1())); err != nil {
+			return err
+		}
+		if err := k.BalanceLogByHeight.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	supplyRng := new(collections.Range[collections.Pair[int64, string]]).
+		EndExclusive(collections.PairPrefix[int64, string](cutoff + 1))
+	supplyIter, err := k.SupplyLogByHeight.Iterate(ctx, supplyRng)
+	if err != nil {
+		return err
+	}
+	staleSupplies, err := supplyIter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range staleSupplies {
+		if err := k.SupplyLog.Remove(ctx, collections.Join(key.K2(), key.K1())); err != nil {
+			return err
+		}
+		if err := k.SupplyLogByHeight.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// heightBoundedLog restricts the raw iteration of a history log to the keys
+// between lower (inclusive) and upper (exclusive), so that paginating over a
+// height range only scans the entries within it.
+type heightBoundedLog[K, V any] struct {
+	query.Collection[K, V]
+	lower, upper []byte
+}
+
+// newHeightBoundedLog bounds log to the keys from start up to and including
+// end, a nil end meaning no upper bound beyond the pagination prefix.
+func newHeightBoundedLog[K, V any](log collections.Map[K, V], start K, end *K) (heightBoundedLog[K, V], error) {
+	lower, err := collections.EncodeKeyWithPrefix(nil, log.KeyCodec(), start)
+	if err != nil {
+		return heightBoundedLog[K, V]{}, err
+	}
+
+	bounded := heightBoundedLog[K, V]{Collection: log, lower: lower}
+	if end != nil {
+		upper, err := collections.EncodeKeyWithPrefix(nil, log.KeyCodec(), *end)
+		if err != nil {
+			return heightBoundedLog[K, V]{}, err
+		}
+		bounded.upper = storetypes.PrefixEndBytes(upper)
+	}
+
+	return bounded, nil
+}
+
+// IterateRaw implements query.Collection, clamping start and end to the bounds
+// of the log.
+func (l heightBoundedLog[K, V]) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[K, V], error) {
+	if start == nil || bytes.Compare(start, l.lower) < 0 {
+		start = l.lower
+	}
+	if l.upper != nil && (end == nil || bytes.Compare(end, l.upper) > 0) {
+		end = l.upper
+	}
+	if end != nil && bytes.Compare(start, end) >= 0 {
+		return collections.Iterator[K, V]{}, collections.ErrInvalidIterator
+	}
+
+	return l.Collection.IterateRaw(ctx, start, end, order)
+}
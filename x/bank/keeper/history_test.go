@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// enableBalanceHistory sets the balance history retention to the given number
+// of blocks.
+func (suite *KeeperTestSuite) enableBalanceHistory(retention uint64) {
+	params := banktypes.DefaultParams()
+	params.BalanceHistoryRetention = retention
+	suite.Require().NoError(suite.bankKeeper.SetParams(suite.ctx, params))
+}
+
+func (suite *KeeperTestSuite) setHeight(height int64) {
+	suite.ctx = sdk.UnwrapSDKContext(suite.ctx).WithBlockHeight(height)
+}
+
+func (suite *KeeperTestSuite) balanceHistory(addr sdk.AccAddress, denom string, start, end int64) []banktypes.HistoricalCoin {
+	res, err := suite.queryClient.BalanceHistory(suite.ctx, &banktypes.QueryBalanceHistoryRequest{
+		Address:     addr.String(),
+		Denom:       denom,
+		StartHeight: start,
+		EndHeight:   end,
+	})
+	suite.Require().NoError(err)
+	return res.Balances
+}
+
+func (suite *KeeperTestSuite) TestBalanceHistory_Disabled() {
+	require := suite.Require()
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	require.Empty(suite.balanceHistory(accAddrs[0], fooDenom, 0, 0))
+
+	res, err := suite.queryClient.SupplyHistory(suite.ctx, &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Empty(res.Supply)
+
+	// nothing is recorded once the history is disabled again
+	suite.enableBalanceHistory(10)
+	suite.enableBalanceHistory(0)
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	require.Empty(suite.balanceHistory(accAddrs[0], fooDenom, 0, 0))
+}
+
+func (suite *KeeperTestSuite) TestBalanceHistory() {
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.setHeight(1)
+	suite.enableBalanceHistory(10)
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	// several changes within a block are recorded once
+	suite.setHeight(3)
+	suite.mockSendCoins(suite.ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(suite.ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	suite.mockSendCoins(suite.ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(suite.ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+
+	// a balance dropping to zero is recorded
+	suite.setHeight(5)
+	suite.mockSendCoins(suite.ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(suite.ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(80))))
+
+	require.Equal([]banktypes.HistoricalCoin{
+		{Height: 1, Coin: newFooCoin(100)},
+		{Height: 3, Coin: newFooCoin(80)},
+		{Height: 5, Coin: newFooCoin(0)},
+	}, suite.balanceHistory(accAddrs[0], fooDenom, 0, 0))
+	require.Equal([]banktypes.HistoricalCoin{
+		{Height: 3, Coin: newFooCoin(20)},
+		{Height: 5, Coin: newFooCoin(100)},
+	}, suite.balanceHistory(accAddrs[1], fooDenom, 0, 0))
+	require.Empty(suite.balanceHistory(accAddrs[0], barDenom, 0, 0))
+
+	// height range
+	require.Equal([]banktypes.HistoricalCoin{
+		{Height: 3, Coin: newFooCoin(80)},
+	}, suite.balanceHistory(accAddrs[0], fooDenom, 2, 4))
+	require.Equal([]banktypes.HistoricalCoin{
+		{Height: 3, Coin: newFooCoin(80)},
+		{Height: 5, Coin: newFooCoin(0)},
+	}, suite.balanceHistory(accAddrs[0], fooDenom, 3, 0))
+
+	// pagination
+	res, err := suite.queryClient.BalanceHistory(suite.ctx, &banktypes.QueryBalanceHistoryRequest{
+		Address:    accAddrs[0].String(),
+		Denom:      fooDenom,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(res.Balances, 2)
+	require.Equal(uint64(3), res.Pagination.Total)
+
+	res, err = suite.queryClient.BalanceHistory(suite.ctx, &banktypes.QueryBalanceHistoryRequest{
+		Address:    accAddrs[0].String(),
+		Denom:      fooDenom,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Equal([]banktypes.HistoricalCoin{{Height: 5, Coin: newFooCoin(0)}}, res.Balances)
+
+	// the height range bounds the pagination
+	res, err = suite.queryClient.BalanceHistory(suite.ctx, &banktypes.QueryBalanceHistoryRequest{
+		Address:     accAddrs[0].String(),
+		Denom:       fooDenom,
+		StartHeight: 2,
+		EndHeight:   5,
+		Pagination:  &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal([]banktypes.HistoricalCoin{{Height: 3, Coin: newFooCoin(80)}}, res.Balances)
+	require.Equal(uint64(2), res.Pagination.Total)
+
+	res, err = suite.queryClient.BalanceHistory(suite.ctx, &banktypes.QueryBalanceHistoryRequest{
+		Address:     accAddrs[0].String(),
+		Denom:       fooDenom,
+		StartHeight: 2,
+		EndHeight:   5,
+		Pagination:  &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Equal([]banktypes.HistoricalCoin{{Height: 5, Coin: newFooCoin(0)}}, res.Balances)
+	require.Nil(res.Pagination.NextKey)
+
+	res, err = suite.queryClient.BalanceHistory(suite.ctx, &banktypes.QueryBalanceHistoryRequest{
+		Address:     accAddrs[0].String(),
+		Denom:       fooDenom,
+		StartHeight: 2,
+		EndHeight:   4,
+		Pagination:  &query.PageRequest{Reverse: true},
+	})
+	require.NoError(err)
+	require.Equal([]banktypes.HistoricalCoin{{Height: 3, Coin: newFooCoin(80)}}, res.Balances)
+
+	// an end height below the start height matches nothing
+	require.Empty(suite.balanceHistory(accAddrs[0], fooDenom, 4, 2))
+
+	_, err = suite.queryClient.BalanceHistory(suite.ctx, &banktypes.QueryBalanceHistoryRequest{Address: "invalid", Denom: fooDenom})
+	require.Error(err)
+	_, err = suite.queryClient.BalanceHistory(suite.ctx, &banktypes.QueryBalanceHistoryRequest{Address: accAddrs[0].String()})
+	require.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestBalanceHistory_Virtual() {
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.setHeight(1)
+	suite.enableBalanceHistory(10)
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	suite.setHeight(2)
+	suite.mockSendCoinsFromAccountToModuleVirtual(acc0, burnerAcc)
+	require.NoError(suite.bankKeeper.SendCoinsFromAccountToModuleVirtual(suite.ctx, accAddrs[0], authtypes.Burner, sdk.NewCoins(newFooCoin(30))))
+
+	// the virtual balance is recorded once credited at the end of the block
+	require.Empty(suite.balanceHistory(burnerAcc.GetAddress(), fooDenom, 0, 0))
+
+	suite.authKeeper.EXPECT().HasAccount(suite.ctx, burnerAcc.GetAddress()).Return(true)
+	require.NoError(suite.bankKeeper.CreditVirtualAccounts(suite.ctx))
+
+	require.Equal([]banktypes.HistoricalCoin{
+		{Height: 1, Coin: newFooCoin(100)},
+		{Height: 2, Coin: newFooCoin(70)},
+	}, suite.balanceHistory(accAddrs[0], fooDenom, 0, 0))
+	require.Equal([]banktypes.HistoricalCoin{
+		{Height: 2, Coin: newFooCoin(30)},
+	}, suite.balanceHistory(burnerAcc.GetAddress(), fooDenom, 0, 0))
+}
+
+func (suite *KeeperTestSuite) TestSupplyHistory() {
+	require := suite.Require()
+
+	suite.setHeight(1)
+	suite.enableBalanceHistory(10)
+	suite.mockMintCoins(mintAcc)
+	require.NoError(suite.bankKeeper.MintCoins(suite.ctx, mintAcc.Name, sdk.NewCoins(newFooCoin(100))))
+
+	suite.setHeight(4)
+	suite.mockMintCoins(mintAcc)
+	require.NoError(suite.bankKeeper.MintCoins(suite.ctx, mintAcc.Name, sdk.NewCoins(newFooCoin(50))))
+
+	res, err := suite.queryClient.SupplyHistory(suite.ctx, &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Equal([]banktypes.HistoricalCoin{
+		{Height: 1, Coin: newFooCoin(100)},
+		{Height: 4, Coin: newFooCoin(150)},
+	}, res.Supply)
+
+	res, err = suite.queryClient.SupplyHistory(suite.ctx, &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom, StartHeight: 2})
+	require.NoError(err)
+	require.Equal([]banktypes.HistoricalCoin{{Height: 4, Coin: newFooCoin(150)}}, res.Supply)
+
+	res, err = suite.queryClient.SupplyHistory(suite.ctx, &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom, EndHeight: 3})
+	require.NoError(err)
+	require.Equal([]banktypes.HistoricalCoin{{Height: 1, Coin: newFooCoin(100)}}, res.Supply)
+}
+
+func (suite *KeeperTestSuite) TestPruneBalanceHistory() {
+	require := suite.Require()
+
+	suite.setHeight(1)
+	suite.enableBalanceHistory(5)
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	suite.setHeight(3)
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+
+	// nothing falls out of the retention window yet
+	suite.setHeight(5)
+	require.NoError(suite.bankKeeper.PruneBalanceHistory(suite.ctx))
+	require.Len(suite.balanceHistory(accAddrs[0], fooDenom, 0, 0), 2)
+
+	suite.setHeight(6)
+	require.NoError(suite.bankKeeper.PruneBalanceHistory(suite.ctx))
+	require.Equal([]banktypes.HistoricalCoin{{Height: 3, Coin: newFooCoin(200)}}, suite.balanceHistory(accAddrs[0], fooDenom, 0, 0))
+
+	res, err := suite.queryClient.SupplyHistory(suite.ctx, &banktypes.QuerySupplyHistoryRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Equal([]banktypes.HistoricalCoin{{Height: 3, Coin: newFooCoin(200)}}, res.Supply)
+
+	// disabling the balance history stops the pruning
+	suite.enableBalanceHistory(0)
+	suite.setHeight(100)
+	require.NoError(suite.bankKeeper.PruneBalanceHistory(suite.ctx))
+	require.Len(suite.balanceHistory(accAddrs[0], fooDenom, 0, 0), 1)
+}
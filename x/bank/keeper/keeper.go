@@ -51,6 +51,7 @@ type Keeper interface {
 	SendCoinsFromAccountToModuleVirtual(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccountVirtual(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	CreditVirtualAccounts(ctx context.Context) error
+	PruneBalanceHistory(ctx context.Context) error
 	SendCoinsFromVirtual(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsToVirtual(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	UncheckedSetBalance(ctx context.Context, addr sdk.AccAddress, balance sdk.Coin) error
@@ -383,7 +384,9 @@ func (k BaseKeeper) MintCoins(ctx context.Context, moduleName string, amounts sd
 	for _, amount := range amounts {
 		supply := k.GetSupply(ctx, amount.GetDenom())
 		supply = supply.Add(amount)
-		k.setSupply(ctx, supply)
+		if err := k.setSupply(ctx, supply); err != nil {
+			return err
+		}
 	}
 
 	k.logger.Debug("minted coins from module account", "amount", amounts.String(), "from", moduleName)
@@ -419,7 +422,9 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, moduleName string, amounts sd
 	for _, amount := range amounts {
 		supply := k.GetSupply(ctx, amount.GetDenom())
 		supply = supply.Sub(amount)
-		k.setSupply(ctx, supply)
+		if err := k.setSupply(ctx, supply); err != nil {
+			return err
+		}
 	}
 
 	k.logger.Debug("burned tokens from module account", "amount", amounts.String(), "from", moduleName)
@@ -434,13 +439,19 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, moduleName string, amounts sd
 }
 
 // setSupply sets the supply for the given coin
-func (k BaseKeeper) setSupply(ctx context.Context, coin sdk.Coin) {
+func (k BaseKeeper) setSupply(ctx context.Context, coin sdk.Coin) error {
 	// Bank invariants and IBC requires to remove zero coins.
+	var err error
 	if coin.IsZero() {
-		_ = k.Supply.Remove(ctx, coin.Denom)
+		err = k.Supply.Remove(ctx, coin.Denom)
 	} else {
-		_ = k.Supply.Set(ctx, coin.Denom, coin.Amount)
+		err = k.Supply.Set(ctx, coin.Denom, coin.Amount)
 	}
+	if err != nil {
+		return err
+	}
+
+	return k.recordSupply(ctx, coin)
 }
 
 // trackDelegation tracks the delegation of the given account if it is a vesting account
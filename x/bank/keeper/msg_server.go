@@ -183,3 +183,98 @@ func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEn
 
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
+
+func (k msgServer) SetDenomCompliance(goCtx context.Context, msg *types.MsgSetDenomCompliance) (*types.MsgSetDenomComplianceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, k.GetAuthority(), msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetDenomCompliance(ctx, msg.Compliance); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomComplianceResponse{}, nil
+}
+
+func (k msgServer) SetFrozen(goCtx context.Context, msg *types.MsgSetFrozen) (*types.MsgSetFrozenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateIssuer(ctx, msg.Denom, msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	addr, err := k.stringToBytes(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetFrozen(ctx, msg.Denom, addr, msg.Frozen); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFrozenResponse{}, nil
+}
+
+func (k msgServer) SetAllowlisted(goCtx context.Context, msg *types.MsgSetAllowlisted) (*types.MsgSetAllowlistedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateIssuer(ctx, msg.Denom, msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	addr, err := k.stringToBytes(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetAllowlisted(ctx, msg.Denom, addr, msg.Allowlisted); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAllowlistedResponse{}, nil
+}
+
+func (k msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateIssuer(ctx, msg.Amount.Denom, msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	addr, err := k.stringToBytes(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.Clawback(ctx, addr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// validateIssuer returns an error if the signer is not the issuer of the denom.
+func (k msgServer) validateIssuer(ctx context.Context, denom, signer string) error {
+	compliance, found := k.GetDenomCompliance(ctx, denom)
+	if !found {
+		return types.ErrNoDenomCompliance.Wrap(denom)
+	}
+
+	if compliance.Issuer != signer {
+		return types.ErrNotIssuer.Wrapf("expected %s, got %s", compliance.Issuer, signer)
+	}
+
+	return nil
+}
+
+// stringToBytes decodes an address with the address codec of the keeper.
+func (k msgServer) stringToBytes(addr string) (sdk.AccAddress, error) {
+	bz, err := k.AddressCodec().StringToBytes(addr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return bz, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log/v2"
@@ -47,7 +48,17 @@ type SendKeeper interface {
 	BlockedAddr(addr sdk.AccAddress) bool
 	GetBlockedAddresses() map[string]bool
 
+	GetDenomCompliance(ctx context.Context, denom string) (types.DenomCompliance, bool)
+	GetAllDenomCompliance(ctx context.Context) []types.DenomCompliance
+	SetDenomCompliance(ctx context.Context, compliance types.DenomCompliance) error
+	IsFrozen(ctx context.Context, denom string, addr sdk.AccAddress) bool
+	SetFrozen(ctx context.Context, denom string, addr sdk.AccAddress, frozen bool) error
+	IsAllowlisted(ctx context.Context, denom string, addr sdk.AccAddress) bool
+	SetAllowlisted(ctx context.Context, denom string, addr sdk.AccAddress, allowlisted bool) error
+	Clawback(ctx context.Context, addr sdk.AccAddress, amt sdk.Coin) error
+
 	GetAuthority() string
+	AddressCodec() address.Codec
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -117,6 +128,11 @@ func (k BaseSendKeeper) GetAuthority() string {
 	return k.authority
 }
 
+// AddressCodec returns the address codec used to encode account addresses.
+func (k BaseSendKeeper) AddressCodec() address.Codec {
+	return k.ak.AddressCodec()
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx context.Context) (params types.Params) {
 	p, _ := k.Params.Get(ctx)
@@ -134,9 +150,19 @@ func (k BaseSendKeeper) SetParams(ctx context.Context, params types.Params) erro
 		k.SetAllSendEnabled(ctx, params.SendEnabled)
 
 		// override params without SendEnabled
+		retention := params.BalanceHistoryRetention
 		params = types.NewParams(params.DefaultSendEnabled)
+		params.BalanceHistoryRetention = retention
+	}
+
+	if err := k.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	if params.BalanceHistoryRetention == 0 {
+		return k.HistoryEnabled.Remove(ctx)
 	}
-	return k.Params.Set(ctx, params)
+	return k.HistoryEnabled.Set(ctx, true)
 }
 
 // InputOutputCoins performs multi-send functionality. It accepts an
@@ -181,6 +207,10 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			return err
 		}
 
+		if err := k.checkCompliance(ctx, inAddress, updatedAddressBz, out.Coins); err != nil {
+			return err
+		}
+
 		updatedAddressStr, err := k.ak.AddressCodec().BytesToString(updatedAddressBz)
 		if err != nil {
 			return err
@@ -237,6 +267,10 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
+	if err := k.checkCompliance(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -382,9 +416,14 @@ func (k BaseSendKeeper) UncheckedSetBalance(ctx context.Context, addr sdk.AccAdd
 		if err != nil {
 			return err
 		}
-		return nil
+	} else {
+		err := k.Balances.Set(ctx, collections.Join(addr, balance.Denom), balance.Amount)
+		if err != nil {
+			return err
+		}
 	}
-	return k.Balances.Set(ctx, collections.Join(addr, balance.Denom), balance.Amount)
+
+	return k.recordBalance(ctx, addr, balance)
 }
 
 // IsSendEnabledCoins checks the coins provided and returns an ErrSendDisabled
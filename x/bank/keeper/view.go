@@ -67,6 +67,18 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+
+	Compliance       collections.Map[string, types.DenomCompliance]
+	FrozenAddrs      collections.KeySet[collections.Pair[string, sdk.AccAddress]]
+	AllowlistedAddrs collections.KeySet[collections.Pair[string, sdk.AccAddress]]
+
+	BalanceLog         collections.Map[collections.Triple[sdk.AccAddress, string, int64], math.Int]
+	BalanceLogByHeight collections.KeySet[collections.Triple[int64, sdk.AccAddress, string]]
+	SupplyLog          collections.Map[collections.Pair[string, int64], math.Int]
+	SupplyLogByHeight  collections.KeySet[collections.Pair[int64, string]]
+	// HistoryEnabled is set while the balance history is enabled, so that
+	// balance and supply writes need not read the params.
+	HistoryEnabled collections.Item[bool]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -82,6 +94,16 @@ func NewBaseViewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService,
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		Compliance:       collections.NewMap(sb, types.DenomCompliancePrefix, "denom_compliance", collections.StringKey, codec.CollValue[types.DenomCompliance](cdc)),
+		FrozenAddrs:      collections.NewKeySet(sb, types.FrozenAddressesPrefix, "frozen_addresses", collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey)),
+		AllowlistedAddrs: collections.NewKeySet(sb, types.AllowlistedAddressesPrefix, "allowlisted_addresses", collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey)),
+
+		BalanceLog:         collections.NewMap(sb, types.BalanceHistoryPrefix, "balance_history", collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.Int64Key), sdk.IntValue),
+		BalanceLogByHeight: collections.NewKeySet(sb, types.BalanceHistoryByHeightPrefix, "balance_history_by_height", collections.TripleKeyCodec(collections.Int64Key, sdk.AccAddressKey, collections.StringKey)),
+		SupplyLog:          collections.NewMap(sb, types.SupplyHistoryPrefix, "supply_history", collections.PairKeyCodec(collections.StringKey, collections.Int64Key), sdk.IntValue),
+		SupplyLogByHeight:  collections.NewKeySet(sb, types.SupplyHistoryByHeightPrefix, "supply_history_by_height", collections.PairKeyCodec(collections.Int64Key, collections.StringKey)),
+		HistoryEnabled:     collections.NewItem(sb, types.BalanceHistoryEnabledKey, "balance_history_enabled", collections.BoolValue),
 	}
 
 	schema, err := sb.Build()
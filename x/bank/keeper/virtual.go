@@ -56,6 +56,10 @@ func (k BaseSendKeeper) SendCoinsToVirtual(ctx context.Context, fromAddr, toAddr
 		return err
 	}
 
+	if err := k.checkCompliance(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	k.addVirtualCoins(ctx, toAddr, amt)
 	if err := k.emitSendCoinsEvents(ctx, fromAddr, toAddr, amt); err != nil {
 		return err
@@ -76,6 +80,10 @@ func (k BaseSendKeeper) SendCoinsFromVirtual(ctx context.Context, fromAddr, toAd
 		return err
 	}
 
+	if err := k.checkCompliance(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	err = k.addCoins(ctx, toAddr, amt)
 	if err != nil {
 		return err
@@ -142,6 +150,8 @@ func (k BaseSendKeeper) subVirtualCoins(ctx context.Context, addr sdk.AccAddress
 
 // CreditVirtualAccounts sum up the transient coins and add them to the real account,
 // should be called at end blocker.
+// The credited balances are recorded in the balance history at the current height, like the
+// debits made by SendCoinsToVirtual.
 func (k BaseSendKeeper) CreditVirtualAccounts(ctx context.Context) error {
 	// No-op if we're not using the objStore to accumulate to module accounts
 	if k.objStoreKey == nil {
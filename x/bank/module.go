@@ -182,7 +182,11 @@ func (am AppModule) WeightedOperationsX(weights simsx.WeightSource, reg simsx.Re
 }
 
 func (am AppModule) EndBlock(ctx context.Context) error {
-	return am.keeper.CreditVirtualAccounts(ctx)
+	if err := am.keeper.CreditVirtualAccounts(ctx); err != nil {
+		return err
+	}
+
+	return am.keeper.PruneBalanceHistory(ctx)
 }
 
 // App Wiring Setup
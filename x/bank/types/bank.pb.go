@@ -37,6 +37,10 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// balance_history_retention is the number of blocks for which the changes of
+	// the account balances and of the supply are kept in the balance history. A
+	// value of 0 disables the balance history.
+	BalanceHistoryRetention uint64 `protobuf:"varint,3,opt,name=balance_history_retention,json=balanceHistoryRetention,proto3" json:"balance_history_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +91,13 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetBalanceHistoryRetention() uint64 {
+	if m != nil {
+		return m.BalanceHistoryRetention
+	}
+	return 0
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -442,6 +453,73 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// DenomCompliance defines the compliance controls of a denom. The issuer of the
+// denom can freeze addresses, allowlist recipients and claw back balances.
+type DenomCompliance struct {
+	// denom is the denom the controls apply to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// issuer is the address managing the compliance controls of the denom, and
+	// receiving the clawed back balances.
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// allowlist_enabled restricts the recipients of the denom to the allowlisted
+	// addresses and the issuer.
+	AllowlistEnabled bool `protobuf:"varint,3,opt,name=allowlist_enabled,json=allowlistEnabled,proto3" json:"allowlist_enabled,omitempty"`
+}
+
+func (m *DenomCompliance) Reset()         { *m = DenomCompliance{} }
+func (m *DenomCompliance) String() string { return proto.CompactTextString(m) }
+func (*DenomCompliance) ProtoMessage()    {}
+func (*DenomCompliance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *DenomCompliance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomCompliance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomCompliance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomCompliance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomCompliance.Merge(m, src)
+}
+func (m *DenomCompliance) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomCompliance) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomCompliance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomCompliance proto.InternalMessageInfo
+
+func (m *DenomCompliance) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomCompliance) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *DenomCompliance) GetAllowlistEnabled() bool {
+	if m != nil {
+		return m.AllowlistEnabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -450,57 +528,63 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*DenomCompliance)(nil), "cosmos.bank.v1beta1.DenomCompliance")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 705 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0x13, 0x49,
-	0x14, 0xf6, 0xf8, 0x77, 0xc6, 0x39, 0x9d, 0x6e, 0x62, 0xe9, 0x26, 0x3e, 0xdd, 0xda, 0x72, 0x73,
-	0x8e, 0xef, 0x6c, 0xe7, 0xc7, 0x09, 0x09, 0x37, 0x08, 0x87, 0x5f, 0x2e, 0x10, 0x68, 0xa3, 0x08,
-	0x89, 0xc6, 0x1a, 0x7b, 0x07, 0x7b, 0x95, 0xdd, 0x99, 0xd5, 0xce, 0x6c, 0x88, 0x5b, 0x2a, 0x44,
-	0x45, 0x4d, 0x15, 0x51, 0x21, 0x44, 0xe1, 0x22, 0x3d, 0x6d, 0x94, 0x2a, 0xa2, 0x42, 0x29, 0x02,
-	0x72, 0x0a, 0xe7, 0xcf, 0x40, 0x3b, 0xbb, 0x6b, 0x3b, 0xc2, 0xa1, 0x44, 0xa2, 0xb1, 0xe7, 0xbd,
-	0xef, 0x7b, 0xef, 0x7d, 0xf3, 0xf6, 0xdb, 0x85, 0x5a, 0x8f, 0x0b, 0x9b, 0x8b, 0x46, 0x97, 0xb0,
-	0xbd, 0xc6, 0xfe, 0x46, 0x97, 0x4a, 0xb2, 0xa1, 0x82, 0xba, 0xe3, 0x72, 0xc9, 0xd1, 0x4a, 0x80,
-	0xd7, 0x55, 0x2a, 0xc4, 0x0b, 0xf9, 0x3e, 0xef, 0x73, 0x85, 0x37, 0xfc, 0x53, 0x40, 0x2d, 0xac,
-	0x06, 0xd4, 0x4e, 0x00, 0x84, 0x75, 0x01, 0x34, 0x9b, 0x22, 0xe8, 0x74, 0x4a, 0x8f, 0x9b, 0x2c,
-	0xc4, 0xff, 0x0c, 0x71, 0x5b, 0xf4, 0x1b, 0xfb, 0x1b, 0xfe, 0x5f, 0x08, 0xfc, 0x41, 0x6c, 0x93,
-	0xf1, 0x86, 0xfa, 0x0d, 0x52, 0xe5, 0xb7, 0x00, 0xa6, 0x1f, 0x13, 0x97, 0xd8, 0x02, 0xdd, 0x87,
-	0xcb, 0x82, 0x32, 0xa3, 0x43, 0x19, 0xe9, 0x5a, 0xd4, 0xc0, 0xa0, 0x94, 0xa8, 0xe4, 0x36, 0x4b,
-	0xf5, 0x05, 0x9a, 0xeb, 0x3b, 0x94, 0x19, 0x77, 0x03, 0x5e, 0x2b, 0x8e, 0x81, 0x9e, 0x13, 0xb3,
-	0x04, 0x5a, 0x87, 0x79, 0x83, 0x3e, 0x23, 0x9e, 0x25, 0x3b, 0x57, 0x1a, 0xc6, 0x4b, 0xa0, 0x92,
-	0xd5, 0x51, 0x88, 0xcd, 0xb5, 0x68, 0xfe, 0xfd, 0x6a, 0x32, 0xaa, 0xe2, 0x60, 0x50, 0x4d, 0x18,
-	0x7b, 0x8d, 0x83, 0x60, 0x85, 0x81, 0xb2, 0xf2, 0x36, 0xcc, 0xcd, 0xb1, 0x51, 0x1e, 0xa6, 0x0c,
-	0xca, 0xb8, 0x8d, 0x41, 0x09, 0x54, 0x96, 0xf4, 0x20, 0x40, 0x18, 0x66, 0xae, 0x0e, 0x8a, 0xc2,
-	0x66, 0xf2, 0xf2, 0xb0, 0x08, 0xca, 0x27, 0x00, 0xa6, 0xda, 0xcc, 0xf1, 0x24, 0xda, 0x84, 0x19,
-	0x62, 0x18, 0x2e, 0x15, 0x22, 0xe8, 0xd0, 0xc2, 0x9f, 0x8e, 0x6a, 0xf9, 0xf0, 0x9a, 0xb7, 0x03,
-	0x64, 0x47, 0xba, 0x26, 0xeb, 0xeb, 0x11, 0x11, 0x3d, 0x87, 0x29, 0x7f, 0xc3, 0x02, 0xc7, 0xd5,
-	0x56, 0x56, 0x67, 0x5b, 0x11, 0x74, 0xba, 0x95, 0x6d, 0x6e, 0xb2, 0xd6, 0xbd, 0xe3, 0xf3, 0x62,
-	0xec, 0xfd, 0x97, 0x62, 0xa5, 0x6f, 0xca, 0x81, 0xd7, 0xad, 0xf7, 0xb8, 0x1d, 0x3e, 0xbe, 0xc6,
-	0xdc, 0x05, 0xe5, 0xd0, 0xa1, 0x42, 0x15, 0x88, 0x37, 0x93, 0x51, 0x75, 0xd9, 0xa2, 0x7d, 0xd2,
-	0x1b, 0x76, 0xd4, 0x8c, 0x77, 0x93, 0x51, 0x15, 0xe8, 0xc1, 0xbc, 0x66, 0xfe, 0xe5, 0x61, 0x31,
-	0x76, 0x79, 0x58, 0x8c, 0xbd, 0x98, 0x8c, 0xaa, 0x91, 0x9c, 0xf2, 0x47, 0x00, 0xd3, 0x8f, 0x3c,
-	0xf9, 0xcb, 0xdd, 0x26, 0x1b, 0xdd, 0xa6, 0xfc, 0x01, 0xc0, 0xf4, 0x8e, 0xe7, 0x38, 0xd6, 0xd0,
-	0x57, 0x23, 0xb9, 0x24, 0x56, 0xe8, 0xb8, 0x9f, 0xa1, 0x46, 0xcd, 0x6b, 0xae, 0x85, 0x6a, 0xc0,
-	0xc9, 0x51, 0xed, 0xaf, 0x85, 0x36, 0x57, 0x02, 0xdb, 0x18, 0x94, 0x9f, 0xc0, 0xa5, 0x3b, 0xbe,
-	0xcd, 0x76, 0x99, 0x29, 0xaf, 0x31, 0x60, 0x01, 0x66, 0xe9, 0x81, 0xc3, 0x19, 0x65, 0x52, 0x39,
-	0xf0, 0x37, 0x7d, 0x1a, 0xfb, 0xe6, 0x24, 0x96, 0x49, 0x04, 0x15, 0x38, 0x51, 0x4a, 0x54, 0x96,
-	0xf4, 0x28, 0x2c, 0x9f, 0xc5, 0x61, 0xf6, 0x21, 0x95, 0xc4, 0x20, 0x92, 0xa0, 0x12, 0xcc, 0x19,
-	0x54, 0xf4, 0x5c, 0xd3, 0x91, 0x26, 0x67, 0x61, 0xfb, 0xf9, 0x14, 0xba, 0xe5, 0x33, 0x18, 0xb7,
-	0x3b, 0x1e, 0x33, 0x65, 0xf4, 0xfc, 0xb4, 0x85, 0xef, 0xe8, 0x54, 0xaf, 0x0e, 0x8d, 0xe8, 0x28,
-	0x10, 0x82, 0x49, 0x7f, 0xaf, 0x38, 0xa1, 0x7a, 0xab, 0xb3, 0xaf, 0xce, 0x30, 0x85, 0x63, 0x91,
-	0x21, 0x4e, 0xaa, 0x74, 0x14, 0xa2, 0x7f, 0x60, 0x92, 0x11, 0x9b, 0xe2, 0x94, 0x72, 0xd6, 0xca,
-	0xd9, 0x51, 0xed, 0xf7, 0xd9, 0xa2, 0x4b, 0xeb, 0xf5, 0xff, 0xb7, 0x74, 0x45, 0x40, 0xff, 0xc2,
-	0xb4, 0x18, 0xda, 0x5d, 0x6e, 0xe1, 0xf4, 0xf5, 0xd4, 0x90, 0x82, 0xfe, 0x83, 0x09, 0xcf, 0x35,
-	0x71, 0x46, 0x31, 0x0b, 0xe3, 0xf3, 0x62, 0x62, 0x57, 0x6f, 0x7f, 0x5f, 0x70, 0x43, 0xf7, 0x69,
-	0xe8, 0x26, 0xcc, 0x7a, 0xae, 0xd9, 0x19, 0x10, 0x31, 0xc0, 0x59, 0x55, 0xa2, 0x8d, 0xcf, 0x8b,
-	0x99, 0x5d, 0xbd, 0xfd, 0x80, 0x88, 0xc1, 0xa2, 0xb2, 0x8c, 0xe7, 0x9a, 0x3e, 0xd6, 0xda, 0x3e,
-	0x1e, 0x6b, 0xe0, 0x74, 0xac, 0x81, 0xaf, 0x63, 0x0d, 0xbc, 0xbe, 0xd0, 0x62, 0xa7, 0x17, 0x5a,
-	0xec, 0xf3, 0x85, 0x16, 0x7b, 0xba, 0xf6, 0x43, 0x07, 0x85, 0x9f, 0x1f, 0x65, 0xa4, 0x6e, 0x5a,
-	0x7d, 0x29, 0xb7, 0xbe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x05, 0x03, 0x28, 0xdd, 0x05, 0x00,
-	0x00,
+	// 787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x8f, 0x1b, 0x45,
+	0x14, 0xf6, 0xd8, 0x3e, 0xdb, 0x37, 0x17, 0x14, 0x32, 0xb1, 0x94, 0x39, 0x23, 0xd6, 0xab, 0x6d,
+	0x70, 0x1c, 0x6c, 0xdf, 0x25, 0x08, 0x09, 0x37, 0x88, 0x33, 0x3f, 0xe2, 0x02, 0x81, 0xf6, 0x74,
+	0x42, 0xa2, 0x59, 0x8d, 0xbd, 0x83, 0x3d, 0xca, 0xee, 0xcc, 0x6a, 0x67, 0x36, 0x89, 0x5b, 0x0a,
+	0x84, 0xa8, 0xa8, 0xa9, 0xae, 0x44, 0x88, 0xc2, 0xc5, 0xf5, 0xb4, 0x51, 0xaa, 0x88, 0x0a, 0xa5,
+	0x30, 0xc8, 0x57, 0x38, 0x7f, 0x06, 0xda, 0x99, 0x5d, 0xdb, 0x27, 0x7c, 0xd0, 0x21, 0xa5, 0xb1,
+	0xf7, 0xbd, 0xef, 0x7b, 0xf3, 0xbe, 0x79, 0xf3, 0xde, 0x83, 0xd6, 0x58, 0xc8, 0x50, 0xc8, 0xde,
+	0x88, 0xf0, 0x47, 0xbd, 0xc7, 0xc7, 0x23, 0xaa, 0xc8, 0xb1, 0x36, 0xba, 0x51, 0x2c, 0x94, 0x40,
+	0xb7, 0x0d, 0xde, 0xd5, 0xae, 0x0c, 0x6f, 0xd4, 0x27, 0x62, 0x22, 0x34, 0xde, 0x4b, 0xbf, 0x0c,
+	0xb5, 0x71, 0x68, 0xa8, 0x9e, 0x01, 0xb2, 0x38, 0x03, 0x6d, 0xb2, 0x48, 0xba, 0xce, 0x32, 0x16,
+	0x8c, 0x67, 0xf8, 0x9d, 0x0c, 0x0f, 0xe5, 0xa4, 0xf7, 0xf8, 0x38, 0xfd, 0xcb, 0x80, 0x5b, 0x24,
+	0x64, 0x5c, 0xf4, 0xf4, 0xaf, 0x71, 0x39, 0x0b, 0x00, 0x2b, 0x5f, 0x92, 0x98, 0x84, 0x12, 0x7d,
+	0x06, 0x6f, 0x48, 0xca, 0x7d, 0x8f, 0x72, 0x32, 0x0a, 0xa8, 0x8f, 0x81, 0x5d, 0x6a, 0x1d, 0xdc,
+	0xb7, 0xbb, 0x3b, 0x34, 0x77, 0x4f, 0x29, 0xf7, 0x3f, 0x31, 0xbc, 0x93, 0x22, 0x06, 0xee, 0x81,
+	0xdc, 0x38, 0xd0, 0x11, 0xac, 0xfb, 0xf4, 0x1b, 0x92, 0x04, 0xca, 0xbb, 0x72, 0x60, 0xd1, 0x06,
+	0xad, 0x9a, 0x8b, 0x32, 0x6c, 0xeb, 0x08, 0xd4, 0x87, 0x87, 0x23, 0x12, 0x10, 0x3e, 0xa6, 0xde,
+	0x94, 0x49, 0x25, 0xe2, 0x99, 0x17, 0x53, 0x45, 0xb9, 0x62, 0x82, 0xe3, 0x92, 0x0d, 0x5a, 0x65,
+	0xf7, 0x4e, 0x46, 0x78, 0x68, 0x70, 0x37, 0x87, 0xfb, 0x6f, 0xff, 0xb0, 0x9a, 0xb7, 0xb1, 0x11,
+	0xd9, 0x91, 0xfe, 0xa3, 0xde, 0x53, 0x53, 0x7e, 0x73, 0x2b, 0x67, 0x00, 0x0f, 0xb6, 0x33, 0xd5,
+	0xe1, 0x9e, 0x4f, 0xb9, 0x08, 0x31, 0xb0, 0x41, 0x6b, 0xdf, 0x35, 0x06, 0xc2, 0xb0, 0x7a, 0x55,
+	0x64, 0x6e, 0xf6, 0xcb, 0xaf, 0xce, 0x9b, 0xc0, 0x79, 0x0e, 0xe0, 0xde, 0x90, 0x47, 0x89, 0x42,
+	0xf7, 0x61, 0x95, 0xf8, 0x7e, 0x4c, 0xa5, 0x34, 0x27, 0x9c, 0xe0, 0xdf, 0x2f, 0x3a, 0xf5, 0xac,
+	0x44, 0x1f, 0x19, 0xe4, 0x54, 0xc5, 0x8c, 0x4f, 0xdc, 0x9c, 0x88, 0x9e, 0xc0, 0xbd, 0xf4, 0x75,
+	0x24, 0x2e, 0xea, 0x8a, 0x1e, 0x6e, 0x2a, 0x2a, 0xe9, 0xba, 0xa2, 0x03, 0xc1, 0xf8, 0xc9, 0xa7,
+	0xcf, 0x16, 0xcd, 0xc2, 0x2f, 0x7f, 0x36, 0x5b, 0x13, 0xa6, 0xa6, 0xc9, 0xa8, 0x3b, 0x16, 0x61,
+	0xf6, 0xf4, 0xbd, 0xad, 0x0b, 0xaa, 0x59, 0x44, 0xa5, 0x0e, 0x90, 0x3f, 0xad, 0xe6, 0xed, 0x1b,
+	0x01, 0x9d, 0x90, 0xf1, 0xcc, 0xd3, 0x39, 0x7e, 0x5e, 0xcd, 0xdb, 0xc0, 0x35, 0xf9, 0xfa, 0xf5,
+	0xef, 0xcf, 0x9b, 0x85, 0x57, 0xe7, 0xcd, 0xc2, 0xb7, 0xab, 0x79, 0x3b, 0x97, 0xe3, 0xfc, 0x06,
+	0x60, 0xe5, 0x8b, 0x44, 0xbd, 0x76, 0xb7, 0xa9, 0xe5, 0xb7, 0x71, 0x7e, 0x05, 0xb0, 0x72, 0x9a,
+	0x44, 0x51, 0x30, 0x4b, 0xd5, 0x28, 0xa1, 0x48, 0x80, 0xc1, 0xff, 0xa6, 0x46, 0xe7, 0xeb, 0xdf,
+	0xcd, 0xd4, 0x80, 0xe7, 0x17, 0x9d, 0xb7, 0x76, 0x8e, 0x88, 0x16, 0x38, 0xc4, 0xc0, 0xf9, 0x0a,
+	0xee, 0x7f, 0x9c, 0xb6, 0xd9, 0x19, 0x67, 0xea, 0x9a, 0x06, 0x6c, 0xc0, 0x1a, 0x7d, 0x1a, 0x09,
+	0x4e, 0xb9, 0xd2, 0x1d, 0xf8, 0x86, 0xbb, 0xb6, 0xd3, 0xe6, 0x24, 0x01, 0x23, 0x92, 0x4a, 0x5c,
+	0xb2, 0x4b, 0xad, 0x7d, 0x37, 0x37, 0x9d, 0x97, 0x45, 0x58, 0xfb, 0x9c, 0x2a, 0xe2, 0x13, 0x45,
+	0x90, 0x0d, 0x0f, 0x7c, 0x2a, 0xc7, 0x31, 0x8b, 0xf4, 0xd4, 0x98, 0xe3, 0xb7, 0x5d, 0xe8, 0xc3,
+	0x94, 0xc1, 0x45, 0xe8, 0x25, 0x9c, 0xa9, 0xfc, 0xfd, 0xac, 0x9d, 0xf3, 0xbd, 0xd6, 0xeb, 0x42,
+	0x3f, 0xff, 0x94, 0x08, 0xc1, 0x72, 0x5a, 0x57, 0x3d, 0x91, 0xfb, 0xae, 0xfe, 0x4e, 0xd5, 0xf9,
+	0x4c, 0x46, 0x01, 0x99, 0xe1, 0xb2, 0x76, 0xe7, 0x26, 0x7a, 0x07, 0x96, 0x39, 0x09, 0x29, 0xde,
+	0xd3, 0x9d, 0x75, 0xfb, 0xe5, 0x45, 0xe7, 0xe6, 0xa6, 0xd0, 0xf6, 0x51, 0xf7, 0xbd, 0x07, 0xae,
+	0x26, 0xa0, 0x7b, 0xb0, 0x22, 0x67, 0xe1, 0x48, 0x04, 0xb8, 0x72, 0x3d, 0x35, 0xa3, 0xa0, 0x77,
+	0x61, 0x29, 0x89, 0x19, 0xae, 0x6a, 0x66, 0x63, 0xb9, 0x68, 0x96, 0xce, 0xdc, 0xe1, 0x3f, 0x03,
+	0xde, 0x77, 0x53, 0x1a, 0xfa, 0x00, 0xd6, 0x92, 0x98, 0x79, 0x53, 0x22, 0xa7, 0xb8, 0xa6, 0x43,
+	0xac, 0xe5, 0xa2, 0x59, 0x3d, 0x73, 0x87, 0x0f, 0x89, 0x9c, 0xee, 0x0a, 0xab, 0x26, 0x31, 0x4b,
+	0x31, 0xe7, 0x3b, 0x00, 0x6f, 0xea, 0x32, 0x0c, 0x44, 0x18, 0x05, 0x2c, 0xdd, 0x3d, 0xd7, 0x3c,
+	0xde, 0x11, 0xac, 0x30, 0x29, 0x13, 0x1a, 0xe3, 0xe2, 0x7f, 0x0c, 0x51, 0xc6, 0x43, 0xf7, 0xe0,
+	0x2d, 0x12, 0x04, 0xe2, 0x49, 0xc0, 0xa4, 0x5a, 0xaf, 0xc7, 0x92, 0xde, 0x3c, 0x6f, 0xae, 0x81,
+	0x7c, 0xbf, 0x0e, 0x9e, 0x2d, 0x2d, 0xf0, 0x62, 0x69, 0x81, 0xbf, 0x96, 0x16, 0xf8, 0xf1, 0xd2,
+	0x2a, 0xbc, 0xb8, 0xb4, 0x0a, 0x7f, 0x5c, 0x5a, 0x85, 0xaf, 0xef, 0xfe, 0x6b, 0x2b, 0x67, 0x7b,
+	0x50, 0x77, 0xf4, 0xa8, 0xa2, 0xd7, 0xfd, 0x83, 0xbf, 0x07, 0x00, 0xf9, 0x33, 0xd7, 0x17, 0xa2,
+	0x06, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -579,6 +663,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BalanceHistoryRetention != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.BalanceHistoryRetention))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -901,6 +990,53 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomCompliance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomCompliance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomCompliance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowlistEnabled {
+		i--
+		if m.AllowlistEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -927,6 +1063,9 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if m.BalanceHistoryRetention != 0 {
+		n += 1 + sovBank(uint64(m.BalanceHistoryRetention))
+	}
 	return n
 }
 
@@ -1064,6 +1203,26 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *DenomCompliance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.AllowlistEnabled {
+		n += 2
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1153,6 +1312,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceHistoryRetention", wireType)
+			}
+			m.BalanceHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -2033,6 +2211,140 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomCompliance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomCompliance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomCompliance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowlistEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
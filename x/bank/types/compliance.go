@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDenomCompliance creates a new DenomCompliance object.
+func NewDenomCompliance(denom, issuer string, allowlistEnabled bool) DenomCompliance {
+	return DenomCompliance{
+		Denom:            denom,
+		Issuer:           issuer,
+		AllowlistEnabled: allowlistEnabled,
+	}
+}
+
+// Validate performs basic validation of the compliance controls of a denom. An
+// empty issuer is valid and removes the compliance controls of the denom. The
+// issuer address is checked by the keeper against the chain address codec.
+func (dc DenomCompliance) Validate() error {
+	if err := sdk.ValidateDenom(dc.Denom); err != nil {
+		return err
+	}
+
+	if dc.Issuer == "" && dc.AllowlistEnabled {
+		return fmt.Errorf("allowlist enabled without issuer for denom %s", dc.Denom)
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of a list of addresses of a denom. The
+// addresses themselves are checked by the keeper against the chain address
+// codec.
+func (da DenomAddresses) Validate() error {
+	if err := sdk.ValidateDenom(da.Denom); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(da.Addresses))
+	for _, addr := range da.Addresses {
+		if seen[addr] {
+			return fmt.Errorf("duplicate address %s for denom %s", addr, da.Denom)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// validateDenomAddresses validates the frozen or allowlisted addresses of the
+// genesis state, which must belong to a denom with compliance controls.
+func validateDenomAddresses(name string, entries []DenomAddresses, compliance map[string]bool) error {
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if seen[entry.Denom] {
+			return fmt.Errorf("duplicate %s addresses for denom %s", name, entry.Denom)
+		}
+		seen[entry.Denom] = true
+
+		if !compliance[entry.Denom] {
+			return fmt.Errorf("%s addresses for denom %s without compliance controls", name, entry.Denom)
+		}
+
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
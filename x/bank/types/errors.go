@@ -12,4 +12,8 @@ var (
 	ErrInvalidKey            = errors.Register(ModuleName, 7, "invalid key")
 	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
 	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
+	ErrAddressFrozen         = errors.Register(ModuleName, 10, "address is frozen")
+	ErrNotAllowlisted        = errors.Register(ModuleName, 11, "address is not allowlisted")
+	ErrNotIssuer             = errors.Register(ModuleName, 12, "not the issuer of the denom")
+	ErrNoDenomCompliance     = errors.Register(ModuleName, 13, "denom has no compliance controls")
 )
@@ -21,6 +21,19 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// compliance events name and attributes
+	EventTypeSetDenomCompliance = "set_denom_compliance"
+	EventTypeSetFrozen          = "set_frozen"
+	EventTypeSetAllowlisted     = "set_allowlisted"
+	EventTypeClawback           = "clawback"
+
+	AttributeKeyDenom            = "denom"
+	AttributeKeyIssuer           = "issuer"
+	AttributeKeyAddress          = "address"
+	AttributeKeyAllowlistEnabled = "allowlist_enabled"
+	AttributeKeyFrozen           = "frozen"
+	AttributeKeyAllowlisted      = "allowlisted"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
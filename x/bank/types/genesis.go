@@ -62,6 +62,31 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenCompliance := make(map[string]bool, len(gs.DenomCompliance))
+	for _, compliance := range gs.DenomCompliance {
+		if seenCompliance[compliance.Denom] {
+			return fmt.Errorf("duplicate compliance controls for denom %s", compliance.Denom)
+		}
+
+		if err := compliance.Validate(); err != nil {
+			return err
+		}
+
+		if compliance.Issuer == "" {
+			return fmt.Errorf("compliance controls without issuer for denom %s", compliance.Denom)
+		}
+
+		seenCompliance[compliance.Denom] = true
+	}
+
+	if err := validateDenomAddresses("frozen", gs.FrozenAddresses, seenCompliance); err != nil {
+		return err
+	}
+
+	if err := validateDenomAddresses("allowlisted", gs.AllowlistedAddresses, seenCompliance); err != nil {
+		return err
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -40,6 +40,12 @@ type GenesisState struct {
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
 	// send_enabled defines the denoms where send is enabled or disabled.
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// denom_compliance defines the compliance controls of the denoms having an issuer.
+	DenomCompliance []DenomCompliance `protobuf:"bytes,6,rep,name=denom_compliance,json=denomCompliance,proto3" json:"denom_compliance"`
+	// frozen_addresses defines the addresses frozen by the issuer of each denom.
+	FrozenAddresses []DenomAddresses `protobuf:"bytes,7,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses"`
+	// allowlisted_addresses defines the addresses allowlisted by the issuer of each denom.
+	AllowlistedAddresses []DenomAddresses `protobuf:"bytes,8,rep,name=allowlisted_addresses,json=allowlistedAddresses,proto3" json:"allowlisted_addresses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +116,27 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetDenomCompliance() []DenomCompliance {
+	if m != nil {
+		return m.DenomCompliance
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenAddresses() []DenomAddresses {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAllowlistedAddresses() []DenomAddresses {
+	if m != nil {
+		return m.AllowlistedAddresses
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -152,45 +179,109 @@ func (m *Balance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Balance proto.InternalMessageInfo
 
+// DenomAddresses defines a list of addresses of a denom, used in the bank
+// module's genesis state.
+type DenomAddresses struct {
+	// denom is the denom the addresses belong to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// addresses is the list of addresses.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *DenomAddresses) Reset()         { *m = DenomAddresses{} }
+func (m *DenomAddresses) String() string { return proto.CompactTextString(m) }
+func (*DenomAddresses) ProtoMessage()    {}
+func (*DenomAddresses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{2}
+}
+func (m *DenomAddresses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAddresses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAddresses.Merge(m, src)
+}
+func (m *DenomAddresses) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAddresses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAddresses proto.InternalMessageInfo
+
+func (m *DenomAddresses) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomAddresses) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v1beta1.GenesisState")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v1beta1.Balance")
+	proto.RegisterType((*DenomAddresses)(nil), "cosmos.bank.v1beta1.DenomAddresses")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xbf, 0x6e, 0xd3, 0x50,
-	0x14, 0xc6, 0x6d, 0x42, 0xd3, 0xf6, 0x26, 0x80, 0x30, 0x1d, 0xdc, 0x52, 0x9c, 0xd0, 0x29, 0x54,
-	0x8a, 0x4d, 0x03, 0x12, 0x12, 0x03, 0x12, 0x8e, 0x80, 0x09, 0x81, 0x9a, 0x8d, 0x25, 0xba, 0xb6,
-	0x8f, 0x8c, 0xd5, 0xf8, 0x5e, 0x2b, 0xe7, 0x16, 0xc8, 0x1b, 0x30, 0x32, 0x33, 0x75, 0x44, 0x4c,
-	0x1d, 0xfa, 0x00, 0x88, 0xa9, 0x63, 0xc5, 0x84, 0x18, 0x00, 0x25, 0x43, 0x79, 0x0c, 0xe4, 0x7b,
-	0x6e, 0xfe, 0x48, 0x44, 0x8c, 0x5d, 0x6c, 0xcb, 0xdf, 0x77, 0x7e, 0xdf, 0x39, 0xe7, 0xda, 0xec,
-	0x76, 0x2c, 0x31, 0x97, 0x18, 0x44, 0x5c, 0x1c, 0x04, 0x6f, 0xf6, 0x22, 0x50, 0x7c, 0x2f, 0x48,
-	0x41, 0x00, 0x66, 0xe8, 0x17, 0x43, 0xa9, 0xa4, 0x73, 0x83, 0x2c, 0x7e, 0x69, 0xf1, 0x8d, 0x65,
-	0x6b, 0x23, 0x95, 0xa9, 0xd4, 0x7a, 0x50, 0x3e, 0x91, 0x75, 0xcb, 0x9b, 0xd1, 0x10, 0x66, 0xb4,
-	0x58, 0x66, 0xe2, 0x1f, 0x7d, 0x21, 0x4d, 0x73, 0x49, 0xdf, 0x24, 0xbd, 0x4f, 0x60, 0x93, 0x4b,
-	0xd2, 0x75, 0x9e, 0x67, 0x42, 0x06, 0xfa, 0x4a, 0xaf, 0x76, 0xbe, 0x56, 0x58, 0xfd, 0x19, 0xb5,
-	0xda, 0x53, 0x5c, 0x81, 0xf3, 0x88, 0x55, 0x0b, 0x3e, 0xe4, 0x39, 0xba, 0x76, 0xd3, 0x6e, 0xd5,
-	0x3a, 0x37, 0xfd, 0x25, 0xad, 0xfb, 0x2f, 0xb5, 0x25, 0x5c, 0x3f, 0xfd, 0xd9, 0xb0, 0x3e, 0x9d,
-	0x1f, 0xef, 0xda, 0xfb, 0xa6, 0xca, 0xe9, 0xb2, 0xb5, 0x88, 0x0f, 0xb8, 0x88, 0x01, 0xdd, 0x4b,
-	0xcd, 0x4a, 0xab, 0xd6, 0xd9, 0x5e, 0x4a, 0x08, 0xc9, 0xb4, 0x88, 0x98, 0x15, 0x3a, 0x23, 0x56,
-	0xc5, 0xc3, 0xa2, 0x18, 0x8c, 0xdc, 0x8a, 0x46, 0x6c, 0xce, 0x11, 0x08, 0x33, 0x44, 0x57, 0x66,
-	0x22, 0x7c, 0x5a, 0xd6, 0x7f, 0xfe, 0xd5, 0x68, 0xa5, 0x99, 0x7a, 0x7d, 0x18, 0xf9, 0xb1, 0xcc,
-	0xcd, 0xd0, 0xe6, 0xd6, 0xc6, 0xe4, 0x20, 0x50, 0xa3, 0x02, 0x50, 0x17, 0xe0, 0xc7, 0xf3, 0xe3,
-	0xdd, 0xfa, 0x00, 0x52, 0x1e, 0x8f, 0xfa, 0xe5, 0x5a, 0xd1, 0xf4, 0x4f, 0x81, 0xce, 0x0b, 0x76,
-	0x35, 0x01, 0x21, 0xf3, 0x7e, 0x0e, 0x8a, 0x27, 0x5c, 0x71, 0xf7, 0xb2, 0x6e, 0xe1, 0xd6, 0xd2,
-	0x29, 0x9e, 0x1b, 0xd3, 0xe2, 0x18, 0x57, 0x74, 0xfd, 0x54, 0x71, 0x38, 0xab, 0x23, 0x88, 0xa4,
-	0x0f, 0x82, 0x47, 0x03, 0x48, 0xdc, 0x15, 0x8d, 0x6b, 0x2e, 0xc5, 0xf5, 0x40, 0x24, 0x4f, 0xc8,
-	0x17, 0x6e, 0x97, 0xc4, 0x1f, 0x27, 0xed, 0x6b, 0xf3, 0x31, 0x9a, 0x77, 0xfd, 0xfb, 0x0f, 0x28,
-	0xa4, 0x86, 0x73, 0xeb, 0xce, 0x17, 0x9b, 0xad, 0x9a, 0x7d, 0x3a, 0x1d, 0xb6, 0xca, 0x93, 0x64,
-	0x08, 0x48, 0x07, 0xb8, 0x1e, 0xba, 0xdf, 0x4e, 0xda, 0x1b, 0x26, 0xec, 0x31, 0x29, 0x3d, 0x35,
-	0xcc, 0x44, 0xba, 0x3f, 0x35, 0x3a, 0x6f, 0xd9, 0x8a, 0xde, 0x84, 0x39, 0xb0, 0x0b, 0xd8, 0x36,
-	0xe5, 0x3d, 0x5c, 0x7b, 0x7f, 0xd4, 0xb0, 0xfe, 0x1c, 0x35, 0xac, 0xb0, 0x7b, 0x3a, 0xf6, 0xec,
-	0xb3, 0xb1, 0x67, 0xff, 0x1e, 0x7b, 0xf6, 0x87, 0x89, 0x67, 0x9d, 0x4d, 0x3c, 0xeb, 0xfb, 0xc4,
-	0xb3, 0x5e, 0xdd, 0xf9, 0x6f, 0xd4, 0x3b, 0xfa, 0x0f, 0x74, 0x62, 0x54, 0xd5, 0xdf, 0xf4, 0xbd,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x58, 0x75, 0x60, 0x91, 0x03, 0x00, 0x00,
+	// 583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
+	0x1c, 0xc6, 0xed, 0x96, 0xa4, 0xc9, 0x25, 0xb4, 0xe5, 0x08, 0x92, 0x5b, 0x8a, 0x13, 0x02, 0x43,
+	0xa8, 0x14, 0x9b, 0x06, 0x04, 0x12, 0x03, 0x12, 0x0e, 0x2f, 0x13, 0x02, 0x25, 0x13, 0x1d, 0x88,
+	0xce, 0xf6, 0x61, 0xac, 0xda, 0x77, 0x56, 0xce, 0xa5, 0x84, 0x4f, 0xc0, 0xc8, 0xcc, 0xd4, 0x11,
+	0x31, 0x75, 0xe8, 0x07, 0x60, 0xec, 0x58, 0x31, 0x21, 0x86, 0x82, 0x92, 0xa1, 0x7c, 0x0c, 0xe4,
+	0xbb, 0x8b, 0xe3, 0x08, 0xd3, 0x81, 0x81, 0x25, 0x6f, 0xcf, 0xf3, 0xff, 0x3d, 0xff, 0xe7, 0x72,
+	0x32, 0xb8, 0xea, 0x50, 0x16, 0x52, 0x66, 0xda, 0x88, 0xec, 0x98, 0x6f, 0xb6, 0x6c, 0x1c, 0xa3,
+	0x2d, 0xd3, 0xc3, 0x04, 0x33, 0x9f, 0x19, 0xd1, 0x90, 0xc6, 0x14, 0x5e, 0x14, 0x16, 0x23, 0xb1,
+	0x18, 0xd2, 0xb2, 0x5e, 0xf3, 0xa8, 0x47, 0xb9, 0x6e, 0x26, 0x9f, 0x84, 0x75, 0x5d, 0x4f, 0x69,
+	0x0c, 0xa7, 0x34, 0x87, 0xfa, 0xe4, 0x0f, 0x3d, 0x93, 0xc6, 0xb9, 0x42, 0x5f, 0x13, 0xfa, 0x40,
+	0x80, 0x65, 0xae, 0x90, 0x2e, 0xa0, 0xd0, 0x27, 0xd4, 0xe4, 0xaf, 0xe2, 0xa7, 0xe6, 0x49, 0x01,
+	0x54, 0x9f, 0x88, 0x55, 0xfb, 0x31, 0x8a, 0x31, 0xbc, 0x0f, 0x8a, 0x11, 0x1a, 0xa2, 0x90, 0x69,
+	0x6a, 0x43, 0x6d, 0x55, 0x3a, 0x97, 0x8d, 0x9c, 0xd5, 0x8d, 0xe7, 0xdc, 0x62, 0x95, 0x8f, 0x4e,
+	0xea, 0xca, 0xa7, 0xd3, 0x83, 0x4d, 0xb5, 0x27, 0xa7, 0x60, 0x17, 0x94, 0x6c, 0x14, 0x20, 0xe2,
+	0x60, 0xa6, 0x2d, 0x34, 0x16, 0x5b, 0x95, 0xce, 0x46, 0x2e, 0xc1, 0x12, 0xa6, 0x2c, 0x22, 0x1d,
+	0x84, 0x23, 0x50, 0x64, 0xbb, 0x51, 0x14, 0x8c, 0xb4, 0x45, 0x8e, 0x58, 0x9b, 0x21, 0x18, 0x4e,
+	0x11, 0x5d, 0xea, 0x13, 0xeb, 0x71, 0x32, 0xff, 0xf9, 0x47, 0xbd, 0xe5, 0xf9, 0xf1, 0xeb, 0x5d,
+	0xdb, 0x70, 0x68, 0x28, 0x4b, 0xcb, 0xb7, 0x36, 0x73, 0x77, 0xcc, 0x78, 0x14, 0x61, 0xc6, 0x07,
+	0xd8, 0xc7, 0xd3, 0x83, 0xcd, 0x6a, 0x80, 0x3d, 0xe4, 0x8c, 0x06, 0xc9, 0xb1, 0x32, 0xb9, 0xbf,
+	0x08, 0x84, 0xcf, 0xc0, 0xb2, 0x8b, 0x09, 0x0d, 0x07, 0x21, 0x8e, 0x91, 0x8b, 0x62, 0xa4, 0x9d,
+	0xe3, 0x2b, 0x5c, 0xc9, 0x6d, 0xf1, 0x54, 0x9a, 0xb2, 0x35, 0xce, 0xf3, 0xf9, 0xa9, 0x02, 0x11,
+	0xa8, 0x32, 0x4c, 0xdc, 0x01, 0x26, 0xc8, 0x0e, 0xb0, 0xab, 0x15, 0x38, 0xae, 0x91, 0x8b, 0xeb,
+	0x63, 0xe2, 0x3e, 0x12, 0x3e, 0x6b, 0x23, 0x21, 0x7e, 0x3f, 0x6c, 0xaf, 0xcc, 0x6a, 0x34, 0x6e,
+	0x1a, 0xb7, 0xef, 0x8a, 0x90, 0x0a, 0x9b, 0x59, 0xe1, 0x36, 0x58, 0x15, 0x3b, 0x3b, 0x34, 0x8c,
+	0x02, 0x3f, 0x39, 0x43, 0xad, 0xc8, 0x63, 0xae, 0xe7, 0xc6, 0x3c, 0x4c, 0xcc, 0xdd, 0xd4, 0x9b,
+	0x5d, 0x7e, 0xc5, 0x9d, 0xd7, 0xe0, 0x0b, 0xb0, 0xfa, 0x6a, 0x48, 0xdf, 0x61, 0x32, 0x40, 0xae,
+	0x3b, 0xc4, 0x8c, 0x61, 0xa6, 0x2d, 0x71, 0xf6, 0xb5, 0xbf, 0xb3, 0x1f, 0x4c, 0xad, 0x73, 0x68,
+	0xc1, 0x49, 0x35, 0xe8, 0x80, 0x4b, 0x28, 0x08, 0xe8, 0x5e, 0xe0, 0xb3, 0x18, 0xbb, 0x19, 0x7e,
+	0xe9, 0x9f, 0xf8, 0xb5, 0x0c, 0x2c, 0x35, 0x34, 0xbf, 0xa8, 0x60, 0x49, 0xde, 0x35, 0xd8, 0x01,
+	0x4b, 0x32, 0x84, 0x5f, 0xee, 0xb2, 0xa5, 0x7d, 0x3d, 0x6c, 0xd7, 0x64, 0x8a, 0x1c, 0xe9, 0xc7,
+	0x43, 0x9f, 0x78, 0xbd, 0xa9, 0x11, 0xee, 0x81, 0x02, 0xbf, 0x25, 0xda, 0xc2, 0xff, 0xba, 0x89,
+	0x22, 0xef, 0x5e, 0xe9, 0xfd, 0x7e, 0x5d, 0xf9, 0xb5, 0x5f, 0x57, 0x9a, 0x2f, 0xc1, 0xf2, 0x7c,
+	0x6b, 0x58, 0x03, 0x05, 0xfe, 0x3f, 0x89, 0x1a, 0x3d, 0xf1, 0x05, 0xde, 0x01, 0xe5, 0xd9, 0x19,
+	0x26, 0xeb, 0x9e, 0x55, 0x70, 0x66, 0xb5, 0xba, 0x47, 0x63, 0x5d, 0x3d, 0x1e, 0xeb, 0xea, 0xcf,
+	0xb1, 0xae, 0x7e, 0x98, 0xe8, 0xca, 0xf1, 0x44, 0x57, 0xbe, 0x4d, 0x74, 0x65, 0xfb, 0xc6, 0x99,
+	0x55, 0xde, 0x8a, 0x67, 0x10, 0x6f, 0x64, 0x17, 0xf9, 0xf3, 0xe4, 0xd6, 0xef, 0x01, 0x00, 0x28,
+	0x9f, 0x1b, 0x41, 0x0d, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -213,6 +304,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowlistedAddresses) > 0 {
+		for iNdEx := len(m.AllowlistedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowlistedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DenomCompliance) > 0 {
+		for iNdEx := len(m.DenomCompliance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCompliance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -326,6 +459,45 @@ func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -369,6 +541,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DenomCompliance) > 0 {
+		for _, e := range m.DenomCompliance {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for _, e := range m.FrozenAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AllowlistedAddresses) > 0 {
+		for _, e := range m.AllowlistedAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -391,6 +581,25 @@ func (m *Balance) Size() (n int) {
 	return n
 }
 
+func (m *DenomAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -595,6 +804,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCompliance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCompliance = append(m.DenomCompliance, DenomCompliance{})
+			if err := m.DenomCompliance[len(m.DenomCompliance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, DenomAddresses{})
+			if err := m.FrozenAddresses[len(m.FrozenAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowlistedAddresses = append(m.AllowlistedAddresses, DenomAddresses{})
+			if err := m.AllowlistedAddresses[len(m.AllowlistedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -732,6 +1043,120 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
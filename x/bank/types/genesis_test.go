@@ -143,6 +143,44 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid denom compliance",
+			GenesisState{
+				DenomCompliance:      []DenomCompliance{NewDenomCompliance("uusd", "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", true)},
+				FrozenAddresses:      []DenomAddresses{{Denom: "uusd", Addresses: []string{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"}}},
+				AllowlistedAddresses: []DenomAddresses{{Denom: "uusd", Addresses: []string{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"}}},
+			},
+			false,
+		},
+		{
+			"duplicate denom compliance",
+			GenesisState{
+				DenomCompliance: []DenomCompliance{NewDenomCompliance("uusd", "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", true), NewDenomCompliance("uusd", "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", false)},
+			},
+			true,
+		},
+		{
+			"denom compliance without issuer",
+			GenesisState{
+				DenomCompliance: []DenomCompliance{NewDenomCompliance("uusd", "", false)},
+			},
+			true,
+		},
+		{
+			"frozen addresses without denom compliance",
+			GenesisState{
+				FrozenAddresses: []DenomAddresses{{Denom: "uusd", Addresses: []string{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"}}},
+			},
+			true,
+		},
+		{
+			"duplicate allowlisted address",
+			GenesisState{
+				DenomCompliance:      []DenomCompliance{NewDenomCompliance("uusd", "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", true)},
+				AllowlistedAddresses: []DenomAddresses{{Denom: "uusd", Addresses: []string{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"}}},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -35,6 +35,24 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// DenomCompliancePrefix is the prefix for the compliance controls of a denom.
+	DenomCompliancePrefix = collections.NewPrefix(6)
+	// FrozenAddressesPrefix is the prefix for the addresses frozen by the issuer of a denom.
+	FrozenAddressesPrefix = collections.NewPrefix(7)
+	// AllowlistedAddressesPrefix is the prefix for the addresses allowlisted by the issuer of a denom.
+	AllowlistedAddressesPrefix = collections.NewPrefix(8)
+
+	// BalanceHistoryPrefix is the prefix for the balance history of the accounts.
+	BalanceHistoryPrefix = collections.NewPrefix(9)
+	// BalanceHistoryByHeightPrefix is the prefix for the balance history indexed by height.
+	BalanceHistoryByHeightPrefix = collections.NewPrefix(10)
+	// SupplyHistoryPrefix is the prefix for the supply history of the denoms.
+	SupplyHistoryPrefix = collections.NewPrefix(11)
+	// SupplyHistoryByHeightPrefix is the prefix for the supply history indexed by height.
+	SupplyHistoryByHeightPrefix = collections.NewPrefix(12)
+	// BalanceHistoryEnabledKey is the key of the flag set while the balance history is enabled.
+	BalanceHistoryEnabledKey = collections.NewPrefix(13)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
@@ -8,6 +8,10 @@ var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetDenomCompliance{}
+	_ sdk.Msg = &MsgSetFrozen{}
+	_ sdk.Msg = &MsgSetAllowlisted{}
+	_ sdk.Msg = &MsgClawback{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -28,3 +32,41 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgSetDenomCompliance constructs a message to set the compliance controls of a denom.
+func NewMsgSetDenomCompliance(authority string, compliance DenomCompliance) *MsgSetDenomCompliance {
+	return &MsgSetDenomCompliance{
+		Authority:  authority,
+		Compliance: compliance,
+	}
+}
+
+// NewMsgSetFrozen constructs a message to freeze or unfreeze an address for a denom.
+func NewMsgSetFrozen(issuer, denom, address string, frozen bool) *MsgSetFrozen {
+	return &MsgSetFrozen{
+		Issuer:  issuer,
+		Denom:   denom,
+		Address: address,
+		Frozen:  frozen,
+	}
+}
+
+// NewMsgSetAllowlisted constructs a message to add or remove an address from
+// the allowlist of a denom.
+func NewMsgSetAllowlisted(issuer, denom, address string, allowlisted bool) *MsgSetAllowlisted {
+	return &MsgSetAllowlisted{
+		Issuer:      issuer,
+		Denom:       denom,
+		Address:     address,
+		Allowlisted: allowlisted,
+	}
+}
+
+// NewMsgClawback constructs a message to claw back an amount from an address.
+func NewMsgClawback(issuer, address string, amount sdk.Coin) *MsgClawback {
+	return &MsgClawback{
+		Issuer:  issuer,
+		Address: address,
+		Amount:  amount,
+	}
+}
@@ -114,6 +114,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "DelegatorAutoCompound",
+					Use:       "delegator-auto-compound [delegator-address]",
+					Short:     "Query the validators a delegator has enabled auto-compound for",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "AutoCompoundRound",
+					Use:       "auto-compound-round",
+					Short:     "Query the auto-compound round in progress",
+				},
+				{
+					RpcMethod: "CommissionRecipients",
+					Use:       "commission-recipients [validator-address]",
+					Short:     "Query the recipients the commission of a validator is split among",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -144,6 +165,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound [validator-addr] [enabled]",
+					Short:     "Enable or disable the automatic restaking of the rewards of a delegation",
+					Example:   fmt.Sprintf("%s tx distribution set-auto-compound cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp true --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "enabled"},
+					},
+				},
+				{
+					RpcMethod: "SetCommissionRecipients",
+					Use:       "set-commission-recipients [validator-addr] [recipient]...",
+					Short:     "Split the commission of a validator among weighted recipients (must be a validator operator)",
+					Long:      "Set the recipients the commission of a validator is split among when withdrawn. The weights must sum up to one. Passing no recipient pays the commission back to the withdraw address of the operator.",
+					Example:   fmt.Sprintf(`%s tx distribution set-commission-recipients cosmosvaloper1x20lytyf6zkcrv5edpkfkn8sz578qg5sqfyqnp '{"address":"cosmos1...","weight":"0.7"}' '{"address":"cosmos1...","weight":"0.3"}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "recipients", Varargs: true},
+					},
+				},
 				{
 					RpcMethod: "DepositValidatorRewardsPool",
 					Use:       "fund-validator-rewards-pool [validator-addr] [amount]",
@@ -29,5 +29,21 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 
 	// record the proposer for when we pay out on the next block
 	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
-	return k.SetPreviousProposerConsAddr(ctx, consAddr)
+	if err := k.SetPreviousProposerConsAddr(ctx, consAddr); err != nil {
+		return err
+	}
+
+	// restake the rewards of the delegations having auto-compound enabled,
+	// within the gas budget of the block
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.AutoCompoundInterval > 0 && uint64(height)%params.AutoCompoundInterval == 0 {
+		if err := k.StartAutoCompoundRound(ctx); err != nil {
+			return err
+		}
+	}
+
+	return k.ProcessAutoCompoundRound(ctx)
 }
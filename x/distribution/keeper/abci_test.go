@@ -68,6 +68,7 @@ func setupTest(t *testing.T) testSetup {
 		CommunityPool: sdk.NewDecCoins(),
 	})
 	require.NoError(t, err)
+	require.NoError(t, distrKeeper.Params.Set(testCtx.Ctx, types.DefaultParams()))
 
 	return testSetup{
 		testCtx:       testCtx,
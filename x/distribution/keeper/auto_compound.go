@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetDelegationAutoCompound enables or disables the automatic restaking of the rewards
+// of the delegation of delAddr to valAddr.
+func (k Keeper) SetDelegationAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	key := collections.Join(delAddr, valAddr)
+	if !enabled {
+		return k.AutoCompoundDelegations.Remove(ctx, key)
+	}
+
+	del, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if err != nil && !errors.Is(err, stakingtypes.ErrNoDelegation) {
+		return err
+	}
+	if del == nil {
+		return types.ErrNoDelegationExists
+	}
+
+	return k.AutoCompoundDelegations.Set(ctx, key)
+}
+
+// StartAutoCompoundRound starts an auto-compound round over the delegations
+// having auto-compound enabled, unless a round is already in progress.
+func (k Keeper) StartAutoCompoundRound(ctx context.Context) error {
+	has, err := k.ActiveAutoCompoundRound.Has(ctx)
+	if err != nil || has {
+		return err
+	}
+
+	key, found, err := k.nextAutoCompoundDelegation(ctx, nil)
+	if err != nil || !found {
+		return err
+	}
+
+	next, err := k.autoCompoundDelegation(key)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompoundRound,
+			sdk.NewAttribute(types.AttributeKeyStartHeight, strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
+			sdk.NewAttribute(types.AttributeKeyCompleted, strconv.FormatBool(false)),
+		),
+	)
+
+	return k.ActiveAutoCompoundRound.Set(ctx, types.AutoCompoundRound{
+		StartHeight: sdkCtx.BlockHeight(),
+		Next:        next,
+	})
+}
+
+// ProcessAutoCompoundRound restakes the rewards of the delegations of the
+// round in progress until the auto-compound gas budget of the block is spent.
+// The round resumes from the next delegation in the following block.
+func (k Keeper) ProcessAutoCompoundRound(ctx context.Context) error {
+	round, err := k.ActiveAutoCompoundRound.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(round.Next.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(round.Next.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	gasMeter := sdkCtx.GasMeter()
+	startGas := gasMeter.GasConsumed()
+	processed := 0
+
+	rng := new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]]).
+		StartInclusive(collections.Join(sdk.AccAddress(delAddr), sdk.ValAddress(valAddr)))
+	for {
+		key, found, err := k.nextAutoCompoundDelegation(ctx, rng)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeAutoCompoundRound,
+					sdk.NewAttribute(types.AttributeKeyStartHeight, strconv.FormatInt(round.StartHeight, 10)),
+					sdk.NewAttribute(types.AttributeKeyCompleted, strconv.FormatBool(true)),
+				),
+			)
+			return k.ActiveAutoCompoundRound.Remove(ctx)
+		}
+
+		// at least one delegation is processed per block so that the round
+		// always progresses
+		if processed > 0 && gasMeter.GasConsumed()-startGas >= params.AutoCompoundGasBudget {
+			round.Next, err = k.autoCompoundDelegation(key)
+			if err != nil {
+				return err
+			}
+			return k.ActiveAutoCompoundRound.Set(ctx, round)
+		}
+
+		if err := k.compoundDelegation(ctx, key.K1(), key.K2(), params); err != nil {
+			return err
+		}
+		processed++
+
+		rng = new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]]).StartExclusive(key)
+	}
+}
+
+// compoundDelegation withdraws the rewards of a delegation to the delegator
+// and delegates the bond denom rewards back to the validator, provided they
+// reach the auto-compound min amount. A delegation failing to be compounded is
+// skipped, a delegation which no longer exists is dropped.
+func (k Keeper) compoundDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, params types.Params) error {
+	del, err := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if err != nil && !errors.Is(err, stakingtypes.ErrNoDelegation) {
+		return err
+	}
+	if del == nil {
+		return k.AutoCompoundDelegations.Remove(ctx, collections.Join(delAddr, valAddr))
+	}
+
+	val, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	validator, ok := val.(stakingtypes.Validator)
+	if !ok {
+		return nil
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	rewards, err := k.withdrawDelegationRewards(cacheCtx, val, del, newWithdrawToAddress(delAddr, delAddr))
+	if err == nil {
+		err = k.initializeDelegation(cacheCtx, valAddr, delAddr)
+	}
+	if err != nil {
+		k.Logger(ctx).Error("failed to withdraw auto-compound rewards", "delegator", del.GetDelegatorAddr(), "validator", val.GetOperator(), "error", err)
+		return nil
+	}
+
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() || amount.LT(params.AutoCompoundMinAmount) {
+		return nil
+	}
+
+	if _, err := k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+		k.Logger(ctx).Error("failed to restake auto-compound rewards", "delegator", del.GetDelegatorAddr(), "validator", val.GetOperator(), "error", err)
+		return nil
+	}
+
+	write()
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, del.GetDelegatorAddr()),
+		),
+	)
+
+	return nil
+}
+
+// nextAutoCompoundDelegation returns the first delegation having auto-compound
+// enabled within the given range.
+func (k Keeper) nextAutoCompoundDelegation(
+	ctx context.Context, rng collections.Ranger[collections.Pair[sdk.AccAddress, sdk.ValAddress]],
+) (collections.Pair[sdk.AccAddress, sdk.ValAddress], bool, error) {
+	iter, err := k.AutoCompoundDelegations.Iterate(ctx, rng)
+	if err != nil {
+		return collections.Pair[sdk.AccAddress, sdk.ValAddress]{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return collections.Pair[sdk.AccAddress, sdk.ValAddress]{}, false, nil
+	}
+
+	key, err := iter.Key()
+	return key, err == nil, err
+}
+
+func (k Keeper) autoCompoundDelegation(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (types.AutoCompoundDelegation, error) {
+	delAddr, err := k.authKeeper.AddressCodec().BytesToString(key.K1())
+	if err != nil {
+		return types.AutoCompoundDelegation{}, err
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K2())
+	if err != nil {
+		return types.AutoCompoundDelegation{}, err
+	}
+
+	return types.AutoCompoundDelegation{DelegatorAddress: delAddr, ValidatorAddress: valAddr}, nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// SetValidatorCommissionRecipients sets the recipients the commission of a
+// validator is split among when withdrawn. An empty list of recipients pays the
+// commission back to the withdraw address of the validator operator.
+func (k Keeper) SetValidatorCommissionRecipients(ctx context.Context, valAddr sdk.ValAddress, recipients []types.CommissionRecipient) error {
+	if len(recipients) == 0 {
+		return k.ValidatorCommissionRecipients.Remove(ctx, valAddr)
+	}
+
+	if err := types.ValidateCommissionRecipients(recipients); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidRecipients, err.Error())
+	}
+
+	for _, r := range recipients {
+		addr, err := k.authKeeper.AddressCodec().StringToBytes(r.Address)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+		}
+
+		if k.bankKeeper.BlockedAddr(addr) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", r.Address)
+		}
+	}
+
+	return k.ValidatorCommissionRecipients.Set(ctx, valAddr, types.CommissionRecipients{Recipients: recipients})
+}
+
+// GetValidatorCommissionRecipients returns the recipients the commission of a
+// validator is split among, or nil if the commission is paid to the withdraw
+// address of the validator operator.
+func (k Keeper) GetValidatorCommissionRecipients(ctx context.Context, valAddr sdk.ValAddress) ([]types.CommissionRecipient, error) {
+	recipients, err := k.ValidatorCommissionRecipients.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return recipients.Recipients, nil
+}
+
+// sendCommissionToRecipients splits the withdrawn commission of a validator
+// among its recipients according to their weights. Each share is truncated and
+// the last recipient receives the remainder so that the whole commission is
+// paid out.
+func (k Keeper) sendCommissionToRecipients(ctx context.Context, valAddr sdk.ValAddress, commission sdk.Coins, recipients []types.CommissionRecipient) error {
+	valOperator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	remaining := commission
+	for i, r := range recipients {
+		addr, err := k.authKeeper.AddressCodec().StringToBytes(r.Address)
+		if err != nil {
+			return err
+		}
+
+		share := remaining
+		if i < len(recipients)-1 {
+			share = sdk.NewCoins()
+			for _, coin := range commission {
+				share = share.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToLegacyDec().Mul(r.Weight).TruncateInt()))
+			}
+		}
+		remaining = remaining.Sub(share...)
+
+		if share.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, share); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommissionPayout,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, share.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valOperator),
+				sdk.NewAttribute(types.AttributeKeyRecipient, r.Address),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks wrapper struct for the distribution keeper
+type EpochHooks struct {
+	k Keeper
+}
+
+// EpochHooks returns the epoch hooks of the distribution keeper, which start
+// an auto-compound round at the end of the auto-compound epoch.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// AfterEpochEnd starts an auto-compound round when the ended epoch is the
+// auto-compound epoch.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundEpochIdentifier == "" || params.AutoCompoundEpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	return h.k.StartAutoCompoundRound(ctx)
+}
+
+// BeforeEpochStart is a no-op.
+func (h EpochHooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
@@ -115,6 +118,37 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 	}
 
+	for _, ac := range data.AutoCompoundDelegations {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(ac.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(ac.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.AutoCompoundDelegations.Set(ctx, collections.Join(sdk.AccAddress(delegatorAddress), sdk.ValAddress(valAddr)))
+		if err != nil {
+			panic(err)
+		}
+	}
+	if data.AutoCompoundRound != nil {
+		if err := k.ActiveAutoCompoundRound.Set(ctx, *data.AutoCompoundRound); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range data.ValidatorCommissionRecipients {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.ValidatorCommissionRecipients.Set(ctx, valAddr, types.CommissionRecipients{Recipients: record.Recipients})
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -230,5 +264,44 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompound := make([]types.AutoCompoundDelegation, 0)
+	err = k.AutoCompoundDelegations.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (stop bool, err error) {
+		ac, err := k.autoCompoundDelegation(key)
+		if err != nil {
+			return true, err
+		}
+		autoCompound = append(autoCompound, ac)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genState := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	genState.AutoCompoundDelegations = autoCompound
+
+	err = k.ValidatorCommissionRecipients.Walk(ctx, nil, func(valAddr sdk.ValAddress, recipients types.CommissionRecipients) (stop bool, err error) {
+		valOperator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return true, err
+		}
+		genState.ValidatorCommissionRecipients = append(genState.ValidatorCommissionRecipients, types.ValidatorCommissionRecipientsRecord{
+			ValidatorAddress: valOperator,
+			Recipients:       recipients.Recipients,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	round, err := k.ActiveAutoCompoundRound.Get(ctx)
+	switch {
+	case err == nil:
+		genState.AutoCompoundRound = &round
+	case !errors.Is(err, collections.ErrNotFound):
+		panic(err)
+	}
+
+	return genState
 }
@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -474,3 +475,70 @@ func (k Querier) DelegatorStartingInfo(ctx context.Context, req *types.QueryDele
 
 	return &types.QueryDelegatorStartingInfoResponse{StartingInfo: startingInfo}, nil
 }
+
+// DelegatorAutoCompound queries the validators a delegator has enabled auto-compound for
+func (k Querier) DelegatorAutoCompound(ctx context.Context, req *types.QueryDelegatorAutoCompoundRequest) (*types.QueryDelegatorAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validators, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.AutoCompoundDelegations,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], _ collections.NoValue) (string, error) {
+			return k.stakingKeeper.ValidatorAddressCodec().BytesToString(key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.ValAddress](delAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegatorAutoCompoundResponse{Validators: validators, Pagination: pageRes}, nil
+}
+
+// CommissionRecipients queries the recipients the commission of a validator is split among
+func (k Querier) CommissionRecipients(ctx context.Context, req *types.QueryCommissionRecipientsRequest) (*types.QueryCommissionRecipientsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients, err := k.GetValidatorCommissionRecipients(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCommissionRecipientsResponse{Recipients: recipients}, nil
+}
+
+// AutoCompoundRound queries the auto-compound round in progress
+func (k Querier) AutoCompoundRound(ctx context.Context, _ *types.QueryAutoCompoundRoundRequest) (*types.QueryAutoCompoundRoundResponse, error) {
+	round, err := k.ActiveAutoCompoundRound.Get(ctx)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return &types.QueryAutoCompoundRoundResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAutoCompoundRoundResponse{Round: &round}, nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -101,6 +102,12 @@ func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, val
 		return err
 	}
 
+	// remove commission recipients
+	err = h.k.ValidatorCommissionRecipients.Remove(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
 	// clear slashes
 	h.k.DeleteValidatorSlashEvents(ctx, valAddr)
 
@@ -189,8 +196,9 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
-	return nil
+// BeforeDelegationRemoved disables the auto-compounding of the removed delegation
+func (h Hooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.AutoCompoundDelegations.Remove(ctx, collections.Join(delAddr, valAddr))
 }
 
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
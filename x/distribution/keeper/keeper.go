@@ -29,6 +29,14 @@ type Keeper struct {
 	Schema  collections.Schema
 	Params  collections.Item[types.Params]
 	FeePool collections.Item[types.FeePool]
+	// AutoCompoundDelegations holds the delegations whose rewards are restaked
+	// automatically, keyed by delegator and validator.
+	AutoCompoundDelegations collections.KeySet[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	// ActiveAutoCompoundRound holds the auto-compound round in progress.
+	ActiveAutoCompoundRound collections.Item[types.AutoCompoundRound]
+	// ValidatorCommissionRecipients holds the recipients the commission of a
+	// validator is split among when withdrawn, keyed by validator.
+	ValidatorCommissionRecipients collections.Map[sdk.ValAddress, types.CommissionRecipients]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -58,6 +66,15 @@ func NewKeeper(
 		authority:        authority,
 		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		FeePool:          collections.NewItem(sb, types.FeePoolKey, "fee_pool", codec.CollValue[types.FeePool](cdc)),
+		AutoCompoundDelegations: collections.NewKeySet(
+			sb, types.AutoCompoundDelegationsKey, "auto_compound_delegations",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey),
+		),
+		ActiveAutoCompoundRound: collections.NewItem(sb, types.AutoCompoundRoundKey, "auto_compound_round", codec.CollValue[types.AutoCompoundRound](cdc)),
+		ValidatorCommissionRecipients: collections.NewMap(
+			sb, types.ValidatorCommissionRecipientsKey, "validator_commission_recipients",
+			sdk.ValAddressKey, codec.CollValue[types.CommissionRecipients](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -175,15 +192,27 @@ func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.Val
 	}
 
 	if !commission.IsZero() {
-		// determine where commission for this validator should go
-		dest, err := k.resolveWithdrawDestinationStrict(ctx, sdk.AccAddress(valAddr))
+		recipients, err := k.GetValidatorCommissionRecipients(ctx, valAddr)
 		if err != nil {
 			return nil, err
 		}
 
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, dest.ResolvedWithdrawAddr, commission)
-		if err != nil {
-			return nil, err
+		if len(recipients) > 0 {
+			// split the commission among the recipients set by the validator
+			if err := k.sendCommissionToRecipients(ctx, valAddr, commission, recipients); err != nil {
+				return nil, err
+			}
+		} else {
+			// determine where commission for this validator should go
+			dest, err := k.resolveWithdrawDestinationStrict(ctx, sdk.AccAddress(valAddr))
+			if err != nil {
+				return nil, err
+			}
+
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, dest.ResolvedWithdrawAddr, commission)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
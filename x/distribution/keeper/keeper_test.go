@@ -132,6 +132,74 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	}, remainder)
 }
 
+func TestWithdrawValidatorCommission_Recipients(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+	addrs := simtestutil.CreateIncrementalAccounts(4)
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// the weights must sum up to one
+	err := distrKeeper.SetValidatorCommissionRecipients(ctx, valAddr, []types.CommissionRecipient{
+		{Address: addrs[1].String(), Weight: math.LegacyNewDecWithPrec(5, 1)},
+		{Address: addrs[2].String(), Weight: math.LegacyNewDecWithPrec(4, 1)},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidRecipients)
+
+	recipients := []types.CommissionRecipient{
+		{Address: addrs[1].String(), Weight: math.LegacyNewDecWithPrec(5, 1)},
+		{Address: addrs[2].String(), Weight: math.LegacyNewDecWithPrec(3, 1)},
+		{Address: addrs[3].String(), Weight: math.LegacyNewDecWithPrec(2, 1)},
+	}
+	require.NoError(t, distrKeeper.SetValidatorCommissionRecipients(ctx, valAddr, recipients))
+
+	got, err := distrKeeper.GetValidatorCommissionRecipients(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, recipients, got)
+
+	valCommission := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(11))}
+	require.NoError(t, distrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: valCommission}))
+	require.NoError(t, distrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: valCommission}))
+
+	// the shares are truncated and the last recipient receives the remainder
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", addrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 5))).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", addrs[2], sdk.NewCoins(sdk.NewInt64Coin("stake", 3))).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", addrs[3], sdk.NewCoins(sdk.NewInt64Coin("stake", 3))).Return(nil)
+
+	commission, err := distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 11)), commission)
+
+	// an empty list pays the commission back to the operator
+	require.NoError(t, distrKeeper.SetValidatorCommissionRecipients(ctx, valAddr, nil))
+	got, err = distrKeeper.GetValidatorCommissionRecipients(ctx, valAddr)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
 func TestWithdrawValidatorCommission_BlockedWithdrawAddress(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(types.StoreKey)
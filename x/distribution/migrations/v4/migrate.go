@@ -0,0 +1,32 @@
+package v4
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// Migrate adds the auto-compound params. Existing chains inherit the default
+// auto-compound interval, gas budget and min amount when the fields are absent.
+func Migrate(ctx context.Context, params collections.Item[types.Params]) error {
+	p, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// params predating auto-compound have no gas budget
+	if p.AutoCompoundGasBudget == 0 {
+		p.AutoCompoundInterval = types.DefaultAutoCompoundInterval
+		p.AutoCompoundGasBudget = types.DefaultAutoCompoundGasBudget
+	}
+	if p.AutoCompoundMinAmount.IsNil() {
+		p.AutoCompoundMinAmount = types.DefaultAutoCompoundMinAmount
+	}
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return params.Set(ctx, p)
+}
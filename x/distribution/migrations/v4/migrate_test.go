@@ -0,0 +1,54 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	v4 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey))
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	t.Run("sets missing auto-compound params", func(t *testing.T) {
+		require.NoError(t, params.Set(ctx, types.Params{
+			CommunityTax:        math.LegacyNewDecWithPrec(2, 2),
+			WithdrawAddrEnabled: true,
+		}))
+		require.NoError(t, v4.Migrate(ctx, params))
+
+		res, err := params.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, types.DefaultParams(), res)
+	})
+
+	t.Run("preserves pre-existing auto-compound params", func(t *testing.T) {
+		p := types.DefaultParams()
+		p.AutoCompoundInterval = 0
+		p.AutoCompoundEpochIdentifier = "day"
+		p.AutoCompoundMinAmount = math.NewInt(1000)
+		require.NoError(t, params.Set(ctx, p))
+		require.NoError(t, v4.Migrate(ctx, params))
+
+		res, err := params.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, p, res)
+	})
+}
@@ -11,8 +11,9 @@ import (
 
 // Simulation parameter constants
 const (
-	CommunityTax    = "community_tax"
-	WithdrawEnabled = "withdraw_enabled"
+	CommunityTax         = "community_tax"
+	WithdrawEnabled      = "withdraw_enabled"
+	AutoCompoundInterval = "auto_compound_interval"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -25,6 +26,11 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenAutoCompoundInterval returns a randomized AutoCompoundInterval parameter.
+func GenAutoCompoundInterval(r *rand.Rand) uint64 {
+	return uint64(r.Intn(20))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax math.LegacyDec
@@ -33,11 +39,17 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var withdrawEnabled bool
 	simState.AppParams.GetOrGenerate(WithdrawEnabled, &withdrawEnabled, simState.Rand, func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) })
 
+	var autoCompoundInterval uint64
+	simState.AppParams.GetOrGenerate(AutoCompoundInterval, &autoCompoundInterval, simState.Rand, func(r *rand.Rand) { autoCompoundInterval = GenAutoCompoundInterval(r) })
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:          communityTax,
+			WithdrawAddrEnabled:   withdrawEnabled,
+			AutoCompoundInterval:  autoCompoundInterval,
+			AutoCompoundMinAmount: types.DefaultAutoCompoundMinAmount,
+			AutoCompoundGasBudget: types.DefaultAutoCompoundGasBudget,
 		},
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&distrGenesis)
@@ -38,6 +38,18 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_interval is the number of blocks between two auto-compound
+	// rounds. Zero disables the block based rounds.
+	AutoCompoundInterval uint64 `protobuf:"varint,5,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty"`
+	// auto_compound_epoch_identifier is the x/epochs epoch at the end of which an
+	// auto-compound round starts. Empty disables the epoch based rounds.
+	AutoCompoundEpochIdentifier string `protobuf:"bytes,6,opt,name=auto_compound_epoch_identifier,json=autoCompoundEpochIdentifier,proto3" json:"auto_compound_epoch_identifier,omitempty"`
+	// auto_compound_min_amount is the minimum amount of bond denom rewards of a
+	// delegation for it to be restaked by an auto-compound round.
+	AutoCompoundMinAmount cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=auto_compound_min_amount,json=autoCompoundMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"auto_compound_min_amount"`
+	// auto_compound_gas_budget is the gas an auto-compound round may consume per
+	// block, the remaining delegations are processed in the next blocks.
+	AutoCompoundGasBudget uint64 `protobuf:"varint,8,opt,name=auto_compound_gas_budget,json=autoCompoundGasBudget,proto3" json:"auto_compound_gas_budget,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +92,27 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundInterval() uint64 {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundEpochIdentifier() string {
+	if m != nil {
+		return m.AutoCompoundEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetAutoCompoundGasBudget() uint64 {
+	if m != nil {
+		return m.AutoCompoundGasBudget
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -623,6 +656,168 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// AutoCompoundDelegation is a delegation whose rewards are restaked
+// automatically.
+type AutoCompoundDelegation struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoCompoundDelegation) Reset()         { *m = AutoCompoundDelegation{} }
+func (m *AutoCompoundDelegation) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundDelegation) ProtoMessage()    {}
+func (*AutoCompoundDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *AutoCompoundDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundDelegation.Merge(m, src)
+}
+func (m *AutoCompoundDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundDelegation proto.InternalMessageInfo
+
+// AutoCompoundRound is an auto-compound round in progress.
+type AutoCompoundRound struct {
+	// start_height is the height at which the round started.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// next is the next delegation to be processed by the round.
+	Next AutoCompoundDelegation `protobuf:"bytes,2,opt,name=next,proto3" json:"next"`
+}
+
+func (m *AutoCompoundRound) Reset()         { *m = AutoCompoundRound{} }
+func (m *AutoCompoundRound) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundRound) ProtoMessage()    {}
+func (*AutoCompoundRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *AutoCompoundRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundRound.Merge(m, src)
+}
+func (m *AutoCompoundRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundRound proto.InternalMessageInfo
+
+// CommissionRecipient is an address receiving a share of the commission of a
+// validator.
+type CommissionRecipient struct {
+	// address is the address receiving the commission share.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the share of the commission paid to the address.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *CommissionRecipient) Reset()         { *m = CommissionRecipient{} }
+func (m *CommissionRecipient) String() string { return proto.CompactTextString(m) }
+func (*CommissionRecipient) ProtoMessage()    {}
+func (*CommissionRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{14}
+}
+func (m *CommissionRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionRecipient.Merge(m, src)
+}
+func (m *CommissionRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionRecipient proto.InternalMessageInfo
+
+// CommissionRecipients is the list of addresses the commission of a validator
+// is split among when withdrawn.
+type CommissionRecipients struct {
+	Recipients []CommissionRecipient `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *CommissionRecipients) Reset()         { *m = CommissionRecipients{} }
+func (m *CommissionRecipients) String() string { return proto.CompactTextString(m) }
+func (*CommissionRecipients) ProtoMessage()    {}
+func (*CommissionRecipients) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{15}
+}
+func (m *CommissionRecipients) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionRecipients) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionRecipients.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionRecipients) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionRecipients.Merge(m, src)
+}
+func (m *CommissionRecipients) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionRecipients) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionRecipients.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionRecipients proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -636,6 +831,10 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*AutoCompoundDelegation)(nil), "cosmos.distribution.v1beta1.AutoCompoundDelegation")
+	proto.RegisterType((*AutoCompoundRound)(nil), "cosmos.distribution.v1beta1.AutoCompoundRound")
+	proto.RegisterType((*CommissionRecipient)(nil), "cosmos.distribution.v1beta1.CommissionRecipient")
+	proto.RegisterType((*CommissionRecipients)(nil), "cosmos.distribution.v1beta1.CommissionRecipients")
 }
 
 func init() {
@@ -643,71 +842,88 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1012 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x34, 0x89, 0xd3, 0x4c, 0xdb, 0x84, 0x4e, 0x9c, 0xd4, 0x71, 0x8b, 0x6d, 0x56, 0xaa,
-	0x30, 0x81, 0xd8, 0xa4, 0x48, 0x08, 0xe5, 0xd6, 0xd8, 0xad, 0x40, 0x2a, 0x34, 0xda, 0x20, 0x90,
-	0xe0, 0xb0, 0x1a, 0xef, 0x4e, 0xec, 0x21, 0xbb, 0x33, 0xcb, 0xcc, 0xd8, 0x49, 0x0e, 0xdc, 0x03,
-	0x07, 0xe0, 0x06, 0xea, 0xa9, 0x82, 0x4b, 0xc5, 0x29, 0x87, 0xfc, 0x88, 0x8a, 0x53, 0x55, 0x10,
-	0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0x7e, 0x05, 0x9a, 0x9d, 0xf1, 0xae, 0x13, 0x42, 0x81, 0x22,
-	0x8b, 0x4b, 0x94, 0x79, 0x6f, 0xf6, 0x7d, 0xdf, 0xf7, 0xe6, 0x9b, 0x37, 0x86, 0x75, 0x9f, 0xcb,
-	0x88, 0xcb, 0x46, 0x40, 0xa5, 0x12, 0xb4, 0xdd, 0x53, 0x94, 0xb3, 0x46, 0x7f, 0xb9, 0x4d, 0x14,
-	0x5e, 0x3e, 0x11, 0xac, 0xc7, 0x82, 0x2b, 0x8e, 0xae, 0x9a, 0xfd, 0xf5, 0x13, 0x29, 0xbb, 0xbf,
-	0x54, 0xe8, 0xf0, 0x0e, 0x4f, 0xf6, 0x35, 0xf4, 0x7f, 0xe6, 0x93, 0x52, 0xd9, 0x42, 0xb4, 0xb1,
-	0x24, 0x69, 0x69, 0x9f, 0x53, 0x5b, 0xb2, 0xb4, 0x60, 0xf2, 0x9e, 0xf9, 0xd0, 0xd6, 0x37, 0xa9,
-	0xcb, 0x38, 0xa2, 0x8c, 0x37, 0x92, 0xbf, 0x26, 0xe4, 0xdc, 0x1b, 0x83, 0xf9, 0x35, 0x2c, 0x70,
-	0x24, 0xd1, 0xfb, 0xf0, 0x92, 0xcf, 0xa3, 0xa8, 0xc7, 0xa8, 0xda, 0xf1, 0x14, 0xde, 0x2e, 0x82,
-	0x2a, 0xa8, 0x4d, 0xad, 0xbe, 0xfa, 0xf0, 0xa0, 0x92, 0xfb, 0xe9, 0xa0, 0x62, 0xa9, 0xca, 0x60,
-	0xb3, 0x4e, 0x79, 0x23, 0xc2, 0xaa, 0x5b, 0xbf, 0x43, 0x3a, 0xd8, 0xdf, 0x69, 0x11, 0xff, 0xf1,
-	0xfe, 0x12, 0xb4, 0x48, 0x2d, 0xe2, 0x3f, 0x38, 0xde, 0x5b, 0x04, 0xee, 0xc5, 0xb4, 0xd8, 0xdb,
-	0x78, 0x1b, 0x7d, 0x00, 0x0b, 0x9a, 0xb0, 0x66, 0x15, 0x73, 0x49, 0x84, 0x27, 0xc8, 0x16, 0x16,
-	0x41, 0xf1, 0x5c, 0x82, 0xf1, 0xda, 0xd3, 0x61, 0x14, 0x81, 0x8b, 0x74, 0xd5, 0x35, 0x5b, 0xd4,
-	0x4d, 0x6a, 0xa2, 0x10, 0xce, 0xb5, 0x39, 0xeb, 0xc9, 0x3f, 0x81, 0x8d, 0xfd, 0x47, 0xb0, 0xd9,
-	0xa4, 0xec, 0x29, 0xb4, 0x1b, 0x70, 0x6e, 0x8b, 0xaa, 0x6e, 0x20, 0xf0, 0x96, 0x87, 0x83, 0x40,
-	0x78, 0x84, 0xe1, 0x76, 0x48, 0x82, 0xe2, 0x78, 0x15, 0xd4, 0xce, 0xbb, 0xb3, 0x83, 0xe4, 0xcd,
-	0x20, 0x10, 0xb7, 0x4c, 0x6a, 0xe5, 0xfa, 0x27, 0xc7, 0x7b, 0x8b, 0x55, 0x03, 0xb0, 0x24, 0x83,
-	0xcd, 0xc6, 0xf6, 0x49, 0xc7, 0x98, 0x13, 0x71, 0x7e, 0x00, 0xb0, 0xf4, 0x0e, 0x0e, 0x69, 0x80,
-	0x15, 0x17, 0xaf, 0x53, 0xa9, 0xb8, 0xa0, 0x3e, 0x0e, 0x0d, 0xb0, 0x44, 0x9f, 0x02, 0x78, 0xc5,
-	0xef, 0x45, 0xbd, 0x10, 0x2b, 0xda, 0x27, 0x56, 0xa4, 0x27, 0xb0, 0xa2, 0xbc, 0x08, 0xaa, 0x63,
-	0xb5, 0x0b, 0x37, 0xae, 0x59, 0x3f, 0xd6, 0x75, 0x97, 0x06, 0xbe, 0xd2, 0x8a, 0x9a, 0x9c, 0x32,
-	0xd3, 0x88, 0x6f, 0x7e, 0xae, 0xbc, 0xd8, 0xa1, 0xaa, 0xdb, 0x6b, 0xd7, 0x7d, 0x1e, 0x59, 0xbf,
-	0x34, 0x86, 0xa8, 0xa9, 0x9d, 0x98, 0xc8, 0xc1, 0x37, 0xd2, 0x9c, 0xed, 0x5c, 0x06, 0x6b, 0xc8,
-	0xb8, 0x1a, 0x14, 0x3d, 0x0f, 0x67, 0x04, 0xd9, 0x20, 0x82, 0x30, 0x9f, 0x78, 0x3e, 0xef, 0x31,
-	0x95, 0x9c, 0xef, 0x25, 0x77, 0x3a, 0x0d, 0x37, 0x75, 0xd4, 0xf9, 0x1a, 0xc0, 0x2b, 0xa9, 0xb0,
-	0x66, 0x4f, 0x08, 0xc2, 0xd4, 0x40, 0x55, 0x0c, 0x27, 0x8d, 0x12, 0x39, 0x62, 0x11, 0x03, 0x18,
-	0x34, 0x0f, 0xf3, 0x31, 0x11, 0x94, 0x1b, 0x37, 0x8e, 0xbb, 0x76, 0xe5, 0x7c, 0x09, 0x60, 0x39,
-	0x65, 0x79, 0xd3, 0xb7, 0x9a, 0x49, 0xd0, 0xe4, 0x51, 0x44, 0xa5, 0xa4, 0x9c, 0xa1, 0x3e, 0x84,
-	0x7e, 0xba, 0x1a, 0x31, 0xdf, 0x21, 0x24, 0xe7, 0x33, 0x00, 0xaf, 0xa6, 0xd4, 0xee, 0xf6, 0x94,
-	0x54, 0x98, 0x05, 0x94, 0x75, 0xfe, 0xb7, 0x26, 0x6a, 0x46, 0xb3, 0x29, 0xa3, 0xf5, 0x10, 0xcb,
-	0xee, 0xad, 0x3e, 0x61, 0x0a, 0xbd, 0x00, 0x9f, 0xe9, 0x0f, 0xc2, 0x9e, 0x6d, 0x33, 0x48, 0xda,
-	0x3c, 0x93, 0xc6, 0xd7, 0x92, 0x30, 0x7a, 0x13, 0x9e, 0xdf, 0x10, 0xd8, 0xd7, 0x37, 0xc0, 0xce,
-	0x85, 0xe5, 0x7f, 0x7d, 0x55, 0xdd, 0xb4, 0x84, 0xf3, 0x31, 0x80, 0x85, 0x33, 0x18, 0x49, 0xf4,
-	0x21, 0x9c, 0xcf, 0x28, 0x49, 0x9d, 0xf0, 0x48, 0x92, 0xb1, 0xbd, 0x7a, 0xb9, 0xfe, 0x84, 0xa9,
-	0x5c, 0x3f, 0xa3, 0xe4, 0xea, 0x94, 0xe6, 0x69, 0x1a, 0x52, 0xe8, 0x9f, 0x01, 0xe9, 0xec, 0x02,
-	0x38, 0x79, 0x9b, 0x90, 0x35, 0xce, 0x43, 0xf4, 0x11, 0x9c, 0xce, 0xe6, 0x6c, 0xcc, 0x79, 0x38,
-	0xe2, 0x23, 0xca, 0xa6, 0xba, 0x86, 0x77, 0xbe, 0x38, 0x07, 0x4b, 0xcd, 0xe1, 0xc8, 0x7a, 0x4c,
-	0x58, 0x60, 0x86, 0x1a, 0x0e, 0x51, 0x01, 0x4e, 0x28, 0xaa, 0x42, 0x62, 0xa6, 0xbf, 0x6b, 0x16,
-	0xa8, 0x0a, 0x2f, 0x04, 0x44, 0xfa, 0x82, 0xc6, 0xd9, 0xe9, 0xb8, 0xc3, 0x21, 0x74, 0x0d, 0x4e,
-	0x09, 0xe2, 0xd3, 0x98, 0x12, 0xa6, 0xcc, 0xa0, 0x75, 0xb3, 0x00, 0xda, 0x81, 0x79, 0x1c, 0x25,
-	0x03, 0x61, 0x3c, 0xd1, 0xba, 0x70, 0xa6, 0xd6, 0x44, 0xe8, 0x6d, 0x2b, 0xb4, 0xf6, 0x0f, 0x84,
-	0x26, 0x2a, 0xef, 0x1d, 0xef, 0x2d, 0x5e, 0x0c, 0x13, 0x3b, 0x78, 0x7e, 0x26, 0xdb, 0x02, 0xae,
-	0xd4, 0x76, 0xef, 0x57, 0x72, 0xbf, 0xdd, 0xaf, 0xe4, 0xbe, 0xdd, 0x5f, 0x2a, 0x59, 0xd4, 0x0e,
-	0xef, 0x0f, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x7b, 0x00, 0xe7, 0x5a, 0x44, 0x57, 0xd2, 0xa7,
-	0xa7, 0xb0, 0x50, 0x94, 0x75, 0xde, 0x60, 0x1b, 0xc9, 0x60, 0x8b, 0x05, 0xe9, 0x53, 0xae, 0x1f,
-	0x95, 0x61, 0x0f, 0x4f, 0x0f, 0xc2, 0xd6, 0xc2, 0x77, 0xe0, 0x84, 0x54, 0x78, 0x93, 0x58, 0xff,
-	0x3e, 0xed, 0xdb, 0x69, 0x8a, 0xa0, 0x16, 0xcc, 0x77, 0x09, 0xed, 0x74, 0x4d, 0x43, 0xc7, 0x57,
-	0x5f, 0xfa, 0xfd, 0xa0, 0x32, 0xe3, 0x0b, 0xa2, 0x87, 0x2d, 0xf3, 0x4c, 0xea, 0xab, 0xe3, 0xbd,
-	0xc5, 0xd3, 0x31, 0xdb, 0x00, 0xb3, 0x70, 0x7e, 0x05, 0x70, 0xc1, 0xca, 0xa2, 0x9c, 0xa5, 0x02,
-	0xed, 0xf3, 0xf5, 0x16, 0xbc, 0x9c, 0x5d, 0x06, 0xfd, 0x7e, 0x11, 0x29, 0xed, 0xcb, 0xff, 0xdc,
-	0xe3, 0xfd, 0xa5, 0x67, 0x2d, 0xb5, 0x6c, 0x0e, 0x9a, 0x2d, 0xeb, 0x4a, 0xe8, 0x71, 0x93, 0xdd,
-	0x6d, 0x1b, 0x47, 0x0c, 0xe6, 0xd3, 0xa7, 0x7d, 0x94, 0xae, 0xb6, 0x28, 0x2b, 0xe3, 0xfa, 0x78,
-	0x9d, 0xef, 0x00, 0xbc, 0xfe, 0xd7, 0xa6, 0x7e, 0x97, 0xaa, 0x6e, 0x8b, 0xc4, 0x5c, 0x52, 0x35,
-	0x22, 0x7f, 0xcf, 0x0f, 0xf9, 0x5b, 0xa7, 0xec, 0x0a, 0x15, 0xe1, 0x64, 0x60, 0x80, 0x8b, 0x13,
-	0x49, 0x62, 0xb0, 0x5c, 0x71, 0x76, 0xff, 0xd6, 0x92, 0xab, 0x77, 0x1f, 0x1c, 0x96, 0xc1, 0xc3,
-	0xc3, 0x32, 0x78, 0x74, 0x58, 0x06, 0xbf, 0x1c, 0x96, 0xc1, 0xe7, 0x47, 0xe5, 0xdc, 0xa3, 0xa3,
-	0x72, 0xee, 0xc7, 0xa3, 0x72, 0xee, 0xbd, 0xe5, 0x27, 0xf6, 0xec, 0xd4, 0x2f, 0x8a, 0xa4, 0x85,
-	0xed, 0x7c, 0xf2, 0xa3, 0xef, 0x95, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x78, 0xae, 0xbc, 0xbc,
-	0xa7, 0x0a, 0x00, 0x00,
+	// 1282 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xa4, 0x8e, 0x93, 0x4c, 0xfa, 0x95, 0x89, 0x93, 0x6e, 0xd3, 0xe2, 0xa4, 0x2b, 0x55,
+	0x84, 0x40, 0xec, 0x26, 0x45, 0x80, 0x72, 0x4b, 0x9c, 0x94, 0x46, 0xea, 0x47, 0xb4, 0x45, 0x20,
+	0xc1, 0x61, 0x35, 0xde, 0x9d, 0xd8, 0x43, 0xbd, 0x33, 0xcb, 0xcc, 0xd8, 0x49, 0x0e, 0xbd, 0x17,
+	0x0e, 0xc0, 0x01, 0x09, 0xc4, 0xa9, 0xa2, 0x97, 0x8a, 0x53, 0x0e, 0xb9, 0xf4, 0x3f, 0xa8, 0x38,
+	0x55, 0x05, 0x21, 0xc4, 0xa1, 0x40, 0x7a, 0x08, 0xe2, 0xaf, 0x40, 0xb3, 0x33, 0x5e, 0x6f, 0x5c,
+	0x53, 0x4a, 0xab, 0x88, 0x8b, 0xe5, 0x79, 0x6f, 0xe6, 0xfd, 0x7e, 0xef, 0x6b, 0xde, 0x2c, 0x2c,
+	0x05, 0x5c, 0x46, 0x5c, 0x96, 0x43, 0x2a, 0x95, 0xa0, 0xd5, 0xa6, 0xa2, 0x9c, 0x95, 0x5b, 0xf3,
+	0x55, 0xa2, 0xf0, 0xfc, 0x01, 0x61, 0x29, 0x16, 0x5c, 0x71, 0x74, 0xc6, 0xec, 0x2f, 0x1d, 0x50,
+	0xd9, 0xfd, 0x93, 0x85, 0x1a, 0xaf, 0xf1, 0x64, 0x5f, 0x59, 0xff, 0x33, 0x47, 0x26, 0x8b, 0x16,
+	0xa2, 0x8a, 0x25, 0x49, 0x4d, 0x07, 0x9c, 0x5a, 0x93, 0x93, 0xa7, 0x8d, 0xde, 0x37, 0x07, 0xad,
+	0x7d, 0xa3, 0x1a, 0xc5, 0x11, 0x65, 0xbc, 0x9c, 0xfc, 0x1a, 0x91, 0x7b, 0x7f, 0x00, 0xe6, 0xd7,
+	0xb1, 0xc0, 0x91, 0x44, 0x1f, 0xc1, 0x63, 0x01, 0x8f, 0xa2, 0x26, 0xa3, 0x6a, 0xdb, 0x57, 0x78,
+	0xcb, 0x01, 0xd3, 0x60, 0x66, 0x78, 0xf9, 0xad, 0x07, 0x8f, 0xa7, 0xfa, 0x7e, 0x7d, 0x3c, 0x65,
+	0xa9, 0xca, 0xf0, 0x66, 0x89, 0xf2, 0x72, 0x84, 0x55, 0xbd, 0x74, 0x85, 0xd4, 0x70, 0xb0, 0xbd,
+	0x42, 0x82, 0x47, 0xbb, 0x73, 0xd0, 0x22, 0xad, 0x90, 0xe0, 0xde, 0xfe, 0xce, 0x2c, 0xf0, 0x8e,
+	0xa6, 0xc6, 0xde, 0xc3, 0x5b, 0xe8, 0x63, 0x58, 0xd0, 0x84, 0x35, 0xab, 0x98, 0x4b, 0x22, 0x7c,
+	0x41, 0x36, 0xb1, 0x08, 0x9d, 0xfe, 0x04, 0xe3, 0x9d, 0x17, 0xc3, 0x70, 0x80, 0x87, 0xb4, 0xd5,
+	0x75, 0x6b, 0xd4, 0x4b, 0x6c, 0xa2, 0x06, 0x1c, 0xaf, 0x72, 0xd6, 0x94, 0x4f, 0x81, 0x1d, 0x79,
+	0x49, 0xb0, 0xb1, 0xc4, 0x6c, 0x17, 0xda, 0x02, 0x1c, 0xdf, 0xa4, 0xaa, 0x1e, 0x0a, 0xbc, 0xe9,
+	0xe3, 0x30, 0x14, 0x3e, 0x61, 0xb8, 0xda, 0x20, 0xa1, 0x93, 0x9b, 0x06, 0x33, 0x43, 0xde, 0x58,
+	0x5b, 0xb9, 0x14, 0x86, 0x62, 0xd5, 0xa8, 0xd0, 0x9b, 0x70, 0x02, 0x37, 0x15, 0xf7, 0x03, 0x1e,
+	0xc5, 0xbc, 0xc9, 0x42, 0x9f, 0x32, 0x45, 0x44, 0x0b, 0x37, 0x9c, 0x81, 0x69, 0x30, 0x93, 0xf3,
+	0x0a, 0x5a, 0x5b, 0xb1, 0xca, 0x35, 0xab, 0x43, 0x15, 0x58, 0x3c, 0x78, 0x8a, 0xc4, 0x3c, 0xa8,
+	0xfb, 0x34, 0x24, 0x4c, 0xd1, 0x0d, 0x4a, 0x84, 0x93, 0xd7, 0x0e, 0x7a, 0x67, 0xb2, 0xa7, 0x57,
+	0xf5, 0x9e, 0xb5, 0x74, 0x0b, 0xa2, 0xd0, 0x39, 0x68, 0x24, 0xa2, 0xcc, 0xc7, 0x11, 0x6f, 0x32,
+	0xe5, 0x0c, 0x26, 0xf1, 0xb9, 0x60, 0xe3, 0x33, 0xfe, 0x74, 0x7c, 0xd6, 0x98, 0xca, 0x44, 0x66,
+	0x8d, 0x29, 0x93, 0xea, 0xf1, 0x2c, 0xe0, 0x55, 0xca, 0x96, 0x12, 0x73, 0xe8, 0xed, 0x6e, 0xa8,
+	0x1a, 0x96, 0x7e, 0xb5, 0x19, 0xd6, 0x88, 0x72, 0x86, 0x12, 0x3f, 0x0f, 0x1c, 0x7c, 0x17, 0xcb,
+	0xe5, 0x44, 0xb9, 0x78, 0xfe, 0xb3, 0xfd, 0x9d, 0xd9, 0x69, 0x83, 0x32, 0x27, 0xc3, 0x9b, 0xe5,
+	0xad, 0x83, 0x0d, 0x65, 0x0a, 0xd6, 0xfd, 0x19, 0xc0, 0xc9, 0xf7, 0x71, 0x83, 0x86, 0x58, 0x71,
+	0x71, 0x99, 0x4a, 0xc5, 0x05, 0x0d, 0x70, 0xc3, 0xe4, 0x45, 0xa2, 0xcf, 0x01, 0x3c, 0x15, 0x34,
+	0xa3, 0x66, 0x03, 0x2b, 0xda, 0x22, 0xb6, 0x06, 0x7c, 0x81, 0x15, 0xe5, 0x0e, 0x98, 0x3e, 0x32,
+	0x33, 0xb2, 0x70, 0xd6, 0xb6, 0x6b, 0x49, 0x17, 0x51, 0xbb, 0xed, 0x74, 0xc2, 0x2b, 0x9c, 0x32,
+	0x53, 0x27, 0xdf, 0xff, 0x36, 0xf5, 0x7a, 0x8d, 0xaa, 0x7a, 0xb3, 0x5a, 0x0a, 0x78, 0x64, 0xdb,
+	0xa9, 0x9c, 0xa1, 0xa6, 0xb6, 0x63, 0x22, 0xdb, 0x67, 0xa4, 0x8d, 0x47, 0x07, 0xd6, 0x90, 0xf1,
+	0x34, 0x28, 0x7a, 0x15, 0x9e, 0x10, 0x64, 0x83, 0x08, 0xc2, 0x02, 0xe2, 0x07, 0x49, 0xc4, 0x75,
+	0xf9, 0x1f, 0xf3, 0x8e, 0xa7, 0xe2, 0x8a, 0x96, 0xba, 0x77, 0x01, 0x3c, 0x95, 0x3a, 0x56, 0x69,
+	0x0a, 0x41, 0x98, 0x6a, 0x7b, 0x15, 0xc3, 0x41, 0xe3, 0x89, 0x3c, 0x64, 0x27, 0xda, 0x30, 0x68,
+	0x02, 0xe6, 0x63, 0x22, 0x28, 0x37, 0xcd, 0x9a, 0xf3, 0xec, 0xca, 0xfd, 0x06, 0xc0, 0x62, 0xca,
+	0x72, 0x29, 0xb0, 0x3e, 0x93, 0xb0, 0xc2, 0xa3, 0x88, 0x4a, 0x49, 0x39, 0x43, 0x2d, 0x08, 0x83,
+	0x74, 0x75, 0xc8, 0x7c, 0x33, 0x48, 0xee, 0x17, 0x00, 0x9e, 0x49, 0xa9, 0x5d, 0x6f, 0x2a, 0xa9,
+	0x30, 0x0b, 0x29, 0xab, 0xfd, 0x6f, 0x41, 0xd4, 0x8c, 0xc6, 0x52, 0x46, 0x37, 0x1a, 0x58, 0xd6,
+	0x57, 0x5b, 0x84, 0x29, 0xf4, 0x1a, 0x3c, 0xd9, 0x6a, 0x8b, 0x7d, 0x1b, 0x66, 0x90, 0x84, 0xf9,
+	0x44, 0x2a, 0x5f, 0x4f, 0xc4, 0xe8, 0x2a, 0x1c, 0xda, 0x10, 0x38, 0xd0, 0x1d, 0x60, 0xaf, 0xcd,
+	0xf9, 0xff, 0x7c, 0x93, 0x79, 0xa9, 0x09, 0xf7, 0x53, 0x00, 0x0b, 0x3d, 0x18, 0x49, 0xf4, 0x09,
+	0x9c, 0xe8, 0x50, 0x92, 0x5a, 0xe1, 0x93, 0x44, 0x63, 0x63, 0x75, 0xa1, 0xf4, 0x8c, 0xa1, 0x55,
+	0xea, 0x61, 0x72, 0x79, 0x58, 0xf3, 0x34, 0x01, 0x29, 0xb4, 0x7a, 0x40, 0xba, 0xb7, 0x01, 0x1c,
+	0xbc, 0x44, 0xc8, 0x3a, 0xe7, 0x0d, 0x74, 0x0b, 0x1e, 0xef, 0x8c, 0xa1, 0x98, 0xf3, 0xc6, 0x21,
+	0xa7, 0xa8, 0x33, 0xf4, 0x34, 0xbc, 0xfb, 0x75, 0x3f, 0x9c, 0xac, 0x64, 0x25, 0x37, 0x62, 0xc2,
+	0x42, 0x73, 0xe7, 0xe3, 0x06, 0x2a, 0xc0, 0x01, 0x45, 0x55, 0x83, 0x98, 0xe1, 0xe8, 0x99, 0x05,
+	0x9a, 0x86, 0x23, 0x21, 0x91, 0x81, 0xa0, 0x71, 0x27, 0x3b, 0x5e, 0x56, 0x84, 0xce, 0xc2, 0x61,
+	0x41, 0x02, 0x1a, 0x53, 0xc2, 0x94, 0x99, 0x43, 0x5e, 0x47, 0x80, 0xb6, 0x61, 0xde, 0x5e, 0xc1,
+	0xb9, 0xc4, 0xd7, 0xd3, 0x3d, 0x7d, 0x4d, 0x1c, 0xbd, 0x64, 0x1d, 0x9d, 0x79, 0x0e, 0x47, 0x13,
+	0x2f, 0xbf, 0xdd, 0xdf, 0x99, 0x3d, 0xda, 0x48, 0xca, 0xc1, 0x0f, 0x3a, 0x6e, 0x5b, 0xc0, 0xc5,
+	0x99, 0xdb, 0x77, 0xa6, 0xfa, 0xfe, 0xbc, 0x33, 0xd5, 0xf7, 0xc3, 0xee, 0xdc, 0xa4, 0x45, 0xad,
+	0xf1, 0x56, 0x06, 0x94, 0x29, 0xcd, 0x19, 0xb8, 0x3f, 0x01, 0x38, 0xbe, 0x42, 0xb4, 0x25, 0x9d,
+	0x3d, 0x85, 0x85, 0xa2, 0xac, 0xb6, 0xc6, 0x36, 0x92, 0x8b, 0x2d, 0x16, 0xa4, 0x45, 0xb9, 0x9e,
+	0xb9, 0xd9, 0x1a, 0x3e, 0xde, 0x16, 0xdb, 0x12, 0xbe, 0x02, 0x07, 0xa4, 0xc2, 0x37, 0x89, 0xd3,
+	0xff, 0x52, 0x4f, 0x0b, 0x63, 0x04, 0xad, 0xc0, 0x7c, 0x9d, 0xd0, 0x5a, 0xdd, 0x04, 0x34, 0xb7,
+	0xfc, 0xc6, 0x5f, 0x8f, 0xa7, 0x4e, 0x04, 0x82, 0xe8, 0xcb, 0x96, 0xf9, 0x46, 0xf5, 0xdd, 0xfe,
+	0xce, 0x6c, 0xb7, 0xcc, 0x06, 0xc0, 0x2c, 0xdc, 0x3f, 0x00, 0x3c, 0x6d, 0xdd, 0xa2, 0x9c, 0xa5,
+	0x0e, 0xda, 0xe9, 0x7e, 0x0d, 0x8e, 0x76, 0x9a, 0x41, 0x8f, 0x77, 0x22, 0xa5, 0x7d, 0x18, 0x9d,
+	0x7b, 0xb4, 0x3b, 0xf7, 0x8a, 0xa5, 0xd6, 0xb9, 0x07, 0xcd, 0x96, 0x1b, 0x4a, 0xe8, 0xeb, 0xe6,
+	0x64, 0xab, 0x4b, 0x8e, 0x18, 0xcc, 0xa7, 0x2f, 0x9f, 0xc3, 0xac, 0x6a, 0x8b, 0xb2, 0x98, 0xd3,
+	0xe9, 0x75, 0x7f, 0x04, 0xf0, 0xfc, 0x3f, 0x17, 0xf5, 0x07, 0x54, 0xd5, 0x57, 0x48, 0xcc, 0x25,
+	0x55, 0x87, 0x54, 0xdf, 0x13, 0x99, 0xfa, 0xd6, 0x2a, 0xbb, 0x42, 0x0e, 0x1c, 0x0c, 0x0d, 0x70,
+	0xf2, 0xf0, 0x19, 0xf6, 0xda, 0xcb, 0x45, 0xf7, 0xf6, 0xbf, 0x96, 0xa4, 0x7b, 0x1f, 0xc0, 0x89,
+	0xa5, 0xcc, 0x03, 0xa2, 0x93, 0x45, 0xb4, 0x0a, 0x47, 0xc3, 0x76, 0x26, 0xbb, 0xd2, 0xe6, 0x3c,
+	0xda, 0x9d, 0x2b, 0x58, 0xab, 0x5d, 0xd9, 0x4a, 0x8f, 0xb4, 0xb3, 0xd5, 0x33, 0xfb, 0xfd, 0x2f,
+	0x9c, 0xfd, 0xc5, 0xa1, 0x76, 0xb3, 0xb9, 0x5f, 0x01, 0x38, 0x9a, 0xe5, 0xee, 0xe9, 0x1f, 0x74,
+	0x0e, 0x1e, 0x95, 0xba, 0xb1, 0x6c, 0xa1, 0x26, 0x8c, 0x8f, 0x78, 0x23, 0x89, 0xec, 0x72, 0x22,
+	0x42, 0x1e, 0xcc, 0x31, 0xb2, 0x65, 0x5e, 0x0e, 0x23, 0x0b, 0x17, 0x9f, 0x79, 0x17, 0xf7, 0x0e,
+	0x4e, 0xf6, 0x3a, 0x4e, 0x6c, 0x65, 0x68, 0xdd, 0x05, 0x70, 0xac, 0x33, 0xbf, 0xbd, 0x34, 0x81,
+	0x0b, 0x70, 0xf0, 0x79, 0xa3, 0xd8, 0xde, 0x88, 0xae, 0xc1, 0xfc, 0xa6, 0x71, 0xe3, 0xe5, 0xba,
+	0xdd, 0x5a, 0xc9, 0xb0, 0xbc, 0x05, 0x0b, 0x3d, 0x48, 0xea, 0x2f, 0x18, 0x98, 0xd6, 0xdc, 0xf3,
+	0x4d, 0xab, 0x1e, 0x66, 0xb2, 0xe1, 0xc9, 0x98, 0xeb, 0xc0, 0x2f, 0x5f, 0xbf, 0xb7, 0x57, 0x04,
+	0x0f, 0xf6, 0x8a, 0xe0, 0xe1, 0x5e, 0x11, 0xfc, 0xbe, 0x57, 0x04, 0x5f, 0x3e, 0x29, 0xf6, 0x3d,
+	0x7c, 0x52, 0xec, 0xfb, 0xe5, 0x49, 0xb1, 0xef, 0xc3, 0xf9, 0x67, 0xf6, 0x6a, 0xd7, 0x4b, 0x36,
+	0x69, 0xdd, 0x6a, 0x3e, 0xf9, 0x16, 0xbb, 0xf8, 0xf7, 0x00, 0x37, 0x74, 0x6d, 0x3d, 0x3e, 0x0e,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -741,6 +957,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if this.AutoCompoundEpochIdentifier != that1.AutoCompoundEpochIdentifier {
+		return false
+	}
+	if !this.AutoCompoundMinAmount.Equal(that1.AutoCompoundMinAmount) {
+		return false
+	}
+	if this.AutoCompoundGasBudget != that1.AutoCompoundGasBudget {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1068,6 +1296,33 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundGasBudget != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundGasBudget))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.AutoCompoundMinAmount.Size()
+		i -= size
+		if _, err := m.AutoCompoundMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.AutoCompoundEpochIdentifier) > 0 {
+		i -= len(m.AutoCompoundEpochIdentifier)
+		copy(dAtA[i:], m.AutoCompoundEpochIdentifier)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.AutoCompoundEpochIdentifier)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1584,6 +1839,158 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionRecipients) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionRecipients) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionRecipients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1610,6 +2017,18 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundInterval))
+	}
+	l = len(m.AutoCompoundEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.AutoCompoundMinAmount.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.AutoCompoundGasBudget != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundGasBudget))
+	}
 	return n
 }
 
@@ -1815,13 +2234,74 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
-func sovDistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDistribution(x uint64) (n int) {
-	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *AutoCompoundDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoCompoundRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.StartHeight))
+	}
+	l = m.Next.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *CommissionRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *CommissionRecipients) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1972,6 +2452,110 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoCompoundMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundGasBudget", wireType)
+			}
+			m.AutoCompoundGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3266,6 +3850,422 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompoundRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Next.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionRecipients) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRecipients: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRecipients: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, CommissionRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,18 +2,28 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress     = "set_withdraw_address"
-	EventTypeRewards                = "rewards"
-	EventTypeCommission             = "commission"
-	EventTypeWithdrawRewards        = "withdraw_rewards"
-	EventTypeWithdrawCommission     = "withdraw_commission"
-	EventTypeProposerReward         = "proposer_reward"
-	EventTypeWithdrawAddrRedirected = "withdraw_addr_redirected"
+	EventTypeSetWithdrawAddress      = "set_withdraw_address"
+	EventTypeRewards                 = "rewards"
+	EventTypeCommission              = "commission"
+	EventTypeWithdrawRewards         = "withdraw_rewards"
+	EventTypeWithdrawCommission      = "withdraw_commission"
+	EventTypeProposerReward          = "proposer_reward"
+	EventTypeWithdrawAddrRedirected  = "withdraw_addr_redirected"
+	EventTypeSetAutoCompound         = "set_auto_compound"
+	EventTypeAutoCompound            = "auto_compound"
+	EventTypeAutoCompoundRound       = "auto_compound_round"
+	EventTypeSetCommissionRecipients = "set_commission_recipients"
+	EventTypeCommissionPayout        = "commission_payout"
 
 	AttributeKeyWithdrawAddress         = "withdraw_address"
 	AttributeKeyValidator               = "validator"
 	AttributeKeyDelegator               = "delegator"
 	AttributeKeyOriginalWithdrawAddress = "original_withdraw_address"
+	AttributeKeyEnabled                 = "enabled"
+	AttributeKeyStartHeight             = "start_height"
+	AttributeKeyCompleted               = "completed"
+	AttributeKeyRecipient               = "recipient"
+	AttributeKeyWeight                  = "weight"
 
 	AttributeValueCommunityPool = "community_pool"
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -44,5 +46,18 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	seen := make(map[string]bool, len(gs.ValidatorCommissionRecipients))
+	for _, record := range gs.ValidatorCommissionRecipients {
+		if seen[record.ValidatorAddress] {
+			return fmt.Errorf("duplicate commission recipients for validator %s", record.ValidatorAddress)
+		}
+		seen[record.ValidatorAddress] = true
+
+		if err := ValidateCommissionRecipients(record.Recipients); err != nil {
+			return fmt.Errorf("invalid commission recipients for validator %s: %w", record.ValidatorAddress, err)
+		}
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
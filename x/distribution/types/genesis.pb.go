@@ -326,6 +326,49 @@ func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
 
+// ValidatorCommissionRecipientsRecord is used for import / export via genesis
+// json.
+type ValidatorCommissionRecipientsRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// recipients defines the addresses the commission of the validator is split
+	// among.
+	Recipients []CommissionRecipient `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *ValidatorCommissionRecipientsRecord) Reset()         { *m = ValidatorCommissionRecipientsRecord{} }
+func (m *ValidatorCommissionRecipientsRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionRecipientsRecord) ProtoMessage()    {}
+func (*ValidatorCommissionRecipientsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{7}
+}
+func (m *ValidatorCommissionRecipientsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCommissionRecipientsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCommissionRecipientsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorCommissionRecipientsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionRecipientsRecord.Merge(m, src)
+}
+func (m *ValidatorCommissionRecipientsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCommissionRecipientsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionRecipientsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCommissionRecipientsRecord proto.InternalMessageInfo
+
 // GenesisState defines the distribution module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
@@ -348,13 +391,21 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_delegations defines the delegations whose rewards are
+	// restaked automatically.
+	AutoCompoundDelegations []AutoCompoundDelegation `protobuf:"bytes,11,rep,name=auto_compound_delegations,json=autoCompoundDelegations,proto3" json:"auto_compound_delegations"`
+	// auto_compound_round defines the auto-compound round in progress, if any.
+	AutoCompoundRound *AutoCompoundRound `protobuf:"bytes,12,opt,name=auto_compound_round,json=autoCompoundRound,proto3" json:"auto_compound_round,omitempty"`
+	// validator_commission_recipients defines the commission recipients of the
+	// validators.
+	ValidatorCommissionRecipients []ValidatorCommissionRecipientsRecord `protobuf:"bytes,13,rep,name=validator_commission_recipients,json=validatorCommissionRecipients,proto3" json:"validator_commission_recipients"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,6 +442,7 @@ func init() {
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
+	proto.RegisterType((*ValidatorCommissionRecipientsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCommissionRecipientsRecord")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
 }
 
@@ -399,66 +451,74 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 938 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x25, 0x4d, 0x26, 0x45, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0x76, 0x9d, 0x94, 0x1e,
-	0x0a, 0x28, 0x6b, 0x12, 0x10, 0x54, 0x45, 0x20, 0x35, 0x6e, 0xcb, 0x8f, 0x03, 0x8d, 0x6c, 0x09,
-	0x04, 0x42, 0xb2, 0xc6, 0xbb, 0xe3, 0xf5, 0x08, 0x7b, 0xc7, 0x9a, 0x19, 0xaf, 0x01, 0x89, 0x03,
-	0xa7, 0x22, 0xc4, 0x81, 0x13, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x8f, 0xe8, 0xb1,
-	0xaa, 0x38, 0x70, 0x02, 0xe4, 0x1c, 0x40, 0x1c, 0xf9, 0x0b, 0xd0, 0xce, 0xcc, 0xee, 0x8e, 0xb3,
-	0xdb, 0xad, 0x1b, 0xf0, 0xa5, 0x8d, 0x67, 0xde, 0x7b, 0xdf, 0xf7, 0xbd, 0xf7, 0xf6, 0xbd, 0x01,
-	0xcf, 0x7b, 0x94, 0x0f, 0x28, 0xaf, 0xfb, 0x84, 0x0b, 0x46, 0x3a, 0x23, 0x41, 0x68, 0x58, 0x8f,
-	0x76, 0x3b, 0x58, 0xa0, 0xdd, 0x7a, 0x80, 0x43, 0xcc, 0x09, 0x77, 0x87, 0x8c, 0x0a, 0x0a, 0xcf,
-	0x29, 0x53, 0xd7, 0x34, 0x75, 0xb5, 0xe9, 0xe6, 0x5a, 0x40, 0x03, 0x2a, 0xed, 0xea, 0xf1, 0x5f,
-	0xca, 0x65, 0xd3, 0xd1, 0xd1, 0x3b, 0x88, 0xe3, 0x34, 0xaa, 0x47, 0x49, 0xa8, 0xef, 0xdd, 0x32,
-	0xf4, 0x29, 0x1c, 0x65, 0xbf, 0xa1, 0xec, 0xdb, 0x0a, 0x48, 0xf3, 0x51, 0x57, 0xab, 0x68, 0x40,
-	0x42, 0x5a, 0x97, 0xff, 0xaa, 0xa3, 0x8b, 0x3f, 0x59, 0xe0, 0xec, 0x75, 0xdc, 0xc7, 0x01, 0x12,
-	0x94, 0x7d, 0x40, 0x44, 0xcf, 0x67, 0x68, 0xfc, 0x4e, 0xd8, 0xa5, 0xf0, 0x06, 0x58, 0xf5, 0x93,
-	0x8b, 0x36, 0xf2, 0x7d, 0x86, 0x39, 0xb7, 0xad, 0x2d, 0xeb, 0xf2, 0xf2, 0xbe, 0xfd, 0xf0, 0xde,
-	0xce, 0x9a, 0x8e, 0x7c, 0x4d, 0xdd, 0xb4, 0x04, 0x23, 0x61, 0xd0, 0x3c, 0x9d, 0xba, 0xe8, 0x73,
-	0xd8, 0x00, 0xa7, 0xc7, 0x3a, 0x6c, 0x1a, 0xa5, 0xfa, 0x98, 0x28, 0xcf, 0x24, 0x1e, 0xfa, 0xf8,
-	0xea, 0xd2, 0x57, 0x77, 0x6a, 0x95, 0xbf, 0xee, 0xd4, 0x2a, 0x17, 0x6f, 0x57, 0xc1, 0xf6, 0xfb,
-	0xa8, 0x4f, 0xfc, 0x18, 0xe3, 0xd6, 0x48, 0x70, 0x81, 0x42, 0x3f, 0xf6, 0xc1, 0x63, 0xc4, 0x7c,
-	0xde, 0xc4, 0x1e, 0x65, 0x3e, 0x7c, 0x0f, 0xac, 0x46, 0x89, 0xd1, 0x11, 0xee, 0xdb, 0x0f, 0xef,
-	0xed, 0x5c, 0xd0, 0xa8, 0x69, 0xa0, 0x23, 0x22, 0xa2, 0x23, 0xe7, 0xf0, 0xb6, 0x05, 0xce, 0xd0,
-	0x0c, 0xac, 0xcd, 0x14, 0x9a, 0x5d, 0xdd, 0x5a, 0xb8, 0xbc, 0xb2, 0x77, 0x5e, 0x97, 0xc8, 0x8d,
-	0x4b, 0x98, 0x54, 0xdb, 0xbd, 0x8e, 0xbd, 0x06, 0x25, 0xe1, 0xfe, 0x95, 0xfb, 0xbf, 0xd5, 0x2a,
-	0x3f, 0xfe, 0x5e, 0x7b, 0x31, 0x20, 0xa2, 0x37, 0xea, 0xb8, 0x1e, 0x1d, 0xe8, 0xaa, 0xe8, 0xff,
-	0x76, 0xb8, 0xff, 0x49, 0x5d, 0x7c, 0x36, 0xc4, 0x3c, 0xf1, 0xe1, 0x77, 0xff, 0xfc, 0xf9, 0x05,
-	0xab, 0x09, 0x69, 0x4e, 0x9f, 0x91, 0x89, 0xbf, 0x2d, 0x70, 0x29, 0x13, 0xe0, 0x79, 0xa3, 0xc1,
-	0xa8, 0x8f, 0x04, 0xf6, 0x1b, 0x74, 0x30, 0x20, 0x9c, 0x13, 0x1a, 0xce, 0x29, 0x19, 0x3d, 0xb0,
-	0x82, 0x32, 0x38, 0x59, 0xcc, 0x95, 0xbd, 0xd7, 0xdd, 0x92, 0xce, 0x77, 0xcb, 0x79, 0xee, 0x2f,
-	0xc7, 0x29, 0x52, 0x9a, 0xcd, 0xd0, 0x86, 0xd8, 0x7f, 0x2c, 0xb0, 0x95, 0x06, 0x79, 0x9b, 0x70,
-	0x41, 0x19, 0xf1, 0x50, 0x7f, 0xbe, 0x55, 0x5f, 0x07, 0x8b, 0x43, 0xcc, 0x08, 0x55, 0x1a, 0x4f,
-	0x34, 0xf5, 0x2f, 0xf8, 0x31, 0x38, 0x99, 0x34, 0xc0, 0x82, 0x14, 0xff, 0xda, 0x6c, 0xe2, 0x73,
-	0xbc, 0x4d, 0xe1, 0x49, 0x48, 0x43, 0xf4, 0x2f, 0x16, 0xb8, 0x90, 0x3a, 0x37, 0x46, 0x8c, 0xe1,
-	0x50, 0xcc, 0x57, 0xf1, 0x87, 0x99, 0x32, 0x55, 0xd6, 0x57, 0x66, 0x53, 0x36, 0x4d, 0xee, 0x31,
-	0xb2, 0x7e, 0xa8, 0x82, 0x73, 0xe9, 0xc8, 0x69, 0x09, 0xc4, 0x04, 0x09, 0x83, 0x78, 0xe4, 0x68,
-	0x51, 0xff, 0xd3, 0xe0, 0x29, 0xcc, 0x4d, 0xf5, 0xf8, 0xb9, 0xe9, 0x80, 0xa7, 0xb9, 0x26, 0xdb,
-	0x26, 0x61, 0x97, 0xea, 0xda, 0xef, 0x95, 0x66, 0xa8, 0x50, 0xa7, 0x99, 0x9f, 0x53, 0xdc, 0xb8,
-	0x30, 0x92, 0xf4, 0x5d, 0x15, 0x6c, 0xa4, 0xd4, 0x5a, 0x7d, 0xc4, 0x7b, 0x37, 0x22, 0x99, 0xe1,
-	0x79, 0x75, 0x7a, 0x0f, 0x93, 0xa0, 0x27, 0x92, 0x4e, 0x57, 0xbf, 0x8c, 0x2f, 0x60, 0x61, 0xea,
-	0x0b, 0xa0, 0xe0, 0x6c, 0x86, 0xcf, 0x63, 0x76, 0x6d, 0x1c, 0xd3, 0xb3, 0x4f, 0xc8, 0x9c, 0xbc,
-	0x34, 0x5b, 0xd7, 0x64, 0xb2, 0xcc, 0x8c, 0x9c, 0x89, 0xf2, 0xf7, 0x46, 0x62, 0xbe, 0x59, 0x06,
-	0xa7, 0xde, 0x52, 0x3b, 0xb7, 0x25, 0x90, 0xc0, 0xf0, 0x26, 0x58, 0x1c, 0x22, 0x86, 0x06, 0x2a,
-	0x01, 0x2b, 0x7b, 0xcf, 0x95, 0x82, 0x1f, 0x48, 0x53, 0x13, 0x4f, 0x7b, 0xc3, 0x77, 0xc1, 0x52,
-	0x17, 0xe3, 0xf6, 0x90, 0xd2, 0xbe, 0x6e, 0xfe, 0x4b, 0xa5, 0x91, 0x6e, 0x62, 0x7c, 0x40, 0x69,
-	0x7f, 0xaa, 0xd9, 0xbb, 0xea, 0x0c, 0x8e, 0x81, 0x9d, 0xb5, 0x70, 0xba, 0xfe, 0xe2, 0xae, 0x89,
-	0x47, 0xc6, 0xc2, 0xec, 0x6d, 0x63, 0x6e, 0x64, 0x13, 0x69, 0xdd, 0x2f, 0xb2, 0xe0, 0xf1, 0xb7,
-	0x33, 0x64, 0x38, 0x22, 0x74, 0x24, 0x1f, 0x00, 0x43, 0xca, 0x31, 0x93, 0x45, 0x29, 0xfd, 0x76,
-	0x12, 0x97, 0x03, 0xed, 0x01, 0x3f, 0x2f, 0x5e, 0x77, 0x4f, 0x49, 0xea, 0x6f, 0xce, 0x56, 0xdd,
-	0x47, 0x2d, 0x67, 0x53, 0x46, 0xc1, 0x86, 0x83, 0xdf, 0x5b, 0x60, 0xdb, 0x68, 0xee, 0x6c, 0x1d,
-	0xb4, 0xbd, 0x74, 0x63, 0x70, 0x7b, 0x51, 0x52, 0xb9, 0xf6, 0x1f, 0xb6, 0x4e, 0x9e, 0x4d, 0x2d,
-	0x2a, 0x75, 0xe0, 0xf0, 0x6b, 0x0b, 0x9c, 0xcf, 0xa8, 0xf5, 0xd2, 0x71, 0x9e, 0x26, 0xe8, 0xa4,
-	0x64, 0xf5, 0xc6, 0x31, 0xd7, 0x41, 0x9e, 0xd1, 0x66, 0xf4, 0x48, 0x63, 0xf8, 0xa5, 0x05, 0x36,
-	0x32, 0x32, 0x9e, 0x9a, 0xc0, 0x29, 0x93, 0x25, 0xc9, 0xe4, 0xea, 0x71, 0xc6, 0x77, 0x9e, 0xc6,
-	0xb3, 0x51, 0xb1, 0x25, 0xfc, 0xc2, 0xec, 0xf3, 0xa9, 0xe9, 0xc8, 0xed, 0x65, 0xc9, 0xe0, 0xca,
-	0x93, 0x8f, 0xc7, 0x3c, 0x7e, 0xd6, 0xed, 0xa6, 0x1d, 0x87, 0x63, 0xb0, 0x5e, 0x38, 0x86, 0xb8,
-	0x0d, 0x24, 0xf8, 0xab, 0x4f, 0x3a, 0x87, 0xf2, 0xd0, 0x6b, 0x05, 0xd3, 0xc8, 0x58, 0x66, 0xfb,
-	0xb7, 0xee, 0x4e, 0x1c, 0xeb, 0xfe, 0xc4, 0xb1, 0x1e, 0x4c, 0x1c, 0xeb, 0x8f, 0x89, 0x63, 0x7d,
-	0x7b, 0xe8, 0x54, 0x1e, 0x1c, 0x3a, 0x95, 0x5f, 0x0f, 0x9d, 0xca, 0x47, 0xbb, 0xa5, 0x6f, 0xbe,
-	0x4f, 0xa7, 0xdf, 0xf4, 0xf2, 0x09, 0xd8, 0x59, 0x94, 0xef, 0xf2, 0x97, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xbe, 0x75, 0xf7, 0x68, 0x75, 0x0c, 0x00, 0x00,
+	// 1060 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x3a, 0x21, 0x4d, 0xc6, 0xa9, 0x68, 0x26, 0x69, 0xba, 0x49, 0x1b, 0x3b, 0x69, 0x7b,
+	0x28, 0xa0, 0xac, 0x49, 0x8a, 0xa0, 0x2a, 0x02, 0x11, 0x3b, 0x2d, 0x3f, 0x0e, 0x34, 0x72, 0x24,
+	0x10, 0x3f, 0x84, 0x35, 0xde, 0x9d, 0xd8, 0x23, 0xec, 0x9d, 0xd5, 0xcc, 0xec, 0x1a, 0x90, 0x38,
+	0x70, 0x2a, 0xe2, 0x80, 0x38, 0x21, 0x8e, 0x15, 0xa7, 0x0a, 0x09, 0x89, 0x43, 0xff, 0x88, 0x1e,
+	0xab, 0x8a, 0x03, 0x07, 0x04, 0x28, 0x39, 0x80, 0x38, 0x22, 0xfe, 0x00, 0xb4, 0xb3, 0xb3, 0xbb,
+	0xe3, 0x78, 0xb3, 0x71, 0x42, 0x7d, 0xd9, 0xc4, 0x33, 0xef, 0xbd, 0xef, 0xfb, 0xde, 0xbc, 0x79,
+	0x6f, 0x17, 0x3c, 0x63, 0x53, 0xde, 0xa3, 0xbc, 0xea, 0x10, 0x2e, 0x18, 0x69, 0xf9, 0x82, 0x50,
+	0xb7, 0x1a, 0x6c, 0xb4, 0xb0, 0x40, 0x1b, 0xd5, 0x36, 0x76, 0x31, 0x27, 0xdc, 0xf2, 0x18, 0x15,
+	0x14, 0x5e, 0x8c, 0x4c, 0x2d, 0xdd, 0xd4, 0x52, 0xa6, 0xcb, 0x0b, 0x6d, 0xda, 0xa6, 0xd2, 0xae,
+	0x1a, 0xfe, 0x17, 0xb9, 0x2c, 0x97, 0x55, 0xf4, 0x16, 0xe2, 0x38, 0x89, 0x6a, 0x53, 0xe2, 0xaa,
+	0x7d, 0x2b, 0x0f, 0x7d, 0x00, 0x27, 0xb2, 0x5f, 0x8a, 0xec, 0x9b, 0x11, 0x90, 0xe2, 0x13, 0x6d,
+	0xcd, 0xa1, 0x1e, 0x71, 0x69, 0x55, 0x3e, 0xa3, 0xa5, 0xcb, 0x3f, 0x1a, 0xe0, 0xfc, 0x36, 0xee,
+	0xe2, 0x36, 0x12, 0x94, 0xbd, 0x4b, 0x44, 0xc7, 0x61, 0xa8, 0xff, 0xa6, 0xbb, 0x47, 0xe1, 0x2d,
+	0x30, 0xe7, 0xc4, 0x1b, 0x4d, 0xe4, 0x38, 0x0c, 0x73, 0x6e, 0x1a, 0xab, 0xc6, 0xb5, 0x99, 0x9a,
+	0xf9, 0xf8, 0xc1, 0xfa, 0x82, 0x8a, 0xbc, 0x15, 0xed, 0xec, 0x0a, 0x46, 0xdc, 0x76, 0xe3, 0x5c,
+	0xe2, 0xa2, 0xd6, 0x61, 0x1d, 0x9c, 0xeb, 0xab, 0xb0, 0x49, 0x94, 0xe2, 0x31, 0x51, 0x9e, 0x8e,
+	0x3d, 0xd4, 0xf2, 0xcd, 0xe9, 0x2f, 0xef, 0x55, 0x0a, 0x7f, 0xdd, 0xab, 0x14, 0x2e, 0xdf, 0x2d,
+	0x82, 0xb5, 0x77, 0x50, 0x97, 0x38, 0x21, 0xc6, 0x1d, 0x5f, 0x70, 0x81, 0x5c, 0x27, 0xf4, 0xc1,
+	0x7d, 0xc4, 0x1c, 0xde, 0xc0, 0x36, 0x65, 0x0e, 0x7c, 0x1b, 0xcc, 0x05, 0xb1, 0xd1, 0x21, 0xee,
+	0x6b, 0x8f, 0x1f, 0xac, 0xaf, 0x28, 0xd4, 0x24, 0xd0, 0x21, 0x11, 0xc1, 0xa1, 0x75, 0x78, 0xd7,
+	0x00, 0xf3, 0x34, 0x05, 0x6b, 0xb2, 0x08, 0xcd, 0x2c, 0xae, 0x4e, 0x5c, 0x2b, 0x6d, 0x5e, 0x52,
+	0x47, 0x64, 0x85, 0x47, 0x18, 0x9f, 0xb6, 0xb5, 0x8d, 0xed, 0x3a, 0x25, 0x6e, 0xed, 0xc6, 0xc3,
+	0xdf, 0x2a, 0x85, 0x1f, 0x7e, 0xaf, 0x3c, 0xd7, 0x26, 0xa2, 0xe3, 0xb7, 0x2c, 0x9b, 0xf6, 0xd4,
+	0xa9, 0xa8, 0x3f, 0xeb, 0xdc, 0xf9, 0xb8, 0x2a, 0x3e, 0xf5, 0x30, 0x8f, 0x7d, 0xf8, 0xfd, 0x3f,
+	0x7f, 0x7a, 0xd6, 0x68, 0x40, 0x3a, 0xa4, 0x4f, 0xcb, 0xc4, 0xdf, 0x06, 0xb8, 0x9a, 0x0a, 0xb0,
+	0x6d, 0xbf, 0xe7, 0x77, 0x91, 0xc0, 0x4e, 0x9d, 0xf6, 0x7a, 0x84, 0x73, 0x42, 0xdd, 0x31, 0x25,
+	0xa3, 0x03, 0x4a, 0x28, 0x85, 0x93, 0x87, 0x59, 0xda, 0x7c, 0xd9, 0xca, 0xa9, 0x7c, 0x2b, 0x9f,
+	0x67, 0x6d, 0x26, 0x4c, 0x51, 0xa4, 0x59, 0x0f, 0xad, 0x89, 0xfd, 0xc7, 0x00, 0xab, 0x49, 0x90,
+	0x37, 0x08, 0x17, 0x94, 0x11, 0x1b, 0x75, 0xc7, 0x7b, 0xea, 0x8b, 0x60, 0xca, 0xc3, 0x8c, 0xd0,
+	0x48, 0xe3, 0x64, 0x43, 0xfd, 0x82, 0x1f, 0x82, 0x33, 0x71, 0x01, 0x4c, 0x48, 0xf1, 0x2f, 0x8d,
+	0x26, 0x7e, 0x88, 0xb7, 0x2e, 0x3c, 0x0e, 0xa9, 0x89, 0xfe, 0xd9, 0x00, 0x2b, 0x89, 0x73, 0xdd,
+	0x67, 0x0c, 0xbb, 0x62, 0xbc, 0x8a, 0xdf, 0x4b, 0x95, 0x45, 0xc7, 0xfa, 0xc2, 0x68, 0xca, 0x06,
+	0xc9, 0x1d, 0x23, 0xeb, 0xfb, 0x22, 0xb8, 0x98, 0xb4, 0x9c, 0x5d, 0x81, 0x98, 0x20, 0x6e, 0x3b,
+	0x6c, 0x39, 0x4a, 0xd4, 0x13, 0x6a, 0x3c, 0x99, 0xb9, 0x29, 0x9e, 0x3e, 0x37, 0x2d, 0x70, 0x96,
+	0x2b, 0xb2, 0x4d, 0xe2, 0xee, 0x51, 0x75, 0xf6, 0x9b, 0xb9, 0x19, 0xca, 0xd4, 0xa9, 0xe7, 0x67,
+	0x96, 0x6b, 0x1b, 0x5a, 0x92, 0xbe, 0x2d, 0x82, 0xa5, 0x84, 0xda, 0x6e, 0x17, 0xf1, 0xce, 0xad,
+	0x40, 0x66, 0x78, 0x5c, 0x95, 0xde, 0xc1, 0xa4, 0xdd, 0x11, 0x71, 0xa5, 0x47, 0xbf, 0xb4, 0x1b,
+	0x30, 0x31, 0x70, 0x03, 0x28, 0x38, 0x9f, 0xe2, 0xf3, 0x90, 0x5d, 0x13, 0x87, 0xf4, 0xcc, 0x49,
+	0x99, 0x93, 0xe7, 0x47, 0xab, 0x9a, 0x54, 0x96, 0x9e, 0x91, 0xf9, 0x60, 0x78, 0x5f, 0x4b, 0xcc,
+	0xaf, 0x06, 0xb8, 0x92, 0xd6, 0x9d, 0xde, 0xeb, 0x88, 0x47, 0xb0, 0x2b, 0xc6, 0x75, 0x35, 0x3e,
+	0x00, 0x80, 0x25, 0x18, 0xaa, 0xf1, 0xe7, 0xeb, 0xcc, 0x20, 0xa7, 0xeb, 0xd4, 0xc2, 0x69, 0xf2,
+	0xfe, 0x2d, 0x81, 0xd9, 0xd7, 0xa3, 0x57, 0x8a, 0x5d, 0x81, 0x04, 0x86, 0xb7, 0xc1, 0x94, 0x87,
+	0x18, 0xea, 0x45, 0xe4, 0x4b, 0x9b, 0x57, 0x72, 0x31, 0x77, 0xa4, 0xa9, 0x0e, 0xa3, 0xbc, 0xe1,
+	0x5b, 0x60, 0x7a, 0x0f, 0xe3, 0xa6, 0x47, 0x69, 0x57, 0xdd, 0xed, 0xab, 0xb9, 0x91, 0x6e, 0x63,
+	0xbc, 0x43, 0x69, 0x77, 0xe0, 0x2e, 0xef, 0x45, 0x6b, 0xb0, 0x0f, 0xcc, 0xf4, 0x86, 0x26, 0xd3,
+	0x3d, 0xbc, 0x14, 0x61, 0x47, 0x9c, 0x18, 0xfd, 0x56, 0xe8, 0x2f, 0x1c, 0x3a, 0xd2, 0xa2, 0x93,
+	0x65, 0xc1, 0xc3, 0xd6, 0xe0, 0x31, 0x1c, 0x10, 0xea, 0xcb, 0xf7, 0x1b, 0x8f, 0x72, 0xcc, 0xcc,
+	0xc9, 0xe3, 0x5a, 0x43, 0xec, 0xb2, 0xa3, 0x3c, 0xe0, 0x67, 0xd9, 0xd3, 0xfc, 0x29, 0x49, 0xfd,
+	0xd5, 0xd1, 0x8a, 0xf7, 0xa8, 0x77, 0x0f, 0x5d, 0x46, 0xc6, 0x00, 0x87, 0xdf, 0x19, 0x60, 0x4d,
+	0x2b, 0xcc, 0x74, 0xda, 0x35, 0xed, 0xa4, 0x5e, 0xb8, 0x39, 0x25, 0xa9, 0x6c, 0xfd, 0x8f, 0xa1,
+	0x3a, 0xcc, 0xa6, 0x12, 0xe4, 0x3a, 0x70, 0xf8, 0x95, 0x01, 0x2e, 0xa5, 0xd4, 0x3a, 0xc9, 0xb4,
+	0x4a, 0x12, 0x74, 0x46, 0xb2, 0x7a, 0xe5, 0x94, 0xd3, 0x6e, 0x98, 0xd1, 0x72, 0x70, 0xa4, 0x31,
+	0xfc, 0xc2, 0x00, 0x4b, 0x29, 0x19, 0x3b, 0x1a, 0x30, 0x09, 0x93, 0x69, 0xc9, 0xe4, 0xe6, 0x69,
+	0xa6, 0xd3, 0x30, 0x8d, 0x0b, 0x41, 0xb6, 0x25, 0xfc, 0x5c, 0xaf, 0xf3, 0x81, 0xe6, 0xcf, 0xcd,
+	0x19, 0xc9, 0xe0, 0xc6, 0xc9, 0xbb, 0xff, 0x30, 0xfe, 0xa2, 0x93, 0x65, 0xc7, 0x61, 0x1f, 0x2c,
+	0x66, 0x76, 0x59, 0x6e, 0x02, 0x09, 0xfe, 0xe2, 0x49, 0xdb, 0xec, 0x30, 0xf4, 0x42, 0x46, 0xb3,
+	0xe5, 0xd0, 0x07, 0x4b, 0xc8, 0x17, 0x34, 0xac, 0x48, 0x8f, 0xfa, 0xae, 0xd3, 0x54, 0x04, 0x65,
+	0x69, 0x96, 0x24, 0xf6, 0xf5, 0x5c, 0xec, 0x2d, 0x5f, 0xd0, 0xba, 0x72, 0xde, 0x4e, 0x7c, 0x6b,
+	0x93, 0x21, 0x70, 0xe3, 0x02, 0xca, 0xdc, 0xe5, 0xf0, 0x23, 0x30, 0x3f, 0x08, 0xcb, 0xc2, 0xa7,
+	0x39, 0x2b, 0xbb, 0x95, 0x35, 0x32, 0x60, 0x43, 0x3e, 0xe6, 0xd0, 0xe1, 0x25, 0xf8, 0xb5, 0x01,
+	0x2a, 0x5a, 0x49, 0x25, 0x95, 0xdf, 0xd4, 0x1a, 0xfb, 0x59, 0xa9, 0xee, 0xb5, 0x11, 0x0b, 0xeb,
+	0xc8, 0xf1, 0xa3, 0xa4, 0xae, 0x04, 0x79, 0xa6, 0x69, 0xdb, 0xaf, 0xdd, 0xb9, 0xbf, 0x5f, 0x36,
+	0x1e, 0xee, 0x97, 0x8d, 0x47, 0xfb, 0x65, 0xe3, 0x8f, 0xfd, 0xb2, 0xf1, 0xcd, 0x41, 0xb9, 0xf0,
+	0xe8, 0xa0, 0x5c, 0xf8, 0xe5, 0xa0, 0x5c, 0x78, 0x7f, 0x23, 0xf7, 0xd3, 0xe1, 0x93, 0xc1, 0x4f,
+	0x43, 0xf9, 0x25, 0xd1, 0x9a, 0x92, 0x9f, 0x77, 0xd7, 0xff, 0x1b, 0x00, 0xa9, 0x1d, 0x6c, 0xa8,
+	0xbc, 0x0e, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -764,6 +824,50 @@ func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorCommissionRecipientsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionRecipientsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionRecipientsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -784,6 +888,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorCommissionRecipients) > 0 {
+		for iNdEx := len(m.ValidatorCommissionRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCommissionRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.AutoCompoundRound != nil {
+		{
+			size, err := m.AutoCompoundRound.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1047,6 +1191,25 @@ func (m *ValidatorSlashEventRecord) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorCommissionRecipientsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1103,6 +1266,22 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for _, e := range m.AutoCompoundDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AutoCompoundRound != nil {
+		l = m.AutoCompoundRound.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ValidatorCommissionRecipients) > 0 {
+		for _, e := range m.ValidatorCommissionRecipients {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2006,6 +2185,122 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorCommissionRecipientsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCommissionRecipientsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCommissionRecipientsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, CommissionRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2371,6 +2666,110 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegations = append(m.AutoCompoundDelegations, AutoCompoundDelegation{})
+			if err := m.AutoCompoundDelegations[len(m.AutoCompoundDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundRound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoCompoundRound == nil {
+				m.AutoCompoundRound = &AutoCompoundRound{}
+			}
+			if err := m.AutoCompoundRound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCommissionRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorCommissionRecipients = append(m.ValidatorCommissionRecipients, ValidatorCommissionRecipientsRecord{})
+			if err := m.ValidatorCommissionRecipients[len(m.ValidatorCommissionRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -43,6 +43,10 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0a<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: AutoCompoundDelegations
+//
+// - 0x0b: AutoCompoundRound
 var (
 	FeePoolKey                        = collections.NewPrefix(0) // key for global distribution state
 	ProposerKey                       = []byte{0x01}             // key for the proposer operator address
@@ -56,6 +60,11 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = collections.NewPrefix(9) // key for distribution module params
+
+	AutoCompoundDelegationsKey = collections.NewPrefix(10) // key for the delegations restaked automatically
+	AutoCompoundRoundKey       = collections.NewPrefix(11) // key for the auto-compound round in progress
+
+	ValidatorCommissionRecipientsKey = collections.NewPrefix(12) // key for the recipients of the commission of validators
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
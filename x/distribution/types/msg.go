@@ -12,6 +12,8 @@ var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
+	_ sdk.Msg = (*MsgSetCommissionRecipients)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -52,3 +54,22 @@ func NewMsgDepositValidatorRewardsPool(depositor, valAddr string, amount sdk.Coi
 		ValidatorAddress: valAddr,
 	}
 }
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound enabling or disabling
+// the automatic restaking of the rewards of a delegation.
+func NewMsgSetAutoCompound(delAddr, valAddr string, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Enabled:          enabled,
+	}
+}
+
+// NewMsgSetCommissionRecipients returns a new MsgSetCommissionRecipients
+// setting the recipients the commission of a validator is split among.
+func NewMsgSetCommissionRecipients(valAddr string, recipients []CommissionRecipient) *MsgSetCommissionRecipients {
+	return &MsgSetCommissionRecipients{
+		ValidatorAddress: valAddr,
+		Recipients:       recipients,
+	}
+}
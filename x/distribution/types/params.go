@@ -6,19 +6,57 @@ import (
 	"cosmossdk.io/math"
 )
 
+// Default auto-compound parameters
+const (
+	DefaultAutoCompoundInterval  uint64 = 100
+	DefaultAutoCompoundGasBudget uint64 = 5_000_000
+)
+
+// DefaultAutoCompoundMinAmount is the default minimum amount of rewards
+// restaked by an auto-compound round.
+var DefaultAutoCompoundMinAmount = math.ZeroInt()
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:                math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:          math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:         math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:         true,
+		AutoCompoundInterval:        DefaultAutoCompoundInterval,
+		AutoCompoundEpochIdentifier: "",
+		AutoCompoundMinAmount:       DefaultAutoCompoundMinAmount,
+		AutoCompoundGasBudget:       DefaultAutoCompoundGasBudget,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	return p.validateAutoCompound()
+}
+
+// AutoCompoundEnabled returns true if auto-compound rounds are started every
+// few blocks or at the end of an epoch.
+func (p Params) AutoCompoundEnabled() bool {
+	return p.AutoCompoundInterval > 0 || p.AutoCompoundEpochIdentifier != ""
+}
+
+func (p Params) validateAutoCompound() error {
+	if p.AutoCompoundMinAmount.IsNil() {
+		return fmt.Errorf("auto-compound min amount must be not nil")
+	}
+	if p.AutoCompoundMinAmount.IsNegative() {
+		return fmt.Errorf("auto-compound min amount cannot be negative: %s", p.AutoCompoundMinAmount)
+	}
+	if p.AutoCompoundEnabled() && p.AutoCompoundGasBudget == 0 {
+		return fmt.Errorf("auto-compound gas budget must be positive when auto-compound is enabled")
+	}
+
+	return nil
 }
 
 func validateCommunityTax(tax math.LegacyDec) error {
@@ -34,10 +34,33 @@ func TestParams_ValidateBasic(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			p := types.Params{
-				CommunityTax:        tt.fields.CommunityTax,
-				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
+			p := types.DefaultParams()
+			p.CommunityTax = tt.fields.CommunityTax
+			p.WithdrawAddrEnabled = tt.fields.WithdrawAddrEnabled
+			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
 			}
+		})
+	}
+}
+
+func TestParams_ValidateAutoCompound(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(p *types.Params)
+		wantErr  bool
+	}{
+		{"default", func(p *types.Params) {}, false},
+		{"epoch triggered", func(p *types.Params) { p.AutoCompoundInterval, p.AutoCompoundEpochIdentifier = 0, "day" }, false},
+		{"disabled without gas budget", func(p *types.Params) { p.AutoCompoundInterval, p.AutoCompoundGasBudget = 0, 0 }, false},
+		{"enabled without gas budget", func(p *types.Params) { p.AutoCompoundGasBudget = 0 }, true},
+		{"min amount nil", func(p *types.Params) { p.AutoCompoundMinAmount = sdkmath.Int{} }, true},
+		{"negative min amount", func(p *types.Params) { p.AutoCompoundMinAmount = sdkmath.NewInt(-1) }, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := types.DefaultParams()
+			tt.malleate(&p)
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
 			}
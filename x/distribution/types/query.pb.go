@@ -1256,6 +1256,299 @@ func (m *QueryDelegatorStartingInfoResponse) GetStartingInfo() DelegatorStarting
 	return DelegatorStartingInfo{}
 }
 
+// QueryDelegatorAutoCompoundRequest is the request type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) Reset()         { *m = QueryDelegatorAutoCompoundRequest{} }
+func (m *QueryDelegatorAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{26}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegatorAutoCompoundResponse is the response type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundResponse struct {
+	// validators defines the validators to which the delegations are restaked
+	// automatically.
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Reset()         { *m = QueryDelegatorAutoCompoundResponse{} }
+func (m *QueryDelegatorAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{27}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundResponse) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAutoCompoundRoundRequest is the request type for the
+// Query/AutoCompoundRound RPC method.
+type QueryAutoCompoundRoundRequest struct {
+}
+
+func (m *QueryAutoCompoundRoundRequest) Reset()         { *m = QueryAutoCompoundRoundRequest{} }
+func (m *QueryAutoCompoundRoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundRoundRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundRoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{28}
+}
+func (m *QueryAutoCompoundRoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundRoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundRoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundRoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundRoundRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundRoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundRoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundRoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundRoundRequest proto.InternalMessageInfo
+
+// QueryAutoCompoundRoundResponse is the response type for the
+// Query/AutoCompoundRound RPC method.
+type QueryAutoCompoundRoundResponse struct {
+	// round defines the auto-compound round in progress, nil if there is none.
+	Round *AutoCompoundRound `protobuf:"bytes,1,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (m *QueryAutoCompoundRoundResponse) Reset()         { *m = QueryAutoCompoundRoundResponse{} }
+func (m *QueryAutoCompoundRoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundRoundResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundRoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{29}
+}
+func (m *QueryAutoCompoundRoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundRoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundRoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundRoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundRoundResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundRoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundRoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundRoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundRoundResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundRoundResponse) GetRound() *AutoCompoundRound {
+	if m != nil {
+		return m.Round
+	}
+	return nil
+}
+
+// QueryCommissionRecipientsRequest is the request type for the
+// Query/CommissionRecipients RPC method.
+type QueryCommissionRecipientsRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryCommissionRecipientsRequest) Reset()         { *m = QueryCommissionRecipientsRequest{} }
+func (m *QueryCommissionRecipientsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommissionRecipientsRequest) ProtoMessage()    {}
+func (*QueryCommissionRecipientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{30}
+}
+func (m *QueryCommissionRecipientsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommissionRecipientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommissionRecipientsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommissionRecipientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommissionRecipientsRequest.Merge(m, src)
+}
+func (m *QueryCommissionRecipientsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommissionRecipientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommissionRecipientsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommissionRecipientsRequest proto.InternalMessageInfo
+
+func (m *QueryCommissionRecipientsRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryCommissionRecipientsResponse is the response type for the
+// Query/CommissionRecipients RPC method.
+type QueryCommissionRecipientsResponse struct {
+	// recipients defines the addresses the commission of the validator is split
+	// among, empty if the commission is paid to the validator operator.
+	Recipients []CommissionRecipient `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *QueryCommissionRecipientsResponse) Reset()         { *m = QueryCommissionRecipientsResponse{} }
+func (m *QueryCommissionRecipientsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommissionRecipientsResponse) ProtoMessage()    {}
+func (*QueryCommissionRecipientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{31}
+}
+func (m *QueryCommissionRecipientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommissionRecipientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommissionRecipientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommissionRecipientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommissionRecipientsResponse.Merge(m, src)
+}
+func (m *QueryCommissionRecipientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommissionRecipientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommissionRecipientsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommissionRecipientsResponse proto.InternalMessageInfo
+
+func (m *QueryCommissionRecipientsResponse) GetRecipients() []CommissionRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -1283,6 +1576,12 @@ func init() {
 	proto.RegisterType((*QueryValidatorCurrentRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorCurrentRewardsResponse")
 	proto.RegisterType((*QueryDelegatorStartingInfoRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorStartingInfoRequest")
 	proto.RegisterType((*QueryDelegatorStartingInfoResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorStartingInfoResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundResponse")
+	proto.RegisterType((*QueryAutoCompoundRoundRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundRoundRequest")
+	proto.RegisterType((*QueryAutoCompoundRoundResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundRoundResponse")
+	proto.RegisterType((*QueryCommissionRecipientsRequest)(nil), "cosmos.distribution.v1beta1.QueryCommissionRecipientsRequest")
+	proto.RegisterType((*QueryCommissionRecipientsResponse)(nil), "cosmos.distribution.v1beta1.QueryCommissionRecipientsResponse")
 }
 
 func init() {
@@ -1290,102 +1589,114 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x70, 0x14, 0xc5,
-	0x17, 0x4e, 0x2f, 0x21, 0xfc, 0x78, 0xc0, 0x2f, 0x49, 0x83, 0xb0, 0x0c, 0xb0, 0x09, 0x13, 0x21,
-	0x29, 0xa8, 0xec, 0x40, 0xb0, 0x00, 0x41, 0x84, 0x6c, 0x12, 0xc4, 0x02, 0xf9, 0xb3, 0x41, 0x29,
-	0x54, 0x6a, 0x6b, 0x76, 0x67, 0xb2, 0x19, 0xdd, 0x9d, 0xde, 0x4c, 0xcf, 0x26, 0xa6, 0x28, 0x0e,
-	0xe2, 0x41, 0xe4, 0x44, 0xe9, 0xc5, 0xa3, 0x47, 0xcb, 0x93, 0x07, 0xbc, 0x78, 0xd1, 0x9b, 0x1c,
-	0x29, 0xac, 0xb2, 0x3c, 0xa9, 0x95, 0x58, 0x25, 0x1e, 0xb4, 0xac, 0xf2, 0xe0, 0x51, 0x6b, 0xbb,
-	0x7b, 0x66, 0x67, 0x76, 0x67, 0x66, 0x67, 0x77, 0xdd, 0x2a, 0x2f, 0xb0, 0xf4, 0xf4, 0xfb, 0xde,
-	0xfb, 0x5e, 0xbf, 0xd7, 0xfd, 0xbe, 0x02, 0xc6, 0x0b, 0x84, 0x96, 0x09, 0x55, 0x34, 0x83, 0xda,
-	0x96, 0x91, 0xaf, 0xda, 0x06, 0x31, 0x95, 0xe5, 0xa3, 0x79, 0xdd, 0x56, 0x8f, 0x2a, 0x4b, 0x55,
-	0xdd, 0x5a, 0x4d, 0x57, 0x2c, 0x62, 0x13, 0xbc, 0x87, 0x6f, 0x4c, 0x7b, 0x37, 0xa6, 0xc5, 0x46,
-	0xe9, 0x90, 0x40, 0xc9, 0xab, 0x54, 0xe7, 0x56, 0x2e, 0x46, 0x45, 0x2d, 0x1a, 0xa6, 0xca, 0x76,
-	0x33, 0x20, 0x69, 0x47, 0x91, 0x14, 0x09, 0xfb, 0xa9, 0xd4, 0x7e, 0x89, 0xd5, 0xbd, 0x45, 0x42,
-	0x8a, 0x25, 0x5d, 0x51, 0x2b, 0x86, 0xa2, 0x9a, 0x26, 0xb1, 0x99, 0x09, 0x15, 0x5f, 0x53, 0x5e,
-	0x7c, 0x07, 0xb9, 0x40, 0x0c, 0x07, 0x33, 0x1d, 0xc5, 0xc2, 0x17, 0x31, 0xdf, 0xbf, 0x9b, 0xef,
-	0xcf, 0xf1, 0x30, 0x04, 0x33, 0xfe, 0x69, 0x58, 0x2d, 0x1b, 0x26, 0x51, 0xd8, 0x9f, 0x7c, 0x49,
-	0xde, 0x01, 0xf8, 0x5a, 0x8d, 0xd3, 0x55, 0xd5, 0x52, 0xcb, 0x34, 0xab, 0x2f, 0x55, 0x75, 0x6a,
-	0xcb, 0xb7, 0x60, 0xbb, 0x6f, 0x95, 0x56, 0x88, 0x49, 0x75, 0x7c, 0x1e, 0x06, 0x2a, 0x6c, 0x25,
-	0x89, 0x46, 0xd1, 0xc4, 0x96, 0xa9, 0xb1, 0x74, 0x44, 0xe2, 0xd2, 0xdc, 0x38, 0xb3, 0xf9, 0xd1,
-	0x0f, 0x23, 0x7d, 0x9f, 0xfe, 0xf2, 0xf9, 0x21, 0x94, 0x15, 0xd6, 0xf2, 0x0a, 0x1c, 0x60, 0xf0,
-	0xaf, 0xa9, 0x25, 0x43, 0x53, 0x6d, 0x62, 0xcd, 0x7a, 0xec, 0x5f, 0x36, 0x17, 0x88, 0x88, 0x03,
-	0x5f, 0x86, 0xe1, 0x65, 0x67, 0x4f, 0x4e, 0xd5, 0x34, 0x4b, 0xa7, 0xdc, 0xf7, 0xe6, 0xcc, 0xfe,
-	0x27, 0x0f, 0x27, 0xf7, 0x09, 0xf7, 0x2e, 0xce, 0x34, 0xdf, 0x32, 0x6f, 0x5b, 0x86, 0x59, 0xcc,
-	0x0e, 0x2d, 0x37, 0xac, 0xcb, 0xbf, 0x27, 0xe0, 0x60, 0x2b, 0xcf, 0x82, 0xeb, 0x25, 0x18, 0x22,
-	0x15, 0xdd, 0xea, 0xcc, 0xf3, 0xa0, 0x63, 0x2a, 0x96, 0xf1, 0x5d, 0x04, 0xc3, 0x54, 0x2f, 0x2d,
-	0xe4, 0xf2, 0xc4, 0xd4, 0x72, 0x96, 0xbe, 0xa2, 0x5a, 0x1a, 0x4d, 0x26, 0x46, 0x37, 0x4c, 0x6c,
-	0x99, 0xda, 0xeb, 0x64, 0xb1, 0x56, 0x01, 0x6e, 0xf6, 0x66, 0xf5, 0xc2, 0x0c, 0x31, 0xcc, 0xcc,
-	0xc9, 0x5a, 0xfa, 0x3e, 0xfb, 0x71, 0xe4, 0x70, 0xd1, 0xb0, 0x17, 0xab, 0xf9, 0x74, 0x81, 0x94,
-	0xc5, 0xa1, 0x8a, 0xbf, 0x26, 0xa9, 0xf6, 0xb6, 0x62, 0xaf, 0x56, 0x74, 0xea, 0xd8, 0x50, 0x9e,
-	0xed, 0xc1, 0x9a, 0xc3, 0x0c, 0x31, 0xb5, 0x2c, 0x77, 0x87, 0x97, 0x00, 0x0a, 0xa4, 0x5c, 0x36,
-	0x28, 0x35, 0x88, 0x99, 0xdc, 0x10, 0xc3, 0xf9, 0xb1, 0x0e, 0x9c, 0x67, 0x3d, 0x4e, 0xe4, 0x55,
-	0x18, 0xf7, 0xe7, 0xfb, 0x4a, 0xd5, 0xa6, 0xb6, 0x6a, 0x6a, 0xb5, 0x2c, 0xf1, 0xb0, 0x7a, 0x75,
-	0xd6, 0x1f, 0x20, 0x98, 0x68, 0xed, 0x5b, 0x9c, 0xf6, 0x2d, 0xd8, 0xe4, 0x1c, 0x0a, 0x2f, 0xed,
-	0x93, 0x91, 0xa5, 0x1d, 0x01, 0xe9, 0xad, 0x77, 0x07, 0x53, 0x5e, 0x82, 0x11, 0x7f, 0x28, 0x33,
-	0x6e, 0x8a, 0x7a, 0x45, 0xff, 0x3e, 0x82, 0xd1, 0x70, 0x9f, 0x82, 0xf6, 0x82, 0xaf, 0x22, 0x38,
-	0xf3, 0xd3, 0xf1, 0x98, 0x4f, 0x17, 0x0a, 0xd5, 0x72, 0xb5, 0xa4, 0xda, 0xba, 0x56, 0x07, 0xf6,
-	0x92, 0xf7, 0x96, 0xc1, 0xfd, 0x04, 0xec, 0xf5, 0x07, 0x33, 0x5f, 0x52, 0xe9, 0xa2, 0xde, 0xab,
-	0xc3, 0xc7, 0xe3, 0x30, 0x48, 0x6d, 0xd5, 0xb2, 0x0d, 0xb3, 0x98, 0x5b, 0xd4, 0x8d, 0xe2, 0xa2,
-	0x9d, 0x4c, 0x8c, 0xa2, 0x89, 0xfe, 0xec, 0xff, 0x9d, 0xe5, 0x0b, 0x6c, 0x15, 0x8f, 0xc1, 0x36,
-	0x9d, 0x1d, 0x9f, 0xb3, 0x6d, 0x03, 0xdb, 0xb6, 0x95, 0x2f, 0x8a, 0x4d, 0xe7, 0x01, 0xea, 0x57,
-	0x7d, 0xb2, 0x9f, 0xa5, 0xe9, 0xa0, 0xaf, 0x71, 0xf8, 0x6b, 0x52, 0xbf, 0xf9, 0x8a, 0xba, 0x60,
-	0x96, 0xf5, 0x58, 0x9e, 0xea, 0xbf, 0xf7, 0xc9, 0x48, 0x9f, 0xfc, 0x15, 0x82, 0x7d, 0x21, 0xc9,
-	0x10, 0xc7, 0xf2, 0x2a, 0x6c, 0xa2, 0x7c, 0x29, 0x89, 0x58, 0x97, 0x1e, 0x89, 0x77, 0x26, 0x0c,
-	0x67, 0x6e, 0x59, 0x37, 0x6d, 0x5f, 0x15, 0x0a, 0x2c, 0xfc, 0x92, 0x8f, 0x46, 0x82, 0xd1, 0x18,
-	0x6f, 0x49, 0x83, 0xc7, 0xe4, 0xe5, 0x21, 0x7f, 0xed, 0x30, 0x98, 0xd5, 0x4b, 0x7a, 0x91, 0xad,
-	0x35, 0x34, 0xf3, 0x1c, 0x0c, 0x6b, 0xfc, 0x5b, 0xd3, 0x79, 0x26, 0x9f, 0x3c, 0x9c, 0xdc, 0x21,
-	0x9c, 0x36, 0x1c, 0xa3, 0x6b, 0xe2, 0x1c, 0x63, 0x60, 0x59, 0x24, 0x3a, 0x2e, 0x8b, 0x53, 0xff,
-	0xab, 0x1d, 0xc0, 0xd3, 0xda, 0x21, 0x7c, 0x88, 0x20, 0x15, 0x46, 0x41, 0x9c, 0x42, 0xc5, 0x7b,
-	0x27, 0xf4, 0xf2, 0xa2, 0x76, 0xaf, 0x89, 0x2a, 0xc8, 0x0d, 0x31, 0x5d, 0x27, 0xb6, 0x5a, 0xea,
-	0x49, 0x6e, 0x3d, 0xb9, 0xf8, 0x03, 0xc1, 0x58, 0xa4, 0x5f, 0x91, 0x90, 0x37, 0x1a, 0x13, 0x72,
-	0x3c, 0xb2, 0x2c, 0xeb, 0x68, 0xb3, 0x8e, 0x6f, 0x8e, 0x18, 0x74, 0x45, 0xe2, 0x12, 0x6c, 0xb4,
-	0x6b, 0x4e, 0x7b, 0xfc, 0x28, 0x72, 0x27, 0xb2, 0x25, 0x2e, 0x64, 0x37, 0x32, 0xb7, 0x84, 0x7a,
-	0x97, 0xe6, 0x4b, 0xe2, 0x42, 0x0e, 0xf4, 0x29, 0x52, 0x9c, 0x02, 0x70, 0x8b, 0x96, 0x67, 0x79,
-	0x73, 0xd6, 0xb3, 0xe2, 0x41, 0x5b, 0x81, 0x67, 0xfd, 0x68, 0x37, 0x0c, 0x7b, 0x51, 0xb3, 0xd4,
-	0x15, 0xe1, 0xb8, 0x67, 0x34, 0x96, 0xc5, 0xf0, 0x16, 0xee, 0x58, 0x70, 0x99, 0x81, 0xa1, 0x15,
-	0xf1, 0x29, 0xb6, 0xe3, 0xc1, 0x15, 0x3f, 0x98, 0xc7, 0xef, 0x1e, 0xd8, 0xcd, 0xfc, 0xd6, 0x5e,
-	0x9b, 0xaa, 0x69, 0xd8, 0xab, 0x57, 0x09, 0x29, 0x39, 0x03, 0xeb, 0x3d, 0x04, 0x52, 0xd0, 0x57,
-	0x11, 0xca, 0x5b, 0xd0, 0x5f, 0x21, 0xa4, 0xd4, 0xe3, 0x3e, 0x66, 0x3e, 0xe4, 0x07, 0xa8, 0x71,
-	0xc6, 0xbc, 0x60, 0x50, 0x9b, 0x58, 0x46, 0xa1, 0xa9, 0x93, 0xff, 0xed, 0x57, 0x6f, 0x27, 0x0c,
-	0x54, 0x74, 0xcb, 0x20, 0x9a, 0x78, 0xec, 0xc4, 0xbf, 0xe4, 0xf7, 0x51, 0xe3, 0x18, 0x16, 0x10,
-	0x92, 0x48, 0xd5, 0x9b, 0x8d, 0x93, 0xd0, 0x89, 0x78, 0x6f, 0x4f, 0x13, 0x62, 0xe0, 0x20, 0x54,
-	0x15, 0x37, 0x4d, 0x7d, 0x28, 0xa9, 0x5a, 0x96, 0x6e, 0xda, 0x3d, 0x9e, 0x05, 0xdf, 0x45, 0xa2,
-	0x5b, 0x42, 0xfd, 0x0a, 0xf6, 0x37, 0x1b, 0xd9, 0x3f, 0x17, 0x8f, 0xbd, 0x1f, 0x2e, 0x90, 0xfa,
-	0x97, 0x08, 0xf6, 0xfb, 0x1b, 0x67, 0x5e, 0x8c, 0x22, 0x5e, 0xc5, 0xf3, 0xdf, 0x7c, 0x38, 0x6b,
-	0xfd, 0x25, 0x47, 0x05, 0x2f, 0xd2, 0x97, 0x87, 0x6d, 0xee, 0xd8, 0x65, 0x98, 0x0b, 0x44, 0x24,
-	0x71, 0x2a, 0xce, 0x3b, 0xe1, 0x87, 0xf4, 0xa6, 0x70, 0x2b, 0xf5, 0x7c, 0x98, 0xfa, 0x66, 0x17,
-	0x6c, 0x64, 0xa1, 0xe0, 0x8f, 0x11, 0x0c, 0x70, 0x91, 0x89, 0x95, 0x48, 0x0f, 0xcd, 0x0a, 0x57,
-	0x3a, 0x12, 0xdf, 0x80, 0x73, 0x93, 0x0f, 0xdf, 0xfd, 0xf6, 0xe7, 0x8f, 0x12, 0x07, 0xf0, 0x98,
-	0x12, 0x25, 0xc8, 0xb9, 0xc2, 0xc5, 0xbf, 0x22, 0xd8, 0x1d, 0xaa, 0x31, 0x71, 0xa6, 0xb5, 0xf3,
-	0x56, 0xd2, 0x58, 0x9a, 0xe9, 0x0a, 0x43, 0x70, 0x9a, 0x61, 0x9c, 0xce, 0xe0, 0xd3, 0x91, 0x9c,
-	0xea, 0xef, 0x8f, 0x72, 0xbb, 0xa9, 0xaa, 0xee, 0xe0, 0xf7, 0x12, 0xb0, 0x27, 0x42, 0x10, 0xe1,
-	0xd9, 0x36, 0x22, 0x0d, 0x95, 0x87, 0xd2, 0x5c, 0x97, 0x28, 0x82, 0xf1, 0x0d, 0xc6, 0xf8, 0x1a,
-	0xbe, 0xd2, 0x05, 0x63, 0x85, 0xd4, 0xf1, 0x1d, 0x2d, 0x8f, 0xd7, 0x10, 0x6c, 0x0f, 0x90, 0x5a,
-	0xf8, 0x85, 0x36, 0xe2, 0x6e, 0x52, 0x85, 0xd2, 0x99, 0x0e, 0xad, 0x05, 0xdb, 0xcb, 0x8c, 0xed,
-	0x05, 0x7c, 0xbe, 0x1b, 0xb6, 0x75, 0x1d, 0x87, 0xbf, 0x43, 0x30, 0xd4, 0xa8, 0x5a, 0xf0, 0xf3,
-	0x6d, 0xc4, 0xe8, 0x97, 0x7d, 0xd2, 0xa9, 0x4e, 0x4c, 0x05, 0xb7, 0x8b, 0x8c, 0xdb, 0x1c, 0x9e,
-	0xe9, 0x86, 0x9b, 0x23, 0x8d, 0x7e, 0x43, 0x30, 0xdc, 0xa4, 0x04, 0x70, 0x8c, 0xf0, 0xc2, 0x14,
-	0x90, 0x74, 0xba, 0x23, 0x5b, 0xc1, 0x2d, 0xc7, 0xb8, 0xdd, 0xc4, 0x37, 0x22, 0xb9, 0xb9, 0xb7,
-	0x3e, 0x55, 0x6e, 0x37, 0x3d, 0x1a, 0x77, 0x14, 0x51, 0x99, 0x81, 0x3d, 0xfb, 0x14, 0xc1, 0xce,
-	0xe0, 0x69, 0x1f, 0x9f, 0x6d, 0x27, 0xf0, 0x00, 0x7d, 0x22, 0x9d, 0xeb, 0x1c, 0xa0, 0xad, 0xa3,
-	0x8d, 0x47, 0x9f, 0x35, 0x66, 0xc0, 0xc8, 0x1d, 0xa7, 0x31, 0xc3, 0xd5, 0x41, 0x9c, 0xc6, 0x8c,
-	0x98, 0xf3, 0x63, 0x36, 0x66, 0x0b, 0x86, 0xf5, 0xda, 0xc6, 0x7f, 0x21, 0x48, 0x86, 0x0d, 0xe4,
-	0x78, 0xba, 0x8d, 0x58, 0x83, 0x55, 0x84, 0x94, 0xe9, 0x06, 0x42, 0x70, 0xbe, 0xce, 0x38, 0x5f,
-	0xc6, 0x97, 0xba, 0xe1, 0xdc, 0xa8, 0x28, 0xf0, 0x17, 0x08, 0xb6, 0xf9, 0x86, 0x7e, 0x7c, 0xbc,
-	0x75, 0xac, 0x41, 0x1a, 0x42, 0x3a, 0xd1, 0xb6, 0x9d, 0x20, 0x76, 0x8c, 0x11, 0x9b, 0xc4, 0x87,
-	0x23, 0x89, 0x15, 0x1c, 0xdb, 0x5c, 0x4d, 0x26, 0xe0, 0x7b, 0x09, 0x90, 0xc2, 0x87, 0x67, 0xdc,
-	0xce, 0xf3, 0x1e, 0xa6, 0x2f, 0xa4, 0xd9, 0xee, 0x40, 0xda, 0xba, 0x8c, 0x5a, 0x5c, 0xb4, 0x8b,
-	0x2e, 0x7c, 0xce, 0xbd, 0x97, 0xb8, 0x3a, 0xb9, 0x83, 0xff, 0x44, 0xb0, 0x2b, 0x64, 0x92, 0xc6,
-	0xe7, 0xda, 0x79, 0x00, 0x83, 0xb4, 0x84, 0x34, 0xdd, 0x05, 0x82, 0xc8, 0xc0, 0x3c, 0xcb, 0xc0,
-	0x2b, 0xf8, 0x62, 0x57, 0xcf, 0x28, 0xc7, 0x76, 0x07, 0x86, 0xbf, 0x11, 0x3c, 0x13, 0x38, 0xfa,
-	0xe2, 0x17, 0xdb, 0x68, 0xb6, 0x00, 0x0d, 0x21, 0x9d, 0xed, 0xd8, 0x5e, 0xf0, 0xd5, 0x19, 0xdf,
-	0x1c, 0xbe, 0xd5, 0x4d, 0xa7, 0xfa, 0x84, 0x40, 0x50, 0x46, 0x32, 0x17, 0x1f, 0xad, 0xa5, 0xd0,
-	0xe3, 0xb5, 0x14, 0xfa, 0x69, 0x2d, 0x85, 0x1e, 0xac, 0xa7, 0xfa, 0x1e, 0xaf, 0xa7, 0xfa, 0xbe,
-	0x5f, 0x4f, 0xf5, 0xbd, 0x7e, 0x34, 0x52, 0x7b, 0xbf, 0xe3, 0x8f, 0x87, 0x49, 0xf1, 0xfc, 0x00,
-	0xfb, 0xff, 0xac, 0x63, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x01, 0x22, 0x25, 0xf5, 0x1b,
-	0x00, 0x00,
+	// 1712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4f, 0x6c, 0x13, 0x47,
+	0x17, 0xcf, 0x98, 0x10, 0x3e, 0x1e, 0xf0, 0x25, 0x19, 0xf2, 0xf1, 0x99, 0x25, 0x38, 0x61, 0xf3,
+	0x41, 0x22, 0x50, 0x6c, 0x08, 0x9f, 0x80, 0x92, 0x06, 0xc8, 0xdf, 0x82, 0x42, 0x81, 0x38, 0xb4,
+	0x88, 0xb6, 0xc8, 0x5a, 0x7b, 0x37, 0xce, 0xb6, 0xf6, 0x8e, 0xb3, 0x7f, 0x92, 0x46, 0x08, 0xa9,
+	0xa5, 0x87, 0x52, 0x4e, 0xa8, 0xbd, 0xf4, 0xd8, 0x63, 0xd5, 0x13, 0xaa, 0xe8, 0xa5, 0x97, 0xf6,
+	0x54, 0x71, 0x44, 0x54, 0xaa, 0x7a, 0x6a, 0xab, 0x50, 0xa9, 0x54, 0x55, 0x2b, 0xaa, 0x1e, 0x7a,
+	0x6c, 0xe5, 0x99, 0xd9, 0xf5, 0xae, 0xbd, 0xbb, 0x5e, 0xdb, 0xb1, 0xd4, 0x0b, 0x98, 0xd9, 0x79,
+	0xbf, 0xf7, 0x7e, 0x6f, 0xde, 0xcc, 0xbc, 0xdf, 0x08, 0x18, 0xce, 0x11, 0xa3, 0x48, 0x8c, 0x94,
+	0xac, 0x1a, 0xa6, 0xae, 0x66, 0x2d, 0x53, 0x25, 0x5a, 0x6a, 0xf5, 0x58, 0x56, 0x31, 0xa5, 0x63,
+	0xa9, 0x15, 0x4b, 0xd1, 0xd7, 0x93, 0x25, 0x9d, 0x98, 0x04, 0xef, 0x63, 0x13, 0x93, 0xee, 0x89,
+	0x49, 0x3e, 0x51, 0x38, 0xcc, 0x51, 0xb2, 0x92, 0xa1, 0x30, 0x2b, 0x07, 0xa3, 0x24, 0xe5, 0x55,
+	0x4d, 0xa2, 0xb3, 0x29, 0x90, 0xd0, 0x97, 0x27, 0x79, 0x42, 0x7f, 0xa6, 0xca, 0xbf, 0xf8, 0x68,
+	0x7f, 0x9e, 0x90, 0x7c, 0x41, 0x49, 0x49, 0x25, 0x35, 0x25, 0x69, 0x1a, 0x31, 0xa9, 0x89, 0xc1,
+	0xbf, 0x26, 0xdc, 0xf8, 0x36, 0x72, 0x8e, 0xa8, 0x36, 0x66, 0x32, 0x8c, 0x85, 0x27, 0x62, 0x36,
+	0x7f, 0x2f, 0x9b, 0x9f, 0x61, 0x61, 0x70, 0x66, 0xec, 0x53, 0xaf, 0x54, 0x54, 0x35, 0x92, 0xa2,
+	0x7f, 0xb2, 0x21, 0xb1, 0x0f, 0xf0, 0x42, 0x99, 0xd3, 0x15, 0x49, 0x97, 0x8a, 0x46, 0x5a, 0x59,
+	0xb1, 0x14, 0xc3, 0x14, 0x6f, 0xc0, 0x6e, 0xcf, 0xa8, 0x51, 0x22, 0x9a, 0xa1, 0xe0, 0x39, 0xe8,
+	0x2a, 0xd1, 0x91, 0x38, 0x1a, 0x44, 0x23, 0x3b, 0xc6, 0x86, 0x92, 0x21, 0x89, 0x4b, 0x32, 0xe3,
+	0xa9, 0xed, 0x0f, 0xbf, 0x1b, 0xe8, 0xf8, 0xf8, 0xa7, 0xfb, 0x87, 0x51, 0x9a, 0x5b, 0x8b, 0x6b,
+	0x70, 0x90, 0xc2, 0xbf, 0x2c, 0x15, 0x54, 0x59, 0x32, 0x89, 0x3e, 0xe3, 0xb2, 0xbf, 0xa0, 0x2d,
+	0x11, 0x1e, 0x07, 0xbe, 0x04, 0xbd, 0xab, 0xf6, 0x9c, 0x8c, 0x24, 0xcb, 0xba, 0x62, 0x30, 0xdf,
+	0xdb, 0xa7, 0x0e, 0x3c, 0x7e, 0x30, 0xba, 0x9f, 0xbb, 0x77, 0x70, 0x26, 0xd9, 0x94, 0x45, 0x53,
+	0x57, 0xb5, 0x7c, 0xba, 0x67, 0xb5, 0x6a, 0x5c, 0xfc, 0x2d, 0x06, 0x87, 0xea, 0x79, 0xe6, 0x5c,
+	0x2f, 0x42, 0x0f, 0x29, 0x29, 0x7a, 0x73, 0x9e, 0xbb, 0x6d, 0x53, 0x3e, 0x8c, 0x6f, 0x23, 0xe8,
+	0x35, 0x94, 0xc2, 0x52, 0x26, 0x4b, 0x34, 0x39, 0xa3, 0x2b, 0x6b, 0x92, 0x2e, 0x1b, 0xf1, 0xd8,
+	0xe0, 0x96, 0x91, 0x1d, 0x63, 0xfd, 0x76, 0x16, 0xcb, 0x15, 0xe0, 0x64, 0x6f, 0x46, 0xc9, 0x4d,
+	0x13, 0x55, 0x9b, 0x3a, 0x55, 0x4e, 0xdf, 0x27, 0xdf, 0x0f, 0x1c, 0xc9, 0xab, 0xe6, 0xb2, 0x95,
+	0x4d, 0xe6, 0x48, 0x91, 0x2f, 0x2a, 0xff, 0x6b, 0xd4, 0x90, 0xdf, 0x48, 0x99, 0xeb, 0x25, 0xc5,
+	0xb0, 0x6d, 0x0c, 0x96, 0xed, 0xee, 0xb2, 0xc3, 0x29, 0xa2, 0xc9, 0x69, 0xe6, 0x0e, 0xaf, 0x00,
+	0xe4, 0x48, 0xb1, 0xa8, 0x1a, 0x86, 0x4a, 0xb4, 0xf8, 0x96, 0x08, 0xce, 0x8f, 0x37, 0xe1, 0x3c,
+	0xed, 0x72, 0x22, 0xae, 0xc3, 0xb0, 0x37, 0xdf, 0x97, 0x2d, 0xd3, 0x30, 0x25, 0x4d, 0x2e, 0x67,
+	0x89, 0x85, 0xd5, 0xae, 0xb5, 0x7e, 0x0f, 0xc1, 0x48, 0x7d, 0xdf, 0x7c, 0xb5, 0x6f, 0xc0, 0x36,
+	0x7b, 0x51, 0x58, 0x69, 0x9f, 0x0a, 0x2d, 0xed, 0x10, 0x48, 0x77, 0xbd, 0xdb, 0x98, 0xe2, 0x0a,
+	0x0c, 0x78, 0x43, 0x99, 0x76, 0x52, 0xd4, 0x2e, 0xfa, 0x77, 0x11, 0x0c, 0x06, 0xfb, 0xe4, 0xb4,
+	0x97, 0x3c, 0x15, 0xc1, 0x98, 0x8f, 0x47, 0x63, 0x3e, 0x99, 0xcb, 0x59, 0x45, 0xab, 0x20, 0x99,
+	0x8a, 0x5c, 0x01, 0x76, 0x93, 0x77, 0x97, 0xc1, 0xdd, 0x18, 0xf4, 0x7b, 0x83, 0x59, 0x2c, 0x48,
+	0xc6, 0xb2, 0xd2, 0xae, 0xc5, 0xc7, 0xc3, 0xd0, 0x6d, 0x98, 0x92, 0x6e, 0xaa, 0x5a, 0x3e, 0xb3,
+	0xac, 0xa8, 0xf9, 0x65, 0x33, 0x1e, 0x1b, 0x44, 0x23, 0x9d, 0xe9, 0x7f, 0xdb, 0xc3, 0xe7, 0xe9,
+	0x28, 0x1e, 0x82, 0x5d, 0x8a, 0x26, 0xbb, 0xa6, 0x6d, 0xa1, 0xd3, 0x76, 0xb2, 0x41, 0x3e, 0x69,
+	0x0e, 0xa0, 0x72, 0xd4, 0xc7, 0x3b, 0x69, 0x9a, 0x0e, 0x79, 0x36, 0x0e, 0xbb, 0x4d, 0x2a, 0x27,
+	0x5f, 0x5e, 0xe1, 0xcc, 0xd2, 0x2e, 0xcb, 0xd3, 0x9d, 0x77, 0x3e, 0x1a, 0xe8, 0x10, 0xbf, 0x40,
+	0xb0, 0x3f, 0x20, 0x19, 0x7c, 0x59, 0x5e, 0x82, 0x6d, 0x06, 0x1b, 0x8a, 0x23, 0xba, 0x4b, 0x8f,
+	0x46, 0x5b, 0x13, 0x8a, 0x33, 0xbb, 0xaa, 0x68, 0xa6, 0xa7, 0x0a, 0x39, 0x16, 0x7e, 0xc1, 0x43,
+	0x23, 0x46, 0x69, 0x0c, 0xd7, 0xa5, 0xc1, 0x62, 0x72, 0xf3, 0x10, 0xbf, 0xb4, 0x19, 0xcc, 0x28,
+	0x05, 0x25, 0x4f, 0xc7, 0xaa, 0x36, 0xf3, 0x2c, 0xf4, 0xca, 0xec, 0x5b, 0xcd, 0x7a, 0xc6, 0x1f,
+	0x3f, 0x18, 0xed, 0xe3, 0x4e, 0xab, 0x96, 0xd1, 0x31, 0xb1, 0x97, 0xd1, 0xb7, 0x2c, 0x62, 0x4d,
+	0x97, 0xc5, 0xe9, 0x7f, 0x95, 0x17, 0xe0, 0x69, 0x79, 0x11, 0xde, 0x47, 0x90, 0x08, 0xa2, 0xc0,
+	0x57, 0xa1, 0xe4, 0x3e, 0x13, 0xda, 0x79, 0x50, 0x3b, 0xc7, 0x84, 0x05, 0x62, 0x55, 0x4c, 0x57,
+	0x89, 0x29, 0x15, 0xda, 0x92, 0x5b, 0x57, 0x2e, 0x9e, 0x21, 0x18, 0x0a, 0xf5, 0xcb, 0x13, 0xf2,
+	0x6a, 0x75, 0x42, 0x4e, 0x84, 0x96, 0x65, 0x05, 0x6d, 0xc6, 0xf6, 0xcd, 0x10, 0xfd, 0x8e, 0x48,
+	0x5c, 0x80, 0xad, 0x66, 0xd9, 0x69, 0x9b, 0x2f, 0x45, 0xe6, 0x44, 0xd4, 0xf9, 0x81, 0xec, 0x44,
+	0xe6, 0x94, 0x50, 0xfb, 0xd2, 0x7c, 0x11, 0x06, 0x83, 0x7d, 0xf2, 0x14, 0x27, 0x00, 0x9c, 0xa2,
+	0x65, 0x59, 0xde, 0x9e, 0x76, 0x8d, 0xb8, 0xd0, 0xd6, 0xe0, 0x7f, 0x5e, 0xb4, 0x6b, 0xaa, 0xb9,
+	0x2c, 0xeb, 0xd2, 0x1a, 0x77, 0xdc, 0x36, 0x1a, 0xab, 0x70, 0xb0, 0x8e, 0x63, 0xce, 0x65, 0x1a,
+	0x7a, 0xd6, 0xf8, 0xa7, 0xc8, 0x8e, 0xbb, 0xd7, 0xbc, 0x60, 0x2e, 0xbf, 0xfb, 0x60, 0x2f, 0xf5,
+	0x5b, 0xbe, 0x6d, 0x2c, 0x4d, 0x35, 0xd7, 0xaf, 0x10, 0x52, 0xb0, 0x1b, 0xd6, 0x3b, 0x08, 0x04,
+	0xbf, 0xaf, 0x3c, 0x94, 0xd7, 0xa1, 0xb3, 0x44, 0x48, 0xa1, 0xcd, 0xfb, 0x98, 0xfa, 0x10, 0xef,
+	0xa1, 0xea, 0x1e, 0xf3, 0xbc, 0x6a, 0x98, 0x44, 0x57, 0x73, 0x35, 0x3b, 0x79, 0xb3, 0x6f, 0xbd,
+	0x3d, 0xd0, 0x55, 0x52, 0x74, 0x95, 0xc8, 0xfc, 0xb2, 0xe3, 0xff, 0x12, 0xdf, 0x45, 0x30, 0x5c,
+	0x37, 0x24, 0x9e, 0xaa, 0xd7, 0xaa, 0x3b, 0xa1, 0x93, 0xd1, 0xee, 0x9e, 0x1a, 0x44, 0xdf, 0x46,
+	0xc8, 0xe2, 0x27, 0x4d, 0xa5, 0x29, 0xb1, 0x74, 0x5d, 0xd1, 0xcc, 0x36, 0xf7, 0x82, 0x6f, 0x23,
+	0xbe, 0x5b, 0x02, 0xfd, 0x72, 0xf6, 0xd7, 0xab, 0xd9, 0xff, 0x3f, 0x1a, 0x7b, 0x2f, 0x9c, 0x2f,
+	0xf5, 0xcf, 0x11, 0x1c, 0xf0, 0x6e, 0x9c, 0x45, 0xde, 0x8a, 0xb8, 0x15, 0xcf, 0x3f, 0xf3, 0xe2,
+	0x2c, 0xef, 0x2f, 0x31, 0x2c, 0x78, 0x9e, 0xbe, 0x2c, 0xec, 0x72, 0xda, 0x2e, 0x55, 0x5b, 0x22,
+	0x3c, 0x89, 0x63, 0x51, 0xee, 0x09, 0x2f, 0xa4, 0x3b, 0x85, 0x3b, 0x0d, 0xd7, 0x07, 0xf1, 0xd3,
+	0x9a, 0x3c, 0x4e, 0x5a, 0x26, 0x99, 0x26, 0xc5, 0x12, 0xb1, 0x34, 0x79, 0x93, 0xf3, 0x38, 0xe7,
+	0xd3, 0x32, 0x35, 0xd1, 0xf9, 0x89, 0xf7, 0x6b, 0xf2, 0xe7, 0x0d, 0x9a, 0xe7, 0x6f, 0xb2, 0xf6,
+	0xf8, 0x8f, 0xb2, 0x5e, 0x2e, 0xa3, 0xcd, 0x6b, 0xf2, 0x06, 0x78, 0x8f, 0xe7, 0x09, 0xd4, 0x95,
+	0x62, 0x71, 0x09, 0x12, 0x41, 0x13, 0x38, 0x9d, 0x19, 0xd8, 0xaa, 0x97, 0x07, 0x78, 0x19, 0x24,
+	0x43, 0xcb, 0xa0, 0x16, 0x86, 0x19, 0x8b, 0x3a, 0x0c, 0x3a, 0x47, 0xbb, 0xad, 0x5f, 0x72, 0x6a,
+	0x49, 0x55, 0x34, 0xb3, 0x6d, 0x07, 0xc6, 0x5b, 0x76, 0x91, 0xf9, 0x3b, 0x75, 0x1a, 0x22, 0xd0,
+	0x9d, 0xd1, 0x48, 0xad, 0xba, 0x0f, 0x9c, 0x47, 0x33, 0x55, 0xe0, 0xc6, 0x7e, 0xef, 0x87, 0xad,
+	0x34, 0x04, 0xfc, 0x21, 0x82, 0x2e, 0xf6, 0x98, 0x82, 0x53, 0xa1, 0xe8, 0xb5, 0x2f, 0x39, 0xc2,
+	0xd1, 0xe8, 0x06, 0x8c, 0x94, 0x78, 0xe4, 0xf6, 0xd7, 0x3f, 0x7e, 0x10, 0x3b, 0x88, 0x87, 0x52,
+	0x61, 0x0f, 0x4f, 0xec, 0x25, 0x07, 0xff, 0x8c, 0x60, 0x6f, 0xe0, 0x5b, 0x0a, 0x9e, 0xaa, 0xef,
+	0xbc, 0xde, 0x13, 0x90, 0x30, 0xdd, 0x12, 0x06, 0xe7, 0x34, 0x4d, 0x39, 0x4d, 0xe0, 0xf1, 0x50,
+	0x4e, 0x95, 0x5d, 0x94, 0xba, 0x59, 0x53, 0x4d, 0xb7, 0xf0, 0x3b, 0x31, 0xd8, 0x17, 0x22, 0xfc,
+	0xf1, 0x4c, 0x03, 0x91, 0x06, 0x3e, 0x83, 0x08, 0xb3, 0x2d, 0xa2, 0x70, 0xc6, 0xd7, 0x28, 0xe3,
+	0x05, 0x7c, 0xb9, 0x05, 0xc6, 0x29, 0x52, 0xc1, 0xb7, 0xdf, 0xac, 0xf0, 0x06, 0x82, 0xdd, 0x3e,
+	0x4f, 0x0a, 0xf8, 0xf9, 0x06, 0xe2, 0xae, 0x79, 0xfd, 0x10, 0x26, 0x9a, 0xb4, 0xe6, 0x6c, 0x2f,
+	0x51, 0xb6, 0xe7, 0xf1, 0x5c, 0x2b, 0x6c, 0x2b, 0xef, 0x15, 0xf8, 0x1b, 0x04, 0x3d, 0xd5, 0xea,
+	0x1c, 0x3f, 0xd7, 0x40, 0x8c, 0xde, 0xe7, 0x0d, 0xe1, 0x74, 0x33, 0xa6, 0x9c, 0xdb, 0x3c, 0xe5,
+	0x36, 0x8b, 0xa7, 0x5b, 0xe1, 0x66, 0x3f, 0x01, 0xfc, 0x8a, 0xa0, 0xb7, 0x46, 0xf1, 0xe2, 0x08,
+	0xe1, 0x05, 0x29, 0x7d, 0x61, 0xbc, 0x29, 0x5b, 0xce, 0x2d, 0x43, 0xb9, 0x5d, 0xc7, 0xd7, 0x42,
+	0xb9, 0x39, 0xb7, 0xb2, 0x91, 0xba, 0x59, 0x73, 0xa9, 0xdf, 0x4a, 0xf1, 0xca, 0xf4, 0xdd, 0xb3,
+	0x4f, 0x11, 0xec, 0xf1, 0x57, 0xb5, 0xf8, 0x6c, 0x23, 0x81, 0xfb, 0xe8, 0x70, 0xe1, 0x5c, 0xf3,
+	0x00, 0x0d, 0x2d, 0x6d, 0x34, 0xfa, 0x74, 0x63, 0xfa, 0x48, 0xcb, 0x28, 0x1b, 0x33, 0x58, 0x05,
+	0x0b, 0x13, 0x4d, 0x5a, 0x37, 0xb4, 0x31, 0xeb, 0x30, 0x74, 0x75, 0x37, 0x7f, 0x22, 0x88, 0x07,
+	0x09, 0x4f, 0x3c, 0xd9, 0x40, 0xac, 0xfe, 0x6a, 0x59, 0x98, 0x6a, 0x05, 0x82, 0x73, 0xbe, 0x4a,
+	0x39, 0x5f, 0xc2, 0x17, 0x5b, 0xe1, 0x5c, 0xad, 0x9c, 0xf1, 0x67, 0x08, 0x76, 0x79, 0xc4, 0x2d,
+	0x3e, 0x51, 0x3f, 0x56, 0x3f, 0xad, 0x2c, 0x9c, 0x6c, 0xd8, 0x8e, 0x13, 0x3b, 0x4e, 0x89, 0x8d,
+	0xe2, 0x23, 0xa1, 0xc4, 0x72, 0xb6, 0x6d, 0xa6, 0x2c, 0x87, 0xf1, 0x9d, 0x18, 0x08, 0xc1, 0x22,
+	0x11, 0x37, 0x72, 0xbd, 0x07, 0xe9, 0x68, 0x61, 0xa6, 0x35, 0x90, 0x86, 0x0e, 0xa3, 0x3a, 0x07,
+	0xed, 0xb2, 0x03, 0x9f, 0x71, 0xce, 0x25, 0xa6, 0xc2, 0x6f, 0xe1, 0x3f, 0x10, 0xfc, 0x37, 0x40,
+	0x31, 0xe2, 0x73, 0x8d, 0x5c, 0x80, 0x7e, 0x9a, 0x59, 0x98, 0x6c, 0x01, 0x81, 0x67, 0x60, 0x91,
+	0x66, 0xe0, 0x45, 0x3c, 0xdf, 0xd2, 0x35, 0xca, 0xb0, 0x9d, 0x86, 0xe1, 0x2f, 0x04, 0xff, 0xf1,
+	0x95, 0x78, 0xf8, 0x4c, 0x03, 0x9b, 0xcd, 0x47, 0x2b, 0x0b, 0x67, 0x9b, 0xb6, 0xe7, 0x7c, 0x15,
+	0xca, 0x37, 0x83, 0x6f, 0xb4, 0xb2, 0x53, 0x3d, 0x82, 0xd7, 0xf7, 0x12, 0xfa, 0xc5, 0x9d, 0x01,
+	0xb7, 0xcc, 0x69, 0x28, 0x03, 0x3e, 0x2a, 0x57, 0x38, 0xdb, 0xb4, 0x3d, 0xcf, 0xc0, 0x02, 0xcd,
+	0xc0, 0x3c, 0xbe, 0xd0, 0x4a, 0x06, 0x24, 0xcb, 0x24, 0x99, 0x9c, 0xcd, 0xe9, 0x2b, 0x04, 0xbd,
+	0x35, 0x5a, 0x2e, 0x4a, 0x8b, 0x11, 0x24, 0x34, 0x85, 0xf1, 0xa6, 0x6c, 0x39, 0xc3, 0x53, 0x94,
+	0xe1, 0x18, 0x3e, 0x1a, 0xca, 0xd0, 0x43, 0x21, 0x43, 0x75, 0x27, 0x7e, 0x86, 0xa0, 0xcf, 0x4f,
+	0xfe, 0xe1, 0x89, 0x68, 0x07, 0x68, 0x80, 0x56, 0x15, 0xce, 0x34, 0x6b, 0xce, 0x19, 0x5d, 0xa7,
+	0x8c, 0x16, 0xf1, 0xc2, 0xe6, 0x34, 0xbb, 0x99, 0x8a, 0xe6, 0x9c, 0x9a, 0x7f, 0xb8, 0x91, 0x40,
+	0x8f, 0x36, 0x12, 0xe8, 0x87, 0x8d, 0x04, 0xba, 0xf7, 0x24, 0xd1, 0xf1, 0xe8, 0x49, 0xa2, 0xe3,
+	0xdb, 0x27, 0x89, 0x8e, 0x57, 0x8e, 0x85, 0xbe, 0x86, 0xbe, 0xe9, 0x8d, 0x81, 0x3e, 0x8e, 0x66,
+	0xbb, 0xe8, 0xff, 0x30, 0x38, 0xfe, 0xf7, 0x00, 0xeb, 0xc0, 0x2f, 0x2e, 0x87, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1420,6 +1731,7 @@ type QueryClient interface {
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
+	//
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// ValidatorHistoricalRewards queries historical rewards for a validator at a specific period.
 	ValidatorHistoricalRewards(ctx context.Context, in *QueryValidatorHistoricalRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorHistoricalRewardsResponse, error)
@@ -1427,6 +1739,14 @@ type QueryClient interface {
 	ValidatorCurrentRewards(ctx context.Context, in *QueryValidatorCurrentRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorCurrentRewardsResponse, error)
 	// DelegatorStartingInfo queries the starting info for a delegator.
 	DelegatorStartingInfo(ctx context.Context, in *QueryDelegatorStartingInfoRequest, opts ...grpc.CallOption) (*QueryDelegatorStartingInfoResponse, error)
+	// DelegatorAutoCompound queries the validators to which the delegations of a
+	// delegator are restaked automatically.
+	DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error)
+	// AutoCompoundRound queries the auto-compound round in progress.
+	AutoCompoundRound(ctx context.Context, in *QueryAutoCompoundRoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundRoundResponse, error)
+	// CommissionRecipients queries the addresses the commission of a validator
+	// is split among.
+	CommissionRecipients(ctx context.Context, in *QueryCommissionRecipientsRequest, opts ...grpc.CallOption) (*QueryCommissionRecipientsResponse, error)
 }
 
 type queryClient struct {
@@ -1554,6 +1874,33 @@ func (c *queryClient) DelegatorStartingInfo(ctx context.Context, in *QueryDelega
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error) {
+	out := new(QueryDelegatorAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoCompoundRound(ctx context.Context, in *QueryAutoCompoundRoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundRoundResponse, error) {
+	out := new(QueryAutoCompoundRoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoCompoundRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommissionRecipients(ctx context.Context, in *QueryCommissionRecipientsRequest, opts ...grpc.CallOption) (*QueryCommissionRecipientsResponse, error) {
+	out := new(QueryCommissionRecipientsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/CommissionRecipients", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1576,6 +1923,7 @@ type QueryServer interface {
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
+	//
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// ValidatorHistoricalRewards queries historical rewards for a validator at a specific period.
 	ValidatorHistoricalRewards(context.Context, *QueryValidatorHistoricalRewardsRequest) (*QueryValidatorHistoricalRewardsResponse, error)
@@ -1583,6 +1931,14 @@ type QueryServer interface {
 	ValidatorCurrentRewards(context.Context, *QueryValidatorCurrentRewardsRequest) (*QueryValidatorCurrentRewardsResponse, error)
 	// DelegatorStartingInfo queries the starting info for a delegator.
 	DelegatorStartingInfo(context.Context, *QueryDelegatorStartingInfoRequest) (*QueryDelegatorStartingInfoResponse, error)
+	// DelegatorAutoCompound queries the validators to which the delegations of a
+	// delegator are restaked automatically.
+	DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error)
+	// AutoCompoundRound queries the auto-compound round in progress.
+	AutoCompoundRound(context.Context, *QueryAutoCompoundRoundRequest) (*QueryAutoCompoundRoundResponse, error)
+	// CommissionRecipients queries the addresses the commission of a validator
+	// is split among.
+	CommissionRecipients(context.Context, *QueryCommissionRecipientsRequest) (*QueryCommissionRecipientsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1628,6 +1984,15 @@ func (*UnimplementedQueryServer) ValidatorCurrentRewards(ctx context.Context, re
 func (*UnimplementedQueryServer) DelegatorStartingInfo(ctx context.Context, req *QueryDelegatorStartingInfoRequest) (*QueryDelegatorStartingInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorStartingInfo not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorAutoCompound(ctx context.Context, req *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompound not implemented")
+}
+func (*UnimplementedQueryServer) AutoCompoundRound(ctx context.Context, req *QueryAutoCompoundRoundRequest) (*QueryAutoCompoundRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundRound not implemented")
+}
+func (*UnimplementedQueryServer) CommissionRecipients(ctx context.Context, req *QueryCommissionRecipientsRequest) (*QueryCommissionRecipientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommissionRecipients not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1867,6 +2232,60 @@ func _Query_DelegatorStartingInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, req.(*QueryDelegatorAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoCompoundRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoCompoundRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundRound(ctx, req.(*QueryAutoCompoundRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommissionRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommissionRecipientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommissionRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/CommissionRecipients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommissionRecipients(ctx, req.(*QueryCommissionRecipientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
@@ -1924,6 +2343,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorStartingInfo",
 			Handler:    _Query_DelegatorStartingInfo_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompound",
+			Handler:    _Query_DelegatorAutoCompound_Handler,
+		},
+		{
+			MethodName: "AutoCompoundRound",
+			Handler:    _Query_AutoCompoundRound_Handler,
+		},
+		{
+			MethodName: "CommissionRecipients",
+			Handler:    _Query_CommissionRecipients_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2838,19 +3269,230 @@ func (m *QueryDelegatorStartingInfoResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDelegatorAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundRoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundRoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundRoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundRoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundRoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundRoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Round != nil {
+		{
+			size, err := m.Round.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommissionRecipientsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommissionRecipientsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommissionRecipientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommissionRecipientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommissionRecipientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommissionRecipientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -3220,64 +3862,150 @@ func (m *QueryDelegatorStartingInfoResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
+func (m *QueryDelegatorAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundRoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAutoCompoundRoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != nil {
+		l = m.Round.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommissionRecipientsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommissionRecipientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -3500,13 +4228,512 @@ func (m *QueryValidatorDistributionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SelfBondRewards", wireType)
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfBondRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelfBondRewards = append(m.SelfBondRewards, types.DecCoin{})
+			if err := m.SelfBondRewards[len(m.SelfBondRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = append(m.Commission, types.DecCoin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorCommissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
+			}
+			m.StartingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
 			}
-			var msglen int
+			m.EndingHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3516,29 +4743,14 @@ func (m *QueryValidatorDistributionInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EndingHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SelfBondRewards = append(m.SelfBondRewards, types.DecCoin{})
-			if err := m.SelfBondRewards[len(m.SelfBondRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3565,8 +4777,10 @@ func (m *QueryValidatorDistributionInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = append(m.Commission, types.DecCoin{})
-			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3591,7 +4805,7 @@ func (m *QueryValidatorDistributionInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3614,17 +4828,17 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3634,77 +4848,29 @@ func (m *QueryValidatorOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3731,7 +4897,10 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3756,7 +4925,7 @@ func (m *QueryValidatorOutstandingRewardsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3779,13 +4948,45 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCommissionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -3838,7 +5039,7 @@ func (m *QueryValidatorCommissionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3861,15 +5062,15 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCommissionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3896,7 +5097,8 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3921,7 +5123,7 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3944,15 +5146,15 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3980,81 +5182,7 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingHeight", wireType)
-			}
-			m.StartingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndingHeight", wireType)
-			}
-			m.EndingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndingHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4077,7 +5205,7 @@ func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4100,15 +5228,15 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4135,14 +5263,14 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Slashes = append(m.Slashes, ValidatorSlashEvent{})
-			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, DelegationDelegatorReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4169,10 +5297,8 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Total = append(m.Total, types.DecCoin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4197,7 +5323,7 @@ func (m *QueryValidatorSlashesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4220,10 +5346,10 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4258,9 +5384,59 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4288,7 +5464,7 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4311,7 +5487,7 @@ func (m *QueryDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4334,17 +5510,17 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4354,25 +5530,23 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4395,7 +5569,7 @@ func (m *QueryDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4418,15 +5592,15 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4454,7 +5628,7 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4477,7 +5651,7 @@ func (m *QueryDelegationTotalRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4500,49 +5674,65 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommunityPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegationTotalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommunityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, DelegationDelegatorReward{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommunityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4569,8 +5759,8 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.DecCoin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pool = append(m.Pool, types.DecCoin{})
+			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4595,7 +5785,7 @@ func (m *QueryDelegationTotalRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorHistoricalRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4618,15 +5808,15 @@ func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4654,8 +5844,27 @@ func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4677,7 +5886,7 @@ func (m *QueryDelegatorValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorHistoricalRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4700,17 +5909,17 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4720,23 +5929,24 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4759,7 +5969,7 @@ func (m *QueryDelegatorValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorCurrentRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4782,15 +5992,15 @@ func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorCurrentRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorCurrentRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4818,7 +6028,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4841,7 +6051,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorCurrentRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4864,17 +6074,17 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorCurrentRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorCurrentRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4884,23 +6094,24 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4923,7 +6134,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorStartingInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4946,12 +6157,76 @@ func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommunityPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorStartingInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommunityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorStartingInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4973,7 +6248,7 @@ func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorStartingInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4996,15 +6271,15 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCommunityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorStartingInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorStartingInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5031,8 +6306,7 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pool = append(m.Pool, types.DecCoin{})
-			if err := m.Pool[len(m.Pool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5057,7 +6331,7 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorHistoricalRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorAutoCompoundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5080,15 +6354,15 @@ func (m *QueryValidatorHistoricalRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5116,13 +6390,13 @@ func (m *QueryValidatorHistoricalRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Period = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5132,11 +6406,28 @@ func (m *QueryValidatorHistoricalRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5158,7 +6449,7 @@ func (m *QueryValidatorHistoricalRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorHistoricalRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorAutoCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5181,15 +6472,47 @@ func (m *QueryValidatorHistoricalRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorHistoricalRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5216,7 +6539,10 @@ func (m *QueryValidatorHistoricalRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5241,7 +6567,7 @@ func (m *QueryValidatorHistoricalRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorCurrentRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoCompoundRoundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5264,44 +6590,12 @@ func (m *QueryValidatorCurrentRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCurrentRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoCompoundRoundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCurrentRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoCompoundRoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5323,7 +6617,7 @@ func (m *QueryValidatorCurrentRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorCurrentRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoCompoundRoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5346,15 +6640,15 @@ func (m *QueryValidatorCurrentRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorCurrentRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoCompoundRoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorCurrentRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoCompoundRoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5381,7 +6675,10 @@ func (m *QueryValidatorCurrentRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Round == nil {
+				m.Round = &AutoCompoundRound{}
+			}
+			if err := m.Round.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5406,7 +6703,7 @@ func (m *QueryValidatorCurrentRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorStartingInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCommissionRecipientsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5429,45 +6726,13 @@ func (m *QueryDelegatorStartingInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorStartingInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommissionRecipientsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorStartingInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommissionRecipientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -5520,7 +6785,7 @@ func (m *QueryDelegatorStartingInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegatorStartingInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCommissionRecipientsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5543,15 +6808,15 @@ func (m *QueryDelegatorStartingInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegatorStartingInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommissionRecipientsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegatorStartingInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommissionRecipientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5578,7 +6843,8 @@ func (m *QueryDelegatorStartingInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipients = append(m.Recipients, CommissionRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,11 +1,17 @@
 package types
 
 import (
+	"fmt"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxCommissionRecipients is the maximum number of recipients the commission
+// of a validator can be split among.
+const MaxCommissionRecipients = 10
+
 // NewValidatorHistoricalRewards creates a new ValidatorHistoricalRewards
 func NewValidatorHistoricalRewards(cumulativeRewardRatio sdk.DecCoins, referenceCount uint32) ValidatorHistoricalRewards {
 	return ValidatorHistoricalRewards{
@@ -34,3 +40,38 @@ func NewValidatorSlashEvent(validatorPeriod uint64, fraction sdkmath.LegacyDec)
 		Fraction:        fraction,
 	}
 }
+
+// ValidateCommissionRecipients validates a list of commission recipients: it
+// must hold between one and MaxCommissionRecipients distinct addresses with
+// positive weights summing up to one.
+func ValidateCommissionRecipients(recipients []CommissionRecipient) error {
+	if len(recipients) == 0 {
+		return fmt.Errorf("commission recipients cannot be empty")
+	}
+	if len(recipients) > MaxCommissionRecipients {
+		return fmt.Errorf("too many commission recipients: %d > %d", len(recipients), MaxCommissionRecipients)
+	}
+
+	seen := make(map[string]bool, len(recipients))
+	total := sdkmath.LegacyZeroDec()
+	for _, r := range recipients {
+		if r.Address == "" {
+			return fmt.Errorf("commission recipient address cannot be empty")
+		}
+		if seen[r.Address] {
+			return fmt.Errorf("duplicate commission recipient %s", r.Address)
+		}
+		seen[r.Address] = true
+
+		if r.Weight.IsNil() || !r.Weight.IsPositive() {
+			return fmt.Errorf("commission recipient %s weight must be positive: %s", r.Address, r.Weight)
+		}
+		total = total.Add(r.Weight)
+	}
+
+	if !total.Equal(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("commission recipient weights must sum up to one: %s", total)
+	}
+
+	return nil
+}
@@ -24,6 +24,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "identifier"},
 					},
 				},
+				{
+					RpcMethod: "UpcomingEpochBoundaries",
+					Use:       "upcoming-epoch-boundaries [identifier]",
+					Short:     "Query the next epoch starts of specified identifier, with their time and estimated height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "identifier"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: epochsv1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "AddEpochInfo",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveEpochInfo",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "UpdateEpochDuration",
+					Skip:      true, // skipped because authority gated
+				},
 			},
 		},
 	}
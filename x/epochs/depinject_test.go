@@ -18,10 +18,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/runtime"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	"github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/epochs"
 	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
 	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var _ types.EpochHooks = testEpochHooks{}
@@ -49,7 +51,7 @@ func TestInvokeSetHooks(t *testing.T) {
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	storeService := runtime.NewKVStoreService(key)
 	encCfg := testutil.MakeTestEncodingConfig()
-	mockKeeper := keeper.NewKeeper(storeService, encCfg.Codec)
+	mockKeeper := keeper.NewKeeper(storeService, encCfg.Codec, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	// Create mock hooks
 	hook1 := types.EpochHooksWrapper{
@@ -58,6 +58,11 @@ func (k *Keeper) BeginBlocker(ctx sdk.Context) error {
 
 				epochInfo.CurrentEpoch += 1
 				epochInfo.CurrentEpochStartTime = epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+				// a duration updated during the previous epoch applies from this epoch
+				if epochInfo.PendingDuration != nil {
+					epochInfo.Duration = *epochInfo.PendingDuration
+					epochInfo.PendingDuration = nil
+				}
 				ctx.Logger().Debug(fmt.Sprintf("Starting epoch with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
 			}
 
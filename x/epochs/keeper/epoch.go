@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/epochs/types"
@@ -39,6 +41,44 @@ func (k *Keeper) AddEpochInfo(ctx sdk.Context, epoch types.EpochInfo) error {
 	return k.EpochInfo.Set(ctx, epoch.Identifier, epoch)
 }
 
+// RemoveEpochInfo removes an epoch info. Will return an error if the identifier does not exist.
+func (k *Keeper) RemoveEpochInfo(ctx sdk.Context, identifier string) error {
+	isExist, err := k.EpochInfo.Has(ctx, identifier)
+	if err != nil {
+		return err
+	}
+	if !isExist {
+		return fmt.Errorf("epoch with identifier %s not found", identifier)
+	}
+	return k.EpochInfo.Remove(ctx, identifier)
+}
+
+// UpdateEpochDuration changes the duration of an epoch and returns the first epoch number using it.
+// The duration of an epoch whose counting has not started changes immediately, while a running
+// epoch keeps its duration until its next epoch start, so that the current epoch is not cut short
+// or extended.
+func (k *Keeper) UpdateEpochDuration(ctx sdk.Context, identifier string, duration time.Duration) (int64, error) {
+	if duration <= 0 {
+		return 0, errors.New("epoch duration must be positive")
+	}
+	epoch, err := k.EpochInfo.Get(ctx, identifier)
+	if err != nil {
+		return 0, fmt.Errorf("epoch with identifier %s not found", identifier)
+	}
+
+	effectiveEpoch := epoch.CurrentEpoch + 1
+	switch {
+	case !epoch.EpochCountingStarted:
+		epoch.Duration = duration
+		epoch.PendingDuration = nil
+	case duration == epoch.Duration:
+		epoch.PendingDuration = nil
+	default:
+		epoch.PendingDuration = &duration
+	}
+	return effectiveEpoch, k.EpochInfo.Set(ctx, identifier, epoch)
+}
+
 // AllEpochInfos iterate through epochs to return all epochs info.
 func (k *Keeper) AllEpochInfos(ctx sdk.Context) ([]types.EpochInfo, error) {
 	var epochs []types.EpochInfo
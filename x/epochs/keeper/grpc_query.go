@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,6 +14,14 @@ import (
 
 var _ types.QueryServer = Querier{}
 
+const (
+	// defaultUpcomingBoundariesLimit is the number of boundaries returned by UpcomingEpochBoundaries
+	// when the request does not set a limit.
+	defaultUpcomingBoundariesLimit = 10
+	// maxUpcomingBoundariesLimit is the maximum number of boundaries returned by UpcomingEpochBoundaries.
+	maxUpcomingBoundariesLimit = 100
+)
+
 // Querier defines a wrapper around the x/epochs keeper providing gRPC method
 // handlers.
 type Querier struct {
@@ -52,3 +61,75 @@ func (q Querier) CurrentEpoch(ctx context.Context, req *types.QueryCurrentEpochR
 		CurrentEpoch: info.CurrentEpoch,
 	}, nil
 }
+
+// UpcomingEpochBoundaries provides the next epoch starts of specified identifier.
+func (q Querier) UpcomingEpochBoundaries(ctx context.Context, req *types.QueryUpcomingEpochBoundariesRequest) (*types.QueryUpcomingEpochBoundariesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Identifier == "" {
+		return nil, status.Error(codes.InvalidArgument, "identifier is empty")
+	}
+
+	limit := req.Limit
+	switch {
+	case limit == 0:
+		limit = defaultUpcomingBoundariesLimit
+	case limit > maxUpcomingBoundariesLimit:
+		limit = maxUpcomingBoundariesLimit
+	}
+
+	info, err := q.EpochInfo.Get(ctx, req.Identifier)
+	if err != nil {
+		return nil, errors.New("identifier not available")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := averageBlockTime(info, sdkCtx.BlockTime(), sdkCtx.BlockHeight())
+	if req.BlockTime != nil {
+		if *req.BlockTime <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "block time must be positive")
+		}
+		blockTime = *req.BlockTime
+	}
+
+	return &types.QueryUpcomingEpochBoundariesResponse{
+		Boundaries: upcomingEpochBoundaries(info, sdkCtx.BlockTime(), sdkCtx.BlockHeight(), blockTime, limit),
+	}, nil
+}
+
+// averageBlockTime measures the average block time since the start of the current epoch.
+// It returns 0 if no block was produced since then.
+func averageBlockTime(epoch types.EpochInfo, blockTime time.Time, height int64) time.Duration {
+	blocks := height - epoch.CurrentEpochStartHeight
+	if !epoch.EpochCountingStarted || blocks <= 0 || !blockTime.After(epoch.CurrentEpochStartTime) {
+		return 0
+	}
+	return blockTime.Sub(epoch.CurrentEpochStartTime) / time.Duration(blocks)
+}
+
+// upcomingEpochBoundaries returns the next limit epoch starts of the epoch. The heights are
+// estimated from the given average block time, and left to 0 if it is unknown.
+func upcomingEpochBoundaries(epoch types.EpochInfo, blockTime time.Time, height int64, avgBlockTime time.Duration, limit uint32) []types.EpochBoundary {
+	next, number, duration := epoch.StartTime, int64(1), epoch.Duration
+	if epoch.EpochCountingStarted {
+		next, number = epoch.CurrentEpochStartTime.Add(epoch.Duration), epoch.CurrentEpoch+1
+		if epoch.PendingDuration != nil {
+			duration = *epoch.PendingDuration
+		}
+	}
+
+	boundaries := make([]types.EpochBoundary, 0, limit)
+	lastHeight := height
+	for range limit {
+		boundary := types.EpochBoundary{EpochNumber: number, Time: next}
+		if avgBlockTime > 0 {
+			// at most one epoch starts per block, so missed epochs start in consecutive blocks
+			boundary.EstimatedHeight = max(height+int64(next.Sub(blockTime)/avgBlockTime)+1, lastHeight+1)
+			lastHeight = boundary.EstimatedHeight
+		}
+		boundaries = append(boundaries, boundary)
+		next, number = next.Add(duration), number+1
+	}
+	return boundaries
+}
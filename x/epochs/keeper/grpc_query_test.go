@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/x/epochs/types"
 )
 
@@ -20,3 +22,58 @@ func (s *KeeperTestSuite) TestQueryEpochInfos() {
 
 	s.Require().Equal(expectedEpochs, epochInfosResponse.Epochs)
 }
+
+func (s *KeeperTestSuite) TestQueryUpcomingEpochBoundaries() {
+	s.SetupTest()
+	startTime := s.Ctx.BlockTime()
+
+	_, err := s.queryClient.UpcomingEpochBoundaries(s.Ctx, &types.QueryUpcomingEpochBoundariesRequest{})
+	s.Require().ErrorContains(err, "identifier is empty")
+
+	_, err = s.queryClient.UpcomingEpochBoundaries(s.Ctx, &types.QueryUpcomingEpochBoundariesRequest{Identifier: "month"})
+	s.Require().ErrorContains(err, "identifier not available")
+
+	// before the epoch starts, the first boundary is the start time and the block time is unknown
+	res, err := s.queryClient.UpcomingEpochBoundaries(s.Ctx, &types.QueryUpcomingEpochBoundariesRequest{Identifier: "hour", Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.EpochBoundary{
+		{EpochNumber: 1, Time: startTime},
+		{EpochNumber: 2, Time: startTime.Add(time.Hour)},
+	}, res.Boundaries)
+
+	// start the epochs, then produce 60 blocks of 5 seconds
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 60).WithBlockTime(startTime.Add(5 * time.Minute))
+	s.SetupQueryClient()
+
+	res, err = s.queryClient.UpcomingEpochBoundaries(s.Ctx, &types.QueryUpcomingEpochBoundariesRequest{Identifier: "hour", Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.EpochBoundary{
+		{EpochNumber: 2, Time: startTime.Add(time.Hour), EstimatedHeight: s.Ctx.BlockHeight() + 661},
+		{EpochNumber: 3, Time: startTime.Add(2 * time.Hour), EstimatedHeight: s.Ctx.BlockHeight() + 1381},
+	}, res.Boundaries)
+
+	// the block time can be overridden
+	blockTime := 10 * time.Second
+	res, err = s.queryClient.UpcomingEpochBoundaries(s.Ctx, &types.QueryUpcomingEpochBoundariesRequest{Identifier: "hour", Limit: 1, BlockTime: &blockTime})
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.BlockHeight()+331, res.Boundaries[0].EstimatedHeight)
+
+	// a pending duration applies after the next boundary
+	_, err = s.EpochsKeeper.UpdateEpochDuration(s.Ctx, "hour", 2*time.Hour)
+	s.Require().NoError(err)
+	res, err = s.queryClient.UpcomingEpochBoundaries(s.Ctx, &types.QueryUpcomingEpochBoundariesRequest{Identifier: "hour"})
+	s.Require().NoError(err)
+	s.Require().Len(res.Boundaries, 10)
+	s.Require().Equal(startTime.Add(time.Hour), res.Boundaries[0].Time)
+	s.Require().Equal(startTime.Add(3*time.Hour), res.Boundaries[1].Time)
+
+	// missed epochs start in consecutive blocks
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(3 * time.Hour))
+	s.SetupQueryClient()
+	res, err = s.queryClient.UpcomingEpochBoundaries(s.Ctx, &types.QueryUpcomingEpochBoundariesRequest{Identifier: "minute", Limit: 3, BlockTime: &blockTime})
+	s.Require().NoError(err)
+	for i, boundary := range res.Boundaries {
+		s.Require().Equal(s.Ctx.BlockHeight()+int64(i)+1, boundary.EstimatedHeight)
+	}
+}
@@ -14,8 +14,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
 	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type KeeperTestSuite struct {
@@ -30,6 +32,11 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	s.Ctx = ctx
 	s.EpochsKeeper = epochsKeeper
+	s.SetupQueryClient()
+}
+
+// SetupQueryClient sets up the query client at the current context of the suite.
+func (s *KeeperTestSuite) SetupQueryClient() {
 	queryRouter := baseapp.NewGRPCQueryRouter()
 	cfg := module.NewConfigurator(nil, nil, queryRouter)
 	types.RegisterQueryServer(cfg.QueryServer(), epochskeeper.NewQuerier(s.EpochsKeeper))
@@ -54,6 +61,7 @@ func Setup(t *testing.T) (sdk.Context, epochskeeper.Keeper) {
 	epochsKeeper := epochskeeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	epochsKeeper.SetHooks(types.NewMultiEpochHooks())
 	ctx = ctx.WithBlockTime(time.Now().UTC()).WithBlockHeight(1).WithChainID("epochs")
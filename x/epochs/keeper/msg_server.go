@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/epochs MsgServer interface.
+func NewMsgServerImpl(k *Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// AddEpochInfo adds a new epoch definition.
+func (ms msgServer) AddEpochInfo(goCtx context.Context, msg *types.MsgAddEpochInfo) (*types.MsgAddEpochInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, ms.authority, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if msg.Duration <= 0 {
+		return nil, errors.New("epoch duration must be positive")
+	}
+
+	// a start time in the past would tick all the missed epochs, one per block
+	if !msg.StartTime.IsZero() && msg.StartTime.Before(ctx.BlockTime()) {
+		return nil, fmt.Errorf("epoch start time %s is before the block time %s", msg.StartTime, ctx.BlockTime())
+	}
+
+	epoch := types.NewGenesisEpochInfo(msg.Identifier, msg.Duration)
+	epoch.StartTime = msg.StartTime
+	if err := ms.Keeper.AddEpochInfo(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEpochInfoAdded{Identifier: msg.Identifier}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddEpochInfoResponse{}, nil
+}
+
+// RemoveEpochInfo removes an epoch definition.
+func (ms msgServer) RemoveEpochInfo(goCtx context.Context, msg *types.MsgRemoveEpochInfo) (*types.MsgRemoveEpochInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, ms.authority, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.RemoveEpochInfo(ctx, msg.Identifier); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEpochInfoRemoved{Identifier: msg.Identifier}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveEpochInfoResponse{}, nil
+}
+
+// UpdateEpochDuration updates the duration of an epoch.
+func (ms msgServer) UpdateEpochDuration(goCtx context.Context, msg *types.MsgUpdateEpochDuration) (*types.MsgUpdateEpochDurationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, ms.authority, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	effectiveEpoch, err := ms.Keeper.UpdateEpochDuration(ctx, msg.Identifier, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEpochDurationUpdated{
+		Identifier:      msg.Identifier,
+		DurationSeconds: int64(msg.Duration.Seconds()),
+		EffectiveEpoch:  effectiveEpoch,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEpochDurationResponse{}, nil
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"time"
+
+	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+func (s *KeeperTestSuite) TestMsgAddEpochInfo() {
+	authority := s.EpochsKeeper.GetAuthority()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgAddEpochInfo
+		expErr string
+	}{
+		{
+			name:   "invalid authority",
+			msg:    &types.MsgAddEpochInfo{Authority: "invalid", Identifier: "month", Duration: time.Hour * 24 * 30},
+			expErr: "invalid authority",
+		},
+		{
+			name:   "zero duration",
+			msg:    &types.MsgAddEpochInfo{Authority: authority, Identifier: "month"},
+			expErr: "epoch duration must be positive",
+		},
+		{
+			name:   "start time in the past",
+			msg:    &types.MsgAddEpochInfo{Authority: authority, Identifier: "month", StartTime: s.Ctx.BlockTime().Add(-time.Second), Duration: time.Hour * 24 * 30},
+			expErr: "is before the block time",
+		},
+		{
+			name:   "empty identifier",
+			msg:    &types.MsgAddEpochInfo{Authority: authority, Duration: time.Hour * 24 * 30},
+			expErr: "epoch identifier should NOT be empty",
+		},
+		{
+			name:   "existing identifier",
+			msg:    &types.MsgAddEpochInfo{Authority: authority, Identifier: "day", Duration: time.Hour * 24 * 30},
+			expErr: "epoch with identifier day already exists",
+		},
+		{
+			name: "start time defaults to the block time",
+			msg:  &types.MsgAddEpochInfo{Authority: authority, Identifier: "month", Duration: time.Hour * 24 * 30},
+		},
+		{
+			name: "future start time",
+			msg:  &types.MsgAddEpochInfo{Authority: authority, Identifier: "year", StartTime: s.Ctx.BlockTime().Add(time.Hour), Duration: time.Hour * 24 * 365},
+		},
+	}
+
+	msgServer := epochskeeper.NewMsgServerImpl(&s.EpochsKeeper)
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.AddEpochInfo(s.Ctx, tc.msg)
+			if tc.expErr != "" {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			epoch, err := s.EpochsKeeper.GetEpochInfo(s.Ctx, tc.msg.Identifier)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.msg.Duration, epoch.Duration)
+			s.Require().False(epoch.EpochCountingStarted)
+			if tc.msg.StartTime.IsZero() {
+				s.Require().Equal(s.Ctx.BlockTime(), epoch.StartTime)
+			} else {
+				s.Require().Equal(tc.msg.StartTime, epoch.StartTime)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgRemoveEpochInfo() {
+	authority := s.EpochsKeeper.GetAuthority()
+	msgServer := epochskeeper.NewMsgServerImpl(&s.EpochsKeeper)
+
+	_, err := msgServer.RemoveEpochInfo(s.Ctx, &types.MsgRemoveEpochInfo{Authority: "invalid", Identifier: "day"})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	_, err = msgServer.RemoveEpochInfo(s.Ctx, &types.MsgRemoveEpochInfo{Authority: authority, Identifier: "month"})
+	s.Require().ErrorContains(err, "epoch with identifier month not found")
+
+	_, err = msgServer.RemoveEpochInfo(s.Ctx, &types.MsgRemoveEpochInfo{Authority: authority, Identifier: "day"})
+	s.Require().NoError(err)
+
+	_, err = s.EpochsKeeper.GetEpochInfo(s.Ctx, "day")
+	s.Require().Error(err)
+	epochs, err := s.EpochsKeeper.AllEpochInfos(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(epochs, 3)
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateEpochDuration() {
+	authority := s.EpochsKeeper.GetAuthority()
+	msgServer := epochskeeper.NewMsgServerImpl(&s.EpochsKeeper)
+	startTime := s.Ctx.BlockTime()
+
+	_, err := msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{Authority: "invalid", Identifier: "day", Duration: time.Hour})
+	s.Require().ErrorContains(err, "invalid authority")
+
+	_, err = msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{Authority: authority, Identifier: "month", Duration: time.Hour})
+	s.Require().ErrorContains(err, "epoch with identifier month not found")
+
+	_, err = msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{Authority: authority, Identifier: "day", Duration: -time.Hour})
+	s.Require().ErrorContains(err, "epoch duration must be positive")
+
+	// the duration of an epoch which has not started changes immediately
+	_, err = msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{Authority: authority, Identifier: "week", Duration: time.Hour * 24 * 14})
+	s.Require().NoError(err)
+	week, err := s.EpochsKeeper.GetEpochInfo(s.Ctx, "week")
+	s.Require().NoError(err)
+	s.Require().Equal(time.Hour*24*14, week.Duration)
+	s.Require().Nil(week.PendingDuration)
+
+	// start the day epoch
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(s.Ctx))
+	day, err := s.EpochsKeeper.GetEpochInfo(s.Ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), day.CurrentEpoch)
+
+	// the duration of a running epoch changes at its next epoch start
+	_, err = msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{Authority: authority, Identifier: "day", Duration: time.Hour * 48})
+	s.Require().NoError(err)
+	day, err = s.EpochsKeeper.GetEpochInfo(s.Ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(time.Hour*24, day.Duration)
+	s.Require().Equal(time.Hour*48, *day.PendingDuration)
+
+	// the current epoch keeps its length
+	ctx := s.Ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(time.Hour*24 + time.Second))
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(ctx))
+	day, err = s.EpochsKeeper.GetEpochInfo(ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(2), day.CurrentEpoch)
+	s.Require().Equal(startTime.Add(time.Hour*24), day.CurrentEpochStartTime)
+	s.Require().Equal(time.Hour*48, day.Duration)
+	s.Require().Nil(day.PendingDuration)
+
+	// the next epoch uses the new duration
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(startTime.Add(time.Hour*48 + time.Second))
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(ctx))
+	day, err = s.EpochsKeeper.GetEpochInfo(ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(2), day.CurrentEpoch)
+
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(startTime.Add(time.Hour*72 + time.Second))
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(ctx))
+	day, err = s.EpochsKeeper.GetEpochInfo(ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(3), day.CurrentEpoch)
+
+	// setting back the current duration cancels a pending change
+	_, err = msgServer.UpdateEpochDuration(ctx, &types.MsgUpdateEpochDuration{Authority: authority, Identifier: "day", Duration: time.Hour})
+	s.Require().NoError(err)
+	_, err = msgServer.UpdateEpochDuration(ctx, &types.MsgUpdateEpochDuration{Authority: authority, Identifier: "day", Duration: time.Hour * 48})
+	s.Require().NoError(err)
+	day, err = s.EpochsKeeper.GetEpochInfo(ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Nil(day.PendingDuration)
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgAddEpochInfo{}, "cosmos-sdk/epochs/MsgAddEpochInfo")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveEpochInfo{}, "cosmos-sdk/epochs/MsgRemoveEpochInfo")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateEpochDuration{}, "cosmos-sdk/epochs/MsgUpdateDuration")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgAddEpochInfo{},
+		&MsgRemoveEpochInfo{},
+		&MsgUpdateEpochDuration{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -120,9 +120,168 @@ func (m *EventEpochStart) GetEpochStartTime() int64 {
 	return 0
 }
 
+// EventEpochInfoAdded is an event emitted when an epoch is added with
+// Msg/AddEpochInfo.
+type EventEpochInfoAdded struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *EventEpochInfoAdded) Reset()         { *m = EventEpochInfoAdded{} }
+func (m *EventEpochInfoAdded) String() string { return proto.CompactTextString(m) }
+func (*EventEpochInfoAdded) ProtoMessage()    {}
+func (*EventEpochInfoAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691f9b4b0a500cb4, []int{2}
+}
+func (m *EventEpochInfoAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochInfoAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochInfoAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochInfoAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochInfoAdded.Merge(m, src)
+}
+func (m *EventEpochInfoAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochInfoAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochInfoAdded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochInfoAdded proto.InternalMessageInfo
+
+func (m *EventEpochInfoAdded) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+// EventEpochInfoRemoved is an event emitted when an epoch is removed with
+// Msg/RemoveEpochInfo.
+type EventEpochInfoRemoved struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (m *EventEpochInfoRemoved) Reset()         { *m = EventEpochInfoRemoved{} }
+func (m *EventEpochInfoRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventEpochInfoRemoved) ProtoMessage()    {}
+func (*EventEpochInfoRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691f9b4b0a500cb4, []int{3}
+}
+func (m *EventEpochInfoRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochInfoRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochInfoRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochInfoRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochInfoRemoved.Merge(m, src)
+}
+func (m *EventEpochInfoRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochInfoRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochInfoRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochInfoRemoved proto.InternalMessageInfo
+
+func (m *EventEpochInfoRemoved) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+// EventEpochDurationUpdated is an event emitted when the duration of an epoch
+// is updated with Msg/UpdateEpochDuration.
+type EventEpochDurationUpdated struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// duration_seconds is the new duration in seconds.
+	DurationSeconds int64 `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// effective_epoch is the first epoch number using the new duration.
+	EffectiveEpoch int64 `protobuf:"varint,3,opt,name=effective_epoch,json=effectiveEpoch,proto3" json:"effective_epoch,omitempty"`
+}
+
+func (m *EventEpochDurationUpdated) Reset()         { *m = EventEpochDurationUpdated{} }
+func (m *EventEpochDurationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventEpochDurationUpdated) ProtoMessage()    {}
+func (*EventEpochDurationUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691f9b4b0a500cb4, []int{4}
+}
+func (m *EventEpochDurationUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochDurationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochDurationUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochDurationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochDurationUpdated.Merge(m, src)
+}
+func (m *EventEpochDurationUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochDurationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochDurationUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochDurationUpdated proto.InternalMessageInfo
+
+func (m *EventEpochDurationUpdated) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EventEpochDurationUpdated) GetDurationSeconds() int64 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
+}
+
+func (m *EventEpochDurationUpdated) GetEffectiveEpoch() int64 {
+	if m != nil {
+		return m.EffectiveEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventEpochEnd)(nil), "cosmos.epochs.v1beta1.EventEpochEnd")
 	proto.RegisterType((*EventEpochStart)(nil), "cosmos.epochs.v1beta1.EventEpochStart")
+	proto.RegisterType((*EventEpochInfoAdded)(nil), "cosmos.epochs.v1beta1.EventEpochInfoAdded")
+	proto.RegisterType((*EventEpochInfoRemoved)(nil), "cosmos.epochs.v1beta1.EventEpochInfoRemoved")
+	proto.RegisterType((*EventEpochDurationUpdated)(nil), "cosmos.epochs.v1beta1.EventEpochDurationUpdated")
 }
 
 func init() {
@@ -130,21 +289,27 @@ func init() {
 }
 
 var fileDescriptor_691f9b4b0a500cb4 = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0x28, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x85, 0xa8, 0xd1, 0x83, 0xa8, 0xd1, 0x83, 0xaa, 0x51, 0x32, 0xe2, 0xe2, 0x75, 0x05, 0x29, 0x73,
-	0x05, 0x09, 0xbb, 0xe6, 0xa5, 0x08, 0x29, 0x72, 0xf1, 0x80, 0x95, 0xc4, 0xe7, 0x95, 0xe6, 0x26,
-	0xa5, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x30, 0x07, 0x71, 0x83, 0xc5, 0xfc, 0xc0, 0x42, 0x4a,
-	0x71, 0x5c, 0xfc, 0x08, 0x3d, 0xc1, 0x25, 0x89, 0x45, 0x25, 0x44, 0xe8, 0x12, 0xd2, 0xe0, 0x12,
-	0x80, 0x28, 0x29, 0x06, 0xe9, 0x88, 0x2f, 0xc9, 0xcc, 0x4d, 0x95, 0x60, 0x02, 0x2b, 0xe3, 0x4b,
-	0x85, 0x1b, 0x14, 0x92, 0x99, 0x9b, 0xea, 0xe4, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0xda, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50,
-	0x3f, 0x43, 0x28, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x0a, 0x58, 0x00, 0x94, 0x54, 0x16, 0xa4, 0x16,
-	0x27, 0xb1, 0x81, 0x3d, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x2e, 0xe8, 0x93, 0x1e,
-	0x01, 0x00, 0x00,
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3f, 0x4b, 0xc3, 0x40,
+	0x14, 0xc0, 0x1b, 0x0b, 0x82, 0xcf, 0x3f, 0x2d, 0x91, 0x42, 0x5d, 0x0e, 0xcd, 0x62, 0x45, 0x4c,
+	0xa8, 0x22, 0xce, 0x8a, 0x19, 0x5c, 0x1c, 0x5a, 0x5d, 0x1c, 0x0c, 0x49, 0xee, 0xc5, 0x1e, 0x92,
+	0xbb, 0x90, 0x7b, 0x0d, 0xfa, 0x29, 0xf4, 0x63, 0x39, 0x76, 0x74, 0x94, 0xf6, 0x8b, 0x48, 0x2f,
+	0x57, 0xab, 0x93, 0x9d, 0x02, 0x3f, 0x7e, 0xbf, 0x4b, 0x5e, 0xde, 0x81, 0x97, 0x2a, 0x9d, 0x2b,
+	0x1d, 0x60, 0xa1, 0xd2, 0x91, 0x0e, 0xaa, 0x7e, 0x82, 0x14, 0xf7, 0x03, 0xac, 0x50, 0x92, 0xf6,
+	0x8b, 0x52, 0x91, 0x72, 0x3b, 0xb5, 0xe3, 0xd7, 0x8e, 0x6f, 0x1d, 0xef, 0x14, 0xb6, 0xc3, 0xb9,
+	0x16, 0xce, 0x71, 0x28, 0xb9, 0x7b, 0x00, 0x5b, 0x46, 0x89, 0xe4, 0x38, 0x4f, 0xb0, 0xec, 0x3a,
+	0xfb, 0x4e, 0xaf, 0x39, 0xd8, 0x34, 0xec, 0xd6, 0x20, 0xef, 0x11, 0x5a, 0xcb, 0x66, 0x48, 0x71,
+	0x49, 0x2b, 0x54, 0x6e, 0x0f, 0xda, 0xb5, 0xa2, 0xe7, 0x45, 0x44, 0x22, 0xc7, 0xee, 0x9a, 0xd1,
+	0x76, 0xf0, 0xe7, 0xa0, 0x3b, 0x91, 0xa3, 0x77, 0x0e, 0xbb, 0xcb, 0xf3, 0x6f, 0x64, 0xa6, 0x2e,
+	0x39, 0x47, 0xee, 0x32, 0x00, 0xc1, 0x51, 0x92, 0xc8, 0x84, 0x7d, 0xc3, 0xc6, 0xe0, 0x17, 0xf1,
+	0x2e, 0xa0, 0xf3, 0x37, 0x1b, 0x60, 0xae, 0xaa, 0x15, 0xc2, 0x37, 0x07, 0xf6, 0x96, 0xe5, 0xf5,
+	0xb8, 0x8c, 0x49, 0x28, 0x79, 0x5f, 0xf0, 0x98, 0xfe, 0xaf, 0xdd, 0x23, 0x68, 0x73, 0x9b, 0x44,
+	0x1a, 0x53, 0x25, 0xb9, 0xb6, 0x73, 0xb5, 0x16, 0x7c, 0x58, 0x63, 0xf7, 0x10, 0x5a, 0x98, 0x65,
+	0x98, 0x92, 0xa8, 0x30, 0x32, 0x43, 0x77, 0x9b, 0xf6, 0x0f, 0x2c, 0xb0, 0xf9, 0x84, 0xab, 0xf0,
+	0x63, 0xca, 0x9c, 0xc9, 0x94, 0x39, 0x5f, 0x53, 0xe6, 0xbc, 0xcf, 0x58, 0x63, 0x32, 0x63, 0x8d,
+	0xcf, 0x19, 0x6b, 0x3c, 0x1c, 0x3f, 0x09, 0x1a, 0x8d, 0x13, 0x3f, 0x55, 0x79, 0x60, 0xb7, 0x5e,
+	0x3f, 0x4e, 0x34, 0x7f, 0x0e, 0x5e, 0x16, 0x57, 0x80, 0x5e, 0x0b, 0xd4, 0xc9, 0xba, 0x59, 0xfd,
+	0xd9, 0xf7, 0x00, 0x10, 0x84, 0x55, 0xda, 0x20, 0x02, 0x00, 0x00,
 }
 
 func (m *EventEpochEnd) Marshal() (dAtA []byte, err error) {
@@ -208,6 +373,106 @@ func (m *EventEpochStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventEpochInfoAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochInfoAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochInfoAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEpochInfoRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochInfoRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochInfoRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEpochDurationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochDurationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochDurationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EffectiveEpoch != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EffectiveEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DurationSeconds != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DurationSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -246,6 +511,51 @@ func (m *EventEpochStart) Size() (n int) {
 	return n
 }
 
+func (m *EventEpochInfoAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventEpochInfoRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventEpochDurationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.DurationSeconds != 0 {
+		n += 1 + sovEvents(uint64(m.DurationSeconds))
+	}
+	if m.EffectiveEpoch != 0 {
+		n += 1 + sovEvents(uint64(m.EffectiveEpoch))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -409,6 +719,290 @@ func (m *EventEpochStart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventEpochInfoAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochInfoAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochInfoAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEpochInfoRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochInfoRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochInfoRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEpochDurationUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochDurationUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochDurationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
+			}
+			m.DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveEpoch", wireType)
+			}
+			m.EffectiveEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
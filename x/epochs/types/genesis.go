@@ -44,6 +44,9 @@ func (epoch EpochInfo) Validate() error {
 	if epoch.Duration == 0 {
 		return errors.New("epoch duration should NOT be 0")
 	}
+	if epoch.PendingDuration != nil && *epoch.PendingDuration <= 0 {
+		return errors.New("epoch PendingDuration must be positive")
+	}
 	if epoch.CurrentEpoch < 0 {
 		return errors.New("epoch CurrentEpoch must be non-negative")
 	}
@@ -10,19 +10,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -206,43 +211,239 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
+// QueryUpcomingEpochBoundariesRequest defines the gRPC request structure for
+// querying the next epoch starts of an epoch by its identifier.
+type QueryUpcomingEpochBoundariesRequest struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// limit is the number of boundaries to return, it defaults to 10 and is
+	// capped at 100.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// block_time overrides the average block time used to estimate the heights
+	// of the boundaries. By default, it is measured over the current epoch.
+	BlockTime *time.Duration `protobuf:"bytes,3,opt,name=block_time,json=blockTime,proto3,stdduration" json:"block_time,omitempty"`
+}
+
+func (m *QueryUpcomingEpochBoundariesRequest) Reset()         { *m = QueryUpcomingEpochBoundariesRequest{} }
+func (m *QueryUpcomingEpochBoundariesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingEpochBoundariesRequest) ProtoMessage()    {}
+func (*QueryUpcomingEpochBoundariesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dacbc976c75f2414, []int{4}
+}
+func (m *QueryUpcomingEpochBoundariesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingEpochBoundariesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingEpochBoundariesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpcomingEpochBoundariesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingEpochBoundariesRequest.Merge(m, src)
+}
+func (m *QueryUpcomingEpochBoundariesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingEpochBoundariesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingEpochBoundariesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpcomingEpochBoundariesRequest proto.InternalMessageInfo
+
+func (m *QueryUpcomingEpochBoundariesRequest) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *QueryUpcomingEpochBoundariesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *QueryUpcomingEpochBoundariesRequest) GetBlockTime() *time.Duration {
+	if m != nil {
+		return m.BlockTime
+	}
+	return nil
+}
+
+// QueryUpcomingEpochBoundariesResponse defines the gRPC response structure for
+// querying the next epoch starts of an epoch by its identifier.
+type QueryUpcomingEpochBoundariesResponse struct {
+	Boundaries []EpochBoundary `protobuf:"bytes,1,rep,name=boundaries,proto3" json:"boundaries"`
+}
+
+func (m *QueryUpcomingEpochBoundariesResponse) Reset()         { *m = QueryUpcomingEpochBoundariesResponse{} }
+func (m *QueryUpcomingEpochBoundariesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingEpochBoundariesResponse) ProtoMessage()    {}
+func (*QueryUpcomingEpochBoundariesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dacbc976c75f2414, []int{5}
+}
+func (m *QueryUpcomingEpochBoundariesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingEpochBoundariesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingEpochBoundariesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpcomingEpochBoundariesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingEpochBoundariesResponse.Merge(m, src)
+}
+func (m *QueryUpcomingEpochBoundariesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingEpochBoundariesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingEpochBoundariesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpcomingEpochBoundariesResponse proto.InternalMessageInfo
+
+func (m *QueryUpcomingEpochBoundariesResponse) GetBoundaries() []EpochBoundary {
+	if m != nil {
+		return m.Boundaries
+	}
+	return nil
+}
+
+// EpochBoundary describes an upcoming epoch start.
+type EpochBoundary struct {
+	// epoch_number is the number of the epoch starting at the boundary.
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// time is the time the block starting the epoch must exceed. The epoch
+	// starts at the first block whose time is after it.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// estimated_height is the estimated height of the block starting the epoch,
+	// or 0 if the block time is unknown.
+	EstimatedHeight int64 `protobuf:"varint,3,opt,name=estimated_height,json=estimatedHeight,proto3" json:"estimated_height,omitempty"`
+}
+
+func (m *EpochBoundary) Reset()         { *m = EpochBoundary{} }
+func (m *EpochBoundary) String() string { return proto.CompactTextString(m) }
+func (*EpochBoundary) ProtoMessage()    {}
+func (*EpochBoundary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dacbc976c75f2414, []int{6}
+}
+func (m *EpochBoundary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochBoundary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochBoundary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochBoundary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochBoundary.Merge(m, src)
+}
+func (m *EpochBoundary) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochBoundary) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochBoundary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochBoundary proto.InternalMessageInfo
+
+func (m *EpochBoundary) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochBoundary) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *EpochBoundary) GetEstimatedHeight() int64 {
+	if m != nil {
+		return m.EstimatedHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochInfosRequest)(nil), "cosmos.epochs.v1beta1.QueryEpochInfosRequest")
 	proto.RegisterType((*QueryEpochInfosResponse)(nil), "cosmos.epochs.v1beta1.QueryEpochInfosResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "cosmos.epochs.v1beta1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "cosmos.epochs.v1beta1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryUpcomingEpochBoundariesRequest)(nil), "cosmos.epochs.v1beta1.QueryUpcomingEpochBoundariesRequest")
+	proto.RegisterType((*QueryUpcomingEpochBoundariesResponse)(nil), "cosmos.epochs.v1beta1.QueryUpcomingEpochBoundariesResponse")
+	proto.RegisterType((*EpochBoundary)(nil), "cosmos.epochs.v1beta1.EpochBoundary")
 }
 
 func init() { proto.RegisterFile("cosmos/epochs/v1beta1/query.proto", fileDescriptor_dacbc976c75f2414) }
 
 var fileDescriptor_dacbc976c75f2414 = []byte{
-	// 409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0x28, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x85,
-	0x28, 0xd1, 0x83, 0x28, 0xd1, 0x83, 0x2a, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd0,
-	0x07, 0xb1, 0x20, 0x8a, 0xa5, 0x64, 0xd2, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0x13, 0x0b, 0x32,
-	0xf5, 0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0xa1, 0xb2, 0xca, 0xd8,
-	0x6d, 0x4b, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x84, 0x29, 0x92, 0x84, 0x28, 0x8a, 0x87, 0x98, 0x0d,
-	0xb5, 0x1c, 0xcc, 0x51, 0x92, 0xe0, 0x12, 0x0b, 0x04, 0xb9, 0xcc, 0x15, 0xa4, 0xdf, 0x33, 0x2f,
-	0x2d, 0xbf, 0x38, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0x29, 0x92, 0x4b, 0x1c, 0x43, 0xa6,
-	0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0xc8, 0x8e, 0x8b, 0x0d, 0x62, 0x9f, 0x04, 0xa3, 0x02, 0xb3,
-	0x06, 0xb7, 0x91, 0x82, 0x1e, 0x56, 0x0f, 0xe9, 0xc1, 0xb5, 0x3a, 0xb1, 0x9c, 0xb8, 0x27, 0xcf,
-	0x10, 0x04, 0xd5, 0xa5, 0x64, 0xc5, 0x25, 0x01, 0x36, 0xda, 0xb9, 0xb4, 0xa8, 0x28, 0x35, 0xaf,
-	0x04, 0xac, 0x0c, 0x6a, 0xad, 0x90, 0x1c, 0x17, 0x57, 0x66, 0x4a, 0x6a, 0x5e, 0x49, 0x66, 0x5a,
-	0x66, 0x6a, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x92, 0x88, 0x92, 0x03, 0x97, 0x24,
-	0x16, 0xbd, 0x50, 0x87, 0x29, 0x73, 0xf1, 0x26, 0x43, 0xc4, 0xe3, 0xc1, 0x56, 0x81, 0xf5, 0x33,
-	0x07, 0xf1, 0x24, 0x23, 0x29, 0x36, 0x7a, 0xc2, 0xc4, 0xc5, 0x0a, 0x36, 0x42, 0x68, 0x29, 0x23,
-	0x17, 0x17, 0xc2, 0x7b, 0x42, 0xba, 0x38, 0xbc, 0x81, 0x3d, 0x80, 0xa4, 0xf4, 0x88, 0x55, 0x0e,
-	0x71, 0x9c, 0x92, 0xc5, 0xa9, 0x2d, 0xba, 0xfc, 0x10, 0x2d, 0xba, 0xc5, 0x29, 0xd9, 0x0a, 0x06,
-	0x7a, 0xa6, 0xc6, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x92, 0x17, 0x92, 0xd5, 0xc7, 0x1e, 0x8d, 0x10,
-	0xae, 0xd0, 0x26, 0x46, 0x2e, 0x1e, 0x64, 0xff, 0x0a, 0xe9, 0xe3, 0xb3, 0x1a, 0x4b, 0xa8, 0x4a,
-	0x19, 0x10, 0xaf, 0x01, 0xea, 0x5a, 0x7b, 0x5c, 0xae, 0x55, 0x13, 0x52, 0xc1, 0xe1, 0x5a, 0x94,
-	0xa0, 0x77, 0x72, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xed, 0xf4,
-	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x98, 0x49, 0x08, 0x8b, 0xf4, 0x2b, 0x60,
-	0xc6, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xd3, 0xa9, 0x31, 0x20, 0x00, 0x00, 0xff,
-	0xff, 0xad, 0x09, 0x2f, 0xe7, 0x57, 0x03, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x41, 0x4f, 0xd4, 0x4e,
+	0x14, 0xdf, 0x61, 0x17, 0xf2, 0xe7, 0x01, 0xe1, 0x9f, 0x09, 0xca, 0xb2, 0xd1, 0xee, 0x52, 0x88,
+	0x59, 0x63, 0x68, 0x61, 0x89, 0x09, 0xc1, 0x04, 0xcd, 0x2a, 0x89, 0x7a, 0x30, 0xb1, 0xc1, 0x83,
+	0x5e, 0x36, 0x6d, 0x77, 0xe8, 0x4e, 0xa0, 0x9d, 0xd2, 0x99, 0x1a, 0xb9, 0xfa, 0x09, 0x48, 0x3c,
+	0x68, 0xe2, 0xd9, 0x2f, 0xa0, 0x7e, 0x08, 0x62, 0x3c, 0x90, 0x78, 0xf1, 0xa4, 0x06, 0xfc, 0x20,
+	0xa6, 0x33, 0x53, 0x58, 0xa4, 0xbb, 0x21, 0xde, 0xda, 0xf7, 0x7e, 0xbf, 0xf7, 0x7e, 0xbf, 0xf7,
+	0xde, 0xc0, 0xbc, 0xcf, 0x78, 0xc8, 0xb8, 0x4d, 0x62, 0xe6, 0xf7, 0xb8, 0xfd, 0x72, 0xc5, 0x23,
+	0xc2, 0x5d, 0xb1, 0xf7, 0x52, 0x92, 0xec, 0x5b, 0x71, 0xc2, 0x04, 0xc3, 0x57, 0x14, 0xc4, 0x52,
+	0x10, 0x4b, 0x43, 0x6a, 0x33, 0x01, 0x0b, 0x98, 0x44, 0xd8, 0xd9, 0x97, 0x02, 0xd7, 0xae, 0x05,
+	0x8c, 0x05, 0xbb, 0xc4, 0x76, 0x63, 0x6a, 0xbb, 0x51, 0xc4, 0x84, 0x2b, 0x28, 0x8b, 0xb8, 0xce,
+	0x2e, 0x14, 0x77, 0x0b, 0x48, 0x44, 0x38, 0xcd, 0x41, 0x73, 0x0a, 0xd4, 0x51, 0xb5, 0x75, 0x73,
+	0x95, 0x32, 0x74, 0x75, 0xf9, 0xe7, 0xa5, 0xdb, 0x76, 0x37, 0x4d, 0x64, 0x03, 0x9d, 0xaf, 0xff,
+	0x9d, 0x17, 0x34, 0x24, 0x5c, 0xb8, 0x61, 0xac, 0x00, 0x66, 0x15, 0xae, 0x3e, 0xcd, 0xac, 0x6d,
+	0x66, 0x02, 0x1e, 0x45, 0xdb, 0x8c, 0x3b, 0x64, 0x2f, 0x25, 0x5c, 0x98, 0xcf, 0x61, 0xf6, 0x42,
+	0x86, 0xc7, 0x2c, 0xe2, 0x04, 0x6f, 0xc0, 0x98, 0x12, 0x5c, 0x45, 0x8d, 0x72, 0x73, 0xa2, 0xd5,
+	0xb0, 0x0a, 0x27, 0x62, 0x9d, 0x52, 0xdb, 0x95, 0xc3, 0x1f, 0xf5, 0x92, 0xa3, 0x59, 0xe6, 0x3a,
+	0x54, 0x65, 0xe9, 0xfb, 0x69, 0x92, 0x90, 0x48, 0x48, 0x98, 0x6e, 0x8b, 0x0d, 0x00, 0xda, 0x25,
+	0x91, 0xa0, 0xdb, 0x94, 0x24, 0x55, 0xd4, 0x40, 0xcd, 0x71, 0xa7, 0x2f, 0x62, 0xde, 0x83, 0xb9,
+	0x02, 0xae, 0x16, 0xb6, 0x00, 0x53, 0xbe, 0x8a, 0x77, 0x64, 0x2b, 0xc9, 0x2f, 0x3b, 0x93, 0x7e,
+	0x1f, 0xd8, 0x7c, 0x8f, 0x60, 0x41, 0x96, 0x78, 0x16, 0xfb, 0x2c, 0xa4, 0x51, 0x20, 0xc3, 0x6d,
+	0x96, 0x46, 0x5d, 0x37, 0xa1, 0x84, 0x5f, 0x52, 0x09, 0x9e, 0x81, 0xd1, 0x5d, 0x1a, 0x52, 0x51,
+	0x1d, 0x69, 0xa0, 0xe6, 0x94, 0xa3, 0x7e, 0xf0, 0x06, 0x80, 0xb7, 0xcb, 0xfc, 0x9d, 0x4e, 0x36,
+	0xe9, 0x6a, 0xb9, 0x81, 0x9a, 0x13, 0xad, 0x39, 0x4b, 0xad, 0xc1, 0xca, 0xd7, 0x60, 0x3d, 0xd0,
+	0x6b, 0x6a, 0x57, 0xde, 0xfd, 0xac, 0x23, 0x67, 0x5c, 0x52, 0xb6, 0x68, 0x48, 0xcc, 0x04, 0x16,
+	0x87, 0x8b, 0xd3, 0x56, 0x1f, 0x03, 0x78, 0xa7, 0x51, 0xbd, 0x87, 0xc5, 0x61, 0x7b, 0xd0, 0x35,
+	0xf6, 0xf5, 0x2e, 0xfa, 0xd8, 0xe6, 0x5b, 0x04, 0x53, 0xe7, 0x30, 0x78, 0x1e, 0x26, 0x65, 0x8d,
+	0x4e, 0x94, 0x86, 0x9e, 0x76, 0x5f, 0x76, 0x26, 0x64, 0xec, 0x89, 0x0c, 0xe1, 0x35, 0xa8, 0x48,
+	0x8b, 0x23, 0xd2, 0x62, 0xed, 0x82, 0xc5, 0xad, 0xfc, 0xd2, 0xda, 0xff, 0x65, 0x0d, 0x0f, 0x32,
+	0x9f, 0x92, 0x81, 0x6f, 0xc2, 0xff, 0x84, 0x0b, 0x1a, 0xba, 0x82, 0x74, 0x3b, 0x3d, 0x42, 0x83,
+	0x9e, 0x90, 0x83, 0x2a, 0x3b, 0xd3, 0xa7, 0xf1, 0x87, 0x32, 0xdc, 0xfa, 0x54, 0x81, 0x51, 0x39,
+	0x0e, 0xfc, 0x01, 0x01, 0x9c, 0x9d, 0x22, 0x5e, 0x1a, 0x60, 0xb5, 0xf8, 0x98, 0x6b, 0xd6, 0x65,
+	0xe1, 0x6a, 0xba, 0xe6, 0xda, 0x97, 0xcf, 0x4b, 0xd3, 0x8a, 0xb2, 0xc4, 0xbb, 0x3b, 0x8d, 0x65,
+	0xeb, 0xf6, 0xea, 0xeb, 0x6f, 0xbf, 0xdf, 0x8c, 0xd4, 0xf1, 0x75, 0xbb, 0xf8, 0xcd, 0xaa, 0x5f,
+	0xfc, 0x11, 0xc1, 0x64, 0xff, 0x6d, 0x62, 0x7b, 0x58, 0xeb, 0x82, 0x17, 0x50, 0x5b, 0xbe, 0x3c,
+	0x41, 0xab, 0xbd, 0x3b, 0x48, 0xed, 0x0d, 0xbc, 0x38, 0x40, 0xed, 0xb9, 0x67, 0x82, 0xbf, 0x22,
+	0x98, 0x1d, 0x70, 0x70, 0x78, 0x7d, 0x98, 0x9c, 0xe1, 0x4f, 0xa8, 0x76, 0xe7, 0x9f, 0xb8, 0xf9,
+	0x0e, 0xa4, 0x85, 0x16, 0x5e, 0x1e, 0x60, 0x21, 0xd5, 0x7c, 0xe5, 0xa1, 0x73, 0x76, 0xcf, 0xed,
+	0xcd, 0xc3, 0x63, 0x03, 0x1d, 0x1d, 0x1b, 0xe8, 0xd7, 0xb1, 0x81, 0x0e, 0x4e, 0x8c, 0xd2, 0xd1,
+	0x89, 0x51, 0xfa, 0x7e, 0x62, 0x94, 0x5e, 0xdc, 0x0a, 0xa8, 0xe8, 0xa5, 0x9e, 0xe5, 0xb3, 0x30,
+	0xaf, 0x7a, 0x36, 0x37, 0xfb, 0x55, 0xde, 0x42, 0xec, 0xc7, 0x84, 0x7b, 0x63, 0xf2, 0x96, 0x57,
+	0xff, 0x0c, 0x00, 0xe3, 0x5f, 0x21, 0x13, 0x13, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -261,6 +462,9 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochInfosRequest, opts ...grpc.CallOption) (*QueryEpochInfosResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// UpcomingEpochBoundaries provides the next epoch starts of specified
+	// identifier, with their time and estimated block height
+	UpcomingEpochBoundaries(ctx context.Context, in *QueryUpcomingEpochBoundariesRequest, opts ...grpc.CallOption) (*QueryUpcomingEpochBoundariesResponse, error)
 }
 
 type queryClient struct {
@@ -289,12 +493,24 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) UpcomingEpochBoundaries(ctx context.Context, in *QueryUpcomingEpochBoundariesRequest, opts ...grpc.CallOption) (*QueryUpcomingEpochBoundariesResponse, error) {
+	out := new(QueryUpcomingEpochBoundariesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.epochs.v1beta1.Query/UpcomingEpochBoundaries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
 	EpochInfos(context.Context, *QueryEpochInfosRequest) (*QueryEpochInfosResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// UpcomingEpochBoundaries provides the next epoch starts of specified
+	// identifier, with their time and estimated block height
+	UpcomingEpochBoundaries(context.Context, *QueryUpcomingEpochBoundariesRequest) (*QueryUpcomingEpochBoundariesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -307,6 +523,9 @@ func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpoch
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (*UnimplementedQueryServer) UpcomingEpochBoundaries(ctx context.Context, req *QueryUpcomingEpochBoundariesRequest) (*QueryUpcomingEpochBoundariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingEpochBoundaries not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -348,6 +567,24 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpcomingEpochBoundaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpcomingEpochBoundariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingEpochBoundaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.epochs.v1beta1.Query/UpcomingEpochBoundaries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingEpochBoundaries(ctx, req.(*QueryUpcomingEpochBoundariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.epochs.v1beta1.Query",
@@ -361,6 +598,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "UpcomingEpochBoundaries",
+			Handler:    _Query_UpcomingEpochBoundaries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/epochs/v1beta1/query.proto",
@@ -484,6 +725,129 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUpcomingEpochBoundariesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingEpochBoundariesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingEpochBoundariesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.BlockTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpcomingEpochBoundariesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingEpochBoundariesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingEpochBoundariesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Boundaries) > 0 {
+		for iNdEx := len(m.Boundaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Boundaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochBoundary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochBoundary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochBoundary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -544,6 +908,58 @@ func (m *QueryCurrentEpochResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUpcomingEpochBoundariesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	if m.BlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.BlockTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpcomingEpochBoundariesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Boundaries) > 0 {
+		for _, e := range m.Boundaries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochBoundary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EstimatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedHeight))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -835,6 +1251,348 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUpcomingEpochBoundariesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingEpochBoundariesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingEpochBoundariesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockTime == nil {
+				m.BlockTime = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpcomingEpochBoundariesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingEpochBoundariesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingEpochBoundariesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Boundaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Boundaries = append(m.Boundaries, EpochBoundary{})
+			if err := m.Boundaries[len(m.Boundaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochBoundary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochBoundary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochBoundary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedHeight", wireType)
+			}
+			m.EstimatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
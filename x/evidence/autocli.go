@@ -28,6 +28,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all (paginated) submitted evidence",
 					Example:   fmt.Sprintf("%s query evidence list --page=2 --page-limit=50", version.AppName),
 				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current evidence parameters",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: evidencev1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SubmitEvidence",
+					Skip:      true, // submitted through the evidence handlers of the app
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update evidence module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update evidence module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query evidence params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx evidence update-params-proposal '{ "max_age_num_blocks": "100000" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
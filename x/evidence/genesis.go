@@ -17,6 +17,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
 		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
 	}
 
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+
 	for _, e := range gs.Evidence {
 		evi, ok := e.GetCachedValue().(exported.Evidence)
 		if !ok {
@@ -26,7 +30,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
 			panic(fmt.Sprintf("evidence with hash %s already exists", evi.Hash()))
 		}
 
-		if err := k.Evidences.Set(ctx, evi.Hash(), evi); err != nil {
+		if err := k.SetEvidence(ctx, evi); err != nil {
 			panic(err)
 		}
 	}
@@ -34,8 +38,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs *types.GenesisState) {
 
 // ExportGenesis returns the evidence module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	gs := new(types.GenesisState)
-	err := k.Evidences.Walk(ctx, nil, func(_ []byte, value exported.Evidence) (stop bool, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs := &types.GenesisState{Params: params}
+	err = k.Evidences.Walk(ctx, nil, func(_ []byte, value exported.Evidence) (stop bool, err error) {
 		anyEvi, err := codectypes.NewAnyWithValue(value)
 		if err != nil {
 			return false, err
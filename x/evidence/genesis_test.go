@@ -69,7 +69,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence)
 			},
 			true,
 			func() {
@@ -90,7 +90,7 @@ func (suite *GenesisTestSuite) TestInitGenesis() {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence)
 			},
 			false,
 			func() {
@@ -11,11 +11,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
-// BeginBlocker iterates through and handles any newly discovered evidence of
-// misbehavior submitted by CometBFT. Currently, only equivocation is handled.
+// BeginBlocker prunes the expired evidence, then iterates through and handles
+// any newly discovered evidence of misbehavior submitted by CometBFT. Currently,
+// only equivocation is handled.
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker) //nolint:staticcheck // TODO: switch to OpenTelemetry
 
+	if err := k.PruneExpiredEvidence(ctx); err != nil {
+		return err
+	}
+
 	bi := k.cometInfo.GetCometBlockInfo(ctx)
 	if bi == nil {
 		// If we don't have block info, we don't have any evidence to process.  Block info may be nil during
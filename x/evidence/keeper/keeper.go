@@ -32,14 +32,22 @@ type Keeper struct {
 
 	cometInfo comet.BlockInfoService
 
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
 	Schema    collections.Schema
+	Params    collections.Item[types.Params]
 	Evidences collections.Map[[]byte, exported.Evidence]
+	// EvidenceByHeight indexes the submitted evidence by infraction height, so
+	// that expired evidence can be pruned.
+	EvidenceByHeight collections.KeySet[collections.Pair[int64, []byte]]
 }
 
 // NewKeeper creates a new Keeper object.
 func NewKeeper(
 	cdc codec.BinaryCodec, storeService store.KVStoreService, stakingKeeper types.StakingKeeper,
-	slashingKeeper types.SlashingKeeper, ac address.Codec, ci comet.BlockInfoService,
+	slashingKeeper types.SlashingKeeper, ac address.Codec, ci comet.BlockInfoService, authority string,
 ) *Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := &Keeper{
@@ -49,7 +57,13 @@ func NewKeeper(
 		slashingKeeper: slashingKeeper,
 		addressCodec:   ac,
 		cometInfo:      ci,
+		authority:      authority,
+		Params:         collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Evidences:      collections.NewMap(sb, types.KeyPrefixEvidence, "evidences", collections.BytesKey, codec.CollInterfaceValue[exported.Evidence](cdc)),
+		EvidenceByHeight: collections.NewKeySet(
+			sb, types.KeyPrefixEvidenceByHeight, "evidence_by_height",
+			collections.PairKeyCodec(collections.Int64Key, collections.BytesKey),
+		),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -59,6 +73,11 @@ func NewKeeper(
 	return k
 }
 
+// GetAuthority returns the x/evidence module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx context.Context) log.Logger {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -94,13 +113,30 @@ func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, error)
 }
 
 // SubmitEvidence attempts to match evidence against the keepers router and execute
-// the corresponding registered Evidence Handler. An error is returned if no
-// registered Handler exists or if the Handler fails. Otherwise, the evidence is
-// persisted.
+// the corresponding registered Evidence Handler. An error is returned if the
+// evidence was already submitted, if it is older than the MaxAgeNumBlocks param,
+// if no registered Handler exists or if the Handler fails. Otherwise, the
+// evidence is persisted.
 func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence) error {
 	if _, err := k.Evidences.Get(ctx, evidence.Hash()); err == nil {
 		return errors.Wrap(types.ErrEvidenceExists, strings.ToUpper(hex.EncodeToString(evidence.Hash())))
 	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Expired evidence is pruned from the store, so it must be rejected to
+	// prevent it from being replayed.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if params.IsExpired(evidence.GetHeight(), sdkCtx.BlockHeight()) {
+		return errors.Wrapf(
+			types.ErrEvidenceExpired, "evidence height %d, block height %d, max age %d blocks",
+			evidence.GetHeight(), sdkCtx.BlockHeight(), params.MaxAgeNumBlocks,
+		)
+	}
+
 	if !k.router.HasRoute(evidence.Route()) {
 		return errors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Route())
 	}
@@ -110,7 +146,6 @@ func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence)
 		return errors.Wrap(types.ErrInvalidEvidence, err.Error())
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSubmitEvidence,
@@ -118,5 +153,54 @@ func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence)
 		),
 	)
 
-	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+	return k.SetEvidence(ctx, evidence)
+}
+
+// SetEvidence persists the evidence and indexes it by height so that it is
+// pruned once it expires.
+func (k Keeper) SetEvidence(ctx context.Context, evidence exported.Evidence) error {
+	if err := k.Evidences.Set(ctx, evidence.Hash(), evidence); err != nil {
+		return err
+	}
+
+	return k.EvidenceByHeight.Set(ctx, collections.Join(evidence.GetHeight(), evidence.Hash()))
+}
+
+// PruneExpiredEvidence removes the submitted evidence that is older than the
+// MaxAgeNumBlocks param. Such evidence cannot be submitted again, as it is
+// rejected by SubmitEvidence.
+func (k Keeper) PruneExpiredEvidence(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MaxAgeNumBlocks == 0 {
+		return nil
+	}
+
+	// evidence is expired if its height is lower than the cutoff height
+	cutoff := sdk.UnwrapSDKContext(ctx).BlockHeight() - params.MaxAgeNumBlocks
+	rng := new(collections.Range[collections.Pair[int64, []byte]]).
+		EndExclusive(collections.Join(cutoff, []byte{}))
+
+	keys, err := k.EvidenceByHeight.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+
+	expired, err := keys.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.Evidences.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+		if err := k.EvidenceByHeight.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
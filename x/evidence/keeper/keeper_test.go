@@ -21,11 +21,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	evidencetestutil "github.com/cosmos/cosmos-sdk/x/evidence/testutil"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var (
@@ -106,6 +108,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		slashingKeeper,
 		address.NewBech32Codec("cosmos"),
 		&evidencetestutil.MockCometInfo{},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	suite.stakingKeeper = stakingKeeper
@@ -117,6 +120,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	evidenceKeeper.SetRouter(router)
 
 	suite.ctx = testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 1})
+	suite.Require().NoError(evidenceKeeper.Params.Set(suite.ctx, types.DefaultParams()))
 	suite.encCfg = moduletestutil.MakeTestEncodingConfig(evidence.AppModuleBasic{})
 
 	suite.accountKeeper = accountKeeper
@@ -206,6 +210,100 @@ func (suite *KeeperTestSuite) TestSubmitInvalidEvidence() {
 	suite.Nil(res)
 }
 
+func (suite *KeeperTestSuite) TestSubmitExpiredEvidence() {
+	suite.Require().NoError(suite.evidenceKeeper.Params.Set(suite.ctx, types.NewParams(10)))
+	pk := ed25519.GenPrivKey()
+
+	newEvidence := func(height int64) *types.Equivocation {
+		return &types.Equivocation{
+			Height:           height,
+			Power:            100,
+			Time:             time.Now().UTC(),
+			ConsensusAddress: sdk.ConsAddress(pk.PubKey().Address().Bytes()).String(),
+		}
+	}
+
+	ctx := suite.ctx.WithBlockHeight(21)
+	suite.ErrorIs(suite.evidenceKeeper.SubmitEvidence(ctx, newEvidence(9)), types.ErrEvidenceExpired)
+	suite.NoError(suite.evidenceKeeper.SubmitEvidence(ctx, newEvidence(11)))
+
+	// evidence never expires if MaxAgeNumBlocks is zero
+	suite.Require().NoError(suite.evidenceKeeper.Params.Set(suite.ctx, types.NewParams(0)))
+	suite.NoError(suite.evidenceKeeper.SubmitEvidence(ctx, newEvidence(9)))
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredEvidence() {
+	suite.Require().NoError(suite.evidenceKeeper.Params.Set(suite.ctx, types.NewParams(10)))
+	pk := ed25519.GenPrivKey()
+
+	var evidence []*types.Equivocation
+	for _, height := range []int64{1, 3, 5} {
+		e := &types.Equivocation{
+			Height:           height,
+			Power:            100,
+			Time:             time.Now().UTC(),
+			ConsensusAddress: sdk.ConsAddress(pk.PubKey().Address().Bytes()).String(),
+		}
+		suite.Require().NoError(suite.evidenceKeeper.SubmitEvidence(suite.ctx.WithBlockHeight(height), e))
+		evidence = append(evidence, e)
+	}
+
+	// evidence at height 1 and 3 is older than 10 blocks at height 14
+	suite.Require().NoError(suite.evidenceKeeper.PruneExpiredEvidence(suite.ctx.WithBlockHeight(14)))
+
+	for i, e := range evidence {
+		has, err := suite.evidenceKeeper.Evidences.Has(suite.ctx, e.Hash())
+		suite.Require().NoError(err)
+		suite.Require().Equal(i == 2, has)
+
+		has, err = suite.evidenceKeeper.EvidenceByHeight.Has(suite.ctx, collections.Join(e.Height, e.Hash()))
+		suite.Require().NoError(err)
+		suite.Require().Equal(i == 2, has)
+	}
+
+	// pruned evidence cannot be replayed
+	suite.ErrorIs(suite.evidenceKeeper.SubmitEvidence(suite.ctx.WithBlockHeight(14), evidence[0]), types.ErrEvidenceExpired)
+}
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	pk := ed25519.GenPrivKey()
+
+	// evidence stored by version 1 is not indexed by height
+	var evidence []*types.Equivocation
+	for _, height := range []int64{1, 3, 5} {
+		e := &types.Equivocation{
+			Height:           height,
+			Power:            100,
+			Time:             time.Now().UTC(),
+			ConsensusAddress: sdk.ConsAddress(pk.PubKey().Address().Bytes()).String(),
+		}
+		suite.Require().NoError(suite.evidenceKeeper.Evidences.Set(suite.ctx, e.Hash(), e))
+		evidence = append(evidence, e)
+	}
+
+	suite.Require().NoError(keeper.NewMigrator(suite.evidenceKeeper).Migrate1to2(suite.ctx))
+
+	params, err := suite.evidenceKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), params)
+
+	for _, e := range evidence {
+		has, err := suite.evidenceKeeper.EvidenceByHeight.Has(suite.ctx, collections.Join(e.Height, e.Hash()))
+		suite.Require().NoError(err)
+		suite.Require().True(has)
+	}
+
+	// the migrated evidence is pruned once expired
+	suite.Require().NoError(suite.evidenceKeeper.Params.Set(suite.ctx, types.NewParams(10)))
+	suite.Require().NoError(suite.evidenceKeeper.PruneExpiredEvidence(suite.ctx.WithBlockHeight(14)))
+
+	for i, e := range evidence {
+		has, err := suite.evidenceKeeper.Evidences.Has(suite.ctx, e.Hash())
+		suite.Require().NoError(err)
+		suite.Require().Equal(i == 2, has)
+	}
+}
+
 func (suite *KeeperTestSuite) TestIterateEvidence() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	numEvidence := 100
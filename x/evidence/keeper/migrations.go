@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns Migrator instance for the state migration.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/evidence module state from the consensus version 1
+// to version 2. Specifically, it sets the default params, as params were
+// introduced in version 2, and indexes the evidence submitted before the
+// migration by height, so that it can be pruned.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.keeper.Params.Set(ctx, types.DefaultParams()); err != nil {
+		return err
+	}
+
+	return m.keeper.Evidences.Walk(ctx, nil, func(key []byte, evidence exported.Evidence) (stop bool, err error) {
+		return false, m.keeper.EvidenceByHeight.Set(ctx, collections.Join(evidence.GetHeight(), key))
+	})
+}
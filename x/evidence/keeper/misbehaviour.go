@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MisbehaviourHandler returns the Handler of an app-defined misbehaviour of
+// validators, e.g. misreporting oracle prices or double-signing a vote
+// extension. The evidence must implement exported.ValidatorEvidence. It is
+// first checked by verify, which must establish the validity of the evidence
+// independently of its submitter, then the validator is punished according to
+// the given slashing policy.
+//
+// The returned handler is registered on the Router under the route of the
+// evidence type:
+//
+//	router.AddRoute(oracletypes.RouteMisreport, evidenceKeeper.MisbehaviourHandler(verifyMisreport, policy))
+//
+// The evidence is considered invalid if:
+// - it is rejected by verify
+// - the validator is unbonded or does not exist
+// - the signing info does not exist
+// - the validator is already tombstoned
+func (k Keeper) MisbehaviourHandler(verify types.Handler, policy types.SlashingPolicy) types.Handler {
+	if err := policy.Validate(); err != nil {
+		panic(fmt.Errorf("invalid slashing policy: %w", err))
+	}
+
+	return func(ctx context.Context, e exported.Evidence) error {
+		evidence, ok := e.(exported.ValidatorEvidence)
+		if !ok {
+			return fmt.Errorf("evidence %T is not evidence against a validator", e)
+		}
+
+		if err := verify(ctx, evidence); err != nil {
+			return err
+		}
+
+		return k.handleMisbehaviour(ctx, evidence, policy)
+	}
+}
+
+func (k Keeper) handleMisbehaviour(ctx context.Context, evidence exported.ValidatorEvidence, policy types.SlashingPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	consAddr := evidence.GetConsensusAddress()
+
+	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return errorsmod.Wrapf(err, "validator %s", consAddr)
+	}
+	if err != nil {
+		return err
+	}
+	if validator == nil || validator.IsUnbonded() {
+		return fmt.Errorf("validator %s is unbonded", consAddr)
+	}
+
+	if !k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr) {
+		return fmt.Errorf("signing info for validator %s not found", consAddr)
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return fmt.Errorf("validator %s is already tombstoned", consAddr)
+	}
+
+	infractionHeight := evidence.GetHeight()
+	k.Logger(ctx).Info(
+		"confirmed misbehaviour",
+		"validator", consAddr,
+		"route", evidence.Route(),
+		"infraction_height", infractionHeight,
+	)
+
+	if policy.Slashes() {
+		power, err := k.infractionPower(ctx, validator, consAddr, infractionHeight)
+		if err != nil {
+			return err
+		}
+
+		// As for equivocations, the stake distribution which signed the block is
+		// the one of ValidatorUpdateDelay blocks before the infraction.
+		distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+
+		err = k.slashingKeeper.SlashWithInfractionReason(
+			ctx,
+			consAddr,
+			policy.SlashFraction,
+			power, distributionHeight,
+			stakingtypes.Infraction_INFRACTION_UNSPECIFIED,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Jail the validator if not already jailed. A validator that is already
+	// jailed keeps its jail end time, unless it is tombstoned.
+	jailedUntil := sdkCtx.BlockTime().Add(policy.JailDuration)
+	if policy.Tombstone {
+		jailedUntil = types.DoubleSignJailEndTime
+	}
+
+	jailed := false
+	if (policy.JailDuration > 0 || policy.Tombstone) && !validator.IsJailed() {
+		if err := k.slashingKeeper.Jail(ctx, consAddr); err != nil {
+			return err
+		}
+		jailed = true
+	}
+
+	if jailed || policy.Tombstone {
+		if err := k.slashingKeeper.JailUntil(ctx, consAddr, jailedUntil); err != nil {
+			return err
+		}
+
+		jailed = true
+	}
+
+	if policy.Tombstone {
+		if err := k.slashingKeeper.Tombstone(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyEvidenceRoute, evidence.Route()),
+		sdk.NewAttribute(types.AttributeKeyValidator, consAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyTombstoned, fmt.Sprintf("%t", policy.Tombstone)),
+	}
+	if policy.Slashes() {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeySlashFraction, policy.SlashFraction.String()))
+	}
+	if jailed {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyJailedUntil, jailedUntil.String()))
+	}
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeMisbehaviour, attrs...))
+
+	return nil
+}
+
+// infractionPower returns the consensus power of a validator at the infraction
+// height, from the historical info of the staking module, or its current power
+// if the historical info was pruned or the validator was not in the validator
+// set. The power carried by the evidence is never used, as the evidence may be
+// submitted by anyone.
+func (k Keeper) infractionPower(ctx context.Context, validator stakingtypes.ValidatorI, consAddr sdk.ConsAddress, height int64) (int64, error) {
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+
+	hi, err := k.stakingKeeper.GetHistoricalInfo(ctx, height)
+	if errors.Is(err, stakingtypes.ErrNoHistoricalInfo) {
+		return validator.GetConsensusPower(powerReduction), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	for _, val := range hi.Valset {
+		valConsAddr, err := val.GetConsAddr()
+		if err != nil {
+			return 0, err
+		}
+		if consAddr.Equals(sdk.ConsAddress(valConsAddr)) {
+			return val.ConsensusPower(powerReduction), nil
+		}
+	}
+
+	return validator.GetConsensusPower(powerReduction), nil
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// testMisbehaviour is an app-defined misbehaviour of a validator.
+type testMisbehaviour struct {
+	*types.Equivocation
+}
+
+func (e testMisbehaviour) GetConsensusAddress() sdk.ConsAddress {
+	return e.Equivocation.GetConsensusAddress(address.NewBech32Codec("cosmosvalcons"))
+}
+
+func (suite *KeeperTestSuite) TestMisbehaviourHandler() {
+	consPk := ed25519.GenPrivKey().PubKey()
+	consAddr := sdk.ConsAddress(consPk.Address())
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockHeight(20).WithBlockTime(blockTime)
+
+	newEvidence := func(power int64) exported.Evidence {
+		return testMisbehaviour{&types.Equivocation{
+			Height:           11,
+			Power:            power,
+			Time:             blockTime,
+			ConsensusAddress: consAddr.String(),
+		}}
+	}
+	validator := stakingtypes.Validator{Status: stakingtypes.Bonded, Tokens: sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)}
+
+	// the validator had less power at the infraction height
+	historicalValidator, err := stakingtypes.NewValidator(sdk.ValAddress(consAddr).String(), consPk, stakingtypes.Description{})
+	suite.Require().NoError(err)
+	historicalValidator.Status = stakingtypes.Bonded
+	historicalValidator.Tokens = sdk.TokensFromConsensusPower(7, sdk.DefaultPowerReduction)
+	otherValidator, err := stakingtypes.NewValidator(sdk.ValAddress("other").String(), ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	otherValidator.Tokens = sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction)
+	historicalInfo := stakingtypes.HistoricalInfo{Valset: []stakingtypes.Validator{otherValidator, historicalValidator}}
+
+	verified := func(context.Context, exported.Evidence) error { return nil }
+
+	testCases := []struct {
+		name      string
+		evidence  exported.Evidence
+		verify    types.Handler
+		policy    types.SlashingPolicy
+		malleate  func()
+		expErrMsg string
+	}{
+		{
+			name:      "not validator evidence",
+			evidence:  &types.Equivocation{Height: 11, Power: 100, Time: blockTime, ConsensusAddress: consAddr.String()},
+			verify:    verified,
+			malleate:  func() {},
+			expErrMsg: "is not evidence against a validator",
+		},
+		{
+			name:     "verification failure",
+			evidence: newEvidence(100),
+			verify: func(context.Context, exported.Evidence) error {
+				return errors.New("invalid oracle report signature")
+			},
+			malleate:  func() {},
+			expErrMsg: "invalid oracle report signature",
+		},
+		{
+			name:     "unknown validator",
+			evidence: newEvidence(100),
+			verify:   verified,
+			malleate: func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(nil, stakingtypes.ErrNoValidatorFound)
+			},
+			expErrMsg: "validator does not exist",
+		},
+		{
+			name:     "tombstoned validator",
+			evidence: newEvidence(100),
+			verify:   verified,
+			malleate: func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+				suite.slashingKeeper.EXPECT().HasValidatorSigningInfo(gomock.Any(), consAddr).Return(true)
+				suite.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(true)
+			},
+			expErrMsg: "already tombstoned",
+		},
+		{
+			name:     "slash and jail",
+			evidence: newEvidence(100),
+			verify:   verified,
+			policy:   types.SlashingPolicy{SlashFraction: math.LegacyNewDecWithPrec(1, 2), JailDuration: time.Hour},
+			malleate: func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+				suite.slashingKeeper.EXPECT().HasValidatorSigningInfo(gomock.Any(), consAddr).Return(true)
+				suite.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+				suite.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction)
+				suite.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(11)).Return(historicalInfo, nil)
+				suite.slashingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, math.LegacyNewDecWithPrec(1, 2), int64(7), int64(10), stakingtypes.Infraction_INFRACTION_UNSPECIFIED).Return(nil)
+				suite.slashingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return(nil)
+				suite.slashingKeeper.EXPECT().JailUntil(gomock.Any(), consAddr, blockTime.Add(time.Hour)).Return(nil)
+			},
+		},
+		{
+			name:     "slash current power without historical info",
+			evidence: newEvidence(100),
+			verify:   verified,
+			policy:   types.SlashingPolicy{SlashFraction: math.LegacyNewDecWithPrec(1, 2)},
+			malleate: func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+				suite.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction)
+				suite.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(11)).Return(stakingtypes.HistoricalInfo{}, stakingtypes.ErrNoHistoricalInfo)
+				suite.slashingKeeper.EXPECT().HasValidatorSigningInfo(gomock.Any(), consAddr).Return(true)
+				suite.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+				suite.slashingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, math.LegacyNewDecWithPrec(1, 2), int64(10), int64(10), stakingtypes.Infraction_INFRACTION_UNSPECIFIED).Return(nil)
+			},
+		},
+		{
+			name:     "slash current power outside of the historical validator set",
+			evidence: newEvidence(100),
+			verify:   verified,
+			policy:   types.SlashingPolicy{SlashFraction: math.LegacyNewDecWithPrec(1, 2)},
+			malleate: func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+				suite.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction)
+				suite.stakingKeeper.EXPECT().GetHistoricalInfo(gomock.Any(), int64(11)).Return(stakingtypes.HistoricalInfo{Valset: []stakingtypes.Validator{otherValidator}}, nil)
+				suite.slashingKeeper.EXPECT().HasValidatorSigningInfo(gomock.Any(), consAddr).Return(true)
+				suite.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+				suite.slashingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, math.LegacyNewDecWithPrec(1, 2), int64(10), int64(10), stakingtypes.Infraction_INFRACTION_UNSPECIFIED).Return(nil)
+			},
+		},
+		{
+			name:     "tombstone",
+			evidence: newEvidence(100),
+			verify:   verified,
+			policy:   types.SlashingPolicy{Tombstone: true},
+			malleate: func() {
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(validator, nil)
+				suite.slashingKeeper.EXPECT().HasValidatorSigningInfo(gomock.Any(), consAddr).Return(true)
+				suite.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+				suite.slashingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return(nil)
+				suite.slashingKeeper.EXPECT().JailUntil(gomock.Any(), consAddr, types.DoubleSignJailEndTime).Return(nil)
+				suite.slashingKeeper.EXPECT().Tombstone(gomock.Any(), consAddr).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tc.malleate()
+
+			handler := suite.evidenceKeeper.MisbehaviourHandler(tc.verify, tc.policy)
+			err := handler(ctx, tc.evidence)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMisbehaviourHandlerInvalidPolicy() {
+	suite.Require().Panics(func() {
+		suite.evidenceKeeper.MisbehaviourHandler(
+			func(context.Context, exported.Evidence) error { return nil },
+			types.SlashingPolicy{SlashFraction: math.LegacyNewDec(2)},
+		)
+	})
+}
@@ -76,3 +76,50 @@ func (s *KeeperTestSuite) TestSubmitEvidence() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateParams
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			req: &types.MsgUpdateParams{
+				Authority: sdk.AccAddress(valAddress).String(),
+				Params:    types.DefaultParams(),
+			},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "invalid params",
+			req: &types.MsgUpdateParams{
+				Authority: s.evidenceKeeper.GetAuthority(),
+				Params:    types.NewParams(-1),
+			},
+			expErrMsg: "max age num blocks must not be negative",
+		},
+		{
+			name: "valid params",
+			req: &types.MsgUpdateParams{
+				Authority: s.evidenceKeeper.GetAuthority(),
+				Params:    types.NewParams(500),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+			params, err := s.evidenceKeeper.Params.Get(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.req.Params, params)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+// Evidence of misbehaviour may be gossiped by the validators in their vote
+// extensions, e.g. evidence of misreported oracle prices observed by a
+// validator. The proposer of the next block injects the evidence of the vote
+// extensions as the first transaction of its proposal, and the evidence is
+// submitted in the PreBlocker of the application. Applications wire the
+// evidence injection as follows:
+//
+//   - ExtendVote: include MarshalVoteExtensionEvidence in the vote extension.
+//   - VerifyVoteExtension: reject vote extensions failing VerifyVoteExtensionEvidence.
+//   - PrepareProposal: call InjectVoteExtensionEvidence with the extended commit
+//     and the max tx bytes of the block.
+//   - ProcessProposal: reject proposals failing ValidateInjectedEvidence.
+//   - PreBlocker: call SubmitInjectedEvidence with the transactions of the block.
+//
+// The evidence is injected in all the blocks proposed while vote extensions are
+// enabled, even if there is no evidence, so that the first transaction of such
+// blocks is always the injected evidence. Since any proposer may inject any
+// evidence, the handlers of the evidence must verify it independently of its
+// submitter.
+
+// MarshalVoteExtensionEvidence encodes the evidence observed by a validator, to
+// be included in its vote extension.
+func (k Keeper) MarshalVoteExtensionEvidence(evidence []exported.Evidence) ([]byte, error) {
+	ve := types.VoteExtensionEvidence{Evidence: make([]*codectypes.Any, len(evidence))}
+	for i, e := range evidence {
+		anyEvi, err := codectypes.NewAnyWithValue(e)
+		if err != nil {
+			return nil, err
+		}
+		ve.Evidence[i] = anyEvi
+	}
+
+	return k.cdc.Marshal(&ve)
+}
+
+// UnmarshalVoteExtensionEvidence decodes evidence encoded by
+// MarshalVoteExtensionEvidence.
+func (k Keeper) UnmarshalVoteExtensionEvidence(bz []byte) ([]exported.Evidence, error) {
+	var ve types.VoteExtensionEvidence
+	if err := k.cdc.Unmarshal(bz, &ve); err != nil {
+		return nil, err
+	}
+
+	evidence := make([]exported.Evidence, len(ve.Evidence))
+	for i, anyEvi := range ve.Evidence {
+		if err := k.cdc.UnpackAny(anyEvi, &evidence[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return evidence, nil
+}
+
+// VerifyVoteExtensionEvidence checks the evidence of a vote extension without
+// executing its handler: the evidence must be valid and have a registered
+// handler, and the vote extension must carry at most MaxVoteExtensionEvidence
+// evidence in MaxVoteExtensionEvidenceBytes.
+func (k Keeper) VerifyVoteExtensionEvidence(bz []byte) error {
+	_, err := k.verifyVoteExtensionEvidence(bz)
+	return err
+}
+
+// verifyVoteExtensionEvidence checks the evidence of a vote extension and
+// returns it.
+func (k Keeper) verifyVoteExtensionEvidence(bz []byte) ([]exported.Evidence, error) {
+	if len(bz) > types.MaxVoteExtensionEvidenceBytes {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEvidence, "vote extension evidence too large: %d > %d bytes", len(bz), types.MaxVoteExtensionEvidenceBytes)
+	}
+
+	evidence, err := k.UnmarshalVoteExtensionEvidence(bz)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEvidence, err.Error())
+	}
+
+	if len(evidence) > types.MaxVoteExtensionEvidence {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEvidence, "too much vote extension evidence: %d > %d", len(evidence), types.MaxVoteExtensionEvidence)
+	}
+
+	return evidence, k.validateEvidence(evidence)
+}
+
+// InjectVoteExtensionEvidence returns the transactions of a proposal with the
+// evidence of the vote extensions of the commit injected as the first
+// transaction. Invalid vote extensions are ignored, as well as evidence which
+// is expired or already submitted.
+//
+// The injected evidence and the transactions fit in maxTxBytes, the max tx bytes
+// of the block: the evidence is injected until it fills maxTxBytes, and the
+// transactions exceeding the remaining bytes are removed from the end of txs.
+func (k Keeper) InjectVoteExtensionEvidence(ctx context.Context, commit abci.ExtendedCommitInfo, txs [][]byte, maxTxBytes int64) ([][]byte, error) {
+	if !voteExtensionsEnabled(ctx) {
+		return txs, nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	seen := make(map[string]bool)
+	var (
+		evidence     []exported.Evidence
+		evidenceSize int
+	)
+	for _, vote := range commit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		voteEvidence, err := k.verifyVoteExtensionEvidence(vote.VoteExtension)
+		if err != nil {
+			continue
+		}
+
+		for _, e := range voteEvidence {
+			hash := string(e.Hash())
+			if seen[hash] || params.IsExpired(e.GetHeight(), height) {
+				continue
+			}
+			seen[hash] = true
+
+			if has, err := k.Evidences.Has(ctx, e.Hash()); err != nil {
+				return nil, err
+			} else if has {
+				continue
+			}
+
+			// the size of a repeated field is the sum of the sizes of its elements
+			anyEvi, err := codectypes.NewAnyWithValue(e)
+			if err != nil {
+				return nil, err
+			}
+			size := (&types.VoteExtensionEvidence{Evidence: []*codectypes.Any{anyEvi}}).Size()
+			if txProtoSize(evidenceSize+size) > maxTxBytes {
+				continue
+			}
+
+			evidence = append(evidence, e)
+			evidenceSize += size
+		}
+	}
+
+	bz, err := k.MarshalVoteExtensionEvidence(evidence)
+	if err != nil {
+		return nil, err
+	}
+
+	// the injected evidence is reserved from the max tx bytes of the block
+	res := [][]byte{bz}
+	totalSize := txProtoSize(len(bz))
+	for _, tx := range txs {
+		totalSize += txProtoSize(len(tx))
+		if totalSize > maxTxBytes {
+			break
+		}
+		res = append(res, tx)
+	}
+
+	return res, nil
+}
+
+// txProtoSize returns the size of a transaction of n bytes in the data of a
+// block, as computed by CometBFT: the transaction with its tag and length.
+func txProtoSize(n int) int64 {
+	return int64(protowire.SizeTag(1) + protowire.SizeBytes(n))
+}
+
+// ValidateInjectedEvidence checks the evidence injected in a proposal by
+// InjectVoteExtensionEvidence.
+func (k Keeper) ValidateInjectedEvidence(ctx context.Context, txs [][]byte) error {
+	evidence, err := k.injectedEvidence(ctx, txs)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(evidence))
+	for _, e := range evidence {
+		if seen[string(e.Hash())] {
+			return errorsmod.Wrapf(types.ErrInvalidEvidence, "duplicate injected evidence %X", e.Hash())
+		}
+		seen[string(e.Hash())] = true
+	}
+
+	return k.validateEvidence(evidence)
+}
+
+// SubmitInjectedEvidence submits the evidence injected in the block by
+// InjectVoteExtensionEvidence. Evidence failing submission is ignored, so that
+// evidence submitted in a previous block or through a transaction does not
+// halt the chain.
+func (k Keeper) SubmitInjectedEvidence(ctx context.Context, txs [][]byte) error {
+	evidence, err := k.injectedEvidence(ctx, txs)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, e := range evidence {
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.SubmitEvidence(cacheCtx, e); err != nil {
+			k.Logger(ctx).Info("ignored injected evidence", "hash", fmt.Sprintf("%X", e.Hash()), "err", err)
+			continue
+		}
+
+		write()
+	}
+
+	return nil
+}
+
+// injectedEvidence returns the evidence injected in the block, if vote
+// extensions are enabled.
+func (k Keeper) injectedEvidence(ctx context.Context, txs [][]byte) ([]exported.Evidence, error) {
+	if !voteExtensionsEnabled(ctx) {
+		return nil, nil
+	}
+
+	if len(txs) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidEvidence, "missing injected evidence")
+	}
+
+	evidence, err := k.UnmarshalVoteExtensionEvidence(txs[0])
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEvidence, "invalid injected evidence: %s", err)
+	}
+
+	return evidence, nil
+}
+
+// validateEvidence performs the stateless validation of the evidence.
+func (k Keeper) validateEvidence(evidence []exported.Evidence) error {
+	for _, e := range evidence {
+		if e == nil {
+			return errorsmod.Wrap(types.ErrInvalidEvidence, "missing evidence")
+		}
+
+		if err := e.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidEvidence, "failed basic validation: %s", err)
+		}
+
+		if !k.router.HasRoute(e.Route()) {
+			return errorsmod.Wrap(types.ErrNoEvidenceHandlerExists, e.Route())
+		}
+	}
+
+	return nil
+}
+
+// voteExtensionsEnabled returns true if the proposal of the current block
+// carries the vote extensions of the previous block.
+func voteExtensionsEnabled(ctx context.Context) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cp := sdkCtx.ConsensusParams()
+	enableHeight := cp.GetAbci().GetVoteExtensionsEnableHeight()
+
+	return enableHeight != 0 && sdkCtx.BlockHeight() > enableHeight
+}
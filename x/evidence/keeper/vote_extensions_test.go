@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+func newEquivocation(height int64) *types.Equivocation {
+	return &types.Equivocation{
+		Height:           height,
+		Power:            100,
+		Time:             time.Now().UTC(),
+		ConsensusAddress: sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+	}
+}
+
+func (suite *KeeperTestSuite) voteExtension(evidence ...exported.Evidence) []byte {
+	bz, err := suite.evidenceKeeper.MarshalVoteExtensionEvidence(evidence)
+	suite.Require().NoError(err)
+	return bz
+}
+
+func (suite *KeeperTestSuite) TestVerifyVoteExtensionEvidence() {
+	e := newEquivocation(11)
+
+	evidence, err := suite.evidenceKeeper.UnmarshalVoteExtensionEvidence(suite.voteExtension(e))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]exported.Evidence{e}, evidence)
+
+	suite.Require().NoError(suite.evidenceKeeper.VerifyVoteExtensionEvidence(suite.voteExtension()))
+	suite.Require().NoError(suite.evidenceKeeper.VerifyVoteExtensionEvidence(suite.voteExtension(e)))
+	suite.Require().ErrorIs(suite.evidenceKeeper.VerifyVoteExtensionEvidence(suite.voteExtension(newEquivocation(0))), types.ErrInvalidEvidence)
+	suite.Require().ErrorIs(suite.evidenceKeeper.VerifyVoteExtensionEvidence([]byte("invalid")), types.ErrInvalidEvidence)
+
+	// the evidence of a vote extension is capped in count and size
+	evidence = make([]exported.Evidence, types.MaxVoteExtensionEvidence+1)
+	for i := range evidence {
+		evidence[i] = newEquivocation(11)
+	}
+	suite.Require().ErrorContains(suite.evidenceKeeper.VerifyVoteExtensionEvidence(suite.voteExtension(evidence...)), "too much vote extension evidence")
+	suite.Require().NoError(suite.evidenceKeeper.VerifyVoteExtensionEvidence(suite.voteExtension(evidence[1:]...)))
+	suite.Require().ErrorContains(suite.evidenceKeeper.VerifyVoteExtensionEvidence(make([]byte, types.MaxVoteExtensionEvidenceBytes+1)), "vote extension evidence too large")
+}
+
+func (suite *KeeperTestSuite) TestInjectVoteExtensionEvidence() {
+	ctx := suite.ctx.WithBlockHeight(20).WithConsensusParams(cmtproto.ConsensusParams{
+		Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1},
+	})
+	suite.Require().NoError(suite.evidenceKeeper.Params.Set(ctx, types.NewParams(10)))
+
+	valid, invalid, expired, absent, submitted := newEquivocation(11), newEquivocation(13), newEquivocation(7), newEquivocation(15), newEquivocation(17)
+	invalid.Height = 12 // rejected by the handler
+	suite.Require().NoError(suite.evidenceKeeper.SubmitEvidence(ctx, submitted))
+
+	commit := abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{
+			{BlockIdFlag: cmtproto.BlockIDFlagCommit, VoteExtension: suite.voteExtension(valid, submitted)},
+			{BlockIdFlag: cmtproto.BlockIDFlagCommit, VoteExtension: suite.voteExtension(valid, invalid, expired)},
+			{BlockIdFlag: cmtproto.BlockIDFlagAbsent, VoteExtension: suite.voteExtension(absent)},
+			{BlockIdFlag: cmtproto.BlockIDFlagCommit, VoteExtension: []byte("invalid")},
+			{BlockIdFlag: cmtproto.BlockIDFlagCommit},
+		},
+	}
+	txs := [][]byte{[]byte("tx")}
+
+	// nothing is injected if vote extensions are disabled
+	res, err := suite.evidenceKeeper.InjectVoteExtensionEvidence(suite.ctx, commit, txs, 1<<20)
+	suite.Require().NoError(err)
+	suite.Require().Equal(txs, res)
+
+	res, err = suite.evidenceKeeper.InjectVoteExtensionEvidence(ctx, commit, txs, 1<<20)
+	suite.Require().NoError(err)
+	suite.Require().Len(res, 2)
+	suite.Require().Equal(txs[0], res[1])
+
+	// the injected evidence is reserved from the max tx bytes
+	maxTxBytes := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{res[0]})
+	bounded, err := suite.evidenceKeeper.InjectVoteExtensionEvidence(ctx, commit, txs, maxTxBytes)
+	suite.Require().NoError(err)
+	suite.Require().Equal(res[:1], bounded)
+
+	// the evidence exceeding the max tx bytes is not injected
+	bounded, err = suite.evidenceKeeper.InjectVoteExtensionEvidence(ctx, commit, txs, maxTxBytes-1)
+	suite.Require().NoError(err)
+	boundedEvidence, err := suite.evidenceKeeper.UnmarshalVoteExtensionEvidence(bounded[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal([]exported.Evidence{valid}, boundedEvidence)
+
+	injected, err := suite.evidenceKeeper.UnmarshalVoteExtensionEvidence(res[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal([]exported.Evidence{valid, invalid}, injected)
+
+	suite.Require().NoError(suite.evidenceKeeper.ValidateInjectedEvidence(ctx, res))
+	suite.Require().ErrorIs(suite.evidenceKeeper.ValidateInjectedEvidence(ctx, nil), types.ErrInvalidEvidence)
+	suite.Require().ErrorIs(suite.evidenceKeeper.ValidateInjectedEvidence(ctx, [][]byte{suite.voteExtension(valid, valid)}), types.ErrInvalidEvidence)
+
+	// the evidence rejected by its handler is ignored
+	suite.Require().NoError(suite.evidenceKeeper.SubmitInjectedEvidence(ctx, res))
+
+	has, err := suite.evidenceKeeper.Evidences.Has(ctx, valid.Hash())
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+
+	has, err = suite.evidenceKeeper.Evidences.Has(ctx, invalid.Hash())
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	// evidence submitted in a previous block is ignored
+	suite.Require().NoError(suite.evidenceKeeper.SubmitInjectedEvidence(ctx, res))
+}
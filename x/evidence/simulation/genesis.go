@@ -10,19 +10,29 @@ import (
 )
 
 // Simulation parameter constants
-const evidence = "evidence"
+const (
+	evidence        = "evidence"
+	maxAgeNumBlocks = "max_age_num_blocks"
+)
 
 // GenEvidences returns an empty slice of evidences.
 func GenEvidences(_ *rand.Rand, _ []simtypes.Account) []exported.Evidence {
 	return []exported.Evidence{}
 }
 
+// GenMaxAgeNumBlocks randomized MaxAgeNumBlocks
+func GenMaxAgeNumBlocks(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 100, 200000))
+}
+
 // RandomizedGenState generates a random GenesisState for evidence
 func RandomizedGenState(simState *module.SimulationState) {
 	var ev []exported.Evidence
-
 	simState.AppParams.GetOrGenerate(evidence, &ev, simState.Rand, func(r *rand.Rand) { ev = GenEvidences(r, simState.Accounts) })
 
-	evidenceGenesis := types.NewGenesisState(ev)
+	var maxAge int64
+	simState.AppParams.GetOrGenerate(maxAgeNumBlocks, &maxAge, simState.Rand, func(r *rand.Rand) { maxAge = GenMaxAgeNumBlocks(r) })
+
+	evidenceGenesis := types.NewGenesisState(types.NewParams(maxAge), ev)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(evidenceGenesis)
 }
@@ -42,4 +42,5 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &evidenceGenesis)
 
 	require.Len(t, evidenceGenesis.Evidence, 0)
+	require.Positive(t, evidenceGenesis.Params.MaxAgeNumBlocks)
 }
@@ -3,6 +3,12 @@ package types
 // evidence module events
 const (
 	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypeMisbehaviour   = "misbehaviour"
 
-	AttributeKeyEvidenceHash = "evidence_hash"
+	AttributeKeyEvidenceHash  = "evidence_hash"
+	AttributeKeyEvidenceRoute = "evidence_route"
+	AttributeKeyValidator     = "validator"
+	AttributeKeySlashFraction = "slash_fraction"
+	AttributeKeyJailedUntil   = "jailed_until"
+	AttributeKeyTombstoned    = "tombstoned"
 )
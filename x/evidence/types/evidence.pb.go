@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	any "github.com/cosmos/gogoproto/types/any"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -75,8 +76,105 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// Params defines the parameters of the evidence module.
+type Params struct {
+	// max_age_num_blocks is the maximum age, in blocks, of the evidence submitted
+	// through the evidence router. Older evidence is rejected, and the stored
+	// evidence is pruned once it gets older. Zero disables the expiry.
+	MaxAgeNumBlocks int64 `protobuf:"varint,1,opt,name=max_age_num_blocks,json=maxAgeNumBlocks,proto3" json:"max_age_num_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxAgeNumBlocks() int64 {
+	if m != nil {
+		return m.MaxAgeNumBlocks
+	}
+	return 0
+}
+
+// VoteExtensionEvidence is the evidence of misbehavior carried by a vote
+// extension, and injected in the block proposal.
+type VoteExtensionEvidence struct {
+	// evidence defines the evidence of misbehavior.
+	Evidence []*any.Any `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (m *VoteExtensionEvidence) Reset()         { *m = VoteExtensionEvidence{} }
+func (m *VoteExtensionEvidence) String() string { return proto.CompactTextString(m) }
+func (*VoteExtensionEvidence) ProtoMessage()    {}
+func (*VoteExtensionEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{2}
+}
+func (m *VoteExtensionEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteExtensionEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteExtensionEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteExtensionEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteExtensionEvidence.Merge(m, src)
+}
+func (m *VoteExtensionEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteExtensionEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteExtensionEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteExtensionEvidence proto.InternalMessageInfo
+
+func (m *VoteExtensionEvidence) GetEvidence() []*any.Any {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*Params)(nil), "cosmos.evidence.v1beta1.Params")
+	proto.RegisterType((*VoteExtensionEvidence)(nil), "cosmos.evidence.v1beta1.VoteExtensionEvidence")
 }
 
 func init() {
@@ -84,32 +182,91 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0x84, 0x0b, 0xe8, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x43, 0xd4, 0xe9,
-	0xc1, 0x85, 0xa1, 0xea, 0xa4, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0xad,
-	0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xe5, 0xd3, 0xf3, 0xf3,
-	0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2, 0x34, 0xfd, 0x92, 0xcc, 0xdc, 0xd4, 0xe2, 0x92,
-	0xc4, 0xdc, 0x02, 0xa8, 0x02, 0x49, 0x88, 0x15, 0xf1, 0x10, 0x9d, 0x50, 0xfb, 0xc0, 0x1c, 0xa5,
-	0x17, 0x8c, 0x5c, 0x3c, 0xae, 0x85, 0xa5, 0x99, 0x65, 0xf9, 0xc9, 0x89, 0x25, 0x99, 0xf9, 0x79,
-	0x42, 0x62, 0x5c, 0x6c, 0x19, 0xa9, 0x99, 0xe9, 0x19, 0x25, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc,
-	0x41, 0x50, 0x9e, 0x90, 0x2d, 0x17, 0x0b, 0xc8, 0x58, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x29, 0x3d, 0x88, 0x9d, 0x7a, 0x30, 0x3b, 0xf5, 0x42, 0x60, 0x76, 0x3a, 0xf1, 0x9e, 0xb8, 0x27,
-	0xcf, 0x30, 0xe1, 0xbe, 0x3c, 0xe3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xc0, 0xda, 0x84, 0x44,
-	0xb8, 0x58, 0x0b, 0xf2, 0xcb, 0x53, 0x8b, 0x24, 0x98, 0xc1, 0xa6, 0x42, 0x38, 0x42, 0xae, 0x5c,
-	0x82, 0xc9, 0xf9, 0x79, 0xc5, 0xa9, 0x79, 0xc5, 0xa5, 0xc5, 0xf1, 0x89, 0x29, 0x29, 0x45, 0xa9,
-	0xc5, 0xc5, 0x12, 0x2c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0x9d,
-	0xea, 0x08, 0x91, 0x09, 0x2e, 0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x12, 0x80, 0x6b, 0x81, 0x8a, 0x5b,
-	0xa9, 0x74, 0x2c, 0x90, 0x67, 0x78, 0xb1, 0x40, 0x9e, 0xa1, 0xeb, 0xf9, 0x06, 0x2d, 0x68, 0x78,
-	0xea, 0x16, 0xa7, 0x64, 0xeb, 0x23, 0xfb, 0xcc, 0xc9, 0x7b, 0xc5, 0x23, 0x39, 0xc6, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0x85, 0x86, 0x90, 0x3e, 0x92, 0x41, 0x15, 0x88, 0x68, 0x2c, 0xa9, 0x2c, 0x48,
-	0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xdc, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x73, 0xbd, 0x43, 0x8e,
-	0xe6, 0x01, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xbd, 0xa4, 0x44, 0xb0, 0x05, 0x41, 0xad, 0x00, 0x26, 0x42, 0xb6, 0x89, 0x2a, 0x14,
+	0x15, 0xc5, 0x56, 0xcb, 0xad, 0x12, 0x87, 0x58, 0xca, 0x09, 0x09, 0xa1, 0x80, 0x2a, 0xc4, 0xc5,
+	0x5a, 0x3b, 0xcb, 0x66, 0xd5, 0xee, 0x4e, 0xf0, 0xae, 0x43, 0xf2, 0x06, 0x88, 0x53, 0x1f, 0xa1,
+	0xc7, 0x1e, 0x7b, 0xe8, 0x43, 0x54, 0x9c, 0x2a, 0x4e, 0x9c, 0x00, 0x25, 0x87, 0xf6, 0x31, 0x50,
+	0xec, 0xb5, 0xa9, 0xf8, 0x73, 0xb1, 0x76, 0xbe, 0x99, 0xf9, 0xcd, 0xb7, 0x3b, 0xc6, 0x4f, 0x52,
+	0x50, 0x02, 0x54, 0x48, 0xa7, 0x7c, 0x44, 0x65, 0x4a, 0xc3, 0xe9, 0x76, 0x42, 0x35, 0xd9, 0xae,
+	0x85, 0x60, 0x92, 0x81, 0x06, 0xfb, 0x41, 0x59, 0x17, 0xd4, 0xb2, 0xa9, 0x6b, 0x6f, 0x10, 0xc1,
+	0x25, 0x84, 0xc5, 0xb7, 0xac, 0x6d, 0xb7, 0x18, 0x30, 0x28, 0x8e, 0xe1, 0xea, 0x64, 0xd4, 0x87,
+	0x0c, 0x80, 0x1d, 0xd0, 0xb0, 0x88, 0x92, 0xfc, 0x7d, 0x48, 0xe4, 0xdc, 0xa4, 0xbc, 0x3f, 0x53,
+	0x9a, 0x0b, 0xaa, 0x34, 0x11, 0x93, 0xaa, 0xb7, 0x9c, 0x1e, 0x97, 0x50, 0x63, 0xa5, 0x08, 0x3a,
+	0x97, 0x08, 0xdf, 0x1a, 0x7c, 0xc8, 0xf9, 0x14, 0x52, 0xa2, 0x39, 0x48, 0xfb, 0x3e, 0x6e, 0x8e,
+	0x29, 0x67, 0x63, 0xed, 0x20, 0x1f, 0x75, 0x1b, 0x43, 0x13, 0xd9, 0xcf, 0xf1, 0xda, 0x0a, 0xeb,
+	0x5c, 0xf3, 0x51, 0x77, 0x7d, 0xa7, 0x1d, 0x94, 0x33, 0x83, 0x6a, 0x66, 0xf0, 0xa6, 0x9a, 0x19,
+	0xdd, 0x3e, 0xfb, 0xee, 0x59, 0x87, 0x3f, 0x3c, 0x74, 0x7c, 0x71, 0xb2, 0x85, 0x86, 0x45, 0x9b,
+	0xdd, 0xc2, 0xd7, 0x27, 0xf0, 0x91, 0x66, 0x4e, 0xa3, 0xa0, 0x96, 0x81, 0x3d, 0xc0, 0x1b, 0x29,
+	0x48, 0x45, 0xa5, 0xca, 0x55, 0x4c, 0x46, 0xa3, 0x8c, 0x2a, 0xe5, 0xac, 0xf9, 0xa8, 0x7b, 0x33,
+	0x72, 0xbe, 0x9e, 0xf6, 0x5a, 0xc6, 0x6a, 0xbf, 0xcc, 0xbc, 0xd6, 0x19, 0x97, 0x6c, 0x78, 0xb7,
+	0x6e, 0x31, 0xfa, 0xee, 0xe6, 0xa7, 0x23, 0xcf, 0xba, 0x3c, 0xf2, 0xac, 0xcf, 0x17, 0x27, 0x5b,
+	0xe6, 0xa9, 0x7b, 0x6a, 0xb4, 0x1f, 0x5e, 0xbd, 0x59, 0xe7, 0x2d, 0x6e, 0xbe, 0x22, 0x19, 0x11,
+	0xca, 0x7e, 0x8a, 0x6d, 0x41, 0x66, 0x31, 0x61, 0x34, 0x96, 0xb9, 0x88, 0x93, 0x03, 0x48, 0xf7,
+	0x95, 0xb9, 0xef, 0x1d, 0x41, 0x66, 0x7d, 0x46, 0x5f, 0xe6, 0x22, 0x2a, 0xe4, 0xdd, 0xc7, 0x2b,
+	0xe8, 0xa3, 0x2b, 0xd0, 0xd9, 0xef, 0x6d, 0x97, 0xbc, 0x0e, 0xe0, 0x7b, 0x7b, 0xa0, 0xe9, 0x60,
+	0xa6, 0xa9, 0x54, 0x1c, 0xe4, 0xc0, 0xe4, 0xed, 0x3d, 0x7c, 0xa3, 0xaa, 0x75, 0x90, 0xdf, 0xe8,
+	0xae, 0xef, 0xb4, 0xfe, 0x7a, 0xb8, 0xbe, 0x9c, 0x47, 0x9b, 0x5f, 0x4e, 0x7b, 0xfe, 0x7f, 0x7e,
+	0x91, 0xa0, 0xa2, 0x0d, 0x6b, 0x56, 0xf4, 0xe2, 0x78, 0xe1, 0xa2, 0xb3, 0x85, 0x8b, 0xce, 0x17,
+	0x2e, 0xfa, 0xb9, 0x70, 0xd1, 0xe1, 0xd2, 0xb5, 0xce, 0x97, 0xae, 0xf5, 0x6d, 0xe9, 0x5a, 0xef,
+	0x7a, 0x8c, 0xeb, 0x71, 0x9e, 0x04, 0x29, 0x08, 0xb3, 0xec, 0xf0, 0xdf, 0xf6, 0xf5, 0x7c, 0x42,
+	0x55, 0xd2, 0x2c, 0xac, 0x3c, 0xfb, 0x35, 0x00, 0xe2, 0xce, 0xc5, 0xa0, 0xcc, 0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxAgeNumBlocks != that1.MaxAgeNumBlocks {
+		return false
+	}
+	return true
 }
+func (this *VoteExtensionEvidence) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*VoteExtensionEvidence)
+	if !ok {
+		that2, ok := that.(VoteExtensionEvidence)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Evidence) != len(that1.Evidence) {
+		return false
+	}
+	for i := range this.Evidence {
+		if !this.Evidence[i].Equal(that1.Evidence[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -158,6 +315,71 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAgeNumBlocks != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.MaxAgeNumBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteExtensionEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteExtensionEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteExtensionEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvidence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -190,6 +412,33 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAgeNumBlocks != 0 {
+		n += 1 + sovEvidence(uint64(m.MaxAgeNumBlocks))
+	}
+	return n
+}
+
+func (m *VoteExtensionEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovEvidence(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -349,6 +598,159 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeNumBlocks", wireType)
+			}
+			m.MaxAgeNumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeNumBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteExtensionEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteExtensionEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteExtensionEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, &any.Any{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
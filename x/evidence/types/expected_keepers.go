@@ -20,6 +20,8 @@ type (
 		ValidatorByConsAddr(context.Context, sdk.ConsAddress) (stakingtypes.ValidatorI, error)
 		ValidatorByHistoricalConsAddr(context.Context, sdk.ConsAddress) (stakingtypes.Validator, error)
 		GetParams(ctx context.Context) (params stakingtypes.Params, err error)
+		GetHistoricalInfo(ctx context.Context, height int64) (stakingtypes.HistoricalInfo, error)
+		PowerReduction(ctx context.Context) math.Int
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -43,11 +43,11 @@ func TestNewGenesisState(t *testing.T) {
 
 			if tc.expPass {
 				require.NotPanics(t, func() {
-					types.NewGenesisState(evidence)
+					types.NewGenesisState(types.DefaultParams(), evidence)
 				})
 			} else {
 				require.Panics(t, func() {
-					types.NewGenesisState(evidence)
+					types.NewGenesisState(types.DefaultParams(), evidence)
 				})
 			}
 		})
@@ -78,7 +78,7 @@ func TestGenesisStateValidate(t *testing.T) {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence)
 			},
 			true,
 		},
@@ -94,7 +94,7 @@ func TestGenesisStateValidate(t *testing.T) {
 						ConsensusAddress: pk.PubKey().Address().String(),
 					}
 				}
-				genesisState = types.NewGenesisState(testEvidence)
+				genesisState = types.NewGenesisState(types.DefaultParams(), testEvidence)
 			},
 			false,
 		},
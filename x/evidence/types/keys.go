@@ -13,4 +13,8 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixEvidence = collections.NewPrefix(0)
+	ParamsKey         = collections.NewPrefix(1)
+	// KeyPrefixEvidenceByHeight indexes the evidence submitted through the
+	// evidence router by infraction height, to expire it.
+	KeyPrefixEvidenceByHeight = collections.NewPrefix(2)
 )
@@ -1,9 +1,51 @@
 package types
 
 import (
+	"fmt"
 	"time"
 )
 
 // DoubleSignJailEndTime period ends at Max Time supported by Amino
 // (Dec 31, 9999 - 23:59:59 GMT).
 var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
+// DefaultMaxAgeNumBlocks is the default maximum age, in blocks, of the evidence
+// submitted through the evidence router. It matches the CometBFT default.
+const DefaultMaxAgeNumBlocks int64 = 100000
+
+const (
+	// MaxVoteExtensionEvidence is the maximum number of evidence in a vote
+	// extension.
+	MaxVoteExtensionEvidence = 16
+
+	// MaxVoteExtensionEvidenceBytes is the maximum size of the evidence of a
+	// vote extension.
+	MaxVoteExtensionEvidenceBytes = 16 * 1024
+)
+
+// NewParams creates a new Params object.
+func NewParams(maxAgeNumBlocks int64) Params {
+	return Params{
+		MaxAgeNumBlocks: maxAgeNumBlocks,
+	}
+}
+
+// DefaultParams returns the default evidence module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxAgeNumBlocks)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if p.MaxAgeNumBlocks < 0 {
+		return fmt.Errorf("max age num blocks must not be negative: %d", p.MaxAgeNumBlocks)
+	}
+
+	return nil
+}
+
+// IsExpired returns true if evidence of the given height is too old at the
+// given block height.
+func (p Params) IsExpired(evidenceHeight, blockHeight int64) bool {
+	return p.MaxAgeNumBlocks > 0 && blockHeight-evidenceHeight > p.MaxAgeNumBlocks
+}
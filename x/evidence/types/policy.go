@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// SlashingPolicy defines the punishment of the validator committing an
+// app-defined misbehaviour, e.g. misreporting oracle prices or double-signing
+// a vote extension.
+type SlashingPolicy struct {
+	// SlashFraction is the fraction of the stake of the validator slashed at the
+	// infraction height. A nil or zero fraction does not slash.
+	SlashFraction math.LegacyDec
+	// JailDuration is the duration the validator is jailed for, from the block
+	// time of the submission. Zero does not jail.
+	JailDuration time.Duration
+	// Tombstone jails the validator forever and prevents it from being punished
+	// again.
+	Tombstone bool
+}
+
+// Validate validates the slashing policy.
+func (p SlashingPolicy) Validate() error {
+	if !p.SlashFraction.IsNil() && (p.SlashFraction.IsNegative() || p.SlashFraction.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", p.SlashFraction)
+	}
+
+	if p.JailDuration < 0 {
+		return fmt.Errorf("jail duration must not be negative: %s", p.JailDuration)
+	}
+
+	return nil
+}
+
+// Slashes returns true if the policy slashes the validator.
+func (p SlashingPolicy) Slashes() bool {
+	return !p.SlashFraction.IsNil() && p.SlashFraction.IsPositive()
+}
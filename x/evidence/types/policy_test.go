@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+)
+
+func TestSlashingPolicyValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy types.SlashingPolicy
+		expErr string
+	}{
+		{"empty", types.SlashingPolicy{}, ""},
+		{"valid", types.SlashingPolicy{SlashFraction: math.LegacyNewDecWithPrec(5, 2), JailDuration: time.Hour, Tombstone: true}, ""},
+		{"full slash", types.SlashingPolicy{SlashFraction: math.LegacyOneDec()}, ""},
+		{"negative slash fraction", types.SlashingPolicy{SlashFraction: math.LegacyNewDec(-1)}, "slash fraction must be between 0 and 1"},
+		{"slash fraction above one", types.SlashingPolicy{SlashFraction: math.LegacyNewDecWithPrec(11, 1)}, "slash fraction must be between 0 and 1"},
+		{"negative jail duration", types.SlashingPolicy{JailDuration: -time.Second}, "jail duration must not be negative"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+
+	require.False(t, types.SlashingPolicy{}.Slashes())
+	require.False(t, types.SlashingPolicy{SlashFraction: math.LegacyZeroDec()}.Slashes())
+	require.True(t, types.SlashingPolicy{SlashFraction: math.LegacyNewDecWithPrec(1, 2)}.Slashes())
+}
+
+func TestParamsIsExpired(t *testing.T) {
+	params := types.NewParams(10)
+	require.False(t, params.IsExpired(10, 20))
+	require.True(t, params.IsExpired(9, 20))
+
+	// evidence never expires if MaxAgeNumBlocks is zero
+	require.False(t, types.NewParams(0).IsExpired(1, 1000))
+
+	require.NoError(t, types.DefaultParams().Validate())
+	require.Error(t, types.NewParams(-1).Validate())
+}
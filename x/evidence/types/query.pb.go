@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
@@ -237,11 +239,96 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evidence.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evidence.v1beta1.QueryParamsResponse")
 }
 
 func init() {
@@ -249,36 +336,42 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4d, 0x8b, 0x13, 0x31,
-	0x18, 0xc7, 0x9b, 0xf1, 0x85, 0x35, 0xbb, 0x22, 0xc4, 0x95, 0xad, 0x83, 0x0c, 0x75, 0x16, 0x6c,
-	0x5d, 0x98, 0x64, 0xba, 0x0a, 0x9e, 0xb7, 0xa0, 0xab, 0x37, 0x9d, 0xa3, 0x97, 0x25, 0xd3, 0x8d,
-	0x33, 0x83, 0x6d, 0x32, 0x6d, 0x66, 0x8a, 0x45, 0xbc, 0xf8, 0x09, 0x04, 0xf1, 0x24, 0x7e, 0x0b,
-	0xbf, 0x82, 0xe0, 0xb1, 0xe0, 0x45, 0x3c, 0x49, 0xeb, 0x07, 0x91, 0xe6, 0xa5, 0xad, 0x7d, 0xb1,
-	0x7a, 0x4c, 0xf2, 0xfc, 0xff, 0xff, 0x5f, 0x9e, 0xe7, 0x81, 0x87, 0x6d, 0x21, 0xbb, 0x42, 0x12,
-	0x36, 0xc8, 0xce, 0x19, 0x6f, 0x33, 0x32, 0x68, 0xc6, 0xac, 0xa0, 0x4d, 0xd2, 0x2b, 0x59, 0x7f,
-	0x88, 0xf3, 0xbe, 0x28, 0x04, 0x3a, 0xd0, 0x45, 0xd8, 0x16, 0x61, 0x53, 0xe4, 0x1e, 0x19, 0x75,
-	0x4c, 0x25, 0xd3, 0x8a, 0x99, 0x3e, 0xa7, 0x49, 0xc6, 0x69, 0x91, 0x09, 0xae, 0x4d, 0xdc, 0x9b,
-	0x89, 0x10, 0x49, 0x87, 0x11, 0x75, 0x8a, 0xcb, 0x17, 0x84, 0x72, 0xe3, 0xef, 0xde, 0x32, 0x4f,
-	0x34, 0xcf, 0x08, 0xe5, 0x5c, 0x14, 0x4a, 0x27, 0xad, 0x50, 0x87, 0x9c, 0xa9, 0x13, 0x31, 0x28,
-	0xea, 0xe0, 0xa7, 0x70, 0xff, 0xd9, 0x34, 0xf5, 0xa1, 0x01, 0x8b, 0x58, 0xaf, 0x64, 0xb2, 0x40,
-	0x75, 0x78, 0xd5, 0xb2, 0x9e, 0xa5, 0x54, 0xa6, 0x55, 0x50, 0x03, 0x8d, 0xbd, 0x96, 0x53, 0x05,
-	0xd1, 0x9e, 0x7d, 0x78, 0x4c, 0x65, 0x8a, 0xea, 0xf0, 0xa2, 0x7a, 0x77, 0x6a, 0xa0, 0x71, 0xa5,
-	0x75, 0xfd, 0xc7, 0xe7, 0xe0, 0x9a, 0x0e, 0x08, 0xe4, 0xf9, 0xcb, 0x5a, 0x88, 0xef, 0x3f, 0x88,
-	0x54, 0x81, 0xff, 0x04, 0xde, 0x58, 0x4a, 0x92, 0xb9, 0xe0, 0x92, 0xa1, 0x10, 0xee, 0x58, 0x47,
-	0x95, 0xb2, 0x7b, 0xbc, 0x8f, 0xf5, 0x77, 0xb0, 0xfd, 0x29, 0x3e, 0xe1, 0xc3, 0x68, 0x56, 0xe5,
-	0x53, 0x78, 0xa0, 0xac, 0x4e, 0x3a, 0x9d, 0x65, 0xee, 0x47, 0x10, 0xce, 0xfb, 0x66, 0xec, 0xee,
-	0x60, 0xf3, 0xe5, 0x69, 0x93, 0xb1, 0x1e, 0x8b, 0x69, 0x32, 0x7e, 0x4a, 0x13, 0xab, 0x8d, 0x16,
-	0x94, 0xfe, 0x07, 0x00, 0xab, 0xab, 0x19, 0x6b, 0x89, 0x2f, 0x6c, 0x27, 0x46, 0xa7, 0x7f, 0x60,
-	0x39, 0x0a, 0xab, 0xbe, 0x15, 0x4b, 0xc7, 0x2d, 0x72, 0x1d, 0x7f, 0x71, 0xe0, 0x25, 0xc5, 0x85,
-	0x3e, 0x02, 0xb8, 0x63, 0xc9, 0x50, 0x80, 0x37, 0x2c, 0x18, 0x5e, 0x37, 0x5d, 0x17, 0xff, 0x6b,
-	0xb9, 0x26, 0xf0, 0xc3, 0xb7, 0xdf, 0x7e, 0xbd, 0x77, 0x8e, 0x50, 0x83, 0x6c, 0x5a, 0xf6, 0xd9,
-	0xc5, 0xeb, 0xe9, 0xb0, 0xdf, 0xa0, 0x4f, 0x00, 0xee, 0x2e, 0xb4, 0x0e, 0x85, 0x7f, 0x4f, 0x5c,
-	0x9d, 0xa4, 0xdb, 0xfc, 0x0f, 0x85, 0xc1, 0xbc, 0xab, 0x30, 0x0f, 0xd1, 0xed, 0xad, 0x98, 0xad,
-	0xd3, 0xaf, 0x63, 0x0f, 0x8c, 0xc6, 0x1e, 0xf8, 0x39, 0xf6, 0xc0, 0xbb, 0x89, 0x57, 0x19, 0x4d,
-	0xbc, 0xca, 0xf7, 0x89, 0x57, 0x79, 0x1e, 0x24, 0x59, 0x91, 0x96, 0x31, 0x6e, 0x8b, 0xae, 0xb5,
-	0x99, 0x2f, 0x34, 0x79, 0x35, 0xf7, 0x2c, 0x86, 0x39, 0x93, 0xf1, 0x65, 0x35, 0xf1, 0x7b, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xea, 0x6b, 0xf3, 0x30, 0x07, 0x04, 0x00, 0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6f, 0x12, 0x41,
+	0x18, 0xc6, 0x19, 0xaa, 0xa4, 0x9d, 0xd6, 0x18, 0xa7, 0x98, 0xe2, 0xc6, 0x2c, 0x74, 0x9b, 0x14,
+	0x44, 0x99, 0x81, 0x6a, 0xe2, 0xb9, 0x9b, 0x68, 0xf5, 0x56, 0xf7, 0xa6, 0x97, 0x66, 0x96, 0x8e,
+	0xbb, 0x1b, 0x61, 0x67, 0xcb, 0x2c, 0x8d, 0xc4, 0x78, 0xf1, 0xec, 0xc1, 0xc4, 0x78, 0x32, 0xde,
+	0x3d, 0x7a, 0xf0, 0x43, 0xf4, 0xd8, 0x44, 0x0f, 0xc6, 0x83, 0x31, 0x60, 0xe2, 0xd7, 0x30, 0xcc,
+	0x1f, 0x28, 0x6d, 0x29, 0x78, 0x21, 0xcc, 0xcc, 0xf3, 0x3c, 0xef, 0x8f, 0xf7, 0x7d, 0x81, 0x1b,
+	0x4d, 0x2e, 0xda, 0x5c, 0x10, 0x76, 0x18, 0xed, 0xb3, 0xb8, 0xc9, 0xc8, 0x61, 0xc3, 0x67, 0x29,
+	0x6d, 0x90, 0x83, 0x2e, 0xeb, 0xf4, 0x70, 0xd2, 0xe1, 0x29, 0x47, 0x6b, 0x4a, 0x84, 0x8d, 0x08,
+	0x6b, 0x91, 0x55, 0xd5, 0x6e, 0x9f, 0x0a, 0xa6, 0x1c, 0x23, 0x7f, 0x42, 0x83, 0x28, 0xa6, 0x69,
+	0xc4, 0x63, 0x15, 0x62, 0xdd, 0x08, 0x38, 0x0f, 0x5a, 0x8c, 0xc8, 0x93, 0xdf, 0x7d, 0x4e, 0x68,
+	0xac, 0xf3, 0xad, 0x9b, 0xfa, 0x89, 0x26, 0x11, 0xa1, 0x71, 0xcc, 0x53, 0xe9, 0x13, 0xc6, 0xa8,
+	0x8a, 0xec, 0xc9, 0x13, 0xd1, 0x28, 0xea, 0x69, 0x73, 0x1a, 0xfd, 0x88, 0x54, 0xe9, 0xf2, 0x01,
+	0x0f, 0xb8, 0xf2, 0x0f, 0xbf, 0xe9, 0xdb, 0x6b, 0xb4, 0x1d, 0xc5, 0x9c, 0xc8, 0x4f, 0x75, 0xe5,
+	0x84, 0x30, 0xff, 0x64, 0xf8, 0x33, 0x1e, 0x68, 0xbf, 0xc7, 0x0e, 0xba, 0x4c, 0xa4, 0xa8, 0x0c,
+	0xaf, 0x98, 0xc8, 0xbd, 0x90, 0x8a, 0xb0, 0x00, 0x4a, 0xa0, 0xb2, 0xe2, 0x66, 0x0b, 0xc0, 0x5b,
+	0x31, 0x0f, 0x8f, 0xa8, 0x08, 0x51, 0x19, 0x5e, 0x92, 0xef, 0xd9, 0x12, 0xa8, 0x2c, 0xb9, 0xab,
+	0x3f, 0xbf, 0xd6, 0xae, 0x2a, 0xc6, 0x9a, 0xd8, 0x7f, 0x51, 0xaa, 0xe3, 0x7b, 0xf7, 0x3d, 0x29,
+	0x70, 0x1e, 0xc3, 0xeb, 0xa7, 0x2a, 0x89, 0x84, 0xc7, 0x82, 0xa1, 0x3a, 0x5c, 0x34, 0x89, 0xb2,
+	0xca, 0xf2, 0x56, 0x1e, 0xab, 0xfe, 0x60, 0xd3, 0x3a, 0xbc, 0x1d, 0xf7, 0xbc, 0x91, 0xca, 0xa1,
+	0x70, 0x4d, 0x46, 0x6d, 0xb7, 0x5a, 0xa7, 0xb9, 0x1f, 0x42, 0x38, 0x1e, 0x84, 0x8e, 0xdb, 0xc4,
+	0xba, 0x87, 0xc3, 0xa9, 0x61, 0x35, 0x67, 0xdd, 0x37, 0xbc, 0x4b, 0x03, 0xe3, 0xf5, 0x4e, 0x38,
+	0x9d, 0x0f, 0x00, 0x16, 0xce, 0xd6, 0x38, 0x97, 0x78, 0x61, 0x36, 0x31, 0xda, 0x99, 0xc0, 0xca,
+	0x4a, 0xac, 0xf2, 0x4c, 0x2c, 0x55, 0x6e, 0x82, 0x2b, 0x0f, 0x91, 0xc4, 0xda, 0xa5, 0x1d, 0xda,
+	0x16, 0x9a, 0xdc, 0x79, 0x0a, 0x57, 0x27, 0x6e, 0x35, 0xa7, 0x0b, 0x73, 0x89, 0xbc, 0xd1, 0x8d,
+	0x28, 0xe2, 0x29, 0x7b, 0x8d, 0x95, 0xd1, 0x5d, 0x3a, 0xfa, 0x55, 0xcc, 0x7c, 0xfe, 0xfb, 0xa5,
+	0x0a, 0x3c, 0xed, 0xdc, 0xfa, 0xbe, 0x00, 0x2f, 0xcb, 0x6c, 0xf4, 0x11, 0xc0, 0x45, 0xd3, 0x0a,
+	0x54, 0x9b, 0x1a, 0x75, 0xde, 0x3a, 0x59, 0x78, 0x5e, 0xb9, 0x22, 0x77, 0xea, 0x6f, 0xbe, 0xfd,
+	0x79, 0x9f, 0xad, 0xa2, 0x0a, 0x99, 0xb5, 0xf0, 0xe4, 0xd5, 0x70, 0xbb, 0x5e, 0xa3, 0x4f, 0x00,
+	0x2e, 0x9f, 0x98, 0x15, 0xaa, 0x5f, 0x5c, 0xf1, 0xec, 0xea, 0x58, 0x8d, 0xff, 0x70, 0x68, 0xcc,
+	0x5b, 0x12, 0x73, 0x03, 0xad, 0xcf, 0xc4, 0x44, 0x6f, 0x01, 0xcc, 0xa9, 0x2e, 0xa3, 0xdb, 0x17,
+	0x17, 0x9a, 0x18, 0xad, 0x75, 0x67, 0x3e, 0xb1, 0x06, 0x2a, 0x4b, 0xa0, 0x75, 0x54, 0x9c, 0x0a,
+	0xa4, 0xc6, 0xea, 0xee, 0x1c, 0xf5, 0x6d, 0x70, 0xdc, 0xb7, 0xc1, 0xef, 0xbe, 0x0d, 0xde, 0x0d,
+	0xec, 0xcc, 0xf1, 0xc0, 0xce, 0xfc, 0x18, 0xd8, 0x99, 0x67, 0xb5, 0x20, 0x4a, 0xc3, 0xae, 0x8f,
+	0x9b, 0xbc, 0x6d, 0x42, 0xc6, 0x7f, 0x68, 0xf2, 0x72, 0x9c, 0x98, 0xf6, 0x12, 0x26, 0xfc, 0x9c,
+	0xdc, 0xf8, 0xbb, 0xff, 0x06, 0x00, 0xe8, 0xee, 0xe4, 0x02, 0x58, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -297,6 +390,8 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of the evidence module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -325,12 +420,23 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of the evidence module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -343,6 +449,9 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -384,6 +493,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
@@ -397,6 +524,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -558,6 +689,62 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -631,6 +818,26 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1045,6 +1252,139 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,58 @@
+package feeabs
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	feeabsv1 "cosmossdk.io/api/cosmos/feeabs/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: feeabsv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current feeabs parameters",
+				},
+				{
+					RpcMethod: "Rates",
+					Use:       "rates",
+					Short:     "Query the effective rates of the whitelisted fee denoms",
+				},
+				{
+					RpcMethod:      "ConvertFee",
+					Use:            "convert-fee [fee]",
+					Short:          "Query the native denom value of a fee",
+					Example:        fmt.Sprintf(`%s query feeabs convert-fee 100uatom,20stake`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "fee"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: feeabsv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateRates",
+					Use:       "update-rates --rates [rates]",
+					Short:     "Update the rates of the oracle fee denoms, must be signed by the oracle account",
+					Example:   fmt.Sprintf(`%s tx feeabs update-rates --rates '{"denom":"uatom","rate":"2.5"}' --from oracle`, version.AppName),
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update feeabs module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update feeabs module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query feeabs params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx feeabs update-params-proposal '{ "native_denom": "stake", "fee_denoms": [ ... ], "oracle": "cosmos1..." }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+)
+
+var _ ante.TxFeeChecker = Keeper{}.CheckTxFee
+
+// CheckTxFee implements ante.TxFeeChecker. It behaves like the default fee
+// checker, except that the fees paid in whitelisted fee denoms are converted to
+// the native denom before being checked against the validator minimum gas
+// prices. The tx priority is computed from the converted fees, so that fees of
+// the same value get the same priority whatever their denom.
+//
+// The fees are not converted in the returned coins, they are deducted in the
+// denoms they were paid in.
+func (k Keeper) CheckTxFee(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	converted, err := k.ConvertToNative(ctx, feeCoins)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Ensure that the provided fees meet a minimum threshold for the validator,
+	// if this is a CheckTx. This is only for local mempool purposes, and thus
+	// is only ran on check tx.
+	if ctx.IsCheckTx() {
+		minGasPrices := ctx.MinGasPrices()
+		if !minGasPrices.IsZero() {
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+
+			// Determine the required fees by multiplying each required minimum gas
+			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+			glDec := sdkmath.LegacyNewDec(int64(gas))
+			for i, gp := range minGasPrices {
+				fee := gp.Amount.Mul(glDec)
+				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+			}
+
+			// a min gas price may be set in a whitelisted fee denom, in which
+			// case the fees are accepted as they are.
+			if !feeCoins.IsAnyGTE(requiredFees) && !converted.IsAnyGTE(requiredFees) {
+				return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s (%s in native denom) required: %s", feeCoins, converted, requiredFees)
+			}
+		}
+	}
+
+	priority := getTxPriority(converted, int64(gas))
+	return feeCoins, priority, nil
+}
+
+// getTxPriority returns the smallest gas price of the given fees, see the
+// default fee checker of x/auth/ante.
+func getTxPriority(fee sdk.Coins, gas int64) int64 {
+	var priority int64
+	for _, c := range fee {
+		p := int64(math.MaxInt64)
+		gasPrice := c.Amount.QuoRaw(gas)
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return priority
+}
+
+// FeeConversionHook returns an ante.FeeConversionHook swapping the fees
+// collected in whitelisted fee denoms to the native denom through the given
+// swapper. Each swap must return at least the value of the fee at the current
+// rate, fees in other denoms are left untouched.
+func (k Keeper) FeeConversionHook(swapper types.FeeSwapper) ante.FeeConversionHook {
+	return func(ctx sdk.Context, feeRecipientModule string, fees sdk.Coins) error {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, fee := range fees {
+			rate, ok, err := k.GetRate(ctx, params, fee.Denom)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			minOut := sdk.NewCoin(params.NativeDenom, rate.MulInt(fee.Amount).TruncateInt())
+			if err := swapper.SwapToNative(ctx, feeRecipientModule, fee, minOut); err != nil {
+				return errorsmod.Wrapf(err, "failed to swap %s to %s", fee, params.NativeDenom)
+			}
+		}
+
+		return nil
+	}
+}
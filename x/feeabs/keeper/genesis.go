@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+)
+
+// InitGenesis initializes the feeabs module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	for _, rate := range gs.OracleRates {
+		if err := k.OracleRates.Set(ctx, rate.Denom, rate.Rate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the feeabs module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := []types.DenomRate{}
+	err = k.OracleRates.Walk(ctx, nil, func(denom string, rate sdkmath.LegacyDec) (bool, error) {
+		rates = append(rates, types.DenomRate{Denom: denom, Rate: rate})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		Params:      params,
+		OracleRates: rates,
+	}, nil
+}
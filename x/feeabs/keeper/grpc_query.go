@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the x/feeabs QueryServer interface.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the feeabs module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Rates returns the effective rates of the whitelisted fee denoms.
+func (q queryServer) Rates(ctx context.Context, _ *types.QueryRatesRequest) (*types.QueryRatesResponse, error) {
+	rates, err := q.k.GetRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRatesResponse{Rates: rates}, nil
+}
+
+// ConvertFee returns the native denom value of a fee.
+func (q queryServer) ConvertFee(ctx context.Context, req *types.QueryConvertFeeRequest) (*types.QueryConvertFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fee, err := sdk.ParseCoinsNormalized(req.Fee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	converted, err := q.k.ConvertToNative(ctx, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryConvertFeeResponse{Fee: converted}, nil
+}
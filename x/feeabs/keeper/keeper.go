@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log/v2"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+)
+
+// Keeper of the feeabs store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService corestoretypes.KVStoreService
+	addressCodec address.Codec
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// OracleRates holds the last rates submitted by the oracle, by denom.
+	OracleRates collections.Map[string, sdkmath.LegacyDec]
+}
+
+// NewKeeper creates a new feeabs Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService corestoretypes.KVStoreService,
+	ak types.AccountKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		addressCodec: ak.AddressCodec(),
+		authority:    authority,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		OracleRates:  collections.NewMap(sb, types.OracleRatesKey, "oracle_rates", collections.StringKey, sdk.LegacyDecValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/feeabs module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the params, and removes the oracle rates of the denoms which
+// are no longer oracle fee denoms.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	if params.Oracle != "" {
+		if _, err := k.addressCodec.StringToBytes(params.Oracle); err != nil {
+			return fmt.Errorf("invalid oracle address: %w", err)
+		}
+	}
+
+	if err := k.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	var stale []string
+	err := k.OracleRates.Walk(ctx, nil, func(denom string, _ sdkmath.LegacyDec) (bool, error) {
+		if fd, ok := params.FeeDenom(denom); !ok || fd.Source != types.RateSourceOracle {
+			stale = append(stale, denom)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, denom := range stale {
+		if err := k.OracleRates.Remove(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetRate returns the amount of native denom one unit of the given fee denom is
+// worth. It returns false if the denom is not whitelisted, or if it is an
+// oracle fee denom for which the oracle has not submitted any rate yet.
+func (k Keeper) GetRate(ctx context.Context, params types.Params, denom string) (sdkmath.LegacyDec, bool, error) {
+	fd, ok := params.FeeDenom(denom)
+	if !ok {
+		return sdkmath.LegacyDec{}, false, nil
+	}
+
+	if fd.Source == types.RateSourceStatic {
+		return fd.StaticRate, true, nil
+	}
+
+	rate, err := k.OracleRates.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdkmath.LegacyDec{}, false, nil
+	} else if err != nil {
+		return sdkmath.LegacyDec{}, false, err
+	}
+
+	return rate, true, nil
+}
+
+// GetRates returns the effective rates of all the whitelisted fee denoms which
+// currently have one.
+func (k Keeper) GetRates(ctx context.Context) ([]types.DenomRate, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make([]types.DenomRate, 0, len(params.FeeDenoms))
+	for _, fd := range params.FeeDenoms {
+		rate, ok, err := k.GetRate(ctx, params, fd.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			rates = append(rates, types.DenomRate{Denom: fd.Denom, Rate: rate})
+		}
+	}
+
+	return rates, nil
+}
+
+// UpdateRates sets the rates of oracle fee denoms, the signer must be the
+// oracle set in the params.
+func (k Keeper) UpdateRates(ctx context.Context, oracle string, rates []types.DenomRate) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.Oracle == "" || params.Oracle != oracle {
+		return types.ErrInvalidOracle.Wrapf("expected %q, got %q", params.Oracle, oracle)
+	}
+
+	for _, rate := range rates {
+		if err := rate.Validate(); err != nil {
+			return types.ErrInvalidRate.Wrap(err.Error())
+		}
+
+		fd, ok := params.FeeDenom(rate.Denom)
+		if !ok || fd.Source != types.RateSourceOracle {
+			return types.ErrUnknownFeeDenom.Wrapf("%s is not an oracle fee denom", rate.Denom)
+		}
+
+		if err := k.OracleRates.Set(ctx, rate.Denom, rate.Rate); err != nil {
+			return err
+		}
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventRatesUpdated{
+		Oracle: oracle,
+		Rates:  rates,
+	})
+}
+
+// ConvertToNative returns the given fees where the coins of the whitelisted fee
+// denoms are replaced by their value in the native denom, rounded down. Coins
+// of other denoms are kept as is.
+func (k Keeper) ConvertToNative(ctx context.Context, fees sdk.Coins) (sdk.Coins, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	converted := sdk.NewCoins()
+	for _, fee := range fees {
+		rate, ok, err := k.GetRate(ctx, params, fee.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			converted = converted.Add(fee)
+			continue
+		}
+
+		converted = converted.Add(sdk.NewCoin(params.NativeDenom, rate.MulInt(fee.Amount).TruncateInt()))
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,284 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/keeper"
+	feeabstestutil "github.com/cosmos/cosmos-sdk/x/feeabs/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	oracle = sdk.AccAddress("oracle______________")
+	other  = sdk.AccAddress("other_______________")
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	txConfig    client.TxConfig
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	ctrl        *gomock.Controller
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feeabs.AppModule{})
+	s.txConfig = encCfg.TxConfig
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	s.ctrl = gomock.NewController(s.T())
+	accountKeeper := feeabstestutil.NewMockAccountKeeper(s.ctrl)
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	s.keeper = keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		accountKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	// uatom is worth 2 stake, uosmo is priced by the oracle
+	gs := types.DefaultGenesis()
+	gs.Params.FeeDenoms = []types.FeeDenom{
+		{Denom: "uatom", Source: types.RateSourceStatic, StaticRate: math.LegacyNewDec(2)},
+		{Denom: "uosmo", Source: types.RateSourceOracle},
+	}
+	gs.Params.Oracle = oracle.String()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, *gs))
+}
+
+func (s *KeeperTestSuite) newFeeTx(fee sdk.Coins, gas uint64) sdk.Tx {
+	txBuilder := s.txConfig.NewTxBuilder()
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(gas)
+	return txBuilder.GetTx()
+}
+
+func (s *KeeperTestSuite) TestUpdateRates() {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateRates
+		expErr error
+	}{
+		{
+			name:   "not the oracle",
+			msg:    &types.MsgUpdateRates{Oracle: other.String(), Rates: []types.DenomRate{{Denom: "uosmo", Rate: math.LegacyOneDec()}}},
+			expErr: types.ErrInvalidOracle,
+		},
+		{
+			name:   "static fee denom",
+			msg:    &types.MsgUpdateRates{Oracle: oracle.String(), Rates: []types.DenomRate{{Denom: "uatom", Rate: math.LegacyOneDec()}}},
+			expErr: types.ErrUnknownFeeDenom,
+		},
+		{
+			name:   "unknown fee denom",
+			msg:    &types.MsgUpdateRates{Oracle: oracle.String(), Rates: []types.DenomRate{{Denom: "ujuno", Rate: math.LegacyOneDec()}}},
+			expErr: types.ErrUnknownFeeDenom,
+		},
+		{
+			name:   "zero rate",
+			msg:    &types.MsgUpdateRates{Oracle: oracle.String(), Rates: []types.DenomRate{{Denom: "uosmo", Rate: math.LegacyZeroDec()}}},
+			expErr: types.ErrInvalidRate,
+		},
+		{
+			name: "valid",
+			msg:  &types.MsgUpdateRates{Oracle: oracle.String(), Rates: []types.DenomRate{{Denom: "uosmo", Rate: math.LegacyNewDecWithPrec(5, 1)}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateRates(s.ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+
+	res, err := s.queryServer.Rates(s.ctx, &types.QueryRatesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DenomRate{
+		{Denom: "uatom", Rate: math.LegacyNewDec(2)},
+		{Denom: "uosmo", Rate: math.LegacyNewDecWithPrec(5, 1)},
+	}, res.Rates)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	_, err := s.msgServer.UpdateRates(s.ctx, &types.MsgUpdateRates{Oracle: oracle.String(), Rates: []types.DenomRate{{Denom: "uosmo", Rate: math.LegacyOneDec()}}})
+	s.Require().NoError(err)
+
+	authority := s.keeper.GetAuthority()
+	params := types.DefaultParams()
+	params.FeeDenoms = []types.FeeDenom{{Denom: "uosmo", Source: types.RateSourceStatic, StaticRate: math.LegacyNewDec(3)}}
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: other.String(), Params: params})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	invalid := params
+	invalid.FeeDenoms = []types.FeeDenom{{Denom: "uosmo", Source: types.RateSourceOracle}}
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Params: invalid})
+	s.Require().ErrorContains(err, "oracle must be set")
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	s.Require().NoError(err)
+
+	// uosmo is no longer priced by the oracle, its oracle rate is removed
+	has, err := s.keeper.OracleRates.Has(s.ctx, "uosmo")
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	res, err := s.queryServer.Rates(s.ctx, &types.QueryRatesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.DenomRate{{Denom: "uosmo", Rate: math.LegacyNewDec(3)}}, res.Rates)
+}
+
+func (s *KeeperTestSuite) TestConvertFee() {
+	res, err := s.queryServer.ConvertFee(s.ctx, &types.QueryConvertFeeRequest{Fee: "5uatom,10uosmo,3stake"})
+	s.Require().NoError(err)
+	// uosmo has no rate yet
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 13), sdk.NewInt64Coin("uosmo", 10)), res.Fee)
+
+	_, err = s.msgServer.UpdateRates(s.ctx, &types.MsgUpdateRates{Oracle: oracle.String(), Rates: []types.DenomRate{{Denom: "uosmo", Rate: math.LegacyNewDecWithPrec(25, 2)}}})
+	s.Require().NoError(err)
+
+	res, err = s.queryServer.ConvertFee(s.ctx, &types.QueryConvertFeeRequest{Fee: "5uatom,10uosmo,3stake"})
+	s.Require().NoError(err)
+	// 10uosmo is worth 2.5stake, rounded down
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), res.Fee)
+
+	_, err = s.queryServer.ConvertFee(s.ctx, &types.QueryConvertFeeRequest{Fee: "invalid"})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestCheckTxFee() {
+	// 1 stake per gas
+	ctx := s.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyOneDec())))
+
+	_, err := s.msgServer.UpdateRates(s.ctx, &types.MsgUpdateRates{Oracle: oracle.String(), Rates: []types.DenomRate{{Denom: "uosmo", Rate: math.LegacyNewDecWithPrec(1, 1)}}})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		fee         sdk.Coins
+		expErr      error
+		expPriority int64
+	}{
+		{
+			name:        "native denom",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			expPriority: 1,
+		},
+		{
+			name:   "insufficient native denom",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("stake", 99)),
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:        "static fee denom",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+			expPriority: 2,
+		},
+		{
+			name:   "insufficient static fee denom",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("uatom", 49)),
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:        "oracle fee denom",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000)),
+			expPriority: 1,
+		},
+		{
+			name:        "mixed fee denoms",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("uatom", 25), sdk.NewInt64Coin("uosmo", 500)),
+			expPriority: 1,
+		},
+		{
+			name:   "not whitelisted",
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("ujuno", 1_000_000)),
+			expErr: sdkerrors.ErrInsufficientFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			fee, priority, err := s.keeper.CheckTxFee(ctx, s.newFeeTx(tc.fee, 100))
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			// fees are deducted in the denoms they were paid in
+			s.Require().Equal(tc.fee, fee)
+			s.Require().Equal(tc.expPriority, priority)
+		})
+	}
+
+	// min gas prices are not checked outside of CheckTx
+	_, _, err = s.keeper.CheckTxFee(ctx.WithIsCheckTx(false), s.newFeeTx(sdk.NewCoins(sdk.NewInt64Coin("ujuno", 1)), 100))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestFeeConversionHook() {
+	swapper := feeabstestutil.NewMockFeeSwapper(s.ctrl)
+	hook := s.keeper.FeeConversionHook(swapper)
+
+	// only the whitelisted fee denoms with a rate are swapped
+	swapper.EXPECT().SwapToNative(gomock.Any(), authtypes.FeeCollectorName, sdk.NewInt64Coin("uatom", 7), sdk.NewInt64Coin("stake", 14)).Return(nil)
+	s.Require().NoError(hook(s.ctx, authtypes.FeeCollectorName, sdk.NewCoins(
+		sdk.NewInt64Coin("stake", 1),
+		sdk.NewInt64Coin("uatom", 7),
+		sdk.NewInt64Coin("uosmo", 10),
+	)))
+
+	swapper.EXPECT().SwapToNative(gomock.Any(), authtypes.FeeCollectorName, gomock.Any(), gomock.Any()).Return(sdkerrors.ErrInsufficientFunds)
+	err := hook(s.ctx, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("uatom", 7)))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	_, err := s.msgServer.UpdateRates(s.ctx, &types.MsgUpdateRates{Oracle: oracle.String(), Rates: []types.DenomRate{{Denom: "uosmo", Rate: math.LegacyOneDec()}}})
+	s.Require().NoError(err)
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+	s.Require().Equal([]types.DenomRate{{Denom: "uosmo", Rate: math.LegacyOneDec()}}, gs.OracleRates)
+
+	s.SetupTest()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, *gs))
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+}
@@ -0,0 +1,28 @@
+package simulation
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+)
+
+// RandomizedGenState generates a random GenesisState for feeabs
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+	params.NativeDenom = simState.BondDenom
+
+	// randomly whitelist a static fee denom
+	if simState.Rand.Intn(2) == 0 {
+		params.FeeDenoms = append(params.FeeDenoms, types.FeeDenom{
+			Denom:      "feeabs",
+			Source:     types.RateSourceStatic,
+			StaticRate: sdkmath.LegacyNewDecWithPrec(int64(simState.Rand.Intn(1000)+1), 2),
+		})
+	}
+
+	feeabsGenesis := types.DefaultGenesis()
+	feeabsGenesis.Params = params
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feeabsGenesis)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: x/feeabs/types/expected_keepers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=x/feeabs/types/expected_keepers.go -package testutil -destination x/feeabs/testutil/expected_keepers_mocks.go
+//
+
+// Package testutil is a generated GoMock package.
+package testutil
+
+import (
+	context "context"
+	reflect "reflect"
+
+	address "cosmossdk.io/core/address"
+	types "github.com/cosmos/cosmos-sdk/types"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccountKeeper is a mock of AccountKeeper interface.
+type MockAccountKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockAccountKeeperMockRecorder is the mock recorder for MockAccountKeeper.
+type MockAccountKeeperMockRecorder struct {
+	mock *MockAccountKeeper
+}
+
+// NewMockAccountKeeper creates a new mock instance.
+func NewMockAccountKeeper(ctrl *gomock.Controller) *MockAccountKeeper {
+	mock := &MockAccountKeeper{ctrl: ctrl}
+	mock.recorder = &MockAccountKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountKeeper) EXPECT() *MockAccountKeeperMockRecorder {
+	return m.recorder
+}
+
+// AddressCodec mocks base method.
+func (m *MockAccountKeeper) AddressCodec() address.Codec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddressCodec")
+	ret0, _ := ret[0].(address.Codec)
+	return ret0
+}
+
+// AddressCodec indicates an expected call of AddressCodec.
+func (mr *MockAccountKeeperMockRecorder) AddressCodec() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddressCodec", reflect.TypeOf((*MockAccountKeeper)(nil).AddressCodec))
+}
+
+// MockFeeSwapper is a mock of FeeSwapper interface.
+type MockFeeSwapper struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeSwapperMockRecorder
+	isgomock struct{}
+}
+
+// MockFeeSwapperMockRecorder is the mock recorder for MockFeeSwapper.
+type MockFeeSwapperMockRecorder struct {
+	mock *MockFeeSwapper
+}
+
+// NewMockFeeSwapper creates a new mock instance.
+func NewMockFeeSwapper(ctrl *gomock.Controller) *MockFeeSwapper {
+	mock := &MockFeeSwapper{ctrl: ctrl}
+	mock.recorder = &MockFeeSwapperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeSwapper) EXPECT() *MockFeeSwapperMockRecorder {
+	return m.recorder
+}
+
+// SwapToNative mocks base method.
+func (m *MockFeeSwapper) SwapToNative(ctx context.Context, moduleName string, coin, minOut types.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapToNative", ctx, moduleName, coin, minOut)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SwapToNative indicates an expected call of SwapToNative.
+func (mr *MockFeeSwapperMockRecorder) SwapToNative(ctx, moduleName, coin, minOut any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapToNative", reflect.TypeOf((*MockFeeSwapper)(nil).SwapToNative), ctx, moduleName, coin, minOut)
+}
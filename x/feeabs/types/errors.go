@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/feeabs module sentinel errors
+var (
+	ErrUnknownFeeDenom = errors.Register(ModuleName, 2, "unknown fee denom")
+	ErrInvalidRate     = errors.Register(ModuleName, 3, "invalid rate")
+	ErrInvalidOracle   = errors.Register(ModuleName, 4, "signer is not the oracle")
+)
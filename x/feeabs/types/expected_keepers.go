@@ -0,0 +1,22 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the expected account keeper.
+type AccountKeeper interface {
+	AddressCodec() address.Codec
+}
+
+// FeeSwapper converts fees collected in alternate denoms to the native denom,
+// typically through a DEX or an AMM.
+type FeeSwapper interface {
+	// SwapToNative swaps the given coin held by the module account for at
+	// least minOut, and credits the proceeds to the same module account.
+	SwapToNative(ctx context.Context, moduleName string, coin sdk.Coin, minOut sdk.Coin) error
+}
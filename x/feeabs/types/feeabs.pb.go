@@ -0,0 +1,970 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feeabs/v1/feeabs.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RateSource defines where the conversion rate of a fee denom comes from.
+type RateSource int32
+
+const (
+	// RATE_SOURCE_UNSPECIFIED defines a no-op rate source.
+	RateSourceUnspecified RateSource = 0
+	// RATE_SOURCE_STATIC defines a rate fixed by governance in the params.
+	RateSourceStatic RateSource = 1
+	// RATE_SOURCE_ORACLE defines a rate updated by the oracle account.
+	RateSourceOracle RateSource = 2
+)
+
+var RateSource_name = map[int32]string{
+	0: "RATE_SOURCE_UNSPECIFIED",
+	1: "RATE_SOURCE_STATIC",
+	2: "RATE_SOURCE_ORACLE",
+}
+
+var RateSource_value = map[string]int32{
+	"RATE_SOURCE_UNSPECIFIED": 0,
+	"RATE_SOURCE_STATIC":      1,
+	"RATE_SOURCE_ORACLE":      2,
+}
+
+func (x RateSource) String() string {
+	return proto.EnumName(RateSource_name, int32(x))
+}
+
+func (RateSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c268a9828f81a6b6, []int{0}
+}
+
+// FeeDenom defines an alternate denom accepted to pay fees.
+type FeeDenom struct {
+	// denom is the alternate fee denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// source defines where the conversion rate of the denom comes from.
+	Source RateSource `protobuf:"varint,2,opt,name=source,proto3,enum=cosmos.feeabs.v1.RateSource" json:"source,omitempty"`
+	// static_rate is the amount of native denom one unit of denom is worth. It
+	// is only used by static fee denoms.
+	StaticRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=static_rate,json=staticRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"static_rate"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c268a9828f81a6b6, []int{0}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetSource() RateSource {
+	if m != nil {
+		return m.Source
+	}
+	return RateSourceUnspecified
+}
+
+// Params defines the parameters for the x/feeabs module.
+type Params struct {
+	// native_denom is the denom the alternate fee denoms are converted to. The
+	// minimum gas prices in this denom are used to check alternate fees.
+	NativeDenom string `protobuf:"bytes,1,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty"`
+	// fee_denoms are the whitelisted alternate fee denoms.
+	FeeDenoms []FeeDenom `protobuf:"bytes,2,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms"`
+	// oracle is the account allowed to update the rates of the oracle fee
+	// denoms.
+	Oracle string `protobuf:"bytes,3,opt,name=oracle,proto3" json:"oracle,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c268a9828f81a6b6, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetOracle() string {
+	if m != nil {
+		return m.Oracle
+	}
+	return ""
+}
+
+// DenomRate defines the conversion rate of a fee denom.
+type DenomRate struct {
+	// denom is the alternate fee denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the amount of native denom one unit of denom is worth.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *DenomRate) Reset()         { *m = DenomRate{} }
+func (m *DenomRate) String() string { return proto.CompactTextString(m) }
+func (*DenomRate) ProtoMessage()    {}
+func (*DenomRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c268a9828f81a6b6, []int{2}
+}
+func (m *DenomRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRate.Merge(m, src)
+}
+func (m *DenomRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRate proto.InternalMessageInfo
+
+func (m *DenomRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.feeabs.v1.RateSource", RateSource_name, RateSource_value)
+	proto.RegisterType((*FeeDenom)(nil), "cosmos.feeabs.v1.FeeDenom")
+	proto.RegisterType((*Params)(nil), "cosmos.feeabs.v1.Params")
+	proto.RegisterType((*DenomRate)(nil), "cosmos.feeabs.v1.DenomRate")
+}
+
+func init() { proto.RegisterFile("cosmos/feeabs/v1/feeabs.proto", fileDescriptor_c268a9828f81a6b6) }
+
+var fileDescriptor_c268a9828f81a6b6 = []byte{
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
+	0x1c, 0xf5, 0xa5, 0x25, 0x22, 0x17, 0x84, 0x82, 0x15, 0x44, 0x6a, 0xc0, 0x09, 0x99, 0xa2, 0x40,
+	0x6c, 0x52, 0x10, 0x03, 0x5b, 0xfe, 0xb8, 0x52, 0xa4, 0x8a, 0x54, 0xe7, 0x64, 0x61, 0xb1, 0x2e,
+	0xf6, 0xc5, 0xb1, 0x8a, 0x7d, 0x91, 0xef, 0x1a, 0xd1, 0x6f, 0x80, 0x32, 0xf1, 0x05, 0x3a, 0x21,
+	0x21, 0xc4, 0xd4, 0xa1, 0x1f, 0x81, 0xa1, 0x63, 0xd5, 0x09, 0x31, 0x54, 0x28, 0x19, 0xfa, 0x35,
+	0x90, 0x7d, 0x17, 0xa5, 0x0a, 0xb0, 0x74, 0xb1, 0xce, 0xbf, 0xf7, 0x7e, 0xef, 0xde, 0xef, 0xfd,
+	0x0e, 0x3e, 0x75, 0x29, 0x0b, 0x29, 0x33, 0xc7, 0x84, 0xe0, 0x11, 0x33, 0x67, 0x4d, 0x79, 0x32,
+	0xa6, 0x31, 0xe5, 0x54, 0x2d, 0x08, 0xd8, 0x90, 0xc5, 0x59, 0x53, 0x2b, 0xfa, 0xd4, 0xa7, 0x29,
+	0x68, 0x26, 0x27, 0xc1, 0xd3, 0x76, 0x04, 0xcf, 0x11, 0x80, 0x6c, 0x12, 0xd0, 0x03, 0x1c, 0x06,
+	0x11, 0x35, 0xd3, 0xaf, 0x28, 0x55, 0xbf, 0x03, 0x78, 0x77, 0x8f, 0x90, 0x2e, 0x89, 0x68, 0xa8,
+	0x16, 0xe1, 0x1d, 0x2f, 0x39, 0x94, 0x40, 0x05, 0xd4, 0x72, 0x48, 0xfc, 0xa8, 0xaf, 0x61, 0x96,
+	0xd1, 0xa3, 0xd8, 0x25, 0xa5, 0x4c, 0x05, 0xd4, 0xee, 0xef, 0x3e, 0x31, 0x36, 0x9d, 0x18, 0x08,
+	0x73, 0x62, 0xa7, 0x1c, 0x24, 0xb9, 0x2a, 0x82, 0x79, 0xc6, 0x31, 0x0f, 0x5c, 0x27, 0xc6, 0x9c,
+	0x94, 0xb6, 0x12, 0xc5, 0x76, 0xf3, 0xfc, 0xaa, 0xac, 0xfc, 0xba, 0x2a, 0x3f, 0x16, 0x0a, 0xcc,
+	0x3b, 0x34, 0x02, 0x6a, 0x86, 0x98, 0x4f, 0x8c, 0x7d, 0xe2, 0x63, 0xf7, 0xb8, 0x4b, 0xdc, 0xcb,
+	0xb3, 0x06, 0x94, 0x17, 0x74, 0x89, 0x8b, 0xa0, 0x50, 0x49, 0x6e, 0xa8, 0xfe, 0x00, 0x30, 0x7b,
+	0x80, 0x63, 0x1c, 0x32, 0xf5, 0x19, 0xbc, 0x17, 0x61, 0x1e, 0xcc, 0x88, 0x73, 0xd3, 0x71, 0x5e,
+	0xd4, 0xc4, 0x34, 0x5d, 0x08, 0xc7, 0x44, 0xe2, 0xac, 0x94, 0xa9, 0x6c, 0xd5, 0xf2, 0xbb, 0xda,
+	0xdf, 0xde, 0x57, 0xd3, 0xb7, 0x73, 0x89, 0xb9, 0x6f, 0xd7, 0xa7, 0x75, 0x80, 0x72, 0x63, 0x59,
+	0x64, 0xea, 0x4b, 0x98, 0xa5, 0x31, 0x76, 0x3f, 0xac, 0x46, 0x28, 0x5d, 0x9e, 0x35, 0x8a, 0x52,
+	0xa4, 0xe5, 0x79, 0x31, 0x61, 0xcc, 0xe6, 0x71, 0x10, 0xf9, 0x48, 0xf2, 0xde, 0x96, 0xe7, 0xd7,
+	0xa7, 0x75, 0x4d, 0x50, 0x1a, 0xcc, 0x3b, 0x34, 0x3f, 0xae, 0x56, 0x2a, 0xbc, 0x57, 0x27, 0x30,
+	0x97, 0x8a, 0x27, 0x33, 0xfd, 0x27, 0x73, 0x0b, 0x6e, 0xa7, 0xb1, 0x65, 0x6e, 0x1b, 0x5b, 0xda,
+	0x5e, 0xff, 0x0a, 0x20, 0x5c, 0xef, 0x46, 0x7d, 0x03, 0x1f, 0xa1, 0xd6, 0xc0, 0x72, 0xec, 0xfe,
+	0x10, 0x75, 0x2c, 0x67, 0xf8, 0xce, 0x3e, 0xb0, 0x3a, 0xbd, 0xbd, 0x9e, 0xd5, 0x2d, 0x28, 0xda,
+	0xce, 0xfc, 0xa4, 0xf2, 0x70, 0x4d, 0x1e, 0x46, 0x6c, 0x4a, 0xdc, 0x60, 0x1c, 0x10, 0x4f, 0x7d,
+	0x01, 0xd5, 0x9b, 0x7d, 0xf6, 0xa0, 0x35, 0xe8, 0x75, 0x0a, 0x40, 0x2b, 0xce, 0x4f, 0x2a, 0x85,
+	0x75, 0x8b, 0x9d, 0x6e, 0x6a, 0x93, 0xdd, 0x47, 0xad, 0xce, 0xbe, 0x55, 0xc8, 0x6c, 0xb2, 0xfb,
+	0x69, 0x5a, 0xda, 0xf6, 0xa7, 0x2f, 0xba, 0xd2, 0xb6, 0xce, 0x17, 0x3a, 0xb8, 0x58, 0xe8, 0xe0,
+	0xf7, 0x42, 0x07, 0x9f, 0x97, 0xba, 0x72, 0xb1, 0xd4, 0x95, 0x9f, 0x4b, 0x5d, 0x79, 0xff, 0xdc,
+	0x0f, 0xf8, 0xe4, 0x68, 0x64, 0xb8, 0x34, 0x94, 0x8f, 0xd9, 0xfc, 0x57, 0xb4, 0xfc, 0x78, 0x4a,
+	0xd8, 0x28, 0x9b, 0x3e, 0xea, 0x57, 0x7f, 0x06, 0x00, 0x48, 0x34, 0xea, 0x76, 0x4b, 0x03, 0x00,
+	0x00,
+}
+
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StaticRate.Size()
+		i -= size
+		if _, err := m.StaticRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Source != 0 {
+		i = encodeVarintFeeabs(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeabs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeabs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeabs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovFeeabs(uint64(m.Source))
+	}
+	l = m.StaticRate.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovFeeabs(uint64(l))
+		}
+	}
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	return n
+}
+
+func sovFeeabs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeabs(x uint64) (n int) {
+	return sovFeeabs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= RateSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaticRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StaticRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeabs(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeabs
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeabs
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeabs
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeabs        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeabs          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeabs = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,37 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default genesis state of the x/feeabs module.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:      DefaultParams(),
+		OracleRates: []DenomRate{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.OracleRates))
+	for _, rate := range gs.OracleRates {
+		if err := rate.Validate(); err != nil {
+			return err
+		}
+		if seen[rate.Denom] {
+			return fmt.Errorf("duplicate oracle rate for %s", rate.Denom)
+		}
+		seen[rate.Denom] = true
+
+		fd, ok := gs.Params.FeeDenom(rate.Denom)
+		if !ok || fd.Source != RateSourceOracle {
+			return fmt.Errorf("%s is not an oracle fee denom", rate.Denom)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feeabs/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(gs *types.GenesisState)
+		expErr   string
+	}{
+		{
+			name:     "valid",
+			malleate: func(*types.GenesisState) {},
+		},
+		{
+			name: "invalid native denom",
+			malleate: func(gs *types.GenesisState) {
+				gs.Params.NativeDenom = ""
+			},
+			expErr: "invalid native denom",
+		},
+		{
+			name: "native denom whitelisted",
+			malleate: func(gs *types.GenesisState) {
+				gs.Params.FeeDenoms[0].Denom = sdk.DefaultBondDenom
+			},
+			expErr: "cannot be the native denom",
+		},
+		{
+			name: "duplicate fee denom",
+			malleate: func(gs *types.GenesisState) {
+				gs.Params.FeeDenoms[1].Denom = "uatom"
+				gs.OracleRates = nil
+			},
+			expErr: "duplicate fee denom uatom",
+		},
+		{
+			name: "unspecified rate source",
+			malleate: func(gs *types.GenesisState) {
+				gs.Params.FeeDenoms[0].Source = types.RateSourceUnspecified
+			},
+			expErr: "unspecified rate source",
+		},
+		{
+			name: "non positive static rate",
+			malleate: func(gs *types.GenesisState) {
+				gs.Params.FeeDenoms[0].StaticRate = math.LegacyZeroDec()
+			},
+			expErr: "invalid static rate",
+		},
+		{
+			name: "oracle fee denom with a static rate",
+			malleate: func(gs *types.GenesisState) {
+				gs.Params.FeeDenoms[1].StaticRate = math.LegacyOneDec()
+			},
+			expErr: "cannot have a static rate",
+		},
+		{
+			name: "missing oracle",
+			malleate: func(gs *types.GenesisState) {
+				gs.Params.Oracle = ""
+			},
+			expErr: "oracle must be set",
+		},
+		{
+			name: "oracle rate of a static fee denom",
+			malleate: func(gs *types.GenesisState) {
+				gs.OracleRates[0].Denom = "uatom"
+			},
+			expErr: "uatom is not an oracle fee denom",
+		},
+		{
+			name: "duplicate oracle rate",
+			malleate: func(gs *types.GenesisState) {
+				gs.OracleRates = append(gs.OracleRates, gs.OracleRates[0])
+			},
+			expErr: "duplicate oracle rate for uosmo",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesis()
+			gs.Params.FeeDenoms = []types.FeeDenom{
+				{Denom: "uatom", Source: types.RateSourceStatic, StaticRate: math.LegacyNewDec(2)},
+				{Denom: "uosmo", Source: types.RateSourceOracle},
+			}
+			gs.Params.Oracle = sdk.AccAddress("oracle").String()
+			gs.OracleRates = []types.DenomRate{{Denom: "uosmo", Rate: math.LegacyOneDec()}}
+			tc.malleate(gs)
+
+			err := gs.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "feeabs"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey      = collections.NewPrefix(0)
+	OracleRatesKey = collections.NewPrefix(1)
+)
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns default x/feeabs module parameters.
+func DefaultParams() Params {
+	return Params{
+		NativeDenom: sdk.DefaultBondDenom,
+		FeeDenoms:   []FeeDenom{},
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.NativeDenom); err != nil {
+		return fmt.Errorf("invalid native denom: %w", err)
+	}
+
+	seen := make(map[string]bool, len(p.FeeDenoms))
+	hasOracleDenom := false
+	for _, fd := range p.FeeDenoms {
+		if err := fd.Validate(); err != nil {
+			return err
+		}
+		if fd.Denom == p.NativeDenom {
+			return fmt.Errorf("fee denom %s cannot be the native denom", fd.Denom)
+		}
+		if seen[fd.Denom] {
+			return fmt.Errorf("duplicate fee denom %s", fd.Denom)
+		}
+		seen[fd.Denom] = true
+		hasOracleDenom = hasOracleDenom || fd.Source == RateSourceOracle
+	}
+
+	if hasOracleDenom && p.Oracle == "" {
+		return errors.New("oracle must be set when an oracle fee denom is whitelisted")
+	}
+
+	return nil
+}
+
+// FeeDenom returns the whitelisted fee denom with the given denom.
+func (p Params) FeeDenom(denom string) (FeeDenom, bool) {
+	for _, fd := range p.FeeDenoms {
+		if fd.Denom == denom {
+			return fd, true
+		}
+	}
+
+	return FeeDenom{}, false
+}
+
+// Validate does the sanity check on the fee denom.
+func (fd FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(fd.Denom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+
+	switch fd.Source {
+	case RateSourceStatic:
+		if err := ValidateRate(fd.StaticRate); err != nil {
+			return fmt.Errorf("invalid static rate of fee denom %s: %w", fd.Denom, err)
+		}
+	case RateSourceOracle:
+		if !fd.StaticRate.IsNil() && !fd.StaticRate.IsZero() {
+			return fmt.Errorf("oracle fee denom %s cannot have a static rate", fd.Denom)
+		}
+	default:
+		return fmt.Errorf("unspecified rate source for fee denom %s", fd.Denom)
+	}
+
+	return nil
+}
+
+// ValidateRate checks that a conversion rate is positive.
+func ValidateRate(rate sdkmath.LegacyDec) error {
+	if rate.IsNil() || !rate.IsPositive() {
+		return fmt.Errorf("rate must be positive: %s", rate)
+	}
+
+	return nil
+}
+
+// Validate does the sanity check on the denom rate.
+func (dr DenomRate) Validate() error {
+	if err := sdk.ValidateDenom(dr.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+
+	return ValidateRate(dr.Rate)
+}
@@ -62,7 +62,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 				return err
 			}
 
-			params, err := keeper.Params.Get(ctx)
+			params, err := keeper.ProposalParams(ctx, proposal)
 			if err != nil {
 				return err
 			}
@@ -143,11 +143,11 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 				return err
 			}
 
-			// If an expedited proposal fails, we do not want to update
+			// If an expedited or optimistic proposal fails, we do not want to update
 			// the deposit at this point since the proposal is converted to regular.
 			// As a result, the deposits are either deleted or refunded in all cases
-			// EXCEPT when an expedited proposal fails.
-			if !proposal.Expedited || passes {
+			// EXCEPT when an expedited or optimistic proposal fails.
+			if !(proposal.Expedited || proposal.IsOptimistic()) || passes {
 				if burnDeposits {
 					err = keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
 				} else {
@@ -170,6 +170,11 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 					msg    sdk.Msg
 				)
 
+				// a passed multiple choice proposal has a winning option and no messages
+				if proposal.IsMultipleChoice() {
+					proposal.WinningOption, _, _ = tallyResults.WinningOption()
+				}
+
 				// attempt to execute all messages within the passed proposal
 				// Messages may mutate state thus we use a cached context. If one of
 				// the handlers fails, no state mutation is written and the error
@@ -221,7 +226,7 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 				// once the regular voting period expires again, the tally is repeated
 				// according to the regular proposal rules.
 				proposal.Expedited = false
-				params, err := keeper.Params.Get(ctx)
+				params, err := keeper.ProposalParams(ctx, proposal)
 				if err != nil {
 					return err
 				}
@@ -235,6 +240,23 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 
 				tagValue = types.AttributeValueExpeditedProposalRejected
 				logMsg = "expedited proposal converted to regular"
+			case proposal.IsOptimistic():
+				// When enough voting power votes against an optimistic proposal,
+				// it is converted to a standard proposal and a full voting period
+				// starts, after which it is tallied according to the standard
+				// proposal rules.
+				proposal.ProposalType = v1.ProposalTypeStandard
+				if err = keeper.ActivateVotingPeriod(ctx, proposal); err != nil {
+					return err
+				}
+
+				proposal, err = keeper.Proposals.Get(ctx, proposal.Id)
+				if err != nil {
+					return err
+				}
+
+				tagValue = types.AttributeValueOptimisticProposalRejected
+				logMsg = "optimistic proposal converted to standard"
 			default:
 				proposal.Status = v1.StatusRejected
 				proposal.FailedReason = "proposal did not get enough votes to pass"
@@ -267,14 +289,16 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 				"results", logMsg,
 			)
 
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.EventTypeActiveProposal,
-					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
-					sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
-					sdk.NewAttribute(types.AttributeKeyProposalLog, logMsg),
-				),
-			)
+			attributes := []sdk.Attribute{
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+				sdk.NewAttribute(types.AttributeKeyProposalLog, logMsg),
+			}
+			if label, ok := proposal.WinningOptionLabel(); ok {
+				attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyWinningOption, label))
+			}
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeActiveProposal, attributes...))
 		}
 	}
 	return nil
@@ -494,6 +494,159 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	}
 }
 
+func TestMultipleChoiceProposalPassedEndblocker(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.NewContext(false)
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+	SortAddresses(addrs)
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: app.LastBlockHeight() + 1,
+		Hash:   app.LastCommitID().Hash,
+	})
+	require.NoError(t, err)
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	_, err = suite.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	params, _ := suite.GovKeeper.Params.Get(ctx)
+	multipleChoice := v1.MultipleChoice{Options: []string{"Option A", "Option B", "Option C"}}
+	res, err := govMsgSvr.SubmitMultipleChoiceProposal(ctx, v1.NewMsgSubmitMultipleChoiceProposal(params.MinDeposit, addrs[0].String(), "", "title", "summary", multipleChoice))
+	require.NoError(t, err)
+
+	require.NoError(t, suite.GovKeeper.AddVote(ctx, res.ProposalId, addrs[0], v1.NewNonSplitVoteOption(v1.OptionTwo), ""))
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*params.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+
+	require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+
+	proposal, err := suite.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+	require.Equal(t, v1.OptionTwo, proposal.WinningOption)
+	require.Equal(t, []string{"0", suite.StakingKeeper.TokensFromConsensusPower(ctx, 10).String(), "0"}, proposal.FinalTallyResult.OptionCounts)
+
+	var winningOption string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeActiveProposal {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyWinningOption {
+				winningOption = attr.Value
+			}
+		}
+	}
+	require.Equal(t, "Option B", winningOption)
+}
+
+func TestOptimisticProposalEndblocker(t *testing.T) {
+	testcases := []struct {
+		name       string
+		voteOption v1.VoteOption
+		expPass    bool
+	}{
+		{
+			name:    "passes without objection",
+			expPass: true,
+		},
+		{
+			name:       "passes with yes votes",
+			voteOption: v1.OptionYes,
+			expPass:    true,
+		},
+		{
+			name:       "converted to standard when vetoed",
+			voteOption: v1.OptionNoWithVeto,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.App
+			ctx := app.NewContext(false)
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 10, valTokens)
+
+			SortAddresses(addrs)
+
+			govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+			_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+				Height: app.LastBlockHeight() + 1,
+				Hash:   app.LastCommitID().Hash,
+			})
+			require.NoError(t, err)
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+			_, err = suite.StakingKeeper.EndBlocker(ctx)
+			require.NoError(t, err)
+
+			params, _ := suite.GovKeeper.Params.Get(ctx)
+			params.OptimisticAuthorizedAddresses = []string{addrs[1].String()}
+			require.NoError(t, suite.GovKeeper.Params.Set(ctx, params))
+
+			macc := suite.GovKeeper.GetGovernanceAccount(ctx)
+			initialModuleAccCoins := suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
+
+			msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, params.MinDeposit, addrs[1].String(), "", "title", "summary", false)
+			require.NoError(t, err)
+			msg.ProposalType = v1.ProposalTypeOptimistic
+			res, err := govMsgSvr.SubmitProposal(ctx, msg)
+			require.NoError(t, err)
+
+			if tc.voteOption != v1.OptionEmpty {
+				require.NoError(t, suite.GovKeeper.AddVote(ctx, res.ProposalId, addrs[0], v1.NewNonSplitVoteOption(tc.voteOption), ""))
+			}
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(*params.VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+
+			proposal, err := suite.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+			require.NoError(t, err)
+
+			if tc.expPass {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+				require.True(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).Equal(initialModuleAccCoins))
+				return
+			}
+
+			// the proposal restarts a full voting period as a standard proposal,
+			// keeping its deposits
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.Equal(t, v1.ProposalTypeStandard, proposal.ProposalType)
+			require.Equal(t, ctx.BlockTime(), *proposal.VotingStartTime)
+			require.Equal(t, ctx.BlockTime().Add(*params.VotingPeriod), *proposal.VotingEndTime)
+			require.True(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).Equal(initialModuleAccCoins.Add(params.MinDeposit...)))
+
+			has, err := suite.GovKeeper.ActiveProposalsQueue.Has(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id))
+			require.NoError(t, err)
+			require.True(t, has)
+
+			// the standard proposal is then rejected without votes
+			newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+			ctx = ctx.WithBlockHeader(newHeader)
+			require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+
+			proposal, err = suite.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+			require.NoError(t, err)
+			require.Equal(t, v1.StatusRejected, proposal.Status)
+		})
+	}
+}
+
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	suite := createTestSuite(t)
 	app := suite.App
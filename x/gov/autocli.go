@@ -28,7 +28,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "Proposals",
 					Use:       "proposals",
 					Short:     "Query proposals with optional filters",
-					Example:   fmt.Sprintf("%[1]s query gov proposals --depositor cosmos1...\n%[1]s query gov proposals --voter cosmos1...\n%[1]s query gov proposals --proposal-status (unspecified | deposit-period | voting-period | passed | rejected | failed)", version.AppName),
+					Example:   fmt.Sprintf("%[1]s query gov proposals --depositor cosmos1...\n%[1]s query gov proposals --voter cosmos1...\n%[1]s query gov proposals --proposal-status (unspecified | deposit-period | voting-period | passed | rejected | failed)\n%[1]s query gov proposals --proposal-type (standard | multiple-choice | optimistic)", version.AppName),
 				},
 				{
 					RpcMethod: "Proposal",
@@ -76,6 +76,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "ProposalRequirements",
+					Use:       "proposal-requirements [msg-type-url...]",
+					Short:     "Query the governance requirements of a proposal containing the given message types",
+					Example:   fmt.Sprintf("%s query gov proposal-requirements /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade /cosmos.bank.v1beta1.MsgSend", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "msg_urls", Varargs: true},
+					},
+				},
 				{
 					RpcMethod: "TallyResult",
 					Use:       "tally [proposal-id]",
@@ -72,6 +72,7 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdVote(),
 		NewCmdWeightedVote(),
 		NewCmdSubmitProposal(),
+		NewCmdSubmitMultipleChoiceProposal(),
 		NewCmdDraftProposal(),
 		NewCmdCancelProposal(),
 
@@ -116,6 +117,10 @@ Where proposal.json contains:
   "expedited": false
 }
 
+Set "optimistic": true to submit an optimistic proposal, which passes at the end of its
+voting period unless enough voting power votes against it. Only the optimistic authorized
+addresses can submit optimistic proposals.
+
 metadata example: 
 {
 	"title": "",
@@ -144,7 +149,63 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			if proposal.Optimistic {
+				msg.ProposalType = v1.ProposalTypeOptimistic
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitMultipleChoiceProposal implements submitting a multiple choice
+// proposal transaction command.
+func NewCmdSubmitMultipleChoiceProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-multiple-choice-proposal [path/to/proposal.json]",
+		Short: "Submit a multiple choice proposal along with its options, metadata and deposit",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a multiple choice proposal along with its options, metadata and deposit.
+They should be defined in a JSON file. The proposal has between %d and %d options,
+voted with their number, and its outcome is either "plurality" or "threshold".
+
+Example:
+$ %s tx gov submit-multiple-choice-proposal path/to/proposal.json
+
+Where proposal.json contains:
+
+{
+  "metadata": "4pIMOgIGx1vZGU=",
+  "deposit": "10stake",
+  "title": "My proposal",
+  "summary": "A short summary of my proposal",
+  "options": ["Option A", "Option B", "Option C"],
+  "outcome": "plurality"
+}
+
+The options are then voted with:
+$ %s tx gov vote 1 2 --from mykey
+`,
+				v1.MinMultipleChoiceOptions, v1.MaxMultipleChoiceOptions, version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, multipleChoice, deposit, err := parseSubmitMultipleChoiceProposal(args[0])
+			if err != nil {
+				return err
+			}
 
+			msg := v1.NewMsgSubmitMultipleChoiceProposal(deposit, clientCtx.GetFromAddress().String(), proposal.Metadata, proposal.Title, proposal.Summary, multipleChoice)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -311,6 +372,7 @@ func NewCmdVote() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a vote for an active proposal. You can
 find the proposal-id by running "%s query gov proposals".
+The options of a multiple choice proposal are voted with their number.
 
 Example:
 $ %s tx gov vote 1 yes --from mykey
@@ -82,12 +82,13 @@ func parseSubmitLegacyProposal(fs *pflag.FlagSet) (*legacyProposal, error) {
 // proposal defines the new Msg-based proposal.
 type proposal struct {
 	// Msgs defines an array of sdk.Msgs proto-JSON-encoded as Anys.
-	Messages  []json.RawMessage `json:"messages,omitempty"`
-	Metadata  string            `json:"metadata"`
-	Deposit   string            `json:"deposit"`
-	Title     string            `json:"title"`
-	Summary   string            `json:"summary"`
-	Expedited bool              `json:"expedited"`
+	Messages   []json.RawMessage `json:"messages,omitempty"`
+	Metadata   string            `json:"metadata"`
+	Deposit    string            `json:"deposit"`
+	Title      string            `json:"title"`
+	Summary    string            `json:"summary"`
+	Expedited  bool              `json:"expedited"`
+	Optimistic bool              `json:"optimistic,omitempty"`
 }
 
 // parseSubmitProposal reads and parses the proposal.
@@ -123,6 +124,47 @@ func parseSubmitProposal(cdc codec.Codec, path string) (proposal, []sdk.Msg, sdk
 	return proposal, msgs, deposit, nil
 }
 
+// multipleChoiceProposal defines the JSON file of a multiple choice proposal.
+type multipleChoiceProposal struct {
+	Metadata string   `json:"metadata"`
+	Deposit  string   `json:"deposit"`
+	Title    string   `json:"title"`
+	Summary  string   `json:"summary"`
+	Options  []string `json:"options"`
+	Outcome  string   `json:"outcome"`
+}
+
+// parseSubmitMultipleChoiceProposal reads and parses the multiple choice proposal.
+func parseSubmitMultipleChoiceProposal(path string) (multipleChoiceProposal, govv1.MultipleChoice, sdk.Coins, error) {
+	var proposal multipleChoiceProposal
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return proposal, govv1.MultipleChoice{}, nil, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, govv1.MultipleChoice{}, nil, err
+	}
+
+	multipleChoice := govv1.MultipleChoice{Options: proposal.Options}
+	switch proposal.Outcome {
+	case "", "plurality":
+		multipleChoice.Outcome = govv1.MultipleChoiceOutcome_MULTIPLE_CHOICE_OUTCOME_PLURALITY
+	case "threshold":
+		multipleChoice.Outcome = govv1.MultipleChoiceOutcome_MULTIPLE_CHOICE_OUTCOME_THRESHOLD
+	default:
+		return proposal, govv1.MultipleChoice{}, nil, fmt.Errorf("invalid outcome %q, expected plurality or threshold", proposal.Outcome)
+	}
+
+	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+	if err != nil {
+		return proposal, govv1.MultipleChoice{}, nil, err
+	}
+
+	return proposal, multipleChoice, deposit, nil
+}
+
 // AddGovPropFlagsToCmd adds flags for defining MsgSubmitProposal fields.
 //
 // See also ReadGovPropFlags.
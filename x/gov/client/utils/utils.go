@@ -1,8 +1,10 @@
 package utils
 
 import (
+	"strconv"
 	"strings"
 
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
@@ -22,6 +24,10 @@ func NormalizeVoteOption(option string) string {
 		return v1beta1.OptionNoWithVeto.String()
 
 	default:
+		// the options of a multiple choice proposal are given by their number
+		if n, err := strconv.Atoi(option); err == nil && n >= 1 && n <= v1.MaxMultipleChoiceOptions {
+			return v1.VoteOption(n).String()
+		}
 		return option
 	}
 }
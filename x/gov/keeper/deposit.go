@@ -78,7 +78,7 @@ func (k Keeper) AddDeposit(ctx context.Context, proposalID uint64, depositorAddr
 		return false, err
 	}
 
-	minDepositAmount := proposal.GetMinDepositFromParams(params)
+	minDepositAmount := proposal.GetMinDepositFromParams(params.ForMessages(proposal.MsgTypeURLs()))
 	minDepositRatio, err := sdkmath.LegacyNewDecFromStr(params.GetMinDepositRatio())
 	if err != nil {
 		return false, err
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -13,6 +14,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
@@ -156,6 +158,40 @@ func TestDeposits(t *testing.T) {
 	}
 }
 
+func TestDepositsMessageBasedParams(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, distKeeper, _, _, ctx := setupGovKeeper(t)
+	trackMockBalances(bankKeeper, distKeeper)
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000))
+
+	// proposals sending coins need a tenth of the default deposit and vote for
+	// an hour
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	votingPeriod := time.Hour
+	minDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinDepositTokens.QuoRaw(10)))
+	params.MessageBasedParams = []v1.MessageBasedParams{{
+		MsgUrl:       sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		MinDeposit:   minDeposit,
+		VotingPeriod: &votingPeriod,
+	}}
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal[:1], "", "title", "summary", TestAddrs[0], false)
+	require.NoError(t, err)
+	require.Equal(t, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, proposal.MsgTypeURLs())
+
+	votingStarted, err := govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], minDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, err = govKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(votingPeriod), *proposal.VotingEndTime)
+}
+
 func TestDepositAmount(t *testing.T) {
 	testcases := []struct {
 		name            string
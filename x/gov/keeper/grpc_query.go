@@ -54,13 +54,18 @@ func (q queryServer) Proposal(ctx context.Context, req *v1.QueryProposalRequest)
 // Proposals implements the Query/Proposals gRPC method
 func (q queryServer) Proposals(ctx context.Context, req *v1.QueryProposalsRequest) (*v1.QueryProposalsResponse, error) {
 	filteredProposals, pageRes, err := query.CollectionFilteredPaginate(ctx, q.k.Proposals, req.Pagination, func(key uint64, p v1.Proposal) (include bool, err error) {
-		matchVoter, matchDepositor, matchStatus := true, true, true
+		matchVoter, matchDepositor, matchStatus, matchType := true, true, true, true
 
 		// match status (if supplied/valid)
 		if v1.ValidProposalStatus(req.ProposalStatus) {
 			matchStatus = p.Status == req.ProposalStatus
 		}
 
+		// match type (if supplied)
+		if req.ProposalType != v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+			matchType = p.Type() == req.ProposalType
+		}
+
 		// match voter address (if supplied)
 		if len(req.Voter) > 0 {
 			voter, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Voter)
@@ -85,7 +90,7 @@ func (q queryServer) Proposals(ctx context.Context, req *v1.QueryProposalsReques
 		}
 
 		// if all match, append to results
-		if matchVoter && matchDepositor && matchStatus {
+		if matchVoter && matchDepositor && matchStatus && matchType {
 			return true, nil
 		}
 		// continue to next item, do not include because we're appending results above.
@@ -271,3 +276,18 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
+
+// ProposalRequirements queries the effective governance requirements of a proposal
+func (q queryServer) ProposalRequirements(ctx context.Context, req *v1.QueryProposalRequirementsRequest) (*v1.QueryProposalRequirementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	params = params.ForMessages(req.MsgUrls)
+	return &v1.QueryProposalRequirementsResponse{Params: &params}, nil
+}
@@ -11,6 +11,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -972,3 +973,56 @@ func (suite *KeeperTestSuite) TestGRPCQueryTallyResult() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryProposalRequirements() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	params, err := suite.govKeeper.Params.Get(ctx)
+	suite.Require().NoError(err)
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	params.MessageBasedParams = []v1.MessageBasedParams{{MsgUrl: sendURL, Threshold: "0.75"}}
+	suite.Require().NoError(suite.govKeeper.Params.Set(ctx, params))
+
+	res, err := queryClient.ProposalRequirements(gocontext.Background(), &v1.QueryProposalRequirementsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, *res.Params)
+
+	res, err = queryClient.ProposalRequirements(gocontext.Background(), &v1.QueryProposalRequirementsRequest{MsgUrls: []string{sendURL}})
+	suite.Require().NoError(err)
+	suite.Require().Equal("0.75", res.Params.Threshold)
+	suite.Require().Equal("0.75", res.Params.ExpeditedThreshold)
+	suite.Require().Equal(params.Quorum, res.Params.Quorum)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryProposalsByType() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	params, err := suite.govKeeper.Params.Get(ctx)
+	suite.Require().NoError(err)
+	params.OptimisticAuthorizedAddresses = []string{addrs[0].String()}
+	suite.Require().NoError(suite.govKeeper.Params.Set(ctx, params))
+
+	standard, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+	suite.Require().NoError(err)
+	optimistic, err := suite.govKeeper.SubmitOptimisticProposal(ctx, TestProposal, "", "title", "summary", addrs[0])
+	suite.Require().NoError(err)
+	multipleChoice, err := suite.govKeeper.SubmitMultipleChoiceProposal(ctx, "", "title", "summary", addrs[0], v1.MultipleChoice{Options: []string{"a", "b"}})
+	suite.Require().NoError(err)
+
+	for proposalType, expID := range map[v1.ProposalType]uint64{
+		v1.ProposalTypeStandard:       standard.Id,
+		v1.ProposalTypeOptimistic:     optimistic.Id,
+		v1.ProposalTypeMultipleChoice: multipleChoice.Id,
+	} {
+		res, err := queryClient.Proposals(gocontext.Background(), &v1.QueryProposalsRequest{ProposalType: proposalType})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.Proposals, 1)
+		suite.Require().Equal(expID, res.Proposals[0].Id)
+	}
+
+	res, err := queryClient.Proposals(gocontext.Background(), &v1.QueryProposalsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Proposals, 3)
+}
@@ -1,5 +1,10 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v6 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v6"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper *Keeper
@@ -11,3 +16,8 @@ func NewMigrator(keeper *Keeper) Migrator {
 		keeper: keeper,
 	}
 }
+
+// Migrate5to6 migrates from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.Params)
+}
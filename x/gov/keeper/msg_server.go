@@ -41,6 +41,16 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
 	}
 
+	switch msg.ProposalType {
+	case v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED, v1.ProposalTypeStandard:
+	case v1.ProposalTypeOptimistic:
+		if msg.Expedited {
+			return nil, errors.Wrap(govtypes.ErrInvalidProposal, "optimistic proposals cannot be expedited")
+		}
+	default:
+		return nil, errors.Wrapf(govtypes.ErrInvalidProposal, "proposal type %s cannot be submitted with MsgSubmitProposal", msg.ProposalType)
+	}
+
 	// check that either metadata or Msgs length is non nil.
 	if len(msg.Messages) == 0 && len(msg.Metadata) == 0 {
 		return nil, errors.Wrap(govtypes.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
@@ -76,7 +86,7 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, fmt.Errorf("failed to get governance parameters: %w", err)
 	}
 
-	if err := k.validateInitialDeposit(ctx, params, initialDeposit, msg.Expedited); err != nil {
+	if err := k.validateInitialDeposit(ctx, params.ForMessages(msg.MsgTypeURLs()), initialDeposit, msg.Expedited); err != nil {
 		return nil, err
 	}
 
@@ -84,7 +94,12 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	var proposal v1.Proposal
+	if msg.ProposalType == v1.ProposalTypeOptimistic {
+		proposal, err = k.Keeper.SubmitOptimisticProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer)
+	} else {
+		proposal, err = k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +133,70 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 	}, nil
 }
 
+// SubmitMultipleChoiceProposal implements the MsgServer.SubmitMultipleChoiceProposal method.
+func (k msgServer) SubmitMultipleChoiceProposal(goCtx context.Context, msg *v1.MsgSubmitMultipleChoiceProposal) (*v1.MsgSubmitMultipleChoiceProposalResponse, error) {
+	if msg.Title == "" {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "proposal title cannot be empty")
+	}
+	if msg.Summary == "" {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "proposal summary cannot be empty")
+	}
+
+	proposer, err := k.authKeeper.AddressCodec().StringToBytes(msg.GetProposer())
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	initialDeposit := msg.GetInitialDeposit()
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get governance parameters: %w", err)
+	}
+
+	if err := k.validateInitialDeposit(ctx, params, initialDeposit, false); err != nil {
+		return nil, err
+	}
+
+	if err := k.validateDepositDenom(ctx, params, initialDeposit); err != nil {
+		return nil, err
+	}
+
+	proposal, err := k.Keeper.SubmitMultipleChoiceProposal(ctx, msg.Metadata, msg.Title, msg.Summary, proposer, msg.MultipleChoice)
+	if err != nil {
+		return nil, err
+	}
+
+	bytes, err := proposal.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	// ref: https://github.com/cosmos/cosmos-sdk/issues/9683
+	ctx.GasMeter().ConsumeGas(
+		3*ctx.KVGasConfig().WriteCostPerByte*uint64(len(bytes)),
+		"submit proposal",
+	)
+
+	votingStarted, err := k.AddDeposit(ctx, proposal.Id, proposer, initialDeposit)
+	if err != nil {
+		return nil, err
+	}
+
+	if votingStarted {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(govtypes.EventTypeSubmitProposal,
+				sdk.NewAttribute(govtypes.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.Id)),
+			),
+		)
+	}
+
+	return &v1.MsgSubmitMultipleChoiceProposalResponse{
+		ProposalId: proposal.Id,
+	}, nil
+}
+
 // CancelProposal implements the MsgServer.CancelProposal method.
 func (k msgServer) CancelProposal(goCtx context.Context, msg *v1.MsgCancelProposal) (*v1.MsgCancelProposalResponse, error) {
 	_, err := k.authKeeper.AddressCodec().StringToBytes(msg.Proposer)
@@ -179,10 +258,6 @@ func (k msgServer) Vote(goCtx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResp
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
 	}
 
-	if !v1.ValidVoteOption(msg.Option) {
-		return nil, errors.Wrap(govtypes.ErrInvalidVote, msg.Option.String())
-	}
-
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	err = k.AddVote(ctx, msg.ProposalId, accAddr, v1.NewNonSplitVoteOption(msg.Option), msg.Metadata)
 	if err != nil {
@@ -206,7 +281,8 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted)
 	totalWeight := math.LegacyNewDec(0)
 	usedOptions := make(map[v1.VoteOption]bool)
 	for _, option := range msg.Options {
-		if !option.IsValid() {
+		// the option itself is validated against the proposal when adding the vote
+		if !option.HasValidWeight() {
 			return nil, errors.Wrap(govtypes.ErrInvalidVote, option.String())
 		}
 		weight, err := math.LegacyNewDecFromStr(option.Weight)
@@ -285,6 +285,131 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitOptimisticProposalReq() {
+	suite.reset()
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addrs := suite.addrs
+	proposer, unauthorized := addrs[0], addrs[1]
+
+	params, _ := suite.govKeeper.Params.Get(suite.ctx)
+	params.OptimisticAuthorizedAddresses = []string{proposer.String()}
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100))),
+	}
+
+	newMsg := func(proposer sdk.AccAddress, proposalType v1.ProposalType, expedited bool) *v1.MsgSubmitProposal {
+		msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{bankMsg}, params.MinDeposit, proposer.String(), "", "Proposal", "description of proposal", expedited)
+		suite.Require().NoError(err)
+		msg.ProposalType = proposalType
+		return msg
+	}
+
+	cases := map[string]struct {
+		msg       *v1.MsgSubmitProposal
+		expErrMsg string
+	}{
+		"unauthorized proposer": {
+			msg:       newMsg(unauthorized, v1.ProposalTypeOptimistic, false),
+			expErrMsg: "is not authorized to submit optimistic proposals",
+		},
+		"expedited": {
+			msg:       newMsg(proposer, v1.ProposalTypeOptimistic, true),
+			expErrMsg: "optimistic proposals cannot be expedited",
+		},
+		"multiple choice type": {
+			msg:       newMsg(proposer, v1.ProposalTypeMultipleChoice, false),
+			expErrMsg: "cannot be submitted with MsgSubmitProposal",
+		},
+		"all good": {
+			msg: newMsg(proposer, v1.ProposalTypeOptimistic, false),
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			res, err := suite.msgSrvr.SubmitProposal(suite.ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			proposal, err := suite.govKeeper.Proposals.Get(suite.ctx, res.ProposalId)
+			suite.Require().NoError(err)
+			suite.Require().True(proposal.IsOptimistic())
+			suite.Require().Equal(v1.StatusVotingPeriod, proposal.Status)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposalReq() {
+	suite.reset()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	params, _ := suite.govKeeper.Params.Get(suite.ctx)
+	minDeposit := params.MinDeposit
+	multipleChoice := v1.MultipleChoice{Options: []string{"Option A", "Option B", "Option C"}}
+
+	cases := map[string]struct {
+		msg       *v1.MsgSubmitMultipleChoiceProposal
+		expErr    bool
+		expErrMsg string
+	}{
+		"invalid addr": {
+			msg:       v1.NewMsgSubmitMultipleChoiceProposal(minDeposit, "", "", "Proposal", "description of proposal", multipleChoice),
+			expErr:    true,
+			expErrMsg: "invalid proposer address",
+		},
+		"empty title": {
+			msg:       v1.NewMsgSubmitMultipleChoiceProposal(minDeposit, proposer.String(), "", "", "description of proposal", multipleChoice),
+			expErr:    true,
+			expErrMsg: "proposal title cannot be empty",
+		},
+		"too few options": {
+			msg:       v1.NewMsgSubmitMultipleChoiceProposal(minDeposit, proposer.String(), "", "Proposal", "description of proposal", v1.MultipleChoice{Options: []string{"Option A"}}),
+			expErr:    true,
+			expErrMsg: "must have between 2 and 10 options",
+		},
+		"option too long": {
+			msg:       v1.NewMsgSubmitMultipleChoiceProposal(minDeposit, proposer.String(), "", "Proposal", "description of proposal", v1.MultipleChoice{Options: []string{"Option A", strings.Repeat("1", 300)}}),
+			expErr:    true,
+			expErrMsg: "metadata too long",
+		},
+		"all good": {
+			msg:    v1.NewMsgSubmitMultipleChoiceProposal(minDeposit, proposer.String(), "", "Proposal", "description of proposal", multipleChoice),
+			expErr: false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			res, err := suite.msgSrvr.SubmitMultipleChoiceProposal(suite.ctx, tc.msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			proposal, err := suite.govKeeper.Proposals.Get(suite.ctx, res.ProposalId)
+			suite.Require().NoError(err)
+			suite.Require().True(proposal.IsMultipleChoice())
+			suite.Require().Equal(v1.StatusVotingPeriod, proposal.Status)
+
+			_, err = suite.msgSrvr.Vote(suite.ctx, v1.NewMsgVote(proposer, res.ProposalId, v1.OptionThree, ""))
+			suite.Require().NoError(err)
+
+			_, err = suite.msgSrvr.Vote(suite.ctx, v1.NewMsgVote(proposer, res.ProposalId, v1.OptionFour, ""))
+			suite.Require().ErrorContains(err, "invalid vote option")
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestCancelProposalReq() {
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
 	addrs := suite.addrs
@@ -1503,6 +1628,75 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			expErr:    true,
 			expErrMsg: "invalid minimum deposit",
 		},
+		{
+			name: "valid message based params",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				votingPeriod := 2 * *params.VotingPeriod
+				params1.MessageBasedParams = []v1.MessageBasedParams{{
+					MsgUrl:       sdk.MsgTypeURL(&banktypes.MsgSend{}),
+					VotingPeriod: &votingPeriod,
+					Threshold:    "0.75",
+				}}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr: false,
+		},
+		{
+			name: "duplicate message based params",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MessageBasedParams = []v1.MessageBasedParams{
+					{MsgUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Threshold: "0.75"},
+					{MsgUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Quorum: "0.5"},
+				}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "duplicate message based params",
+		},
+		{
+			name: "message based params with unaccepted deposit denom",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MessageBasedParams = []v1.MessageBasedParams{{
+					MsgUrl:     sdk.MsgTypeURL(&banktypes.MsgSend{}),
+					MinDeposit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)),
+				}}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "minimum deposit denom atom is not accepted for deposits",
+		},
+		{
+			name: "message based params with invalid threshold",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.MessageBasedParams = []v1.MessageBasedParams{{
+					MsgUrl:    sdk.MsgTypeURL(&banktypes.MsgSend{}),
+					Threshold: "1.5",
+				}}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "vote threshold must be positive and at most 1",
+		},
 		{
 			name: "invalid max deposit period",
 			input: func() *v1.MsgUpdateParams {
@@ -18,24 +18,47 @@ import (
 
 // SubmitProposal creates a new proposal given an array of messages
 func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	err := k.assertMetadataLength(metadata)
+	if err := k.assertProposalText(metadata, title, summary); err != nil {
+		return v1.Proposal{}, err
+	}
+
+	msgTypeURLs, err := k.validateProposalMsgs(ctx, messages)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
 
-	// assert summary is no longer than predefined max length of metadata
-	err = k.assertSummaryLength(summary)
+	return k.insertProposal(ctx, messages, metadata, title, summary, proposer, expedited, v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED, nil, msgTypeURLs)
+}
+
+// SubmitOptimisticProposal creates a new optimistic proposal given an array of
+// messages. Only the optimistic authorized addresses can submit optimistic
+// proposals, which cannot be expedited.
+func (k Keeper) SubmitOptimisticProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
 
-	// assert title is no longer than predefined max length of metadata
-	err = k.assertMetadataLength(title)
+	if !params.IsOptimisticAuthorized(proposer.String()) {
+		return v1.Proposal{}, types.ErrInvalidProposer.Wrapf("%s is not authorized to submit optimistic proposals", proposer)
+	}
+
+	if err := k.assertProposalText(metadata, title, summary); err != nil {
+		return v1.Proposal{}, err
+	}
+
+	msgTypeURLs, err := k.validateProposalMsgs(ctx, messages)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
 
+	return k.insertProposal(ctx, messages, metadata, title, summary, proposer, false, v1.ProposalTypeOptimistic, nil, msgTypeURLs)
+}
+
+// validateProposalMsgs validates the messages of a proposal and returns their
+// type URLs.
+func (k Keeper) validateProposalMsgs(ctx context.Context, messages []sdk.Msg) ([]string, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	msgTypeURLs := make([]string, 0, len(messages))
 
 	// Loop through all messages and confirm that each has a handler and the gov module account
@@ -46,27 +69,27 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 		// perform a basic validation of the message
 		if m, ok := msg.(sdk.HasValidateBasic); ok {
 			if err := m.ValidateBasic(); err != nil {
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
+				return nil, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
 			}
 		}
 
 		signers, _, err := k.cdc.GetMsgV1Signers(msg)
 		if err != nil {
-			return v1.Proposal{}, err
+			return nil, err
 		}
 		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
+			return nil, types.ErrInvalidSigner
 		}
 
 		// assert that the governance module account is the only signer of the messages
 		if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
-			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidSigner, "%s", sdk.AccAddress(signers[0]).String())
+			return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "%s", sdk.AccAddress(signers[0]).String())
 		}
 
 		// use the msg service router to see that there is a valid route for that message.
 		handler := k.router.Handler(msg)
 		if handler == nil {
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+			return nil, errorsmod.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
 		}
 
 		// Only if it's a MsgExecLegacyContent do we try to execute the
@@ -78,14 +101,66 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 			cacheCtx, _ := sdkCtx.CacheContext()
 			if _, err := handler(cacheCtx, msg); err != nil {
 				if errors.Is(err, types.ErrNoProposalHandlerExists) {
-					return v1.Proposal{}, err
+					return nil, err
 				}
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalContent, err.Error())
+				return nil, errorsmod.Wrap(types.ErrInvalidProposalContent, err.Error())
 			}
 		}
 
 	}
 
+	return msgTypeURLs, nil
+}
+
+// SubmitMultipleChoiceProposal creates a new multiple choice proposal given its
+// options. A multiple choice proposal does not execute messages and cannot be
+// expedited.
+func (k Keeper) SubmitMultipleChoiceProposal(ctx context.Context, metadata, title, summary string, proposer sdk.AccAddress, multipleChoice v1.MultipleChoice) (v1.Proposal, error) {
+	if err := k.assertProposalText(metadata, title, summary); err != nil {
+		return v1.Proposal{}, err
+	}
+
+	if err := multipleChoice.ValidateBasic(); err != nil {
+		return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposal, err.Error())
+	}
+
+	for _, option := range multipleChoice.Options {
+		if err := k.assertMetadataLength(option); err != nil {
+			return v1.Proposal{}, err
+		}
+	}
+
+	return k.insertProposal(ctx, nil, metadata, title, summary, proposer, false, v1.ProposalTypeMultipleChoice, &multipleChoice, nil)
+}
+
+// assertProposalText asserts that the metadata, title and summary of a proposal
+// are no longer than their predefined max length.
+func (k Keeper) assertProposalText(metadata, title, summary string) error {
+	if err := k.assertMetadataLength(metadata); err != nil {
+		return err
+	}
+
+	// assert summary is no longer than predefined max length of metadata
+	if err := k.assertSummaryLength(summary); err != nil {
+		return err
+	}
+
+	// assert title is no longer than predefined max length of metadata
+	return k.assertMetadataLength(title)
+}
+
+// insertProposal stores a new proposal of the given type in its deposit period.
+func (k Keeper) insertProposal(
+	ctx context.Context,
+	messages []sdk.Msg,
+	metadata, title, summary string,
+	proposer sdk.AccAddress,
+	expedited bool,
+	proposalType v1.ProposalType,
+	multipleChoice *v1.MultipleChoice,
+	msgTypeURLs []string,
+) (v1.Proposal, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	proposalID, err := k.ProposalID.Next(ctx)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -104,6 +179,9 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 		return v1.Proposal{}, err
 	}
 
+	proposal.ProposalType = proposalType
+	proposal.MultipleChoice = multipleChoice
+
 	err = k.SetProposal(ctx, proposal)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -119,14 +197,16 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 		return v1.Proposal{}, err
 	}
 
-	sdkCtx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeSubmitProposal,
-			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
-			sdk.NewAttribute(types.AttributeKeyProposalProposer, proposer.String()),
-			sdk.NewAttribute(types.AttributeKeyProposalMessages, strings.Join(msgTypeURLs, ",")),
-		),
-	)
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		sdk.NewAttribute(types.AttributeKeyProposalProposer, proposer.String()),
+		sdk.NewAttribute(types.AttributeKeyProposalMessages, strings.Join(msgTypeURLs, ",")),
+	}
+	if proposalType != v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyProposalType, proposalType.String()))
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSubmitProposal, attributes...))
 
 	return proposal, nil
 }
@@ -238,20 +318,35 @@ func (k Keeper) DeleteProposal(ctx context.Context, proposalID uint64) error {
 	return k.Proposals.Remove(ctx, proposalID)
 }
 
+// ProposalParams returns the governance params applying to a proposal, i.e.
+// the params with the message based params of its messages applied.
+func (k Keeper) ProposalParams(ctx context.Context, proposal v1.Proposal) (v1.Params, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return v1.Params{}, err
+	}
+
+	return params.ForMessages(proposal.MsgTypeURLs()), nil
+}
+
 // ActivateVotingPeriod activates the voting period of a proposal
 func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Proposal) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	startTime := sdkCtx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
 	var votingPeriod *time.Duration
-	params, err := k.Params.Get(ctx)
+	params, err := k.ProposalParams(ctx, proposal)
 	if err != nil {
 		return err
 	}
 
-	if proposal.Expedited {
+	switch {
+	case proposal.Expedited:
 		votingPeriod = params.ExpeditedVotingPeriod
-	} else {
+	case proposal.IsOptimistic() && *params.OptimisticVotingPeriod < *params.VotingPeriod:
+		// the voting period of the messages of the proposal can be shorter
+		votingPeriod = params.OptimisticVotingPeriod
+	default:
 		votingPeriod = params.VotingPeriod
 	}
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
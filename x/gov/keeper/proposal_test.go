@@ -95,6 +95,27 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestActivateVotingPeriodOptimistic() {
+	params, err := suite.govKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	params.OptimisticAuthorizedAddresses = []string{suite.addrs[0].String()}
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	proposal, err := suite.govKeeper.SubmitOptimisticProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal))
+
+	proposal, err = suite.govKeeper.Proposals.Get(suite.ctx, proposal.Id)
+	suite.Require().NoError(err)
+	suite.Require().Less(*params.OptimisticVotingPeriod, *params.VotingPeriod)
+	suite.Require().Equal(suite.ctx.BlockHeader().Time.Add(*params.OptimisticVotingPeriod), *proposal.VotingEndTime)
+
+	has, err := suite.govKeeper.ActiveProposalsQueue.Has(suite.ctx, collections.Join(*proposal.VotingEndTime, proposal.Id))
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+	suite.Require().NoError(suite.govKeeper.DeleteProposal(suite.ctx, proposal.Id))
+}
+
 func (suite *KeeperTestSuite) TestDeleteProposalInVotingPeriod() {
 	testCases := []struct {
 		name      string
@@ -16,6 +16,7 @@ import (
 // CalculateVoteResultsAndVotingPowerFn is a function signature for calculating vote results and voting power
 // It can be overridden to customize the voting power calculation for proposals
 // It must fetch validators, calculate total validator power, and return vote results
+// results holds the voting power of each option of proposal.VoteOptions()
 // totalVoterPower is the sum of voting power that actually voted
 // totalValPower is the sum of all active validator power (for quorum calculation)
 type CalculateVoteResultsAndVotingPowerFn func(
@@ -54,11 +55,12 @@ func NewDefaultCalculateVoteResultsAndVotingPower(sk types.StakingKeeper) Calcul
 
 		totalVotingPower := math.LegacyZeroDec()
 
+		// the results hold the power of each option which can be voted on the
+		// proposal, i.e. one per option of a multiple choice proposal
 		results = make(map[v1.VoteOption]math.LegacyDec)
-		results[v1.OptionYes] = math.LegacyZeroDec()
-		results[v1.OptionAbstain] = math.LegacyZeroDec()
-		results[v1.OptionNo] = math.LegacyZeroDec()
-		results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
+		for _, option := range proposal.VoteOptions() {
+			results[option] = math.LegacyZeroDec()
+		}
 
 		rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
 		votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
@@ -149,7 +151,11 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 		return false, false, tallyResults, fmt.Errorf("error while calculating tally results: %w", err)
 	}
 
-	tallyResults = v1.NewTallyResultFromMap(results)
+	if proposal.IsMultipleChoice() {
+		tallyResults = v1.NewMultipleChoiceTallyResultFromMap(results, len(proposal.MultipleChoice.GetOptions()))
+	} else {
+		tallyResults = v1.NewTallyResultFromMap(results)
+	}
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no validator power, the proposal fails
@@ -157,11 +163,17 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 		return false, false, tallyResults, nil
 	}
 
-	params, err := k.Params.Get(ctx)
+	params, err := k.ProposalParams(ctx, proposal)
 	if err != nil {
 		return false, false, tallyResults, fmt.Errorf("error while getting params: %w", err)
 	}
 
+	// An optimistic proposal needs no quorum, it passes unless enough voting
+	// power votes against it
+	if proposal.IsOptimistic() {
+		return optimisticPasses(params, totalValPower, results), false, tallyResults, nil
+	}
+
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(totalValPower))
 	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
@@ -169,6 +181,10 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 		return false, params.BurnVoteQuorum, tallyResults, nil
 	}
 
+	if proposal.IsMultipleChoice() {
+		return multipleChoicePasses(proposal, params, totalVotingPower, tallyResults), false, tallyResults, nil
+	}
+
 	// If no one votes (everyone abstains), proposal fails
 	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
 		return false, false, tallyResults, nil
@@ -198,3 +214,31 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 	return false, false, tallyResults, nil
 }
+
+// multipleChoicePasses returns whether a multiple choice proposal which reached
+// quorum has a winning option. Under the plurality outcome the option with the
+// most voting power wins, and under the threshold outcome it must also exceed
+// the threshold param of the voting power. A tie between the top options has
+// no winner.
+func multipleChoicePasses(proposal v1.Proposal, params v1.Params, totalVotingPower math.LegacyDec, tallyResults v1.TallyResult) bool {
+	_, count, found := tallyResults.WinningOption()
+	if !found {
+		return false
+	}
+
+	if proposal.MultipleChoice.GetOutcome() != v1.MultipleChoiceOutcome_MULTIPLE_CHOICE_OUTCOME_THRESHOLD {
+		return true
+	}
+
+	threshold, _ := math.LegacyNewDecFromStr(params.Threshold)
+	return math.LegacyNewDecFromInt(count).Quo(totalVotingPower).GT(threshold)
+}
+
+// optimisticPasses returns whether an optimistic proposal passes, i.e. whether
+// the voting power voting no or no with veto stays below the optimistic
+// rejected threshold of the total voting power.
+func optimisticPasses(params v1.Params, totalValPower math.Int, results map[v1.VoteOption]math.LegacyDec) bool {
+	rejectedThreshold, _ := math.LegacyNewDecFromStr(params.OptimisticRejectedThreshold)
+	against := results[v1.OptionNo].Add(results[v1.OptionNoWithVeto])
+	return against.Quo(math.LegacyNewDecFromInt(totalValPower)).LT(rejectedThreshold)
+}
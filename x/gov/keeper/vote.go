@@ -29,8 +29,13 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		return err
 	}
 
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
 	for _, option := range options {
-		if !v1.ValidWeightedVoteOption(*option) {
+		if !proposal.ValidWeightedVoteOption(*option) {
 			return errors.Wrap(types.ErrInvalidVote, option.String())
 		}
 	}
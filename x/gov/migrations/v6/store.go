@@ -0,0 +1,28 @@
+package v6
+
+import (
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from the consensus version 5
+// to version 6. It sets the default optimistic rejected threshold and voting
+// period, the latter capped to the voting period, no address being authorized
+// to submit optimistic proposals.
+func MigrateStore(ctx sdk.Context, params collections.Item[v1.Params]) error {
+	currParams, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	currParams.OptimisticRejectedThreshold = v1.DefaultOptimisticRejectedThreshold.String()
+	optimisticVotingPeriod := min(v1.DefaultOptimisticPeriod, *currParams.VotingPeriod)
+	currParams.OptimisticVotingPeriod = &optimisticVotingPeriod
+	if err := currParams.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return params.Set(ctx, currParams)
+}
@@ -0,0 +1,57 @@
+package v6_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	v6 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(gov.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStoreService := runtime.NewKVStoreService(storeKey)
+
+	sb := collections.NewSchemaBuilder(kvStoreService)
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc))
+
+	// params stored before the optimistic proposals were introduced
+	oldParams := v1.DefaultParams()
+	oldParams.OptimisticRejectedThreshold = ""
+	oldParams.OptimisticVotingPeriod = nil
+	require.NoError(t, params.Set(ctx, oldParams))
+
+	require.NoError(t, v6.MigrateStore(ctx, params))
+
+	newParams, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, v1.DefaultParams(), newParams)
+
+	// the optimistic voting period is capped to a shorter voting period
+	votingPeriod, expeditedVotingPeriod := time.Hour, time.Minute
+	oldParams.VotingPeriod = &votingPeriod
+	oldParams.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	require.NoError(t, params.Set(ctx, oldParams))
+
+	require.NoError(t, v6.MigrateStore(ctx, params))
+
+	newParams, err = params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, votingPeriod, *newParams.OptimisticVotingPeriod)
+}
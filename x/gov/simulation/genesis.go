@@ -28,6 +28,9 @@ const (
 	ProposalCancelRate    = "proposal_cancel_rate"
 	MinDepositRatio       = "min_deposit_ratio"
 
+	OptimisticRejectedThreshold = "optimistic_rejected_threshold"
+	OptimisticVotingPeriod      = "optimistic_voting_period"
+
 	// ExpeditedThreshold must be at least as large as the regular Threshold
 	// Therefore, we use this break out point in randomization.
 	tallyNonExpeditedMax = 500
@@ -93,6 +96,16 @@ func GenVeto(r *rand.Rand) sdkmath.LegacyDec {
 	return sdkmath.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenOptimisticRejectedThreshold returns randomized OptimisticRejectedThreshold
+func GenOptimisticRejectedThreshold(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 50, 200)), 3)
+}
+
+// GenOptimisticVotingPeriod returns randomized OptimisticVotingPeriod
+func GenOptimisticVotingPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, expeditedMaxVotingPeriod)) * time.Second
+}
+
 // GenMinDepositRatio returns randomized DepositMinRatio
 func GenMinDepositRatio(r *rand.Rand) sdkmath.LegacyDec {
 	return sdkmath.LegacyMustNewDecFromStr("0.01")
@@ -138,9 +151,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var minDepositRatio sdkmath.LegacyDec
 	simState.AppParams.GetOrGenerate(MinDepositRatio, &minDepositRatio, simState.Rand, func(r *rand.Rand) { minDepositRatio = GenMinDepositRatio(r) })
 
+	var optimisticRejectedThreshold sdkmath.LegacyDec
+	simState.AppParams.GetOrGenerate(OptimisticRejectedThreshold, &optimisticRejectedThreshold, simState.Rand, func(r *rand.Rand) { optimisticRejectedThreshold = GenOptimisticRejectedThreshold(r) })
+
+	var optimisticVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(OptimisticVotingPeriod, &optimisticVotingPeriod, simState.Rand, func(r *rand.Rand) { optimisticVotingPeriod = GenOptimisticVotingPeriod(r) })
+
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
-		v1.NewParams(minDeposit, expeditedMinDeposit, depositPeriod, votingPeriod, expeditedVotingPeriod, quorum.String(), threshold.String(), expeditedVotingThreshold.String(), veto.String(), minInitialDepositRatio.String(), proposalCancelRate.String(), "", simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, minDepositRatio.String()),
+		v1.NewParams(minDeposit, expeditedMinDeposit, depositPeriod, votingPeriod, expeditedVotingPeriod, quorum.String(), threshold.String(), expeditedVotingThreshold.String(), veto.String(), minInitialDepositRatio.String(), proposalCancelRate.String(), "", simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, minDepositRatio.String(), nil, optimisticRejectedThreshold.String(), optimisticVotingPeriod),
 	)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(govGenesis)
 }
@@ -152,6 +152,20 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// AddressCodec mocks base method.
+func (m *MockBankKeeper) AddressCodec() address.Codec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddressCodec")
+	ret0, _ := ret[0].(address.Codec)
+	return ret0
+}
+
+// AddressCodec indicates an expected call of AddressCodec.
+func (mr *MockBankKeeperMockRecorder) AddressCodec() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddressCodec", reflect.TypeOf((*MockBankKeeper)(nil).AddressCodec))
+}
+
 // AllBalances mocks base method.
 func (m *MockBankKeeper) AllBalances(arg0 context.Context, arg1 *types1.QueryAllBalancesRequest) (*types1.QueryAllBalancesResponse, error) {
 	m.ctrl.T.Helper()
@@ -167,6 +181,21 @@ func (mr *MockBankKeeperMockRecorder) AllBalances(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllBalances", reflect.TypeOf((*MockBankKeeper)(nil).AllBalances), arg0, arg1)
 }
 
+// AllowlistedAddresses mocks base method.
+func (m *MockBankKeeper) AllowlistedAddresses(arg0 context.Context, arg1 *types1.QueryAllowlistedAddressesRequest) (*types1.QueryAllowlistedAddressesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowlistedAddresses", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryAllowlistedAddressesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowlistedAddresses indicates an expected call of AllowlistedAddresses.
+func (mr *MockBankKeeperMockRecorder) AllowlistedAddresses(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowlistedAddresses", reflect.TypeOf((*MockBankKeeper)(nil).AllowlistedAddresses), arg0, arg1)
+}
+
 // AppendSendRestriction mocks base method.
 func (m *MockBankKeeper) AppendSendRestriction(restriction types1.SendRestrictionFn) {
 	m.ctrl.T.Helper()
@@ -194,6 +223,21 @@ func (mr *MockBankKeeperMockRecorder) Balance(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balance", reflect.TypeOf((*MockBankKeeper)(nil).Balance), arg0, arg1)
 }
 
+// BalanceHistory mocks base method.
+func (m *MockBankKeeper) BalanceHistory(arg0 context.Context, arg1 *types1.QueryBalanceHistoryRequest) (*types1.QueryBalanceHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceHistory", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryBalanceHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceHistory indicates an expected call of BalanceHistory.
+func (mr *MockBankKeeperMockRecorder) BalanceHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceHistory", reflect.TypeOf((*MockBankKeeper)(nil).BalanceHistory), arg0, arg1)
+}
+
 // BlockedAddr mocks base method.
 func (m *MockBankKeeper) BlockedAddr(addr types0.AccAddress) bool {
 	m.ctrl.T.Helper()
@@ -222,6 +266,20 @@ func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, moduleName, amt any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, moduleName, amt)
 }
 
+// Clawback mocks base method.
+func (m *MockBankKeeper) Clawback(ctx context.Context, addr types0.AccAddress, amt types0.Coin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clawback", ctx, addr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clawback indicates an expected call of Clawback.
+func (mr *MockBankKeeperMockRecorder) Clawback(ctx, addr, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clawback", reflect.TypeOf((*MockBankKeeper)(nil).Clawback), ctx, addr, amt)
+}
+
 // ClearSendRestriction mocks base method.
 func (m *MockBankKeeper) ClearSendRestriction() {
 	m.ctrl.T.Helper()
@@ -293,6 +351,21 @@ func (mr *MockBankKeeperMockRecorder) DeleteSendEnabled(ctx any, denoms ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).DeleteSendEnabled), varargs...)
 }
 
+// DenomCompliance mocks base method.
+func (m *MockBankKeeper) DenomCompliance(arg0 context.Context, arg1 *types1.QueryDenomComplianceRequest) (*types1.QueryDenomComplianceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomCompliance", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryDenomComplianceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomCompliance indicates an expected call of DenomCompliance.
+func (mr *MockBankKeeperMockRecorder) DenomCompliance(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomCompliance", reflect.TypeOf((*MockBankKeeper)(nil).DenomCompliance), arg0, arg1)
+}
+
 // DenomMetadata mocks base method.
 func (m *MockBankKeeper) DenomMetadata(arg0 context.Context, arg1 *types1.QueryDenomMetadataRequest) (*types1.QueryDenomMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -382,6 +455,21 @@ func (mr *MockBankKeeperMockRecorder) ExportGenesis(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportGenesis", reflect.TypeOf((*MockBankKeeper)(nil).ExportGenesis), arg0)
 }
 
+// FrozenAddresses mocks base method.
+func (m *MockBankKeeper) FrozenAddresses(arg0 context.Context, arg1 *types1.QueryFrozenAddressesRequest) (*types1.QueryFrozenAddressesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FrozenAddresses", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QueryFrozenAddressesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FrozenAddresses indicates an expected call of FrozenAddresses.
+func (mr *MockBankKeeperMockRecorder) FrozenAddresses(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FrozenAddresses", reflect.TypeOf((*MockBankKeeper)(nil).FrozenAddresses), arg0, arg1)
+}
+
 // GetAccountsBalances mocks base method.
 func (m *MockBankKeeper) GetAccountsBalances(ctx context.Context) []types1.Balance {
 	m.ctrl.T.Helper()
@@ -410,6 +498,20 @@ func (mr *MockBankKeeperMockRecorder) GetAllBalances(ctx, addr any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalances", reflect.TypeOf((*MockBankKeeper)(nil).GetAllBalances), ctx, addr)
 }
 
+// GetAllDenomCompliance mocks base method.
+func (m *MockBankKeeper) GetAllDenomCompliance(ctx context.Context) []types1.DenomCompliance {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDenomCompliance", ctx)
+	ret0, _ := ret[0].([]types1.DenomCompliance)
+	return ret0
+}
+
+// GetAllDenomCompliance indicates an expected call of GetAllDenomCompliance.
+func (mr *MockBankKeeperMockRecorder) GetAllDenomCompliance(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDenomCompliance", reflect.TypeOf((*MockBankKeeper)(nil).GetAllDenomCompliance), ctx)
+}
+
 // GetAllDenomMetaData mocks base method.
 func (m *MockBankKeeper) GetAllDenomMetaData(ctx context.Context) []types1.Metadata {
 	m.ctrl.T.Helper()
@@ -480,6 +582,21 @@ func (mr *MockBankKeeperMockRecorder) GetBlockedAddresses() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedAddresses", reflect.TypeOf((*MockBankKeeper)(nil).GetBlockedAddresses))
 }
 
+// GetDenomCompliance mocks base method.
+func (m *MockBankKeeper) GetDenomCompliance(ctx context.Context, denom string) (types1.DenomCompliance, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDenomCompliance", ctx, denom)
+	ret0, _ := ret[0].(types1.DenomCompliance)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetDenomCompliance indicates an expected call of GetDenomCompliance.
+func (mr *MockBankKeeperMockRecorder) GetDenomCompliance(ctx, denom any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomCompliance", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomCompliance), ctx, denom)
+}
+
 // GetDenomMetaData mocks base method.
 func (m *MockBankKeeper) GetDenomMetaData(ctx context.Context, denom string) (types1.Metadata, bool) {
 	m.ctrl.T.Helper()
@@ -622,6 +739,34 @@ func (mr *MockBankKeeperMockRecorder) InputOutputCoins(ctx, input, outputs any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InputOutputCoins", reflect.TypeOf((*MockBankKeeper)(nil).InputOutputCoins), ctx, input, outputs)
 }
 
+// IsAllowlisted mocks base method.
+func (m *MockBankKeeper) IsAllowlisted(ctx context.Context, denom string, addr types0.AccAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAllowlisted", ctx, denom, addr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAllowlisted indicates an expected call of IsAllowlisted.
+func (mr *MockBankKeeperMockRecorder) IsAllowlisted(ctx, denom, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAllowlisted", reflect.TypeOf((*MockBankKeeper)(nil).IsAllowlisted), ctx, denom, addr)
+}
+
+// IsFrozen mocks base method.
+func (m *MockBankKeeper) IsFrozen(ctx context.Context, denom string, addr types0.AccAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFrozen", ctx, denom, addr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsFrozen indicates an expected call of IsFrozen.
+func (mr *MockBankKeeperMockRecorder) IsFrozen(ctx, denom, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFrozen", reflect.TypeOf((*MockBankKeeper)(nil).IsFrozen), ctx, denom, addr)
+}
+
 // IsSendEnabledCoin mocks base method.
 func (m *MockBankKeeper) IsSendEnabledCoin(ctx context.Context, coin types0.Coin) bool {
 	m.ctrl.T.Helper()
@@ -784,6 +929,20 @@ func (mr *MockBankKeeperMockRecorder) PrependSendRestriction(restriction any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrependSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).PrependSendRestriction), restriction)
 }
 
+// PruneBalanceHistory mocks base method.
+func (m *MockBankKeeper) PruneBalanceHistory(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneBalanceHistory", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneBalanceHistory indicates an expected call of PruneBalanceHistory.
+func (mr *MockBankKeeperMockRecorder) PruneBalanceHistory(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneBalanceHistory", reflect.TypeOf((*MockBankKeeper)(nil).PruneBalanceHistory), ctx)
+}
+
 // SendCoins mocks base method.
 func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types0.AccAddress, amt types0.Coins) error {
 	m.ctrl.T.Helper()
@@ -923,6 +1082,34 @@ func (mr *MockBankKeeperMockRecorder) SetAllSendEnabled(ctx, sendEnableds any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetAllSendEnabled), ctx, sendEnableds)
 }
 
+// SetAllowlisted mocks base method.
+func (m *MockBankKeeper) SetAllowlisted(ctx context.Context, denom string, addr types0.AccAddress, allowlisted bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAllowlisted", ctx, denom, addr, allowlisted)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAllowlisted indicates an expected call of SetAllowlisted.
+func (mr *MockBankKeeperMockRecorder) SetAllowlisted(ctx, denom, addr, allowlisted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllowlisted", reflect.TypeOf((*MockBankKeeper)(nil).SetAllowlisted), ctx, denom, addr, allowlisted)
+}
+
+// SetDenomCompliance mocks base method.
+func (m *MockBankKeeper) SetDenomCompliance(ctx context.Context, compliance types1.DenomCompliance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomCompliance", ctx, compliance)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDenomCompliance indicates an expected call of SetDenomCompliance.
+func (mr *MockBankKeeperMockRecorder) SetDenomCompliance(ctx, compliance any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomCompliance", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomCompliance), ctx, compliance)
+}
+
 // SetDenomMetaData mocks base method.
 func (m *MockBankKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types1.Metadata) {
 	m.ctrl.T.Helper()
@@ -935,6 +1122,20 @@ func (mr *MockBankKeeperMockRecorder) SetDenomMetaData(ctx, denomMetaData any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetaData), ctx, denomMetaData)
 }
 
+// SetFrozen mocks base method.
+func (m *MockBankKeeper) SetFrozen(ctx context.Context, denom string, addr types0.AccAddress, frozen bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFrozen", ctx, denom, addr, frozen)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFrozen indicates an expected call of SetFrozen.
+func (mr *MockBankKeeperMockRecorder) SetFrozen(ctx, denom, addr, frozen any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFrozen", reflect.TypeOf((*MockBankKeeper)(nil).SetFrozen), ctx, denom, addr, frozen)
+}
+
 // SetParams mocks base method.
 func (m *MockBankKeeper) SetParams(ctx context.Context, params types1.Params) error {
 	m.ctrl.T.Helper()
@@ -1019,6 +1220,21 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// SupplyHistory mocks base method.
+func (m *MockBankKeeper) SupplyHistory(arg0 context.Context, arg1 *types1.QuerySupplyHistoryRequest) (*types1.QuerySupplyHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SupplyHistory", arg0, arg1)
+	ret0, _ := ret[0].(*types1.QuerySupplyHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SupplyHistory indicates an expected call of SupplyHistory.
+func (mr *MockBankKeeperMockRecorder) SupplyHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupplyHistory", reflect.TypeOf((*MockBankKeeper)(nil).SupplyHistory), arg0, arg1)
+}
+
 // SupplyOf mocks base method.
 func (m *MockBankKeeper) SupplyOf(arg0 context.Context, arg1 *types1.QuerySupplyOfRequest) (*types1.QuerySupplyOfResponse, error) {
 	m.ctrl.T.Helper()
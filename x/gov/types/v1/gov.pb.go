@@ -33,6 +33,8 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // VoteOption enumerates the valid vote options for a given governance proposal.
+// The options of a multiple choice proposal are voted with VOTE_OPTION_ONE to
+// VOTE_OPTION_TEN, the first four of which alias the standard vote options.
 type VoteOption int32
 
 const (
@@ -46,6 +48,26 @@ const (
 	VoteOption_VOTE_OPTION_NO VoteOption = 3
 	// VOTE_OPTION_NO_WITH_VETO defines a no with veto vote option.
 	VoteOption_VOTE_OPTION_NO_WITH_VETO VoteOption = 4
+	// VOTE_OPTION_ONE defines the first option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_ONE VoteOption = 1
+	// VOTE_OPTION_TWO defines the second option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_TWO VoteOption = 2
+	// VOTE_OPTION_THREE defines the third option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_THREE VoteOption = 3
+	// VOTE_OPTION_FOUR defines the fourth option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_FOUR VoteOption = 4
+	// VOTE_OPTION_FIVE defines the fifth option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_FIVE VoteOption = 5
+	// VOTE_OPTION_SIX defines the sixth option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_SIX VoteOption = 6
+	// VOTE_OPTION_SEVEN defines the seventh option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_SEVEN VoteOption = 7
+	// VOTE_OPTION_EIGHT defines the eighth option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_EIGHT VoteOption = 8
+	// VOTE_OPTION_NINE defines the ninth option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_NINE VoteOption = 9
+	// VOTE_OPTION_TEN defines the tenth option of a multiple choice proposal.
+	VoteOption_VOTE_OPTION_TEN VoteOption = 10
 )
 
 var VoteOption_name = map[int32]string{
@@ -54,6 +76,16 @@ var VoteOption_name = map[int32]string{
 	2: "VOTE_OPTION_ABSTAIN",
 	3: "VOTE_OPTION_NO",
 	4: "VOTE_OPTION_NO_WITH_VETO",
+	// Duplicate value: 1: "VOTE_OPTION_ONE",
+	// Duplicate value: 2: "VOTE_OPTION_TWO",
+	// Duplicate value: 3: "VOTE_OPTION_THREE",
+	// Duplicate value: 4: "VOTE_OPTION_FOUR",
+	5:  "VOTE_OPTION_FIVE",
+	6:  "VOTE_OPTION_SIX",
+	7:  "VOTE_OPTION_SEVEN",
+	8:  "VOTE_OPTION_EIGHT",
+	9:  "VOTE_OPTION_NINE",
+	10: "VOTE_OPTION_TEN",
 }
 
 var VoteOption_value = map[string]int32{
@@ -62,6 +94,16 @@ var VoteOption_value = map[string]int32{
 	"VOTE_OPTION_ABSTAIN":      2,
 	"VOTE_OPTION_NO":           3,
 	"VOTE_OPTION_NO_WITH_VETO": 4,
+	"VOTE_OPTION_ONE":          1,
+	"VOTE_OPTION_TWO":          2,
+	"VOTE_OPTION_THREE":        3,
+	"VOTE_OPTION_FOUR":         4,
+	"VOTE_OPTION_FIVE":         5,
+	"VOTE_OPTION_SIX":          6,
+	"VOTE_OPTION_SEVEN":        7,
+	"VOTE_OPTION_EIGHT":        8,
+	"VOTE_OPTION_NINE":         9,
+	"VOTE_OPTION_TEN":          10,
 }
 
 func (x VoteOption) String() string {
@@ -72,6 +114,80 @@ func (VoteOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{0}
 }
 
+// ProposalType enumerates the valid types of a governance proposal.
+type ProposalType int32
+
+const (
+	// PROPOSAL_TYPE_UNSPECIFIED defines no proposal type, which falls back to
+	// PROPOSAL_TYPE_STANDARD.
+	ProposalType_PROPOSAL_TYPE_UNSPECIFIED ProposalType = 0
+	// PROPOSAL_TYPE_STANDARD defines a proposal voted with yes, no, abstain or no
+	// with veto which executes its messages when it passes.
+	ProposalType_PROPOSAL_TYPE_STANDARD ProposalType = 1
+	// PROPOSAL_TYPE_MULTIPLE_CHOICE defines a proposal voted on a set of custom
+	// options, which does not execute messages.
+	ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE ProposalType = 2
+	// PROPOSAL_TYPE_OPTIMISTIC defines a proposal submitted by an authorized
+	// proposer which passes at the end of its voting period unless the
+	// optimistic rejected threshold of the voting power votes against it, in
+	// which case it is converted to a standard proposal.
+	ProposalType_PROPOSAL_TYPE_OPTIMISTIC ProposalType = 3
+)
+
+var ProposalType_name = map[int32]string{
+	0: "PROPOSAL_TYPE_UNSPECIFIED",
+	1: "PROPOSAL_TYPE_STANDARD",
+	2: "PROPOSAL_TYPE_MULTIPLE_CHOICE",
+	3: "PROPOSAL_TYPE_OPTIMISTIC",
+}
+
+var ProposalType_value = map[string]int32{
+	"PROPOSAL_TYPE_UNSPECIFIED":     0,
+	"PROPOSAL_TYPE_STANDARD":        1,
+	"PROPOSAL_TYPE_MULTIPLE_CHOICE": 2,
+	"PROPOSAL_TYPE_OPTIMISTIC":      3,
+}
+
+func (x ProposalType) String() string {
+	return proto.EnumName(ProposalType_name, int32(x))
+}
+
+func (ProposalType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
+}
+
+// MultipleChoiceOutcome enumerates how the winning option of a multiple choice
+// proposal is decided.
+type MultipleChoiceOutcome int32
+
+const (
+	// MULTIPLE_CHOICE_OUTCOME_PLURALITY defines that the option with the most
+	// voting power wins.
+	MultipleChoiceOutcome_MULTIPLE_CHOICE_OUTCOME_PLURALITY MultipleChoiceOutcome = 0
+	// MULTIPLE_CHOICE_OUTCOME_THRESHOLD defines that the option with the most
+	// voting power wins only if it exceeds the threshold param of the voting
+	// power.
+	MultipleChoiceOutcome_MULTIPLE_CHOICE_OUTCOME_THRESHOLD MultipleChoiceOutcome = 1
+)
+
+var MultipleChoiceOutcome_name = map[int32]string{
+	0: "MULTIPLE_CHOICE_OUTCOME_PLURALITY",
+	1: "MULTIPLE_CHOICE_OUTCOME_THRESHOLD",
+}
+
+var MultipleChoiceOutcome_value = map[string]int32{
+	"MULTIPLE_CHOICE_OUTCOME_PLURALITY": 0,
+	"MULTIPLE_CHOICE_OUTCOME_THRESHOLD": 1,
+}
+
+func (x MultipleChoiceOutcome) String() string {
+	return proto.EnumName(MultipleChoiceOutcome_name, int32(x))
+}
+
+func (MultipleChoiceOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
+}
+
 // ProposalStatus enumerates the valid statuses of a proposal.
 type ProposalStatus int32
 
@@ -118,7 +234,62 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
+}
+
+// MultipleChoice defines the options of a multiple choice proposal.
+type MultipleChoice struct {
+	// options are the labels of the options, voted with VOTE_OPTION_ONE onwards.
+	Options []string `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	// outcome defines how the winning option is decided.
+	Outcome MultipleChoiceOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=cosmos.gov.v1.MultipleChoiceOutcome" json:"outcome,omitempty"`
+}
+
+func (m *MultipleChoice) Reset()         { *m = MultipleChoice{} }
+func (m *MultipleChoice) String() string { return proto.CompactTextString(m) }
+func (*MultipleChoice) ProtoMessage()    {}
+func (*MultipleChoice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{0}
+}
+func (m *MultipleChoice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultipleChoice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultipleChoice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultipleChoice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultipleChoice.Merge(m, src)
+}
+func (m *MultipleChoice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultipleChoice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultipleChoice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultipleChoice proto.InternalMessageInfo
+
+func (m *MultipleChoice) GetOptions() []string {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *MultipleChoice) GetOutcome() MultipleChoiceOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return MultipleChoiceOutcome_MULTIPLE_CHOICE_OUTCOME_PLURALITY
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
@@ -133,7 +304,7 @@ func (m *WeightedVoteOption) Reset()         { *m = WeightedVoteOption{} }
 func (m *WeightedVoteOption) String() string { return proto.CompactTextString(m) }
 func (*WeightedVoteOption) ProtoMessage()    {}
 func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{0}
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
 }
 func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +362,7 @@ func (m *Deposit) Reset()         { *m = Deposit{} }
 func (m *Deposit) String() string { return proto.CompactTextString(m) }
 func (*Deposit) ProtoMessage()    {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,13 +448,19 @@ type Proposal struct {
 	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
 	// failed_reason defines the reason why the proposal failed
 	FailedReason string `protobuf:"bytes,15,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+	// proposal_type defines the type of the proposal
+	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// multiple_choice defines the options of a multiple choice proposal
+	MultipleChoice *MultipleChoice `protobuf:"bytes,17,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice,omitempty"`
+	// winning_option is the winning option of a passed multiple choice proposal
+	WinningOption VoteOption `protobuf:"varint,18,opt,name=winning_option,json=winningOption,proto3,enum=cosmos.gov.v1.VoteOption" json:"winning_option,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{2}
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,6 +594,27 @@ func (m *Proposal) GetFailedReason() string {
 	return ""
 }
 
+func (m *Proposal) GetProposalType() ProposalType {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (m *Proposal) GetMultipleChoice() *MultipleChoice {
+	if m != nil {
+		return m.MultipleChoice
+	}
+	return nil
+}
+
+func (m *Proposal) GetWinningOption() VoteOption {
+	if m != nil {
+		return m.WinningOption
+	}
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -427,13 +625,16 @@ type TallyResult struct {
 	NoCount string `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
 	// no_with_veto_count is the number of no with veto votes on a proposal.
 	NoWithVetoCount string `protobuf:"bytes,4,opt,name=no_with_veto_count,json=noWithVetoCount,proto3" json:"no_with_veto_count,omitempty"`
+	// option_counts are the number of votes on each option of a multiple choice
+	// proposal, starting with VOTE_OPTION_ONE.
+	OptionCounts []string `protobuf:"bytes,5,rep,name=option_counts,json=optionCounts,proto3" json:"option_counts,omitempty"`
 }
 
 func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{3}
+	return fileDescriptor_e05cb1c0d030febb, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,6 +691,13 @@ func (m *TallyResult) GetNoWithVetoCount() string {
 	return ""
 }
 
+func (m *TallyResult) GetOptionCounts() []string {
+	if m != nil {
+		return m.OptionCounts
+	}
+	return nil
+}
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -508,7 +716,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{4}
+	return fileDescriptor_e05cb1c0d030febb, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +788,7 @@ func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{5}
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +843,7 @@ func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +897,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -748,15 +956,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -767,7 +975,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -779,13 +987,27 @@ type Params struct {
 	// Default value: 0.01. Meaning that for a chain with a min_deposit of 100stake, a deposit of 1stake would be
 	// required.
 	MinDepositRatio string `protobuf:"bytes,16,opt,name=min_deposit_ratio,json=minDepositRatio,proto3" json:"min_deposit_ratio,omitempty"`
+	// message_based_params overrides the governance requirements of the proposals
+	// containing the given message types. A proposal containing several message
+	// types follows the strictest requirements among them.
+	MessageBasedParams []MessageBasedParams `protobuf:"bytes,17,rep,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params"`
+	// optimistic_authorized_addresses defines the addresses allowed to submit
+	// optimistic proposals.
+	OptimisticAuthorizedAddresses []string `protobuf:"bytes,18,rep,name=optimistic_authorized_addresses,json=optimisticAuthorizedAddresses,proto3" json:"optimistic_authorized_addresses,omitempty"`
+	// optimistic_rejected_threshold defines the proportion of the total voting
+	// power voting no or no with veto from which an optimistic proposal is
+	// converted to a standard proposal. Default value: 0.1.
+	OptimisticRejectedThreshold string `protobuf:"bytes,19,opt,name=optimistic_rejected_threshold,json=optimisticRejectedThreshold,proto3" json:"optimistic_rejected_threshold,omitempty"`
+	// optimistic_voting_period defines the duration of the voting period of an
+	// optimistic proposal. It cannot exceed the voting period.
+	OptimisticVotingPeriod *time.Duration `protobuf:"bytes,20,opt,name=optimistic_voting_period,json=optimisticVotingPeriod,proto3,stdduration" json:"optimistic_voting_period,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{8}
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -926,9 +1148,133 @@ func (m *Params) GetMinDepositRatio() string {
 	return ""
 }
 
+func (m *Params) GetMessageBasedParams() []MessageBasedParams {
+	if m != nil {
+		return m.MessageBasedParams
+	}
+	return nil
+}
+
+func (m *Params) GetOptimisticAuthorizedAddresses() []string {
+	if m != nil {
+		return m.OptimisticAuthorizedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetOptimisticRejectedThreshold() string {
+	if m != nil {
+		return m.OptimisticRejectedThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetOptimisticVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.OptimisticVotingPeriod
+	}
+	return nil
+}
+
+// MessageBasedParams defines the governance requirements of the proposals
+// containing a given message type. Each unset field falls back to the
+// corresponding default param.
+type MessageBasedParams struct {
+	// msg_url is the type URL of the message, e.g. /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade.
+	MsgUrl string `protobuf:"bytes,1,opt,name=msg_url,json=msgUrl,proto3" json:"msg_url,omitempty"`
+	// Minimum deposit for a proposal to enter voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,2,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	// Duration of the voting period.
+	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	// Minimum percentage of total stake needed to vote for a result to be considered valid.
+	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Minimum proportion of Yes votes for proposal to pass.
+	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Minimum value of Veto votes to Total votes ratio for proposal to be vetoed.
+	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+}
+
+func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
+func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
+func (*MessageBasedParams) ProtoMessage()    {}
+func (*MessageBasedParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
+}
+func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageBasedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageBasedParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageBasedParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageBasedParams.Merge(m, src)
+}
+func (m *MessageBasedParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageBasedParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageBasedParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageBasedParams proto.InternalMessageInfo
+
+func (m *MessageBasedParams) GetMsgUrl() string {
+	if m != nil {
+		return m.MsgUrl
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
+func (m *MessageBasedParams) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return nil
+}
+
+func (m *MessageBasedParams) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
+	proto.RegisterEnum("cosmos.gov.v1.MultipleChoiceOutcome", MultipleChoiceOutcome_name, MultipleChoiceOutcome_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterType((*MultipleChoice)(nil), "cosmos.gov.v1.MultipleChoice")
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
@@ -938,105 +1284,172 @@ func init() {
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x1a, 0x36, 0x25, 0x59, 0x96, 0x5e, 0x7d, 0x58, 0x19, 0x3b, 0x31, 0xed, 0xc4, 0xb2, 0x23, 0x04,
-	0x81, 0xf3, 0x61, 0xc9, 0x4e, 0xd6, 0xbb, 0x8b, 0x64, 0x2f, 0xb2, 0xc5, 0x24, 0x0a, 0x12, 0x4b,
-	0x4b, 0x29, 0x4e, 0xb2, 0xc0, 0x82, 0xa0, 0xcd, 0x89, 0xcc, 0x5d, 0x91, 0xa3, 0x92, 0x23, 0xc7,
-	0x3a, 0xf7, 0xd4, 0x5b, 0x8e, 0x3d, 0x15, 0x3d, 0xf6, 0xd8, 0x43, 0xd0, 0xdf, 0x90, 0x53, 0x11,
-	0xe4, 0xd2, 0xa2, 0x40, 0xd3, 0x22, 0x39, 0x14, 0x48, 0xff, 0x44, 0x31, 0x1f, 0x14, 0x25, 0x59,
-	0x86, 0xed, 0x5c, 0x6c, 0x6a, 0xe6, 0x79, 0x9e, 0xf7, 0x9d, 0xf7, 0x6b, 0x48, 0x98, 0xdb, 0x23,
-	0xbe, 0x43, 0xfc, 0x52, 0x8b, 0x1c, 0x94, 0x0e, 0xd6, 0xd9, 0xbf, 0x62, 0xc7, 0x23, 0x94, 0xa0,
-	0x8c, 0xd8, 0x28, 0xb2, 0x95, 0x83, 0xf5, 0x85, 0xbc, 0xc4, 0xed, 0x9a, 0x3e, 0x2e, 0x1d, 0xac,
-	0xef, 0x62, 0x6a, 0xae, 0x97, 0xf6, 0x88, 0xed, 0x0a, 0xf8, 0xc2, 0x6c, 0x8b, 0xb4, 0x08, 0x7f,
-	0x2c, 0xb1, 0x27, 0xb9, 0xba, 0xd4, 0x22, 0xa4, 0xd5, 0xc6, 0x25, 0xfe, 0x6b, 0xb7, 0xfb, 0xa2,
-	0x44, 0x6d, 0x07, 0xfb, 0xd4, 0x74, 0x3a, 0x12, 0x30, 0x3f, 0x0a, 0x30, 0xdd, 0x9e, 0xdc, 0xca,
-	0x8f, 0x6e, 0x59, 0x5d, 0xcf, 0xa4, 0x36, 0x09, 0x2c, 0xce, 0x0b, 0x8f, 0x0c, 0x61, 0x54, 0x7a,
-	0x2b, 0xb6, 0xce, 0x99, 0x8e, 0xed, 0x92, 0x12, 0xff, 0x2b, 0x96, 0x0a, 0x04, 0xd0, 0x53, 0x6c,
-	0xb7, 0xf6, 0x29, 0xb6, 0x76, 0x08, 0xc5, 0xb5, 0x0e, 0x53, 0x42, 0xeb, 0x10, 0x27, 0xfc, 0x49,
-	0x55, 0x96, 0x95, 0x95, 0xec, 0xad, 0xf9, 0xe2, 0xd0, 0xa9, 0x8b, 0x21, 0x54, 0x97, 0x40, 0x74,
-	0x15, 0xe2, 0x2f, 0xb9, 0x90, 0x1a, 0x59, 0x56, 0x56, 0x92, 0x9b, 0xd9, 0x77, 0xaf, 0x57, 0x41,
-	0xb2, 0x2a, 0x78, 0x4f, 0x97, 0xbb, 0x85, 0x6f, 0x15, 0x98, 0xaa, 0xe0, 0x0e, 0xf1, 0x6d, 0x8a,
-	0x96, 0x20, 0xd5, 0xf1, 0x48, 0x87, 0xf8, 0x66, 0xdb, 0xb0, 0x2d, 0x6e, 0x2b, 0xa6, 0x43, 0xb0,
-	0x54, 0xb5, 0xd0, 0xdf, 0x21, 0x69, 0x09, 0x2c, 0xf1, 0xa4, 0xae, 0xfa, 0xee, 0xf5, 0xea, 0xac,
-	0xd4, 0x2d, 0x5b, 0x96, 0x87, 0x7d, 0xbf, 0x41, 0x3d, 0xdb, 0x6d, 0xe9, 0x21, 0x14, 0xfd, 0x0b,
-	0xe2, 0xa6, 0x43, 0xba, 0x2e, 0x55, 0xa3, 0xcb, 0xd1, 0x95, 0x54, 0xe8, 0x3f, 0x4b, 0x53, 0x51,
-	0xa6, 0xa9, 0xb8, 0x45, 0x6c, 0x77, 0x33, 0xf9, 0xe6, 0xfd, 0xd2, 0xc4, 0x77, 0x7f, 0x7c, 0x7f,
-	0x5d, 0xd1, 0x25, 0xa7, 0xf0, 0x67, 0x1c, 0x12, 0x75, 0xe9, 0x04, 0xca, 0x42, 0xa4, 0xef, 0x5a,
-	0xc4, 0xb6, 0xd0, 0x1a, 0x24, 0x1c, 0xec, 0xfb, 0x66, 0x0b, 0xfb, 0x6a, 0x84, 0x8b, 0xcf, 0x16,
-	0x45, 0x46, 0x8a, 0x41, 0x46, 0x8a, 0x65, 0xb7, 0xa7, 0xf7, 0x51, 0x68, 0x03, 0xe2, 0x3e, 0x35,
-	0x69, 0xd7, 0x57, 0xa3, 0x3c, 0x98, 0x8b, 0x23, 0xc1, 0x0c, 0x4c, 0x35, 0x38, 0x48, 0x97, 0x60,
-	0xf4, 0x00, 0xd0, 0x0b, 0xdb, 0x35, 0xdb, 0x06, 0x35, 0xdb, 0xed, 0x9e, 0xe1, 0x61, 0xbf, 0xdb,
-	0xa6, 0x6a, 0x6c, 0x59, 0x59, 0x49, 0xdd, 0x5a, 0x18, 0x91, 0x68, 0x32, 0x88, 0xce, 0x11, 0x7a,
-	0x8e, 0xb3, 0x06, 0x56, 0x50, 0x19, 0x52, 0x7e, 0x77, 0xd7, 0xb1, 0xa9, 0xc1, 0xca, 0x4c, 0x9d,
-	0x94, 0x12, 0xa3, 0x5e, 0x37, 0x83, 0x1a, 0xdc, 0x8c, 0xbd, 0xfa, 0x6d, 0x49, 0xd1, 0x41, 0x90,
-	0xd8, 0x32, 0x7a, 0x08, 0x39, 0x19, 0x5d, 0x03, 0xbb, 0x96, 0xd0, 0x89, 0x9f, 0x52, 0x27, 0x2b,
-	0x99, 0x9a, 0x6b, 0x71, 0xad, 0x2a, 0x64, 0x28, 0xa1, 0x66, 0xdb, 0x90, 0xeb, 0xea, 0xd4, 0x19,
-	0x72, 0x94, 0xe6, 0xd4, 0xa0, 0x80, 0x1e, 0xc1, 0xb9, 0x03, 0x42, 0x6d, 0xb7, 0x65, 0xf8, 0xd4,
-	0xf4, 0xe4, 0xf9, 0x12, 0xa7, 0xf4, 0x6b, 0x5a, 0x50, 0x1b, 0x8c, 0xc9, 0x1d, 0x7b, 0x00, 0x72,
-	0x29, 0x3c, 0x63, 0xf2, 0x94, 0x5a, 0x19, 0x41, 0x0c, 0x8e, 0xb8, 0xc0, 0x8a, 0x84, 0x9a, 0x96,
-	0x49, 0x4d, 0x15, 0x58, 0xd9, 0xea, 0xfd, 0xdf, 0xe8, 0x1a, 0x4c, 0x52, 0x9b, 0xb6, 0xb1, 0x9a,
-	0xe2, 0xf5, 0x3c, 0xf3, 0xcb, 0xeb, 0xd5, 0x69, 0x71, 0xf2, 0x55, 0xdf, 0xfa, 0xff, 0xf2, 0x5a,
-	0xf1, 0x6f, 0xff, 0xd0, 0x05, 0x02, 0xad, 0xc2, 0x94, 0xdf, 0x75, 0x1c, 0xd3, 0xeb, 0xa9, 0xe9,
-	0xe3, 0xc1, 0x01, 0x06, 0xdd, 0x87, 0x84, 0xe8, 0x1d, 0xec, 0xa9, 0x19, 0x8e, 0xbf, 0x71, 0x5c,
-	0xb3, 0x8c, 0xd3, 0xe9, 0x93, 0xd1, 0x3a, 0x24, 0xf1, 0x61, 0x07, 0x5b, 0x36, 0xc5, 0x96, 0x9a,
-	0x5d, 0x56, 0x56, 0x12, 0x63, 0x2c, 0x6f, 0xac, 0xe9, 0x21, 0x0a, 0xfd, 0x13, 0x32, 0x2f, 0x4c,
-	0xbb, 0x8d, 0x2d, 0xc3, 0xc3, 0xa6, 0x4f, 0x5c, 0x75, 0xfa, 0x18, 0x87, 0x37, 0xd6, 0xf4, 0xb4,
-	0x40, 0xea, 0x1c, 0x58, 0xf8, 0x49, 0x81, 0xd4, 0x60, 0xb5, 0xde, 0x80, 0x64, 0x0f, 0xfb, 0xc6,
-	0x1e, 0x6f, 0x5f, 0xe5, 0xc8, 0x2c, 0xa9, 0xba, 0x54, 0x4f, 0xf4, 0xb0, 0xbf, 0xc5, 0xf6, 0xd1,
-	0x6d, 0xc8, 0x98, 0xbb, 0x3e, 0x35, 0x6d, 0x57, 0x12, 0x22, 0x63, 0x09, 0x69, 0x09, 0x12, 0xa4,
-	0x6b, 0x90, 0x70, 0x89, 0xc4, 0x47, 0xc7, 0xe2, 0xa7, 0x5c, 0x22, 0xa0, 0x77, 0x01, 0xb9, 0xc4,
-	0x78, 0x69, 0xd3, 0x7d, 0xe3, 0x00, 0xd3, 0x80, 0x14, 0x1b, 0x4b, 0x9a, 0x76, 0xc9, 0x53, 0x9b,
-	0xee, 0xef, 0x60, 0x2a, 0xc8, 0x85, 0x1f, 0x14, 0x88, 0xb1, 0x49, 0x79, 0xf2, 0x9c, 0x2b, 0xc2,
-	0xe4, 0x01, 0xa1, 0xf8, 0xe4, 0x19, 0x27, 0x60, 0xe8, 0x2e, 0x4c, 0x89, 0xb1, 0xeb, 0xab, 0x31,
-	0xde, 0x3c, 0x97, 0x47, 0x06, 0xc2, 0xd1, 0x99, 0xae, 0x07, 0x8c, 0xa1, 0xe2, 0x9c, 0x1c, 0x2e,
-	0xce, 0x87, 0xb1, 0x44, 0x34, 0x17, 0x2b, 0xfc, 0xaa, 0x40, 0x46, 0xb6, 0x58, 0xdd, 0xf4, 0x4c,
-	0xc7, 0x47, 0xcf, 0x21, 0xe5, 0xd8, 0x6e, 0xbf, 0x63, 0x95, 0x93, 0x3a, 0x76, 0x91, 0x75, 0xec,
-	0xa7, 0xf7, 0x4b, 0xe7, 0x07, 0x58, 0x37, 0x89, 0x63, 0x53, 0xec, 0x74, 0x68, 0x4f, 0x07, 0xc7,
-	0x76, 0x83, 0x1e, 0x76, 0x00, 0x39, 0xe6, 0x61, 0x00, 0x32, 0x3a, 0xd8, 0xb3, 0x89, 0xc5, 0x03,
-	0xc1, 0x2c, 0x8c, 0x36, 0x5e, 0x45, 0x5e, 0x76, 0x9b, 0x57, 0x3e, 0xbd, 0x5f, 0xba, 0x74, 0x94,
-	0x18, 0x1a, 0xf9, 0x9a, 0xf5, 0x65, 0xce, 0x31, 0x0f, 0x83, 0x93, 0xf0, 0xfd, 0x3b, 0x11, 0x55,
-	0x29, 0x3c, 0x83, 0xf4, 0x0e, 0xef, 0x57, 0x79, 0xba, 0x0a, 0xc8, 0xfe, 0x0d, 0xac, 0x2b, 0x27,
-	0x59, 0x8f, 0x71, 0xf5, 0xb4, 0x60, 0x0d, 0x28, 0x7f, 0x13, 0x14, 0xb3, 0x54, 0xbe, 0x0a, 0xf1,
-	0x2f, 0xba, 0xc4, 0xeb, 0x3a, 0x63, 0x2a, 0x99, 0xdf, 0x8a, 0x62, 0x17, 0xdd, 0x84, 0x24, 0xdd,
-	0xf7, 0xb0, 0xbf, 0x4f, 0xda, 0xd6, 0x31, 0x17, 0x68, 0x08, 0x40, 0x1b, 0x90, 0xe5, 0xd5, 0x18,
-	0x52, 0xa2, 0x63, 0x29, 0x19, 0x86, 0x6a, 0x06, 0x20, 0xee, 0xe0, 0x97, 0x49, 0x88, 0x4b, 0xdf,
-	0xb4, 0x33, 0xe6, 0x74, 0x60, 0x0a, 0x0f, 0xe6, 0xef, 0xf1, 0xe7, 0xe5, 0x2f, 0x36, 0x3e, 0x3f,
-	0x47, 0x73, 0x11, 0xfd, 0x8c, 0x5c, 0x0c, 0xc4, 0x3d, 0x76, 0xfa, 0xb8, 0x4f, 0x9e, 0x3d, 0xee,
-	0xf1, 0x53, 0xc4, 0x1d, 0x55, 0x61, 0x9e, 0x05, 0xda, 0x76, 0x6d, 0x6a, 0x87, 0xd7, 0x9e, 0xc1,
-	0xdd, 0x57, 0xa7, 0xc6, 0x2a, 0x5c, 0x70, 0x6c, 0xb7, 0x2a, 0xf0, 0x32, 0x3c, 0x3a, 0x43, 0xa3,
-	0x27, 0x70, 0xbe, 0x3f, 0x49, 0xf6, 0x4c, 0x77, 0x0f, 0xb7, 0xa5, 0x4c, 0x82, 0xcb, 0x5c, 0x1e,
-	0x96, 0x19, 0x37, 0x7c, 0x67, 0x02, 0xfe, 0x16, 0xa7, 0x0b, 0xd9, 0xff, 0xc2, 0xec, 0xa8, 0xac,
-	0x85, 0x7d, 0xca, 0xaf, 0xbf, 0xb3, 0xdc, 0x22, 0x1b, 0x6b, 0x3a, 0x1a, 0xd6, 0xaf, 0x60, 0x9f,
-	0xa2, 0xff, 0xc1, 0x5c, 0xff, 0xa6, 0x30, 0x86, 0xb3, 0x0b, 0x27, 0x65, 0x77, 0x8e, 0x65, 0x77,
-	0x9c, 0xa1, 0xf3, 0x7d, 0xc9, 0x9d, 0xc1, 0xcc, 0xeb, 0x30, 0x13, 0xda, 0x0a, 0x13, 0x95, 0x3a,
-	0x6d, 0x7c, 0x50, 0x9f, 0x1d, 0x26, 0xf0, 0x19, 0x84, 0xc6, 0x8c, 0xc1, 0x9e, 0x49, 0x9f, 0xa1,
-	0x67, 0x42, 0xb7, 0x1e, 0x87, 0xcd, 0xb3, 0x02, 0xb9, 0xdd, 0xae, 0xe7, 0xb2, 0xa0, 0x60, 0x43,
-	0x56, 0x2c, 0xbb, 0xba, 0x13, 0x7a, 0x96, 0xad, 0xb3, 0xf1, 0xfd, 0x6f, 0x51, 0xa9, 0x65, 0x58,
-	0xe4, 0xc8, 0x7e, 0x9e, 0xfa, 0x0d, 0xe7, 0x61, 0xc6, 0x16, 0xf7, 0xb4, 0xbe, 0xc0, 0x40, 0xc1,
-	0x1b, 0x65, 0xd0, 0x59, 0x02, 0x81, 0xae, 0x40, 0x36, 0x34, 0xc6, 0x4a, 0x94, 0x5f, 0xd2, 0x09,
-	0x3d, 0x1d, 0x98, 0x62, 0x57, 0x17, 0x7a, 0x0c, 0xe7, 0x06, 0x8e, 0x28, 0xcb, 0x2b, 0x77, 0xda,
-	0xf0, 0x4d, 0x87, 0x93, 0x81, 0x97, 0xd6, 0x9d, 0x99, 0x77, 0x47, 0x5f, 0x35, 0xae, 0x7f, 0xa5,
-	0x00, 0x0c, 0x7c, 0x6e, 0x5c, 0x84, 0xb9, 0x9d, 0x5a, 0x53, 0x33, 0x6a, 0xf5, 0x66, 0xb5, 0xb6,
-	0x6d, 0x3c, 0xd9, 0x6e, 0xd4, 0xb5, 0xad, 0xea, 0xbd, 0xaa, 0x56, 0xc9, 0x4d, 0xa0, 0x19, 0x98,
-	0x1e, 0xdc, 0x7c, 0xae, 0x35, 0x72, 0x0a, 0x9a, 0x83, 0x99, 0xc1, 0xc5, 0xf2, 0x66, 0xa3, 0x59,
-	0xae, 0x6e, 0xe7, 0x22, 0x08, 0x41, 0x76, 0x70, 0x63, 0xbb, 0x96, 0x8b, 0xa2, 0x4b, 0xa0, 0x0e,
-	0xaf, 0x19, 0x4f, 0xab, 0xcd, 0x07, 0xc6, 0x8e, 0xd6, 0xac, 0xe5, 0x62, 0xd7, 0x7f, 0x54, 0x20,
-	0x3b, 0xfc, 0x0a, 0x8e, 0x96, 0xe0, 0x62, 0x5d, 0xaf, 0xd5, 0x6b, 0x8d, 0xf2, 0x23, 0xa3, 0xd1,
-	0x2c, 0x37, 0x9f, 0x34, 0x46, 0x7c, 0x2a, 0x40, 0x7e, 0x14, 0x50, 0xd1, 0xea, 0xb5, 0x46, 0xb5,
-	0x69, 0xd4, 0x35, 0xbd, 0x5a, 0xab, 0xe4, 0x14, 0x74, 0x19, 0x16, 0x47, 0x31, 0x3b, 0xb5, 0x66,
-	0x75, 0xfb, 0x7e, 0x00, 0x89, 0xa0, 0x05, 0xb8, 0x30, 0x0a, 0xa9, 0x97, 0x1b, 0x0d, 0xad, 0x22,
-	0x9c, 0x1e, 0xdd, 0xd3, 0xb5, 0x87, 0xda, 0x56, 0x53, 0xab, 0xe4, 0x62, 0xe3, 0x98, 0xf7, 0xca,
-	0xd5, 0x47, 0x5a, 0x25, 0x37, 0xb9, 0xa9, 0xbd, 0xf9, 0x90, 0x57, 0xde, 0x7e, 0xc8, 0x2b, 0xbf,
-	0x7f, 0xc8, 0x2b, 0xaf, 0x3e, 0xe6, 0x27, 0xde, 0x7e, 0xcc, 0x4f, 0xfc, 0xfc, 0x31, 0x3f, 0xf1,
-	0x9f, 0x1b, 0x2d, 0x9b, 0xee, 0x77, 0x77, 0x8b, 0x7b, 0xc4, 0x91, 0x1f, 0x86, 0xa5, 0x30, 0x33,
-	0xa5, 0x43, 0xfe, 0xb1, 0x4b, 0x7b, 0x1d, 0xec, 0xb3, 0x2f, 0xd9, 0x38, 0xef, 0xc5, 0xdb, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xb4, 0x23, 0x3e, 0x0a, 0x0f, 0x00, 0x00,
+	// 1939 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
+	0x19, 0x36, 0x25, 0x59, 0x96, 0x5e, 0x4b, 0x32, 0x3d, 0x76, 0xd6, 0x8c, 0xb3, 0xfe, 0x88, 0x90,
+	0x2e, 0xbc, 0xc9, 0x46, 0xb2, 0x77, 0xeb, 0xb6, 0xd8, 0x2d, 0x8a, 0x95, 0x25, 0x26, 0x66, 0x60,
+	0x4b, 0x2a, 0x45, 0x3b, 0x71, 0x81, 0x96, 0xa5, 0xc5, 0x89, 0xcc, 0x54, 0xe4, 0xa8, 0xe4, 0xc8,
+	0x89, 0xfb, 0x13, 0x8a, 0x1e, 0xf6, 0xd8, 0x53, 0xd1, 0x63, 0x8f, 0x3d, 0x04, 0xed, 0x4f, 0xe8,
+	0x9e, 0x8a, 0x45, 0x4e, 0x45, 0x81, 0xa6, 0x45, 0x72, 0x68, 0xb1, 0xe7, 0xfe, 0x80, 0x82, 0xc3,
+	0xa1, 0x48, 0xd1, 0x72, 0x6c, 0xe7, 0xd8, 0x4b, 0x62, 0xbe, 0xf3, 0x3c, 0xcf, 0xbc, 0xf3, 0x7e,
+	0x0d, 0x45, 0x58, 0xea, 0x12, 0xcf, 0x26, 0x5e, 0xb5, 0x47, 0x4e, 0xab, 0xa7, 0x5b, 0xfe, 0x7f,
+	0x95, 0x81, 0x4b, 0x28, 0x41, 0xc5, 0x60, 0xa1, 0xe2, 0x5b, 0x4e, 0xb7, 0x96, 0x57, 0x39, 0xee,
+	0xd8, 0xf0, 0x70, 0xf5, 0x74, 0xeb, 0x18, 0x53, 0x63, 0xab, 0xda, 0x25, 0x96, 0x13, 0xc0, 0x97,
+	0x17, 0x7b, 0xa4, 0x47, 0xd8, 0x9f, 0x55, 0xff, 0x2f, 0x6e, 0x5d, 0xeb, 0x11, 0xd2, 0xeb, 0xe3,
+	0x2a, 0x7b, 0x3a, 0x1e, 0x3e, 0xad, 0x52, 0xcb, 0xc6, 0x1e, 0x35, 0xec, 0x01, 0x07, 0xdc, 0x4c,
+	0x02, 0x0c, 0xe7, 0x8c, 0x2f, 0xad, 0x26, 0x97, 0xcc, 0xa1, 0x6b, 0x50, 0x8b, 0x84, 0x3b, 0xde,
+	0x0c, 0x3c, 0xd2, 0x83, 0x4d, 0xb9, 0xb7, 0xc1, 0xd2, 0xbc, 0x61, 0x5b, 0x0e, 0xa9, 0xb2, 0x7f,
+	0x03, 0x53, 0xf9, 0x19, 0x94, 0xf6, 0x87, 0x7d, 0x6a, 0x0d, 0xfa, 0xb8, 0x7e, 0x42, 0xac, 0x2e,
+	0x46, 0x12, 0xcc, 0x90, 0x81, 0xaf, 0xe7, 0x49, 0xc2, 0x7a, 0x7a, 0x23, 0xaf, 0x86, 0x8f, 0xe8,
+	0x47, 0x30, 0x43, 0x86, 0xb4, 0x4b, 0x6c, 0x2c, 0xa5, 0xd6, 0x85, 0x8d, 0xd2, 0xa7, 0x77, 0x2a,
+	0x63, 0xc1, 0xa8, 0x8c, 0x2b, 0xb5, 0x02, 0xac, 0x1a, 0x92, 0xca, 0x04, 0xd0, 0x63, 0x6c, 0xf5,
+	0x4e, 0x28, 0x36, 0x0f, 0x09, 0xc5, 0x2d, 0x26, 0x8b, 0xb6, 0x20, 0x1b, 0x6c, 0x20, 0x09, 0x4c,
+	0xf4, 0x66, 0x42, 0x34, 0x82, 0xaa, 0x1c, 0x88, 0x3e, 0x82, 0xec, 0x73, 0x26, 0xc4, 0xfc, 0xc8,
+	0xef, 0x94, 0x5e, 0xbd, 0xbc, 0x0f, 0x9c, 0xd5, 0xc0, 0x5d, 0x95, 0xaf, 0x96, 0x7f, 0x2f, 0xc0,
+	0x4c, 0x03, 0x0f, 0x88, 0x67, 0x51, 0xb4, 0x06, 0xb3, 0x03, 0x97, 0x0c, 0x88, 0x67, 0xf4, 0x75,
+	0xcb, 0x64, 0x7b, 0x65, 0x54, 0x08, 0x4d, 0x8a, 0x89, 0xbe, 0x07, 0x79, 0x33, 0xc0, 0x12, 0x97,
+	0xeb, 0x4a, 0xaf, 0x5e, 0xde, 0x5f, 0xe4, 0xba, 0x35, 0xd3, 0x74, 0xb1, 0xe7, 0x75, 0xa8, 0x6b,
+	0x39, 0x3d, 0x35, 0x82, 0xa2, 0x1f, 0x42, 0xd6, 0xb0, 0xc9, 0xd0, 0xa1, 0x52, 0x7a, 0x3d, 0xbd,
+	0x31, 0x1b, 0xf9, 0xef, 0x97, 0x44, 0x85, 0x97, 0x44, 0xa5, 0x4e, 0x2c, 0x67, 0x27, 0xff, 0xf5,
+	0xeb, 0xb5, 0xa9, 0x3f, 0xfc, 0xfb, 0x8f, 0x77, 0x05, 0x95, 0x73, 0xca, 0x7f, 0xce, 0x41, 0xae,
+	0xcd, 0x9d, 0x40, 0x25, 0x48, 0x8d, 0x5c, 0x4b, 0x59, 0x26, 0xda, 0x84, 0x9c, 0x8d, 0x3d, 0xcf,
+	0xe8, 0x61, 0x4f, 0x4a, 0x31, 0xf1, 0xc5, 0x4a, 0x90, 0xfd, 0x4a, 0x98, 0xfd, 0x4a, 0xcd, 0x39,
+	0x53, 0x47, 0x28, 0xb4, 0x0d, 0x59, 0x8f, 0x1a, 0x74, 0xe8, 0x49, 0x69, 0x16, 0xcc, 0x95, 0x44,
+	0x30, 0xc3, 0xad, 0x3a, 0x0c, 0xa4, 0x72, 0x30, 0xda, 0x05, 0xf4, 0xd4, 0x72, 0x8c, 0xbe, 0x4e,
+	0x8d, 0x7e, 0xff, 0x4c, 0x77, 0xb1, 0x37, 0xec, 0x53, 0x29, 0xb3, 0x2e, 0x6c, 0xcc, 0x7e, 0xba,
+	0x9c, 0x90, 0xd0, 0x7c, 0x88, 0xca, 0x10, 0xaa, 0xc8, 0x58, 0x31, 0x0b, 0xaa, 0xc1, 0xac, 0x37,
+	0x3c, 0xb6, 0x2d, 0xaa, 0xfb, 0x25, 0x2d, 0x4d, 0x73, 0x89, 0xa4, 0xd7, 0x5a, 0x58, 0xef, 0x3b,
+	0x99, 0xaf, 0xfe, 0xb9, 0x26, 0xa8, 0x10, 0x90, 0x7c, 0x33, 0x7a, 0x04, 0x22, 0x8f, 0xae, 0x8e,
+	0x1d, 0x33, 0xd0, 0xc9, 0x5e, 0x51, 0xa7, 0xc4, 0x99, 0xb2, 0x63, 0x32, 0x2d, 0x05, 0x8a, 0x94,
+	0x50, 0xa3, 0xaf, 0x73, 0xbb, 0x34, 0x73, 0x8d, 0x1c, 0x15, 0x18, 0x35, 0x2c, 0xa0, 0x3d, 0x98,
+	0x3f, 0x25, 0xd4, 0x72, 0x7a, 0xba, 0x47, 0x0d, 0x97, 0x9f, 0x2f, 0x77, 0x45, 0xbf, 0xe6, 0x02,
+	0x6a, 0xc7, 0x67, 0x32, 0xc7, 0x76, 0x81, 0x9b, 0xa2, 0x33, 0xe6, 0xaf, 0xa8, 0x55, 0x0c, 0x88,
+	0xe1, 0x11, 0x97, 0xfd, 0x22, 0xa1, 0x86, 0x69, 0x50, 0x43, 0x02, 0xbf, 0x6c, 0xd5, 0xd1, 0x33,
+	0xfa, 0x18, 0xa6, 0xa9, 0x45, 0xfb, 0x58, 0x9a, 0x65, 0xf5, 0xbc, 0xf0, 0xf7, 0x97, 0xf7, 0xe7,
+	0x82, 0x93, 0xdf, 0xf7, 0xcc, 0x5f, 0xac, 0x6f, 0x56, 0xbe, 0xfb, 0x7d, 0x35, 0x40, 0xa0, 0xfb,
+	0x30, 0xe3, 0x0d, 0x6d, 0xdb, 0x70, 0xcf, 0xa4, 0xc2, 0xc5, 0xe0, 0x10, 0x83, 0x1e, 0x42, 0x2e,
+	0xe8, 0x1d, 0xec, 0x4a, 0x45, 0x86, 0xbf, 0x77, 0x51, 0xb3, 0x4c, 0xd2, 0x19, 0x91, 0xd1, 0x16,
+	0xe4, 0xf1, 0x8b, 0x01, 0x36, 0x2d, 0x8a, 0x4d, 0xa9, 0xb4, 0x2e, 0x6c, 0xe4, 0x26, 0xec, 0xbc,
+	0xbd, 0xa9, 0x46, 0x28, 0xf4, 0x03, 0x28, 0x3e, 0x35, 0xac, 0x3e, 0x36, 0x75, 0x17, 0x1b, 0x1e,
+	0x71, 0xa4, 0xb9, 0x0b, 0x1c, 0xde, 0xde, 0x54, 0x0b, 0x01, 0x52, 0x65, 0x40, 0xf4, 0x25, 0x14,
+	0x47, 0x43, 0x80, 0x9e, 0x0d, 0xb0, 0x24, 0xb2, 0x2e, 0xb9, 0x75, 0x41, 0x97, 0x68, 0x67, 0x03,
+	0xac, 0x16, 0x06, 0xb1, 0x27, 0xf4, 0x00, 0xe6, 0x6c, 0x3e, 0xe5, 0xf4, 0x2e, 0x1b, 0x73, 0xd2,
+	0x3c, 0xcb, 0xdb, 0xca, 0x3b, 0x67, 0xa1, 0x5a, 0xb2, 0xc7, 0x9e, 0xd1, 0x97, 0x50, 0x7a, 0x6e,
+	0x39, 0x8e, 0x5f, 0x00, 0x7c, 0xfa, 0xa1, 0xcb, 0xa6, 0x5f, 0x91, 0x13, 0x82, 0xc7, 0xf2, 0x6f,
+	0x52, 0x30, 0x1b, 0xef, 0xbc, 0x7b, 0x90, 0x3f, 0xc3, 0x9e, 0xde, 0x65, 0xa3, 0x48, 0x38, 0x37,
+	0x17, 0x15, 0x87, 0xaa, 0xb9, 0x33, 0xec, 0xd5, 0xfd, 0x75, 0xf4, 0x19, 0x14, 0x8d, 0x63, 0x8f,
+	0x1a, 0x96, 0xc3, 0x09, 0xa9, 0x89, 0x84, 0x02, 0x07, 0x05, 0xa4, 0x8f, 0x21, 0xe7, 0x10, 0x8e,
+	0x4f, 0x4f, 0xc4, 0xcf, 0x38, 0x24, 0x80, 0x7e, 0x01, 0xc8, 0x21, 0xfa, 0x73, 0x8b, 0x9e, 0xe8,
+	0xa7, 0x98, 0x86, 0xa4, 0xcc, 0x44, 0xd2, 0x9c, 0x43, 0x1e, 0x5b, 0xf4, 0xe4, 0x10, 0x53, 0x32,
+	0x72, 0x2e, 0x88, 0x49, 0x40, 0xf3, 0xa4, 0xe9, 0xf5, 0xf4, 0x04, 0x5e, 0x21, 0x00, 0x31, 0x8e,
+	0x57, 0xfe, 0x93, 0x00, 0x19, 0x3f, 0x58, 0x97, 0x0f, 0xfa, 0x0a, 0x4c, 0x9f, 0x12, 0x8a, 0x2f,
+	0x1f, 0xf2, 0x01, 0x0c, 0x7d, 0x11, 0x5d, 0x88, 0x19, 0x36, 0x3d, 0x6e, 0x27, 0x72, 0x74, 0xfe,
+	0x52, 0x8b, 0xee, 0xcc, 0x78, 0x77, 0x4e, 0x8f, 0x77, 0xe7, 0xa3, 0x4c, 0x2e, 0x2d, 0x66, 0xca,
+	0xff, 0x10, 0xa0, 0xc8, 0x67, 0x4c, 0xdb, 0x70, 0x0d, 0xdb, 0x43, 0x47, 0x30, 0x6b, 0x5b, 0xce,
+	0x68, 0x64, 0x09, 0x97, 0x8d, 0xac, 0x15, 0x7f, 0x64, 0x7d, 0xfb, 0x7a, 0xed, 0x46, 0x8c, 0xf5,
+	0x09, 0xb1, 0x2d, 0x8a, 0xed, 0x01, 0x3d, 0x53, 0xc1, 0xb6, 0x9c, 0x70, 0x88, 0xd9, 0x80, 0x6c,
+	0xe3, 0x45, 0x08, 0xd2, 0x07, 0xd8, 0xb5, 0x88, 0xc9, 0x02, 0xe1, 0xef, 0x90, 0x9c, 0x3c, 0x0d,
+	0xfe, 0x66, 0xb1, 0x73, 0xe7, 0xdb, 0xd7, 0x6b, 0x1f, 0x9e, 0x27, 0x46, 0x9b, 0xfc, 0xd6, 0x1f,
+	0x4c, 0xa2, 0x6d, 0xbc, 0x08, 0x4f, 0xc2, 0xd6, 0x3f, 0x4f, 0x49, 0x42, 0xf9, 0x09, 0x14, 0x0e,
+	0xd9, 0xc0, 0xe2, 0xa7, 0x6b, 0x00, 0x1f, 0x60, 0xe1, 0xee, 0xc2, 0x65, 0xbb, 0x67, 0x98, 0x7a,
+	0x21, 0x60, 0xc5, 0x94, 0x7f, 0x27, 0xf0, 0x0e, 0xe0, 0xca, 0x1f, 0x41, 0xf6, 0x97, 0x43, 0xe2,
+	0x0e, 0x6d, 0x49, 0x98, 0xfc, 0x5a, 0x10, 0xac, 0xa2, 0x4f, 0x20, 0x4f, 0x4f, 0x5c, 0xec, 0x9d,
+	0x90, 0xbe, 0x79, 0xc1, 0x1b, 0x44, 0x04, 0x40, 0xdb, 0x50, 0x62, 0x25, 0x1c, 0x51, 0xd2, 0x13,
+	0x29, 0x45, 0x1f, 0xa5, 0x85, 0x20, 0xe6, 0xe0, 0x7f, 0x67, 0x21, 0xcb, 0x7d, 0x93, 0xaf, 0x99,
+	0xd3, 0xd8, 0x35, 0x14, 0xcf, 0xdf, 0xfe, 0xfb, 0xe5, 0x2f, 0x33, 0x39, 0x3f, 0xe7, 0x73, 0x91,
+	0x7e, 0x8f, 0x5c, 0xc4, 0xe2, 0x9e, 0xb9, 0x7a, 0xdc, 0xa7, 0xaf, 0x1f, 0xf7, 0xec, 0x15, 0xe2,
+	0x8e, 0x14, 0xb8, 0xe9, 0x07, 0xda, 0x72, 0x2c, 0x6a, 0x45, 0xf7, 0xbe, 0xce, 0xdc, 0x97, 0x66,
+	0x26, 0x2a, 0x7c, 0x60, 0x5b, 0x8e, 0x12, 0xe0, 0x79, 0x78, 0x54, 0x1f, 0x8d, 0x0e, 0xe0, 0xc6,
+	0x68, 0x92, 0x74, 0x0d, 0xa7, 0x8b, 0xfb, 0x5c, 0x26, 0xc7, 0x64, 0x6e, 0x8f, 0xcb, 0x4c, 0xba,
+	0x7d, 0x16, 0x42, 0x7e, 0x9d, 0xd1, 0x03, 0xd9, 0x9f, 0xc2, 0x62, 0x52, 0xd6, 0xc4, 0x1e, 0x95,
+	0xf2, 0xd7, 0xbc, 0x46, 0xb7, 0x37, 0x55, 0x34, 0xae, 0xdf, 0xc0, 0x1e, 0x45, 0xcf, 0x60, 0x69,
+	0x74, 0x55, 0xea, 0xe3, 0xd9, 0x85, 0xcb, 0xb2, 0xbb, 0xe4, 0x67, 0x77, 0xd2, 0x46, 0x37, 0x46,
+	0x92, 0x87, 0xf1, 0xcc, 0xab, 0xb0, 0x10, 0xed, 0x15, 0x25, 0x6a, 0xf6, 0xaa, 0xf1, 0x41, 0x23,
+	0x76, 0x94, 0xc0, 0x27, 0x10, 0x6d, 0xa6, 0xc7, 0x7b, 0xa6, 0x70, 0x8d, 0x9e, 0x89, 0xdc, 0xda,
+	0x8f, 0x9a, 0x67, 0x03, 0xc4, 0xe3, 0xa1, 0xeb, 0xf8, 0x41, 0xc1, 0x3a, 0xaf, 0x58, 0xff, 0xdd,
+	0x25, 0xa7, 0x96, 0x7c, 0xbb, 0x3f, 0xbe, 0x7f, 0x1c, 0x54, 0x6a, 0x0d, 0x56, 0x18, 0x72, 0x94,
+	0xa7, 0x51, 0xc3, 0xb9, 0xd8, 0x67, 0x07, 0x2f, 0x2a, 0xea, 0xb2, 0x0f, 0x0a, 0x5f, 0x16, 0xc2,
+	0xce, 0x0a, 0x10, 0xe8, 0x0e, 0x94, 0xa2, 0xcd, 0xfc, 0x12, 0x65, 0x6f, 0x29, 0x39, 0xb5, 0x10,
+	0x6e, 0xe5, 0xdf, 0x77, 0x68, 0x1f, 0xe6, 0x63, 0x47, 0xe4, 0xe5, 0x25, 0x5e, 0x35, 0x7c, 0x73,
+	0xd1, 0x64, 0x08, 0x4a, 0xeb, 0x67, 0xb0, 0xc8, 0x7f, 0x0a, 0xe8, 0x7e, 0x78, 0x4c, 0x7d, 0xc0,
+	0xa6, 0x8f, 0x34, 0x3f, 0xf1, 0xde, 0xda, 0x0f, 0xa0, 0x3b, 0x3e, 0x32, 0x18, 0x53, 0xf1, 0x10,
+	0x22, 0xfb, 0xdc, 0x32, 0xfa, 0x39, 0xac, 0xf9, 0x17, 0x9b, 0x6d, 0x79, 0xd4, 0xea, 0xea, 0xc6,
+	0x90, 0x9e, 0x10, 0xd7, 0xfa, 0x15, 0x36, 0x75, 0x23, 0xa8, 0x54, 0xec, 0x49, 0x68, 0x3d, 0xfd,
+	0xce, 0x4b, 0x75, 0x25, 0x12, 0xa8, 0x8d, 0xf8, 0xb5, 0x90, 0x8e, 0x54, 0x88, 0x01, 0x74, 0x17,
+	0x3f, 0xc3, 0xdd, 0xf1, 0xda, 0x5a, 0x98, 0xd8, 0xc2, 0xb7, 0x22, 0x92, 0xca, 0x39, 0x51, 0x45,
+	0x1d, 0x81, 0x14, 0xd3, 0x1c, 0x6f, 0x89, 0xc5, 0xab, 0x0d, 0xbc, 0x0f, 0x22, 0x81, 0x78, 0x03,
+	0x7c, 0xbe, 0xf0, 0xea, 0xfc, 0xcb, 0x6d, 0xf9, 0x2f, 0x29, 0x40, 0xe7, 0x63, 0x8b, 0x96, 0x60,
+	0xc6, 0xf6, 0x7a, 0xfa, 0xd0, 0xed, 0x07, 0xf7, 0x93, 0x9a, 0xb5, 0xbd, 0xde, 0x81, 0xdb, 0x4f,
+	0xde, 0x0d, 0xa9, 0xf7, 0xbc, 0x1b, 0xfe, 0xff, 0x86, 0xf9, 0xdd, 0xff, 0xa4, 0x00, 0x62, 0x9f,
+	0x0a, 0x6e, 0xc1, 0xd2, 0x61, 0x4b, 0x93, 0xf5, 0x56, 0x5b, 0x53, 0x5a, 0x4d, 0xfd, 0xa0, 0xd9,
+	0x69, 0xcb, 0x75, 0xe5, 0x81, 0x22, 0x37, 0xc4, 0x29, 0xb4, 0x00, 0x73, 0xf1, 0xc5, 0x23, 0xb9,
+	0x23, 0x0a, 0x68, 0x09, 0x16, 0xe2, 0xc6, 0xda, 0x4e, 0x47, 0xab, 0x29, 0x4d, 0x31, 0x85, 0x10,
+	0x94, 0xe2, 0x0b, 0xcd, 0x96, 0x98, 0x46, 0x1f, 0x82, 0x34, 0x6e, 0xd3, 0x1f, 0x2b, 0xda, 0xae,
+	0x7e, 0x28, 0x6b, 0x2d, 0x31, 0x93, 0xd4, 0x6f, 0x35, 0x65, 0x51, 0x48, 0x1a, 0xb5, 0xc7, 0x2d,
+	0x31, 0x85, 0x6e, 0xc0, 0xfc, 0x98, 0x71, 0x57, 0x95, 0x65, 0x31, 0x8d, 0x16, 0x41, 0x8c, 0x9b,
+	0x1f, 0xb4, 0x0e, 0x54, 0x31, 0x73, 0xce, 0xaa, 0x1c, 0xca, 0xe2, 0x74, 0x52, 0xb7, 0xa3, 0x3c,
+	0x11, 0xb3, 0x49, 0xdd, 0x8e, 0x7c, 0x28, 0x37, 0xc5, 0x99, 0xa4, 0x59, 0x56, 0x1e, 0xee, 0x6a,
+	0x62, 0x2e, 0x29, 0xdc, 0x54, 0x9a, 0xb2, 0x98, 0x3f, 0xe7, 0xb0, 0xdc, 0x14, 0x61, 0x39, 0x25,
+	0x0a, 0x77, 0x7f, 0x2d, 0x40, 0x21, 0xfe, 0xbb, 0x07, 0xad, 0xc0, 0xcd, 0xb6, 0xda, 0x6a, 0xb7,
+	0x3a, 0xb5, 0x3d, 0x5d, 0x3b, 0x6a, 0xcb, 0x89, 0x70, 0x2f, 0xc3, 0x07, 0xe3, 0xcb, 0x1d, 0xad,
+	0xd6, 0x6c, 0xd4, 0xd4, 0x86, 0x28, 0xa0, 0xdb, 0xb0, 0x32, 0xbe, 0xb6, 0x7f, 0xb0, 0xa7, 0x29,
+	0xed, 0x3d, 0x59, 0xaf, 0xef, 0xb6, 0x94, 0xba, 0x2c, 0xa6, 0xfc, 0x58, 0x8f, 0x43, 0x7c, 0x87,
+	0xf6, 0x95, 0x8e, 0xa6, 0xd4, 0xc5, 0xf4, 0x5d, 0x0c, 0x37, 0x26, 0x7e, 0x4b, 0x42, 0xdf, 0x81,
+	0xdb, 0x09, 0x2d, 0xbd, 0x75, 0xa0, 0xd5, 0x5b, 0xfb, 0xb2, 0xde, 0xde, 0x3b, 0x50, 0x6b, 0x7b,
+	0x8a, 0x76, 0x24, 0x4e, 0xbd, 0x0b, 0xe6, 0x67, 0xa3, 0xb3, 0xdb, 0xda, 0x6b, 0x88, 0xc2, 0xdd,
+	0xbf, 0x0a, 0x50, 0x1a, 0xff, 0x22, 0x82, 0xd6, 0xe0, 0xd6, 0xc8, 0xaf, 0x8e, 0x56, 0xd3, 0x0e,
+	0x3a, 0x89, 0x73, 0x97, 0x61, 0x35, 0x09, 0x68, 0xc8, 0xed, 0x56, 0x47, 0xd1, 0xf4, 0xb6, 0xac,
+	0x2a, 0xad, 0xe4, 0xf9, 0x39, 0xe6, 0xb0, 0xa5, 0x29, 0xcd, 0x87, 0x21, 0x24, 0x35, 0x16, 0x3e,
+	0x0e, 0x69, 0xd7, 0x3a, 0x1d, 0xb9, 0x11, 0xd4, 0x61, 0x72, 0x4d, 0x95, 0x1f, 0xc9, 0x75, 0x4d,
+	0x6e, 0x88, 0x99, 0x49, 0xcc, 0x07, 0x35, 0x65, 0x4f, 0x6e, 0x88, 0xd3, 0x3b, 0xf2, 0xd7, 0x6f,
+	0x56, 0x85, 0x6f, 0xde, 0xac, 0x0a, 0xff, 0x7a, 0xb3, 0x2a, 0x7c, 0xf5, 0x76, 0x75, 0xea, 0x9b,
+	0xb7, 0xab, 0x53, 0x7f, 0x7b, 0xbb, 0x3a, 0xf5, 0x93, 0x7b, 0x3d, 0x8b, 0x9e, 0x0c, 0x8f, 0x2b,
+	0x5d, 0x62, 0xf3, 0x6f, 0x82, 0xd5, 0x68, 0x6c, 0x55, 0x5f, 0xb0, 0xef, 0x9c, 0xfe, 0x2f, 0x62,
+	0xcf, 0xff, 0x88, 0x99, 0x65, 0xa3, 0xe2, 0xb3, 0xff, 0x0d, 0x00, 0x07, 0xea, 0x7b, 0xae, 0x05,
+	0x15, 0x00, 0x00,
+}
+
+func (m *MultipleChoice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultipleChoice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultipleChoice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Outcome != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Options[iNdEx])
+			copy(dAtA[i:], m.Options[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.Options[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1143,6 +1556,34 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WinningOption != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.WinningOption))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MultipleChoice != nil {
+		{
+			size, err := m.MultipleChoice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ProposalType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if len(m.FailedReason) > 0 {
 		i -= len(m.FailedReason)
 		copy(dAtA[i:], m.FailedReason)
@@ -1189,22 +1630,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1223,22 +1664,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x32
 	}
 	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -1301,6 +1742,15 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptionCounts) > 0 {
+		for iNdEx := len(m.OptionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptionCounts[iNdEx])
+			copy(dAtA[i:], m.OptionCounts[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptionCounts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.NoWithVetoCount) > 0 {
 		i -= len(m.NoWithVetoCount)
 		copy(dAtA[i:], m.NoWithVetoCount)
@@ -1409,12 +1859,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1456,12 +1906,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -1532,6 +1982,54 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OptimisticVotingPeriod != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.OptimisticVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.OptimisticVotingPeriod):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.OptimisticRejectedThreshold) > 0 {
+		i -= len(m.OptimisticRejectedThreshold)
+		copy(dAtA[i:], m.OptimisticRejectedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticRejectedThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for iNdEx := len(m.OptimisticAuthorizedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptimisticAuthorizedAddresses[iNdEx])
+			copy(dAtA[i:], m.OptimisticAuthorizedAddresses[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticAuthorizedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.MessageBasedParams) > 0 {
+		for iNdEx := len(m.MessageBasedParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageBasedParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	if len(m.MinDepositRatio) > 0 {
 		i -= len(m.MinDepositRatio)
 		copy(dAtA[i:], m.MinDepositRatio)
@@ -1593,12 +2091,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -1645,22 +2143,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1681,6 +2179,81 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageBasedParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VotingPeriod != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgUrl) > 0 {
+		i -= len(m.MsgUrl)
+		copy(dAtA[i:], m.MsgUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1692,6 +2265,24 @@ func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *MultipleChoice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovGov(uint64(m.Outcome))
+	}
+	return n
+}
+
 func (m *WeightedVoteOption) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1797,6 +2388,16 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.ProposalType != 0 {
+		n += 2 + sovGov(uint64(m.ProposalType))
+	}
+	if m.MultipleChoice != nil {
+		l = m.MultipleChoice.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.WinningOption != 0 {
+		n += 2 + sovGov(uint64(m.WinningOption))
+	}
 	return n
 }
 
@@ -1822,6 +2423,12 @@ func (m *TallyResult) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.OptionCounts) > 0 {
+		for _, s := range m.OptionCounts {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1975,6 +2582,61 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	if len(m.MessageBasedParams) > 0 {
+		for _, e := range m.MessageBasedParams {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for _, s := range m.OptimisticAuthorizedAddresses {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.OptimisticRejectedThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.OptimisticVotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.OptimisticVotingPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageBasedParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1984,7 +2646,7 @@ func sovGov(x uint64) (n int) {
 func sozGov(x uint64) (n int) {
 	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+func (m *MultipleChoice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2007,34 +2669,15 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultipleChoice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultipleChoice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
-			}
-			m.Option = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2062,8 +2705,27 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
+			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= MultipleChoiceOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2085,7 +2747,7 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2108,17 +2770,17 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 			}
-			m.ProposalId = 0
+			m.Option = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2128,14 +2790,14 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				m.Option |= VoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2163,20 +2825,121 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Weight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
 				b := dAtA[iNdEx]
 				iNdEx++
 				msglen |= int(b&0x7F) << shift
@@ -2715,6 +3478,80 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.FailedReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultipleChoice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MultipleChoice == nil {
+				m.MultipleChoice = &MultipleChoice{}
+			}
+			if err := m.MultipleChoice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningOption", wireType)
+			}
+			m.WinningOption = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinningOption |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2893,6 +3730,38 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			}
 			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionCounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionCounts = append(m.OptionCounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3954,6 +4823,388 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageBasedParams = append(m.MessageBasedParams, MessageBasedParams{})
+			if err := m.MessageBasedParams[len(m.MessageBasedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticAuthorizedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticAuthorizedAddresses = append(m.OptimisticAuthorizedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticRejectedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptimisticVotingPeriod == nil {
+				m.OptimisticVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.OptimisticVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageBasedParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageBasedParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
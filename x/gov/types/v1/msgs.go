@@ -8,8 +8,8 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}
-	_, _                codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgSubmitMultipleChoiceProposal{}
+	_, _                   codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -43,6 +43,11 @@ func (m *MsgSubmitProposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(m.Messages, "sdk.MsgProposal")
 }
 
+// MsgTypeURLs returns the type URLs of the proposal messages.
+func (m *MsgSubmitProposal) MsgTypeURLs() []string {
+	return anyTypeURLs(m.Messages)
+}
+
 // SetMsgs packs sdk.Msg's into m.Messages Any's
 // NOTE: this will overwrite any existing messages
 func (m *MsgSubmitProposal) SetMsgs(msgs []sdk.Msg) error {
@@ -60,6 +65,18 @@ func (m MsgSubmitProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) err
 	return sdktx.UnpackInterfaces(unpacker, m.Messages)
 }
 
+// NewMsgSubmitMultipleChoiceProposal creates a new MsgSubmitMultipleChoiceProposal.
+func NewMsgSubmitMultipleChoiceProposal(initialDeposit sdk.Coins, proposer, metadata, title, summary string, multipleChoice MultipleChoice) *MsgSubmitMultipleChoiceProposal {
+	return &MsgSubmitMultipleChoiceProposal{
+		InitialDeposit: initialDeposit,
+		Proposer:       proposer,
+		Metadata:       metadata,
+		Title:          title,
+		Summary:        summary,
+		MultipleChoice: multipleChoice,
+	}
+}
+
 // NewMsgDeposit creates a new MsgDeposit instance
 func NewMsgDeposit(depositor sdk.AccAddress, proposalID uint64, amount sdk.Coins) *MsgDeposit {
 	return &MsgDeposit{proposalID, depositor.String(), amount}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -13,24 +14,26 @@ import (
 const (
 	DefaultPeriod                         time.Duration = time.Hour * 24 * 2 // 2 days
 	DefaultExpeditedPeriod                time.Duration = time.Hour * 24 * 1 // 1 day
+	DefaultOptimisticPeriod               time.Duration = time.Hour * 24 * 1 // 1 day
 	DefaultMinExpeditedDepositTokensRatio               = 5
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens          = sdkmath.NewInt(10000000)
-	DefaultMinExpeditedDepositTokens = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
-	DefaultQuorum                    = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultThreshold                 = sdkmath.LegacyNewDecWithPrec(5, 1)
-	DefaultExpeditedThreshold        = sdkmath.LegacyNewDecWithPrec(667, 3)
-	DefaultVetoThreshold             = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultMinInitialDepositRatio    = sdkmath.LegacyZeroDec()
-	DefaultProposalCancelRatio       = sdkmath.LegacyMustNewDecFromStr("0.5")
-	DefaultProposalCancelDestAddress = ""
-	DefaultBurnProposalPrevote       = false // set to false to replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteQuorom            = false // set to false to  replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteVeto              = true  // set to true to replicate behavior of when this change was made (0.47)
-	DefaultMinDepositRatio           = sdkmath.LegacyMustNewDecFromStr("0.01")
+	DefaultMinDepositTokens            = sdkmath.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens   = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
+	DefaultQuorum                      = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultThreshold                   = sdkmath.LegacyNewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold          = sdkmath.LegacyNewDecWithPrec(667, 3)
+	DefaultVetoThreshold               = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultMinInitialDepositRatio      = sdkmath.LegacyZeroDec()
+	DefaultProposalCancelRatio         = sdkmath.LegacyMustNewDecFromStr("0.5")
+	DefaultProposalCancelDestAddress   = ""
+	DefaultBurnProposalPrevote         = false // set to false to replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteQuorom              = false // set to false to  replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteVeto                = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultMinDepositRatio             = sdkmath.LegacyMustNewDecFromStr("0.01")
+	DefaultOptimisticRejectedThreshold = sdkmath.LegacyNewDecWithPrec(1, 1)
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -62,24 +65,28 @@ func NewParams(
 	minDeposit, expeditedminDeposit sdk.Coins, maxDepositPeriod, votingPeriod, expeditedVotingPeriod time.Duration,
 	quorum, threshold, expeditedThreshold, vetoThreshold, minInitialDepositRatio, proposalCancelRatio, proposalCancelDest string,
 	burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool, minDepositRatio string,
+	optimisticAuthorizedAddresses []string, optimisticRejectedThreshold string, optimisticVotingPeriod time.Duration,
 ) Params {
 	return Params{
-		MinDeposit:                 minDeposit,
-		ExpeditedMinDeposit:        expeditedminDeposit,
-		MaxDepositPeriod:           &maxDepositPeriod,
-		VotingPeriod:               &votingPeriod,
-		ExpeditedVotingPeriod:      &expeditedVotingPeriod,
-		Quorum:                     quorum,
-		Threshold:                  threshold,
-		ExpeditedThreshold:         expeditedThreshold,
-		VetoThreshold:              vetoThreshold,
-		MinInitialDepositRatio:     minInitialDepositRatio,
-		ProposalCancelRatio:        proposalCancelRatio,
-		ProposalCancelDest:         proposalCancelDest,
-		BurnProposalDepositPrevote: burnProposalDeposit,
-		BurnVoteQuorum:             burnVoteQuorum,
-		BurnVoteVeto:               burnVoteVeto,
-		MinDepositRatio:            minDepositRatio,
+		MinDeposit:                    minDeposit,
+		ExpeditedMinDeposit:           expeditedminDeposit,
+		MaxDepositPeriod:              &maxDepositPeriod,
+		VotingPeriod:                  &votingPeriod,
+		ExpeditedVotingPeriod:         &expeditedVotingPeriod,
+		Quorum:                        quorum,
+		Threshold:                     threshold,
+		ExpeditedThreshold:            expeditedThreshold,
+		VetoThreshold:                 vetoThreshold,
+		MinInitialDepositRatio:        minInitialDepositRatio,
+		ProposalCancelRatio:           proposalCancelRatio,
+		ProposalCancelDest:            proposalCancelDest,
+		BurnProposalDepositPrevote:    burnProposalDeposit,
+		BurnVoteQuorum:                burnVoteQuorum,
+		BurnVoteVeto:                  burnVoteVeto,
+		MinDepositRatio:               minDepositRatio,
+		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
+		OptimisticRejectedThreshold:   optimisticRejectedThreshold,
+		OptimisticVotingPeriod:        &optimisticVotingPeriod,
 	}
 }
 
@@ -102,6 +109,9 @@ func DefaultParams() Params {
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
 		DefaultMinDepositRatio.String(),
+		nil,
+		DefaultOptimisticRejectedThreshold.String(),
+		DefaultOptimisticPeriod,
 	)
 }
 
@@ -219,5 +229,206 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	seenProposers := make(map[string]bool, len(p.OptimisticAuthorizedAddresses))
+	for _, addr := range p.OptimisticAuthorizedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("optimistic authorized address is invalid: %s", addr)
+		}
+		if seenProposers[addr] {
+			return fmt.Errorf("duplicate optimistic authorized address: %s", addr)
+		}
+		seenProposers[addr] = true
+	}
+
+	optimisticRejectedThreshold, err := sdkmath.LegacyNewDecFromStr(p.OptimisticRejectedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid optimistic rejected threshold string: %w", err)
+	}
+	if !optimisticRejectedThreshold.IsPositive() {
+		return fmt.Errorf("optimistic rejected threshold must be positive: %s", optimisticRejectedThreshold)
+	}
+	if optimisticRejectedThreshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("optimistic rejected threshold too large: %s", optimisticRejectedThreshold)
+	}
+
+	if p.OptimisticVotingPeriod == nil {
+		return fmt.Errorf("optimistic voting period must not be nil")
+	}
+	if p.OptimisticVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("optimistic voting period must be positive: %s", p.OptimisticVotingPeriod)
+	}
+	if p.OptimisticVotingPeriod.Seconds() > p.VotingPeriod.Seconds() {
+		return fmt.Errorf("optimistic voting period %s must not exceed the regular voting period %s", p.OptimisticVotingPeriod, p.VotingPeriod)
+	}
+
+	seenMsgURLs := make(map[string]bool, len(p.MessageBasedParams))
+	for _, mp := range p.MessageBasedParams {
+		if seenMsgURLs[mp.MsgUrl] {
+			return fmt.Errorf("duplicate message based params for %s", mp.MsgUrl)
+		}
+		seenMsgURLs[mp.MsgUrl] = true
+
+		if err := mp.validate(minDeposit); err != nil {
+			return fmt.Errorf("invalid message based params for %s: %w", mp.MsgUrl, err)
+		}
+	}
+
+	return nil
+}
+
+// validate performs basic validation on the message based params, the
+// deposit denoms being restricted to the ones of the default minimum deposit.
+func (mp MessageBasedParams) validate(defaultMinDeposit sdk.Coins) error {
+	if mp.MsgUrl == "" {
+		return fmt.Errorf("message type URL cannot be empty")
+	}
+
+	if minDeposit := sdk.Coins(mp.MinDeposit); !minDeposit.Empty() {
+		if !minDeposit.IsValid() {
+			return fmt.Errorf("invalid minimum deposit: %s", minDeposit)
+		}
+		for _, coin := range minDeposit {
+			if found, _ := defaultMinDeposit.Find(coin.Denom); !found {
+				return fmt.Errorf("minimum deposit denom %s is not accepted for deposits", coin.Denom)
+			}
+		}
+	}
+
+	if mp.VotingPeriod != nil && mp.VotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("voting period must be positive: %s", mp.VotingPeriod)
+	}
+
+	if mp.Quorum != "" {
+		quorum, err := sdkmath.LegacyNewDecFromStr(mp.Quorum)
+		if err != nil {
+			return fmt.Errorf("invalid quorum string: %w", err)
+		}
+		if quorum.IsNegative() || quorum.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("quorum must be between 0 and 1: %s", quorum)
+		}
+	}
+
+	for name, value := range map[string]string{"vote threshold": mp.Threshold, "veto threshold": mp.VetoThreshold} {
+		if value == "" {
+			continue
+		}
+		threshold, err := sdkmath.LegacyNewDecFromStr(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s string: %w", name, err)
+		}
+		if !threshold.IsPositive() || threshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("%s must be positive and at most 1: %s", name, threshold)
+		}
+	}
+
 	return nil
 }
+
+// IsOptimisticAuthorized returns true if the address is allowed to submit
+// optimistic proposals.
+func (p Params) IsOptimisticAuthorized(addr string) bool {
+	return slices.Contains(p.OptimisticAuthorizedAddresses, addr)
+}
+
+// ForMessages returns the params applying to a proposal containing messages
+// of the given type URLs. Each message follows its message based params, or
+// the default params when it has none, and the strictest requirements among
+// the messages are kept: the highest minimum deposit, quorum and threshold,
+// the longest voting period and the lowest veto threshold. The expedited
+// requirements are raised to stay stricter than the regular ones.
+func (p Params) ForMessages(msgURLs []string) Params {
+	overrides := make(map[string]MessageBasedParams, len(p.MessageBasedParams))
+	for _, mp := range p.MessageBasedParams {
+		overrides[mp.MsgUrl] = mp
+	}
+
+	var (
+		effective MessageBasedParams
+		applied   bool
+	)
+	for _, msgURL := range msgURLs {
+		mp, ok := overrides[msgURL]
+		if !ok {
+			continue
+		}
+
+		requirements := p.withDefaults(mp)
+		if !applied {
+			effective, applied = requirements, true
+			continue
+		}
+		effective = stricterMessageParams(effective, requirements)
+	}
+	if !applied {
+		return p
+	}
+
+	// messages without message based params follow the default params
+	for _, msgURL := range msgURLs {
+		if _, ok := overrides[msgURL]; !ok {
+			effective = stricterMessageParams(effective, p.withDefaults(MessageBasedParams{}))
+			break
+		}
+	}
+
+	params := p
+	params.MinDeposit = effective.MinDeposit
+	params.VotingPeriod = effective.VotingPeriod
+	params.Quorum = effective.Quorum
+	params.Threshold = effective.Threshold
+	params.VetoThreshold = effective.VetoThreshold
+
+	params.ExpeditedMinDeposit = sdk.Coins(p.ExpeditedMinDeposit).Max(effective.MinDeposit)
+	if p.ExpeditedVotingPeriod.Seconds() > effective.VotingPeriod.Seconds() {
+		params.ExpeditedVotingPeriod = effective.VotingPeriod
+	}
+	params.ExpeditedThreshold = maxDecString(p.ExpeditedThreshold, effective.Threshold)
+
+	return params
+}
+
+// withDefaults fills the unset fields of the message based params with the
+// default params.
+func (p Params) withDefaults(mp MessageBasedParams) MessageBasedParams {
+	if len(mp.MinDeposit) == 0 {
+		mp.MinDeposit = p.MinDeposit
+	}
+	if mp.VotingPeriod == nil {
+		mp.VotingPeriod = p.VotingPeriod
+	}
+	if mp.Quorum == "" {
+		mp.Quorum = p.Quorum
+	}
+	if mp.Threshold == "" {
+		mp.Threshold = p.Threshold
+	}
+	if mp.VetoThreshold == "" {
+		mp.VetoThreshold = p.VetoThreshold
+	}
+	return mp
+}
+
+// stricterMessageParams returns the strictest requirements of a and b.
+func stricterMessageParams(a, b MessageBasedParams) MessageBasedParams {
+	a.MinDeposit = sdk.Coins(a.MinDeposit).Max(b.MinDeposit)
+	if b.VotingPeriod.Seconds() > a.VotingPeriod.Seconds() {
+		a.VotingPeriod = b.VotingPeriod
+	}
+	a.Quorum = maxDecString(a.Quorum, b.Quorum)
+	a.Threshold = maxDecString(a.Threshold, b.Threshold)
+	if maxDecString(a.VetoThreshold, b.VetoThreshold) == a.VetoThreshold {
+		a.VetoThreshold = b.VetoThreshold
+	}
+	return a
+}
+
+// maxDecString returns the greatest of two decimal strings, a being returned
+// if b cannot be parsed.
+func maxDecString(a, b string) string {
+	decA, errA := sdkmath.LegacyNewDecFromStr(a)
+	decB, errB := sdkmath.LegacyNewDecFromStr(b)
+	if errB != nil || (errA == nil && decA.GTE(decB)) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,158 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestParamsForMessages(t *testing.T) {
+	const (
+		sendURL    = "/cosmos.bank.v1beta1.MsgSend"
+		upgradeURL = "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"
+		paramsURL  = "/cosmos.gov.v1.MsgUpdateParams"
+	)
+
+	params := v1.DefaultParams()
+	upgradeVotingPeriod := 2 * v1.DefaultPeriod
+	sendVotingPeriod := time.Hour
+	params.MessageBasedParams = []v1.MessageBasedParams{
+		{
+			MsgUrl:        upgradeURL,
+			MinDeposit:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinExpeditedDepositTokens.MulRaw(2))),
+			VotingPeriod:  &upgradeVotingPeriod,
+			Threshold:     "0.75",
+			VetoThreshold: "0.2",
+		},
+		{
+			MsgUrl:       sendURL,
+			MinDeposit:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1.DefaultMinDepositTokens.QuoRaw(10))),
+			VotingPeriod: &sendVotingPeriod,
+			Quorum:       "0.5",
+			Threshold:    "0.4",
+		},
+	}
+	require.NoError(t, params.ValidateBasic())
+
+	// messages without message based params follow the default params
+	require.Equal(t, params, params.ForMessages(nil))
+	require.Equal(t, params, params.ForMessages([]string{paramsURL}))
+
+	// a single message follows its message based params
+	send := params.ForMessages([]string{sendURL})
+	require.Equal(t, params.MessageBasedParams[1].MinDeposit, send.MinDeposit)
+	require.Equal(t, sendVotingPeriod, *send.VotingPeriod)
+	require.Equal(t, "0.5", send.Quorum)
+	require.Equal(t, "0.4", send.Threshold)
+	require.Equal(t, params.VetoThreshold, send.VetoThreshold)
+	require.Equal(t, params.ExpeditedMinDeposit, send.ExpeditedMinDeposit)
+	require.Equal(t, sendVotingPeriod, *send.ExpeditedVotingPeriod)
+	require.Equal(t, params.ExpeditedThreshold, send.ExpeditedThreshold)
+
+	// the expedited requirements stay stricter than the regular ones
+	upgrade := params.ForMessages([]string{upgradeURL})
+	require.Equal(t, params.MessageBasedParams[0].MinDeposit, upgrade.MinDeposit)
+	require.Equal(t, params.MessageBasedParams[0].MinDeposit, upgrade.ExpeditedMinDeposit)
+	require.Equal(t, *params.ExpeditedVotingPeriod, *upgrade.ExpeditedVotingPeriod)
+	require.Equal(t, "0.75", upgrade.ExpeditedThreshold)
+
+	// several messages follow the strictest requirements among them
+	bundle := params.ForMessages([]string{sendURL, upgradeURL, paramsURL})
+	require.Equal(t, params.MessageBasedParams[0].MinDeposit, bundle.MinDeposit)
+	require.Equal(t, upgradeVotingPeriod, *bundle.VotingPeriod)
+	require.Equal(t, "0.5", bundle.Quorum)
+	require.Equal(t, "0.75", bundle.Threshold)
+	require.Equal(t, "0.2", bundle.VetoThreshold)
+
+	// the default params are part of the strictest requirements of a bundle
+	// containing a message without message based params
+	require.Equal(t, params.MinDeposit, params.ForMessages([]string{sendURL, paramsURL}).MinDeposit)
+	require.Equal(t, send.MinDeposit, params.ForMessages([]string{sendURL, sendURL}).MinDeposit)
+}
+
+func TestParamsValidateOptimistic(t *testing.T) {
+	addr := sdk.AccAddress("optimistic_proposer").String()
+
+	testCases := []struct {
+		name      string
+		malleate  func(*v1.Params)
+		expErrMsg string
+	}{
+		{
+			name: "valid",
+			malleate: func(p *v1.Params) {
+				p.OptimisticAuthorizedAddresses = []string{addr}
+			},
+		},
+		{
+			name: "invalid authorized address",
+			malleate: func(p *v1.Params) {
+				p.OptimisticAuthorizedAddresses = []string{"invalid"}
+			},
+			expErrMsg: "optimistic authorized address is invalid",
+		},
+		{
+			name: "duplicate authorized address",
+			malleate: func(p *v1.Params) {
+				p.OptimisticAuthorizedAddresses = []string{addr, addr}
+			},
+			expErrMsg: "duplicate optimistic authorized address",
+		},
+		{
+			name: "zero rejected threshold",
+			malleate: func(p *v1.Params) {
+				p.OptimisticRejectedThreshold = "0"
+			},
+			expErrMsg: "optimistic rejected threshold must be positive",
+		},
+		{
+			name: "rejected threshold too large",
+			malleate: func(p *v1.Params) {
+				p.OptimisticRejectedThreshold = "1.1"
+			},
+			expErrMsg: "optimistic rejected threshold too large",
+		},
+		{
+			name: "nil voting period",
+			malleate: func(p *v1.Params) {
+				p.OptimisticVotingPeriod = nil
+			},
+			expErrMsg: "optimistic voting period must not be nil",
+		},
+		{
+			name: "zero voting period",
+			malleate: func(p *v1.Params) {
+				period := time.Duration(0)
+				p.OptimisticVotingPeriod = &period
+			},
+			expErrMsg: "optimistic voting period must be positive",
+		},
+		{
+			name: "voting period longer than the regular one",
+			malleate: func(p *v1.Params) {
+				period := *p.VotingPeriod + time.Second
+				p.OptimisticVotingPeriod = &period
+			},
+			expErrMsg: "optimistic voting period 48h0m1s must not exceed the regular voting period 48h0m0s",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := v1.DefaultParams()
+			tc.malleate(&params)
+
+			err := params.ValidateBasic()
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, params.IsOptimisticAuthorized(addr))
+		})
+	}
+}
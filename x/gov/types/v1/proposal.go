@@ -20,6 +20,17 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+
+	ProposalTypeStandard       = ProposalType_PROPOSAL_TYPE_STANDARD
+	ProposalTypeMultipleChoice = ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE
+	ProposalTypeOptimistic     = ProposalType_PROPOSAL_TYPE_OPTIMISTIC
+
+	// MinMultipleChoiceOptions is the minimum number of options of a multiple
+	// choice proposal.
+	MinMultipleChoiceOptions = 2
+	// MaxMultipleChoiceOptions is the maximum number of options of a multiple
+	// choice proposal.
+	MaxMultipleChoiceOptions = 10
 )
 
 // NewProposal creates a new Proposal instance
@@ -53,6 +64,88 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
 }
 
+// MsgTypeURLs returns the type URLs of the proposal messages.
+func (p Proposal) MsgTypeURLs() []string {
+	return anyTypeURLs(p.Messages)
+}
+
+// Type returns the type of the proposal, a proposal without type being a
+// standard proposal.
+func (p Proposal) Type() ProposalType {
+	if p.ProposalType == ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+		return ProposalTypeStandard
+	}
+	return p.ProposalType
+}
+
+// IsMultipleChoice returns true if the proposal is a multiple choice proposal.
+func (p Proposal) IsMultipleChoice() bool {
+	return p.ProposalType == ProposalTypeMultipleChoice
+}
+
+// IsOptimistic returns true if the proposal is an optimistic proposal.
+func (p Proposal) IsOptimistic() bool {
+	return p.ProposalType == ProposalTypeOptimistic
+}
+
+// VoteOptions returns the options which can be voted on the proposal.
+func (p Proposal) VoteOptions() []VoteOption {
+	if !p.IsMultipleChoice() {
+		return []VoteOption{OptionYes, OptionAbstain, OptionNo, OptionNoWithVeto}
+	}
+
+	options := make([]VoteOption, 0, len(p.MultipleChoice.GetOptions()))
+	for i := range p.MultipleChoice.GetOptions() {
+		options = append(options, OptionOne+VoteOption(i))
+	}
+	return options
+}
+
+// WinningOptionLabel returns the label of the winning option of a passed
+// multiple choice proposal.
+func (p Proposal) WinningOptionLabel() (string, bool) {
+	options := p.MultipleChoice.GetOptions()
+	i := int(p.WinningOption - OptionOne)
+	if !p.IsMultipleChoice() || p.WinningOption == OptionEmpty || i >= len(options) {
+		return "", false
+	}
+	return options[i], true
+}
+
+// ValidWeightedVoteOption returns true if the sub vote is valid for the
+// proposal and false otherwise.
+func (p Proposal) ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if !p.IsMultipleChoice() {
+		return ValidWeightedVoteOption(option)
+	}
+	return option.HasValidWeight() && ValidMultipleChoiceVoteOption(option.Option, len(p.MultipleChoice.GetOptions()))
+}
+
+// ValidateBasic performs a stateless validation of the options of a multiple
+// choice proposal.
+func (mc MultipleChoice) ValidateBasic() error {
+	if len(mc.Options) < MinMultipleChoiceOptions || len(mc.Options) > MaxMultipleChoiceOptions {
+		return fmt.Errorf("multiple choice proposals must have between %d and %d options, got %d", MinMultipleChoiceOptions, MaxMultipleChoiceOptions, len(mc.Options))
+	}
+
+	seen := make(map[string]bool, len(mc.Options))
+	for _, option := range mc.Options {
+		if strings.TrimSpace(option) == "" {
+			return fmt.Errorf("multiple choice option cannot be blank")
+		}
+		if seen[option] {
+			return fmt.Errorf("duplicate multiple choice option: %s", option)
+		}
+		seen[option] = true
+	}
+
+	if _, ok := MultipleChoiceOutcome_name[int32(mc.Outcome)]; !ok {
+		return fmt.Errorf("invalid multiple choice outcome: %d", mc.Outcome)
+	}
+
+	return nil
+}
+
 // GetMinDepositFromParams returns min expedited deposit from the gov params if
 // the proposal is expedited. Otherwise, returns the regular min deposit from
 // gov params.
@@ -131,3 +224,12 @@ func ValidProposalStatus(status ProposalStatus) bool {
 	}
 	return false
 }
+
+// anyTypeURLs returns the type URLs of the given Anys.
+func anyTypeURLs(anys []*types.Any) []string {
+	typeURLs := make([]string, len(anys))
+	for i, a := range anys {
+		typeURLs[i] = a.TypeUrl
+	}
+	return typeURLs
+}
@@ -81,3 +81,97 @@ func TestProposalGetMinDepositFromParams(t *testing.T) {
 		require.Equal(t, tc.expectedMinDeposit, actualMinDeposit[0].Amount)
 	}
 }
+
+func TestMultipleChoiceValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name           string
+		multipleChoice v1.MultipleChoice
+		expErr         string
+	}{
+		{
+			name:           "valid",
+			multipleChoice: v1.MultipleChoice{Options: []string{"a", "b", "c"}},
+		},
+		{
+			name:           "too few options",
+			multipleChoice: v1.MultipleChoice{Options: []string{"a"}},
+			expErr:         "must have between 2 and 10 options",
+		},
+		{
+			name:           "too many options",
+			multipleChoice: v1.MultipleChoice{Options: []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"}},
+			expErr:         "must have between 2 and 10 options",
+		},
+		{
+			name:           "blank option",
+			multipleChoice: v1.MultipleChoice{Options: []string{"a", " "}},
+			expErr:         "cannot be blank",
+		},
+		{
+			name:           "duplicate option",
+			multipleChoice: v1.MultipleChoice{Options: []string{"a", "a"}},
+			expErr:         "duplicate multiple choice option",
+		},
+		{
+			name:           "invalid outcome",
+			multipleChoice: v1.MultipleChoice{Options: []string{"a", "b"}, Outcome: 5},
+			expErr:         "invalid multiple choice outcome",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.multipleChoice.ValidateBasic()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProposalMultipleChoiceVoteOptions(t *testing.T) {
+	proposal := v1.Proposal{
+		ProposalType:   v1.ProposalTypeMultipleChoice,
+		MultipleChoice: &v1.MultipleChoice{Options: []string{"a", "b", "c"}},
+	}
+
+	require.Equal(t, []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree}, proposal.VoteOptions())
+	require.True(t, proposal.ValidWeightedVoteOption(*v1.NewWeightedVoteOption(v1.OptionThree, math.LegacyOneDec())))
+	require.False(t, proposal.ValidWeightedVoteOption(*v1.NewWeightedVoteOption(v1.OptionFour, math.LegacyOneDec())))
+	require.False(t, proposal.ValidWeightedVoteOption(*v1.NewWeightedVoteOption(v1.OptionOne, math.LegacyZeroDec())))
+
+	_, ok := proposal.WinningOptionLabel()
+	require.False(t, ok)
+	proposal.WinningOption = v1.OptionTwo
+	label, ok := proposal.WinningOptionLabel()
+	require.True(t, ok)
+	require.Equal(t, "b", label)
+
+	standard := v1.Proposal{}
+	require.Equal(t, []v1.VoteOption{v1.OptionYes, v1.OptionAbstain, v1.OptionNo, v1.OptionNoWithVeto}, standard.VoteOptions())
+	require.True(t, standard.ValidWeightedVoteOption(*v1.NewWeightedVoteOption(v1.OptionNoWithVeto, math.LegacyOneDec())))
+	require.False(t, standard.ValidWeightedVoteOption(*v1.NewWeightedVoteOption(v1.OptionFive, math.LegacyOneDec())))
+}
+
+func TestTallyResultWinningOption(t *testing.T) {
+	results := map[v1.VoteOption]math.LegacyDec{
+		v1.OptionOne:   math.LegacyNewDec(3),
+		v1.OptionThree: math.LegacyNewDec(7),
+	}
+	tally := v1.NewMultipleChoiceTallyResultFromMap(results, 3)
+	require.Equal(t, []string{"3", "0", "7"}, tally.OptionCounts)
+
+	option, count, found := tally.WinningOption()
+	require.True(t, found)
+	require.Equal(t, v1.OptionThree, option)
+	require.Equal(t, math.NewInt(7), count)
+
+	results[v1.OptionTwo] = math.LegacyNewDec(7)
+	_, _, found = v1.NewMultipleChoiceTallyResultFromMap(results, 3).WinningOption()
+	require.False(t, found)
+
+	_, _, found = v1.NewMultipleChoiceTallyResultFromMap(nil, 3).WinningOption()
+	require.False(t, found)
+}
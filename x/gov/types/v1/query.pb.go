@@ -214,6 +214,8 @@ type QueryProposalsRequest struct {
 	Depositor string `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// proposal_type defines the type of the proposals.
+	ProposalType ProposalType `protobuf:"varint,5,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
 }
 
 func (m *QueryProposalsRequest) Reset()         { *m = QueryProposalsRequest{} }
@@ -277,6 +279,13 @@ func (m *QueryProposalsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryProposalsRequest) GetProposalType() ProposalType {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+}
+
 // QueryProposalsResponse is the response type for the Query/Proposals RPC
 // method.
 type QueryProposalsResponse struct {
@@ -973,6 +982,99 @@ func (m *QueryTallyResultResponse) GetTally() *TallyResult {
 	return nil
 }
 
+// QueryProposalRequirementsRequest is the request type for the Query/ProposalRequirements RPC method.
+type QueryProposalRequirementsRequest struct {
+	// msg_urls defines the type URLs of the messages of the proposal.
+	MsgUrls []string `protobuf:"bytes,1,rep,name=msg_urls,json=msgUrls,proto3" json:"msg_urls,omitempty"`
+}
+
+func (m *QueryProposalRequirementsRequest) Reset()         { *m = QueryProposalRequirementsRequest{} }
+func (m *QueryProposalRequirementsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalRequirementsRequest) ProtoMessage()    {}
+func (*QueryProposalRequirementsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{18}
+}
+func (m *QueryProposalRequirementsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalRequirementsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalRequirementsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalRequirementsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalRequirementsRequest.Merge(m, src)
+}
+func (m *QueryProposalRequirementsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalRequirementsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalRequirementsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalRequirementsRequest proto.InternalMessageInfo
+
+func (m *QueryProposalRequirementsRequest) GetMsgUrls() []string {
+	if m != nil {
+		return m.MsgUrls
+	}
+	return nil
+}
+
+// QueryProposalRequirementsResponse is the response type for the Query/ProposalRequirements RPC method.
+type QueryProposalRequirementsResponse struct {
+	// params defines the governance params applying to the proposal, with the
+	// message based params of its messages applied.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryProposalRequirementsResponse) Reset()         { *m = QueryProposalRequirementsResponse{} }
+func (m *QueryProposalRequirementsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalRequirementsResponse) ProtoMessage()    {}
+func (*QueryProposalRequirementsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46a436d1109b50d0, []int{19}
+}
+func (m *QueryProposalRequirementsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalRequirementsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalRequirementsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalRequirementsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalRequirementsResponse.Merge(m, src)
+}
+func (m *QueryProposalRequirementsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalRequirementsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalRequirementsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalRequirementsResponse proto.InternalMessageInfo
+
+func (m *QueryProposalRequirementsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryConstitutionRequest)(nil), "cosmos.gov.v1.QueryConstitutionRequest")
 	proto.RegisterType((*QueryConstitutionResponse)(nil), "cosmos.gov.v1.QueryConstitutionResponse")
@@ -992,77 +1094,86 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryProposalRequirementsRequest)(nil), "cosmos.gov.v1.QueryProposalRequirementsRequest")
+	proto.RegisterType((*QueryProposalRequirementsResponse)(nil), "cosmos.gov.v1.QueryProposalRequirementsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 1039 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5b, 0x6f, 0xdc, 0x54,
-	0x10, 0x8e, 0x37, 0x97, 0x66, 0x27, 0x97, 0xc2, 0xa4, 0x6d, 0xb6, 0x6e, 0xbb, 0x0d, 0x0e, 0x4d,
-	0x02, 0x25, 0x76, 0x37, 0x4d, 0x1b, 0x09, 0x8a, 0x50, 0xd3, 0x92, 0x82, 0xc4, 0x43, 0x70, 0x2b,
-	0x1e, 0x78, 0x89, 0x9c, 0xac, 0x65, 0x2c, 0x36, 0x3e, 0xee, 0x9e, 0xb3, 0x2b, 0x42, 0x1a, 0x21,
-	0x55, 0xe2, 0xf2, 0x04, 0x48, 0x54, 0x82, 0xff, 0xc0, 0x6b, 0x7f, 0x04, 0x8f, 0x15, 0xbc, 0x20,
-	0x9e, 0x50, 0xc2, 0x0f, 0x41, 0x3e, 0x67, 0xec, 0xb5, 0x1d, 0xef, 0x25, 0x55, 0xd4, 0xc7, 0x9d,
-	0xf3, 0xcd, 0x37, 0xdf, 0xcc, 0x99, 0x33, 0xe3, 0x85, 0x8b, 0x3b, 0x8c, 0xef, 0x32, 0x6e, 0x79,
-	0xac, 0x6d, 0xb5, 0x6b, 0xd6, 0xe3, 0x96, 0xdb, 0xdc, 0x33, 0xc3, 0x26, 0x13, 0x0c, 0xa7, 0xd4,
-	0x91, 0xe9, 0xb1, 0xb6, 0xd9, 0xae, 0xe9, 0x6f, 0x13, 0x72, 0xdb, 0xe1, 0xae, 0xc2, 0x59, 0xed,
-	0xda, 0xb6, 0x2b, 0x9c, 0x9a, 0x15, 0x3a, 0x9e, 0x1f, 0x38, 0xc2, 0x67, 0x81, 0x72, 0xd5, 0x2f,
-	0x7b, 0x8c, 0x79, 0x0d, 0xd7, 0x72, 0x42, 0xdf, 0x72, 0x82, 0x80, 0x09, 0x79, 0xc8, 0xe9, 0x74,
-	0x36, 0x1b, 0x33, 0xe2, 0x57, 0x07, 0x24, 0x66, 0x4b, 0xfe, 0xb2, 0x28, 0xbc, 0xfc, 0x61, 0xe8,
-	0x50, 0xf9, 0x34, 0x8a, 0x79, 0x8f, 0x05, 0x5c, 0xf8, 0xa2, 0x15, 0xf1, 0xd9, 0xee, 0xe3, 0x96,
-	0xcb, 0x85, 0xf1, 0x01, 0x5c, 0x2c, 0x38, 0xe3, 0x21, 0x0b, 0xb8, 0x8b, 0x06, 0x4c, 0xee, 0xa4,
-	0xec, 0x15, 0x6d, 0x4e, 0x5b, 0x2a, 0xdb, 0x19, 0x9b, 0xb1, 0x06, 0xe7, 0x24, 0xc1, 0x66, 0x93,
-	0x85, 0x8c, 0x3b, 0x0d, 0x22, 0xc6, 0xab, 0x30, 0x11, 0x92, 0x69, 0xcb, 0xaf, 0x4b, 0xd7, 0x11,
-	0x1b, 0x62, 0xd3, 0xc7, 0x75, 0xe3, 0x13, 0x38, 0x9f, 0x73, 0xa4, 0xa8, 0x37, 0x61, 0x3c, 0x86,
-	0x49, 0xb7, 0x89, 0x95, 0x59, 0x33, 0x53, 0x4e, 0x33, 0x71, 0x49, 0x80, 0xc6, 0x4f, 0xa5, 0x1c,
-	0x1d, 0x8f, 0x85, 0x6c, 0xc0, 0xd9, 0x44, 0x08, 0x17, 0x8e, 0x68, 0x71, 0xc9, 0x3a, 0xbd, 0x72,
-	0xa5, 0x0b, 0xeb, 0x43, 0x09, 0xb2, 0xa7, 0xc3, 0xcc, 0x6f, 0x34, 0x61, 0xb4, 0xcd, 0x84, 0xdb,
-	0xac, 0x94, 0xa2, 0x2a, 0xac, 0x57, 0xfe, 0x7c, 0xbe, 0x7c, 0x8e, 0x08, 0xee, 0xd6, 0xeb, 0x4d,
-	0x97, 0xf3, 0x87, 0xa2, 0xe9, 0x07, 0x9e, 0xad, 0x60, 0x78, 0x1b, 0xca, 0x75, 0x37, 0x64, 0xdc,
-	0x17, 0xac, 0x59, 0x19, 0xee, 0xe3, 0xd3, 0x81, 0xe2, 0x06, 0x40, 0xa7, 0x27, 0x2a, 0x23, 0xb2,
-	0x00, 0x0b, 0xb1, 0xd4, 0xa8, 0x81, 0x4c, 0xd5, 0x68, 0xd4, 0x40, 0xe6, 0xa6, 0xe3, 0xb9, 0x94,
-	0xab, 0x9d, 0xf2, 0x34, 0x7e, 0xd3, 0xe0, 0x42, 0xbe, 0x22, 0x54, 0xe1, 0x5b, 0x50, 0x8e, 0x93,
-	0x8b, 0x8a, 0x31, 0xdc, 0xab, 0xc4, 0x1d, 0x24, 0x3e, 0xc8, 0x28, 0x2b, 0x49, 0x65, 0x8b, 0x7d,
-	0x95, 0xa9, 0x98, 0x19, 0x69, 0x3b, 0xf0, 0x9a, 0x54, 0xf6, 0x19, 0x13, 0xee, 0xa0, 0xfd, 0x72,
-	0xd2, 0xfa, 0x1b, 0x77, 0xe0, 0xf5, 0x54, 0x10, 0xca, 0x7c, 0x11, 0x46, 0xa2, 0x53, 0xea, 0xab,
-	0x99, 0x5c, 0xd2, 0x12, 0x2a, 0x01, 0xc6, 0x93, 0x94, 0x37, 0x1f, 0x58, 0xe3, 0x46, 0x41, 0x85,
-	0x5e, 0xe6, 0xee, 0x7e, 0xd0, 0x00, 0xd3, 0xe1, 0x49, 0xfd, 0x5b, 0xaa, 0x04, 0xf1, 0x9d, 0x15,
-	0xca, 0x57, 0x88, 0xd3, 0xbb, 0xab, 0x5b, 0xa4, 0x64, 0xd3, 0x69, 0x3a, 0xbb, 0x99, 0x4a, 0x48,
-	0xc3, 0x96, 0xd8, 0x0b, 0x5d, 0x1a, 0x0c, 0xa0, 0x4c, 0x8f, 0xf6, 0x42, 0xd7, 0xf8, 0xbd, 0x04,
-	0x33, 0x19, 0x3f, 0x4a, 0xe1, 0x3e, 0x4c, 0xb5, 0x99, 0xf0, 0x03, 0x6f, 0x4b, 0x81, 0xe9, 0x26,
-	0x2e, 0x1d, 0x4f, 0xc5, 0x0f, 0x3c, 0xe5, 0xbb, 0x5e, 0xaa, 0x68, 0xf6, 0x64, 0x3b, 0x65, 0xc1,
-	0x07, 0x30, 0x4d, 0x0f, 0x26, 0xa6, 0x51, 0x19, 0x5e, 0xce, 0xd1, 0xdc, 0x57, 0xa0, 0x14, 0xcf,
-	0x54, 0x3d, 0x6d, 0xc2, 0xbb, 0x30, 0x29, 0x9c, 0x46, 0x63, 0x2f, 0xa6, 0x19, 0x96, 0x34, 0x7a,
-	0x8e, 0xe6, 0x51, 0x04, 0x49, 0x91, 0x4c, 0x88, 0x8e, 0x01, 0xd7, 0x61, 0x8c, 0x9c, 0xd5, 0x5b,
-	0x3d, 0x9f, 0x7f, 0x49, 0xca, 0x6f, 0xe6, 0x9f, 0xe7, 0xcb, 0x67, 0xd5, 0xc9, 0x32, 0xaf, 0x7f,
-	0x39, 0x77, 0xc3, 0x5c, 0x5d, 0xb3, 0xc9, 0xd3, 0x08, 0xa8, 0x58, 0xa4, 0x77, 0xe0, 0x7e, 0xcb,
-	0xcc, 0x98, 0xd2, 0xc0, 0x33, 0xc6, 0xf8, 0x88, 0x86, 0x76, 0x12, 0x8f, 0x6e, 0xe7, 0x06, 0x9c,
-	0x21, 0x10, 0xdd, 0xcb, 0x85, 0xe2, 0x82, 0xda, 0x31, 0xcc, 0xf8, 0x26, 0xcb, 0xf4, 0xea, 0x9f,
-	0xca, 0x33, 0x8d, 0x06, 0x7f, 0x47, 0x01, 0x25, 0xb3, 0x02, 0xe3, 0xa4, 0x32, 0x7e, 0x30, 0xdd,
-	0xb2, 0x49, 0x70, 0xa7, 0xf7, 0x6c, 0xde, 0x85, 0x59, 0xa9, 0x4a, 0xb6, 0x8e, 0xed, 0xf2, 0x56,
-	0x43, 0x9c, 0x60, 0x33, 0x56, 0x8e, 0xfb, 0x26, 0x37, 0x34, 0x2a, 0x9b, 0x8f, 0xee, 0xa7, 0xb0,
-	0x53, 0xc9, 0x45, 0x01, 0x57, 0x8e, 0xca, 0x30, 0x2a, 0xe9, 0xf0, 0x3b, 0x0d, 0x26, 0xd3, 0x7b,
-	0x1e, 0x17, 0x73, 0xde, 0xdd, 0xbe, 0x12, 0xf4, 0xa5, 0xfe, 0x40, 0xa5, 0xcf, 0x98, 0x7f, 0xfa,
-	0xd7, 0x7f, 0xbf, 0x94, 0xae, 0xe0, 0x25, 0x2b, 0xfb, 0xa1, 0x92, 0xfe, 0x66, 0xc0, 0x6f, 0x35,
-	0x18, 0x8f, 0x17, 0x0c, 0xce, 0x17, 0x71, 0xe7, 0xbe, 0x26, 0xf4, 0x37, 0x7b, 0x83, 0x28, 0xb8,
-	0x29, 0x83, 0x2f, 0xe1, 0x42, 0x2e, 0x78, 0xb2, 0xc2, 0xac, 0xfd, 0x54, 0xe5, 0x0f, 0xf0, 0x6b,
-	0x28, 0x27, 0xcb, 0x11, 0x7b, 0x86, 0x88, 0xfb, 0x5a, 0xbf, 0xd6, 0x07, 0x45, 0x4a, 0xe6, 0xa4,
-	0x12, 0x1d, 0x2b, 0xdd, 0x94, 0xe0, 0xf7, 0x1a, 0x8c, 0x44, 0x03, 0x1b, 0xaf, 0x16, 0x31, 0xa6,
-	0x36, 0xa3, 0x3e, 0xd7, 0x1d, 0x40, 0xd1, 0xee, 0xc8, 0x68, 0xb7, 0x71, 0x75, 0xb0, 0xbc, 0x2d,
-	0xb9, 0x22, 0xac, 0x7d, 0xb9, 0x27, 0x0f, 0xf0, 0xa9, 0x06, 0xa3, 0x72, 0xcf, 0x60, 0xd7, 0x48,
-	0x49, 0xfa, 0x6f, 0xf4, 0x40, 0x90, 0x98, 0x55, 0x29, 0xc6, 0xc4, 0x77, 0x4e, 0x22, 0x06, 0x9f,
-	0xc0, 0x18, 0xcd, 0xd3, 0xc2, 0x10, 0x99, 0xed, 0xa3, 0x1b, 0xbd, 0x20, 0x24, 0xe3, 0xba, 0x94,
-	0x71, 0x0d, 0xe7, 0xf3, 0x32, 0x24, 0xcc, 0xda, 0x4f, 0xad, 0xaf, 0x03, 0xfc, 0x55, 0x83, 0x33,
-	0x34, 0x0c, 0xb0, 0x90, 0x3c, 0x3b, 0x98, 0xf5, 0xf9, 0x9e, 0x18, 0x52, 0x70, 0x4f, 0x2a, 0x78,
-	0x1f, 0xdf, 0x1b, 0xb0, 0x10, 0xf1, 0x10, 0xb2, 0xf6, 0x93, 0x41, 0x7d, 0x80, 0x3f, 0x6a, 0x30,
-	0x1e, 0x4f, 0x36, 0xec, 0x15, 0x96, 0xf7, 0x7c, 0x2a, 0xf9, 0xe1, 0x68, 0xac, 0x49, 0x71, 0x35,
-	0xb4, 0x4e, 0x28, 0x0e, 0x9f, 0x69, 0x30, 0x91, 0x9a, 0x32, 0xb8, 0x50, 0x14, 0xee, 0xf8, 0xd4,
-	0xd3, 0x17, 0xfb, 0xe2, 0x5e, 0xb2, 0x7f, 0xe4, 0x94, 0x5b, 0xff, 0xf0, 0x8f, 0xc3, 0xaa, 0xf6,
-	0xe2, 0xb0, 0xaa, 0xfd, 0x7b, 0x58, 0xd5, 0x7e, 0x3e, 0xaa, 0x0e, 0xbd, 0x38, 0xaa, 0x0e, 0xfd,
-	0x7d, 0x54, 0x1d, 0xfa, 0xfc, 0xba, 0xe7, 0x8b, 0x2f, 0x5a, 0xdb, 0xe6, 0x0e, 0xdb, 0x8d, 0x19,
-	0x3b, 0x6b, 0xd8, 0xfa, 0x4a, 0xd2, 0x47, 0x5d, 0xc0, 0xa3, 0xbf, 0x64, 0x63, 0xf2, 0x1f, 0xd3,
-	0xcd, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x44, 0x39, 0x4f, 0xd2, 0xdb, 0x0d, 0x00, 0x00,
+	// 1138 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0xdc, 0x54,
+	0x17, 0x8e, 0x27, 0xdf, 0x27, 0x1f, 0x7d, 0xdf, 0x93, 0xa4, 0x71, 0x9c, 0x76, 0x9a, 0x3a, 0x6d,
+	0x12, 0x68, 0x63, 0x27, 0x69, 0xda, 0x48, 0xd0, 0x0a, 0x9a, 0x96, 0x14, 0x24, 0x16, 0xc1, 0x2d,
+	0x2c, 0xd8, 0x8c, 0x9c, 0x8c, 0x65, 0x2c, 0x66, 0x7c, 0x5d, 0x5f, 0xcf, 0x88, 0x90, 0x46, 0x48,
+	0x95, 0xf8, 0xd8, 0x80, 0x90, 0xa8, 0x04, 0xff, 0x80, 0x05, 0xdb, 0xfe, 0x08, 0x96, 0x05, 0x36,
+	0x88, 0x15, 0x4a, 0xf8, 0x21, 0xc8, 0xf7, 0x1e, 0xcf, 0xd8, 0xce, 0x7c, 0xa5, 0xaa, 0x58, 0x8d,
+	0x7c, 0xef, 0x73, 0x9e, 0xf3, 0x9c, 0x7b, 0xce, 0x3d, 0xe7, 0x0e, 0xcc, 0xed, 0x33, 0x5e, 0x65,
+	0xdc, 0x74, 0x59, 0xdd, 0xac, 0xaf, 0x9b, 0x8f, 0x6b, 0x4e, 0x78, 0x60, 0x04, 0x21, 0x8b, 0x18,
+	0x4e, 0xc8, 0x2d, 0xc3, 0x65, 0x75, 0xa3, 0xbe, 0xae, 0xbd, 0x4e, 0xc8, 0x3d, 0x9b, 0x3b, 0x12,
+	0x67, 0xd6, 0xd7, 0xf7, 0x9c, 0xc8, 0x5e, 0x37, 0x03, 0xdb, 0xf5, 0x7c, 0x3b, 0xf2, 0x98, 0x2f,
+	0x4d, 0xb5, 0x0b, 0x2e, 0x63, 0x6e, 0xc5, 0x31, 0xed, 0xc0, 0x33, 0x6d, 0xdf, 0x67, 0x91, 0xd8,
+	0xe4, 0xb4, 0x3b, 0x9b, 0xf5, 0x19, 0xf3, 0xcb, 0x0d, 0x12, 0x53, 0x12, 0x5f, 0x26, 0xb9, 0x17,
+	0x1f, 0xba, 0x06, 0xea, 0x07, 0xb1, 0xcf, 0x7b, 0xcc, 0xe7, 0x91, 0x17, 0xd5, 0x62, 0x3e, 0xcb,
+	0x79, 0x5c, 0x73, 0x78, 0xa4, 0xbf, 0x05, 0x73, 0x2d, 0xf6, 0x78, 0xc0, 0x7c, 0xee, 0xa0, 0x0e,
+	0xe3, 0xfb, 0xa9, 0x75, 0x55, 0x59, 0x50, 0x56, 0x46, 0xad, 0xcc, 0x9a, 0xbe, 0x05, 0xd3, 0x82,
+	0x60, 0x37, 0x64, 0x01, 0xe3, 0x76, 0x85, 0x88, 0xf1, 0x12, 0x8c, 0x05, 0xb4, 0x54, 0xf2, 0xca,
+	0xc2, 0x74, 0xc0, 0x82, 0x64, 0xe9, 0xbd, 0xb2, 0xfe, 0x3e, 0xcc, 0xe4, 0x0c, 0xc9, 0xeb, 0x0d,
+	0x18, 0x49, 0x60, 0xc2, 0x6c, 0x6c, 0x63, 0xd6, 0xc8, 0x1c, 0xa7, 0xd1, 0x30, 0x69, 0x00, 0xf5,
+	0xdf, 0x0a, 0x39, 0x3a, 0x9e, 0x08, 0xd9, 0x81, 0x73, 0x0d, 0x21, 0x3c, 0xb2, 0xa3, 0x1a, 0x17,
+	0xac, 0x93, 0x1b, 0x17, 0xdb, 0xb0, 0x3e, 0x14, 0x20, 0x6b, 0x32, 0xc8, 0x7c, 0xa3, 0x01, 0x83,
+	0x75, 0x16, 0x39, 0xa1, 0x5a, 0x88, 0x4f, 0x61, 0x5b, 0xfd, 0xfd, 0xf9, 0xea, 0x34, 0x11, 0xdc,
+	0x2d, 0x97, 0x43, 0x87, 0xf3, 0x87, 0x51, 0xe8, 0xf9, 0xae, 0x25, 0x61, 0x78, 0x0b, 0x46, 0xcb,
+	0x4e, 0xc0, 0xb8, 0x17, 0xb1, 0x50, 0xed, 0xef, 0x62, 0xd3, 0x84, 0xe2, 0x0e, 0x40, 0xb3, 0x26,
+	0xd4, 0x01, 0x71, 0x00, 0x4b, 0x89, 0xd4, 0xb8, 0x80, 0x0c, 0x59, 0x68, 0x54, 0x40, 0xc6, 0xae,
+	0xed, 0x3a, 0x14, 0xab, 0x95, 0xb2, 0xc4, 0xb7, 0x61, 0xa2, 0x11, 0x77, 0x74, 0x10, 0x38, 0xea,
+	0xa0, 0x88, 0x7a, 0xbe, 0x4d, 0xd4, 0x8f, 0x0e, 0x02, 0xc7, 0x1a, 0x0f, 0x52, 0x5f, 0xfa, 0x4f,
+	0x0a, 0x9c, 0xcf, 0x9f, 0x29, 0xe5, 0xe8, 0x26, 0x8c, 0x26, 0xd0, 0xf8, 0x38, 0xfb, 0x3b, 0x25,
+	0xa9, 0x89, 0xc4, 0x07, 0x99, 0xd8, 0x0a, 0x22, 0xb6, 0xe5, 0xae, 0xb1, 0x49, 0x9f, 0xe9, 0xe0,
+	0xf4, 0x7d, 0xf8, 0x9f, 0x50, 0xf6, 0x11, 0x8b, 0x9c, 0x5e, 0x2b, 0xee, 0xac, 0x19, 0xd4, 0x6f,
+	0xc3, 0xff, 0x53, 0x4e, 0x28, 0xf2, 0x65, 0x18, 0x88, 0x77, 0xa9, 0x32, 0xa7, 0x72, 0x41, 0x0b,
+	0xa8, 0x00, 0xe8, 0x4f, 0x52, 0xd6, 0xbc, 0x67, 0x8d, 0x3b, 0x2d, 0x4e, 0xe8, 0x25, 0xb2, 0xaf,
+	0x7f, 0xa3, 0x00, 0xa6, 0xdd, 0x93, 0xfa, 0xd7, 0xe4, 0x11, 0x24, 0x39, 0x6b, 0x29, 0x5f, 0x22,
+	0x5e, 0x5d, 0xae, 0x6e, 0x92, 0x92, 0x5d, 0x3b, 0xb4, 0xab, 0x99, 0x93, 0x10, 0x0b, 0xb2, 0x38,
+	0x65, 0x6b, 0x01, 0xb9, 0x24, 0xaa, 0xef, 0x97, 0x02, 0x4c, 0x65, 0xec, 0x28, 0x84, 0xfb, 0x30,
+	0x51, 0x67, 0x91, 0xe7, 0xbb, 0x25, 0x09, 0xa6, 0x4c, 0xcc, 0x9f, 0x0e, 0xc5, 0xf3, 0x5d, 0x69,
+	0xbb, 0x5d, 0x50, 0x15, 0x6b, 0xbc, 0x9e, 0x5a, 0xc1, 0x07, 0x30, 0x49, 0x57, 0x2e, 0xa1, 0x91,
+	0x11, 0x5e, 0xc8, 0xd1, 0xdc, 0x97, 0xa0, 0x14, 0xcf, 0x44, 0x39, 0xbd, 0x84, 0x77, 0x61, 0x3c,
+	0xb2, 0x2b, 0x95, 0x83, 0x84, 0xa6, 0x5f, 0xd0, 0x68, 0x39, 0x9a, 0x47, 0x31, 0x24, 0x45, 0x32,
+	0x16, 0x35, 0x17, 0x70, 0x1b, 0x86, 0xc8, 0x58, 0xde, 0xf6, 0x99, 0xfc, 0x4d, 0x92, 0x76, 0x53,
+	0x7f, 0x3d, 0x5f, 0x3d, 0x27, 0x77, 0x56, 0x79, 0xf9, 0xd3, 0x85, 0x35, 0x63, 0x73, 0xcb, 0x22,
+	0x4b, 0xdd, 0xa7, 0xc3, 0x22, 0xbd, 0x3d, 0xd7, 0x5b, 0xa6, 0x4b, 0x15, 0x7a, 0xee, 0x52, 0xfa,
+	0xbb, 0x30, 0x9d, 0xf5, 0x47, 0xd9, 0x59, 0x83, 0x61, 0x02, 0x51, 0x5e, 0xce, 0xb7, 0x3e, 0x50,
+	0x2b, 0x81, 0xe9, 0x5f, 0x64, 0x99, 0xfe, 0xfb, 0xab, 0xf2, 0x4c, 0x81, 0x99, 0x9c, 0x02, 0x0a,
+	0x66, 0x03, 0x46, 0x48, 0x65, 0x72, 0x61, 0xda, 0x45, 0xd3, 0xc0, 0xbd, 0xba, 0x6b, 0xf3, 0x06,
+	0xcc, 0x0a, 0x55, 0xa2, 0x74, 0x2c, 0x87, 0xd7, 0x2a, 0xd1, 0x19, 0x66, 0xab, 0x7a, 0xda, 0xb6,
+	0x91, 0xa1, 0x41, 0x51, 0x7c, 0xaa, 0xd2, 0xbe, 0x52, 0xc9, 0x44, 0x02, 0xf5, 0x3b, 0xb0, 0x70,
+	0x6a, 0xc4, 0x7b, 0xa1, 0x53, 0x75, 0xfc, 0x66, 0xb6, 0xe6, 0x60, 0xa4, 0xca, 0xdd, 0x52, 0x2d,
+	0xa4, 0x79, 0x30, 0x6a, 0x0d, 0x57, 0xb9, 0xfb, 0x61, 0x58, 0xe1, 0xba, 0x05, 0x97, 0x3b, 0x98,
+	0x93, 0xaa, 0xd5, 0xc6, 0x1d, 0x50, 0x3a, 0xdc, 0x81, 0xa4, 0xdc, 0x37, 0xbe, 0x1d, 0x83, 0x41,
+	0x41, 0x8a, 0x5f, 0x29, 0x30, 0x9e, 0x7e, 0xbc, 0xe0, 0x72, 0xce, 0xb2, 0xdd, 0xd3, 0x47, 0x5b,
+	0xe9, 0x0e, 0x94, 0xe2, 0xf4, 0xc5, 0xa7, 0x7f, 0xfc, 0xf3, 0x43, 0xe1, 0x22, 0xce, 0x9b, 0xd9,
+	0xd7, 0x57, 0xfa, 0x21, 0x84, 0x5f, 0x2a, 0x30, 0x92, 0x84, 0x88, 0x8b, 0xad, 0xb8, 0x73, 0x4f,
+	0x24, 0xed, 0x4a, 0x67, 0x10, 0x39, 0x37, 0x84, 0xf3, 0x15, 0x5c, 0xca, 0x39, 0x6f, 0x4c, 0x55,
+	0xf3, 0x30, 0x55, 0x0c, 0x47, 0xf8, 0x39, 0x8c, 0x26, 0x1c, 0x1c, 0x3b, 0xba, 0x48, 0x92, 0xa7,
+	0x5d, 0xed, 0x82, 0x22, 0x25, 0x0b, 0x42, 0x89, 0x86, 0x6a, 0x3b, 0x25, 0xf8, 0xb5, 0x02, 0x03,
+	0xf1, 0x0c, 0xc1, 0x4b, 0xad, 0x18, 0x53, 0xc3, 0x5a, 0x5b, 0x68, 0x0f, 0x20, 0x6f, 0xb7, 0x85,
+	0xb7, 0x5b, 0xb8, 0xd9, 0x5b, 0xdc, 0xa6, 0x98, 0x5a, 0xe6, 0x61, 0xfc, 0x13, 0x1e, 0xe1, 0x53,
+	0x05, 0x06, 0x63, 0x3a, 0x8e, 0x6d, 0x3d, 0x35, 0xc2, 0xbf, 0xdc, 0x01, 0x41, 0x62, 0x36, 0x85,
+	0x18, 0x03, 0xaf, 0x9f, 0x45, 0x0c, 0x3e, 0x81, 0x21, 0x6a, 0xf1, 0x2d, 0x5d, 0x64, 0x06, 0xa2,
+	0xa6, 0x77, 0x82, 0x90, 0x8c, 0x6b, 0x42, 0xc6, 0x55, 0x5c, 0xcc, 0xcb, 0x10, 0x30, 0xf3, 0x30,
+	0x35, 0x51, 0x8f, 0xf0, 0x47, 0x05, 0x86, 0xa9, 0x3f, 0x61, 0x4b, 0xf2, 0xec, 0xac, 0xd0, 0x16,
+	0x3b, 0x62, 0x48, 0xc1, 0x3d, 0xa1, 0xe0, 0x0e, 0xbe, 0xd9, 0xe3, 0x41, 0x24, 0x7d, 0xd1, 0x3c,
+	0x6c, 0xcc, 0x8e, 0x23, 0xfc, 0x4e, 0x81, 0x11, 0x22, 0xe6, 0xd8, 0xc9, 0x2d, 0xef, 0x78, 0x55,
+	0xf2, 0xfd, 0x5a, 0xdf, 0x12, 0xe2, 0xd6, 0xd1, 0x3c, 0xa3, 0x38, 0x7c, 0xa6, 0xc0, 0x58, 0xaa,
+	0xf1, 0xe1, 0x52, 0x2b, 0x77, 0xa7, 0x1b, 0xb1, 0xb6, 0xdc, 0x15, 0xf7, 0x92, 0xf5, 0x23, 0x1a,
+	0x2f, 0xfe, 0xac, 0xc0, 0x74, 0xab, 0xae, 0x89, 0x66, 0xb7, 0xf6, 0x92, 0x6b, 0xcf, 0xda, 0x5a,
+	0xef, 0x06, 0xa4, 0xf8, 0xba, 0x50, 0xbc, 0x84, 0x57, 0xda, 0x28, 0x2e, 0x85, 0x29, 0xab, 0xed,
+	0x77, 0x7e, 0x3d, 0x2e, 0x2a, 0x2f, 0x8e, 0x8b, 0xca, 0xdf, 0xc7, 0x45, 0xe5, 0xfb, 0x93, 0x62,
+	0xdf, 0x8b, 0x93, 0x62, 0xdf, 0x9f, 0x27, 0xc5, 0xbe, 0x8f, 0xaf, 0xb9, 0x5e, 0xf4, 0x49, 0x6d,
+	0xcf, 0xd8, 0x67, 0xd5, 0x84, 0xa9, 0xf9, 0x86, 0x31, 0x3f, 0x13, 0xb4, 0x71, 0xbd, 0xf2, 0xf8,
+	0x1f, 0xf1, 0x90, 0xf8, 0xc3, 0x7a, 0xe3, 0xdf, 0x01, 0x00, 0x56, 0x6e, 0x1c, 0x0f, 0x5a, 0x0f,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1095,6 +1206,9 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ProposalRequirements queries the effective governance requirements of a
+	// proposal containing the given message types.
+	ProposalRequirements(ctx context.Context, in *QueryProposalRequirementsRequest, opts ...grpc.CallOption) (*QueryProposalRequirementsResponse, error)
 }
 
 type queryClient struct {
@@ -1186,6 +1300,15 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) ProposalRequirements(ctx context.Context, in *QueryProposalRequirementsRequest, opts ...grpc.CallOption) (*QueryProposalRequirementsResponse, error) {
+	out := new(QueryProposalRequirementsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Query/ProposalRequirements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Constitution queries the chain's constitution.
@@ -1206,6 +1329,9 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ProposalRequirements queries the effective governance requirements of a
+	// proposal containing the given message types.
+	ProposalRequirements(context.Context, *QueryProposalRequirementsRequest) (*QueryProposalRequirementsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1239,6 +1365,9 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) ProposalRequirements(ctx context.Context, req *QueryProposalRequirementsRequest) (*QueryProposalRequirementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalRequirements not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1406,6 +1535,24 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProposalRequirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalRequirementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalRequirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Query/ProposalRequirements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalRequirements(ctx, req.(*QueryProposalRequirementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Query",
@@ -1447,6 +1594,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "ProposalRequirements",
+			Handler:    _Query_ProposalRequirements_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -1588,6 +1739,11 @@ func (m *QueryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProposalType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalType))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -2153,6 +2309,73 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProposalRequirementsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRequirementsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRequirementsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgUrls) > 0 {
+		for iNdEx := len(m.MsgUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgUrls[iNdEx])
+			copy(dAtA[i:], m.MsgUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalRequirementsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRequirementsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRequirementsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2232,6 +2455,9 @@ func (m *QueryProposalsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.ProposalType != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalType))
+	}
 	return n
 }
 
@@ -2445,6 +2671,34 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposalRequirementsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgUrls) > 0 {
+		for _, s := range m.MsgUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProposalRequirementsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2886,6 +3140,25 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4282,6 +4555,174 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProposalRequirementsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalRequirementsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalRequirementsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgUrls = append(m.MsgUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalRequirementsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalRequirementsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalRequirementsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
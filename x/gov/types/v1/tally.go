@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"slices"
+
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,6 +50,46 @@ func NewTallyResultFromMap(results map[VoteOption]math.LegacyDec) TallyResult {
 	)
 }
 
+// NewMultipleChoiceTallyResultFromMap creates a new TallyResult instance of a
+// multiple choice proposal with the given number of options from a Option ->
+// Dec map.
+func NewMultipleChoiceTallyResultFromMap(results map[VoteOption]math.LegacyDec, numOptions int) TallyResult {
+	tally := EmptyTallyResult()
+	tally.OptionCounts = make([]string, numOptions)
+	for i := range tally.OptionCounts {
+		count := math.ZeroInt()
+		if power, ok := results[OptionOne+VoteOption(i)]; ok {
+			count = power.TruncateInt()
+		}
+		tally.OptionCounts[i] = count.String()
+	}
+	return tally
+}
+
+// WinningOption returns the multiple choice option with the most votes, or
+// false if no option has strictly more votes than every other option.
+func (tr TallyResult) WinningOption() (option VoteOption, count math.Int, found bool) {
+	count = math.ZeroInt()
+	for i, c := range tr.OptionCounts {
+		optionCount, ok := math.NewIntFromString(c)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case optionCount.GT(count):
+			option, count, found = OptionOne+VoteOption(i), optionCount, true
+		case optionCount.Equal(count):
+			found = false
+		}
+	}
+
+	if !found {
+		return OptionEmpty, math.ZeroInt(), false
+	}
+	return option, count, true
+}
+
 // EmptyTallyResult returns an empty TallyResult.
 func EmptyTallyResult() TallyResult {
 	return NewTallyResult(math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), math.ZeroInt())
@@ -58,5 +100,6 @@ func (tr TallyResult) Equals(comp TallyResult) bool {
 	return tr.YesCount == comp.YesCount &&
 		tr.AbstainCount == comp.AbstainCount &&
 		tr.NoCount == comp.NoCount &&
-		tr.NoWithVetoCount == comp.NoWithVetoCount
+		tr.NoWithVetoCount == comp.NoWithVetoCount &&
+		slices.Equal(tr.OptionCounts, comp.OptionCounts)
 }
@@ -55,6 +55,9 @@ type MsgSubmitProposal struct {
 	Summary string `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
 	// expedited defines if the proposal is expedited or not
 	Expedited bool `protobuf:"varint,7,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// proposal_type defines the type of the proposal, either standard or
+	// optimistic. An optimistic proposal cannot be expedited.
+	ProposalType ProposalType `protobuf:"varint,8,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -139,6 +142,13 @@ func (m *MsgSubmitProposal) GetExpedited() bool {
 	return false
 }
 
+func (m *MsgSubmitProposal) GetProposalType() ProposalType {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	// proposal_id defines the unique id of the proposal.
@@ -185,6 +195,147 @@ func (m *MsgSubmitProposalResponse) GetProposalId() uint64 {
 	return 0
 }
 
+// MsgSubmitMultipleChoiceProposal defines an sdk.Msg type that supports
+// submitting a multiple choice proposal.
+type MsgSubmitMultipleChoiceProposal struct {
+	// initial_deposit is the deposit value that must be paid at proposal submission.
+	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit"`
+	// proposer is the account address of the proposer.
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// metadata is any arbitrary metadata attached to the proposal.
+	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// title is the title of the proposal.
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is the summary of the proposal
+	Summary string `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	// multiple_choice defines the options of the proposal.
+	MultipleChoice MultipleChoice `protobuf:"bytes,6,opt,name=multiple_choice,json=multipleChoice,proto3" json:"multiple_choice"`
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) Reset()         { *m = MsgSubmitMultipleChoiceProposal{} }
+func (m *MsgSubmitMultipleChoiceProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitMultipleChoiceProposal) ProtoMessage()    {}
+func (*MsgSubmitMultipleChoiceProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{2}
+}
+func (m *MsgSubmitMultipleChoiceProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitMultipleChoiceProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitMultipleChoiceProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitMultipleChoiceProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitMultipleChoiceProposal.Merge(m, src)
+}
+func (m *MsgSubmitMultipleChoiceProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitMultipleChoiceProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitMultipleChoiceProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitMultipleChoiceProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitMultipleChoiceProposal) GetInitialDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.InitialDeposit
+	}
+	return nil
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) GetMultipleChoice() MultipleChoice {
+	if m != nil {
+		return m.MultipleChoice
+	}
+	return MultipleChoice{}
+}
+
+// MsgSubmitMultipleChoiceProposalResponse defines the
+// Msg/SubmitMultipleChoiceProposal response type.
+type MsgSubmitMultipleChoiceProposalResponse struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgSubmitMultipleChoiceProposalResponse) Reset() {
+	*m = MsgSubmitMultipleChoiceProposalResponse{}
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitMultipleChoiceProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitMultipleChoiceProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{3}
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitMultipleChoiceProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitMultipleChoiceProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitMultipleChoiceProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitMultipleChoiceProposalResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitMultipleChoiceProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
 // MsgExecLegacyContent is used to wrap the legacy content field into a message.
 // This ensures backwards compatibility with v1beta1.MsgSubmitProposal.
 type MsgExecLegacyContent struct {
@@ -198,7 +349,7 @@ func (m *MsgExecLegacyContent) Reset()         { *m = MsgExecLegacyContent{} }
 func (m *MsgExecLegacyContent) String() string { return proto.CompactTextString(m) }
 func (*MsgExecLegacyContent) ProtoMessage()    {}
 func (*MsgExecLegacyContent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{2}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{4}
 }
 func (m *MsgExecLegacyContent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,7 +400,7 @@ func (m *MsgExecLegacyContentResponse) Reset()         { *m = MsgExecLegacyConte
 func (m *MsgExecLegacyContentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecLegacyContentResponse) ProtoMessage()    {}
 func (*MsgExecLegacyContentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{3}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{5}
 }
 func (m *MsgExecLegacyContentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +445,7 @@ func (m *MsgVote) Reset()         { *m = MsgVote{} }
 func (m *MsgVote) String() string { return proto.CompactTextString(m) }
 func (*MsgVote) ProtoMessage()    {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{4}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{6}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +510,7 @@ func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
 func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteResponse) ProtoMessage()    {}
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{5}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{7}
 }
 func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,7 +555,7 @@ func (m *MsgVoteWeighted) Reset()         { *m = MsgVoteWeighted{} }
 func (m *MsgVoteWeighted) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteWeighted) ProtoMessage()    {}
 func (*MsgVoteWeighted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{6}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{8}
 }
 func (m *MsgVoteWeighted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,7 +620,7 @@ func (m *MsgVoteWeightedResponse) Reset()         { *m = MsgVoteWeightedResponse
 func (m *MsgVoteWeightedResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteWeightedResponse) ProtoMessage()    {}
 func (*MsgVoteWeightedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{7}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{9}
 }
 func (m *MsgVoteWeightedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,7 +663,7 @@ func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
 func (m *MsgDeposit) String() string { return proto.CompactTextString(m) }
 func (*MsgDeposit) ProtoMessage()    {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{8}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{10}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,7 +721,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{9}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{11}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -613,7 +764,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{10}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{12}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -665,7 +816,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{11}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{13}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -706,7 +857,7 @@ func (m *MsgCancelProposal) Reset()         { *m = MsgCancelProposal{} }
 func (m *MsgCancelProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelProposal) ProtoMessage()    {}
 func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{12}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{14}
 }
 func (m *MsgCancelProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -764,7 +915,7 @@ func (m *MsgCancelProposalResponse) Reset()         { *m = MsgCancelProposalResp
 func (m *MsgCancelProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelProposalResponse) ProtoMessage()    {}
 func (*MsgCancelProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ff8f4a63b6fc9a9, []int{13}
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{15}
 }
 func (m *MsgCancelProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,6 +968,8 @@ func (m *MsgCancelProposalResponse) GetCanceledHeight() uint64 {
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
+	proto.RegisterType((*MsgSubmitMultipleChoiceProposal)(nil), "cosmos.gov.v1.MsgSubmitMultipleChoiceProposal")
+	proto.RegisterType((*MsgSubmitMultipleChoiceProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitMultipleChoiceProposalResponse")
 	proto.RegisterType((*MsgExecLegacyContent)(nil), "cosmos.gov.v1.MsgExecLegacyContent")
 	proto.RegisterType((*MsgExecLegacyContentResponse)(nil), "cosmos.gov.v1.MsgExecLegacyContentResponse")
 	proto.RegisterType((*MsgVote)(nil), "cosmos.gov.v1.MsgVote")
@@ -834,79 +987,87 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1140 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcb, 0x6f, 0xdc, 0x44,
-	0x18, 0x8f, 0xf3, 0xda, 0x64, 0x92, 0x26, 0xca, 0x64, 0xdb, 0x3a, 0x56, 0xd9, 0xdd, 0xba, 0x08,
-	0x96, 0x84, 0xd8, 0xd9, 0xd0, 0xb4, 0x68, 0xe9, 0xa5, 0x1b, 0x8a, 0x88, 0x44, 0xa0, 0x72, 0x78,
-	0x48, 0x08, 0x29, 0x72, 0xd6, 0x83, 0x63, 0x75, 0xed, 0x31, 0x3b, 0xb3, 0xab, 0x2c, 0x27, 0xc4,
-	0xb1, 0xa7, 0x9e, 0x91, 0xb8, 0x23, 0x4e, 0x39, 0xe4, 0xc6, 0x3f, 0x50, 0xf5, 0x80, 0xaa, 0x1c,
-	0x10, 0xe2, 0xd0, 0xa2, 0x04, 0x88, 0xc4, 0xbf, 0xc0, 0x01, 0x34, 0x0f, 0x7b, 0xd7, 0x8f, 0x6c,
-	0x0a, 0x07, 0x2e, 0x89, 0xfd, 0xcd, 0xef, 0x7b, 0xfc, 0x7e, 0xf3, 0xcd, 0x37, 0x5e, 0x70, 0xa5,
-	0x89, 0x89, 0x8f, 0x89, 0xe9, 0xe2, 0xae, 0xd9, 0xad, 0x99, 0xf4, 0xc0, 0x08, 0xdb, 0x98, 0x62,
-	0x78, 0x49, 0xd8, 0x0d, 0x17, 0x77, 0x8d, 0x6e, 0x4d, 0x2b, 0x49, 0xd8, 0x9e, 0x4d, 0x90, 0xd9,
-	0xad, 0xed, 0x21, 0x6a, 0xd7, 0xcc, 0x26, 0xf6, 0x02, 0x01, 0xd7, 0xae, 0x26, 0xc3, 0x30, 0x2f,
-	0xb1, 0x50, 0x74, 0xb1, 0x8b, 0xf9, 0xa3, 0xc9, 0x9e, 0xa4, 0x75, 0x49, 0xc0, 0x77, 0xc5, 0x82,
-	0x4c, 0x25, 0x97, 0x5c, 0x8c, 0xdd, 0x16, 0x32, 0xf9, 0xdb, 0x5e, 0xe7, 0x73, 0xd3, 0x0e, 0x7a,
-	0xa9, 0x24, 0x3e, 0x71, 0x59, 0x12, 0x9f, 0xb8, 0x72, 0x61, 0xc1, 0xf6, 0xbd, 0x00, 0x9b, 0xfc,
-	0xaf, 0x34, 0x95, 0xd3, 0x61, 0xa8, 0xe7, 0x23, 0x42, 0x6d, 0x3f, 0x14, 0x00, 0xfd, 0xb7, 0x31,
-	0xb0, 0xb0, 0x4d, 0xdc, 0x9d, 0xce, 0x9e, 0xef, 0xd1, 0xfb, 0x6d, 0x1c, 0x62, 0x62, 0xb7, 0xe0,
-	0x1a, 0x98, 0xf2, 0x11, 0x21, 0xb6, 0x8b, 0x88, 0xaa, 0x54, 0xc6, 0xaa, 0x33, 0xeb, 0x45, 0x43,
-	0x44, 0x32, 0xa2, 0x48, 0xc6, 0xdd, 0xa0, 0x67, 0xc5, 0x28, 0xf8, 0x50, 0x01, 0xf3, 0x5e, 0xe0,
-	0x51, 0xcf, 0x6e, 0xed, 0x3a, 0x28, 0xc4, 0xc4, 0xa3, 0xea, 0x28, 0xf7, 0x5c, 0x32, 0x24, 0x31,
-	0x26, 0x9a, 0x21, 0x45, 0x33, 0x36, 0xb1, 0x17, 0x34, 0xde, 0x79, 0xfc, 0xac, 0x3c, 0xf2, 0xfd,
-	0xf3, 0x72, 0xd5, 0xf5, 0xe8, 0x7e, 0x67, 0xcf, 0x68, 0x62, 0x5f, 0xaa, 0x20, 0xff, 0xad, 0x12,
-	0xe7, 0x81, 0x49, 0x7b, 0x21, 0x22, 0xdc, 0x81, 0x7c, 0x73, 0x76, 0xb8, 0x3c, 0xdb, 0x42, 0xae,
-	0xdd, 0xec, 0xed, 0x32, 0xd9, 0xc9, 0x77, 0x67, 0x87, 0xcb, 0x8a, 0x35, 0x27, 0x33, 0xbf, 0x2d,
-	0x12, 0xc3, 0x9b, 0x60, 0x2a, 0xe4, 0x54, 0x50, 0x5b, 0x1d, 0xab, 0x28, 0xd5, 0xe9, 0x86, 0x7a,
-	0x7c, 0xb4, 0x5a, 0x94, 0x75, 0xdc, 0x75, 0x9c, 0x36, 0x22, 0x64, 0x87, 0xb6, 0xbd, 0xc0, 0xb5,
-	0x62, 0x24, 0xd4, 0x18, 0x69, 0x6a, 0x3b, 0x36, 0xb5, 0xd5, 0x71, 0xe6, 0x65, 0xc5, 0xef, 0xf0,
-	0x35, 0x30, 0x41, 0x3d, 0xda, 0x42, 0xea, 0x04, 0x0f, 0xb7, 0xf8, 0xcb, 0xd1, 0xea, 0x7c, 0xbf,
-	0xc4, 0xca, 0x9a, 0x71, 0xf3, 0xb6, 0x25, 0x10, 0x70, 0x15, 0x14, 0x48, 0xc7, 0xf7, 0xed, 0x76,
-	0x4f, 0x9d, 0x3c, 0x1f, 0x1c, 0x61, 0x60, 0x0d, 0x4c, 0xa3, 0x83, 0x10, 0x39, 0x1e, 0x45, 0x8e,
-	0x5a, 0xa8, 0x28, 0xd5, 0xa9, 0x1c, 0x87, 0x8d, 0x35, 0xab, 0x8f, 0xaa, 0xd7, 0xbe, 0x3e, 0x3b,
-	0x5c, 0x8e, 0xeb, 0x7e, 0x78, 0x76, 0xb8, 0x5c, 0x1e, 0x90, 0xab, 0x5b, 0x33, 0x33, 0x1b, 0xaa,
-	0xdf, 0x01, 0x4b, 0x19, 0xa3, 0x85, 0x48, 0x88, 0x03, 0x82, 0x60, 0x19, 0xcc, 0x84, 0xd2, 0xb6,
-	0xeb, 0x39, 0xaa, 0x52, 0x51, 0xaa, 0xe3, 0x16, 0x88, 0x4c, 0x5b, 0x8e, 0xfe, 0x83, 0x02, 0x8a,
-	0xdb, 0xc4, 0xbd, 0x77, 0x80, 0x9a, 0xef, 0x71, 0xf1, 0x37, 0x71, 0x40, 0x51, 0x40, 0xe1, 0xfb,
-	0xa0, 0xd0, 0x14, 0x8f, 0xdc, 0xeb, 0x9c, 0x36, 0x69, 0x94, 0x9e, 0x1c, 0xad, 0x6a, 0x89, 0x93,
-	0x14, 0x35, 0x01, 0xf7, 0xb5, 0xa2, 0x20, 0xf0, 0x1a, 0x98, 0xb6, 0x3b, 0x74, 0x1f, 0xb7, 0x3d,
-	0xda, 0x53, 0x47, 0xf9, 0x1e, 0xf4, 0x0d, 0xf5, 0x0d, 0xc6, 0xbb, 0xff, 0xce, 0x88, 0xeb, 0x19,
-	0xe2, 0x99, 0x22, 0xf5, 0x12, 0xb8, 0x96, 0x67, 0x8f, 0xe8, 0xeb, 0xbf, 0x2b, 0xa0, 0xb0, 0x4d,
-	0xdc, 0x8f, 0x31, 0x45, 0x70, 0x23, 0x47, 0x8a, 0x46, 0xf1, 0xcf, 0x67, 0xe5, 0x41, 0xb3, 0x68,
-	0xba, 0x01, 0x81, 0xa0, 0x01, 0x26, 0xba, 0x98, 0xa2, 0xb6, 0xa8, 0x79, 0x48, 0xb7, 0x09, 0x18,
-	0xac, 0x81, 0x49, 0x1c, 0x52, 0x0f, 0x07, 0xbc, 0x3d, 0xe7, 0xfa, 0x67, 0x44, 0xa8, 0x63, 0xb0,
-	0x5a, 0x3e, 0xe0, 0x00, 0x4b, 0x02, 0x87, 0x75, 0x67, 0xfd, 0x65, 0x26, 0x8c, 0x08, 0xcd, 0x44,
-	0xb9, 0x9c, 0x11, 0x85, 0xc5, 0xd3, 0x17, 0xc0, 0xbc, 0x7c, 0x8c, 0xa9, 0xff, 0xad, 0xc4, 0xb6,
-	0x4f, 0x90, 0xe7, 0xee, 0x53, 0xe4, 0xfc, 0x5f, 0x12, 0xbc, 0x05, 0x0a, 0x82, 0x19, 0x51, 0xc7,
-	0xf8, 0x9c, 0xb8, 0x9e, 0xd2, 0x20, 0x2a, 0x68, 0x40, 0x8b, 0xc8, 0x63, 0xa8, 0x18, 0xaf, 0x27,
-	0xc5, 0x78, 0x29, 0x57, 0x8c, 0x28, 0xb8, 0xbe, 0x04, 0xae, 0xa6, 0x4c, 0xb1, 0x38, 0x7f, 0x28,
-	0x00, 0x6c, 0x13, 0x37, 0x1a, 0x2a, 0xff, 0x51, 0x97, 0x5b, 0x60, 0x5a, 0xce, 0x43, 0x7c, 0xb1,
-	0x36, 0x7d, 0x28, 0xbc, 0x03, 0x26, 0x6d, 0x1f, 0x77, 0x02, 0x2a, 0xe5, 0x19, 0x32, 0x46, 0xa7,
-	0xd9, 0x18, 0x15, 0x99, 0xa5, 0x4f, 0x7d, 0x85, 0x1f, 0x95, 0x38, 0x1a, 0x13, 0x42, 0xcd, 0x08,
-	0x21, 0x99, 0xe9, 0x45, 0x00, 0xfb, 0x6f, 0x31, 0xfd, 0x1f, 0x45, 0x6f, 0x7c, 0x14, 0x3a, 0x36,
-	0x45, 0xf7, 0xed, 0xb6, 0xed, 0x13, 0x46, 0xa6, 0x7f, 0x3e, 0x95, 0x8b, 0xc8, 0xc4, 0x50, 0xf8,
-	0x26, 0x98, 0x0c, 0x79, 0x04, 0xae, 0xc0, 0xcc, 0xfa, 0xe5, 0xd4, 0x5e, 0x8b, 0xf0, 0x09, 0x22,
-	0x02, 0x5f, 0xdf, 0x3a, 0xce, 0x0e, 0xcf, 0xec, 0x18, 0xb8, 0x31, 0xc0, 0xed, 0x20, 0xba, 0x7d,
-	0x53, 0xc5, 0xeb, 0x06, 0xdf, 0xea, 0x41, 0x53, 0xc4, 0xb5, 0xbe, 0x98, 0x93, 0x45, 0xff, 0x56,
-	0xe1, 0x57, 0xe3, 0xa6, 0x1d, 0x34, 0x51, 0x6b, 0xe0, 0x6a, 0xcc, 0x69, 0x83, 0xf9, 0x54, 0x1b,
-	0x24, 0x3a, 0x60, 0xf0, 0x36, 0x1a, 0x7d, 0xd1, 0xdb, 0xa8, 0x5e, 0x39, 0xce, 0x5e, 0x02, 0x89,
-	0xb9, 0xaf, 0xff, 0xa4, 0xf0, 0xa1, 0x9e, 0xac, 0x2f, 0x1e, 0xea, 0xff, 0xbe, 0xce, 0x2d, 0x70,
-	0xa9, 0xc9, 0x63, 0x21, 0x67, 0x97, 0x7d, 0x26, 0xc8, 0xbd, 0xd2, 0x32, 0x23, 0xfd, 0xc3, 0xe8,
-	0x1b, 0xa2, 0x31, 0xc5, 0x36, 0xec, 0xd1, 0xf3, 0xb2, 0x62, 0xcd, 0x46, 0xae, 0x6c, 0x11, 0xbe,
-	0x0a, 0xe6, 0xe3, 0x50, 0xfb, 0xfc, 0x5c, 0xf1, 0x41, 0x37, 0x6e, 0xcd, 0x45, 0xe6, 0x77, 0xb9,
-	0x35, 0x47, 0xf8, 0x8d, 0xb5, 0xf5, 0xbf, 0x26, 0xc0, 0xd8, 0x36, 0x71, 0xe1, 0x17, 0x60, 0x2e,
-	0xf5, 0x5d, 0x52, 0x49, 0xf5, 0x4d, 0xe6, 0x4e, 0xd3, 0xaa, 0x17, 0x21, 0xe2, 0xfe, 0x5e, 0x7c,
-	0x92, 0xd9, 0xf3, 0x5b, 0xf0, 0x4b, 0xb0, 0x90, 0xbd, 0xe5, 0x6e, 0x64, 0x63, 0x66, 0x40, 0xda,
-	0xca, 0x0b, 0x80, 0x86, 0xe7, 0xde, 0x01, 0xe3, 0xfc, 0x0e, 0xba, 0x92, 0x8d, 0xc4, 0xec, 0x5a,
-	0x29, 0xdf, 0x3e, 0x3c, 0xe8, 0x03, 0x30, 0x9b, 0x98, 0xee, 0xe7, 0x04, 0x89, 0xd6, 0xb5, 0x57,
-	0x86, 0xaf, 0x0f, 0x4f, 0xf6, 0x19, 0x28, 0x44, 0xd3, 0x72, 0x29, 0x1b, 0x47, 0x2e, 0x69, 0xd7,
-	0xcf, 0x5d, 0xba, 0x90, 0x4a, 0x62, 0x18, 0xe5, 0x50, 0x19, 0x5c, 0xcf, 0xa3, 0x92, 0x77, 0xf8,
-	0xf3, 0x92, 0xdd, 0x66, 0xbd, 0x97, 0x3a, 0xf8, 0x39, 0xbd, 0x97, 0x44, 0xe4, 0xf5, 0x5e, 0xfe,
-	0xe1, 0xcc, 0x49, 0xb9, 0xb1, 0xa6, 0x4d, 0x7c, 0xc5, 0x26, 0x5f, 0xe3, 0xde, 0xe3, 0x93, 0x92,
-	0xf2, 0xf4, 0xa4, 0xa4, 0xfc, 0x7a, 0x52, 0x52, 0x1e, 0x9d, 0x96, 0x46, 0x9e, 0x9e, 0x96, 0x46,
-	0x7e, 0x3e, 0x2d, 0x8d, 0x7c, 0xba, 0x32, 0xf4, 0x33, 0x59, 0xcc, 0x3d, 0xfe, 0xb1, 0xcc, 0x7e,
-	0x9a, 0x4c, 0xf2, 0xe3, 0xfa, 0xc6, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x73, 0x98, 0xed, 0x9b,
-	0xda, 0x0c, 0x00, 0x00,
+	// 1271 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xd6, 0x49, 0x9c, 0x4c, 0xd2, 0x58, 0xd9, 0xba, 0xed, 0x7a, 0x69, 0x6d, 0x77, 0x0b,
+	0xad, 0x49, 0xc9, 0x3a, 0x0e, 0x4d, 0x8b, 0x4c, 0x0f, 0xd4, 0xa1, 0x88, 0x20, 0x0c, 0x65, 0x53,
+	0x40, 0x42, 0x48, 0xd6, 0xc6, 0x1e, 0x36, 0xab, 0x7a, 0x77, 0x16, 0xcf, 0xd8, 0x8a, 0x39, 0x21,
+	0x84, 0x38, 0xf4, 0xd4, 0x33, 0x12, 0x57, 0x84, 0x38, 0xe5, 0x90, 0x1b, 0xfc, 0x01, 0x55, 0x0f,
+	0xa8, 0xca, 0x01, 0x21, 0x0e, 0x2d, 0x4a, 0x04, 0x91, 0xf8, 0x27, 0x40, 0x33, 0x3b, 0xbb, 0xf6,
+	0xfe, 0xf0, 0x3a, 0xcd, 0x01, 0x71, 0x49, 0x76, 0xdf, 0xfb, 0xe6, 0xcd, 0xbc, 0xef, 0x7d, 0xfb,
+	0xe6, 0x19, 0x9c, 0x6b, 0x22, 0x6c, 0x21, 0x5c, 0x36, 0x50, 0xaf, 0xdc, 0xab, 0x94, 0xc9, 0x8e,
+	0xea, 0x74, 0x10, 0x41, 0xe2, 0x69, 0xd7, 0xae, 0x1a, 0xa8, 0xa7, 0xf6, 0x2a, 0x72, 0x9e, 0xc3,
+	0xb6, 0x74, 0x0c, 0xcb, 0xbd, 0xca, 0x16, 0x24, 0x7a, 0xa5, 0xdc, 0x44, 0xa6, 0xed, 0xc2, 0xe5,
+	0xf3, 0xc1, 0x30, 0x74, 0x95, 0xeb, 0xc8, 0x1a, 0xc8, 0x40, 0xec, 0xb1, 0x4c, 0x9f, 0xb8, 0x35,
+	0xe7, 0xc2, 0x1b, 0xae, 0x83, 0x6f, 0xc5, 0x5d, 0x06, 0x42, 0x46, 0x1b, 0x96, 0xd9, 0xdb, 0x56,
+	0xf7, 0xb3, 0xb2, 0x6e, 0xf7, 0x43, 0x9b, 0x58, 0xd8, 0xa0, 0x9b, 0x58, 0xd8, 0xe0, 0x8e, 0x45,
+	0xdd, 0x32, 0x6d, 0x54, 0x66, 0x7f, 0xb9, 0xa9, 0x10, 0x0e, 0x43, 0x4c, 0x0b, 0x62, 0xa2, 0x5b,
+	0x8e, 0x0b, 0x50, 0xbe, 0x9f, 0x04, 0x8b, 0x75, 0x6c, 0x6c, 0x76, 0xb7, 0x2c, 0x93, 0xdc, 0xed,
+	0x20, 0x07, 0x61, 0xbd, 0x2d, 0xae, 0x80, 0x19, 0x0b, 0x62, 0xac, 0x1b, 0x10, 0x4b, 0x42, 0x31,
+	0x55, 0x9a, 0x5b, 0xcd, 0xaa, 0x6e, 0x24, 0xd5, 0x8b, 0xa4, 0xde, 0xb6, 0xfb, 0x9a, 0x8f, 0x12,
+	0x1f, 0x08, 0x20, 0x63, 0xda, 0x26, 0x31, 0xf5, 0x76, 0xa3, 0x05, 0x1d, 0x84, 0x4d, 0x22, 0x9d,
+	0x62, 0x2b, 0x73, 0x2a, 0x4f, 0x8c, 0x92, 0xa6, 0x72, 0xd2, 0xd4, 0x75, 0x64, 0xda, 0xb5, 0xb7,
+	0x1e, 0x3d, 0x2d, 0x4c, 0xfc, 0xf8, 0xac, 0x50, 0x32, 0x4c, 0xb2, 0xdd, 0xdd, 0x52, 0x9b, 0xc8,
+	0xe2, 0x2c, 0xf0, 0x7f, 0xcb, 0xb8, 0x75, 0xbf, 0x4c, 0xfa, 0x0e, 0xc4, 0x6c, 0x01, 0xfe, 0xf6,
+	0x68, 0x77, 0x69, 0xbe, 0x0d, 0x0d, 0xbd, 0xd9, 0x6f, 0x50, 0xda, 0xf1, 0x0f, 0x47, 0xbb, 0x4b,
+	0x82, 0xb6, 0xc0, 0x77, 0x7e, 0xd3, 0xdd, 0x58, 0xbc, 0x0e, 0x66, 0x1c, 0x96, 0x0a, 0xec, 0x48,
+	0xa9, 0xa2, 0x50, 0x9a, 0xad, 0x49, 0xfb, 0x7b, 0xcb, 0x59, 0x7e, 0x8e, 0xdb, 0xad, 0x56, 0x07,
+	0x62, 0xbc, 0x49, 0x3a, 0xa6, 0x6d, 0x68, 0x3e, 0x52, 0x94, 0x69, 0xd2, 0x44, 0x6f, 0xe9, 0x44,
+	0x97, 0x26, 0xe9, 0x2a, 0xcd, 0x7f, 0x17, 0x5f, 0x06, 0x53, 0xc4, 0x24, 0x6d, 0x28, 0x4d, 0xb1,
+	0x70, 0x67, 0x7e, 0xdf, 0x5b, 0xce, 0x0c, 0x8e, 0x58, 0x5c, 0x51, 0xaf, 0xdf, 0xd4, 0x5c, 0x84,
+	0xb8, 0x0c, 0xd2, 0xb8, 0x6b, 0x59, 0x7a, 0xa7, 0x2f, 0x4d, 0x8f, 0x06, 0x7b, 0x18, 0xb1, 0x02,
+	0x66, 0xe1, 0x8e, 0x03, 0x5b, 0x26, 0x81, 0x2d, 0x29, 0x5d, 0x14, 0x4a, 0x33, 0x31, 0x0b, 0xd6,
+	0x56, 0xb4, 0x01, 0x4a, 0x7c, 0x03, 0x9c, 0x76, 0x78, 0xa5, 0x1a, 0x94, 0x1c, 0x69, 0xa6, 0x28,
+	0x94, 0x16, 0x56, 0x5f, 0x50, 0x03, 0x62, 0x55, 0xbd, 0x6a, 0xde, 0xeb, 0x3b, 0x50, 0x9b, 0x77,
+	0x86, 0xde, 0xaa, 0x95, 0xaf, 0x8e, 0x76, 0x97, 0xfc, 0xcc, 0x1f, 0x1c, 0xed, 0x2e, 0x15, 0x86,
+	0x08, 0xef, 0x55, 0xca, 0x11, 0x49, 0x28, 0xb7, 0x40, 0x2e, 0x62, 0xd4, 0x20, 0x76, 0x90, 0x8d,
+	0xa1, 0x58, 0x00, 0x73, 0xfe, 0x89, 0xcc, 0x96, 0x24, 0x14, 0x85, 0xd2, 0xa4, 0x06, 0x3c, 0xd3,
+	0x46, 0x4b, 0xf9, 0x39, 0x05, 0x0a, 0xfe, 0xf2, 0x7a, 0xb7, 0x4d, 0x4c, 0xa7, 0x0d, 0xd7, 0xb7,
+	0x91, 0xd9, 0x84, 0xbe, 0xe8, 0xe2, 0x24, 0x24, 0xfc, 0x1f, 0x24, 0x74, 0xea, 0x44, 0x12, 0x4a,
+	0x85, 0x24, 0x94, 0xf5, 0x24, 0xe4, 0x6a, 0xcb, 0x7d, 0x11, 0xa5, 0x81, 0x5a, 0x98, 0xb4, 0x06,
+	0xc2, 0xf8, 0x00, 0x64, 0x2c, 0x4e, 0x54, 0xa3, 0xc9, 0x98, 0x62, 0x7a, 0x9a, 0x5b, 0xbd, 0x18,
+	0xaa, 0x73, 0x90, 0xce, 0xda, 0x2c, 0x65, 0x84, 0x27, 0x65, 0x05, 0x5c, 0xd5, 0x9b, 0x91, 0xb2,
+	0xbf, 0x34, 0xa2, 0xec, 0xc1, 0x98, 0xca, 0x3b, 0xe0, 0xea, 0x98, 0xea, 0x1d, 0x5f, 0x0a, 0x3f,
+	0x09, 0x20, 0x5b, 0xc7, 0xc6, 0x9d, 0x1d, 0xd8, 0x7c, 0x97, 0x95, 0x61, 0x1d, 0xd9, 0x04, 0xda,
+	0x44, 0x7c, 0x0f, 0xa4, 0x9b, 0xee, 0x23, 0x5b, 0x35, 0xa2, 0xe7, 0xd4, 0xf2, 0x8f, 0xf7, 0x96,
+	0xe5, 0x00, 0x03, 0x9e, 0x1c, 0xd8, 0x5a, 0xcd, 0x0b, 0x22, 0x5e, 0x00, 0xb3, 0x7a, 0x97, 0x6c,
+	0xa3, 0x8e, 0x49, 0xfa, 0x6e, 0x0d, 0xb5, 0x81, 0xa1, 0xba, 0x46, 0xb9, 0x18, 0xbc, 0x53, 0x32,
+	0x94, 0x08, 0x19, 0x91, 0x43, 0x2a, 0x79, 0x70, 0x21, 0xce, 0xee, 0xa5, 0xaf, 0xfc, 0x29, 0x80,
+	0x74, 0x1d, 0x1b, 0x1f, 0x21, 0x02, 0xc5, 0xb5, 0x18, 0x2a, 0x6a, 0xd9, 0xbf, 0x9f, 0x16, 0x86,
+	0xcd, 0x6e, 0xa5, 0x86, 0x08, 0x12, 0x55, 0x30, 0xd5, 0x43, 0xe4, 0x18, 0xba, 0x73, 0x61, 0x62,
+	0x05, 0x4c, 0x23, 0x87, 0x98, 0xc8, 0x66, 0x92, 0x5b, 0x18, 0x7c, 0x2d, 0x5c, 0x1f, 0xf4, 0x2c,
+	0xef, 0x33, 0x80, 0xc6, 0x81, 0x49, 0xad, 0xae, 0xfa, 0x22, 0x25, 0xc6, 0x0d, 0x4d, 0x49, 0x39,
+	0x1b, 0x21, 0x85, 0xc6, 0x53, 0x16, 0x41, 0x86, 0x3f, 0xfa, 0xa9, 0xff, 0x23, 0xf8, 0xb6, 0x8f,
+	0xa1, 0x69, 0x6c, 0xd3, 0x56, 0xf5, 0x1f, 0x51, 0xf0, 0x3a, 0x48, 0xbb, 0x99, 0x61, 0x29, 0xc5,
+	0x3a, 0xc6, 0xa5, 0x10, 0x07, 0xde, 0x81, 0x86, 0xb8, 0xf0, 0x56, 0x24, 0x92, 0xf1, 0x4a, 0x90,
+	0x8c, 0x8b, 0xb1, 0x64, 0x78, 0xc1, 0x95, 0x1c, 0x38, 0x1f, 0x32, 0xf9, 0xe4, 0xfc, 0x25, 0x00,
+	0x50, 0xc7, 0x86, 0xd7, 0x5e, 0x4e, 0xc8, 0xcb, 0x0d, 0x30, 0xcb, 0x3b, 0x23, 0x1a, 0xcf, 0xcd,
+	0x00, 0x2a, 0xde, 0x02, 0xd3, 0xba, 0x85, 0xba, 0x36, 0xe1, 0xf4, 0x24, 0x34, 0xd4, 0xa1, 0xf6,
+	0xc1, 0xd7, 0x54, 0xaf, 0xb1, 0x4f, 0xc5, 0x8f, 0x46, 0x89, 0x90, 0x22, 0x44, 0xf0, 0xcc, 0x94,
+	0x2c, 0x10, 0x07, 0x6f, 0x7e, 0xfa, 0xbf, 0xb8, 0xda, 0xf8, 0xd0, 0x69, 0xe9, 0x04, 0xde, 0xd5,
+	0x3b, 0xba, 0x85, 0x69, 0x32, 0x83, 0xef, 0x53, 0x18, 0x97, 0x8c, 0x0f, 0x15, 0x5f, 0x03, 0xd3,
+	0x0e, 0x8b, 0xc0, 0x18, 0x98, 0x5b, 0x3d, 0x1b, 0xbe, 0xf7, 0x98, 0x33, 0x90, 0x88, 0x8b, 0xaf,
+	0x6e, 0xec, 0x47, 0x6f, 0xe2, 0x68, 0x1b, 0xb8, 0x3c, 0x94, 0xdb, 0x8e, 0x37, 0xca, 0x85, 0x0e,
+	0xaf, 0xa8, 0xe0, 0x7c, 0xc8, 0xe4, 0xe5, 0x5a, 0x3d, 0x13, 0xb3, 0x8b, 0xf2, 0x9d, 0xc0, 0xe6,
+	0xac, 0x75, 0xdd, 0x6e, 0xc2, 0xf6, 0xd0, 0x9c, 0x15, 0x23, 0x83, 0x4c, 0x48, 0x06, 0x01, 0x05,
+	0x9c, 0xe8, 0x5e, 0xaa, 0x16, 0xf7, 0xa3, 0x13, 0x45, 0xe0, 0x2e, 0x50, 0x7e, 0x15, 0x40, 0x2e,
+	0x72, 0x3e, 0xbf, 0xa9, 0x3f, 0xff, 0x39, 0x37, 0xc0, 0xe9, 0x26, 0x8b, 0x05, 0x5b, 0x0d, 0x3a,
+	0x73, 0xf2, 0x5a, 0xc9, 0x91, 0x96, 0x7e, 0xcf, 0x1b, 0x48, 0x6b, 0x33, 0xb4, 0x60, 0x0f, 0x9f,
+	0x15, 0x04, 0x6d, 0xde, 0x5b, 0x4a, 0x9d, 0xe2, 0x55, 0x90, 0xf1, 0x43, 0x6d, 0xb3, 0xef, 0x8a,
+	0x35, 0xba, 0x49, 0x6d, 0xc1, 0x33, 0xbf, 0xcd, 0xac, 0x31, 0xc4, 0xaf, 0xad, 0xac, 0x7e, 0x9d,
+	0x06, 0xa9, 0x3a, 0x36, 0xc4, 0xcf, 0xc1, 0x42, 0x68, 0xc8, 0x2d, 0x86, 0xef, 0xd1, 0xf0, 0x78,
+	0x23, 0x97, 0xc6, 0x21, 0x7c, 0x7d, 0x9f, 0x79, 0x1c, 0xa9, 0xf9, 0x0d, 0xf1, 0x0b, 0xb0, 0x18,
+	0xbd, 0xe5, 0x2e, 0x47, 0x63, 0x46, 0x40, 0xf2, 0xb5, 0x63, 0x80, 0x92, 0xf7, 0xde, 0x04, 0x93,
+	0xec, 0x0e, 0x3a, 0x17, 0x8d, 0x44, 0xed, 0x72, 0x3e, 0xde, 0x9e, 0x1c, 0xf4, 0x3e, 0x98, 0x0f,
+	0x74, 0xf7, 0x11, 0x41, 0x3c, 0xbf, 0x7c, 0x25, 0xd9, 0x9f, 0xbc, 0xd9, 0xa7, 0x20, 0xed, 0x75,
+	0xcb, 0x5c, 0x34, 0x0e, 0x77, 0xc9, 0x97, 0x46, 0xba, 0xc6, 0xa6, 0x12, 0x68, 0x46, 0x31, 0xa9,
+	0x0c, 0xfb, 0xe5, 0x2b, 0xc9, 0xfe, 0xa4, 0xcd, 0x6e, 0x52, 0xed, 0x85, 0x3e, 0xfc, 0x18, 0xed,
+	0x05, 0x11, 0x72, 0x69, 0x1c, 0x22, 0x61, 0xcb, 0xb5, 0x15, 0xf1, 0x1b, 0x01, 0x5c, 0x48, 0x9c,
+	0xb6, 0xd5, 0x51, 0xda, 0x8e, 0xc7, 0xcb, 0x37, 0x9e, 0x0f, 0xef, 0x9d, 0x4e, 0x9e, 0xfa, 0x92,
+	0xb6, 0xe0, 0xda, 0x9d, 0x47, 0x07, 0x79, 0xe1, 0xc9, 0x41, 0x5e, 0xf8, 0xe3, 0x20, 0x2f, 0x3c,
+	0x3c, 0xcc, 0x4f, 0x3c, 0x39, 0xcc, 0x4f, 0xfc, 0x76, 0x98, 0x9f, 0xf8, 0xe4, 0x5a, 0xe2, 0xe4,
+	0xee, 0x36, 0x60, 0x36, 0xbf, 0xd3, 0x1f, 0xdc, 0xd3, 0xac, 0x6f, 0xbc, 0xfa, 0xef, 0x00, 0x38,
+	0xeb, 0x41, 0x16, 0xb0, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -937,6 +1098,9 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// CancelProposal defines a method to cancel governance proposal
 	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
+	// SubmitMultipleChoiceProposal defines a method to create a new multiple
+	// choice proposal.
+	SubmitMultipleChoiceProposal(ctx context.Context, in *MsgSubmitMultipleChoiceProposal, opts ...grpc.CallOption) (*MsgSubmitMultipleChoiceProposalResponse, error)
 }
 
 type msgClient struct {
@@ -1010,6 +1174,15 @@ func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, o
 	return out, nil
 }
 
+func (c *msgClient) SubmitMultipleChoiceProposal(ctx context.Context, in *MsgSubmitMultipleChoiceProposal, opts ...grpc.CallOption) (*MsgSubmitMultipleChoiceProposalResponse, error) {
+	out := new(MsgSubmitMultipleChoiceProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1028,6 +1201,9 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// CancelProposal defines a method to cancel governance proposal
 	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// SubmitMultipleChoiceProposal defines a method to create a new multiple
+	// choice proposal.
+	SubmitMultipleChoiceProposal(context.Context, *MsgSubmitMultipleChoiceProposal) (*MsgSubmitMultipleChoiceProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1055,6 +1231,9 @@ func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateP
 func (*UnimplementedMsgServer) CancelProposal(ctx context.Context, req *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
 }
+func (*UnimplementedMsgServer) SubmitMultipleChoiceProposal(ctx context.Context, req *MsgSubmitMultipleChoiceProposal) (*MsgSubmitMultipleChoiceProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMultipleChoiceProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1186,6 +1365,24 @@ func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitMultipleChoiceProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitMultipleChoiceProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitMultipleChoiceProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitMultipleChoiceProposal(ctx, req.(*MsgSubmitMultipleChoiceProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
@@ -1219,6 +1416,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelProposal",
 			Handler:    _Msg_CancelProposal_Handler,
 		},
+		{
+			MethodName: "SubmitMultipleChoiceProposal",
+			Handler:    _Msg_SubmitMultipleChoiceProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -1244,6 +1445,11 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProposalType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalType))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Expedited {
 		i--
 		if m.Expedited {
@@ -1341,6 +1547,109 @@ func (m *MsgSubmitProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitMultipleChoiceProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitMultipleChoiceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MultipleChoice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InitialDeposit) > 0 {
+		for iNdEx := len(m.InitialDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitMultipleChoiceProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitMultipleChoiceProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitMultipleChoiceProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExecLegacyContent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1750,12 +2059,12 @@ func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i--
 		dAtA[i] = 0x18
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x12
 	if m.ProposalId != 0 {
@@ -1814,6 +2123,9 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if m.Expedited {
 		n += 2
 	}
+	if m.ProposalType != 0 {
+		n += 1 + sovTx(uint64(m.ProposalType))
+	}
 	return n
 }
 
@@ -1829,24 +2141,69 @@ func (m *MsgSubmitProposalResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgExecLegacyContent) Size() (n int) {
+func (m *MsgSubmitMultipleChoiceProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Content != nil {
-		l = m.Content.Size()
+	if len(m.InitialDeposit) > 0 {
+		for _, e := range m.InitialDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Proposer)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Authority)
+	l = len(m.Metadata)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
-
-func (m *MsgExecLegacyContentResponse) Size() (n int) {
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MultipleChoice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitMultipleChoiceProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgExecLegacyContent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecLegacyContentResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2261,6 +2618,25 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Expedited = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2351,6 +2727,320 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitMultipleChoiceProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitMultipleChoiceProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitMultipleChoiceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialDeposit = append(m.InitialDeposit, types.Coin{})
+			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultipleChoice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MultipleChoice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitMultipleChoiceProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitMultipleChoiceProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitMultipleChoiceProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgExecLegacyContent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
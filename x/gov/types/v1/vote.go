@@ -16,6 +16,17 @@ const (
 	OptionNo         = VoteOption_VOTE_OPTION_NO
 	OptionNoWithVeto = VoteOption_VOTE_OPTION_NO_WITH_VETO
 	OptionAbstain    = VoteOption_VOTE_OPTION_ABSTAIN
+
+	OptionOne   = VoteOption_VOTE_OPTION_ONE
+	OptionTwo   = VoteOption_VOTE_OPTION_TWO
+	OptionThree = VoteOption_VOTE_OPTION_THREE
+	OptionFour  = VoteOption_VOTE_OPTION_FOUR
+	OptionFive  = VoteOption_VOTE_OPTION_FIVE
+	OptionSix   = VoteOption_VOTE_OPTION_SIX
+	OptionSeven = VoteOption_VOTE_OPTION_SEVEN
+	OptionEight = VoteOption_VOTE_OPTION_EIGHT
+	OptionNine  = VoteOption_VOTE_OPTION_NINE
+	OptionTen   = VoteOption_VOTE_OPTION_TEN
 )
 
 // NewVote creates a new Vote instance
@@ -64,16 +75,14 @@ func NewWeightedVoteOption(option VoteOption, weight math.LegacyDec) *WeightedVo
 
 // IsValid returns true if the sub vote is valid and false otherwise.
 func (w *WeightedVoteOption) IsValid() bool {
-	weight, err := math.LegacyNewDecFromStr(w.Weight)
-	if err != nil {
-		return false
-	}
-
-	if !weight.IsPositive() || weight.GT(math.LegacyNewDec(1)) {
-		return false
-	}
+	return ValidWeightedVoteOption(*w)
+}
 
-	return ValidVoteOption(w.Option)
+// HasValidWeight returns true if the weight of the sub vote is valid and false
+// otherwise.
+func (w *WeightedVoteOption) HasValidWeight() bool {
+	weight, err := math.LegacyNewDecFromStr(w.Weight)
+	return err == nil && weight.IsPositive() && weight.LTE(math.LegacyNewDec(1))
 }
 
 // NewNonSplitVoteOption creates a single option vote with weight 1
@@ -83,11 +92,7 @@ func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
 
 // ValidWeightedVoteOption returns true if the sub vote is valid and false otherwise.
 func ValidWeightedVoteOption(option WeightedVoteOption) bool {
-	weight, err := math.LegacyNewDecFromStr(option.Weight)
-	if err != nil || !weight.IsPositive() || weight.GT(math.LegacyNewDec(1)) {
-		return false
-	}
-	return ValidVoteOption(option.Option)
+	return option.HasValidWeight() && ValidVoteOption(option.Option)
 }
 
 // WeightedVoteOptions describes an array of WeightedVoteOptions
@@ -141,6 +146,12 @@ func ValidVoteOption(option VoteOption) bool {
 	return false
 }
 
+// ValidMultipleChoiceVoteOption returns true if the vote option is one of the
+// given number of options of a multiple choice proposal and false otherwise.
+func ValidMultipleChoiceVoteOption(option VoteOption, numOptions int) bool {
+	return option >= OptionOne && int(option) <= numOptions
+}
+
 // Format implements the fmt.Formatter interface.
 func (vo VoteOption) Format(s fmt.State, verb rune) {
 	switch verb {
@@ -29,6 +29,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "annual-provisions",
 					Short:     "Query the current minting annual provisions value",
 				},
+				{
+					RpcMethod: "SupplyProjection",
+					Use:       "supply-projection",
+					Short:     "Query the projected supply of the mint denom under the current mint model",
+					Example:   fmt.Sprintf("%s query mint supply-projection --blocks 6311520 --points 12", version.AppName),
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
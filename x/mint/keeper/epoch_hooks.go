@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks wrapper struct for the mint keeper
+type EpochHooks struct {
+	k Keeper
+}
+
+// EpochHooks returns the epoch hooks of the mint keeper, which track the epoch
+// number the segments of the epoch based piecewise mint model start at.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// AfterEpochEnd records the number of the ended epoch when it is the piecewise
+// epoch.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.PiecewiseEpochIdentifier == "" || params.PiecewiseEpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	minter, err := h.k.Minter.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	minter.EpochNumber = epochNumber
+	return h.k.Minter.Set(ctx, minter)
+}
+
+// BeforeEpochStart is a no-op.
+func (h EpochHooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
@@ -2,7 +2,12 @@ package keeper
 
 import (
 	"context"
+	"math"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -45,3 +50,40 @@ func (q queryServer) AnnualProvisions(ctx context.Context, _ *types.QueryAnnualP
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// SupplyProjection returns the projected supply of the mint denom under the
+// current mint model.
+func (q queryServer) SupplyProjection(ctx context.Context, req *types.QuerySupplyProjectionRequest) (*types.QuerySupplyProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	blocks, points := req.Blocks, req.Points
+	if blocks == 0 {
+		params, err := q.k.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		blocks = params.BlocksPerYear
+	}
+	if points == 0 {
+		points = 1
+	}
+
+	if points > types.MaxSupplyProjectionPoints {
+		return nil, status.Errorf(codes.InvalidArgument, "points cannot exceed %d", types.MaxSupplyProjectionPoints)
+	}
+	if uint64(points) > blocks {
+		return nil, status.Error(codes.InvalidArgument, "points cannot exceed blocks")
+	}
+	if blocks > uint64(math.MaxInt64-sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return nil, status.Errorf(codes.InvalidArgument, "blocks too large: %d", blocks)
+	}
+
+	supply, projection, err := q.k.ProjectSupply(ctx, blocks, points)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySupplyProjectionResponse{Supply: supply, Points: projection}, nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v3 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v3"
+	v4 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place state migrations.
@@ -22,3 +23,9 @@ func NewMigrator(k Keeper) Migrator {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.Migrate(ctx, m.keeper.storeService.OpenKVStore(ctx), m.keeper.cdc, m.keeper.Params)
 }
+
+// Migrate3to4 migrates the x/mint module state from the consensus version 3 to
+// version 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.Migrate(ctx, m.keeper.Params)
+}
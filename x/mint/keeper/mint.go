@@ -42,9 +42,20 @@ func DefaultMintFn(ic types.InflationCalculationFn) MintFn {
 			return err
 		}
 
+		totalSupply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount // fetch total supply from the bank module
+
 		// update minter's inflation and annual provisions
-		minter.Inflation = ic(ctx, minter, params, bondedRatio)
-		minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+		if params.MintModel == types.MintModelBondedRatio {
+			minter.Inflation = ic(ctx, minter, params, bondedRatio)
+			minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+		} else {
+			// the inflation of the other mint models is informational only
+			minter.AnnualProvisions = minter.ModelAnnualProvisions(params, ctx.BlockHeight(), totalSupply)
+			minter.Inflation = sdkmath.LegacyZeroDec()
+			if totalSupply.IsPositive() {
+				minter.Inflation = minter.AnnualProvisions.QuoInt(totalSupply)
+			}
+		}
 		if err = k.Minter.Set(ctx, minter); err != nil {
 			return err
 		}
@@ -53,7 +64,6 @@ func DefaultMintFn(ic types.InflationCalculationFn) MintFn {
 		mintedCoin := minter.BlockProvision(params)
 
 		maxSupply := params.MaxSupply
-		totalSupply := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount // fetch total supply from the bank module
 
 		// if maxSupply is not infinite, and minted coins exceeds maxSupply, adjust minted coins to be the diff
 		if !maxSupply.IsZero() && totalSupply.Add(mintedCoin.Amount).GT(maxSupply) {
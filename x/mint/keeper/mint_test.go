@@ -204,3 +204,42 @@ func (s *MintFnTestSuite) TestCustomMintFn() {
 		return event.Type == "custom_mint"
 	}), "expected custom_mint event to be emitted")
 }
+
+// TestDefaultMintFn_SupplyProjection checks that the projected supply of the
+// halving model matches the supply actually minted block by block.
+func (s *MintFnTestSuite) TestDefaultMintFn_SupplyProjection() {
+	params := types.DefaultParams()
+	params.MintModel = types.MintModelHalving
+	params.BlocksPerYear = 100
+	params.AnnualEmission = math.NewInt(1000)
+	params.HalvingInterval = 30
+	params.MaxSupply = math.NewInt(1500)
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	supply := math.NewInt(1000)
+	s.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(supply, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(50, 2), nil).AnyTimes()
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), "stake").DoAndReturn(func(_ context.Context, denom string) sdk.Coin {
+		return sdk.NewCoin(denom, supply)
+	}).AnyTimes()
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, coins sdk.Coins) error {
+		supply = supply.Add(coins.AmountOf("stake"))
+		return nil
+	}).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil).AnyTimes()
+
+	res, err := keeper.NewQueryServerImpl(s.mintKeeper).SupplyProjection(s.ctx, &types.QuerySupplyProjectionRequest{Blocks: 200, Points: 4})
+	s.Require().NoError(err)
+	s.Require().Equal(supply, res.Supply)
+	s.Require().Len(res.Points, 4)
+
+	for height := int64(1); height <= 200; height++ {
+		s.Require().NoError(s.mintKeeper.MintFn(s.ctx.WithBlockHeight(height)))
+		if height%50 == 0 {
+			s.Require().Equal(types.SupplyProjectionPoint{Height: height, Supply: supply}, res.Points[height/50-1])
+		}
+	}
+
+	// the max supply caps the projection
+	s.Require().Equal(params.MaxSupply, res.Points[3].Supply)
+}
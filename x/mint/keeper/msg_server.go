@@ -33,6 +33,24 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 		return nil, err
 	}
 
+	params, err := ms.Params.Get(goCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the halving schedule of a newly selected mint model starts at this block
+	if params.MintModel != msg.Params.MintModel {
+		minter, err := ms.Minter.Get(goCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		minter.ModelStartHeight = ctx.BlockHeight()
+		if err := ms.Minter.Set(goCtx, minter); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := ms.Params.Set(goCtx, msg.Params); err != nil {
 		return nil, err
 	}
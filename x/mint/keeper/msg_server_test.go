@@ -56,6 +56,8 @@ func (s *IntegrationTestSuite) TestUpdateParams() {
 					GoalBonded:          sdkmath.LegacyNewDecWithPrec(37, 2),
 					BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
 					MaxSupply:           sdkmath.ZeroInt(), // infinite supply
+					AnnualEmission:      sdkmath.ZeroInt(),
+					CappedSupplyRate:    sdkmath.LegacyZeroDec(),
 				},
 			},
 			expectErr: false,
@@ -86,6 +88,8 @@ func (s *IntegrationTestSuite) TestUpdateParamsAuthority() {
 		GoalBonded:          sdkmath.LegacyNewDecWithPrec(37, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
 		MaxSupply:           sdkmath.ZeroInt(),
+		AnnualEmission:      sdkmath.ZeroInt(),
+		CappedSupplyRate:    sdkmath.LegacyZeroDec(),
 	}
 
 	s.Run("fallback to keeper authority", func() {
@@ -122,3 +126,26 @@ func (s *IntegrationTestSuite) TestUpdateParamsAuthority() {
 		s.Require().Contains(err.Error(), "invalid authority")
 	})
 }
+
+func (s *IntegrationTestSuite) TestUpdateParamsMintModel() {
+	params := types.DefaultParams()
+	params.MintModel = types.MintModelFixedEmission
+	params.AnnualEmission = sdkmath.NewInt(1000)
+
+	ctx := s.ctx.WithBlockHeight(10)
+	_, err := s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.mintKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+
+	minter, err := s.mintKeeper.Minter.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(10), minter.ModelStartHeight)
+
+	// updating the params of the selected model keeps its start height
+	params.AnnualEmission = sdkmath.NewInt(2000)
+	_, err = s.msgServer.UpdateParams(ctx.WithBlockHeight(20), &types.MsgUpdateParams{Authority: s.mintKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+
+	minter, err = s.mintKeeper.Minter.Get(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(10), minter.ModelStartHeight)
+}
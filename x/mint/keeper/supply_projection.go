@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// ProjectSupply projects the supply of the mint denom over the given number of
+// blocks under the current params, returning the current supply and the
+// projected supply at the given number of evenly spaced heights. The annual
+// provisions of the bonded ratio model and the segment of the epoch based
+// piecewise model are held at their current value.
+func (k Keeper) ProjectSupply(ctx context.Context, blocks uint64, points uint32) (math.Int, []types.SupplyProjectionPoint, error) {
+	minter, err := k.Minter.Get(ctx)
+	if err != nil {
+		return math.Int{}, nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.Int{}, nil, err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	current := k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount
+
+	supply, cur := current, height
+	projection := make([]types.SupplyProjectionPoint, 0, points)
+	for i := uint32(1); i <= points; i++ {
+		target := height + int64(blocks/uint64(points)*uint64(i))
+		if i == points {
+			target = height + int64(blocks)
+		}
+
+		for cur < target {
+			// the annual provisions are constant until the next model change
+			next := min(target, minter.NextModelChange(params, cur+1)-1)
+			minted, err := projectMinted(params, minter, cur+1, supply, next-cur)
+			if err != nil {
+				return math.Int{}, nil, err
+			}
+
+			supply, cur = supply.Add(minted), next
+		}
+
+		projection = append(projection, types.SupplyProjectionPoint{Height: target, Supply: supply})
+	}
+
+	return current, projection, nil
+}
+
+// projectMinted returns the amount minted over the given number of blocks from
+// the given height, over which the annual provisions of the mint model do not
+// change other than through the supply.
+func projectMinted(params types.Params, minter types.Minter, height int64, supply math.Int, blocks int64) (math.Int, error) {
+	var minted math.Int
+	if params.MintModel == types.MintModelCappedSupply {
+		// the remaining supply decays geometrically by the per-block rate
+		remaining := params.MaxSupply.Sub(supply)
+		if !remaining.IsPositive() {
+			return math.ZeroInt(), nil
+		}
+
+		retention := math.LegacyOneDec().Sub(params.CappedSupplyRate.QuoInt64(int64(params.BlocksPerYear)))
+		minted = remaining.Sub(math.LegacyNewDecFromInt(remaining).Mul(retention.Power(uint64(blocks))).TruncateInt())
+	} else {
+		minter.AnnualProvisions = minter.ModelAnnualProvisions(params, height, supply)
+
+		var err error
+		minted, err = minter.BlockProvision(params).Amount.SafeMul(math.NewInt(blocks))
+		if err != nil {
+			return math.Int{}, err
+		}
+	}
+
+	// the projected supply never exceeds the max supply
+	if params.MaxSupply.IsPositive() && supply.Add(minted).GT(params.MaxSupply) {
+		minted = math.MaxInt(params.MaxSupply.Sub(supply), math.ZeroInt())
+	}
+
+	return minted, nil
+}
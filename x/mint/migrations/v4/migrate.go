@@ -0,0 +1,32 @@
+package v4
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// Migrate migrates the x/mint module state from the consensus version 3 to
+// version 4. It keeps the bonded ratio mint model and zeroes the parameters of
+// the other mint models.
+func Migrate(ctx sdk.Context, params collections.Item[types.Params]) error {
+	currParams, err := params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	currParams.MintModel = types.MintModelBondedRatio
+	if currParams.AnnualEmission.IsNil() {
+		currParams.AnnualEmission = math.ZeroInt()
+	}
+	if currParams.CappedSupplyRate.IsNil() {
+		currParams.CappedSupplyRate = math.LegacyZeroDec()
+	}
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	return params.Set(ctx, currParams)
+}
@@ -0,0 +1,44 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	v4 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(mint.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStoreService := runtime.NewKVStoreService(storeKey)
+
+	sb := collections.NewSchemaBuilder(kvStoreService)
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	// params stored before the mint models were introduced
+	oldParams := types.DefaultParams()
+	oldParams.AnnualEmission = math.Int{}
+	oldParams.CappedSupplyRate = math.LegacyDec{}
+	require.NoError(t, params.Set(ctx, oldParams))
+
+	require.NoError(t, v4.Migrate(ctx, params))
+
+	res, err := params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), res)
+}
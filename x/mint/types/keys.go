@@ -13,4 +13,8 @@ const (
 
 	// StoreKey is the default store key for mint
 	StoreKey = ModuleName
+
+	// MaxSupplyProjectionPoints is the maximum number of points a supply
+	// projection query can return.
+	MaxSupplyProjectionPoints = 100
 )
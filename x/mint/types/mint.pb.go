@@ -26,12 +26,91 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MintModel defines the model computing the annual provisions.
+type MintModel int32
+
+const (
+	// MINT_MODEL_BONDED_RATIO_UNSPECIFIED adjusts the inflation rate towards the
+	// goal bonded ratio within the min and max inflation.
+	MintModelBondedRatio MintModel = 0
+	// MINT_MODEL_FIXED_EMISSION mints annual_emission per year.
+	MintModelFixedEmission MintModel = 1
+	// MINT_MODEL_HALVING mints annual_emission per year, halved every
+	// halving_interval blocks.
+	MintModelHalving MintModel = 2
+	// MINT_MODEL_CAPPED_SUPPLY mints per year capped_supply_rate of the supply
+	// remaining below max_supply.
+	MintModelCappedSupply MintModel = 3
+	// MINT_MODEL_PIECEWISE mints per year the annual emission of the segment the
+	// current height or epoch falls in.
+	MintModelPiecewise MintModel = 4
+)
+
+var MintModel_name = map[int32]string{
+	0: "MINT_MODEL_BONDED_RATIO_UNSPECIFIED",
+	1: "MINT_MODEL_FIXED_EMISSION",
+	2: "MINT_MODEL_HALVING",
+	3: "MINT_MODEL_CAPPED_SUPPLY",
+	4: "MINT_MODEL_PIECEWISE",
+}
+
+var MintModel_value = map[string]int32{
+	"MINT_MODEL_BONDED_RATIO_UNSPECIFIED": 0,
+	"MINT_MODEL_FIXED_EMISSION":           1,
+	"MINT_MODEL_HALVING":                  2,
+	"MINT_MODEL_CAPPED_SUPPLY":            3,
+	"MINT_MODEL_PIECEWISE":                4,
+}
+
+func (x MintModel) String() string {
+	return proto.EnumName(MintModel_name, int32(x))
+}
+
+func (MintModel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+
+// PiecewiseBasis defines what the segments of the piecewise model start at.
+type PiecewiseBasis int32
+
+const (
+	// PIECEWISE_BASIS_HEIGHT_UNSPECIFIED starts the segments at block heights.
+	PiecewiseBasisHeight PiecewiseBasis = 0
+	// PIECEWISE_BASIS_EPOCH starts the segments at epoch numbers of the
+	// piecewise epoch identifier.
+	PiecewiseBasisEpoch PiecewiseBasis = 1
+)
+
+var PiecewiseBasis_name = map[int32]string{
+	0: "PIECEWISE_BASIS_HEIGHT_UNSPECIFIED",
+	1: "PIECEWISE_BASIS_EPOCH",
+}
+
+var PiecewiseBasis_value = map[string]int32{
+	"PIECEWISE_BASIS_HEIGHT_UNSPECIFIED": 0,
+	"PIECEWISE_BASIS_EPOCH":              1,
+}
+
+func (x PiecewiseBasis) String() string {
+	return proto.EnumName(PiecewiseBasis_name, int32(x))
+}
+
+func (PiecewiseBasis) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{1}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual inflation rate
 	Inflation cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation"`
 	// current annual expected provisions
 	AnnualProvisions cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"annual_provisions"`
+	// height at which the current mint model was selected, the halving model
+	// counts its halvings from it.
+	ModelStartHeight int64 `protobuf:"varint,3,opt,name=model_start_height,json=modelStartHeight,proto3" json:"model_start_height,omitempty"`
+	// number of the last ended epoch of the piecewise epoch identifier, the
+	// piecewise model by epoch selects its segment from it.
+	EpochNumber int64 `protobuf:"varint,4,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -67,6 +146,68 @@ func (m *Minter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Minter proto.InternalMessageInfo
 
+func (m *Minter) GetModelStartHeight() int64 {
+	if m != nil {
+		return m.ModelStartHeight
+	}
+	return 0
+}
+
+func (m *Minter) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+// MintSegment defines a segment of the piecewise model.
+type MintSegment struct {
+	// height or epoch number the segment starts at.
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// amount minted per year within the segment.
+	AnnualEmission cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=annual_emission,json=annualEmission,proto3,customtype=cosmossdk.io/math.Int" json:"annual_emission"`
+}
+
+func (m *MintSegment) Reset()         { *m = MintSegment{} }
+func (m *MintSegment) String() string { return proto.CompactTextString(m) }
+func (*MintSegment) ProtoMessage()    {}
+func (*MintSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{1}
+}
+func (m *MintSegment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintSegment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintSegment.Merge(m, src)
+}
+func (m *MintSegment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintSegment proto.InternalMessageInfo
+
+func (m *MintSegment) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
 // Params defines the parameters for the x/mint module.
 type Params struct {
 	// type of coin to mint
@@ -85,13 +226,29 @@ type Params struct {
 	//
 	// A value of "0" indicates an unlimited (infinite) maximum supply.
 	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// model computing the annual provisions.
+	MintModel MintModel `protobuf:"varint,8,opt,name=mint_model,json=mintModel,proto3,enum=cosmos.mint.v1beta1.MintModel" json:"mint_model,omitempty"`
+	// amount minted per year by the fixed emission model, and before the first
+	// halving by the halving model.
+	AnnualEmission cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=annual_emission,json=annualEmission,proto3,customtype=cosmossdk.io/math.Int" json:"annual_emission"`
+	// number of blocks after which the halving model halves the annual emission.
+	HalvingInterval uint64 `protobuf:"varint,10,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+	// share of the supply remaining below max_supply minted per year by the
+	// capped supply model.
+	CappedSupplyRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=capped_supply_rate,json=cappedSupplyRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"capped_supply_rate"`
+	// what the segments of the piecewise model start at.
+	PiecewiseBasis PiecewiseBasis `protobuf:"varint,12,opt,name=piecewise_basis,json=piecewiseBasis,proto3,enum=cosmos.mint.v1beta1.PiecewiseBasis" json:"piecewise_basis,omitempty"`
+	// epoch identifier the piecewise model by epoch counts the epochs of.
+	PiecewiseEpochIdentifier string `protobuf:"bytes,13,opt,name=piecewise_epoch_identifier,json=piecewiseEpochIdentifier,proto3" json:"piecewise_epoch_identifier,omitempty"`
+	// segments of the piecewise model, sorted by start.
+	PiecewiseSegments []MintSegment `protobuf:"bytes,14,rep,name=piecewise_segments,json=piecewiseSegments,proto3" json:"piecewise_segments"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2df116d183c1e223, []int{1}
+	return fileDescriptor_2df116d183c1e223, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,45 +291,114 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetMintModel() MintModel {
+	if m != nil {
+		return m.MintModel
+	}
+	return MintModelBondedRatio
+}
+
+func (m *Params) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+func (m *Params) GetPiecewiseBasis() PiecewiseBasis {
+	if m != nil {
+		return m.PiecewiseBasis
+	}
+	return PiecewiseBasisHeight
+}
+
+func (m *Params) GetPiecewiseEpochIdentifier() string {
+	if m != nil {
+		return m.PiecewiseEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetPiecewiseSegments() []MintSegment {
+	if m != nil {
+		return m.PiecewiseSegments
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.mint.v1beta1.MintModel", MintModel_name, MintModel_value)
+	proto.RegisterEnum("cosmos.mint.v1beta1.PiecewiseBasis", PiecewiseBasis_name, PiecewiseBasis_value)
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
+	proto.RegisterType((*MintSegment)(nil), "cosmos.mint.v1beta1.MintSegment")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6b, 0xd4, 0x40,
-	0x1c, 0xc5, 0x37, 0xba, 0xae, 0xec, 0x68, 0xd1, 0x4e, 0x2d, 0x8c, 0x95, 0xa6, 0xa5, 0x07, 0xa9,
-	0x85, 0x26, 0x2e, 0x82, 0x07, 0x8f, 0xdb, 0xbd, 0x14, 0x2c, 0x2e, 0xf1, 0x20, 0x2a, 0x38, 0xfc,
-	0x93, 0x8c, 0xd9, 0x71, 0x33, 0x33, 0x21, 0x33, 0x5b, 0xb2, 0x5f, 0xc1, 0x93, 0x1f, 0xc3, 0x63,
-	0x0f, 0x5e, 0xbc, 0x78, 0xee, 0xb1, 0x78, 0x12, 0x0f, 0x45, 0x76, 0x0f, 0xfd, 0x1a, 0x92, 0x99,
-	0xb0, 0x15, 0x7b, 0xd2, 0xf6, 0x12, 0x92, 0xf7, 0xfe, 0xf9, 0xbd, 0xc7, 0x30, 0x7f, 0xe4, 0x27,
-	0x4a, 0x0b, 0xa5, 0x43, 0xc1, 0xa5, 0x09, 0x0f, 0x7b, 0x31, 0x33, 0xd0, 0xb3, 0x1f, 0x41, 0x51,
-	0x2a, 0xa3, 0xf0, 0x8a, 0xf3, 0x03, 0x2b, 0x35, 0xfe, 0xda, 0xbd, 0x4c, 0x65, 0xca, 0xfa, 0x61,
-	0xfd, 0xe6, 0x46, 0xd7, 0xee, 0xbb, 0x51, 0xea, 0x8c, 0xe6, 0x3f, 0x67, 0x2d, 0x83, 0xe0, 0x52,
-	0x85, 0xf6, 0xe9, 0xa4, 0xad, 0xaf, 0x1e, 0xea, 0x1c, 0x70, 0x69, 0x58, 0x89, 0x5f, 0xa0, 0x2e,
-	0x97, 0xef, 0x73, 0x30, 0x5c, 0x49, 0xe2, 0x6d, 0x7a, 0xdb, 0xdd, 0x7e, 0xef, 0xf8, 0x74, 0xa3,
-	0xf5, 0xf3, 0x74, 0xe3, 0x81, 0xc3, 0xe8, 0x74, 0x1c, 0x70, 0x15, 0x0a, 0x30, 0xa3, 0xe0, 0x39,
-	0xcb, 0x20, 0x99, 0x0e, 0x58, 0xf2, 0xfd, 0xcb, 0x2e, 0x6a, 0x52, 0x06, 0x2c, 0x89, 0xce, 0x19,
-	0xf8, 0x1d, 0x5a, 0x06, 0x29, 0x27, 0x90, 0xd7, 0x5d, 0x0e, 0xb9, 0xe6, 0x4a, 0x6a, 0x72, 0xed,
-	0x7f, 0xc1, 0x77, 0x1d, 0x6b, 0xb8, 0x40, 0x6d, 0x7d, 0x6b, 0xa3, 0xce, 0x10, 0x4a, 0x10, 0x1a,
-	0xaf, 0x23, 0x54, 0x1f, 0x0d, 0x4d, 0x99, 0x54, 0xc2, 0x95, 0x8f, 0xba, 0xb5, 0x32, 0xa8, 0x05,
-	0xfc, 0x01, 0xad, 0x2e, 0x6a, 0xd1, 0x12, 0x0c, 0xa3, 0xc9, 0x08, 0x64, 0xc6, 0x9a, 0x36, 0x4f,
-	0xff, 0xb9, 0xcd, 0xe7, 0xb3, 0xa3, 0x1d, 0x2f, 0x5a, 0x59, 0x40, 0x23, 0x30, 0x6c, 0xcf, 0x22,
-	0xf1, 0x5b, 0xb4, 0x74, 0x9e, 0x25, 0xa0, 0x22, 0xd7, 0x2f, 0x95, 0x71, 0x7b, 0x01, 0x3b, 0x80,
-	0xea, 0x2f, 0x38, 0x97, 0xa4, 0x7d, 0x55, 0x70, 0x2e, 0xf1, 0x2b, 0x74, 0x2b, 0x53, 0x90, 0xd3,
-	0x58, 0xc9, 0x94, 0xa5, 0xe4, 0xc6, 0xa5, 0xd0, 0xa8, 0x46, 0xf5, 0x2d, 0x09, 0x3f, 0x44, 0x77,
-	0xe2, 0x5c, 0x25, 0x63, 0x4d, 0x0b, 0x56, 0xd2, 0x29, 0x83, 0x92, 0x74, 0x36, 0xbd, 0xed, 0x76,
-	0xb4, 0xe4, 0xe4, 0x21, 0x2b, 0x5f, 0x33, 0xa8, 0x6f, 0x20, 0x12, 0x50, 0x51, 0x3d, 0x29, 0x8a,
-	0x7c, 0x4a, 0x6e, 0xda, 0xfc, 0xc7, 0x4d, 0xfe, 0xea, 0xc5, 0xfc, 0x7d, 0x69, 0xfe, 0x48, 0xde,
-	0x97, 0xc6, 0x25, 0x77, 0x05, 0x54, 0x2f, 0x2d, 0xe2, 0xd9, 0xfa, 0xc7, 0xb3, 0xa3, 0x1d, 0xe2,
-	0x06, 0x76, 0x75, 0x3a, 0x0e, 0x2b, 0xb7, 0x61, 0xee, 0xd6, 0xf4, 0xf7, 0x8e, 0x67, 0xbe, 0x77,
-	0x32, 0xf3, 0xbd, 0x5f, 0x33, 0xdf, 0xfb, 0x34, 0xf7, 0x5b, 0x27, 0x73, 0xbf, 0xf5, 0x63, 0xee,
-	0xb7, 0xde, 0x3c, 0xca, 0xb8, 0x19, 0x4d, 0xe2, 0x20, 0x51, 0xa2, 0x59, 0xa1, 0xf0, 0x22, 0xc5,
-	0x4c, 0x0b, 0xa6, 0xe3, 0x8e, 0x5d, 0xa4, 0x27, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x40, 0x97,
-	0xc4, 0xef, 0xc3, 0x03, 0x00, 0x00,
+	// 969 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x2d, 0xc5, 0x8d, 0x56, 0xb6, 0x4c, 0xaf, 0xe5, 0x94, 0x66, 0x11, 0x85, 0x75, 0x80,
+	0xc2, 0x31, 0x12, 0x29, 0x76, 0x81, 0x16, 0x2d, 0x5a, 0xa0, 0xfa, 0xa1, 0x23, 0x02, 0xfa, 0x21,
+	0x44, 0xa7, 0xa9, 0x53, 0xa0, 0x8b, 0x15, 0xb9, 0x91, 0xb6, 0x16, 0x97, 0x04, 0x49, 0xbb, 0xf2,
+	0xa5, 0xe7, 0x42, 0xe8, 0xa1, 0x2f, 0xa0, 0x53, 0x2f, 0x39, 0xe6, 0xd0, 0x87, 0xc8, 0x31, 0xed,
+	0xa9, 0xe8, 0x21, 0x28, 0xec, 0x43, 0x5e, 0xa3, 0xe0, 0x2e, 0x4b, 0xcb, 0x89, 0x7b, 0x68, 0x9c,
+	0x0b, 0xc1, 0x9d, 0x99, 0xef, 0xdb, 0x9d, 0x99, 0x6f, 0x67, 0x41, 0xd9, 0xf6, 0x42, 0xd7, 0x0b,
+	0xab, 0x2e, 0x65, 0x51, 0xf5, 0x78, 0x67, 0x40, 0x22, 0xbc, 0xc3, 0x17, 0x15, 0x3f, 0xf0, 0x22,
+	0x0f, 0xae, 0x09, 0x7f, 0x85, 0x9b, 0x12, 0xbf, 0x5a, 0x1a, 0x7a, 0x43, 0x8f, 0xfb, 0xab, 0xf1,
+	0x9f, 0x08, 0x55, 0x37, 0x44, 0x28, 0x12, 0x8e, 0x04, 0x27, 0x5c, 0xab, 0xd8, 0xa5, 0xcc, 0xab,
+	0xf2, 0xaf, 0x30, 0x6d, 0x4e, 0x17, 0xc0, 0x62, 0x87, 0xb2, 0x88, 0x04, 0xb0, 0x07, 0xf2, 0x94,
+	0x3d, 0x19, 0xe3, 0x88, 0x7a, 0x4c, 0x91, 0x34, 0x69, 0x2b, 0x5f, 0xdf, 0x79, 0xfe, 0xf2, 0x56,
+	0xe6, 0xaf, 0x97, 0xb7, 0x3e, 0x10, 0x34, 0xa1, 0x73, 0x58, 0xa1, 0x5e, 0xd5, 0xc5, 0xd1, 0xa8,
+	0xd2, 0x26, 0x43, 0x6c, 0x9f, 0x34, 0x89, 0xfd, 0xc7, 0x6f, 0xf7, 0x40, 0xb2, 0x4b, 0x93, 0xd8,
+	0xfd, 0x73, 0x0e, 0xf8, 0x1d, 0x58, 0xc5, 0x8c, 0x1d, 0xe1, 0x71, 0x7c, 0x96, 0x63, 0x1a, 0x52,
+	0x8f, 0x85, 0xca, 0xc2, 0xdb, 0x12, 0xcb, 0x82, 0xcb, 0x4c, 0xa9, 0xe0, 0x5d, 0x00, 0x5d, 0xcf,
+	0x21, 0x63, 0x14, 0x46, 0x38, 0x88, 0xd0, 0x88, 0xd0, 0xe1, 0x28, 0x52, 0xb2, 0x9a, 0xb4, 0x95,
+	0xed, 0xcb, 0xdc, 0x63, 0xc5, 0x8e, 0x16, 0xb7, 0xc3, 0x0f, 0xc1, 0x12, 0xf1, 0x3d, 0x7b, 0x84,
+	0xd8, 0x91, 0x3b, 0x20, 0x81, 0x92, 0xe3, 0x71, 0x05, 0x6e, 0xeb, 0x72, 0xd3, 0xe6, 0x8f, 0xa0,
+	0x10, 0xd7, 0xc2, 0x22, 0x43, 0x97, 0xb0, 0x08, 0x96, 0xc0, 0x35, 0xce, 0xcc, 0x8b, 0x91, 0xeb,
+	0x8b, 0x05, 0x3c, 0x00, 0x2b, 0x49, 0x56, 0xc4, 0xa5, 0x61, 0x7c, 0x92, 0x24, 0xa7, 0xfb, 0x49,
+	0x4e, 0xeb, 0x6f, 0xe6, 0x64, 0xb0, 0x68, 0x2e, 0x1b, 0x83, 0x45, 0x4f, 0x5f, 0x3d, 0xdb, 0x96,
+	0xfa, 0x45, 0x41, 0xa4, 0x27, 0x3c, 0x9b, 0xbf, 0x5f, 0x07, 0x8b, 0x26, 0x0e, 0xb0, 0x1b, 0xc2,
+	0x9b, 0x00, 0xc4, 0xbd, 0x46, 0x0e, 0x61, 0x9e, 0x2b, 0xba, 0xd1, 0xcf, 0xc7, 0x96, 0x66, 0x6c,
+	0x80, 0xdf, 0x83, 0xf5, 0xb4, 0xce, 0x28, 0xc0, 0x11, 0x41, 0xf6, 0x08, 0xb3, 0x21, 0x49, 0x8e,
+	0xf2, 0xc9, 0xff, 0x2e, 0xaf, 0x38, 0xd0, 0x5a, 0x4a, 0xda, 0xc7, 0x11, 0x69, 0x70, 0x4a, 0xf8,
+	0x2d, 0x58, 0x3e, 0xdf, 0xcb, 0xc5, 0x13, 0x25, 0x7b, 0xa5, 0x3d, 0x96, 0x52, 0xb2, 0x0e, 0x9e,
+	0xbc, 0x46, 0x4e, 0x99, 0x92, 0x7b, 0x57, 0xe4, 0x94, 0xc1, 0x47, 0xa0, 0x30, 0xf4, 0xf0, 0x18,
+	0x0d, 0x3c, 0xe6, 0x10, 0x47, 0xb9, 0x76, 0x25, 0x6a, 0x10, 0x53, 0xd5, 0x39, 0x13, 0xfc, 0x08,
+	0xac, 0x0c, 0xc6, 0x9e, 0x7d, 0x18, 0x22, 0x9f, 0x04, 0xe8, 0x84, 0xe0, 0x40, 0x59, 0xe4, 0x1a,
+	0x59, 0x16, 0x66, 0x93, 0x04, 0x07, 0x04, 0xc7, 0x57, 0x0a, 0xb8, 0x78, 0x82, 0xc2, 0x23, 0xdf,
+	0x1f, 0x9f, 0x28, 0xef, 0xbd, 0xa5, 0x4c, 0xf2, 0x2e, 0x9e, 0x58, 0x9c, 0x02, 0x7e, 0x99, 0xc8,
+	0x82, 0xab, 0x5b, 0xb9, 0xae, 0x49, 0x5b, 0xc5, 0xdd, 0x72, 0xe5, 0x92, 0xe1, 0x50, 0x89, 0x85,
+	0xdc, 0x89, 0xa3, 0x84, 0x6c, 0xf8, 0xef, 0x65, 0xda, 0xcd, 0xbf, 0x1b, 0xed, 0xc2, 0x3b, 0x40,
+	0x1e, 0xe1, 0xf1, 0x31, 0x65, 0x43, 0xc4, 0xc7, 0xc9, 0x31, 0x1e, 0x2b, 0x80, 0xd7, 0x64, 0x25,
+	0xb1, 0x1b, 0x89, 0x19, 0x3a, 0x00, 0xda, 0xd8, 0xf7, 0x89, 0x93, 0x14, 0x86, 0x0b, 0x58, 0x29,
+	0x5c, 0xa9, 0x3b, 0xb2, 0x60, 0x14, 0x65, 0x8a, 0xc5, 0x0b, 0xdb, 0x60, 0xc5, 0xa7, 0xc4, 0x26,
+	0x3f, 0xd0, 0x90, 0xa0, 0x01, 0x0e, 0x69, 0xa8, 0x2c, 0xf1, 0x7a, 0xdd, 0xbe, 0xb4, 0x5e, 0xe6,
+	0xbf, 0xb1, 0xf5, 0x38, 0xb4, 0x5f, 0xf4, 0x2f, 0xac, 0xe1, 0x17, 0x40, 0x3d, 0x67, 0x13, 0x73,
+	0x84, 0x3a, 0x84, 0x45, 0xf4, 0x09, 0x25, 0x81, 0xb2, 0xcc, 0xef, 0xa7, 0x92, 0x46, 0xe8, 0x71,
+	0x80, 0x91, 0xfa, 0xe1, 0x63, 0x00, 0xcf, 0xd1, 0xa1, 0x18, 0x2f, 0xa1, 0x52, 0xd4, 0xb2, 0x5b,
+	0x85, 0x5d, 0xed, 0x3f, 0xdb, 0x97, 0xcc, 0xa1, 0x7a, 0x3e, 0xae, 0x89, 0x48, 0x73, 0x35, 0xa5,
+	0x49, 0x9c, 0xe1, 0xe7, 0x37, 0xa7, 0xaf, 0x9e, 0x6d, 0x2b, 0x82, 0xe3, 0x5e, 0xe8, 0x1c, 0x56,
+	0x27, 0xe2, 0x15, 0x11, 0x83, 0x64, 0xfb, 0xe9, 0x02, 0xc8, 0xa7, 0x5a, 0x80, 0x35, 0x70, 0xbb,
+	0x63, 0x74, 0xf7, 0x51, 0xa7, 0xd7, 0xd4, 0xdb, 0xa8, 0xde, 0xeb, 0x36, 0xf5, 0x26, 0xea, 0xd7,
+	0xf6, 0x8d, 0x1e, 0x7a, 0xd8, 0xb5, 0x4c, 0xbd, 0x61, 0xec, 0x19, 0x7a, 0x53, 0xce, 0xa8, 0xca,
+	0x74, 0xa6, 0x95, 0x52, 0x9c, 0x90, 0x7d, 0x3f, 0xbe, 0x58, 0xf0, 0x33, 0xb0, 0x31, 0x47, 0xb1,
+	0x67, 0x7c, 0xa3, 0x37, 0x91, 0xde, 0x31, 0x2c, 0xcb, 0xe8, 0x75, 0x65, 0x49, 0x55, 0xa7, 0x33,
+	0xed, 0x46, 0x0a, 0xdc, 0xa3, 0x13, 0xe2, 0xa4, 0x1a, 0xb9, 0x0b, 0xe0, 0x1c, 0xb4, 0x55, 0x6b,
+	0x7f, 0x6d, 0x74, 0x1f, 0xc8, 0x0b, 0x6a, 0x69, 0x3a, 0xd3, 0xe4, 0x14, 0xd3, 0x12, 0x72, 0x81,
+	0x9f, 0x02, 0x65, 0x2e, 0xba, 0x51, 0x33, 0x4d, 0xbd, 0x89, 0xac, 0x87, 0xa6, 0xd9, 0x3e, 0x90,
+	0xb3, 0xea, 0xc6, 0x74, 0xa6, 0xad, 0xa7, 0x98, 0xc6, 0x5c, 0xf7, 0xe1, 0x7d, 0x50, 0x9a, 0x03,
+	0x9a, 0x86, 0xde, 0xd0, 0x1f, 0x19, 0x96, 0x2e, 0xe7, 0xd4, 0x1b, 0xd3, 0x99, 0x06, 0x53, 0x50,
+	0xda, 0x72, 0x35, 0xf7, 0xd3, 0xaf, 0xe5, 0xcc, 0xf6, 0xcf, 0x12, 0x28, 0x5e, 0x94, 0x01, 0xfc,
+	0x0a, 0x6c, 0xa6, 0x78, 0x54, 0xaf, 0x59, 0x86, 0x85, 0x5a, 0xba, 0xf1, 0xa0, 0xb5, 0x7f, 0x59,
+	0xb9, 0x2e, 0x62, 0x93, 0x67, 0x67, 0x17, 0xac, 0xbf, 0xce, 0xa0, 0x9b, 0xbd, 0x46, 0x4b, 0x96,
+	0xd4, 0xf7, 0xa7, 0x33, 0x6d, 0xed, 0x22, 0x88, 0x0b, 0x47, 0x1c, 0xa7, 0xde, 0x78, 0x7e, 0x5a,
+	0x96, 0x5e, 0x9c, 0x96, 0xa5, 0xbf, 0x4f, 0xcb, 0xd2, 0x2f, 0x67, 0xe5, 0xcc, 0x8b, 0xb3, 0x72,
+	0xe6, 0xcf, 0xb3, 0x72, 0xe6, 0xf1, 0x9d, 0x21, 0x8d, 0x46, 0x47, 0x83, 0x8a, 0xed, 0xb9, 0xc9,
+	0x03, 0x5f, 0x7d, 0xb3, 0xff, 0xd1, 0x89, 0x4f, 0xc2, 0xc1, 0x22, 0x7f, 0xe6, 0x3f, 0xfe, 0x67,
+	0x00, 0xb6, 0xe2, 0xaf, 0xc1, 0x61, 0x08, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -195,6 +421,16 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ModelStartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ModelStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.AnnualProvisions.Size()
 		i -= size
@@ -218,6 +454,44 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MintSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualEmission.Size()
+		i -= size
+		if _, err := m.AnnualEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Start != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -238,6 +512,62 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PiecewiseSegments) > 0 {
+		for iNdEx := len(m.PiecewiseSegments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PiecewiseSegments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PiecewiseEpochIdentifier) > 0 {
+		i -= len(m.PiecewiseEpochIdentifier)
+		copy(dAtA[i:], m.PiecewiseEpochIdentifier)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.PiecewiseEpochIdentifier)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.PiecewiseBasis != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.PiecewiseBasis))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.CappedSupplyRate.Size()
+		i -= size
+		if _, err := m.CappedSupplyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.HalvingInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.AnnualEmission.Size()
+		i -= size
+		if _, err := m.AnnualEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.MintModel != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MintModel))
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.MaxSupply.Size()
 		i -= size
@@ -324,6 +654,26 @@ func (m *Minter) Size() (n int) {
 	n += 1 + l + sovMint(uint64(l))
 	l = m.AnnualProvisions.Size()
 	n += 1 + l + sovMint(uint64(l))
+	if m.ModelStartHeight != 0 {
+		n += 1 + sovMint(uint64(m.ModelStartHeight))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovMint(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *MintSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovMint(uint64(m.Start))
+	}
+	l = m.AnnualEmission.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -350,6 +700,29 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MaxSupply.Size()
 	n += 1 + l + sovMint(uint64(l))
+	if m.MintModel != 0 {
+		n += 1 + sovMint(uint64(m.MintModel))
+	}
+	l = m.AnnualEmission.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.HalvingInterval != 0 {
+		n += 1 + sovMint(uint64(m.HalvingInterval))
+	}
+	l = m.CappedSupplyRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.PiecewiseBasis != 0 {
+		n += 1 + sovMint(uint64(m.PiecewiseBasis))
+	}
+	l = len(m.PiecewiseEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.PiecewiseSegments) > 0 {
+		for _, e := range m.PiecewiseSegments {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -456,6 +829,147 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelStartHeight", wireType)
+			}
+			m.ModelStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModelStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintSegment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintSegment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -727,6 +1241,197 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintModel", wireType)
+			}
+			m.MintModel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintModel |= MintModel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CappedSupplyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CappedSupplyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PiecewiseBasis", wireType)
+			}
+			m.PiecewiseBasis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PiecewiseBasis |= PiecewiseBasis(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PiecewiseEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PiecewiseEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PiecewiseSegments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PiecewiseSegments = append(m.PiecewiseSegments, MintSegment{})
+			if err := m.PiecewiseSegments[len(m.PiecewiseSegments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
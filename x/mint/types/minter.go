@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	gomath "math"
+	"math/big"
 
 	"cosmossdk.io/math"
 
@@ -80,3 +82,93 @@ func (m Minter) BlockProvision(params Params) sdk.Coin {
 	provisionAmt := m.AnnualProvisions.QuoInt(math.NewInt(int64(params.BlocksPerYear)))
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
+
+// ModelAnnualProvisions returns the annual provisions of the mint model
+// selected by the params at the given height, for every model other than the
+// bonded ratio one which is driven by the inflation rate instead.
+func (m Minter) ModelAnnualProvisions(params Params, height int64, totalSupply math.Int) math.LegacyDec {
+	switch params.MintModel {
+	case MintModelFixedEmission:
+		return math.LegacyNewDecFromInt(params.AnnualEmission)
+
+	case MintModelHalving:
+		return math.LegacyNewDecFromInt(halve(params.AnnualEmission, m.halvings(params, height)))
+
+	case MintModelCappedSupply:
+		remaining := params.MaxSupply.Sub(totalSupply)
+		if !remaining.IsPositive() {
+			return math.LegacyZeroDec()
+		}
+		return math.LegacyNewDecFromInt(remaining).Mul(params.CappedSupplyRate)
+
+	case MintModelPiecewise:
+		position := uint64(0)
+		switch {
+		case params.PiecewiseBasis == PiecewiseBasisEpoch && m.EpochNumber > 0:
+			position = uint64(m.EpochNumber)
+		case params.PiecewiseBasis == PiecewiseBasisHeight && height > 0:
+			position = uint64(height)
+		}
+
+		segment, found := params.PiecewiseSegment(position)
+		if !found {
+			return math.LegacyZeroDec()
+		}
+		return math.LegacyNewDecFromInt(segment.AnnualEmission)
+
+	default:
+		return m.AnnualProvisions
+	}
+}
+
+// NextModelChange returns the first height after the given one at which the
+// annual provisions of the selected mint model may change without the supply
+// changing, or math.MaxInt64 if there is none.
+func (m Minter) NextModelChange(params Params, height int64) int64 {
+	switch {
+	case params.MintModel == MintModelHalving:
+		halvings := m.halvings(params, height)
+		if halve(params.AnnualEmission, halvings).IsZero() {
+			return gomath.MaxInt64
+		}
+		if height < m.ModelStartHeight {
+			return m.ModelStartHeight
+		}
+		return m.ModelStartHeight + (halvings+1)*int64(params.HalvingInterval)
+
+	case params.MintModel == MintModelPiecewise && params.PiecewiseBasis == PiecewiseBasisHeight:
+		for _, segment := range params.PiecewiseSegments {
+			if segment.Start > uint64(max(height, 0)) && segment.Start <= gomath.MaxInt64 {
+				return int64(segment.Start)
+			}
+		}
+	}
+
+	return gomath.MaxInt64
+}
+
+// PiecewiseSegment returns the last piecewise segment starting at or before the
+// given position.
+func (p Params) PiecewiseSegment(position uint64) (segment MintSegment, found bool) {
+	for _, s := range p.PiecewiseSegments {
+		if s.Start > position {
+			break
+		}
+		segment, found = s, true
+	}
+	return segment, found
+}
+
+// halvings returns the number of halvings of the annual emission at the given
+// height since the halving model was selected.
+func (m Minter) halvings(params Params, height int64) int64 {
+	if params.HalvingInterval == 0 || height <= m.ModelStartHeight {
+		return 0
+	}
+	return (height - m.ModelStartHeight) / int64(params.HalvingInterval)
+}
+
+// halve halves the given amount the given number of times.
+func halve(amount math.Int, halvings int64) math.Int {
+	return math.NewIntFromBigInt(new(big.Int).Rsh(amount.BigInt(), uint(halvings)))
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	gomath "math"
 	"math/rand"
 	"testing"
 
@@ -136,3 +137,69 @@ func BenchmarkNextAnnualProvisions(b *testing.B) {
 		minter.NextAnnualProvisions(params, totalSupply)
 	}
 }
+
+func TestModelAnnualProvisions(t *testing.T) {
+	params := DefaultParams()
+	params.AnnualEmission = math.NewInt(1000)
+	params.HalvingInterval = 100
+	params.MaxSupply = math.NewInt(10_000)
+	params.CappedSupplyRate = math.LegacyNewDecWithPrec(1, 1)
+	params.PiecewiseSegments = []MintSegment{
+		{Start: 10, AnnualEmission: math.NewInt(300)},
+		{Start: 20, AnnualEmission: math.NewInt(200)},
+	}
+
+	minter := DefaultInitialMinter()
+	minter.ModelStartHeight = 50
+	minter.EpochNumber = 25
+
+	tests := []struct {
+		name   string
+		model  MintModel
+		basis  PiecewiseBasis
+		height int64
+		supply math.Int
+		exp    math.LegacyDec
+	}{
+		{"fixed emission", MintModelFixedEmission, PiecewiseBasisHeight, 1, math.NewInt(5000), math.LegacyNewDec(1000)},
+		{"halving before the first interval", MintModelHalving, PiecewiseBasisHeight, 149, math.NewInt(5000), math.LegacyNewDec(1000)},
+		{"halving after one interval", MintModelHalving, PiecewiseBasisHeight, 150, math.NewInt(5000), math.LegacyNewDec(500)},
+		{"halving after three intervals", MintModelHalving, PiecewiseBasisHeight, 350, math.NewInt(5000), math.LegacyNewDec(125)},
+		{"halving to zero", MintModelHalving, PiecewiseBasisHeight, 100_000, math.NewInt(5000), math.LegacyZeroDec()},
+		{"capped supply", MintModelCappedSupply, PiecewiseBasisHeight, 1, math.NewInt(5000), math.LegacyNewDec(500)},
+		{"capped supply reached", MintModelCappedSupply, PiecewiseBasisHeight, 1, math.NewInt(10_000), math.LegacyZeroDec()},
+		{"piecewise before the first segment", MintModelPiecewise, PiecewiseBasisHeight, 9, math.NewInt(5000), math.LegacyZeroDec()},
+		{"piecewise by height", MintModelPiecewise, PiecewiseBasisHeight, 15, math.NewInt(5000), math.LegacyNewDec(300)},
+		{"piecewise by epoch", MintModelPiecewise, PiecewiseBasisEpoch, 15, math.NewInt(5000), math.LegacyNewDec(200)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params.MintModel, params.PiecewiseBasis = tc.model, tc.basis
+			require.Equal(t, tc.exp, minter.ModelAnnualProvisions(params, tc.height, tc.supply))
+		})
+	}
+}
+
+func TestNextModelChange(t *testing.T) {
+	params := DefaultParams()
+	params.MintModel = MintModelHalving
+	params.AnnualEmission = math.NewInt(4)
+	params.HalvingInterval = 100
+
+	minter := DefaultInitialMinter()
+	minter.ModelStartHeight = 50
+
+	require.Equal(t, int64(150), minter.NextModelChange(params, 60))
+	require.Equal(t, int64(250), minter.NextModelChange(params, 150))
+	// the emission is zero after the third halving
+	require.Equal(t, int64(gomath.MaxInt64), minter.NextModelChange(params, 400))
+
+	params.MintModel = MintModelPiecewise
+	params.PiecewiseSegments = []MintSegment{{Start: 10, AnnualEmission: math.NewInt(1)}, {Start: 20, AnnualEmission: math.NewInt(2)}}
+	require.Equal(t, int64(10), minter.NextModelChange(params, 5))
+	require.Equal(t, int64(20), minter.NextModelChange(params, 10))
+	require.Equal(t, int64(gomath.MaxInt64), minter.NextModelChange(params, 20))
+
+	params.MintModel = MintModelFixedEmission
+	require.Equal(t, int64(gomath.MaxInt64), minter.NextModelChange(params, 1))
+}
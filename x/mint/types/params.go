@@ -21,6 +21,8 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
 		MaxSupply:           maxSupply,
+		AnnualEmission:      sdkmath.ZeroInt(),
+		CappedSupplyRate:    sdkmath.LegacyZeroDec(),
 	}
 }
 
@@ -34,6 +36,9 @@ func DefaultParams() Params {
 		GoalBonded:          sdkmath.LegacyNewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
 		MaxSupply:           sdkmath.ZeroInt(),          // assuming zero is infinite
+		MintModel:           MintModelBondedRatio,
+		AnnualEmission:      sdkmath.ZeroInt(),
+		CappedSupplyRate:    sdkmath.LegacyZeroDec(),
 	}
 }
 
@@ -66,8 +71,63 @@ func (p Params) Validate() error {
 			p.InflationMax, p.InflationMin,
 		)
 	}
+	if err := validateAnnualEmission(p.AnnualEmission); err != nil {
+		return err
+	}
+	if err := validateCappedSupplyRate(p.CappedSupplyRate); err != nil {
+		return err
+	}
+	if err := validatePiecewiseSegments(p.PiecewiseSegments); err != nil {
+		return err
+	}
 
-	return nil
+	return p.validateMintModel()
+}
+
+// validateMintModel checks that the parameters required by the selected mint
+// model are set.
+func (p Params) validateMintModel() error {
+	switch p.MintModel {
+	case MintModelBondedRatio, MintModelFixedEmission:
+		return nil
+
+	case MintModelHalving:
+		if p.HalvingInterval == 0 {
+			return errors.New("halving interval must be positive for the halving mint model")
+		}
+		if p.HalvingInterval > math.MaxInt64 {
+			return fmt.Errorf("halving interval too large: %d, maximum value is: %d", p.HalvingInterval, math.MaxInt64)
+		}
+		return nil
+
+	case MintModelCappedSupply:
+		if !p.MaxSupply.IsPositive() {
+			return errors.New("max supply must be positive for the capped supply mint model")
+		}
+		if !p.CappedSupplyRate.IsPositive() {
+			return errors.New("capped supply rate must be positive for the capped supply mint model")
+		}
+		return nil
+
+	case MintModelPiecewise:
+		if len(p.PiecewiseSegments) == 0 {
+			return errors.New("piecewise segments cannot be empty for the piecewise mint model")
+		}
+		switch p.PiecewiseBasis {
+		case PiecewiseBasisHeight:
+			return nil
+		case PiecewiseBasisEpoch:
+			if strings.TrimSpace(p.PiecewiseEpochIdentifier) == "" {
+				return errors.New("piecewise epoch identifier cannot be blank for the epoch piecewise basis")
+			}
+			return nil
+		default:
+			return fmt.Errorf("unknown piecewise basis: %d", p.PiecewiseBasis)
+		}
+
+	default:
+		return fmt.Errorf("unknown mint model: %d", p.MintModel)
+	}
 }
 
 func validateMintDenom(denom string) error {
@@ -161,3 +221,41 @@ func validateMaxSupply(i interface{}) error {
 
 	return nil
 }
+
+func validateAnnualEmission(annualEmission sdkmath.Int) error {
+	if annualEmission.IsNil() {
+		return errors.New("annual emission cannot be nil")
+	}
+	if annualEmission.IsNegative() {
+		return fmt.Errorf("annual emission cannot be negative: %s", annualEmission)
+	}
+
+	return nil
+}
+
+func validateCappedSupplyRate(rate sdkmath.LegacyDec) error {
+	if rate.IsNil() {
+		return fmt.Errorf("capped supply rate cannot be nil: %s", rate)
+	}
+	if rate.IsNegative() {
+		return fmt.Errorf("capped supply rate cannot be negative: %s", rate)
+	}
+	if rate.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("capped supply rate too large: %s", rate)
+	}
+
+	return nil
+}
+
+func validatePiecewiseSegments(segments []MintSegment) error {
+	for i, segment := range segments {
+		if err := validateAnnualEmission(segment.AnnualEmission); err != nil {
+			return fmt.Errorf("invalid piecewise segment %d: %w", i, err)
+		}
+		if i > 0 && segment.Start <= segments[i-1].Start {
+			return fmt.Errorf("piecewise segments must have strictly increasing starts: %d after %d", segment.Start, segments[i-1].Start)
+		}
+	}
+
+	return nil
+}
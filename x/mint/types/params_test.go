@@ -378,3 +378,60 @@ func TestValidateMaxSupply(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMintModel(t *testing.T) {
+	t.Parallel()
+
+	withModel := func(model MintModel, update func(p *Params)) Params {
+		p := DefaultParams()
+		p.MintModel = model
+		update(&p)
+		return p
+	}
+	segments := []MintSegment{{Start: 0, AnnualEmission: sdkmath.NewInt(100)}, {Start: 10, AnnualEmission: sdkmath.NewInt(50)}}
+
+	tests := []struct {
+		name        string
+		params      Params
+		errContains string
+	}{
+		{name: "fixed emission", params: withModel(MintModelFixedEmission, func(p *Params) { p.AnnualEmission = sdkmath.NewInt(100) })},
+		{name: "negative annual emission", params: withModel(MintModelFixedEmission, func(p *Params) { p.AnnualEmission = sdkmath.NewInt(-1) }), errContains: "annual emission cannot be negative"},
+		{name: "halving", params: withModel(MintModelHalving, func(p *Params) { p.HalvingInterval = 100 })},
+		{name: "halving without interval", params: withModel(MintModelHalving, func(p *Params) {}), errContains: "halving interval must be positive"},
+		{name: "capped supply", params: withModel(MintModelCappedSupply, func(p *Params) {
+			p.MaxSupply, p.CappedSupplyRate = sdkmath.NewInt(1000), sdkmath.LegacyNewDecWithPrec(1, 1)
+		})},
+		{name: "capped supply without max supply", params: withModel(MintModelCappedSupply, func(p *Params) {
+			p.CappedSupplyRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+		}), errContains: "max supply must be positive"},
+		{name: "capped supply rate too large", params: withModel(MintModelCappedSupply, func(p *Params) {
+			p.MaxSupply, p.CappedSupplyRate = sdkmath.NewInt(1000), sdkmath.LegacyNewDec(2)
+		}), errContains: "capped supply rate too large"},
+		{name: "piecewise by height", params: withModel(MintModelPiecewise, func(p *Params) { p.PiecewiseSegments = segments })},
+		{name: "piecewise without segments", params: withModel(MintModelPiecewise, func(p *Params) {}), errContains: "piecewise segments cannot be empty"},
+		{name: "piecewise segments out of order", params: withModel(MintModelPiecewise, func(p *Params) {
+			p.PiecewiseSegments = []MintSegment{segments[1], segments[0]}
+		}), errContains: "strictly increasing"},
+		{name: "piecewise by epoch", params: withModel(MintModelPiecewise, func(p *Params) {
+			p.PiecewiseBasis, p.PiecewiseEpochIdentifier, p.PiecewiseSegments = PiecewiseBasisEpoch, "week", segments
+		})},
+		{name: "piecewise by epoch without identifier", params: withModel(MintModelPiecewise, func(p *Params) {
+			p.PiecewiseBasis, p.PiecewiseSegments = PiecewiseBasisEpoch, segments
+		}), errContains: "piecewise epoch identifier cannot be blank"},
+		{name: "unknown model", params: withModel(MintModel(42), func(p *Params) {}), errContains: "unknown mint model"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.params.Validate()
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	schedulerv1 "cosmossdk.io/api/cosmos/scheduler/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: schedulerv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current scheduler parameters",
+				},
+				{
+					RpcMethod:      "Job",
+					Use:            "job [job-id]",
+					Short:          "Query a pending job by its identifier",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "job_id"}},
+				},
+				{
+					RpcMethod: "Jobs",
+					Use:       "jobs",
+					Short:     "Query all the pending jobs",
+				},
+				{
+					RpcMethod:      "JobsByOwner",
+					Use:            "jobs-by-owner [owner]",
+					Short:          "Query the pending jobs of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              schedulerv1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // the schedule-job command is defined manually
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "ScheduleJob",
+					Skip:      true, // skipped because it has a custom command
+				},
+				{
+					RpcMethod:      "CancelJob",
+					Use:            "cancel-job [job-id]",
+					Short:          "Cancel a pending job and get the fees of its remaining executions refunded",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "job_id"}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update scheduler module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update scheduler module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query scheduler params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx scheduler update-params-proposal '{ "max_block_gas": "10000000", ... }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
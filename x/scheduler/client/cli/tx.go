@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+const (
+	FlagHeight        = "height"
+	FlagTime          = "time"
+	FlagEpoch         = "epoch"
+	FlagExecutions    = "executions"
+	FlagBlockInterval = "block-interval"
+	FlagTimeInterval  = "time-interval"
+	FlagJobGas        = "job-gas"
+	FlagJobFee        = "job-fee"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/scheduler transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Scheduler transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewScheduleJobCmd(),
+	)
+
+	return txCmd
+}
+
+// NewScheduleJobCmd returns a CLI command handler for creating a MsgScheduleJob
+// transaction.
+func NewScheduleJobCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-job [msgs-file]",
+		Short: "Schedule messages to be executed at a given height, time or epoch end",
+		Long: fmt.Sprintf(`Schedule messages to be executed on behalf of the sender at a given height,
+time or at the end of an x/epochs epoch. The messages are read from a JSON file holding
+an array of proto-JSON-encoded messages, which must all be signed by the sender.
+The fee of each execution is escrowed when the job is scheduled.
+
+Example:
+$ %s tx scheduler schedule-job path/to/msgs.json --height 1000 --job-gas 200000 --job-fee 2000stake --from mykey
+$ %s tx scheduler schedule-job path/to/msgs.json --epoch day --executions 30 --job-gas 200000 --job-fee 2000stake --from mykey
+
+Where msgs.json contains:
+
+[
+  {
+    "@type": "/cosmos.bank.v1beta1.MsgSend",
+    "from_address": "cosmos1...",
+    "to_address": "cosmos1...",
+    "amount": [{"denom": "stake", "amount": "10"}]
+  }
+]
+`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := parseMsgs(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			anys, err := sdktx.SetMsgs(msgs)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgScheduleJob{Owner: clientCtx.GetFromAddress().String(), Msgs: anys}
+			if msg.Trigger, err = readTrigger(cmd); err != nil {
+				return err
+			}
+			if msg.Executions, err = cmd.Flags().GetUint64(FlagExecutions); err != nil {
+				return err
+			}
+			if msg.BlockInterval, err = cmd.Flags().GetInt64(FlagBlockInterval); err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(FlagTimeInterval) {
+				timeInterval, err := cmd.Flags().GetDuration(FlagTimeInterval)
+				if err != nil {
+					return err
+				}
+				msg.TimeInterval = &timeInterval
+			}
+			if msg.GasLimit, err = cmd.Flags().GetUint64(FlagJobGas); err != nil {
+				return err
+			}
+			fee, err := cmd.Flags().GetString(FlagJobFee)
+			if err != nil {
+				return err
+			}
+			if msg.Fee, err = sdk.ParseCoinsNormalized(fee); err != nil {
+				return fmt.Errorf("invalid job fee: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeight, 0, "The height at which the job is executed")
+	cmd.Flags().String(FlagTime, "", "The time after which the job is executed, in RFC3339 format")
+	cmd.Flags().String(FlagEpoch, "", "The identifier of the epoch at the end of which the job is executed")
+	cmd.Flags().Uint64(FlagExecutions, 1, "The number of times the job is executed")
+	cmd.Flags().Int64(FlagBlockInterval, 0, "The number of blocks between two executions of a job triggered by height")
+	cmd.Flags().Duration(FlagTimeInterval, 0, "The duration between two executions of a job triggered by time")
+	cmd.Flags().Uint64(FlagJobGas, 0, "The gas limit of each execution of the job")
+	cmd.Flags().String(FlagJobFee, "", "The fee paid for each execution of the job")
+	cmd.MarkFlagsMutuallyExclusive(FlagHeight, FlagTime, FlagEpoch)
+	_ = cmd.MarkFlagRequired(FlagJobGas)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readTrigger reads the trigger of a job from the flags.
+func readTrigger(cmd *cobra.Command) (types.Trigger, error) {
+	var trigger types.Trigger
+	var err error
+
+	if trigger.Height, err = cmd.Flags().GetInt64(FlagHeight); err != nil {
+		return trigger, err
+	}
+	if trigger.EpochIdentifier, err = cmd.Flags().GetString(FlagEpoch); err != nil {
+		return trigger, err
+	}
+
+	timeStr, err := cmd.Flags().GetString(FlagTime)
+	if err != nil {
+		return trigger, err
+	}
+	if timeStr != "" {
+		t, err := time.Parse(time.RFC3339, timeStr)
+		if err != nil {
+			return trigger, fmt.Errorf("invalid time: %w", err)
+		}
+		trigger.Time = &t
+	}
+
+	if trigger.Height == 0 && trigger.Time == nil && trigger.EpochIdentifier == "" {
+		return trigger, fmt.Errorf("one of --%s, --%s or --%s is required", FlagHeight, FlagTime, FlagEpoch)
+	}
+
+	return trigger, nil
+}
+
+// parseMsgs reads the messages of a job from a JSON file.
+func parseMsgs(cdc codec.Codec, path string) ([]sdk.Msg, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgsJSON []json.RawMessage
+	if err := json.Unmarshal(contents, &msgsJSON); err != nil {
+		return nil, err
+	}
+	if len(msgsJSON) == 0 {
+		return nil, errors.New("no messages in file")
+	}
+
+	msgs := make([]sdk.Msg, len(msgsJSON))
+	for i, msgJSON := range msgsJSON {
+		if err := cdc.UnmarshalInterfaceJSON(msgJSON, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("msg %d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
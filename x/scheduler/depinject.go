@@ -0,0 +1,86 @@
+package scheduler
+
+import (
+	modulev1 "cosmossdk.io/api/cosmos/scheduler/module/v1"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/depinject/appconfig"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/keeper"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+var _ depinject.OnePerModuleType = AppModule{}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am AppModule) IsOnePerModuleType() {}
+
+func init() {
+	appconfig.RegisterModule(&modulev1.Module{},
+		appconfig.Provide(ProvideModule),
+	)
+}
+
+type ModuleInputs struct {
+	depinject.In
+
+	Config           *modulev1.Module
+	Cdc              codec.Codec
+	StoreService     store.KVStoreService
+	MsgServiceRouter baseapp.MessageRouter
+
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
+	EpochsKeeper  *epochskeeper.Keeper `optional:"true"`
+}
+
+type ModuleOutputs struct {
+	depinject.Out
+
+	SchedulerKeeper keeper.Keeper
+	Module          appmodule.AppModule
+	EpochHooks      epochstypes.EpochHooksWrapper
+}
+
+func ProvideModule(in ModuleInputs) ModuleOutputs {
+	feeCollectorName := in.Config.FeeCollectorName
+	if feeCollectorName == "" {
+		feeCollectorName = authtypes.FeeCollectorName
+	}
+
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	var epochsKeeper types.EpochsKeeper
+	if in.EpochsKeeper != nil {
+		epochsKeeper = in.EpochsKeeper
+	}
+
+	k := keeper.NewKeeper(
+		in.Cdc,
+		in.StoreService,
+		in.AccountKeeper,
+		in.BankKeeper,
+		epochsKeeper,
+		in.MsgServiceRouter,
+		feeCollectorName,
+		authority.String(),
+	)
+	m := NewAppModule(k)
+
+	return ModuleOutputs{
+		SchedulerKeeper: k,
+		Module:          m,
+		EpochHooks:      epochstypes.EpochHooksWrapper{EpochHooks: k.Hooks()},
+	}
+}
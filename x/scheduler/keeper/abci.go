@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker executes the due jobs: first the jobs triggered by an epoch which
+// ended, then the jobs triggered by height and by time, in the order of their
+// triggers. Jobs are executed as long as their gas limits fit in the max block
+// gas, the remaining ones are executed in the next blocks. Jobs whose gas limit
+// exceeds the max block gas, which may happen once the params are updated, can
+// never be executed and are dropped.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids, dropped, err := k.dueJobIDs(sdkCtx, params.MaxBlockGas)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range dropped {
+		job, err := k.Jobs.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		reason := fmt.Sprintf("gas limit %d exceeds the max block gas %d", job.GasLimit, params.MaxBlockGas)
+		if err := k.dropJob(sdkCtx, job, reason); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range ids {
+		// a job executed before in this block may have cancelled this one
+		job, err := k.Jobs.Get(ctx, id)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		if _, err := k.executeJob(sdkCtx, job); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dueJobIDs returns the identifiers of the due jobs whose gas limits fit in the
+// given max block gas, in execution order, and the identifiers of the due jobs
+// whose gas limits exceed it. Jobs whose gas limits do not fit in the remaining
+// gas are skipped, and come first in the next blocks.
+func (k Keeper) dueJobIDs(ctx sdk.Context, maxBlockGas uint64) (ids, dropped []uint64, err error) {
+	budget := maxBlockGas
+
+	// add returns true to stop the iteration once the budget is exhausted
+	add := func(id uint64) (bool, error) {
+		job, err := k.Jobs.Get(ctx, id)
+		if err != nil {
+			return true, err
+		}
+
+		switch {
+		case job.GasLimit > maxBlockGas:
+			dropped = append(dropped, id)
+		case job.GasLimit <= budget:
+			budget -= job.GasLimit
+			ids = append(ids, id)
+		}
+
+		return budget == 0, nil
+	}
+
+	err = k.DueJobs.Walk(ctx, nil, add)
+	if err != nil || budget == 0 {
+		return ids, dropped, err
+	}
+
+	err = k.HeightQueue.Walk(ctx, collections.NewPrefixUntilPairRange[int64, uint64](ctx.BlockHeight()), func(key collections.Pair[int64, uint64]) (bool, error) {
+		return add(key.K2())
+	})
+	if err != nil || budget == 0 {
+		return ids, dropped, err
+	}
+
+	err = k.TimeQueue.Walk(ctx, collections.NewPrefixUntilPairRange[time.Time, uint64](ctx.BlockTime()), func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		return add(key.K2())
+	})
+
+	return ids, dropped, err
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// InitGenesis initializes the scheduler module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	if err := k.JobSeq.Set(ctx, gs.NextJobId); err != nil {
+		return err
+	}
+
+	for _, job := range gs.Jobs {
+		if err := k.setJob(ctx, job); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range gs.DueJobIds {
+		job, err := k.Jobs.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := k.dequeueJob(ctx, job); err != nil {
+			return err
+		}
+		if err := k.DueJobs.Set(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the scheduler module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nextJobID, err := k.JobSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := []types.Job{}
+	err = k.Jobs.Walk(ctx, nil, func(_ uint64, job types.Job) (bool, error) {
+		jobs = append(jobs, job)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var dueJobIDs []uint64
+	err = k.DueJobs.Walk(ctx, nil, func(id uint64) (bool, error) {
+		dueJobIDs = append(dueJobIDs, id)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		Params:    params,
+		Jobs:      jobs,
+		NextJobId: nextJobID,
+		DueJobIds: dueJobIDs,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks wrapper struct for the scheduler keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the epoch hooks of the scheduler keeper, which mark the jobs
+// triggered by an epoch as due when the epoch ends.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterEpochEnd moves the jobs triggered by the ended epoch to the due jobs.
+func (h Hooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	var keys []collections.Pair[string, uint64]
+	err := h.k.EpochQueue.Walk(ctx, collections.NewPrefixedPairRange[string, uint64](epochIdentifier), func(key collections.Pair[string, uint64]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := h.k.EpochQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := h.k.DueJobs.Set(ctx, key.K2()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BeforeEpochStart is a no-op.
+func (h Hooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
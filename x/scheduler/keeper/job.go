@@ -0,0 +1,322 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// ScheduleJob validates the request, escrows the fees of all the executions of
+// the job and stores it. It returns the identifier of the job.
+func (k Keeper) ScheduleJob(ctx context.Context, msg *types.MsgScheduleJob) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	owner, err := k.addressCodec.StringToBytes(msg.Owner)
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	executions := max(msg.Executions, 1)
+	job := types.Job{
+		Owner:               msg.Owner,
+		Msgs:                msg.Msgs,
+		Trigger:             msg.Trigger,
+		RemainingExecutions: executions,
+		BlockInterval:       msg.BlockInterval,
+		TimeInterval:        msg.TimeInterval,
+		GasLimit:            msg.GasLimit,
+		Fee:                 msg.Fee,
+	}
+	if err := job.Validate(); err != nil {
+		return 0, err
+	}
+
+	switch trigger := msg.Trigger; {
+	case trigger.Height != 0 && trigger.Height <= sdkCtx.BlockHeight():
+		return 0, errorsmod.Wrapf(types.ErrInvalidTrigger, "height %d is not in the future", trigger.Height)
+	case trigger.Time != nil && !trigger.Time.After(sdkCtx.BlockTime()):
+		return 0, errorsmod.Wrapf(types.ErrInvalidTrigger, "time %s is not in the future", trigger.Time)
+	case trigger.EpochIdentifier != "":
+		if k.epochsKeeper == nil {
+			return 0, errorsmod.Wrap(types.ErrInvalidTrigger, "jobs cannot be triggered by an epoch")
+		}
+		if _, err := k.epochsKeeper.GetEpochInfo(sdkCtx, trigger.EpochIdentifier); err != nil {
+			return 0, errorsmod.Wrap(types.ErrUnknownEpoch, trigger.EpochIdentifier)
+		}
+	}
+
+	if len(msg.Msgs) > int(params.MaxMsgsPerJob) {
+		return 0, errorsmod.Wrapf(types.ErrInvalidJob, "too many messages: %d > %d", len(msg.Msgs), params.MaxMsgsPerJob)
+	}
+	if msg.GasLimit > params.MaxJobGas {
+		return 0, errorsmod.Wrapf(types.ErrInvalidJob, "gas limit %d exceeds the max job gas %d", msg.GasLimit, params.MaxJobGas)
+	}
+	if requiredFee := params.RequiredFee(msg.GasLimit); !requiredFee.IsZero() && !msg.Fee.IsAnyGTE(requiredFee) {
+		return 0, errorsmod.Wrapf(types.ErrInsufficientFee, "got: %s required: %s", msg.Fee, requiredFee)
+	}
+
+	msgs, err := job.GetMessages()
+	if err != nil {
+		return 0, err
+	}
+	for i, m := range msgs {
+		if err := k.validateJobMsg(owner, m); err != nil {
+			return 0, errorsmod.Wrapf(err, "msg %d", i)
+		}
+	}
+
+	if fee := job.EscrowedFee(); !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, fee); err != nil {
+			return 0, err
+		}
+	}
+
+	job.Id, err = k.JobSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.setJob(ctx, job); err != nil {
+		return 0, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventJobScheduled{JobId: job.Id, Owner: job.Owner}); err != nil {
+		return 0, err
+	}
+
+	return job.Id, nil
+}
+
+// validateJobMsg checks that a message can be executed on behalf of the owner
+// of a job.
+func (k Keeper) validateJobMsg(owner []byte, msg sdk.Msg) error {
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return err
+	}
+	if len(signers) != 1 || !bytes.Equal(signers[0], owner) {
+		return types.ErrInvalidSigner
+	}
+
+	if k.router.Handler(msg) == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		return m.ValidateBasic()
+	}
+
+	return nil
+}
+
+// CancelJob removes a pending job of the owner and refunds the fees of its
+// remaining executions.
+func (k Keeper) CancelJob(ctx context.Context, owner string, id uint64) (sdk.Coins, error) {
+	ownerAddr, err := k.addressCodec.StringToBytes(owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	job, err := k.Jobs.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrJobNotFound, "job %d", id)
+		}
+		return nil, err
+	}
+
+	if job.Owner != owner {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of job %d", owner, id)
+	}
+
+	refund := job.EscrowedFee()
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, ownerAddr, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.removeJob(ctx, job); err != nil {
+		return nil, err
+	}
+
+	return refund, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventJobCancelled{JobId: id, Owner: owner})
+}
+
+// dropJob removes a job which cannot be executed anymore and refunds the fees
+// of its remaining executions to its owner.
+func (k Keeper) dropJob(ctx sdk.Context, job types.Job, reason string) error {
+	owner, err := k.addressCodec.StringToBytes(job.Owner)
+	if err != nil {
+		return err
+	}
+
+	if refund := job.EscrowedFee(); !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, refund); err != nil {
+			return err
+		}
+	}
+
+	if err := k.removeJob(ctx, job); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("scheduled job dropped", "job", job.Id, "reason", reason)
+	return ctx.EventManager().EmitTypedEvent(&types.EventJobFailed{JobId: job.Id, Error: reason})
+}
+
+// setJob stores a job and enqueues it according to its trigger.
+func (k Keeper) setJob(ctx context.Context, job types.Job) error {
+	owner, err := k.addressCodec.StringToBytes(job.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Jobs.Set(ctx, job.Id, job); err != nil {
+		return err
+	}
+	if err := k.JobsByOwner.Set(ctx, collections.Join(sdk.AccAddress(owner), job.Id)); err != nil {
+		return err
+	}
+
+	return k.enqueueJob(ctx, job)
+}
+
+// enqueueJob adds a job to the queue matching its trigger.
+func (k Keeper) enqueueJob(ctx context.Context, job types.Job) error {
+	switch {
+	case job.Trigger.Height != 0:
+		return k.HeightQueue.Set(ctx, collections.Join(job.Trigger.Height, job.Id))
+	case job.Trigger.Time != nil:
+		return k.TimeQueue.Set(ctx, collections.Join(*job.Trigger.Time, job.Id))
+	default:
+		return k.EpochQueue.Set(ctx, collections.Join(job.Trigger.EpochIdentifier, job.Id))
+	}
+}
+
+// dequeueJob removes a job from the queue matching its trigger and from the
+// due jobs.
+func (k Keeper) dequeueJob(ctx context.Context, job types.Job) error {
+	switch {
+	case job.Trigger.Height != 0:
+		return k.HeightQueue.Remove(ctx, collections.Join(job.Trigger.Height, job.Id))
+	case job.Trigger.Time != nil:
+		return k.TimeQueue.Remove(ctx, collections.Join(*job.Trigger.Time, job.Id))
+	default:
+		if err := k.EpochQueue.Remove(ctx, collections.Join(job.Trigger.EpochIdentifier, job.Id)); err != nil {
+			return err
+		}
+		return k.DueJobs.Remove(ctx, job.Id)
+	}
+}
+
+// removeJob deletes a job and all its references.
+func (k Keeper) removeJob(ctx context.Context, job types.Job) error {
+	owner, err := k.addressCodec.StringToBytes(job.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.dequeueJob(ctx, job); err != nil {
+		return err
+	}
+	if err := k.JobsByOwner.Remove(ctx, collections.Join(sdk.AccAddress(owner), job.Id)); err != nil {
+		return err
+	}
+
+	return k.Jobs.Remove(ctx, job.Id)
+}
+
+// executeJob runs the messages of a job on a branch of the state, with a gas
+// meter limited to the gas limit of the job. The branch is written only if all
+// the messages succeed. The fee of the execution is paid to the fee collector
+// in any case, then the job is either rescheduled or removed. It returns the
+// gas used by the execution.
+func (k Keeper) executeJob(ctx sdk.Context, job types.Job) (uint64, error) {
+	if err := k.dequeueJob(ctx, job); err != nil {
+		return 0, err
+	}
+
+	gasMeter := storetypes.NewGasMeter(job.GasLimit)
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := k.runJobMsgs(cacheCtx.WithGasMeter(gasMeter), job)
+	gasUsed := gasMeter.GasConsumedToLimit()
+
+	if err == nil {
+		writeCache()
+		err = ctx.EventManager().EmitTypedEvent(&types.EventJobExecuted{JobId: job.Id, GasUsed: gasUsed})
+	} else {
+		k.Logger(ctx).Info("scheduled job failed", "job", job.Id, "err", err)
+		err = ctx.EventManager().EmitTypedEvent(&types.EventJobFailed{JobId: job.Id, GasUsed: gasUsed, Error: err.Error()})
+	}
+	if err != nil {
+		return gasUsed, err
+	}
+
+	if !job.Fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, job.Fee); err != nil {
+			return gasUsed, err
+		}
+	}
+
+	job.RemainingExecutions--
+	if job.RemainingExecutions == 0 {
+		if err := k.removeJob(ctx, job); err != nil {
+			return gasUsed, err
+		}
+		return gasUsed, ctx.EventManager().EmitTypedEvent(&types.EventJobCompleted{JobId: job.Id})
+	}
+
+	job.Trigger = job.Trigger.Next(job.BlockInterval, job.TimeInterval)
+	if err := k.Jobs.Set(ctx, job.Id, job); err != nil {
+		return gasUsed, err
+	}
+
+	return gasUsed, k.enqueueJob(ctx, job)
+}
+
+// runJobMsgs executes the messages of a job, recovering from panics, including
+// the out of gas ones.
+func (k Keeper) runJobMsgs(ctx sdk.Context, job types.Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v; gasLimit: %d", oog.Descriptor, job.GasLimit)
+				return
+			}
+			err = fmt.Errorf("executing job %d PANICKED: %v", job.Id, r)
+		}
+	}()
+
+	msgs, err := job.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "msg %d: unrecognized message route: %s", i, sdk.MsgTypeURL(msg))
+		}
+
+		if _, err := handler(ctx, msg); err != nil {
+			return errorsmod.Wrapf(err, "msg %d (%s)", i, sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return nil
+}
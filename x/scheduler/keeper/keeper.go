@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// Keeper of the scheduler store
+type Keeper struct {
+	cdc              codec.Codec
+	storeService     corestoretypes.KVStoreService
+	addressCodec     address.Codec
+	bankKeeper       types.BankKeeper
+	epochsKeeper     types.EpochsKeeper
+	router           baseapp.MessageRouter
+	feeCollectorName string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	JobSeq collections.Sequence
+	Jobs   collections.Map[uint64, types.Job]
+	// JobsByOwner indexes the jobs by owner.
+	JobsByOwner collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// HeightQueue holds the jobs triggered by height, by height.
+	HeightQueue collections.KeySet[collections.Pair[int64, uint64]]
+	// TimeQueue holds the jobs triggered by time, by time.
+	TimeQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+	// EpochQueue holds the jobs triggered by an epoch, by epoch identifier.
+	EpochQueue collections.KeySet[collections.Pair[string, uint64]]
+	// DueJobs holds the jobs triggered by an epoch which ended, waiting for
+	// their execution.
+	DueJobs collections.KeySet[uint64]
+}
+
+// NewKeeper creates a new scheduler Keeper instance. The epochs keeper is
+// optional, jobs cannot be triggered by an epoch without it.
+func NewKeeper(
+	cdc codec.Codec,
+	storeService corestoretypes.KVStoreService,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	ek types.EpochsKeeper,
+	router baseapp.MessageRouter,
+	feeCollectorName string,
+	authority string,
+) Keeper {
+	// ensure scheduler module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:              cdc,
+		storeService:     storeService,
+		addressCodec:     ak.AddressCodec(),
+		bankKeeper:       bk,
+		epochsKeeper:     ek,
+		router:           router,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		JobSeq:           collections.NewSequence(sb, types.JobSeqKey, "job_seq"),
+		Jobs:             collections.NewMap(sb, types.JobsKey, "jobs", collections.Uint64Key, codec.CollValue[types.Job](cdc)),
+		JobsByOwner:      collections.NewKeySet(sb, types.JobsByOwnerKey, "jobs_by_owner", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		HeightQueue:      collections.NewKeySet(sb, types.HeightQueueKey, "height_queue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		TimeQueue:        collections.NewKeySet(sb, types.TimeQueueKey, "time_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		EpochQueue:       collections.NewKeySet(sb, types.EpochQueueKey, "epoch_queue", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		DueJobs:          collections.NewKeySet(sb, types.DueJobsKey, "due_jobs", collections.Uint64Key),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/scheduler module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
@@ -0,0 +1,507 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/keeper"
+	schedulertestutil "github.com/cosmos/cosmos-sdk/x/scheduler/testutil"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+var (
+	owner = sdk.AccAddress("owner_______________")
+	other = sdk.AccAddress("other_______________")
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx          sdk.Context
+	keeper       keeper.Keeper
+	msgServer    types.MsgServer
+	queryServer  types.QueryServer
+	bankKeeper   *schedulertestutil.MockBankKeeper
+	epochsKeeper *schedulertestutil.MockEpochsKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(scheduler.AppModule{})
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeader(cmtproto.Header{Height: 10, Time: time.Unix(1_000_000, 0).UTC()})
+
+	msr := baseapp.NewMsgServiceRouter()
+	msr.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(msr, testdata.MsgServerImpl{})
+
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := schedulertestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName))
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	s.bankKeeper = schedulertestutil.NewMockBankKeeper(ctrl)
+	s.epochsKeeper = schedulertestutil.NewMockEpochsKeeper(ctrl)
+
+	s.keeper = keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		accountKeeper,
+		s.bankKeeper,
+		s.epochsKeeper,
+		msr,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, *types.DefaultGenesis()))
+}
+
+// newScheduleJob returns a valid MsgScheduleJob creating a dog on behalf of
+// the given signer.
+func (s *KeeperTestSuite) newScheduleJob(signer sdk.AccAddress, trigger types.Trigger) *types.MsgScheduleJob {
+	msgs, err := sdktx.SetMsgs([]sdk.Msg{&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}, Owner: signer.String()}})
+	s.Require().NoError(err)
+
+	return &types.MsgScheduleJob{
+		Owner:    owner.String(),
+		Msgs:     msgs,
+		Trigger:  trigger,
+		GasLimit: 100_000,
+		Fee:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+}
+
+func (s *KeeperTestSuite) TestScheduleJob() {
+	past := s.ctx.BlockTime().Add(-time.Second)
+	interval := time.Hour
+
+	s.epochsKeeper.EXPECT().GetEpochInfo(gomock.Any(), "day").Return(epochstypes.EpochInfo{Identifier: "day"}, nil).AnyTimes()
+	s.epochsKeeper.EXPECT().GetEpochInfo(gomock.Any(), "unknown").Return(epochstypes.EpochInfo{}, types.ErrUnknownEpoch).AnyTimes()
+
+	testCases := []struct {
+		name     string
+		malleate func(msg *types.MsgScheduleJob)
+		expErr   error
+	}{
+		{
+			name:     "valid height job",
+			malleate: func(*types.MsgScheduleJob) {},
+		},
+		{
+			name: "valid repeated time job",
+			malleate: func(msg *types.MsgScheduleJob) {
+				next := s.ctx.BlockTime().Add(time.Minute)
+				msg.Trigger = types.Trigger{Time: &next}
+				msg.Executions = 3
+				msg.TimeInterval = &interval
+			},
+		},
+		{
+			name: "valid epoch job",
+			malleate: func(msg *types.MsgScheduleJob) {
+				msg.Trigger = types.Trigger{EpochIdentifier: "day"}
+			},
+		},
+		{
+			name: "no trigger",
+			malleate: func(msg *types.MsgScheduleJob) {
+				msg.Trigger = types.Trigger{}
+			},
+			expErr: types.ErrInvalidTrigger,
+		},
+		{
+			name: "several triggers",
+			malleate: func(msg *types.MsgScheduleJob) {
+				msg.Trigger.EpochIdentifier = "day"
+			},
+			expErr: types.ErrInvalidTrigger,
+		},
+		{
+			name: "past height",
+			malleate: func(msg *types.MsgScheduleJob) {
+				msg.Trigger = types.Trigger{Height: s.ctx.BlockHeight()}
+			},
+			expErr: types.ErrInvalidTrigger,
+		},
+		{
+			name: "past time",
+			malleate: func(msg *types.MsgScheduleJob) {
+				msg.Trigger = types.Trigger{Time: &past}
+			},
+			expErr: types.ErrInvalidTrigger,
+		},
+		{
+			name: "unknown epoch",
+			malleate: func(msg *types.MsgScheduleJob) {
+				msg.Trigger = types.Trigger{EpochIdentifier: "unknown"}
+			},
+			expErr: types.ErrUnknownEpoch,
+		},
+		{
+			name: "repeated height job without interval",
+			malleate: func(msg *types.MsgScheduleJob) {
+				msg.Executions = 2
+			},
+			expErr: types.ErrInvalidJob,
+		},
+		{
+			name: "time interval on height job",
+			malleate: func(msg *types.MsgScheduleJob) {
+				msg.TimeInterval = &interval
+			},
+			expErr: types.ErrInvalidJob,
+		},
+		{
+			name: "no messages",
+			malleate: func(msg *types.MsgScheduleJob) {
+				msg.Msgs = nil
+			},
+			expErr: types.ErrInvalidJob,
+		},
+		{
+			name: "message of another signer",
+			malleate: func(msg *types.MsgScheduleJob) {
+				msg.Msgs = s.newScheduleJob(other, msg.Trigger).Msgs
+			},
+			expErr: types.ErrInvalidSigner,
+		},
+		{
+			name: "gas limit above max job gas",
+			malleate: func(msg *types.MsgScheduleJob) {
+				msg.GasLimit = types.DefaultParams().MaxJobGas + 1
+			},
+			expErr: types.ErrInvalidJob,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msg := s.newScheduleJob(owner, types.Trigger{Height: 20})
+			tc.malleate(msg)
+
+			if tc.expErr == nil {
+				escrow := msg.Fee.MulInt(math.NewIntFromUint64(max(msg.Executions, 1)))
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), owner, types.ModuleName, escrow).Return(nil)
+			}
+
+			res, err := s.msgServer.ScheduleJob(s.ctx, msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			job, err := s.keeper.Jobs.Get(s.ctx, res.JobId)
+			s.Require().NoError(err)
+			s.Require().Equal(msg.Trigger, job.Trigger)
+			s.Require().Equal(max(msg.Executions, 1), job.RemainingExecutions)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestScheduleJobMinGasPrices() {
+	// 100_000 gas at the default 0.001stake requires 100stake
+	msg := s.newScheduleJob(owner, types.Trigger{Height: 20})
+	msg.Fee = sdk.NewCoins(sdk.NewInt64Coin("stake", 99))
+	_, err := s.msgServer.ScheduleJob(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrInsufficientFee)
+
+	// scheduling is free without min gas prices
+	params := types.DefaultParams()
+	params.MinGasPrices = sdk.DecCoins{}
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), owner, types.ModuleName, msg.Fee).Return(nil)
+	_, err = s.msgServer.ScheduleJob(s.ctx, msg)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestCancelJob() {
+	msg := s.newScheduleJob(owner, types.Trigger{Height: 20})
+	msg.Executions = 3
+	msg.BlockInterval = 5
+	escrow := sdk.NewCoins(sdk.NewInt64Coin("stake", 300))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), owner, types.ModuleName, escrow).Return(nil)
+	res, err := s.msgServer.ScheduleJob(s.ctx, msg)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.CancelJob(s.ctx, &types.MsgCancelJob{Owner: other.String(), JobId: res.JobId})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.CancelJob(s.ctx, &types.MsgCancelJob{Owner: owner.String(), JobId: res.JobId + 1})
+	s.Require().ErrorIs(err, types.ErrJobNotFound)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, owner, escrow).Return(nil)
+	cancelRes, err := s.msgServer.CancelJob(s.ctx, &types.MsgCancelJob{Owner: owner.String(), JobId: res.JobId})
+	s.Require().NoError(err)
+	s.Require().Equal(escrow, cancelRes.Refund)
+
+	has, err := s.keeper.Jobs.Has(s.ctx, res.JobId)
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// the cancelled job is never executed
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(20)))
+}
+
+func (s *KeeperTestSuite) TestEndBlockerRepeatedJob() {
+	msg := s.newScheduleJob(owner, types.Trigger{Height: 20})
+	msg.Executions = 2
+	msg.BlockInterval = 5
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), owner, types.ModuleName, gomock.Any()).Return(nil)
+	res, err := s.msgServer.ScheduleJob(s.ctx, msg)
+	s.Require().NoError(err)
+
+	// not due yet
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(19)))
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, msg.Fee).Return(nil).Times(2)
+
+	ctx := s.ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.EndBlocker(ctx))
+	s.Require().True(hasEvent(ctx, "cosmos.scheduler.v1.EventJobExecuted"))
+
+	job, err := s.keeper.Jobs.Get(ctx, res.JobId)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(25), job.Trigger.Height)
+	s.Require().Equal(uint64(1), job.RemainingExecutions)
+
+	ctx = s.ctx.WithBlockHeight(25).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.EndBlocker(ctx))
+	s.Require().True(hasEvent(ctx, "cosmos.scheduler.v1.EventJobCompleted"))
+
+	has, err := s.keeper.Jobs.Has(ctx, res.JobId)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestEndBlockerFailedJob() {
+	// the dog is missing, which makes the handler panic
+	msgs, err := sdktx.SetMsgs([]sdk.Msg{&testdata.MsgCreateDog{Owner: owner.String()}})
+	s.Require().NoError(err)
+	msg := s.newScheduleJob(owner, types.Trigger{Height: 20})
+	msg.Msgs = msgs
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), owner, types.ModuleName, msg.Fee).Return(nil)
+	res, err := s.msgServer.ScheduleJob(s.ctx, msg)
+	s.Require().NoError(err)
+
+	// the fee is charged even though the job failed
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, msg.Fee).Return(nil)
+
+	ctx := s.ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.EndBlocker(ctx))
+	s.Require().True(hasEvent(ctx, "cosmos.scheduler.v1.EventJobFailed"))
+	s.Require().False(hasEvent(ctx, "cosmos.scheduler.v1.EventJobExecuted"))
+
+	has, err := s.keeper.Jobs.Has(ctx, res.JobId)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestEndBlockerGasBudget() {
+	params := types.DefaultParams()
+	params.MaxBlockGas = 150_000
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), owner, types.ModuleName, gomock.Any()).Return(nil).Times(2)
+	first, err := s.msgServer.ScheduleJob(s.ctx, s.newScheduleJob(owner, types.Trigger{Height: 20}))
+	s.Require().NoError(err)
+	second, err := s.msgServer.ScheduleJob(s.ctx, s.newScheduleJob(owner, types.Trigger{Height: 20}))
+	s.Require().NoError(err)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil).Times(2)
+
+	// only the first job fits in the block gas budget
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(20)))
+	has, err := s.keeper.Jobs.Has(s.ctx, first.JobId)
+	s.Require().NoError(err)
+	s.Require().False(has)
+	has, err = s.keeper.Jobs.Has(s.ctx, second.JobId)
+	s.Require().NoError(err)
+	s.Require().True(has)
+
+	// the second one is executed in the next block
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(21)))
+	has, err = s.keeper.Jobs.Has(s.ctx, second.JobId)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestEndBlockerOversizedJob() {
+	schedule := func(gasLimit uint64) uint64 {
+		msg := s.newScheduleJob(owner, types.Trigger{Height: 20})
+		msg.GasLimit = gasLimit
+		msg.Fee = sdk.NewCoins(sdk.NewInt64Coin("stake", int64(gasLimit/1000)))
+		res, err := s.msgServer.ScheduleJob(s.ctx, msg)
+		s.Require().NoError(err)
+		return res.JobId
+	}
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), owner, types.ModuleName, gomock.Any()).Return(nil).Times(4)
+	oversized := schedule(200_000)
+	first := schedule(100_000)
+	skipped := schedule(100_000)
+	last := schedule(50_000)
+
+	// the max block gas is lowered below the gas limit of the oversized job
+	params := types.DefaultParams()
+	params.MaxBlockGas = 150_000
+	params.MaxJobGas = 150_000
+	s.Require().NoError(s.keeper.Params.Set(s.ctx, params))
+
+	// the oversized job is dropped and refunded, and the skipped job does not
+	// block the last one
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, owner, sdk.NewCoins(sdk.NewInt64Coin("stake", 200))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil).Times(2)
+
+	ctx := s.ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.keeper.EndBlocker(ctx))
+	s.Require().True(hasEvent(ctx, "cosmos.scheduler.v1.EventJobFailed"))
+
+	for id, exists := range map[uint64]bool{oversized: false, first: false, skipped: true, last: false} {
+		has, err := s.keeper.Jobs.Has(s.ctx, id)
+		s.Require().NoError(err)
+		s.Require().Equal(exists, has)
+	}
+
+	// the skipped job is executed in the next block
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, gomock.Any()).Return(nil)
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx.WithBlockHeight(21)))
+	has, err := s.keeper.Jobs.Has(s.ctx, skipped)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestEpochJob() {
+	s.epochsKeeper.EXPECT().GetEpochInfo(gomock.Any(), "day").Return(epochstypes.EpochInfo{Identifier: "day"}, nil)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), owner, types.ModuleName, gomock.Any()).Return(nil)
+	msg := s.newScheduleJob(owner, types.Trigger{EpochIdentifier: "day"})
+	msg.Executions = 2
+	res, err := s.msgServer.ScheduleJob(s.ctx, msg)
+	s.Require().NoError(err)
+
+	// the end of another epoch does not trigger the job
+	s.Require().NoError(s.keeper.Hooks().AfterEpochEnd(s.ctx, "week", 1))
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+	job, err := s.keeper.Jobs.Get(s.ctx, res.JobId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), job.RemainingExecutions)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, msg.Fee).Return(nil)
+	s.Require().NoError(s.keeper.Hooks().AfterEpochEnd(s.ctx, "day", 1))
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+
+	job, err = s.keeper.Jobs.Get(s.ctx, res.JobId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), job.RemainingExecutions)
+
+	// the job waits for the next end of the epoch
+	s.Require().NoError(s.keeper.EndBlocker(s.ctx))
+	job, err = s.keeper.Jobs.Get(s.ctx, res.JobId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), job.RemainingExecutions)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	s.epochsKeeper.EXPECT().GetEpochInfo(gomock.Any(), "day").Return(epochstypes.EpochInfo{Identifier: "day"}, nil)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), owner, types.ModuleName, gomock.Any()).Return(nil).Times(2)
+	_, err := s.msgServer.ScheduleJob(s.ctx, s.newScheduleJob(owner, types.Trigger{Height: 20}))
+	s.Require().NoError(err)
+	_, err = s.msgServer.ScheduleJob(s.ctx, s.newScheduleJob(owner, types.Trigger{EpochIdentifier: "day"}))
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.Hooks().AfterEpochEnd(s.ctx, "day", 1))
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+	s.Require().Len(gs.Jobs, 2)
+	s.Require().Equal(uint64(3), gs.NextJobId)
+	s.Require().Equal([]uint64{2}, gs.DueJobIds)
+
+	s.SetupTest()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, *gs))
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+
+	has, err := s.keeper.HeightQueue.Has(s.ctx, collections.Join(int64(20), uint64(1)))
+	s.Require().NoError(err)
+	s.Require().True(has)
+}
+
+func (s *KeeperTestSuite) TestQueries() {
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), owner, types.ModuleName, gomock.Any()).Return(nil)
+	res, err := s.msgServer.ScheduleJob(s.ctx, s.newScheduleJob(owner, types.Trigger{Height: 20}))
+	s.Require().NoError(err)
+
+	jobRes, err := s.queryServer.Job(s.ctx, &types.QueryJobRequest{JobId: res.JobId})
+	s.Require().NoError(err)
+	s.Require().Equal(owner.String(), jobRes.Job.Owner)
+
+	_, err = s.queryServer.Job(s.ctx, &types.QueryJobRequest{JobId: res.JobId + 1})
+	s.Require().Error(err)
+
+	jobsRes, err := s.queryServer.Jobs(s.ctx, &types.QueryJobsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(jobsRes.Jobs, 1)
+
+	byOwnerRes, err := s.queryServer.JobsByOwner(s.ctx, &types.QueryJobsByOwnerRequest{Owner: owner.String()})
+	s.Require().NoError(err)
+	s.Require().Len(byOwnerRes.Jobs, 1)
+
+	byOwnerRes, err = s.queryServer.JobsByOwner(s.ctx, &types.QueryJobsByOwnerRequest{Owner: other.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(byOwnerRes.Jobs)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.DefaultParams()
+	params.MaxBlockGas = 1
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.keeper.GetAuthority(), Params: params})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: other.String(), Params: types.DefaultParams()})
+	s.Require().Error(err)
+
+	params.MaxBlockGas = 20_000_000
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.keeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+}
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/scheduler MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// ScheduleJob schedules a job.
+func (ms msgServer) ScheduleJob(ctx context.Context, msg *types.MsgScheduleJob) (*types.MsgScheduleJobResponse, error) {
+	id, err := ms.Keeper.ScheduleJob(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleJobResponse{JobId: id}, nil
+}
+
+// CancelJob cancels a job.
+func (ms msgServer) CancelJob(ctx context.Context, msg *types.MsgCancelJob) (*types.MsgCancelJobResponse, error) {
+	refund, err := ms.Keeper.CancelJob(ctx, msg.Owner, msg.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelJobResponse{Refund: refund}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, ms.authority, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(goCtx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,31 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+// GenMaxBlockGas randomized MaxBlockGas
+func GenMaxBlockGas(r *rand.Rand) uint64 {
+	return uint64(r.Intn(9_000_000) + 1_000_000)
+}
+
+// GenMaxJobGas randomized MaxJobGas, lower than the max block gas
+func GenMaxJobGas(r *rand.Rand, maxBlockGas uint64) uint64 {
+	return uint64(r.Int63n(int64(maxBlockGas))) + 1
+}
+
+// RandomizedGenState generates a random GenesisState for scheduler
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+	params.MaxBlockGas = GenMaxBlockGas(simState.Rand)
+	params.MaxJobGas = GenMaxJobGas(simState.Rand, params.MaxBlockGas)
+	params.MaxMsgsPerJob = uint32(simState.Rand.Intn(20) + 1)
+
+	schedulerGenesis := types.DefaultGenesis()
+	schedulerGenesis.Params = params
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(schedulerGenesis)
+}
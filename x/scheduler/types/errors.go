@@ -0,0 +1,13 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/scheduler module sentinel errors
+var (
+	ErrJobNotFound     = errors.Register(ModuleName, 2, "job not found")
+	ErrInvalidTrigger  = errors.Register(ModuleName, 3, "invalid trigger")
+	ErrInvalidJob      = errors.Register(ModuleName, 4, "invalid job")
+	ErrInvalidSigner   = errors.Register(ModuleName, 5, "msg signer is not the job owner")
+	ErrUnknownEpoch    = errors.Register(ModuleName, 6, "unknown epoch identifier")
+	ErrInsufficientFee = errors.Register(ModuleName, 7, "insufficient fee")
+)
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// DefaultGenesis returns the default genesis state of the x/scheduler module.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:    DefaultParams(),
+		Jobs:      []Job{},
+		NextJobId: 1,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	jobs := make(map[uint64]Job, len(gs.Jobs))
+	for _, job := range gs.Jobs {
+		if _, ok := jobs[job.Id]; ok {
+			return fmt.Errorf("duplicate job %d", job.Id)
+		}
+		if job.Id >= gs.NextJobId {
+			return fmt.Errorf("job %d is not lower than the next job id %d", job.Id, gs.NextJobId)
+		}
+		if err := job.Validate(); err != nil {
+			return err
+		}
+		jobs[job.Id] = job
+	}
+
+	for _, id := range gs.DueJobIds {
+		job, ok := jobs[id]
+		if !ok {
+			return fmt.Errorf("due job %d not found", id)
+		}
+		if job.Trigger.EpochIdentifier == "" {
+			return fmt.Errorf("due job %d is not triggered by an epoch", id)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, job := range gs.Jobs {
+		if err := job.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/scheduler/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	job := types.Job{
+		Id:                  1,
+		Owner:               sdk.AccAddress("owner").String(),
+		Msgs:                []*codectypes.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"}},
+		Trigger:             types.Trigger{EpochIdentifier: "day"},
+		RemainingExecutions: 1,
+		GasLimit:            100_000,
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(gs *types.GenesisState)
+		expErr   string
+	}{
+		{
+			name:     "valid",
+			malleate: func(*types.GenesisState) {},
+		},
+		{
+			name: "invalid params",
+			malleate: func(gs *types.GenesisState) {
+				gs.Params.MaxJobGas = gs.Params.MaxBlockGas + 1
+			},
+			expErr: "max job gas",
+		},
+		{
+			name: "duplicate job",
+			malleate: func(gs *types.GenesisState) {
+				gs.Jobs = append(gs.Jobs, job)
+			},
+			expErr: "duplicate job 1",
+		},
+		{
+			name: "job id not lower than next job id",
+			malleate: func(gs *types.GenesisState) {
+				gs.NextJobId = 1
+			},
+			expErr: "next job id",
+		},
+		{
+			name: "job without trigger",
+			malleate: func(gs *types.GenesisState) {
+				gs.Jobs[0].Trigger = types.Trigger{}
+			},
+			expErr: "invalid trigger",
+		},
+		{
+			name: "unknown due job",
+			malleate: func(gs *types.GenesisState) {
+				gs.DueJobIds = []uint64{2}
+			},
+			expErr: "due job 2 not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesis()
+			gs.Jobs = []types.Job{job}
+			gs.NextJobId = 2
+			gs.DueJobIds = []uint64{1}
+			tc.malleate(gs)
+
+			err := gs.Validate()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
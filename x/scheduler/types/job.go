@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = Job{}
+	_ codectypes.UnpackInterfacesMessage = MsgScheduleJob{}
+)
+
+// Validate checks that exactly one of the fields of the trigger is set.
+func (t Trigger) Validate() error {
+	set := 0
+	if t.Height != 0 {
+		if t.Height < 0 {
+			return errorsmod.Wrapf(ErrInvalidTrigger, "negative height %d", t.Height)
+		}
+		set++
+	}
+	if t.Time != nil {
+		set++
+	}
+	if t.EpochIdentifier != "" {
+		set++
+	}
+	if set != 1 {
+		return errorsmod.Wrap(ErrInvalidTrigger, "exactly one of height, time or epoch identifier must be set")
+	}
+
+	return nil
+}
+
+// Next returns the trigger of the execution following the one of t, for a job
+// repeated with the given intervals.
+func (t Trigger) Next(blockInterval int64, timeInterval *time.Duration) Trigger {
+	switch {
+	case t.Height != 0:
+		return Trigger{Height: t.Height + blockInterval}
+	case t.Time != nil:
+		next := t.Time.Add(*timeInterval)
+		return Trigger{Time: &next}
+	default:
+		return t
+	}
+}
+
+// ValidateRepetition checks the intervals of a job executed the given number
+// of times against its trigger.
+func (t Trigger) ValidateRepetition(executions uint64, blockInterval int64, timeInterval *time.Duration) error {
+	if blockInterval != 0 && (t.Height == 0 || blockInterval < 0) {
+		return errorsmod.Wrap(ErrInvalidJob, "block interval must be positive and is only allowed for jobs triggered by height")
+	}
+	if timeInterval != nil && (t.Time == nil || *timeInterval <= 0) {
+		return errorsmod.Wrap(ErrInvalidJob, "time interval must be positive and is only allowed for jobs triggered by time")
+	}
+	if executions <= 1 {
+		return nil
+	}
+	if t.Height != 0 && blockInterval == 0 {
+		return errorsmod.Wrap(ErrInvalidJob, "repeated jobs triggered by height require a block interval")
+	}
+	if t.Time != nil && timeInterval == nil {
+		return errorsmod.Wrap(ErrInvalidJob, "repeated jobs triggered by time require a time interval")
+	}
+
+	return nil
+}
+
+// GetMessages unpacks the messages of the job.
+func (j Job) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(j.Msgs, "job")
+}
+
+// EscrowedFee returns the fees of the remaining executions of the job.
+func (j Job) EscrowedFee() sdk.Coins {
+	return j.Fee.MulInt(sdkmath.NewIntFromUint64(j.RemainingExecutions))
+}
+
+// Validate performs a stateless validation of the job.
+func (j Job) Validate() error {
+	if len(j.Msgs) == 0 {
+		return errorsmod.Wrapf(ErrInvalidJob, "job %d has no messages", j.Id)
+	}
+	if err := j.Trigger.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "job %d", j.Id)
+	}
+	if j.RemainingExecutions == 0 {
+		return errorsmod.Wrapf(ErrInvalidJob, "job %d has no remaining executions", j.Id)
+	}
+	if err := j.Trigger.ValidateRepetition(j.RemainingExecutions, j.BlockInterval, j.TimeInterval); err != nil {
+		return errorsmod.Wrapf(err, "job %d", j.Id)
+	}
+	if j.GasLimit == 0 {
+		return errorsmod.Wrapf(ErrInvalidJob, "job %d has no gas limit", j.Id)
+	}
+	if err := j.Fee.Validate(); err != nil {
+		return fmt.Errorf("job %d has an invalid fee: %w", j.Id, err)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (j Job) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, j.Msgs)
+}
+
+// GetMessages unpacks the messages to schedule.
+func (msg MsgScheduleJob) GetMessages() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(msg.Msgs, "job")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgScheduleJob) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, msg.Msgs)
+}
@@ -0,0 +1,22 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "scheduler"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey      = collections.NewPrefix(0)
+	JobSeqKey      = collections.NewPrefix(1)
+	JobsKey        = collections.NewPrefix(2)
+	JobsByOwnerKey = collections.NewPrefix(3)
+	HeightQueueKey = collections.NewPrefix(4)
+	TimeQueueKey   = collections.NewPrefix(5)
+	EpochQueueKey  = collections.NewPrefix(6)
+	DueJobsKey     = collections.NewPrefix(7)
+)
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns default x/scheduler module parameters.
+func DefaultParams() Params {
+	return Params{
+		MaxBlockGas:   10_000_000,
+		MaxJobGas:     1_000_000,
+		MaxMsgsPerJob: 10,
+		MinGasPrices:  sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdkmath.LegacyNewDecWithPrec(1, 3))),
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if p.MaxBlockGas == 0 {
+		return errors.New("max block gas must be positive")
+	}
+	if p.MaxJobGas == 0 {
+		return errors.New("max job gas must be positive")
+	}
+	if p.MaxJobGas > p.MaxBlockGas {
+		return fmt.Errorf("max job gas %d must not be greater than max block gas %d", p.MaxJobGas, p.MaxBlockGas)
+	}
+	if p.MaxMsgsPerJob == 0 {
+		return errors.New("max msgs per job must be positive")
+	}
+	if err := p.MinGasPrices.Validate(); err != nil {
+		return fmt.Errorf("invalid min gas prices: %w", err)
+	}
+
+	return nil
+}
+
+// RequiredFee returns the minimum fee of an execution with the given gas limit,
+// where fee = ceil(minGasPrice * gasLimit) for each of the min gas prices.
+func (p Params) RequiredFee(gasLimit uint64) sdk.Coins {
+	glDec := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(gasLimit))
+	requiredFee := make(sdk.Coins, len(p.MinGasPrices))
+	for i, gp := range p.MinGasPrices {
+		requiredFee[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+	}
+
+	return requiredFee
+}
@@ -26,5 +26,10 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 			return err
 		}
 	}
-	return nil
+
+	if err := k.PruneMaintenanceWindows(ctx); err != nil {
+		return err
+	}
+
+	return k.ProcessAutoUnjails(ctx)
 }
@@ -34,6 +34,28 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "AutoUnjail",
+					Use:       "auto-unjail [validator-address]",
+					Short:     "Query a validator's automatic unjailing status",
+					Example:   fmt.Sprintf("%s query slashing auto-unjail [validator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "MaintenanceWindow",
+					Use:       "maintenance-window [validator-address]",
+					Short:     "Query a validator's maintenance window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "MaintenanceWindows",
+					Use:       "maintenance-windows",
+					Short:     "Query maintenance windows of all validators",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -54,6 +76,28 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod: "SetAutoUnjail",
+					Use:       "set-auto-unjail [validator-address] [enabled]",
+					Short:     "Opt a validator in or out of automatic unjailing after downtime",
+					Example:   fmt.Sprintf("%s tx slashing set-auto-unjail [validator-address] true --from [validator]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "enabled"},
+					},
+				},
+				{
+					RpcMethod: "SetMaintenanceWindow",
+					Use:       "set-maintenance-window-proposal [validator-address] [start-height] [end-height]",
+					Short:     "Submit a proposal to pause the downtime accounting of a validator between two heights",
+					Example:   fmt.Sprintf("%s tx slashing set-maintenance-window-proposal [validator-address] 1000 2000", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "start_height"},
+						{ProtoField: "end_height"},
+					},
+					GovProposal: true,
+				},
 			},
 		},
 	}
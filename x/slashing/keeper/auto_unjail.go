@@ -0,0 +1,373 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// Validators may opt in for automatic unjailing after being jailed for
+// downtime. Once the downtime jail duration has elapsed, the validator is
+// unjailed in the BeginBlocker and put on probation: it must sign a block
+// within AutoUnjailProbationBlocks blocks, at which point the AutoUnjailFee is
+// charged to its operator, or it is jailed again and must be unjailed manually.
+
+// IsAutoUnjailEnabled returns true if the validator opted in for automatic
+// unjailing.
+func (k Keeper) IsAutoUnjailEnabled(ctx context.Context, valAddr sdk.ValAddress) (bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Has(types.AutoUnjailKey(valAddr))
+}
+
+// SetAutoUnjail opts the validator in or out of automatic unjailing.
+func (k Keeper) SetAutoUnjail(ctx context.Context, valAddr sdk.ValAddress, enabled bool) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if enabled {
+		return store.Set(types.AutoUnjailKey(valAddr), []byte{})
+	}
+
+	return store.Delete(types.AutoUnjailKey(valAddr))
+}
+
+// IterateAutoUnjailValidators iterates over the validators which opted in for
+// automatic unjailing.
+func (k Keeper) IterateAutoUnjailValidators(ctx context.Context, handler func(valAddr sdk.ValAddress) (stop bool)) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.AutoUnjailKeyPrefix, storetypes.PrefixEndBytes(types.AutoUnjailKeyPrefix))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.AutoUnjailAddress(iter.Key())) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetPendingAutoUnjail returns the pending automatic unjailing of a validator.
+// If not found it returns ErrNoPendingAutoUnjailFound.
+func (k Keeper) GetPendingAutoUnjail(ctx context.Context, consAddr sdk.ConsAddress) (types.PendingAutoUnjail, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	var pending types.PendingAutoUnjail
+	bz, err := store.Get(types.PendingAutoUnjailKey(consAddr))
+	if err != nil {
+		return pending, err
+	}
+
+	if bz == nil {
+		return pending, types.ErrNoPendingAutoUnjailFound
+	}
+
+	err = k.cdc.Unmarshal(bz, &pending)
+	return pending, err
+}
+
+// SetPendingAutoUnjail sets the pending automatic unjailing of a validator.
+func (k Keeper) SetPendingAutoUnjail(ctx context.Context, consAddr sdk.ConsAddress, pending types.PendingAutoUnjail) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&pending)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.PendingAutoUnjailKey(consAddr), bz)
+}
+
+// DeletePendingAutoUnjail deletes the pending automatic unjailing of a
+// validator.
+func (k Keeper) DeletePendingAutoUnjail(ctx context.Context, consAddr sdk.ConsAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.PendingAutoUnjailKey(consAddr))
+}
+
+// MovePendingAutoUnjail moves the pending automatic unjailing of a validator
+// from one consensus address to another.
+func (k Keeper) MovePendingAutoUnjail(ctx context.Context, oldAddr, newAddr sdk.ConsAddress) error {
+	pending, err := k.GetPendingAutoUnjail(ctx, oldAddr)
+	if err != nil {
+		if errors.IsOf(err, types.ErrNoPendingAutoUnjailFound) {
+			return nil
+		}
+		return err
+	}
+
+	pending.Address = newAddr.String()
+	if err := k.SetPendingAutoUnjail(ctx, newAddr, pending); err != nil {
+		return err
+	}
+	return k.DeletePendingAutoUnjail(ctx, oldAddr)
+}
+
+// IteratePendingAutoUnjails iterates over the pending automatic unjailings.
+func (k Keeper) IteratePendingAutoUnjails(ctx context.Context,
+	handler func(consAddr sdk.ConsAddress, pending types.PendingAutoUnjail) (stop bool),
+) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.PendingAutoUnjailKeyPrefix, storetypes.PrefixEndBytes(types.PendingAutoUnjailKeyPrefix))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var pending types.PendingAutoUnjail
+		if err := k.cdc.Unmarshal(iter.Value(), &pending); err != nil {
+			return err
+		}
+
+		if handler(types.PendingAutoUnjailAddress(iter.Key()), pending) {
+			break
+		}
+	}
+	return nil
+}
+
+// ProcessAutoUnjails unjails the validators whose downtime jail duration has
+// elapsed, and jails again the validators which did not sign a block within
+// their probation. It must be called once per block, after the signatures of
+// the validators are handled. If automatic unjailing is disabled, the pending
+// automatic unjailings are cancelled.
+func (k Keeper) ProcessAutoUnjails(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		consAddrs []sdk.ConsAddress
+		pendings  []types.PendingAutoUnjail
+	)
+	err = k.IteratePendingAutoUnjails(ctx, func(consAddr sdk.ConsAddress, pending types.PendingAutoUnjail) bool {
+		consAddrs = append(consAddrs, consAddr)
+		pendings = append(pendings, pending)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	if params.AutoUnjailProbationBlocks == 0 {
+		return k.cancelAutoUnjails(ctx, consAddrs)
+	}
+
+	for i, consAddr := range consAddrs {
+		pending := pendings[i]
+		if pending.OnProbation() {
+			if height-pending.UnjailHeight > params.AutoUnjailProbationBlocks {
+				if err := k.revokeAutoUnjail(ctx, consAddr, "probation expired"); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		signInfo, err := k.GetValidatorSigningInfo(ctx, consAddr)
+		if err != nil && !errors.IsOf(err, types.ErrNoSigningInfoFound) {
+			return err
+		}
+		if sdkCtx.BlockHeader().Time.Before(signInfo.JailedUntil) {
+			continue
+		}
+
+		if err := k.autoUnjail(ctx, consAddr); err != nil {
+			k.Logger(ctx).Info("failed to automatically unjail validator", "validator", consAddr.String(), "err", err)
+			if err := k.DeletePendingAutoUnjail(ctx, consAddr); err != nil {
+				return err
+			}
+			emitAutoUnjailEvent(sdkCtx, consAddr, types.AttributeValueRevoked, sdk.NewAttribute(types.AttributeKeyReason, err.Error()))
+			continue
+		}
+
+		pending.UnjailHeight = height
+		if err := k.SetPendingAutoUnjail(ctx, consAddr, pending); err != nil {
+			return err
+		}
+		emitAutoUnjailEvent(sdkCtx, consAddr, types.AttributeValueUnjailed)
+	}
+
+	return nil
+}
+
+// cancelAutoUnjails deletes the pending automatic unjailings of validators
+// once automatic unjailing is disabled. Validators still jailed must be
+// unjailed manually, and validators on probation end it without being charged
+// the auto unjail fee.
+func (k Keeper) cancelAutoUnjails(ctx context.Context, consAddrs []sdk.ConsAddress) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, consAddr := range consAddrs {
+		if err := k.DeletePendingAutoUnjail(ctx, consAddr); err != nil {
+			return err
+		}
+		emitAutoUnjailEvent(sdkCtx, consAddr, types.AttributeValueRevoked, sdk.NewAttribute(types.AttributeKeyReason, "automatic unjailing disabled"))
+	}
+
+	return nil
+}
+
+// autoUnjail unjails a validator, leaving the state unchanged on failure.
+func (k Keeper) autoUnjail(ctx context.Context, consAddr sdk.ConsAddress) error {
+	validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+	if validator == nil {
+		return types.ErrNoValidatorForAddress
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if err := k.Unjail(cacheCtx, valAddr); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+// scheduleAutoUnjail schedules the automatic unjailing of a validator jailed
+// for downtime, if it opted in. A validator jailed for downtime while on
+// probation is not unjailed automatically again.
+func (k Keeper) scheduleAutoUnjail(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoUnjailProbationBlocks == 0 {
+		return nil
+	}
+
+	enabled, err := k.IsAutoUnjailEnabled(ctx, valAddr)
+	if err != nil || !enabled {
+		return err
+	}
+
+	pending, err := k.GetPendingAutoUnjail(ctx, consAddr)
+	switch {
+	case err == nil && pending.OnProbation():
+		if err := k.DeletePendingAutoUnjail(ctx, consAddr); err != nil {
+			return err
+		}
+		emitAutoUnjailEvent(sdkCtx, consAddr, types.AttributeValueRevoked, sdk.NewAttribute(types.AttributeKeyReason, "jailed for downtime on probation"))
+		return nil
+
+	case err != nil && !errors.IsOf(err, types.ErrNoPendingAutoUnjailFound):
+		return err
+	}
+
+	if err := k.SetPendingAutoUnjail(ctx, consAddr, types.NewPendingAutoUnjail(consAddr, 0)); err != nil {
+		return err
+	}
+
+	emitAutoUnjailEvent(sdkCtx, consAddr, types.AttributeValueScheduled)
+	return nil
+}
+
+// confirmAutoUnjail ends the probation of a validator which signed a block,
+// charging the auto unjail fee to its operator. The validator is jailed again
+// if the fee cannot be paid, in which case revoked is true. Nothing is charged
+// if automatic unjailing is disabled, as ProcessAutoUnjails then cancels the
+// probation.
+func (k Keeper) confirmAutoUnjail(ctx context.Context, consAddr sdk.ConsAddress) (revoked bool, err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pending, err := k.GetPendingAutoUnjail(ctx, consAddr)
+	if err != nil {
+		if errors.IsOf(err, types.ErrNoPendingAutoUnjailFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if !pending.OnProbation() {
+		return false, nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if params.AutoUnjailProbationBlocks == 0 {
+		return false, nil
+	}
+
+	if !params.AutoUnjailFee.IsZero() {
+		validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
+		if err != nil {
+			return false, err
+		}
+		if validator == nil {
+			return false, types.ErrNoValidatorForAddress
+		}
+
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+		if err != nil {
+			return false, err
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.bk.SendCoinsFromAccountToModule(cacheCtx, sdk.AccAddress(valAddr), authtypes.FeeCollectorName, params.AutoUnjailFee); err != nil {
+			return true, k.revokeAutoUnjail(ctx, consAddr, fmt.Sprintf("failed to pay auto unjail fee: %s", err))
+		}
+		write()
+	}
+
+	if err := k.DeletePendingAutoUnjail(ctx, consAddr); err != nil {
+		return false, err
+	}
+
+	emitAutoUnjailEvent(sdkCtx, consAddr, types.AttributeValueConfirmed, sdk.NewAttribute(types.AttributeKeyFee, params.AutoUnjailFee.String()))
+	return false, nil
+}
+
+// revokeAutoUnjail jails again, without slashing, a validator on probation. The
+// validator must then be unjailed manually.
+func (k Keeper) revokeAutoUnjail(ctx context.Context, consAddr sdk.ConsAddress, reason string) error {
+	isJailed, err := k.sk.IsValidatorJailed(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
+	if !isJailed {
+		if err := k.Jail(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+
+	if err := k.DeletePendingAutoUnjail(ctx, consAddr); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("revoked automatic unjailing of validator", "validator", consAddr.String(), "reason", reason)
+	emitAutoUnjailEvent(sdk.UnwrapSDKContext(ctx), consAddr, types.AttributeValueRevoked, sdk.NewAttribute(types.AttributeKeyReason, reason))
+	return nil
+}
+
+func emitAutoUnjailEvent(ctx sdk.Context, consAddr sdk.ConsAddress, status string, attrs ...sdk.Attribute) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoUnjail,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyStatus, status),
+			}, attrs...)...,
+		),
+	)
+}
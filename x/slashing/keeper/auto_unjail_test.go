@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/core/comet"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/testutil"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupAutoUnjail returns a validator which opted in for automatic unjailing,
+// with a small signed blocks window and an auto unjail fee.
+func (s *KeeperTestSuite) setupAutoUnjail() (stakingtypes.Validator, sdk.ValAddress, sdk.ConsAddress) {
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	consAddr := sdk.ConsAddress(pubKey.Address())
+
+	val, err := stakingtypes.NewValidator(valAddr.String(), pubKey, stakingtypes.Description{Moniker: "test"})
+	s.Require().NoError(err)
+	val.Tokens = sdkmath.NewInt(1000)
+	val.DelegatorShares = sdkmath.LegacyNewDec(1)
+
+	params := testutil.TestParams()
+	params.SignedBlocksWindow = 10
+	params.AutoUnjailFee = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	params.AutoUnjailProbationBlocks = 5
+	s.Require().NoError(s.slashingKeeper.SetParams(s.ctx, params))
+	s.Require().NoError(s.slashingKeeper.SetAutoUnjail(s.ctx, valAddr, true))
+
+	return val, valAddr, consAddr
+}
+
+// jailForDowntime makes the validator miss a block past its downtime
+// threshold.
+func (s *KeeperTestSuite) jailForDowntime(ctx sdk.Context, val stakingtypes.Validator, consAddr sdk.ConsAddress) {
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 10)
+	s.Require().NoError(s.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info))
+
+	s.stakingKeeper.EXPECT().IsValidatorJailed(gomock.Any(), consAddr).Return(false, nil)
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil)
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, gomock.Any(), int64(100), gomock.Any(), stakingtypes.Infraction_INFRACTION_DOWNTIME).Return(sdkmath.ZeroInt(), nil)
+	s.stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return(nil)
+	s.Require().NoError(s.slashingKeeper.HandleValidatorSignature(ctx, consAddr.Bytes(), 100, comet.BlockIDFlagAbsent))
+}
+
+func (s *KeeperTestSuite) TestAutoUnjail() {
+	require := s.Require()
+	val, valAddr, consAddr := s.setupAutoUnjail()
+	ctx := s.ctx.WithBlockHeight(20)
+
+	s.jailForDowntime(ctx, val, consAddr)
+
+	pending, err := s.slashingKeeper.GetPendingAutoUnjail(ctx, consAddr)
+	require.NoError(err)
+	require.False(pending.OnProbation())
+
+	// the validator is not unjailed before the end of its jail duration
+	require.NoError(s.slashingKeeper.ProcessAutoUnjails(ctx))
+	pending, err = s.slashingKeeper.GetPendingAutoUnjail(ctx, consAddr)
+	require.NoError(err)
+	require.False(pending.OnProbation())
+
+	info, err := s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.NoError(err)
+	ctx = ctx.WithBlockHeight(21).WithBlockTime(info.JailedUntil)
+
+	val.Jailed = true
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil)
+	s.stakingKeeper.EXPECT().Delegation(gomock.Any(), sdk.AccAddress(valAddr), valAddr).Return(stakingtypes.NewDelegation(sdk.AccAddress(valAddr).String(), valAddr.String(), sdkmath.LegacyNewDec(1)), nil)
+	s.stakingKeeper.EXPECT().Unjail(gomock.Any(), consAddr).Return(nil)
+	require.NoError(s.slashingKeeper.ProcessAutoUnjails(ctx))
+
+	pending, err = s.slashingKeeper.GetPendingAutoUnjail(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(int64(21), pending.UnjailHeight)
+
+	// signing a block ends the probation of the validator and charges the fee
+	ctx = ctx.WithBlockHeight(23)
+	s.stakingKeeper.EXPECT().IsValidatorJailed(gomock.Any(), consAddr).Return(false, nil)
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))).Return(nil)
+	require.NoError(s.slashingKeeper.HandleValidatorSignature(ctx, consAddr.Bytes(), 100, comet.BlockIDFlagCommit))
+
+	_, err = s.slashingKeeper.GetPendingAutoUnjail(ctx, consAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoPendingAutoUnjailFound)
+
+	info, err = s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(int64(1), info.IndexOffset)
+}
+
+func (s *KeeperTestSuite) TestAutoUnjailRevoked() {
+	require := s.Require()
+	val, valAddr, consAddr := s.setupAutoUnjail()
+	ctx := s.ctx.WithBlockHeight(30)
+
+	// the validator fails to pay the fee
+	require.NoError(s.slashingKeeper.SetPendingAutoUnjail(ctx, consAddr, slashingtypes.NewPendingAutoUnjail(consAddr, 28)))
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, 0, 3, time.Unix(0, 0).UTC(), false, 0)
+	require.NoError(s.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info))
+
+	s.stakingKeeper.EXPECT().IsValidatorJailed(gomock.Any(), consAddr).Return(false, nil).Times(2)
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), authtypes.FeeCollectorName, gomock.Any()).Return(errors.New("insufficient funds"))
+	s.stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return(nil)
+	require.NoError(s.slashingKeeper.HandleValidatorSignature(ctx, consAddr.Bytes(), 100, comet.BlockIDFlagCommit))
+
+	_, err := s.slashingKeeper.GetPendingAutoUnjail(ctx, consAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoPendingAutoUnjailFound)
+
+	// the signing info is left untouched
+	gotInfo, err := s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(info, gotInfo)
+
+	// the validator does not sign within its probation
+	require.NoError(s.slashingKeeper.SetPendingAutoUnjail(ctx, consAddr, slashingtypes.NewPendingAutoUnjail(consAddr, 28)))
+	require.NoError(s.slashingKeeper.ProcessAutoUnjails(ctx.WithBlockHeight(33)))
+	_, err = s.slashingKeeper.GetPendingAutoUnjail(ctx, consAddr)
+	require.NoError(err)
+
+	s.stakingKeeper.EXPECT().IsValidatorJailed(gomock.Any(), consAddr).Return(false, nil)
+	s.stakingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return(nil)
+	require.NoError(s.slashingKeeper.ProcessAutoUnjails(ctx.WithBlockHeight(34)))
+
+	_, err = s.slashingKeeper.GetPendingAutoUnjail(ctx, consAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoPendingAutoUnjailFound)
+
+	// the automatic unjailing of a tombstoned validator is cancelled
+	require.NoError(s.slashingKeeper.SetPendingAutoUnjail(ctx, consAddr, slashingtypes.NewPendingAutoUnjail(consAddr, 0)))
+	require.NoError(s.slashingKeeper.Tombstone(ctx, consAddr))
+
+	val.Jailed = true
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil)
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil)
+	s.stakingKeeper.EXPECT().Delegation(gomock.Any(), sdk.AccAddress(valAddr), valAddr).Return(stakingtypes.NewDelegation(sdk.AccAddress(valAddr).String(), valAddr.String(), sdkmath.LegacyNewDec(1)), nil)
+	require.NoError(s.slashingKeeper.ProcessAutoUnjails(ctx))
+
+	_, err = s.slashingKeeper.GetPendingAutoUnjail(ctx, consAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoPendingAutoUnjailFound)
+}
+
+func (s *KeeperTestSuite) TestAutoUnjailDisabled() {
+	require := s.Require()
+	_, _, consAddr := s.setupAutoUnjail()
+	_, scheduledPubKey, _ := testdata.KeyTestPubAddr()
+	scheduledAddr := sdk.ConsAddress(scheduledPubKey.Address())
+	ctx := s.ctx.WithBlockHeight(30)
+
+	// a validator on probation and a validator waiting to be unjailed
+	require.NoError(s.slashingKeeper.SetPendingAutoUnjail(ctx, consAddr, slashingtypes.NewPendingAutoUnjail(consAddr, 28)))
+	require.NoError(s.slashingKeeper.SetPendingAutoUnjail(ctx, scheduledAddr, slashingtypes.NewPendingAutoUnjail(scheduledAddr, 0)))
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, 0, 3, time.Unix(0, 0).UTC(), false, 0)
+	require.NoError(s.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info))
+
+	params, err := s.slashingKeeper.GetParams(ctx)
+	require.NoError(err)
+	params.AutoUnjailProbationBlocks = 0
+	require.NoError(s.slashingKeeper.SetParams(ctx, params))
+
+	// signing a block does not charge the fee once disabled
+	s.stakingKeeper.EXPECT().IsValidatorJailed(gomock.Any(), consAddr).Return(false, nil)
+	require.NoError(s.slashingKeeper.HandleValidatorSignature(ctx, consAddr.Bytes(), 100, comet.BlockIDFlagCommit))
+
+	// the pending automatic unjailings are cancelled
+	require.NoError(s.slashingKeeper.ProcessAutoUnjails(ctx))
+
+	_, err = s.slashingKeeper.GetPendingAutoUnjail(ctx, consAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoPendingAutoUnjailFound)
+	_, err = s.slashingKeeper.GetPendingAutoUnjail(ctx, scheduledAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoPendingAutoUnjailFound)
+}
+
+func (s *KeeperTestSuite) TestAutoUnjailNotOptedIn() {
+	require := s.Require()
+	val, valAddr, consAddr := s.setupAutoUnjail()
+	require.NoError(s.slashingKeeper.SetAutoUnjail(s.ctx, valAddr, false))
+
+	s.jailForDowntime(s.ctx.WithBlockHeight(20), val, consAddr)
+
+	_, err := s.slashingKeeper.GetPendingAutoUnjail(s.ctx, consAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoPendingAutoUnjailFound)
+}
+
+func (s *KeeperTestSuite) TestSetAutoUnjail() {
+	require := s.Require()
+	val, valAddr, consAddr := s.setupAutoUnjail()
+	require.NoError(s.slashingKeeper.SetAutoUnjail(s.ctx, valAddr, false))
+
+	s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(nil, stakingtypes.ErrNoValidatorFound)
+	_, err := s.msgServer.SetAutoUnjail(s.ctx, slashingtypes.NewMsgSetAutoUnjail(valAddr.String(), true))
+	require.ErrorIs(err, slashingtypes.ErrNoValidatorForAddress)
+
+	s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil)
+	_, err = s.msgServer.SetAutoUnjail(s.ctx, slashingtypes.NewMsgSetAutoUnjail(valAddr.String(), true))
+	require.NoError(err)
+
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(nil, stakingtypes.ErrNoValidatorFound)
+	res, err := s.queryClient.AutoUnjail(s.ctx, &slashingtypes.QueryAutoUnjailRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(err)
+	require.True(res.Enabled)
+	require.Nil(res.Pending)
+
+	// opting out cancels the scheduled automatic unjailing
+	require.NoError(s.slashingKeeper.SetPendingAutoUnjail(s.ctx, consAddr, slashingtypes.NewPendingAutoUnjail(consAddr, 0)))
+
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil)
+	res, err = s.queryClient.AutoUnjail(s.ctx, &slashingtypes.QueryAutoUnjailRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(err)
+	require.True(res.Enabled)
+	require.Equal(int64(0), res.Pending.UnjailHeight)
+
+	s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil)
+	_, err = s.msgServer.SetAutoUnjail(s.ctx, slashingtypes.NewMsgSetAutoUnjail(valAddr.String(), false))
+	require.NoError(err)
+
+	enabled, err := s.slashingKeeper.IsAutoUnjailEnabled(s.ctx, valAddr)
+	require.NoError(err)
+	require.False(enabled)
+	_, err = s.slashingKeeper.GetPendingAutoUnjail(s.ctx, consAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoPendingAutoUnjailFound)
+
+	// opting out does not cancel the probation of an unjailed validator
+	require.NoError(s.slashingKeeper.SetPendingAutoUnjail(s.ctx, consAddr, slashingtypes.NewPendingAutoUnjail(consAddr, 10)))
+
+	s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil)
+	_, err = s.msgServer.SetAutoUnjail(s.ctx, slashingtypes.NewMsgSetAutoUnjail(valAddr.String(), false))
+	require.NoError(err)
+	_, err = s.slashingKeeper.GetPendingAutoUnjail(s.ctx, consAddr)
+	require.NoError(err)
+}
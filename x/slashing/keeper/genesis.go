@@ -51,6 +51,36 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	for _, valAddrStr := range data.AutoUnjailValidators {
+		valAddr, err := keeper.sk.ValidatorAddressCodec().StringToBytes(valAddrStr)
+		if err != nil {
+			panic(err)
+		}
+		if err := keeper.SetAutoUnjail(ctx, valAddr, true); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, pending := range data.PendingAutoUnjails {
+		address, err := keeper.sk.ConsensusAddressCodec().StringToBytes(pending.Address)
+		if err != nil {
+			panic(err)
+		}
+		if err := keeper.SetPendingAutoUnjail(ctx, address, pending); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, window := range data.MaintenanceWindows {
+		address, err := keeper.sk.ConsensusAddressCodec().StringToBytes(window.Address)
+		if err != nil {
+			panic(err)
+		}
+		if err := keeper.SetMaintenanceWindow(ctx, address, window); err != nil {
+			panic(err)
+		}
+	}
+
 	if err := keeper.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -89,5 +119,40 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	autoUnjailValidators := make([]string, 0)
+	err = keeper.IterateAutoUnjailValidators(ctx, func(valAddr sdk.ValAddress) (stop bool) {
+		valAddrStr, err := keeper.sk.ValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			panic(err)
+		}
+		autoUnjailValidators = append(autoUnjailValidators, valAddrStr)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	pendingAutoUnjails := make([]types.PendingAutoUnjail, 0)
+	err = keeper.IteratePendingAutoUnjails(ctx, func(_ sdk.ConsAddress, pending types.PendingAutoUnjail) (stop bool) {
+		pendingAutoUnjails = append(pendingAutoUnjails, pending)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	maintenanceWindows := make([]types.MaintenanceWindow, 0)
+	err = keeper.IterateMaintenanceWindows(ctx, func(_ sdk.ConsAddress, window types.MaintenanceWindow) (stop bool) {
+		maintenanceWindows = append(maintenanceWindows, window)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	data = types.NewGenesisState(params, signingInfos, missedBlocks)
+	data.AutoUnjailValidators = autoUnjailValidators
+	data.PendingAutoUnjails = pendingAutoUnjails
+	data.MaintenanceWindows = maintenanceWindows
+	return data
 }
@@ -26,11 +26,25 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 
 	require.NoError(keeper.SetValidatorSigningInfo(ctx, consAddr1, info1))
 	require.NoError(keeper.SetValidatorSigningInfo(ctx, consAddr2, info2))
+
+	valAddr := sdk.ValAddress("val1________________")
+	pending := types.NewPendingAutoUnjail(consAddr1, 3)
+	window := types.NewMaintenanceWindow(consAddr2, 10, 20)
+	require.NoError(keeper.SetAutoUnjail(ctx, valAddr, true))
+	require.NoError(keeper.SetPendingAutoUnjail(ctx, consAddr1, pending))
+	require.NoError(keeper.SetMaintenanceWindow(ctx, consAddr2, window))
 	genesisState := keeper.ExportGenesis(ctx)
 
 	require.Equal(genesisState.Params, testutil.TestParams())
 	require.Len(genesisState.SigningInfos, 2)
 	require.Equal(genesisState.SigningInfos[0].ValidatorSigningInfo, info1)
+	require.Equal([]string{valAddr.String()}, genesisState.AutoUnjailValidators)
+	require.Equal([]types.PendingAutoUnjail{pending}, genesisState.PendingAutoUnjails)
+	require.Equal([]types.MaintenanceWindow{window}, genesisState.MaintenanceWindows)
+
+	require.NoError(keeper.SetAutoUnjail(ctx, valAddr, false))
+	require.NoError(keeper.DeletePendingAutoUnjail(ctx, consAddr1))
+	require.NoError(keeper.DeleteMaintenanceWindow(ctx, consAddr2))
 
 	// Tombstone validators after genesis shouldn't effect genesis state
 	err := keeper.Tombstone(ctx, consAddr1)
@@ -56,4 +70,14 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	newInfo2, _ := keeper.GetValidatorSigningInfo(ctx, consAddr2)
 	require.Equal(info1, newInfo1)
 	require.Equal(info2, newInfo2)
+
+	enabled, err := keeper.IsAutoUnjailEnabled(ctx, valAddr)
+	require.NoError(err)
+	require.True(enabled)
+	newPending, err := keeper.GetPendingAutoUnjail(ctx, consAddr1)
+	require.NoError(err)
+	require.Equal(pending, newPending)
+	newWindow, err := keeper.GetMaintenanceWindow(ctx, consAddr2)
+	require.NoError(err)
+	require.Equal(window, newWindow)
 }
@@ -6,6 +6,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/store/v2/prefix"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -80,3 +82,103 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// AutoUnjail returns the automatic unjailing status of a validator.
+func (k Keeper) AutoUnjail(ctx context.Context, req *types.QueryAutoUnjailRequest) (*types.QueryAutoUnjailResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := k.IsAutoUnjailEnabled(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryAutoUnjailResponse{Enabled: enabled}
+	validator, err := k.sk.Validator(ctx, valAddr)
+	if err != nil || validator == nil {
+		return res, nil
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := k.GetPendingAutoUnjail(ctx, consAddr)
+	switch {
+	case err == nil:
+		res.Pending = &pending
+	case !errors.IsOf(err, types.ErrNoPendingAutoUnjailFound):
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MaintenanceWindow returns the maintenance window of a specific validator.
+func (k Keeper) MaintenanceWindow(ctx context.Context, req *types.QueryMaintenanceWindowRequest) (*types.QueryMaintenanceWindowResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.sk.Validator(ctx, valAddr)
+	if err != nil || validator == nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddress)
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := k.GetMaintenanceWindow(ctx, consAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "MaintenanceWindow not found for validator %s", req.ValidatorAddress)
+	}
+
+	return &types.QueryMaintenanceWindowResponse{Window: window}, nil
+}
+
+// MaintenanceWindows returns the maintenance windows of all validators.
+func (k Keeper) MaintenanceWindows(ctx context.Context, req *types.QueryMaintenanceWindowsRequest) (*types.QueryMaintenanceWindowsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	var windows []types.MaintenanceWindow
+
+	windowStore := prefix.NewStore(runtime.KVStoreAdapter(store), types.MaintenanceWindowKeyPrefix)
+	pageRes, err := query.Paginate(windowStore, req.Pagination, func(key, value []byte) error {
+		var window types.MaintenanceWindow
+		err := k.cdc.Unmarshal(value, &window)
+		if err != nil {
+			return err
+		}
+		windows = append(windows, window)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMaintenanceWindowsResponse{Windows: windows, Pagination: pageRes}, nil
+}
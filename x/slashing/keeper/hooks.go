@@ -44,9 +44,19 @@ func (h Hooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddres
 	return h.k.SetValidatorSigningInfo(ctx, consAddr, signingInfo)
 }
 
-// AfterValidatorRemoved deletes the address-pubkey relation when a validator is removed,
-func (h Hooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress, _ sdk.ValAddress) error {
-	return h.k.deleteAddrPubkeyRelation(ctx, crypto.Address(consAddr))
+// AfterValidatorRemoved deletes the address-pubkey relation, the automatic
+// unjailing state and the maintenance window when a validator is removed.
+func (h Hooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	if err := h.k.deleteAddrPubkeyRelation(ctx, crypto.Address(consAddr)); err != nil {
+		return err
+	}
+	if err := h.k.SetAutoUnjail(ctx, valAddr, false); err != nil {
+		return err
+	}
+	if err := h.k.DeletePendingAutoUnjail(ctx, consAddr); err != nil {
+		return err
+	}
+	return h.k.DeleteMaintenanceWindow(ctx, consAddr)
 }
 
 // AfterValidatorConsKeyUpdated moves signing state to the new consensus key.
@@ -68,6 +78,12 @@ func (h Hooks) AfterValidatorConsKeyUpdated(ctx context.Context, oldConsAddr, ne
 	if err := h.k.MoveValidatorSigningInfo(ctx, oldConsAddr, newConsAddr); err != nil {
 		return err
 	}
+	if err := h.k.MovePendingAutoUnjail(ctx, oldConsAddr, newConsAddr); err != nil {
+		return err
+	}
+	if err := h.k.MoveMaintenanceWindow(ctx, oldConsAddr, newConsAddr); err != nil {
+		return err
+	}
 	return h.k.MoveMissedBlockBitmap(ctx, oldConsAddr, newConsAddr)
 }
 
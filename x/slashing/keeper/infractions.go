@@ -33,6 +33,29 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 		return nil
 	}
 
+	// a validator automatically unjailed ends its probation by signing a block
+	if signed != comet.BlockIDFlagAbsent {
+		revoked, err := k.confirmAutoUnjail(ctx, consAddr)
+		if err != nil {
+			return err
+		}
+
+		if revoked {
+			return nil
+		}
+	}
+
+	// don't update missed blocks during the validator's maintenance window, as
+	// when it is jailed, so that its bitmap is left untouched
+	inMaintenance, err := k.IsInMaintenance(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
+	if inMaintenance {
+		return nil
+	}
+
 	// fetch signing info
 	signInfo, err := k.GetValidatorSigningInfo(ctx, consAddr)
 	if err != nil {
@@ -164,6 +187,15 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 				return err
 			}
 
+			valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+			if err != nil {
+				return err
+			}
+
+			if err := k.scheduleAutoUnjail(ctx, consAddr, valAddr); err != nil {
+				return err
+			}
+
 			logger.Info(
 				"slashing and jailing validator due to liveness fault",
 				"height", height,
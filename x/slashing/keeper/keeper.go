@@ -21,6 +21,7 @@ type Keeper struct {
 	cdc          codec.BinaryCodec
 	legacyAmino  *codec.LegacyAmino
 	sk           types.StakingKeeper
+	bk           types.BankKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -28,12 +29,13 @@ type Keeper struct {
 }
 
 // NewKeeper creates a slashing keeper
-func NewKeeper(cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, storeService storetypes.KVStoreService, sk types.StakingKeeper, authority string) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, storeService storetypes.KVStoreService, sk types.StakingKeeper, bk types.BankKeeper, authority string) Keeper {
 	return Keeper{
 		storeService: storeService,
 		cdc:          cdc,
 		legacyAmino:  legacyAmino,
 		sk:           sk,
+		bk:           bk,
 		authority:    authority,
 	}
 }
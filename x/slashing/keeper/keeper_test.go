@@ -33,6 +33,7 @@ type KeeperTestSuite struct {
 
 	ctx            sdk.Context
 	stakingKeeper  *slashingtestutil.MockStakingKeeper
+	bankKeeper     *slashingtestutil.MockBankKeeper
 	slashingKeeper slashingkeeper.Keeper
 	queryClient    slashingtypes.QueryClient
 	msgServer      slashingtypes.MsgServer
@@ -50,6 +51,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.stakingKeeper = slashingtestutil.NewMockStakingKeeper(ctrl)
 	s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
 	s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons")).AnyTimes()
+	s.bankKeeper = slashingtestutil.NewMockBankKeeper(ctrl)
 
 	s.ctx = ctx
 	s.slashingKeeper = slashingkeeper.NewKeeper(
@@ -57,6 +59,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		encCfg.Amino,
 		storeService,
 		s.stakingKeeper,
+		s.bankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	// set test params
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// GetMaintenanceWindow returns the maintenance window of a validator. If not
+// found it returns ErrNoMaintenanceWindowFound.
+func (k Keeper) GetMaintenanceWindow(ctx context.Context, consAddr sdk.ConsAddress) (types.MaintenanceWindow, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	var window types.MaintenanceWindow
+	bz, err := store.Get(types.MaintenanceWindowKey(consAddr))
+	if err != nil {
+		return window, err
+	}
+
+	if bz == nil {
+		return window, types.ErrNoMaintenanceWindowFound
+	}
+
+	err = k.cdc.Unmarshal(bz, &window)
+	return window, err
+}
+
+// SetMaintenanceWindow sets the maintenance window of a validator.
+// CONTRACT: This method performs no validation of the window.
+func (k Keeper) SetMaintenanceWindow(ctx context.Context, consAddr sdk.ConsAddress, window types.MaintenanceWindow) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&window)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.MaintenanceWindowKey(consAddr), bz)
+}
+
+// DeleteMaintenanceWindow deletes the maintenance window of a validator.
+func (k Keeper) DeleteMaintenanceWindow(ctx context.Context, consAddr sdk.ConsAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.MaintenanceWindowKey(consAddr))
+}
+
+// MoveMaintenanceWindow moves the maintenance window of a validator from one
+// consensus address to another.
+func (k Keeper) MoveMaintenanceWindow(ctx context.Context, oldAddr, newAddr sdk.ConsAddress) error {
+	window, err := k.GetMaintenanceWindow(ctx, oldAddr)
+	if err != nil {
+		if errors.IsOf(err, types.ErrNoMaintenanceWindowFound) {
+			return nil
+		}
+		return err
+	}
+
+	window.Address = newAddr.String()
+	if err := k.SetMaintenanceWindow(ctx, newAddr, window); err != nil {
+		return err
+	}
+	return k.DeleteMaintenanceWindow(ctx, oldAddr)
+}
+
+// IterateMaintenanceWindows iterates over the maintenance windows.
+func (k Keeper) IterateMaintenanceWindows(ctx context.Context,
+	handler func(consAddr sdk.ConsAddress, window types.MaintenanceWindow) (stop bool),
+) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iter, err := store.Iterator(types.MaintenanceWindowKeyPrefix, storetypes.PrefixEndBytes(types.MaintenanceWindowKeyPrefix))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var window types.MaintenanceWindow
+		if err := k.cdc.Unmarshal(iter.Value(), &window); err != nil {
+			return err
+		}
+
+		if handler(types.MaintenanceWindowAddress(iter.Key()), window) {
+			break
+		}
+	}
+	return nil
+}
+
+// IsInMaintenance returns true if the downtime of the validator is not
+// accounted at the current height.
+func (k Keeper) IsInMaintenance(ctx context.Context, consAddr sdk.ConsAddress) (bool, error) {
+	window, err := k.GetMaintenanceWindow(ctx, consAddr)
+	if err != nil {
+		if errors.IsOf(err, types.ErrNoMaintenanceWindowFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return window.IsActive(sdk.UnwrapSDKContext(ctx).BlockHeight()), nil
+}
+
+// PruneMaintenanceWindows deletes the maintenance windows which ended.
+func (k Keeper) PruneMaintenanceWindows(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	var expired []sdk.ConsAddress
+	err := k.IterateMaintenanceWindows(ctx, func(consAddr sdk.ConsAddress, window types.MaintenanceWindow) bool {
+		if window.IsExpired(height) {
+			expired = append(expired, consAddr)
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, consAddr := range expired {
+		if err := k.DeleteMaintenanceWindow(ctx, consAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/core/comet"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestSetMaintenanceWindow() {
+	require := s.Require()
+	ctx := s.ctx.WithBlockHeight(100)
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	val, err := stakingtypes.NewValidator(valAddr.String(), pubKey, stakingtypes.Description{Moniker: "test"})
+	require.NoError(err)
+
+	newMsg := func(start, end int64) *slashingtypes.MsgSetMaintenanceWindow {
+		return &slashingtypes.MsgSetMaintenanceWindow{
+			Authority:        s.slashingKeeper.GetAuthority(),
+			ValidatorAddress: valAddr.String(),
+			StartHeight:      start,
+			EndHeight:        end,
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func() *slashingtypes.MsgSetMaintenanceWindow
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			malleate: func() *slashingtypes.MsgSetMaintenanceWindow {
+				msg := newMsg(110, 120)
+				msg.Authority = "foo"
+				return msg
+			},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "unknown validator",
+			malleate: func() *slashingtypes.MsgSetMaintenanceWindow {
+				s.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(nil, stakingtypes.ErrNoValidatorFound)
+				return newMsg(110, 120)
+			},
+			expErrMsg: "address is not associated with any known validator",
+		},
+		{
+			name: "end before start",
+			malleate: func() *slashingtypes.MsgSetMaintenanceWindow {
+				s.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(val, nil)
+				return newMsg(120, 110)
+			},
+			expErrMsg: "must be greater than its start height",
+		},
+		{
+			name: "too long",
+			malleate: func() *slashingtypes.MsgSetMaintenanceWindow {
+				s.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(val, nil)
+				return newMsg(110, 110+slashingtypes.DefaultMaxMaintenanceWindowBlocks+1)
+			},
+			expErrMsg: "exceeds the maximum",
+		},
+		{
+			name: "already ended",
+			malleate: func() *slashingtypes.MsgSetMaintenanceWindow {
+				s.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(val, nil)
+				return newMsg(90, 100)
+			},
+			expErrMsg: "window ended",
+		},
+		{
+			name: "no window to delete",
+			malleate: func() *slashingtypes.MsgSetMaintenanceWindow {
+				s.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(val, nil)
+				return newMsg(0, 0)
+			},
+			expErrMsg: "no maintenance window found",
+		},
+		{
+			name: "valid window",
+			malleate: func() *slashingtypes.MsgSetMaintenanceWindow {
+				s.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(val, nil)
+				return newMsg(90, 120)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.SetMaintenanceWindow(ctx, tc.malleate())
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			require.NoError(err)
+		})
+	}
+
+	window, err := s.slashingKeeper.GetMaintenanceWindow(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(slashingtypes.NewMaintenanceWindow(consAddr, 90, 120), window)
+
+	// maintenance windows are disabled
+	params, err := s.slashingKeeper.GetParams(ctx)
+	require.NoError(err)
+	params.MaxMaintenanceWindowBlocks = 0
+	require.NoError(s.slashingKeeper.SetParams(ctx, params))
+
+	s.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(val, nil)
+	_, err = s.msgServer.SetMaintenanceWindow(ctx, newMsg(110, 120))
+	require.ErrorContains(err, "maintenance windows are disabled")
+
+	// windows are deleted even if disabled
+	s.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(val, nil)
+	_, err = s.msgServer.SetMaintenanceWindow(ctx, newMsg(0, 0))
+	require.NoError(err)
+
+	_, err = s.slashingKeeper.GetMaintenanceWindow(ctx, consAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoMaintenanceWindowFound)
+}
+
+func (s *KeeperTestSuite) TestMaintenanceWindowPausesDowntime() {
+	require := s.Require()
+
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, 0, 5, time.Unix(0, 0).UTC(), false, 1)
+	require.NoError(s.slashingKeeper.SetValidatorSigningInfo(s.ctx, consAddr, info))
+	require.NoError(s.slashingKeeper.SetMissedBlockBitmapValue(s.ctx, consAddr, 2, true))
+	require.NoError(s.slashingKeeper.SetMaintenanceWindow(s.ctx, consAddr, slashingtypes.NewMaintenanceWindow(consAddr, 10, 20)))
+
+	// missed blocks are not accounted during the window
+	for height := int64(10); height < 20; height++ {
+		ctx := s.ctx.WithBlockHeight(height)
+		s.stakingKeeper.EXPECT().IsValidatorJailed(ctx, consAddr).Return(false, nil)
+		require.NoError(s.slashingKeeper.HandleValidatorSignature(ctx, consAddr.Bytes(), 100, comet.BlockIDFlagAbsent))
+		require.NoError(s.slashingKeeper.PruneMaintenanceWindows(ctx))
+	}
+
+	gotInfo, err := s.slashingKeeper.GetValidatorSigningInfo(s.ctx, consAddr)
+	require.NoError(err)
+	require.Equal(info, gotInfo)
+
+	missedBlocks, err := s.slashingKeeper.GetValidatorMissedBlocks(s.ctx, consAddr)
+	require.NoError(err)
+	require.Equal([]slashingtypes.MissedBlock{slashingtypes.NewMissedBlock(2, true)}, missedBlocks)
+
+	// the window is pruned once ended, and missed blocks are accounted again
+	ctx := s.ctx.WithBlockHeight(20)
+	require.NoError(s.slashingKeeper.PruneMaintenanceWindows(ctx))
+	_, err = s.slashingKeeper.GetMaintenanceWindow(ctx, consAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoMaintenanceWindowFound)
+
+	s.stakingKeeper.EXPECT().IsValidatorJailed(ctx, consAddr).Return(false, nil)
+	require.NoError(s.slashingKeeper.HandleValidatorSignature(ctx, consAddr.Bytes(), 100, comet.BlockIDFlagAbsent))
+
+	gotInfo, err = s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(int64(6), gotInfo.IndexOffset)
+	require.Equal(int64(2), gotInfo.MissedBlocksCounter)
+}
+
+func (s *KeeperTestSuite) TestGRPCMaintenanceWindows() {
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	consAddr1 := sdk.ConsAddress(pubKey.Address())
+	consAddr2 := sdk.ConsAddress("addr2_______________")
+	val, err := stakingtypes.NewValidator(valAddr.String(), pubKey, stakingtypes.Description{Moniker: "test"})
+	require.NoError(err)
+
+	window1 := slashingtypes.NewMaintenanceWindow(consAddr1, 10, 20)
+	window2 := slashingtypes.NewMaintenanceWindow(consAddr2, 15, 30)
+	require.NoError(s.slashingKeeper.SetMaintenanceWindow(s.ctx, consAddr1, window1))
+	require.NoError(s.slashingKeeper.SetMaintenanceWindow(s.ctx, consAddr2, window2))
+
+	_, err = s.queryClient.MaintenanceWindow(s.ctx, &slashingtypes.QueryMaintenanceWindowRequest{})
+	require.ErrorContains(err, "invalid request")
+
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil)
+	res, err := s.queryClient.MaintenanceWindow(s.ctx, &slashingtypes.QueryMaintenanceWindowRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(err)
+	require.Equal(window1, res.Window)
+
+	resp, err := s.queryClient.MaintenanceWindows(s.ctx, &slashingtypes.QueryMaintenanceWindowsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(err)
+	require.Len(resp.Windows, 1)
+	require.Equal(uint64(2), resp.Pagination.Total)
+}
+
+func (s *KeeperTestSuite) TestLivenessStateHooks() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	oldPk := ed25519.GenPrivKey().PubKey()
+	newPk := ed25519.GenPrivKey().PubKey()
+	oldConsAddr := sdk.ConsAddress(oldPk.Address())
+	newConsAddr := sdk.ConsAddress(newPk.Address())
+	valAddr := sdk.ValAddress(oldPk.Address())
+
+	validator, err := stakingtypes.NewValidator(valAddr.String(), newPk, stakingtypes.Description{})
+	require.NoError(err)
+
+	require.NoError(keeper.SetAutoUnjail(ctx, valAddr, true))
+	require.NoError(keeper.SetPendingAutoUnjail(ctx, oldConsAddr, slashingtypes.NewPendingAutoUnjail(oldConsAddr, 10)))
+	require.NoError(keeper.SetMaintenanceWindow(ctx, oldConsAddr, slashingtypes.NewMaintenanceWindow(oldConsAddr, 10, 20)))
+
+	s.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(validator, nil)
+	require.NoError(keeper.Hooks().AfterValidatorConsKeyUpdated(ctx, oldConsAddr, newConsAddr, valAddr))
+
+	_, err = keeper.GetPendingAutoUnjail(ctx, oldConsAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoPendingAutoUnjailFound)
+	pending, err := keeper.GetPendingAutoUnjail(ctx, newConsAddr)
+	require.NoError(err)
+	require.Equal(slashingtypes.NewPendingAutoUnjail(newConsAddr, 10), pending)
+
+	_, err = keeper.GetMaintenanceWindow(ctx, oldConsAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoMaintenanceWindowFound)
+	window, err := keeper.GetMaintenanceWindow(ctx, newConsAddr)
+	require.NoError(err)
+	require.Equal(slashingtypes.NewMaintenanceWindow(newConsAddr, 10, 20), window)
+
+	require.NoError(keeper.Hooks().AfterValidatorRemoved(ctx, newConsAddr, valAddr))
+
+	enabled, err := keeper.IsAutoUnjailEnabled(ctx, valAddr)
+	require.NoError(err)
+	require.False(enabled)
+	_, err = keeper.GetPendingAutoUnjail(ctx, newConsAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoPendingAutoUnjailFound)
+	_, err = keeper.GetMaintenanceWindow(ctx, newConsAddr)
+	require.ErrorIs(err, slashingtypes.ErrNoMaintenanceWindowFound)
+}
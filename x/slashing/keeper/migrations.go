@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns Migrator instance for the state migration.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate4to5 migrates the x/slashing module state from the consensus version 4
+// to version 5. Specifically, it sets the default automatic unjailing and
+// maintenance window params, which were introduced in version 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.AutoUnjailFee = types.DefaultAutoUnjailFee
+	params.AutoUnjailProbationBlocks = types.DefaultAutoUnjailProbationBlocks
+	params.MaxMaintenanceWindowBlocks = types.DefaultMaxMaintenanceWindowBlocks
+
+	return m.keeper.SetParams(ctx, params)
+}
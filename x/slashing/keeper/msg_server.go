@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -56,3 +57,117 @@ func (k msgServer) Unjail(goCtx context.Context, msg *types.MsgUnjail) (*types.M
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+// SetAutoUnjail implements MsgServer.SetAutoUnjail method.
+// Validators opt in or out of the automatic unjailing after having been jailed
+// for downtime. Opting out cancels the scheduled automatic unjailing of the
+// validator, if any.
+func (k msgServer) SetAutoUnjail(goCtx context.Context, msg *types.MsgSetAutoUnjail) (*types.MsgSetAutoUnjailResponse, error) {
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	validator, err := k.sk.Validator(ctx, valAddr)
+	if err != nil || validator == nil {
+		return nil, types.ErrNoValidatorForAddress
+	}
+
+	if err := k.Keeper.SetAutoUnjail(ctx, valAddr, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	if !msg.Enabled {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return nil, err
+		}
+
+		// the probation of a validator already unjailed is not cancelled
+		pending, err := k.GetPendingAutoUnjail(ctx, consAddr)
+		if err == nil && !pending.OnProbation() {
+			if err := k.DeletePendingAutoUnjail(ctx, consAddr); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &types.MsgSetAutoUnjailResponse{}, nil
+}
+
+// SetMaintenanceWindow implements MsgServer.SetMaintenanceWindow method.
+// It defines a method to pause the downtime accounting of a validator for a
+// bounded period. A window with zero start and end heights deletes the
+// maintenance window of the validator.
+func (k msgServer) SetMaintenanceWindow(goCtx context.Context, msg *types.MsgSetMaintenanceWindow) (*types.MsgSetMaintenanceWindowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := sdk.ValidateAuthority(ctx, k.authority, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	validator, err := k.sk.Validator(ctx, valAddr)
+	if err != nil || validator == nil {
+		return nil, types.ErrNoValidatorForAddress
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.StartHeight == 0 && msg.EndHeight == 0 {
+		if _, err := k.GetMaintenanceWindow(ctx, consAddr); err != nil {
+			return nil, err
+		}
+
+		if err := k.DeleteMaintenanceWindow(ctx, consAddr); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgSetMaintenanceWindowResponse{}, nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	window := types.NewMaintenanceWindow(consAddr, msg.StartHeight, msg.EndHeight)
+	if err := window.Validate(); err != nil {
+		return nil, types.ErrInvalidMaintenanceWindow.Wrap(err.Error())
+	}
+
+	if params.MaxMaintenanceWindowBlocks == 0 {
+		return nil, types.ErrInvalidMaintenanceWindow.Wrap("maintenance windows are disabled")
+	}
+
+	if length := window.EndHeight - window.StartHeight; length > params.MaxMaintenanceWindowBlocks {
+		return nil, types.ErrInvalidMaintenanceWindow.Wrapf("length %d exceeds the maximum of %d blocks", length, params.MaxMaintenanceWindowBlocks)
+	}
+
+	if window.IsExpired(ctx.BlockHeight()) {
+		return nil, types.ErrInvalidMaintenanceWindow.Wrapf("window ended before the current height %d", ctx.BlockHeight())
+	}
+
+	if err := k.Keeper.SetMaintenanceWindow(ctx, consAddr, window); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMaintenanceWindow,
+			sdk.NewAttribute(types.AttributeKeyAddress, window.Address),
+			sdk.NewAttribute(types.AttributeKeyStartHeight, fmt.Sprintf("%d", window.StartHeight)),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, fmt.Sprintf("%d", window.EndHeight)),
+		),
+	)
+
+	return &types.MsgSetMaintenanceWindowResponse{}, nil
+}
@@ -117,6 +117,70 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid auto unjail fee",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:      int64(750),
+					MinSignedPerWindow:      minSignedPerWindow,
+					DowntimeJailDuration:    time.Duration(10),
+					SlashFractionDoubleSign: slashFractionDoubleSign,
+					SlashFractionDowntime:   slashFractionDowntime,
+					AutoUnjailFee:           sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.NewInt(-1)}},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "invalid auto unjail fee",
+		},
+		{
+			name: "set invalid auto unjail probation blocks",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:        int64(750),
+					MinSignedPerWindow:        minSignedPerWindow,
+					DowntimeJailDuration:      time.Duration(10),
+					SlashFractionDoubleSign:   slashFractionDoubleSign,
+					SlashFractionDowntime:     slashFractionDowntime,
+					AutoUnjailProbationBlocks: -1,
+				},
+			},
+			expectErr: true,
+			expErrMsg: "auto unjail probation blocks must be zero (disabled) or at least 3",
+		},
+		{
+			name: "set too short auto unjail probation blocks",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:        int64(750),
+					MinSignedPerWindow:        minSignedPerWindow,
+					DowntimeJailDuration:      time.Duration(10),
+					SlashFractionDoubleSign:   slashFractionDoubleSign,
+					SlashFractionDowntime:     slashFractionDowntime,
+					AutoUnjailProbationBlocks: 2,
+				},
+			},
+			expectErr: true,
+			expErrMsg: "auto unjail probation blocks must be zero (disabled) or at least 3",
+		},
+		{
+			name: "set invalid max maintenance window blocks",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:         int64(750),
+					MinSignedPerWindow:         minSignedPerWindow,
+					DowntimeJailDuration:       time.Duration(10),
+					SlashFractionDoubleSign:    slashFractionDoubleSign,
+					SlashFractionDowntime:      slashFractionDowntime,
+					MaxMaintenanceWindowBlocks: -1,
+				},
+			},
+			expectErr: true,
+			expErrMsg: "max maintenance window blocks cannot be negative",
+		},
 		{
 			name: "set full valid params",
 			request: &slashingtypes.MsgUpdateParams{
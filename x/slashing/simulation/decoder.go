@@ -37,6 +37,21 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.AutoUnjailKeyPrefix):
+			return fmt.Sprintf("%v\n%v", types.AutoUnjailAddress(kvA.Key), types.AutoUnjailAddress(kvB.Key))
+
+		case bytes.Equal(kvA.Key[:1], types.PendingAutoUnjailKeyPrefix):
+			var pendingA, pendingB types.PendingAutoUnjail
+			cdc.MustUnmarshal(kvA.Value, &pendingA)
+			cdc.MustUnmarshal(kvB.Value, &pendingB)
+			return fmt.Sprintf("%v\n%v", pendingA, pendingB)
+
+		case bytes.Equal(kvA.Key[:1], types.MaintenanceWindowKeyPrefix):
+			var windowA, windowB types.MaintenanceWindow
+			cdc.MustUnmarshal(kvA.Value, &windowA)
+			cdc.MustUnmarshal(kvB.Value, &windowB)
+			return fmt.Sprintf("%v\n%v", windowA, windowB)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
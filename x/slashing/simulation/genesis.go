@@ -18,6 +18,9 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	AutoUnjailProbationBlocks  = "auto_unjail_probation_blocks"
+	MaxMaintenanceWindowBlocks = "max_maintenance_window_blocks"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -45,6 +48,20 @@ func GenSlashFractionDowntime(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDec(1).Quo(math.LegacyNewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenAutoUnjailProbationBlocks randomized AutoUnjailProbationBlocks
+func GenAutoUnjailProbationBlocks(r *rand.Rand) int64 {
+	// zero disables automatic unjailing
+	if r.Intn(10) == 0 {
+		return 0
+	}
+	return int64(simulation.RandIntBetween(r, int(types.MinAutoUnjailProbationBlocks), 1000))
+}
+
+// GenMaxMaintenanceWindowBlocks randomized MaxMaintenanceWindowBlocks
+func GenMaxMaintenanceWindowBlocks(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 0, 20000))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -62,9 +79,16 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var slashFractionDowntime math.LegacyDec
 	simState.AppParams.GetOrGenerate(SlashFractionDowntime, &slashFractionDowntime, simState.Rand, func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) })
 
+	var autoUnjailProbationBlocks int64
+	simState.AppParams.GetOrGenerate(AutoUnjailProbationBlocks, &autoUnjailProbationBlocks, simState.Rand, func(r *rand.Rand) { autoUnjailProbationBlocks = GenAutoUnjailProbationBlocks(r) })
+
+	var maxMaintenanceWindowBlocks int64
+	simState.AppParams.GetOrGenerate(MaxMaintenanceWindowBlocks, &maxMaintenanceWindowBlocks, simState.Rand, func(r *rand.Rand) { maxMaintenanceWindowBlocks = GenMaxMaintenanceWindowBlocks(r) })
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, types.DefaultAutoUnjailFee,
+		autoUnjailProbationBlocks, maxMaintenanceWindowBlocks,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
@@ -13,6 +13,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/slashing/Params", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUnjail{}, "cosmos-sdk/MsgUnjail")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/slashing/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoUnjail{}, "cosmos-sdk/x/slashing/MsgSetAutoUnjail")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMaintenanceWindow{}, "cosmos-sdk/MsgSetMaintenanceWindow")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -20,6 +22,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnjail{},
 		&MsgUpdateParams{},
+		&MsgSetAutoUnjail{},
+		&MsgSetMaintenanceWindow{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
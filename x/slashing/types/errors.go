@@ -12,4 +12,7 @@ var (
 	ErrSelfDelegationTooLowToUnjail = errors.Register(ModuleName, 7, "validator's self delegation less than minimum; cannot be unjailed")
 	ErrNoSigningInfoFound           = errors.Register(ModuleName, 8, "no validator signing info found")
 	ErrValidatorTombstoned          = errors.Register(ModuleName, 9, "validator already tombstoned")
+	ErrInvalidMaintenanceWindow     = errors.Register(ModuleName, 10, "invalid maintenance window")
+	ErrNoMaintenanceWindowFound     = errors.Register(ModuleName, 11, "no maintenance window found")
+	ErrNoPendingAutoUnjailFound     = errors.Register(ModuleName, 12, "no pending auto unjail found")
 )
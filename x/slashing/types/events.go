@@ -5,6 +5,9 @@ const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
 
+	EventTypeAutoUnjail        = "auto_unjail"
+	EventTypeMaintenanceWindow = "maintenance_window"
+
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
 	AttributeKeyPower        = "power"
@@ -12,8 +15,17 @@ const (
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyStatus       = "status"
+	AttributeKeyFee          = "fee"
+	AttributeKeyStartHeight  = "start_height"
+	AttributeKeyEndHeight    = "end_height"
 
 	AttributeValueUnspecified      = "unspecified"
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
+
+	AttributeValueScheduled = "scheduled"
+	AttributeValueUnjailed  = "unjailed"
+	AttributeValueConfirmed = "confirmed"
+	AttributeValueRevoked   = "revoked"
 )
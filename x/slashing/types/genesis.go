@@ -62,5 +62,33 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateAutoUnjailFee(data.Params.AutoUnjailFee); err != nil {
+		return err
+	}
+	if err := validateAutoUnjailProbationBlocks(data.Params.AutoUnjailProbationBlocks); err != nil {
+		return err
+	}
+	if err := validateMaxMaintenanceWindowBlocks(data.Params.MaxMaintenanceWindowBlocks); err != nil {
+		return err
+	}
+
+	seenWindows := make(map[string]bool, len(data.MaintenanceWindows))
+	for _, window := range data.MaintenanceWindows {
+		if seenWindows[window.Address] {
+			return fmt.Errorf("duplicate maintenance window for validator %s", window.Address)
+		}
+		seenWindows[window.Address] = true
+
+		if err := window.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, pending := range data.PendingAutoUnjails {
+		if pending.UnjailHeight < 0 {
+			return fmt.Errorf("auto unjail height cannot be negative for validator %s, is %d", pending.Address, pending.UnjailHeight)
+		}
+	}
+
 	return nil
 }
@@ -35,6 +35,13 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// auto_unjail_validators are the operator addresses of the validators which
+	// opted in for automatic unjailing.
+	AutoUnjailValidators []string `protobuf:"bytes,4,rep,name=auto_unjail_validators,json=autoUnjailValidators,proto3" json:"auto_unjail_validators,omitempty"`
+	// pending_auto_unjails are the pending automatic unjailings of validators.
+	PendingAutoUnjails []PendingAutoUnjail `protobuf:"bytes,5,rep,name=pending_auto_unjails,json=pendingAutoUnjails,proto3" json:"pending_auto_unjails"`
+	// maintenance_windows are the maintenance windows of validators.
+	MaintenanceWindows []MaintenanceWindow `protobuf:"bytes,6,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -91,6 +98,27 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetAutoUnjailValidators() []string {
+	if m != nil {
+		return m.AutoUnjailValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingAutoUnjails() []PendingAutoUnjail {
+	if m != nil {
+		return m.PendingAutoUnjails
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaintenanceWindows() []MaintenanceWindow {
+	if m != nil {
+		return m.MaintenanceWindows
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -269,35 +297,41 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0x56, 0x14, 0xe6, 0x6c, 0x07, 0xac, 0x32, 0xc2, 0x24, 0xb2, 0x51, 0x01, 0x9a,
-	0x90, 0x92, 0x68, 0xe3, 0xb8, 0x13, 0xe1, 0x30, 0x71, 0x40, 0x42, 0xe9, 0xc4, 0x81, 0x03, 0x91,
-	0xd3, 0x78, 0x9e, 0xb5, 0xc6, 0xae, 0xf2, 0x79, 0x55, 0x79, 0x03, 0x8e, 0x3c, 0x06, 0xe2, 0xc4,
-	0x81, 0x13, 0x4f, 0xd0, 0x63, 0xc5, 0x89, 0x13, 0x42, 0xed, 0x81, 0xd7, 0x40, 0xd8, 0x29, 0x0d,
-	0xa8, 0x51, 0x0f, 0xbb, 0x24, 0xb1, 0xfd, 0xfb, 0xff, 0xbf, 0xcf, 0xff, 0xd8, 0xf8, 0xd1, 0x40,
-	0x41, 0xa1, 0x20, 0x82, 0x21, 0x85, 0x0b, 0x21, 0x79, 0x34, 0x3e, 0xca, 0x98, 0xa6, 0x47, 0x11,
-	0x67, 0x92, 0x81, 0x80, 0x70, 0x54, 0x2a, 0xad, 0xc8, 0x5d, 0x8b, 0x85, 0x4b, 0x2c, 0xac, 0xb0,
-	0xbd, 0x2e, 0x57, 0x5c, 0x19, 0x26, 0xfa, 0xf3, 0x65, 0xf1, 0xbd, 0xc7, 0x4d, 0xae, 0x7f, 0xf5,
-	0x96, 0xbb, 0x67, 0xb9, 0xd4, 0x1a, 0x54, 0x35, 0xec, 0xd2, 0x6d, 0x5a, 0x08, 0xa9, 0x22, 0xf3,
-	0xb4, 0x53, 0xbd, 0xf7, 0x2d, 0xbc, 0x7d, 0x6a, 0xdb, 0xea, 0x6b, 0xaa, 0x19, 0x89, 0x71, 0x67,
-	0x44, 0x4b, 0x5a, 0x80, 0x87, 0x0e, 0xd0, 0xa1, 0x7b, 0xbc, 0x1f, 0x36, 0xb4, 0x19, 0xbe, 0x32,
-	0x58, 0xbc, 0x35, 0xfd, 0xb1, 0xef, 0x7c, 0xfc, 0xf5, 0xf9, 0x09, 0x4a, 0x2a, 0x25, 0x39, 0xc3,
-	0x3b, 0x20, 0xb8, 0x14, 0x92, 0xa7, 0x42, 0x9e, 0x2b, 0xf0, 0x5a, 0x07, 0xed, 0x43, 0xf7, 0xf8,
-	0x61, 0xa3, 0x55, 0xdf, 0xd2, 0x2f, 0xe4, 0xb9, 0xaa, 0xfb, 0x6d, 0xc3, 0x6a, 0x1e, 0xc8, 0x5b,
-	0xbc, 0x53, 0x08, 0x00, 0x96, 0xa7, 0xd9, 0x50, 0x0d, 0x2e, 0xc1, 0x6b, 0x1b, 0xd7, 0xb0, 0xd1,
-	0xf5, 0x35, 0x1d, 0x8a, 0x9c, 0x6a, 0x55, 0xbe, 0x34, 0xb2, 0xd8, 0xa8, 0xfe, 0xf1, 0x2f, 0x6a,
-	0x0b, 0xbd, 0xaf, 0x08, 0xbb, 0xb5, 0x46, 0xc8, 0x09, 0xbe, 0x49, 0xf3, 0xbc, 0x64, 0x60, 0xa3,
-	0xd8, 0x8a, 0x1f, 0x7c, 0xfb, 0x12, 0xdc, 0xaf, 0x8a, 0x3d, 0x57, 0x12, 0x98, 0x84, 0x2b, 0x78,
-	0x66, 0x91, 0xbe, 0x2e, 0x85, 0xe4, 0xc9, 0x52, 0x41, 0x24, 0xde, 0x1d, 0x2f, 0xcb, 0xa7, 0xf5,
-	0x30, 0xbc, 0x96, 0x89, 0x35, 0xd8, 0xdc, 0x75, 0x43, 0x28, 0xdd, 0xf1, 0x1a, 0xa0, 0xf7, 0x09,
-	0xe1, 0x3b, 0x6b, 0xf7, 0x7b, 0xbd, 0x6d, 0x9c, 0xfd, 0x9f, 0xf9, 0xa6, 0x3f, 0x59, 0x2b, 0xdd,
-	0x9c, 0xf4, 0x09, 0x76, 0x6b, 0x1c, 0xe9, 0xe2, 0x1b, 0x42, 0xe6, 0x6c, 0x62, 0xfa, 0x6b, 0x27,
-	0x76, 0x40, 0x76, 0x71, 0xc7, 0x8a, 0x4c, 0x62, 0xb7, 0x92, 0x6a, 0x14, 0x9f, 0x4e, 0xe7, 0x3e,
-	0x9a, 0xcd, 0x7d, 0xf4, 0x73, 0xee, 0xa3, 0x0f, 0x0b, 0xdf, 0x99, 0x2d, 0x7c, 0xe7, 0xfb, 0xc2,
-	0x77, 0xde, 0x04, 0x5c, 0xe8, 0x8b, 0xab, 0x2c, 0x1c, 0xa8, 0xa2, 0x3a, 0xf7, 0xd5, 0x2b, 0x80,
-	0xfc, 0x32, 0x9a, 0xac, 0x6e, 0x8e, 0x7e, 0x37, 0x62, 0x90, 0x75, 0xcc, 0x0d, 0x78, 0xfa, 0x3b,
-	0x00, 0x00, 0xff, 0xff, 0xc1, 0xf1, 0x72, 0x89, 0xaf, 0x03, 0x00, 0x00,
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0x8d, 0x9b, 0x34, 0xd0, 0x4b, 0x3b, 0x70, 0x84, 0x60, 0x2a, 0xe1, 0x86, 0x08, 0x50, 0x54,
+	0x29, 0xb6, 0x5a, 0xc6, 0x4e, 0x35, 0x43, 0xc5, 0x50, 0x09, 0x25, 0x85, 0x4a, 0x0c, 0x58, 0xe7,
+	0xf8, 0xea, 0x1e, 0x8d, 0xef, 0x2c, 0x7f, 0x97, 0xb4, 0xfc, 0x0b, 0x7e, 0x46, 0xc5, 0xc4, 0xc0,
+	0xc4, 0x2f, 0xe8, 0x58, 0x31, 0x31, 0x21, 0x94, 0x0c, 0xfc, 0x0d, 0x94, 0x3b, 0xc7, 0x71, 0xa2,
+	0x5a, 0x19, 0x58, 0x12, 0xdf, 0x7d, 0xef, 0xbd, 0xef, 0xbb, 0x77, 0x4f, 0x87, 0x5e, 0xf4, 0x05,
+	0x44, 0x02, 0x1c, 0x18, 0x10, 0x38, 0x67, 0x3c, 0x74, 0x46, 0x7b, 0x3e, 0x95, 0x64, 0xcf, 0x09,
+	0x29, 0xa7, 0xc0, 0xc0, 0x8e, 0x13, 0x21, 0x05, 0x7e, 0xac, 0x61, 0xf6, 0x0c, 0x66, 0xa7, 0xb0,
+	0xed, 0x7a, 0x28, 0x42, 0xa1, 0x30, 0xce, 0xf4, 0x4b, 0xc3, 0xb7, 0x5f, 0x16, 0xa9, 0x66, 0x7c,
+	0x8d, 0x7b, 0xa2, 0x71, 0x9e, 0x16, 0x48, 0x7b, 0xe8, 0xd2, 0x03, 0x12, 0x31, 0x2e, 0x1c, 0xf5,
+	0xab, 0xb7, 0x5a, 0xd7, 0x15, 0xb4, 0x79, 0xa4, 0xc7, 0xea, 0x49, 0x22, 0x29, 0x76, 0x51, 0x35,
+	0x26, 0x09, 0x89, 0xc0, 0x34, 0x9a, 0x46, 0xbb, 0xb6, 0xbf, 0x63, 0x17, 0x8c, 0x69, 0xbf, 0x55,
+	0x30, 0x77, 0xe3, 0xe6, 0xf7, 0x4e, 0xe9, 0xfa, 0xef, 0xb7, 0x5d, 0xa3, 0x9b, 0x32, 0xf1, 0x09,
+	0xda, 0x02, 0x16, 0x72, 0xc6, 0x43, 0x8f, 0xf1, 0x33, 0x01, 0xe6, 0x5a, 0xb3, 0xdc, 0xae, 0xed,
+	0x3f, 0x2f, 0x94, 0xea, 0x69, 0xf4, 0x1b, 0x7e, 0x26, 0xf2, 0x7a, 0x9b, 0x30, 0xdf, 0x07, 0xfc,
+	0x11, 0x6d, 0x45, 0x0c, 0x80, 0x06, 0x9e, 0x3f, 0x10, 0xfd, 0x0b, 0x30, 0xcb, 0x4a, 0xd5, 0x2e,
+	0x54, 0x7d, 0x4f, 0x06, 0x2c, 0x20, 0x52, 0x24, 0xc7, 0x8a, 0xe6, 0x2a, 0xd6, 0x82, 0x7e, 0x94,
+	0x2b, 0xe0, 0x53, 0xd4, 0x20, 0x43, 0x29, 0xbc, 0x21, 0xff, 0x44, 0xd8, 0xc0, 0x1b, 0xcd, 0xd8,
+	0x60, 0x56, 0x9a, 0xe5, 0xf6, 0x86, 0xfb, 0xec, 0xe7, 0xf7, 0xce, 0xd3, 0xb4, 0x57, 0x26, 0x7d,
+	0x18, 0x04, 0x09, 0x05, 0xe8, 0xc9, 0x84, 0xf1, 0xb0, 0x5b, 0x9f, 0x0a, 0xbc, 0x53, 0xfc, 0x0c,
+	0x01, 0xd8, 0x47, 0xf5, 0x98, 0xf2, 0x60, 0x6a, 0x47, 0xae, 0x01, 0x98, 0xeb, 0x6a, 0xfe, 0xdd,
+	0x62, 0x83, 0x35, 0xe9, 0x30, 0xd3, 0x74, 0x2b, 0xd3, 0xd9, 0xbb, 0x38, 0x5e, 0x2e, 0x00, 0x26,
+	0xe8, 0x61, 0x44, 0x18, 0x97, 0x94, 0x13, 0xde, 0xa7, 0xde, 0x25, 0xe3, 0x81, 0xb8, 0x04, 0xb3,
+	0xba, 0xa2, 0xc5, 0xf1, 0x9c, 0x73, 0xaa, 0x28, 0xb3, 0x16, 0xd1, 0x72, 0x01, 0x5a, 0x3f, 0x0c,
+	0x54, 0xcb, 0x5d, 0x14, 0x3e, 0x40, 0xf7, 0x88, 0x3e, 0xbd, 0x8a, 0xca, 0xa2, 0x41, 0xaf, 0x05,
+	0x07, 0xca, 0x61, 0x08, 0x8b, 0x06, 0xcd, 0x18, 0x98, 0xa3, 0x46, 0x66, 0xb0, 0x97, 0x0f, 0x8b,
+	0xb9, 0xa6, 0x62, 0xd7, 0x59, 0x7d, 0xab, 0x05, 0xa1, 0xa9, 0x8f, 0xee, 0x00, 0xb4, 0xbe, 0x1a,
+	0xe8, 0xd1, 0x9d, 0x79, 0xf8, 0xbf, 0x63, 0x9c, 0x2c, 0x67, 0x72, 0x55, 0xd2, 0x73, 0xad, 0x0b,
+	0x93, 0xd8, 0x3a, 0x40, 0xb5, 0x1c, 0x0e, 0xd7, 0xd1, 0x3a, 0xe3, 0x01, 0xbd, 0x52, 0xf3, 0x95,
+	0xbb, 0x7a, 0x81, 0x1b, 0xa8, 0xaa, 0x49, 0xca, 0xb1, 0xfb, 0xdd, 0x74, 0xe5, 0x1e, 0xdd, 0x8c,
+	0x2d, 0xe3, 0x76, 0x6c, 0x19, 0x7f, 0xc6, 0x96, 0xf1, 0x65, 0x62, 0x95, 0x6e, 0x27, 0x56, 0xe9,
+	0xd7, 0xc4, 0x2a, 0x7d, 0xe8, 0x84, 0x4c, 0x9e, 0x0f, 0x7d, 0xbb, 0x2f, 0xa2, 0xf4, 0x5d, 0x48,
+	0xff, 0x3a, 0x10, 0x5c, 0x38, 0x57, 0xf3, 0x97, 0x45, 0x7e, 0x8e, 0x29, 0xf8, 0x55, 0xf5, 0x42,
+	0xbc, 0xfa, 0x37, 0x00, 0x11, 0xb2, 0xca, 0xb6, 0xcf, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -320,6 +354,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaintenanceWindows) > 0 {
+		for iNdEx := len(m.MaintenanceWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaintenanceWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PendingAutoUnjails) > 0 {
+		for iNdEx := len(m.PendingAutoUnjails) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAutoUnjails[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AutoUnjailValidators) > 0 {
+		for iNdEx := len(m.AutoUnjailValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoUnjailValidators[iNdEx])
+			copy(dAtA[i:], m.AutoUnjailValidators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoUnjailValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -514,6 +585,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoUnjailValidators) > 0 {
+		for _, s := range m.AutoUnjailValidators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingAutoUnjails) > 0 {
+		for _, e := range m.PendingAutoUnjails {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MaintenanceWindows) > 0 {
+		for _, e := range m.MaintenanceWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -702,6 +791,106 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUnjailValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoUnjailValidators = append(m.AutoUnjailValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAutoUnjails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAutoUnjails = append(m.PendingAutoUnjails, PendingAutoUnjail{})
+			if err := m.PendingAutoUnjails[len(m.PendingAutoUnjails)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceWindows = append(m.MaintenanceWindows, MaintenanceWindow{})
+			if err := m.MaintenanceWindows[len(m.MaintenanceWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
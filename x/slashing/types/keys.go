@@ -46,12 +46,21 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<valAddrLen (1 Byte)><valAddr_Bytes>: []byte{} (auto unjail opt-in)
+//
+// - 0x05<consAddrLen (1 Byte)><consAddress_Bytes>: PendingAutoUnjail
+//
+// - 0x06<consAddrLen (1 Byte)><consAddress_Bytes>: MaintenanceWindow
 
 var (
 	ParamsKey                           = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = []byte{0x02} // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = []byte{0x03} // Prefix for address-pubkey relation
+	AutoUnjailKeyPrefix                 = []byte{0x04} // Prefix for auto unjail opt-in
+	PendingAutoUnjailKeyPrefix          = []byte{0x05} // Prefix for pending auto unjail
+	MaintenanceWindowKeyPrefix          = []byte{0x06} // Prefix for maintenance window
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -87,3 +96,45 @@ func ValidatorMissedBlockBitmapKey(v sdk.ConsAddress, chunkIndex int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// AutoUnjailKey - stored by *Operator* address
+func AutoUnjailKey(v sdk.ValAddress) []byte {
+	return append(AutoUnjailKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// AutoUnjailAddress - extract the address from an auto unjail opt-in key
+func AutoUnjailAddress(key []byte) (v sdk.ValAddress) {
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+
+	return sdk.ValAddress(addr)
+}
+
+// PendingAutoUnjailKey - stored by *Consensus* address (not operator address)
+func PendingAutoUnjailKey(v sdk.ConsAddress) []byte {
+	return append(PendingAutoUnjailKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// PendingAutoUnjailAddress - extract the address from a pending auto unjail key
+func PendingAutoUnjailAddress(key []byte) (v sdk.ConsAddress) {
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+
+	return sdk.ConsAddress(addr)
+}
+
+// MaintenanceWindowKey - stored by *Consensus* address (not operator address)
+func MaintenanceWindowKey(v sdk.ConsAddress) []byte {
+	return append(MaintenanceWindowKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// MaintenanceWindowAddress - extract the address from a maintenance window key
+func MaintenanceWindowAddress(key []byte) (v sdk.ConsAddress) {
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+
+	return sdk.ConsAddress(addr)
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMaintenanceWindow creates a new MaintenanceWindow instance
+func NewMaintenanceWindow(consAddr sdk.ConsAddress, startHeight, endHeight int64) MaintenanceWindow {
+	return MaintenanceWindow{
+		Address:     consAddr.String(),
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
+// Validate performs a stateless validation of the maintenance window.
+func (w MaintenanceWindow) Validate() error {
+	if w.StartHeight < 0 {
+		return fmt.Errorf("maintenance window start height cannot be negative: %d", w.StartHeight)
+	}
+	if w.EndHeight <= w.StartHeight {
+		return fmt.Errorf("maintenance window end height %d must be greater than its start height %d", w.EndHeight, w.StartHeight)
+	}
+
+	return nil
+}
+
+// IsActive returns true if the downtime of the validator is not accounted at
+// the given height.
+func (w MaintenanceWindow) IsActive(height int64) bool {
+	return height >= w.StartHeight && height < w.EndHeight
+}
+
+// IsExpired returns true if the maintenance window ended at the given height.
+func (w MaintenanceWindow) IsExpired(height int64) bool {
+	return height >= w.EndHeight
+}
+
+// NewPendingAutoUnjail creates a new PendingAutoUnjail instance
+func NewPendingAutoUnjail(consAddr sdk.ConsAddress, unjailHeight int64) PendingAutoUnjail {
+	return PendingAutoUnjail{
+		Address:      consAddr.String(),
+		UnjailHeight: unjailHeight,
+	}
+}
+
+// OnProbation returns true if the validator was automatically unjailed and did
+// not sign a block since.
+func (p PendingAutoUnjail) OnProbation() bool {
+	return p.UnjailHeight > 0
+}
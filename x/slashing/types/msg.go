@@ -8,6 +8,8 @@ import (
 var (
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetAutoUnjail{}
+	_ sdk.Msg = &MsgSetMaintenanceWindow{}
 )
 
 // NewMsgUnjail creates a new MsgUnjail instance
@@ -16,3 +18,11 @@ func NewMsgUnjail(validatorAddr string) *MsgUnjail {
 		ValidatorAddr: validatorAddr,
 	}
 }
+
+// NewMsgSetAutoUnjail creates a new MsgSetAutoUnjail instance
+func NewMsgSetAutoUnjail(validatorAddr string, enabled bool) *MsgSetAutoUnjail {
+	return &MsgSetAutoUnjail{
+		ValidatorAddress: validatorAddr,
+		Enabled:          enabled,
+	}
+}
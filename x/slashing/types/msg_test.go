@@ -22,3 +22,16 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgSetAutoUnjailGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("abcd")
+	msg := NewMsgSetAutoUnjail(sdk.ValAddress(addr).String(), true)
+	pc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	bytes, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`{"type":"cosmos-sdk/x/slashing/MsgSetAutoUnjail","value":{"enabled":true,"validator_address":"cosmosvaloper1v93xxeqhg9nn6"}}`,
+		string(bytes),
+	)
+}
@@ -5,31 +5,47 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Default parameter namespace
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	DefaultAutoUnjailProbationBlocks  = int64(100)
+	DefaultMaxMaintenanceWindowBlocks = int64(14400)
+
+	// MinAutoUnjailProbationBlocks is the minimum probation of an enabled
+	// automatic unjailing. A validator unjailed at height H joins the validator
+	// set, and can thus sign, at H+2 at the earliest (ValidatorUpdateDelay), so
+	// a shorter probation would always expire.
+	MinAutoUnjailProbationBlocks = int64(3)
 )
 
 var (
 	DefaultMinSignedPerWindow      = math.LegacyNewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
 	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+	DefaultAutoUnjailFee           sdk.Coins
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow math.LegacyDec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec,
+	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec, autoUnjailFee sdk.Coins,
+	autoUnjailProbationBlocks, maxMaintenanceWindowBlocks int64,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:         signedBlocksWindow,
+		MinSignedPerWindow:         minSignedPerWindow,
+		DowntimeJailDuration:       downtimeJailDuration,
+		SlashFractionDoubleSign:    slashFractionDoubleSign,
+		SlashFractionDowntime:      slashFractionDowntime,
+		AutoUnjailFee:              autoUnjailFee,
+		AutoUnjailProbationBlocks:  autoUnjailProbationBlocks,
+		MaxMaintenanceWindowBlocks: maxMaintenanceWindowBlocks,
 	}
 }
 
@@ -41,6 +57,9 @@ func DefaultParams() Params {
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
+		DefaultAutoUnjailFee,
+		DefaultAutoUnjailProbationBlocks,
+		DefaultMaxMaintenanceWindowBlocks,
 	)
 }
 
@@ -61,6 +80,15 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateAutoUnjailFee(p.AutoUnjailFee); err != nil {
+		return err
+	}
+	if err := validateAutoUnjailProbationBlocks(p.AutoUnjailProbationBlocks); err != nil {
+		return err
+	}
+	if err := validateMaxMaintenanceWindowBlocks(p.MaxMaintenanceWindowBlocks); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -146,3 +174,42 @@ func validateSlashFractionDowntime(i any) error {
 
 	return nil
 }
+
+func validateAutoUnjailFee(i any) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid auto unjail fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateAutoUnjailProbationBlocks(i any) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != 0 && v < MinAutoUnjailProbationBlocks {
+		return fmt.Errorf("auto unjail probation blocks must be zero (disabled) or at least %d: %d", MinAutoUnjailProbationBlocks, v)
+	}
+
+	return nil
+}
+
+func validateMaxMaintenanceWindowBlocks(i any) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("max maintenance window blocks cannot be negative: %d", v)
+	}
+
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -134,6 +136,16 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// auto_unjail_fee is the fee paid by the operator of a validator automatically
+	// unjailed, once the validator has signed again.
+	AutoUnjailFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=auto_unjail_fee,json=autoUnjailFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"auto_unjail_fee"`
+	// auto_unjail_probation_blocks is the number of blocks within which a validator
+	// automatically unjailed must sign again, or be jailed again. Zero disables
+	// automatic unjailing.
+	AutoUnjailProbationBlocks int64 `protobuf:"varint,7,opt,name=auto_unjail_probation_blocks,json=autoUnjailProbationBlocks,proto3" json:"auto_unjail_probation_blocks,omitempty"`
+	// max_maintenance_window_blocks is the maximum length, in blocks, of the
+	// maintenance windows of the validators. Zero disables maintenance windows.
+	MaxMaintenanceWindowBlocks int64 `protobuf:"varint,8,opt,name=max_maintenance_window_blocks,json=maxMaintenanceWindowBlocks,proto3" json:"max_maintenance_window_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -183,9 +195,155 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetAutoUnjailFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AutoUnjailFee
+	}
+	return nil
+}
+
+func (m *Params) GetAutoUnjailProbationBlocks() int64 {
+	if m != nil {
+		return m.AutoUnjailProbationBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMaintenanceWindowBlocks() int64 {
+	if m != nil {
+		return m.MaxMaintenanceWindowBlocks
+	}
+	return 0
+}
+
+// PendingAutoUnjail defines the automatic unjailing of a validator jailed for
+// downtime, which opted in for automatic unjailing.
+type PendingAutoUnjail struct {
+	// address is the consensus address of the validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// unjail_height is the height at which the validator was automatically
+	// unjailed, or zero if the validator is still jailed. Once unjailed, the
+	// validator is on probation until it signs a block.
+	UnjailHeight int64 `protobuf:"varint,2,opt,name=unjail_height,json=unjailHeight,proto3" json:"unjail_height,omitempty"`
+}
+
+func (m *PendingAutoUnjail) Reset()         { *m = PendingAutoUnjail{} }
+func (m *PendingAutoUnjail) String() string { return proto.CompactTextString(m) }
+func (*PendingAutoUnjail) ProtoMessage()    {}
+func (*PendingAutoUnjail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *PendingAutoUnjail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAutoUnjail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAutoUnjail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingAutoUnjail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAutoUnjail.Merge(m, src)
+}
+func (m *PendingAutoUnjail) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAutoUnjail) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAutoUnjail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAutoUnjail proto.InternalMessageInfo
+
+func (m *PendingAutoUnjail) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PendingAutoUnjail) GetUnjailHeight() int64 {
+	if m != nil {
+		return m.UnjailHeight
+	}
+	return 0
+}
+
+// MaintenanceWindow defines a period during which the downtime of a validator
+// is not accounted.
+type MaintenanceWindow struct {
+	// address is the consensus address of the validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// start_height is the first height of the window.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height at which the window ends, exclusive.
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{3}
+}
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
+func (m *MaintenanceWindow) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*PendingAutoUnjail)(nil), "cosmos.slashing.v1beta1.PendingAutoUnjail")
+	proto.RegisterType((*MaintenanceWindow)(nil), "cosmos.slashing.v1beta1.MaintenanceWindow")
 }
 
 func init() {
@@ -193,47 +351,59 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xee, 0x00, 0xa2, 0x4e, 0xf1, 0xe0, 0x58, 0xa4, 0x54, 0xdd, 0x16, 0x0e, 0xa6, 0x21, 0xe9,
-	0xae, 0x60, 0xe2, 0x01, 0x4f, 0x96, 0xc6, 0xf8, 0x83, 0x44, 0x52, 0xfc, 0x91, 0x78, 0x70, 0x33,
-	0xbb, 0x33, 0x9d, 0x8e, 0xec, 0xce, 0x34, 0x3b, 0xb3, 0x02, 0xff, 0x82, 0x89, 0x09, 0x47, 0x8f,
-	0x1e, 0x39, 0x72, 0xe0, 0x1f, 0xf0, 0xc6, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0xfe, 0x19,
-	0x66, 0x67, 0x76, 0x8b, 0x01, 0x4f, 0x5c, 0x9a, 0xf6, 0xfb, 0xbe, 0xf7, 0xbd, 0x7d, 0xdf, 0x7b,
-	0x5d, 0x78, 0x3f, 0x94, 0x2a, 0x96, 0xca, 0x53, 0x11, 0x56, 0x7d, 0x2e, 0x98, 0xf7, 0x69, 0x31,
-	0xa0, 0x1a, 0x2f, 0x8e, 0x00, 0x77, 0x90, 0x48, 0x2d, 0xd1, 0x8c, 0xd5, 0xb9, 0x23, 0x38, 0xd7,
-	0xd5, 0x2a, 0x4c, 0x32, 0x69, 0x34, 0x5e, 0xf6, 0xcd, 0xca, 0x6b, 0x0e, 0x93, 0x92, 0x45, 0xd4,
-	0x33, 0xbf, 0x82, 0xb4, 0xe7, 0x91, 0x34, 0xc1, 0x9a, 0x4b, 0x91, 0xf3, 0xf5, 0xf3, 0xbc, 0xe6,
-	0x31, 0x55, 0x1a, 0xc7, 0x83, 0x5c, 0x30, 0x6b, 0xfb, 0xf9, 0xd6, 0x39, 0x6f, 0x6e, 0xa9, 0x9b,
-	0x38, 0xe6, 0x42, 0x7a, 0xe6, 0xd3, 0x42, 0xf3, 0xdf, 0xc7, 0x60, 0xe5, 0x2d, 0x8e, 0x38, 0xc1,
-	0x5a, 0x26, 0xeb, 0x9c, 0x09, 0x2e, 0xd8, 0x73, 0xd1, 0x93, 0xe8, 0x31, 0xbc, 0x8a, 0x09, 0x49,
-	0xa8, 0x52, 0x55, 0xd0, 0x00, 0xcd, 0xeb, 0xed, 0xb9, 0xa3, 0xfd, 0xd6, 0xbd, 0xdc, 0x6e, 0x45,
-	0x0a, 0x45, 0x85, 0x4a, 0xd5, 0x13, 0x2b, 0x59, 0xd7, 0x09, 0x17, 0xac, 0x5b, 0x54, 0xa0, 0x39,
-	0x38, 0xa5, 0x34, 0x4e, 0xb4, 0xdf, 0xa7, 0x9c, 0xf5, 0x75, 0x75, 0xac, 0x01, 0x9a, 0xe3, 0xdd,
-	0xb2, 0xc1, 0x9e, 0x19, 0x28, 0x93, 0x70, 0x41, 0xe8, 0x96, 0x2f, 0x7b, 0x3d, 0x45, 0x75, 0x75,
-	0xdc, 0x4a, 0x0c, 0xf6, 0xca, 0x40, 0x68, 0x15, 0x4e, 0x7d, 0xc4, 0x3c, 0xa2, 0xc4, 0x4f, 0x85,
-	0xe6, 0x51, 0x75, 0xa2, 0x01, 0x9a, 0xe5, 0xa5, 0x9a, 0x6b, 0x13, 0x70, 0x8b, 0x04, 0xdc, 0xd7,
-	0x45, 0x02, 0xed, 0x1b, 0x07, 0xc7, 0xf5, 0xd2, 0xce, 0xaf, 0x3a, 0xd8, 0x3d, 0xdd, 0x5b, 0x00,
-	0xdd, 0xb2, 0x2d, 0x7f, 0x93, 0x55, 0x23, 0x07, 0x42, 0x2d, 0xe3, 0x40, 0x69, 0x29, 0x28, 0xa9,
-	0x5e, 0x69, 0x80, 0xe6, 0xb5, 0xee, 0x3f, 0x08, 0x5a, 0x82, 0xd3, 0x31, 0x57, 0x8a, 0x12, 0x3f,
-	0x88, 0x64, 0xb8, 0xa1, 0xfc, 0x50, 0xa6, 0x42, 0xd3, 0xa4, 0x3a, 0x69, 0x9e, 0xec, 0x96, 0x25,
-	0xdb, 0x86, 0x5b, 0xb1, 0xd4, 0xf2, 0xc4, 0x9f, 0x6f, 0x75, 0x30, 0xff, 0x65, 0x02, 0x4e, 0xae,
-	0xe1, 0x04, 0xc7, 0x0a, 0x3d, 0x80, 0x15, 0xc5, 0x99, 0x38, 0x33, 0xd9, 0xe4, 0x82, 0xc8, 0x4d,
-	0x13, 0xe1, 0x78, 0x17, 0x59, 0xce, 0x7a, 0xbc, 0x33, 0x0c, 0xe2, 0x59, 0x5b, 0xe1, 0xe7, 0x55,
-	0x03, 0x9a, 0x14, 0x25, 0x59, 0x66, 0x53, 0xed, 0x47, 0xd9, 0x44, 0x3f, 0x8f, 0xeb, 0x77, 0x6c,
-	0xf2, 0x8a, 0x6c, 0xb8, 0x5c, 0x7a, 0x31, 0xd6, 0x7d, 0x77, 0x95, 0x32, 0x1c, 0x6e, 0x77, 0x68,
-	0x78, 0xb4, 0xdf, 0x82, 0xf9, 0x62, 0x3a, 0x34, 0xb4, 0xa3, 0xa3, 0x98, 0x8b, 0x75, 0xe3, 0xb9,
-	0x46, 0x93, 0xbc, 0xd5, 0x07, 0x78, 0x9b, 0xc8, 0x4d, 0x91, 0x1d, 0x8c, 0x9f, 0x25, 0xe3, 0x17,
-	0xa7, 0x65, 0xc2, 0x2f, 0x2f, 0xcd, 0x5e, 0x48, 0xb6, 0x93, 0x0b, 0x6c, 0xb0, 0x5f, 0x47, 0xc1,
-	0x56, 0x0a, 0x9f, 0x17, 0x98, 0x47, 0x85, 0x08, 0x29, 0x58, 0x33, 0x47, 0xee, 0xf7, 0x12, 0x1c,
-	0x66, 0x88, 0x4f, 0x64, 0x1a, 0x44, 0xd4, 0x0c, 0x67, 0xb6, 0x77, 0xf9, 0x79, 0x66, 0x8c, 0xf3,
-	0xd3, 0xdc, 0xb8, 0x63, 0x7c, 0xb3, 0xf9, 0x90, 0x80, 0x33, 0x17, 0x9a, 0xda, 0x67, 0x33, 0x3b,
-	0xbe, 0x7c, 0xc7, 0xe9, 0x73, 0x1d, 0xad, 0xe9, 0xf2, 0xdc, 0xe7, 0xd3, 0xbd, 0x85, 0xbb, 0x56,
-	0xdc, 0x52, 0x64, 0xc3, 0xdb, 0x3a, 0x7b, 0x03, 0xd8, 0x23, 0x68, 0xbf, 0xdc, 0x1d, 0x3a, 0xe0,
-	0x60, 0xe8, 0x80, 0xc3, 0xa1, 0x03, 0x7e, 0x0f, 0x1d, 0xb0, 0x73, 0xe2, 0x94, 0x0e, 0x4f, 0x9c,
-	0xd2, 0x8f, 0x13, 0xa7, 0xf4, 0xbe, 0xc5, 0xb8, 0xee, 0xa7, 0x81, 0x1b, 0xca, 0x38, 0xff, 0x77,
-	0x7a, 0xff, 0x77, 0xd3, 0xdb, 0x03, 0xaa, 0x82, 0x49, 0xb3, 0x8c, 0x87, 0x7f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xec, 0x7c, 0x0b, 0xf0, 0x6f, 0x04, 0x00, 0x00,
+	// 825 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0xeb, 0x44,
+	0x14, 0x8d, 0x5f, 0xfa, 0xf2, 0xde, 0x9b, 0xa4, 0x42, 0x35, 0x79, 0xd4, 0x0d, 0xaf, 0x4e, 0x1a,
+	0x24, 0x14, 0x55, 0x8a, 0x4d, 0x8b, 0xc4, 0xa2, 0x2c, 0x50, 0xd3, 0xa8, 0xe2, 0xa3, 0x88, 0x28,
+	0xa5, 0x20, 0xb1, 0xc0, 0x1a, 0x7b, 0x26, 0xce, 0x50, 0x7b, 0x26, 0xf2, 0x8c, 0x69, 0xfa, 0x13,
+	0x60, 0x55, 0xb1, 0x42, 0x48, 0x48, 0x2c, 0x2b, 0x56, 0x5d, 0xf4, 0x0f, 0xb0, 0xeb, 0xb2, 0xea,
+	0x0a, 0xb1, 0x68, 0x51, 0xba, 0x28, 0x3f, 0x03, 0x79, 0x66, 0x9c, 0x84, 0x56, 0x62, 0x53, 0xbd,
+	0x4d, 0x3f, 0xee, 0x3d, 0xf7, 0x9c, 0x7b, 0x8f, 0xef, 0x5c, 0xf0, 0x6e, 0xc0, 0x78, 0xcc, 0xb8,
+	0xcb, 0x23, 0xc8, 0x87, 0x84, 0x86, 0xee, 0xf7, 0x1b, 0x3e, 0x16, 0x70, 0x63, 0x1a, 0x70, 0x46,
+	0x09, 0x13, 0xcc, 0x5c, 0x56, 0x38, 0x67, 0x1a, 0xd6, 0xb8, 0x5a, 0x35, 0x64, 0x21, 0x93, 0x18,
+	0x37, 0xfb, 0x4b, 0xc1, 0x6b, 0x76, 0xc8, 0x58, 0x18, 0x61, 0x57, 0xfe, 0xe7, 0xa7, 0x03, 0x17,
+	0xa5, 0x09, 0x14, 0x84, 0x51, 0x9d, 0xaf, 0xdf, 0xcf, 0x0b, 0x12, 0x63, 0x2e, 0x60, 0x3c, 0xd2,
+	0x80, 0x15, 0xa5, 0xe7, 0x29, 0x66, 0x2d, 0xae, 0x52, 0x4b, 0x30, 0x26, 0x94, 0xb9, 0xf2, 0x67,
+	0x2e, 0xa7, 0xa7, 0xf0, 0x21, 0xc7, 0xd3, 0x09, 0x02, 0x46, 0xb4, 0x5c, 0xf3, 0x8f, 0x27, 0xa0,
+	0xfa, 0x15, 0x8c, 0x08, 0x82, 0x82, 0x25, 0xfb, 0x24, 0xa4, 0x84, 0x86, 0x9f, 0xd0, 0x01, 0x33,
+	0x3f, 0x04, 0xcf, 0x20, 0x42, 0x09, 0xe6, 0xdc, 0x32, 0x1a, 0x46, 0xeb, 0x45, 0x67, 0xed, 0xea,
+	0xbc, 0xbd, 0xaa, 0xe5, 0x76, 0x18, 0xe5, 0x98, 0xf2, 0x94, 0x6f, 0x2b, 0xc8, 0xbe, 0x48, 0x08,
+	0x0d, 0xfb, 0x79, 0x85, 0xb9, 0x06, 0x2a, 0x5c, 0xc0, 0x44, 0x78, 0x43, 0x4c, 0xc2, 0xa1, 0xb0,
+	0x9e, 0x34, 0x8c, 0x56, 0xb1, 0x5f, 0x96, 0xb1, 0x8f, 0x65, 0x28, 0x83, 0x10, 0x8a, 0xf0, 0xd8,
+	0x63, 0x83, 0x01, 0xc7, 0xc2, 0x2a, 0x2a, 0x88, 0x8c, 0x7d, 0x21, 0x43, 0xe6, 0x1e, 0xa8, 0x7c,
+	0x07, 0x49, 0x84, 0x91, 0x97, 0x52, 0x41, 0x22, 0x6b, 0xa1, 0x61, 0xb4, 0xca, 0x9b, 0x35, 0x47,
+	0x39, 0xe4, 0xe4, 0x0e, 0x39, 0x5f, 0xe6, 0x0e, 0x75, 0x16, 0x2f, 0xae, 0xeb, 0x85, 0x93, 0x9b,
+	0xba, 0x71, 0x7a, 0x77, 0xb6, 0x6e, 0xf4, 0xcb, 0xaa, 0xfc, 0x20, 0xab, 0x36, 0x6d, 0x00, 0x04,
+	0x8b, 0x7d, 0x2e, 0x18, 0xc5, 0xc8, 0x7a, 0xda, 0x30, 0x5a, 0xcf, 0xfb, 0x73, 0x11, 0x73, 0x13,
+	0xbc, 0x8c, 0x09, 0xe7, 0x18, 0x79, 0x7e, 0xc4, 0x82, 0x43, 0xee, 0x05, 0x2c, 0xa5, 0x02, 0x27,
+	0x56, 0x49, 0x76, 0xf6, 0xa6, 0x4a, 0x76, 0x64, 0x6e, 0x47, 0xa5, 0xb6, 0x16, 0xfe, 0xf9, 0xad,
+	0x6e, 0x34, 0x7f, 0x2d, 0x81, 0x52, 0x0f, 0x26, 0x30, 0xe6, 0xe6, 0x7b, 0xa0, 0xca, 0x49, 0x48,
+	0x67, 0x24, 0x47, 0x84, 0x22, 0x76, 0x24, 0x2d, 0x2c, 0xf6, 0x4d, 0x95, 0x53, 0x1c, 0x5f, 0xcb,
+	0x8c, 0x49, 0x32, 0x59, 0xea, 0xe9, 0xaa, 0x11, 0x4e, 0xf2, 0x92, 0xcc, 0xb3, 0x4a, 0xe7, 0x83,
+	0x6c, 0xa2, 0xbf, 0xae, 0xeb, 0x6f, 0x2b, 0xe7, 0x39, 0x3a, 0x74, 0x08, 0x73, 0x63, 0x28, 0x86,
+	0xce, 0x1e, 0x0e, 0x61, 0x70, 0xdc, 0xc5, 0xc1, 0xd5, 0x79, 0x1b, 0xe8, 0x0f, 0xd3, 0xc5, 0x81,
+	0x1a, 0xdd, 0x8c, 0x09, 0xdd, 0x97, 0x9c, 0x3d, 0x9c, 0x68, 0xa9, 0x6f, 0xc1, 0x5b, 0x88, 0x1d,
+	0xd1, 0x6c, 0xa1, 0xbc, 0xcc, 0x19, 0x2f, 0x5f, 0x3d, 0x69, 0x7e, 0x79, 0x73, 0xe5, 0x81, 0xb3,
+	0x5d, 0x0d, 0x50, 0xc6, 0xfe, 0x3c, 0x35, 0xb6, 0x9a, 0xf3, 0x7c, 0x0a, 0x49, 0x94, 0x83, 0x4c,
+	0x0e, 0x6a, 0xf2, 0x11, 0x78, 0x83, 0x04, 0x06, 0x59, 0xc4, 0x43, 0x2c, 0xf5, 0x23, 0x2c, 0x87,
+	0xb3, 0x16, 0x1e, 0x35, 0xcf, 0xb2, 0x64, 0xde, 0xd5, 0xc4, 0x5d, 0xc9, 0x9b, 0xcd, 0x67, 0x52,
+	0xb0, 0xfc, 0x40, 0x54, 0xf5, 0x66, 0x3d, 0x7d, 0x94, 0xe2, 0xcb, 0x7b, 0x8a, 0x8a, 0xd4, 0xfc,
+	0xc1, 0x00, 0x6f, 0xc0, 0x54, 0x30, 0x2f, 0xa5, 0xd2, 0xc3, 0x01, 0xc6, 0x56, 0xa9, 0x51, 0x94,
+	0xf6, 0x69, 0x8a, 0xec, 0xad, 0xe5, 0x57, 0xc0, 0xd9, 0x61, 0x84, 0x76, 0x76, 0xb3, 0x1e, 0x7e,
+	0xbf, 0xa9, 0xb7, 0x42, 0x22, 0x86, 0xa9, 0xef, 0x04, 0x2c, 0xd6, 0x2f, 0x57, 0xff, 0x6a, 0x73,
+	0x74, 0xe8, 0x8a, 0xe3, 0x11, 0xe6, 0xb2, 0x80, 0xff, 0x72, 0x77, 0xb6, 0x5e, 0x89, 0x64, 0x7b,
+	0x5e, 0xf6, 0x5a, 0xb9, 0xea, 0x69, 0x31, 0x53, 0x3e, 0x90, 0xc2, 0xbb, 0x18, 0x9b, 0x1f, 0x81,
+	0x57, 0xf3, 0xad, 0x8c, 0x12, 0xe6, 0xcb, 0x2f, 0xa1, 0x97, 0xcf, 0x7a, 0x26, 0xb7, 0x6e, 0x65,
+	0x56, 0xd4, 0xcb, 0x11, 0x6a, 0x05, 0xcd, 0x6d, 0xb0, 0x1a, 0xc3, 0xb1, 0x17, 0x43, 0x42, 0x05,
+	0xa6, 0x90, 0x06, 0x58, 0x6f, 0x5f, 0xce, 0xf0, 0x5c, 0x32, 0xd4, 0x62, 0x38, 0xfe, 0x7c, 0x86,
+	0x51, 0xeb, 0xa4, 0x28, 0xb6, 0xd6, 0x7e, 0xbc, 0x3b, 0x5b, 0x7f, 0x35, 0x37, 0xc5, 0x78, 0x76,
+	0x31, 0xd5, 0xa3, 0x68, 0xa6, 0x60, 0xa9, 0x87, 0x29, 0x22, 0x34, 0xdc, 0x9e, 0x76, 0xf2, 0xb8,
+	0xfb, 0xf2, 0x0e, 0x58, 0xd4, 0x33, 0xff, 0xe7, 0xc0, 0x54, 0x54, 0x50, 0x5d, 0x98, 0xe6, 0x4f,
+	0x06, 0x58, 0x7a, 0xd0, 0xf5, 0x6b, 0xbf, 0x6b, 0xab, 0x00, 0x60, 0x8a, 0x72, 0x80, 0xba, 0x6a,
+	0x2f, 0x30, 0x45, 0x2a, 0xdd, 0xf9, 0xec, 0x74, 0x62, 0x1b, 0x17, 0x13, 0xdb, 0xb8, 0x9c, 0xd8,
+	0xc6, 0xdf, 0x13, 0xdb, 0x38, 0xb9, 0xb5, 0x0b, 0x97, 0xb7, 0x76, 0xe1, 0xcf, 0x5b, 0xbb, 0xf0,
+	0x4d, 0xfb, 0x7f, 0xf7, 0x63, 0xce, 0x59, 0xb9, 0x2a, 0x7e, 0x49, 0x3e, 0xd4, 0xf7, 0xff, 0x1d,
+	0x00, 0x0d, 0x45, 0x74, 0x19, 0xab, 0x06, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -309,6 +479,77 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if len(this.AutoUnjailFee) != len(that1.AutoUnjailFee) {
+		return false
+	}
+	for i := range this.AutoUnjailFee {
+		if !this.AutoUnjailFee[i].Equal(&that1.AutoUnjailFee[i]) {
+			return false
+		}
+	}
+	if this.AutoUnjailProbationBlocks != that1.AutoUnjailProbationBlocks {
+		return false
+	}
+	if this.MaxMaintenanceWindowBlocks != that1.MaxMaintenanceWindowBlocks {
+		return false
+	}
+	return true
+}
+func (this *PendingAutoUnjail) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingAutoUnjail)
+	if !ok {
+		that2, ok := that.(PendingAutoUnjail)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.UnjailHeight != that1.UnjailHeight {
+		return false
+	}
+	return true
+}
+func (this *MaintenanceWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MaintenanceWindow)
+	if !ok {
+		that2, ok := that.(MaintenanceWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -394,6 +635,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxMaintenanceWindowBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MaxMaintenanceWindowBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AutoUnjailProbationBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.AutoUnjailProbationBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AutoUnjailFee) > 0 {
+		for iNdEx := len(m.AutoUnjailFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoUnjailFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -440,6 +705,81 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingAutoUnjail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAutoUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAutoUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnjailHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.UnjailHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -495,6 +835,53 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.AutoUnjailFee) > 0 {
+		for _, e := range m.AutoUnjailFee {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	if m.AutoUnjailProbationBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.AutoUnjailProbationBlocks))
+	}
+	if m.MaxMaintenanceWindowBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.MaxMaintenanceWindowBlocks))
+	}
+	return n
+}
+
+func (m *PendingAutoUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.UnjailHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.UnjailHeight))
+	}
+	return n
+}
+
+func (m *MaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.EndHeight))
+	}
 	return n
 }
 
@@ -876,6 +1263,299 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUnjailFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoUnjailFee = append(m.AutoUnjailFee, types.Coin{})
+			if err := m.AutoUnjailFee[len(m.AutoUnjailFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoUnjailProbationBlocks", wireType)
+			}
+			m.AutoUnjailProbationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoUnjailProbationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaintenanceWindowBlocks", wireType)
+			}
+			m.MaxMaintenanceWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMaintenanceWindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAutoUnjail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAutoUnjail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAutoUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailHeight", wireType)
+			}
+			m.UnjailHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
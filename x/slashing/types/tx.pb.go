@@ -201,48 +201,267 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetAutoUnjail defines the Msg/SetAutoUnjail request type
+type MsgSetAutoUnjail struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// enabled opts the validator in or out of automatic unjailing.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoUnjail) Reset()         { *m = MsgSetAutoUnjail{} }
+func (m *MsgSetAutoUnjail) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoUnjail) ProtoMessage()    {}
+func (*MsgSetAutoUnjail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{4}
+}
+func (m *MsgSetAutoUnjail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoUnjail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoUnjail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoUnjail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoUnjail.Merge(m, src)
+}
+func (m *MsgSetAutoUnjail) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoUnjail) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoUnjail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoUnjail proto.InternalMessageInfo
+
+func (m *MsgSetAutoUnjail) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSetAutoUnjail) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgSetAutoUnjailResponse defines the Msg/SetAutoUnjail response type
+type MsgSetAutoUnjailResponse struct {
+}
+
+func (m *MsgSetAutoUnjailResponse) Reset()         { *m = MsgSetAutoUnjailResponse{} }
+func (m *MsgSetAutoUnjailResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoUnjailResponse) ProtoMessage()    {}
+func (*MsgSetAutoUnjailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{5}
+}
+func (m *MsgSetAutoUnjailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoUnjailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoUnjailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoUnjailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoUnjailResponse.Merge(m, src)
+}
+func (m *MsgSetAutoUnjailResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoUnjailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoUnjailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoUnjailResponse proto.InternalMessageInfo
+
+// MsgSetMaintenanceWindow is the Msg/SetMaintenanceWindow request type.
+type MsgSetMaintenanceWindow struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// start_height is the first height of the window.
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height at which the window ends, exclusive.
+	EndHeight int64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *MsgSetMaintenanceWindow) Reset()         { *m = MsgSetMaintenanceWindow{} }
+func (m *MsgSetMaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaintenanceWindow) ProtoMessage()    {}
+func (*MsgSetMaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{6}
+}
+func (m *MsgSetMaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaintenanceWindow.Merge(m, src)
+}
+func (m *MsgSetMaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaintenanceWindow proto.InternalMessageInfo
+
+func (m *MsgSetMaintenanceWindow) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetMaintenanceWindow) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSetMaintenanceWindow) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MsgSetMaintenanceWindow) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// MsgSetMaintenanceWindowResponse defines the response structure for executing
+// a MsgSetMaintenanceWindow message.
+type MsgSetMaintenanceWindowResponse struct {
+}
+
+func (m *MsgSetMaintenanceWindowResponse) Reset()         { *m = MsgSetMaintenanceWindowResponse{} }
+func (m *MsgSetMaintenanceWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaintenanceWindowResponse) ProtoMessage()    {}
+func (*MsgSetMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{7}
+}
+func (m *MsgSetMaintenanceWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaintenanceWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaintenanceWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaintenanceWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaintenanceWindowResponse.Merge(m, src)
+}
+func (m *MsgSetMaintenanceWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaintenanceWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaintenanceWindowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaintenanceWindowResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.slashing.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.slashing.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetAutoUnjail)(nil), "cosmos.slashing.v1beta1.MsgSetAutoUnjail")
+	proto.RegisterType((*MsgSetAutoUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgSetAutoUnjailResponse")
+	proto.RegisterType((*MsgSetMaintenanceWindow)(nil), "cosmos.slashing.v1beta1.MsgSetMaintenanceWindow")
+	proto.RegisterType((*MsgSetMaintenanceWindowResponse)(nil), "cosmos.slashing.v1beta1.MsgSetMaintenanceWindowResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 482 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x19, 0x08, 0xd7, 0x0f, 0x51, 0x27, 0x09, 0x51, 0x17, 0x0f,
-	0x31, 0x00, 0x6a, 0x07, 0x44, 0x0a, 0x6a, 0xa3, 0x7e, 0x6e, 0x31, 0x48, 0x37, 0x88, 0x82, 0x4a,
-	0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0xd2, 0x62, 0x46, 0x2e, 0x4e,
-	0xdf, 0xe2, 0xf4, 0xd0, 0xbc, 0xac, 0xc4, 0xcc, 0x1c, 0xa1, 0x14, 0x2e, 0xbe, 0xb2, 0xc4, 0x9c,
-	0xcc, 0x94, 0xc4, 0x92, 0xfc, 0xa2, 0xf8, 0xc4, 0x94, 0x94, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x4e, 0x27, 0xdb, 0x57, 0xf7, 0xe4, 0xd9, 0x41, 0xfc, 0xd4, 0xe2, 0xe2, 0x4b, 0x5b, 0x74, 0x65,
-	0xa1, 0xd6, 0x85, 0xc1, 0xd4, 0x3a, 0x42, 0xa4, 0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x17, 0x3d,
-	0xdf, 0xa0, 0x05, 0x53, 0xbc, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xde, 0x32, 0x64, 0x85, 0x56,
-	0x06, 0x1d, 0x0b, 0xe4, 0x19, 0x9a, 0x9e, 0x6f, 0xd0, 0x42, 0xb3, 0xac, 0xeb, 0xf9, 0x06, 0x2d,
-	0x11, 0x88, 0xd1, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x70, 0x77, 0x29, 0x09, 0x73, 0x09, 0xc2, 0x39,
-	0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x4a, 0xb7, 0x18, 0xb9, 0xf8, 0x41, 0xa2, 0x05,
-	0x29, 0x89, 0x25, 0xa9, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0xa5,
-	0x25, 0x19, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x50, 0xb7, 0x4b, 0x5c, 0xda, 0xa2, 0x0b, 0x35, 0x55,
-	0x0f, 0xc5, 0x9d, 0x41, 0x08, 0xa5, 0x42, 0x4e, 0x5c, 0x6c, 0x05, 0x60, 0x13, 0x24, 0x98, 0x14,
-	0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf5, 0x70, 0xc4, 0x9a, 0x1e, 0xc4, 0x22, 0x27, 0xce, 0x13, 0xf7,
-	0xe4, 0x19, 0x20, 0xbe, 0x83, 0xea, 0xb4, 0xf2, 0xbe, 0xb4, 0x45, 0x97, 0x1f, 0xe1, 0x7c, 0x05,
-	0x03, 0x3d, 0x13, 0x73, 0x90, 0x2f, 0x11, 0xd6, 0x80, 0x3c, 0xa8, 0x8a, 0xe4, 0xc1, 0x0a, 0x44,
-	0x2c, 0xa3, 0x79, 0x44, 0x49, 0x8f, 0x4b, 0x1c, 0x4d, 0x08, 0xe6, 0x6f, 0x2b, 0x61, 0x2c, 0xf6,
-	0x18, 0x3d, 0x67, 0xe4, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x8a, 0xe0, 0x62, 0x83, 0xc6, 0xa5, 0x12,
-	0x4e, 0x2f, 0xc0, 0x83, 0x52, 0x4a, 0x8b, 0xb0, 0x1a, 0x98, 0xb5, 0x42, 0xf5, 0x5c, 0x3c, 0x28,
-	0x41, 0xad, 0x81, 0x57, 0x2f, 0x92, 0x4a, 0x29, 0x03, 0x62, 0x55, 0xc2, 0xa3, 0x56, 0xf8, 0x14,
-	0xa6, 0x17, 0xa5, 0x58, 0x1b, 0x40, 0xc1, 0xed, 0xe4, 0xbd, 0xe2, 0x91, 0x1c, 0xe3, 0x89, 0x47,
-	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
-	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9,
-	0x25, 0xe7, 0xe7, 0x42, 0x33, 0x85, 0x3e, 0xf6, 0x00, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62,
-	0x03, 0xe7, 0x02, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x4e, 0x1c, 0x28, 0xc7, 0x03,
-	0x00, 0x00,
+	// 679 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x4b, 0x1b, 0x4f,
+	0x18, 0xce, 0x98, 0xdf, 0x4f, 0x9b, 0x51, 0xab, 0xae, 0x82, 0xe9, 0x82, 0x9b, 0xb8, 0x50, 0x49,
+	0x03, 0xd9, 0x8d, 0xf6, 0x93, 0x40, 0x0f, 0xe6, 0x54, 0x90, 0x94, 0x12, 0xe9, 0x07, 0xbd, 0xc8,
+	0xc4, 0x1d, 0x36, 0xdb, 0x26, 0x33, 0x61, 0x67, 0x4c, 0xf5, 0xd4, 0xe2, 0xa9, 0xf4, 0xd4, 0x73,
+	0x4f, 0xa5, 0xbd, 0x78, 0xcc, 0xc1, 0x7b, 0xaf, 0xd2, 0x93, 0x78, 0x2a, 0x2d, 0x48, 0x89, 0x87,
+	0x40, 0xff, 0x8a, 0xb2, 0x3b, 0xb3, 0x1b, 0x37, 0x1f, 0xc6, 0x7a, 0x49, 0xb2, 0xef, 0xfb, 0xbc,
+	0xef, 0xf3, 0x3e, 0xef, 0x47, 0x16, 0xa6, 0xb7, 0x29, 0xab, 0x53, 0x66, 0xb2, 0x1a, 0x62, 0x55,
+	0x87, 0xd8, 0x66, 0x73, 0xb5, 0x82, 0x39, 0x5a, 0x35, 0xf9, 0xae, 0xd1, 0x70, 0x29, 0xa7, 0xca,
+	0xa2, 0x40, 0x18, 0x01, 0xc2, 0x90, 0x08, 0x75, 0xc1, 0xa6, 0x36, 0xf5, 0x31, 0xa6, 0xf7, 0x4b,
+	0xc0, 0xd5, 0x95, 0x61, 0x09, 0xc3, 0x78, 0x81, 0xbb, 0x21, 0x70, 0x5b, 0x22, 0x81, 0xe4, 0x10,
+	0x2e, 0xc9, 0x68, 0xd6, 0x99, 0x17, 0xed, 0x7d, 0x49, 0xc7, 0x1c, 0xaa, 0x3b, 0x84, 0x9a, 0xfe,
+	0xa7, 0x30, 0xe9, 0x5f, 0x01, 0x4c, 0x94, 0x98, 0xfd, 0x94, 0xbc, 0x42, 0x4e, 0x4d, 0xb1, 0xe0,
+	0xf5, 0x26, 0xaa, 0x39, 0x16, 0xe2, 0xd4, 0xdd, 0x42, 0x96, 0xe5, 0x26, 0x41, 0x1a, 0x64, 0x12,
+	0xc5, 0x87, 0x7f, 0x4e, 0x53, 0x13, 0xde, 0x33, 0x66, 0xec, 0xe4, 0x30, 0xb7, 0x24, 0xe9, 0x9e,
+	0x05, 0xd8, 0x75, 0xe1, 0xda, 0xe4, 0xae, 0x43, 0xec, 0x2f, 0x9d, 0x56, 0x36, 0x00, 0x1f, 0x74,
+	0x5a, 0x59, 0x50, 0x9e, 0x6e, 0x9e, 0x07, 0x16, 0xf2, 0xef, 0x3f, 0xa7, 0x62, 0xfb, 0x9d, 0x56,
+	0xb6, 0x87, 0xec, 0x43, 0xa7, 0x95, 0x5d, 0x10, 0xa9, 0x73, 0xcc, 0x7a, 0x6d, 0x86, 0x75, 0xe9,
+	0xf3, 0x70, 0x2e, 0x7c, 0x28, 0x63, 0xd6, 0xa0, 0x84, 0x61, 0xfd, 0x27, 0x80, 0x33, 0x9e, 0xb5,
+	0x61, 0x21, 0x8e, 0x9f, 0x20, 0x17, 0xd5, 0x99, 0x72, 0x0f, 0x26, 0xd0, 0x0e, 0xaf, 0x52, 0xd7,
+	0xe1, 0x7b, 0xb2, 0xf6, 0xe4, 0xc9, 0x61, 0x4e, 0x66, 0x35, 0x22, 0x75, 0x96, 0xbb, 0x50, 0xa5,
+	0x08, 0xc7, 0x1b, 0x7e, 0x86, 0xe4, 0x58, 0x1a, 0x64, 0x26, 0xd7, 0x52, 0xc6, 0x90, 0xa9, 0x19,
+	0x82, 0xa8, 0x98, 0x38, 0x3a, 0x4d, 0xc5, 0x84, 0x3a, 0x19, 0x59, 0xd8, 0x38, 0x39, 0xcc, 0xcd,
+	0x74, 0xcb, 0x4f, 0xe7, 0x8d, 0x3b, 0xf7, 0x3d, 0x95, 0x5d, 0x1a, 0x4f, 0xe0, 0xcd, 0x73, 0x02,
+	0x77, 0xbb, 0x53, 0xee, 0x11, 0xa2, 0x1b, 0x70, 0xb1, 0xc7, 0x14, 0xe8, 0x2e, 0xcc, 0x0f, 0xe0,
+	0xd1, 0xbf, 0x01, 0x38, 0x5b, 0x62, 0xf6, 0x26, 0xe6, 0xeb, 0x3b, 0x9c, 0xca, 0x71, 0x3e, 0x86,
+	0x73, 0xd1, 0x0e, 0x63, 0xc6, 0x64, 0x57, 0x96, 0x47, 0x8e, 0xb1, 0x3c, 0xdb, 0xec, 0xb1, 0x2b,
+	0x49, 0x38, 0x81, 0x09, 0xaa, 0xd4, 0xb0, 0xe5, 0xb7, 0xe9, 0x5a, 0x39, 0x78, 0x2c, 0xac, 0x7b,
+	0x42, 0xfb, 0xc9, 0x3c, 0xc1, 0x2b, 0x43, 0x05, 0x47, 0x8a, 0xd5, 0x55, 0x98, 0xec, 0xb5, 0x85,
+	0xa3, 0xfe, 0x34, 0xe6, 0xb7, 0x63, 0x13, 0xf3, 0x12, 0x72, 0x08, 0xc7, 0x04, 0x91, 0x6d, 0xfc,
+	0xdc, 0x21, 0x16, 0x7d, 0x73, 0xe5, 0x91, 0x0f, 0x6c, 0xce, 0xd8, 0xd5, 0x9b, 0xb3, 0x0c, 0xa7,
+	0x18, 0x47, 0x2e, 0xdf, 0xaa, 0x62, 0xc7, 0xae, 0xf2, 0x64, 0x3c, 0x0d, 0x32, 0xf1, 0xf2, 0xa4,
+	0x6f, 0x7b, 0xe4, 0x9b, 0x94, 0x25, 0x08, 0x31, 0xb1, 0x02, 0xc0, 0x7f, 0x3e, 0x20, 0x81, 0x89,
+	0x25, 0xdc, 0x85, 0xbb, 0xfd, 0xdb, 0xa2, 0x47, 0xcf, 0x61, 0x50, 0x03, 0xf4, 0x65, 0x98, 0x1a,
+	0xe2, 0x0a, 0xfa, 0xb7, 0xf6, 0x2b, 0x0e, 0xe3, 0x25, 0x66, 0x2b, 0x2f, 0xe0, 0xb8, 0x5c, 0x0d,
+	0x7d, 0xe8, 0x82, 0x87, 0x87, 0xa6, 0x66, 0x47, 0x63, 0x02, 0x06, 0xe5, 0x2d, 0x9c, 0x8a, 0x1c,
+	0x62, 0xe6, 0xc2, 0xd8, 0x73, 0x48, 0x35, 0x7f, 0x59, 0x64, 0xb8, 0x0d, 0xf3, 0xdf, 0xfb, 0x0f,
+	0x40, 0xa9, 0xc3, 0xe9, 0xe8, 0xf2, 0xdf, 0xba, 0x28, 0x6f, 0x04, 0xaa, 0xae, 0x5e, 0x1a, 0x1a,
+	0xea, 0xdd, 0x07, 0x70, 0x61, 0xe0, 0x3a, 0xe6, 0x47, 0xe4, 0xea, 0x8b, 0x50, 0x1f, 0xfc, 0x6b,
+	0x44, 0x50, 0x84, 0xfa, 0xff, 0x3b, 0xef, 0x0f, 0xa8, 0xb8, 0x71, 0xd0, 0xd6, 0xc0, 0x51, 0x5b,
+	0x03, 0xc7, 0x6d, 0x0d, 0xfc, 0x6e, 0x6b, 0xe0, 0xe3, 0x99, 0x16, 0x3b, 0x3e, 0xd3, 0x62, 0x3f,
+	0xce, 0xb4, 0xd8, 0xcb, 0x9c, 0xed, 0xf0, 0xea, 0x4e, 0xc5, 0xd8, 0xa6, 0x75, 0xf9, 0x9a, 0x30,
+	0x07, 0x5f, 0x24, 0xdf, 0x6b, 0x60, 0x56, 0x19, 0xf7, 0xdf, 0x0b, 0xb7, 0xff, 0x0e, 0x00, 0xad,
+	0x43, 0x7b, 0xe5, 0xd9, 0x06, 0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -338,6 +557,108 @@ func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSetAutoUnjail) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoUnjail)
+	if !ok {
+		that2, ok := that.(MsgSetAutoUnjail)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
+func (this *MsgSetAutoUnjailResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoUnjailResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoUnjailResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgSetMaintenanceWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetMaintenanceWindow)
+	if !ok {
+		that2, ok := that.(MsgSetMaintenanceWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	return true
+}
+func (this *MsgSetMaintenanceWindowResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetMaintenanceWindowResponse)
+	if !ok {
+		that2, ok := that.(MsgSetMaintenanceWindowResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -358,6 +679,13 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetAutoUnjail defines a method for a validator to opt in or out of the
+	// automatic unjailing after a downtime jailing.
+	SetAutoUnjail(ctx context.Context, in *MsgSetAutoUnjail, opts ...grpc.CallOption) (*MsgSetAutoUnjailResponse, error)
+	// SetMaintenanceWindow defines a governance operation for pausing the
+	// downtime accounting of a validator for a bounded period. The authority
+	// defaults to the x/gov module account.
+	SetMaintenanceWindow(ctx context.Context, in *MsgSetMaintenanceWindow, opts ...grpc.CallOption) (*MsgSetMaintenanceWindowResponse, error)
 }
 
 type msgClient struct {
@@ -386,6 +714,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetAutoUnjail(ctx context.Context, in *MsgSetAutoUnjail, opts ...grpc.CallOption) (*MsgSetAutoUnjailResponse, error) {
+	out := new(MsgSetAutoUnjailResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/SetAutoUnjail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaintenanceWindow(ctx context.Context, in *MsgSetMaintenanceWindow, opts ...grpc.CallOption) (*MsgSetMaintenanceWindowResponse, error) {
+	out := new(MsgSetMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/SetMaintenanceWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
@@ -395,6 +741,13 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetAutoUnjail defines a method for a validator to opt in or out of the
+	// automatic unjailing after a downtime jailing.
+	SetAutoUnjail(context.Context, *MsgSetAutoUnjail) (*MsgSetAutoUnjailResponse, error)
+	// SetMaintenanceWindow defines a governance operation for pausing the
+	// downtime accounting of a validator for a bounded period. The authority
+	// defaults to the x/gov module account.
+	SetMaintenanceWindow(context.Context, *MsgSetMaintenanceWindow) (*MsgSetMaintenanceWindowResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -407,6 +760,12 @@ func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*Msg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoUnjail(ctx context.Context, req *MsgSetAutoUnjail) (*MsgSetAutoUnjailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoUnjail not implemented")
+}
+func (*UnimplementedMsgServer) SetMaintenanceWindow(ctx context.Context, req *MsgSetMaintenanceWindow) (*MsgSetMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceWindow not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -448,6 +807,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoUnjail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoUnjail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoUnjail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/SetAutoUnjail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoUnjail(ctx, req.(*MsgSetAutoUnjail))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaintenanceWindow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/SetMaintenanceWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaintenanceWindow(ctx, req.(*MsgSetMaintenanceWindow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
@@ -461,6 +856,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetAutoUnjail",
+			Handler:    _Msg_SetAutoUnjail_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceWindow",
+			Handler:    _Msg_SetMaintenanceWindow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -582,15 +985,148 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetAutoUnjail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoUnjailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoUnjailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaintenanceWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaintenanceWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaintenanceWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *MsgUnjail) Size() (n int) {
@@ -639,6 +1175,63 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAutoUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoUnjailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *MsgSetMaintenanceWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -942,6 +1535,360 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAutoUnjail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoUnjail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoUnjailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoUnjailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMaintenanceWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaintenanceWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMaintenanceWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaintenanceWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaintenanceWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -137,6 +137,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod: "TokenizedShares",
+					Use:       "tokenized-shares [validator-addr]",
+					Short:     "Query the share tokens of a validator",
+					Long:      "Query the supply of the share tokens of a validator and the delegation backing them.",
+					Example:   fmt.Sprintf("$ %s query staking tokenized-shares cosmosvaloper...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the amount of tokens tokenized into share tokens",
+				},
+				{
+					RpcMethod: "ScheduledCommissionChange",
+					Use:       "scheduled-commission-change [validator-addr]",
+					Short:     "Query the commission change scheduled for a validator",
+					Example:   fmt.Sprintf("$ %s query staking scheduled-commission-change cosmosvaloper...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -189,6 +213,36 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking rotate-cons-pub-key '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}' --from myvalidator`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "new_pubkey"}},
 				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-shares [validator-addr] [amount] --from [delegator_address]",
+					Short:          "Tokenize a part of a delegation into share tokens",
+					Long:           "Move an amount of a delegation into the custody delegation of the validator and receive fungible share tokens in return.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-shares cosmosvaloper... 100stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens [amount] --from [delegator_address]",
+					Short:          "Redeem share tokens for a delegation",
+					Long:           "Burn share tokens and receive the corresponding delegation to the validator.",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100share/cosmosvaloper... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ScheduleCommissionChange",
+					Use:            "schedule-commission-change [rate] [effective-time]",
+					Short:          "Schedule a change of the validator commission rate",
+					Long:           "Schedule a change of the validator commission rate taking effect at the given time, replacing any change previously scheduled. The change is checked again against the validator commission when it takes effect.",
+					Example:        fmt.Sprintf(`%s tx staking schedule-commission-change 0.05 2030-01-01T00:00:00Z --from myvalidator`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "rate"}, {ProtoField: "effective_time"}},
+				},
+				{
+					RpcMethod: "CancelCommissionChange",
+					Use:       "cancel-commission-change",
+					Short:     "Cancel the commission change scheduled for the validator",
+					Example:   fmt.Sprintf(`%s tx staking cancel-commission-change --from myvalidator`, version.AppName),
+				},
 			},
 			EnhanceCustomCommand: false, // use custom commands only until v0.51
 		},
@@ -5,6 +5,8 @@ import (
 
 	cmttypes "github.com/cometbft/cometbft/types"
 
+	"cosmossdk.io/math"
+
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -68,9 +70,29 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateCommissionChanges(data.ScheduledCommissionChanges); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateCommissionChanges(changes []types.CommissionChange) error {
+	seen := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		if seen[change.ValidatorAddress] {
+			return fmt.Errorf("duplicate scheduled commission change for validator %s", change.ValidatorAddress)
+		}
+		seen[change.ValidatorAddress] = true
+
+		if change.Rate.IsNil() || change.Rate.IsNegative() || change.Rate.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("invalid scheduled commission rate %s for validator %s", change.Rate, change.ValidatorAddress)
+		}
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -9,10 +9,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// BeginBlocker will persist the current header and validator set as a historical entry
-// and prune the oldest entry based on the HistoricalEntries parameter
+// BeginBlocker will apply the scheduled commission changes which took effect,
+// persist the current header and validator set as a historical entry and prune
+// the oldest entry based on the HistoricalEntries parameter
 func (k *Keeper) BeginBlocker(ctx context.Context) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker) //nolint:staticcheck // TODO: switch to OpenTelemetry
+	if err := k.ApplyCommissionChanges(ctx); err != nil {
+		return err
+	}
 	return k.TrackHistoricalInfo(ctx)
 }
 
@@ -37,6 +37,35 @@ func (k Keeper) IterateValidators(ctx context.Context, fn func(index int64, vali
 	return nil
 }
 
+// IterateValidatorsFrom iterates through the validator set starting at the
+// given store key, or at the first validator if start is empty. The function
+// receives the store key of each validator, which can be passed back as start
+// to resume the iteration.
+func (k Keeper) IterateValidatorsFrom(ctx context.Context, start []byte, fn func(key []byte, validator types.ValidatorI) (stop bool)) error {
+	if len(start) == 0 {
+		start = types.ValidatorsKey
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(start, storetypes.PrefixEndBytes(types.ValidatorsKey))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator, err := types.UnmarshalValidator(k.cdc, iterator.Value())
+		if err != nil {
+			return err
+		}
+		if stop := fn(iterator.Key(), validator); stop {
+			break
+		}
+	}
+
+	return nil
+}
+
 // IterateBondedValidatorsByPower iterates through the bonded validator set and perform the provided function
 func (k Keeper) IterateBondedValidatorsByPower(ctx context.Context, fn func(index int64, validator types.ValidatorI) (stop bool)) error {
 	store := k.storeService.OpenKVStore(ctx)
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetCommissionChange returns the commission change scheduled for a validator.
+func (k Keeper) GetCommissionChange(ctx context.Context, valAddr sdk.ValAddress) (change types.CommissionChange, found bool, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetValidatorCommissionChangeKey(valAddr))
+	if err != nil || bz == nil {
+		return change, false, err
+	}
+
+	if err := k.cdc.Unmarshal(bz, &change); err != nil {
+		return change, false, err
+	}
+	return change, true, nil
+}
+
+// SetCommissionChange schedules a commission change for a validator, replacing
+// any change previously scheduled.
+func (k Keeper) SetCommissionChange(ctx context.Context, valAddr sdk.ValAddress, change types.CommissionChange) error {
+	if err := k.DeleteCommissionChange(ctx, valAddr); err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&change)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetValidatorCommissionChangeKey(valAddr), bz); err != nil {
+		return err
+	}
+	return store.Set(types.GetCommissionChangeQueueKey(change.EffectiveTime, valAddr), []byte{})
+}
+
+// DeleteCommissionChange removes the commission change scheduled for a
+// validator, if any.
+func (k Keeper) DeleteCommissionChange(ctx context.Context, valAddr sdk.ValAddress) error {
+	change, found, err := k.GetCommissionChange(ctx, valAddr)
+	if err != nil || !found {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetCommissionChangeQueueKey(change.EffectiveTime, valAddr)); err != nil {
+		return err
+	}
+	return store.Delete(types.GetValidatorCommissionChangeKey(valAddr))
+}
+
+// IterateCommissionChanges iterates over the scheduled commission changes.
+func (k Keeper) IterateCommissionChanges(ctx context.Context, cb func(change types.CommissionChange) (stop bool, err error)) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.ValidatorCommissionChangeKey, storetypes.PrefixEndBytes(types.ValidatorCommissionChangeKey))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.CommissionChange
+		if err := k.cdc.Unmarshal(iterator.Value(), &change); err != nil {
+			return err
+		}
+
+		stop, err := cb(change)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+
+	return nil
+}
+
+// ApplyCommissionChanges applies the scheduled commission changes which took
+// effect at the current block time. A change which no longer satisfies the
+// commission constraints of the validator is dropped and a
+// commission_change_failed event is emitted instead.
+func (k Keeper) ApplyCommissionChanges(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valAddrs, err := k.dueCommissionChanges(ctx, sdkCtx.BlockHeader().Time)
+	if err != nil {
+		return err
+	}
+
+	for _, valAddr := range valAddrs {
+		change, found, err := k.GetCommissionChange(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		if err := k.DeleteCommissionChange(ctx, valAddr); err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.applyCommissionChange(cacheCtx, valAddr, change); err != nil {
+			if !errors.Is(err, types.ErrNoValidatorFound) {
+				sdkCtx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeCommissionChangeFailed,
+						sdk.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+						sdk.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+						sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+					),
+				)
+			}
+			continue
+		}
+		write()
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommissionChange,
+				sdk.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// applyCommissionChange updates the commission rate of a validator to the
+// scheduled rate.
+func (k Keeper) applyCommissionChange(ctx context.Context, valAddr sdk.ValAddress, change types.CommissionChange) error {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	commission, err := k.UpdateValidatorCommission(ctx, validator, change.Rate)
+	if err != nil {
+		return err
+	}
+
+	// call the before-modification hook since we're about to update the commission
+	if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+		return err
+	}
+
+	validator.Commission = commission
+	return k.SetValidator(ctx, validator)
+}
+
+// dueCommissionChanges walks the commission change queue up to blockTime and
+// returns the validators whose scheduled change took effect.
+func (k Keeper) dueCommissionChanges(ctx context.Context, blockTime time.Time) (valAddrs []sdk.ValAddress, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(
+		types.CommissionChangeQueueKey,
+		storetypes.PrefixEndBytes(types.GetCommissionChangeQueueTimePrefix(blockTime)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, iterator.Close())
+	}()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, valAddr, err := types.ParseCommissionChangeQueueKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		valAddrs = append(valAddrs, valAddr)
+	}
+	return valAddrs, nil
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestCommissionChanges() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	blockTime := ctx.BlockTime()
+	valAddr := sdk.ValAddress(PKs[0].Address())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator.Commission = stakingtypes.NewCommissionWithTime(
+		math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(1, 1), blockTime.Add(-48*time.Hour),
+	)
+	require.NoError(keeper.SetValidator(ctx, validator))
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.MinCommissionChangeNotice = time.Hour
+	require.NoError(keeper.SetParams(ctx, params))
+
+	testCases := []struct {
+		name   string
+		msg    *stakingtypes.MsgScheduleCommissionChange
+		expErr error
+	}{
+		{
+			name:   "effective time not in the future",
+			msg:    stakingtypes.NewMsgScheduleCommissionChange(validator.OperatorAddress, math.LegacyNewDecWithPrec(2, 1), blockTime),
+			expErr: stakingtypes.ErrInvalidCommissionChangeTime,
+		},
+		{
+			name:   "effective time before the minimum notice",
+			msg:    stakingtypes.NewMsgScheduleCommissionChange(validator.OperatorAddress, math.LegacyNewDecWithPrec(2, 1), blockTime.Add(30*time.Minute)),
+			expErr: stakingtypes.ErrInvalidCommissionChangeTime,
+		},
+		{
+			name:   "rate above the max rate",
+			msg:    stakingtypes.NewMsgScheduleCommissionChange(validator.OperatorAddress, math.LegacyNewDecWithPrec(6, 1), blockTime.Add(time.Hour)),
+			expErr: stakingtypes.ErrCommissionGTMaxRate,
+		},
+		{
+			name:   "change above the max change rate",
+			msg:    stakingtypes.NewMsgScheduleCommissionChange(validator.OperatorAddress, math.LegacyNewDecWithPrec(3, 1), blockTime.Add(time.Hour)),
+			expErr: stakingtypes.ErrCommissionGTMaxChangeRate,
+		},
+		{
+			name:   "unknown validator",
+			msg:    stakingtypes.NewMsgScheduleCommissionChange(sdk.ValAddress(PKs[1].Address()).String(), math.LegacyNewDecWithPrec(2, 1), blockTime.Add(time.Hour)),
+			expErr: stakingtypes.ErrNoValidatorFound,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := msgServer.ScheduleCommissionChange(ctx, tc.msg)
+			require.ErrorIs(err, tc.expErr)
+		})
+	}
+
+	_, err = msgServer.CancelCommissionChange(ctx, stakingtypes.NewMsgCancelCommissionChange(validator.OperatorAddress))
+	require.ErrorIs(err, stakingtypes.ErrNoCommissionChangeScheduled)
+
+	// scheduling again replaces the previous change
+	_, err = msgServer.ScheduleCommissionChange(ctx, stakingtypes.NewMsgScheduleCommissionChange(validator.OperatorAddress, math.LegacyNewDecWithPrec(15, 2), blockTime.Add(time.Hour)))
+	require.NoError(err)
+	_, err = msgServer.ScheduleCommissionChange(ctx, stakingtypes.NewMsgScheduleCommissionChange(validator.OperatorAddress, math.LegacyNewDecWithPrec(2, 1), blockTime.Add(2*time.Hour)))
+	require.NoError(err)
+
+	res, err := s.queryClient.ScheduledCommissionChange(ctx, &stakingtypes.QueryScheduledCommissionChangeRequest{ValidatorAddr: validator.OperatorAddress})
+	require.NoError(err)
+	require.NotNil(res.Change)
+	require.Equal(math.LegacyNewDecWithPrec(2, 1), res.Change.Rate)
+	require.True(blockTime.Add(2 * time.Hour).Equal(res.Change.EffectiveTime))
+
+	// the change is not applied before its effective time
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour))
+	require.NoError(keeper.BeginBlocker(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	ctx = ctx.WithBlockTime(blockTime.Add(2 * time.Hour))
+	require.NoError(keeper.BeginBlocker(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(2, 1), validator.Commission.Rate)
+	require.True(ctx.BlockTime().Equal(validator.Commission.UpdateTime))
+
+	_, found, err := keeper.GetCommissionChange(ctx, valAddr)
+	require.NoError(err)
+	require.False(found)
+
+	// a change which no longer satisfies the commission constraints is dropped
+	_, err = msgServer.ScheduleCommissionChange(ctx, stakingtypes.NewMsgScheduleCommissionChange(validator.OperatorAddress, math.LegacyNewDecWithPrec(25, 2), ctx.BlockTime().Add(time.Hour)))
+	require.NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(keeper.BeginBlocker(ctx))
+	validator, err = keeper.GetValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(2, 1), validator.Commission.Rate)
+
+	var failed bool
+	for _, event := range ctx.EventManager().Events() {
+		failed = failed || event.Type == stakingtypes.EventTypeCommissionChangeFailed
+	}
+	require.True(failed)
+
+	_, found, err = keeper.GetCommissionChange(ctx, valAddr)
+	require.NoError(err)
+	require.False(found)
+
+	// a scheduled change can be cancelled
+	_, err = msgServer.ScheduleCommissionChange(ctx, stakingtypes.NewMsgScheduleCommissionChange(validator.OperatorAddress, math.LegacyNewDecWithPrec(25, 2), ctx.BlockTime().Add(time.Hour)))
+	require.NoError(err)
+	_, err = msgServer.CancelCommissionChange(ctx, stakingtypes.NewMsgCancelCommissionChange(validator.OperatorAddress))
+	require.NoError(err)
+
+	res, err = s.queryClient.ScheduledCommissionChange(ctx, &stakingtypes.QueryScheduledCommissionChangeRequest{ValidatorAddr: validator.OperatorAddress})
+	require.NoError(err)
+	require.Nil(res.Change)
+}
@@ -264,6 +264,36 @@ func (k Keeper) IterateUnbondingDelegations(ctx context.Context, fn func(index i
 	return nil
 }
 
+// IterateUnbondingDelegationsFrom iterates through the unbonding delegations
+// starting at the given store key, or at the first one if start is empty. The
+// function receives the store key of each unbonding delegation, which can be
+// passed back as start to resume the iteration.
+func (k Keeper) IterateUnbondingDelegationsFrom(ctx context.Context, start []byte, fn func(key []byte, ubd types.UnbondingDelegation) (stop bool)) error {
+	prefix := types.UnbondingDelegationKey
+	if len(start) == 0 {
+		start = prefix
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(start, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		ubd, err := types.UnmarshalUBD(k.cdc, iterator.Value())
+		if err != nil {
+			return err
+		}
+		if stop := fn(iterator.Key(), ubd); stop {
+			break
+		}
+	}
+
+	return nil
+}
+
 // GetDelegatorUnbonding returns the total amount a delegator has unbonding.
 func (k Keeper) GetDelegatorUnbonding(ctx context.Context, delegator sdk.AccAddress) (math.Int, error) {
 	unbonding := math.ZeroInt()
@@ -297,6 +297,41 @@ func (s *KeeperTestSuite) TestUnbondingDelegation() {
 	require.Equal(0, len(resUnbonds))
 }
 
+func (s *KeeperTestSuite) TestIterateUnbondingDelegationsFrom() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(3)
+
+	for i := range delAddrs {
+		ubd := stakingtypes.NewUnbondingDelegation(
+			delAddrs[i],
+			valAddrs[0],
+			0,
+			time.Unix(0, 0).UTC(),
+			math.NewInt(int64(i+1)),
+			0,
+			address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos"),
+		)
+		require.NoError(keeper.SetUnbondingDelegation(ctx, ubd))
+	}
+
+	// stop at the third unbonding delegation and resume from it
+	var keys [][]byte
+	require.NoError(keeper.IterateUnbondingDelegationsFrom(ctx, nil, func(key []byte, _ stakingtypes.UnbondingDelegation) bool {
+		keys = append(keys, append([]byte{}, key...))
+		return len(keys) == 3
+	}))
+	require.Len(keys, 3)
+
+	var resumed [][]byte
+	require.NoError(keeper.IterateUnbondingDelegationsFrom(ctx, keys[2], func(key []byte, _ stakingtypes.UnbondingDelegation) bool {
+		resumed = append(resumed, append([]byte{}, key...))
+		return false
+	}))
+	require.Equal(keys[2:], resumed)
+}
+
 func (s *KeeperTestSuite) TestUnbondingDelegationsFromValidator() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -105,6 +105,13 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 			panic(err)
 		}
 
+		// rebuild the index of the validators having tokenized shares
+		if sdk.AccAddress(delegatorAddress).Equals(types.TokenizedShareCustodyAddress(valAddr)) {
+			if err := k.storeService.OpenKVStore(ctx).Set(types.GetTokenizedShareValidatorKey(valAddr), []byte{}); err != nil {
+				panic(err)
+			}
+		}
+
 		// Call the after-modification hook if not exported
 		if !data.Exported {
 			if err := k.Hooks().AfterDelegationModified(ctx, delegatorAddress, valAddr); err != nil {
@@ -185,6 +192,17 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		panic(err)
 	}
 
+	for _, change := range data.ScheduledCommissionChanges {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(change.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetCommissionChange(ctx, valAddr, change); err != nil {
+			panic(err)
+		}
+	}
+
 	// don't need to run CometBFT updates if we exported
 	if data.Exported {
 		pendingRotations, err := k.PendingConsKeyRotations(ctx)
@@ -297,6 +315,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	var scheduledCommissionChanges []types.CommissionChange
+	err = k.IterateCommissionChanges(ctx, func(change types.CommissionChange) (bool, error) {
+		scheduledCommissionChanges = append(scheduledCommissionChanges, change)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		Params:                       params,
 		LastTotalPower:               totalPower,
@@ -308,5 +335,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		Exported:                     true,
 		ConsensusKeyRotationHistory:  consKeyRotationHistory,
 		PendingConsensusKeyRotations: pendingConsKeyRotations,
+		ScheduledCommissionChanges:   scheduledCommissionChanges,
 	}
 }
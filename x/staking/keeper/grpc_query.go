@@ -497,6 +497,67 @@ func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizedShares queries the share tokens of a validator and the delegation
+// backing them
+func (k Querier) TokenizedShares(ctx context.Context, req *types.QueryTokenizedSharesRequest) (*types.QueryTokenizedSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	shares, err := k.custodyShares(ctx, types.TokenizedShareCustodyAddress(valAddr), valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := k.tokenizedShareTokens(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTokenizedSharesResponse{
+		Supply: k.bankKeeper.GetSupply(ctx, types.TokenizedShareDenom(req.ValidatorAddr)),
+		Shares: shares,
+		Tokens: tokens,
+	}, nil
+}
+
+// TotalLiquidStaked queries the amount of tokens tokenized into share tokens
+func (k Querier) TotalLiquidStaked(ctx context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	tokens, err := k.Keeper.TotalLiquidStaked(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: tokens}, nil
+}
+
+// ScheduledCommissionChange queries the commission change scheduled for a validator
+func (k Querier) ScheduledCommissionChange(ctx context.Context, req *types.QueryScheduledCommissionChangeRequest) (*types.QueryScheduledCommissionChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	change, found, err := k.GetCommissionChange(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return &types.QueryScheduledCommissionChangeResponse{}, nil
+	}
+
+	return &types.QueryScheduledCommissionChangeResponse{Change: &change}, nil
+}
+
 func queryRedelegation(ctx context.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	v7 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v7"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -22,3 +23,10 @@ func NewMigrator(keeper *Keeper) Migrator {
 func (m Migrator) Migrate5to6(ctx sdk.Context) error {
 	return v6.Migrate(ctx, m.keeper)
 }
+
+// Migrate6to7 migrates the x/staking module state from consensus version 6 to
+// version 7. Specifically, it adds the liquid staking cap and the minimum
+// commission change notice params.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.Migrate(ctx, m.keeper)
+}
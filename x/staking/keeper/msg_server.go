@@ -740,3 +740,190 @@ func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateCon
 
 	return &types.MsgRotateConsPubKeyResponse{}, nil
 }
+
+// TokenizeShares defines a method for tokenizing a part of a delegation into
+// fungible share tokens.
+func (k msgServer) TokenizeShares(ctx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	ctx = types.WithStrictWithdraw(ctx)
+	shareTokens, err := k.Keeper.TokenizeShares(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyShareTokens, shareTokens.String()),
+		),
+	})
+
+	return &types.MsgTokenizeSharesResponse{Amount: shareTokens}, nil
+}
+
+// RedeemTokensForShares defines a method for redeeming share tokens back into
+// a delegation.
+func (k msgServer) RedeemTokensForShares(ctx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid share tokens amount",
+		)
+	}
+
+	ctx = types.WithStrictWithdraw(ctx)
+	redeemed, err := k.Keeper.RedeemTokensForShares(ctx, delegatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemTokensForShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareTokens, msg.Amount.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, redeemed.String()),
+		),
+	})
+
+	return &types.MsgRedeemTokensForSharesResponse{Amount: redeemed}, nil
+}
+
+// ScheduleCommissionChange defines a method for scheduling a change of the commission rate of a validator
+func (k msgServer) ScheduleCommissionChange(ctx context.Context, msg *types.MsgScheduleCommissionChange) (*types.MsgScheduleCommissionChangeResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.Rate.IsNil() || msg.Rate.GT(math.LegacyOneDec()) || msg.Rate.IsNegative() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "commission rate must be between 0 and 1 (inclusive)")
+	}
+
+	minCommissionRate, err := k.MinCommissionRate(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if msg.Rate.LT(minCommissionRate) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "commission rate cannot be less than the min commission rate %s", minCommissionRate.String())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockHeader().Time
+	if !msg.EffectiveTime.After(blockTime) {
+		return nil, types.ErrInvalidCommissionChangeTime
+	}
+
+	// delegators must be given enough time to react to the change
+	minNotice, err := k.MinCommissionChangeNotice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if earliest := blockTime.Add(minNotice); msg.EffectiveTime.Before(earliest) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCommissionChangeTime, "effective time must not be before %s (minimum notice %s)", earliest.Format(time.RFC3339), minNotice)
+	}
+
+	// validator must already be registered
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the change is checked again against the commission of the validator
+	// when it takes effect
+	commission := validator.Commission
+	if msg.Rate.GT(commission.MaxRate) {
+		return nil, types.ErrCommissionGTMaxRate
+	}
+	if msg.Rate.Sub(commission.Rate).GT(commission.MaxChangeRate) {
+		return nil, types.ErrCommissionGTMaxChangeRate
+	}
+
+	err = k.SetCommissionChange(ctx, valAddr, types.CommissionChange{
+		ValidatorAddress: msg.ValidatorAddress,
+		Rate:             msg.Rate,
+		EffectiveTime:    msg.EffectiveTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeScheduleCommissionChange,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, msg.Rate.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveTime, msg.EffectiveTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgScheduleCommissionChangeResponse{}, nil
+}
+
+// CancelCommissionChange defines a method for cancelling the commission change scheduled for a validator
+func (k msgServer) CancelCommissionChange(ctx context.Context, msg *types.MsgCancelCommissionChange) (*types.MsgCancelCommissionChangeResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	_, found, err := k.GetCommissionChange(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.ErrNoCommissionChangeScheduled
+	}
+
+	if err := k.DeleteCommissionChange(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelCommissionChange,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		),
+	})
+
+	return &types.MsgCancelCommissionChangeResponse{}, nil
+}
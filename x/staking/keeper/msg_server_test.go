@@ -1106,13 +1106,15 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &stakingtypes.MsgUpdateParams{
 				Authority: keeper.GetAuthority(),
 				Params: stakingtypes.Params{
-					MinCommissionRate: math.LegacyNewDec(-10),
-					UnbondingTime:     stakingtypes.DefaultUnbondingTime,
-					MaxValidators:     stakingtypes.DefaultMaxValidators,
-					MaxEntries:        stakingtypes.DefaultMaxEntries,
-					HistoricalEntries: stakingtypes.DefaultHistoricalEntries,
-					BondDenom:         stakingtypes.BondStatusBonded,
-					KeyRotationFee:    sdk.NewCoin(sdk.DefaultBondDenom, stakingtypes.DefaultKeyRotationFeeAmount),
+					MinCommissionRate:         math.LegacyNewDec(-10),
+					UnbondingTime:             stakingtypes.DefaultUnbondingTime,
+					MaxValidators:             stakingtypes.DefaultMaxValidators,
+					MaxEntries:                stakingtypes.DefaultMaxEntries,
+					HistoricalEntries:         stakingtypes.DefaultHistoricalEntries,
+					BondDenom:                 stakingtypes.BondStatusBonded,
+					KeyRotationFee:            sdk.NewCoin(sdk.DefaultBondDenom, stakingtypes.DefaultKeyRotationFeeAmount),
+					GlobalLiquidStakingCap:    stakingtypes.DefaultGlobalLiquidStakingCap,
+					ValidatorLiquidStakingCap: stakingtypes.DefaultValidatorLiquidStakingCap,
 				},
 			},
 			expErr:    true,
@@ -1123,13 +1125,15 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &stakingtypes.MsgUpdateParams{
 				Authority: keeper.GetAuthority(),
 				Params: stakingtypes.Params{
-					MinCommissionRate: math.LegacyNewDec(2),
-					UnbondingTime:     stakingtypes.DefaultUnbondingTime,
-					MaxValidators:     stakingtypes.DefaultMaxValidators,
-					MaxEntries:        stakingtypes.DefaultMaxEntries,
-					HistoricalEntries: stakingtypes.DefaultHistoricalEntries,
-					BondDenom:         stakingtypes.BondStatusBonded,
-					KeyRotationFee:    sdk.NewCoin(sdk.DefaultBondDenom, stakingtypes.DefaultKeyRotationFeeAmount),
+					MinCommissionRate:         math.LegacyNewDec(2),
+					UnbondingTime:             stakingtypes.DefaultUnbondingTime,
+					MaxValidators:             stakingtypes.DefaultMaxValidators,
+					MaxEntries:                stakingtypes.DefaultMaxEntries,
+					HistoricalEntries:         stakingtypes.DefaultHistoricalEntries,
+					BondDenom:                 stakingtypes.BondStatusBonded,
+					KeyRotationFee:            sdk.NewCoin(sdk.DefaultBondDenom, stakingtypes.DefaultKeyRotationFeeAmount),
+					GlobalLiquidStakingCap:    stakingtypes.DefaultGlobalLiquidStakingCap,
+					ValidatorLiquidStakingCap: stakingtypes.DefaultValidatorLiquidStakingCap,
 				},
 			},
 			expErr:    true,
@@ -1140,13 +1144,15 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &stakingtypes.MsgUpdateParams{
 				Authority: keeper.GetAuthority(),
 				Params: stakingtypes.Params{
-					MinCommissionRate: stakingtypes.DefaultMinCommissionRate,
-					UnbondingTime:     stakingtypes.DefaultUnbondingTime,
-					MaxValidators:     stakingtypes.DefaultMaxValidators,
-					MaxEntries:        stakingtypes.DefaultMaxEntries,
-					HistoricalEntries: stakingtypes.DefaultHistoricalEntries,
-					BondDenom:         "",
-					KeyRotationFee:    sdk.NewCoin(sdk.DefaultBondDenom, stakingtypes.DefaultKeyRotationFeeAmount),
+					MinCommissionRate:         stakingtypes.DefaultMinCommissionRate,
+					UnbondingTime:             stakingtypes.DefaultUnbondingTime,
+					MaxValidators:             stakingtypes.DefaultMaxValidators,
+					MaxEntries:                stakingtypes.DefaultMaxEntries,
+					HistoricalEntries:         stakingtypes.DefaultHistoricalEntries,
+					BondDenom:                 "",
+					KeyRotationFee:            sdk.NewCoin(sdk.DefaultBondDenom, stakingtypes.DefaultKeyRotationFeeAmount),
+					GlobalLiquidStakingCap:    stakingtypes.DefaultGlobalLiquidStakingCap,
+					ValidatorLiquidStakingCap: stakingtypes.DefaultValidatorLiquidStakingCap,
 				},
 			},
 			expErr:    true,
@@ -1157,13 +1163,15 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &stakingtypes.MsgUpdateParams{
 				Authority: keeper.GetAuthority(),
 				Params: stakingtypes.Params{
-					MinCommissionRate: stakingtypes.DefaultMinCommissionRate,
-					UnbondingTime:     stakingtypes.DefaultUnbondingTime,
-					MaxValidators:     stakingtypes.DefaultMaxValidators,
-					MaxEntries:        stakingtypes.DefaultMaxEntries,
-					HistoricalEntries: stakingtypes.DefaultHistoricalEntries,
-					BondDenom:         "ghosttoken",
-					KeyRotationFee:    sdk.NewInt64Coin("ghosttoken", 1000000),
+					MinCommissionRate:         stakingtypes.DefaultMinCommissionRate,
+					UnbondingTime:             stakingtypes.DefaultUnbondingTime,
+					MaxValidators:             stakingtypes.DefaultMaxValidators,
+					MaxEntries:                stakingtypes.DefaultMaxEntries,
+					HistoricalEntries:         stakingtypes.DefaultHistoricalEntries,
+					BondDenom:                 "ghosttoken",
+					KeyRotationFee:            sdk.NewInt64Coin("ghosttoken", 1000000),
+					GlobalLiquidStakingCap:    stakingtypes.DefaultGlobalLiquidStakingCap,
+					ValidatorLiquidStakingCap: stakingtypes.DefaultValidatorLiquidStakingCap,
 				},
 			},
 			expErr:    true,
@@ -1177,13 +1185,15 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &stakingtypes.MsgUpdateParams{
 				Authority: keeper.GetAuthority(),
 				Params: stakingtypes.Params{
-					MinCommissionRate: stakingtypes.DefaultMinCommissionRate,
-					UnbondingTime:     stakingtypes.DefaultUnbondingTime,
-					MaxValidators:     0,
-					MaxEntries:        stakingtypes.DefaultMaxEntries,
-					HistoricalEntries: stakingtypes.DefaultHistoricalEntries,
-					BondDenom:         stakingtypes.BondStatusBonded,
-					KeyRotationFee:    sdk.NewCoin(sdk.DefaultBondDenom, stakingtypes.DefaultKeyRotationFeeAmount),
+					MinCommissionRate:         stakingtypes.DefaultMinCommissionRate,
+					UnbondingTime:             stakingtypes.DefaultUnbondingTime,
+					MaxValidators:             0,
+					MaxEntries:                stakingtypes.DefaultMaxEntries,
+					HistoricalEntries:         stakingtypes.DefaultHistoricalEntries,
+					BondDenom:                 stakingtypes.BondStatusBonded,
+					KeyRotationFee:            sdk.NewCoin(sdk.DefaultBondDenom, stakingtypes.DefaultKeyRotationFeeAmount),
+					GlobalLiquidStakingCap:    stakingtypes.DefaultGlobalLiquidStakingCap,
+					ValidatorLiquidStakingCap: stakingtypes.DefaultValidatorLiquidStakingCap,
 				},
 			},
 			expErr:    true,
@@ -1194,13 +1204,15 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &stakingtypes.MsgUpdateParams{
 				Authority: keeper.GetAuthority(),
 				Params: stakingtypes.Params{
-					MinCommissionRate: stakingtypes.DefaultMinCommissionRate,
-					UnbondingTime:     stakingtypes.DefaultUnbondingTime,
-					MaxValidators:     stakingtypes.DefaultMaxValidators,
-					MaxEntries:        0,
-					HistoricalEntries: stakingtypes.DefaultHistoricalEntries,
-					BondDenom:         stakingtypes.BondStatusBonded,
-					KeyRotationFee:    sdk.NewCoin(sdk.DefaultBondDenom, stakingtypes.DefaultKeyRotationFeeAmount),
+					MinCommissionRate:         stakingtypes.DefaultMinCommissionRate,
+					UnbondingTime:             stakingtypes.DefaultUnbondingTime,
+					MaxValidators:             stakingtypes.DefaultMaxValidators,
+					MaxEntries:                0,
+					HistoricalEntries:         stakingtypes.DefaultHistoricalEntries,
+					BondDenom:                 stakingtypes.BondStatusBonded,
+					KeyRotationFee:            sdk.NewCoin(sdk.DefaultBondDenom, stakingtypes.DefaultKeyRotationFeeAmount),
+					GlobalLiquidStakingCap:    stakingtypes.DefaultGlobalLiquidStakingCap,
+					ValidatorLiquidStakingCap: stakingtypes.DefaultValidatorLiquidStakingCap,
 				},
 			},
 			expErr:    true,
@@ -1211,13 +1223,15 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			input: &stakingtypes.MsgUpdateParams{
 				Authority: keeper.GetAuthority(),
 				Params: stakingtypes.Params{
-					UnbondingTime:     time.Hour * 24 * 7 * 3 * -1,
-					MaxEntries:        stakingtypes.DefaultMaxEntries,
-					MaxValidators:     stakingtypes.DefaultMaxValidators,
-					HistoricalEntries: stakingtypes.DefaultHistoricalEntries,
-					MinCommissionRate: stakingtypes.DefaultMinCommissionRate,
-					BondDenom:         "denom",
-					KeyRotationFee:    sdk.NewCoin(sdk.DefaultBondDenom, stakingtypes.DefaultKeyRotationFeeAmount),
+					UnbondingTime:             time.Hour * 24 * 7 * 3 * -1,
+					MaxEntries:                stakingtypes.DefaultMaxEntries,
+					MaxValidators:             stakingtypes.DefaultMaxValidators,
+					HistoricalEntries:         stakingtypes.DefaultHistoricalEntries,
+					MinCommissionRate:         stakingtypes.DefaultMinCommissionRate,
+					BondDenom:                 "denom",
+					KeyRotationFee:            sdk.NewCoin(sdk.DefaultBondDenom, stakingtypes.DefaultKeyRotationFeeAmount),
+					GlobalLiquidStakingCap:    stakingtypes.DefaultGlobalLiquidStakingCap,
+					ValidatorLiquidStakingCap: stakingtypes.DefaultValidatorLiquidStakingCap,
 				},
 			},
 			expErr:    true,
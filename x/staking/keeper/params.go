@@ -62,6 +62,12 @@ func (k Keeper) KeyRotationFee(ctx context.Context) (sdk.Coin, error) {
 	return params.KeyRotationFee, err
 }
 
+// MinCommissionChangeNotice - Minimum notice for a scheduled commission change
+func (k Keeper) MinCommissionChangeNotice(ctx context.Context) (time.Duration, error) {
+	params, err := k.GetParams(ctx)
+	return params.MinCommissionChangeNotice, err
+}
+
 // SetParams sets the x/staking module parameters.
 // CONTRACT: This method performs no validation of the parameters.
 func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
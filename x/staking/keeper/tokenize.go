@@ -0,0 +1,361 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// delegatedVestingAccount is implemented by the vesting accounts.
+type delegatedVestingAccount interface {
+	GetDelegatedVesting() sdk.Coins
+}
+
+// TokenizeShares moves amount tokens of the delegation of delAddr to valAddr
+// into the delegation held by the tokenized share custody address of the
+// validator and mints the corresponding share tokens to the delegator.
+func (k Keeper) TokenizeShares(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) (sdk.Coin, error) {
+	if k.authKeeper.GetModuleAddress(types.TokenizedSharesPoolName) == nil {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrTokenizeSharesDisabled, "%s module account is not set", types.TokenizedSharesPoolName)
+	}
+
+	custody := types.TokenizedShareCustodyAddress(valAddr)
+	if delAddr.Equals(custody) {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrTokenizeSharesDisabled, "cannot tokenize the custody delegation")
+	}
+	if bytes.Equal(delAddr, valAddr) {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrTokenizeSharesDisabled, "cannot tokenize the validator self-delegation")
+	}
+
+	hasRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if hasRedelegation {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrTokenizeSharesDisabled, "delegation has an incoming redelegation")
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(delegatedVestingAccount); ok && acc.GetDelegatedVesting().AmountOf(bondDenom).IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrTokenizeSharesDisabled, "delegation contains vesting tokens")
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.compoundTokenizedShares(ctx, valAddr, custody); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	custodyShares, err := k.custodyShares(ctx, custody, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, validator.TokensFromShares(custodyShares).TruncateInt(), amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the delegation is moved between two delegators of the same validator,
+	// hence the tokens stay in the pool matching the validator status
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	newShares, err := k.Delegate(ctx, custody, returnAmount, validator.GetStatus(), validator, false)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	denom := types.TokenizedShareDenom(validator.GetOperator())
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+
+	mintAmount := newShares.TruncateInt()
+	if supply.IsPositive() && custodyShares.IsPositive() {
+		mintAmount = newShares.MulInt(supply).Quo(custodyShares).TruncateInt()
+	}
+	if !mintAmount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount is too small to be tokenized")
+	}
+
+	shareTokens := sdk.NewCoin(denom, mintAmount)
+	if err := k.bankKeeper.MintCoins(ctx, types.TokenizedSharesPoolName, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.TokenizedSharesPoolName, delAddr, sdk.NewCoins(shareTokens)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Set(types.GetTokenizedShareValidatorKey(valAddr), []byte{}); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shareTokens, nil
+}
+
+// RedeemTokensForShares burns the given share tokens of delAddr and moves the
+// corresponding part of the custody delegation to the delegator. The rewards
+// of the custody delegation in other denoms than the bond denom are paid out
+// pro rata to the delegator.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	valAddrStr, err := types.ParseTokenizedShareDenom(amount.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(valAddrStr)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidShareDenom, "invalid validator address: %s", err)
+	}
+
+	custody := types.TokenizedShareCustodyAddress(valAddr)
+	if err := k.compoundTokenizedShares(ctx, valAddr, custody); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	delegation, err := k.GetDelegation(ctx, custody, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount
+	if amount.Amount.GT(supply) {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is greater than the supply %s", amount, supply)
+	}
+
+	shares := delegation.Shares
+	if amount.Amount.LT(supply) {
+		shares = delegation.Shares.MulInt(amount.Amount).QuoInt(supply)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	rewards := sdk.NewCoins()
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, custody) {
+		if coin.Denom == bondDenom {
+			continue
+		}
+		rewards = rewards.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(amount.Amount).Quo(supply)))
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.TokenizedSharesPoolName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.TokenizedSharesPoolName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, custody, delAddr, rewards); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	returnAmount, err := k.Unbond(ctx, custody, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	returnCoin := sdk.NewCoin(bondDenom, returnAmount)
+
+	if _, err := k.GetDelegation(ctx, custody, valAddr); errors.Is(err, types.ErrNoDelegation) {
+		if err := k.storeService.OpenKVStore(ctx).Delete(types.GetTokenizedShareValidatorKey(valAddr)); err != nil {
+			return sdk.Coin{}, err
+		}
+	} else if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if errors.Is(err, types.ErrNoValidatorFound) {
+		// the unbonded validator was removed along with its last delegation,
+		// the tokens are paid out from the not bonded pool
+		if returnAmount.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, sdk.NewCoins(returnCoin)); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+		return returnCoin, nil
+	}
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, err := k.Delegate(ctx, delAddr, returnAmount, validator.GetStatus(), validator, false); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return returnCoin, nil
+}
+
+// TotalLiquidStaked returns the amount of tokens backing share tokens, summed
+// over all validators.
+func (k Keeper) TotalLiquidStaked(ctx context.Context) (math.Int, error) {
+	total := math.ZeroInt()
+	err := k.IterateTokenizedShareValidators(ctx, func(valAddr sdk.ValAddress) (bool, error) {
+		tokens, err := k.tokenizedShareTokens(ctx, valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		total = total.Add(tokens)
+		return false, nil
+	})
+
+	return total, err
+}
+
+// IterateTokenizedShareValidators iterates over the validators having
+// tokenized shares.
+func (k Keeper) IterateTokenizedShareValidators(ctx context.Context, cb func(valAddr sdk.ValAddress) (stop bool, err error)) error {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.TokenizedShareValidatorsKey, storetypes.PrefixEndBytes(types.TokenizedShareValidatorsKey))
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key format: prefix || length || valAddr
+		valAddr := sdk.ValAddress(iterator.Key()[len(types.TokenizedShareValidatorsKey)+1:])
+		stop, err := cb(valAddr)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+
+	return nil
+}
+
+// tokenizedShareTokens returns the amount of tokens of the custody delegation
+// of a validator.
+func (k Keeper) tokenizedShareTokens(ctx context.Context, valAddr sdk.ValAddress) (math.Int, error) {
+	shares, err := k.custodyShares(ctx, types.TokenizedShareCustodyAddress(valAddr), valAddr)
+	if err != nil || shares.IsZero() {
+		return math.ZeroInt(), err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return validator.TokensFromShares(shares).TruncateInt(), nil
+}
+
+// custodyShares returns the shares of the custody delegation, zero if there is
+// none.
+func (k Keeper) custodyShares(ctx context.Context, custody sdk.AccAddress, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	delegation, err := k.GetDelegation(ctx, custody, valAddr)
+	if errors.Is(err, types.ErrNoDelegation) {
+		return math.LegacyZeroDec(), nil
+	}
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return delegation.Shares, nil
+}
+
+// compoundTokenizedShares withdraws the rewards of the custody delegation of a
+// validator to the custody address and delegates its bond denom balance back
+// to the validator, so that the share tokens accrue the staking rewards.
+func (k Keeper) compoundTokenizedShares(ctx context.Context, valAddr sdk.ValAddress, custody sdk.AccAddress) error {
+	_, err := k.GetDelegation(ctx, custody, valAddr)
+	switch {
+	case err == nil:
+		if err := k.Hooks().BeforeDelegationSharesModified(ctx, custody, valAddr); err != nil {
+			return err
+		}
+		if err := k.Hooks().AfterDelegationModified(ctx, custody, valAddr); err != nil {
+			return err
+		}
+	case errors.Is(err, types.ErrNoDelegation):
+		return nil
+	default:
+		return err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, custody, bondDenom)
+	if !balance.IsPositive() {
+		return nil
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	if validator.InvalidExRate() {
+		return nil
+	}
+
+	_, err = k.Delegate(ctx, custody, balance.Amount, types.Unbonded, validator, true)
+	return err
+}
+
+// checkLiquidStakingCaps checks that tokenizing amount more tokens of the
+// validator keeps the liquid staked tokens within the validator and global
+// caps.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, validator types.Validator, custodyTokens, amount math.Int) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	validatorLimit := params.ValidatorLiquidStakingCap.MulInt(validator.GetTokens())
+	if math.LegacyNewDecFromInt(custodyTokens.Add(amount)).GT(validatorLimit) {
+		return errorsmod.Wrapf(types.ErrValidatorLiquidStakingCapExceeded, "tokenizing %s would exceed %s of the validator tokens", amount, params.ValidatorLiquidStakingCap)
+	}
+
+	totalLiquid, err := k.TotalLiquidStaked(ctx)
+	if err != nil {
+		return err
+	}
+
+	bonded, err := k.TotalValidatorPower(ctx)
+	if err != nil {
+		return err
+	}
+
+	globalLimit := params.GlobalLiquidStakingCap.MulInt(bonded)
+	if math.LegacyNewDecFromInt(totalLiquid.Add(amount)).GT(globalLimit) {
+		return errorsmod.Wrapf(types.ErrGlobalLiquidStakingCapExceeded, "tokenizing %s would exceed %s of the bonded tokens", amount, params.GlobalLiquidStakingCap)
+	}
+
+	return nil
+}
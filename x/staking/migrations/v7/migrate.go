@@ -0,0 +1,38 @@
+package v7
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type paramsKeeper interface {
+	GetParams(context.Context) (types.Params, error)
+	SetParams(context.Context, types.Params) error
+}
+
+// Migrate adds the GlobalLiquidStakingCap, ValidatorLiquidStakingCap and
+// MinCommissionChangeNotice params. Existing chains inherit the default caps,
+// which leave tokenization unbounded, when the fields are absent, and a
+// commission change notice equal to their unbonding time.
+func Migrate(ctx context.Context, keeper paramsKeeper) error {
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.GlobalLiquidStakingCap.IsNil() {
+		params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	}
+	if params.ValidatorLiquidStakingCap.IsNil() {
+		params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	}
+	if params.MinCommissionChangeNotice == 0 {
+		params.MinCommissionChangeNotice = params.UnbondingTime
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return keeper.SetParams(ctx, params)
+}
@@ -54,7 +54,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
 	keyRotationFee := sdk.NewCoin(simState.BondDenom, types.DefaultKeyRotationFeeAmount)
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate, keyRotationFee)
+	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate, keyRotationFee,
+		types.DefaultGlobalLiquidStakingCap, types.DefaultValidatorLiquidStakingCap, simState.UnbondTime)
 
 	// validators & delegations
 	var (
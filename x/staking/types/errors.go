@@ -52,4 +52,12 @@ var (
 	ErrConsensusPubKeyAlreadyUsedForValidator = errors.Register(ModuleName, 46, "consensus pubkey is already in use by another validator")
 	ErrConsensusPubKeyInRotationHistory       = errors.Register(ModuleName, 47, "consensus pubkey was previously rotated away from and is still within the unbonding window")
 	ErrExceedingMaxConsPubKeyRotations        = errors.Register(ModuleName, 48, "validator has reached the max consensus pubkey rotations within the unbonding period")
+
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 49, "tokenizing shares would exceed the global liquid staking cap")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 50, "tokenizing shares would exceed the validator liquid staking cap")
+	ErrInvalidShareDenom                 = errors.Register(ModuleName, 51, "invalid tokenized share denom")
+	ErrTokenizeSharesDisabled            = errors.Register(ModuleName, 52, "delegation cannot be tokenized")
+
+	ErrNoCommissionChangeScheduled = errors.Register(ModuleName, 53, "no commission change scheduled")
+	ErrInvalidCommissionChangeTime = errors.Register(ModuleName, 54, "commission change effective time must be in the future")
 )
@@ -12,6 +12,12 @@ const (
 	EventTypeRedelegate                = "redelegate"
 	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
 	EventTypeApplyConsPubKeyRotation   = "apply_cons_pubkey_rotation"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemTokensForShares     = "redeem_tokens_for_shares"
+	EventTypeScheduleCommissionChange  = "schedule_commission_change"
+	EventTypeCancelCommissionChange    = "cancel_commission_change"
+	EventTypeCommissionChange          = "commission_change"
+	EventTypeCommissionChangeFailed    = "commission_change_failed"
 
 	AttributeKeyValidator            = "validator"
 	AttributeKeyCommissionRate       = "commission_rate"
@@ -29,4 +35,7 @@ const (
 	AttributeKeyEvidenceExpiryTime   = "evidence_expiry_time"
 	AttributeKeyEvidenceExpiryHeight = "evidence_expiry_height"
 	AttributeKeyFeeBurned            = "fee_burned"
+	AttributeKeyShareTokens          = "share_tokens"
+	AttributeKeyEffectiveTime        = "effective_time"
+	AttributeKeyError                = "error"
 )
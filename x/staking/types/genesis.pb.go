@@ -57,6 +57,9 @@ type GenesisState struct {
 	// pending_consensus_key_rotations defines consensus key rotations whose
 	// SDK-side validator state has not reached its deferred apply height yet.
 	PendingConsensusKeyRotations []PendingConsensusKeyRotation `protobuf:"bytes,10,rep,name=pending_consensus_key_rotations,json=pendingConsensusKeyRotations,proto3" json:"pending_consensus_key_rotations"`
+	// scheduled_commission_changes defines the commission changes which have
+	// not taken effect yet.
+	ScheduledCommissionChanges []CommissionChange `protobuf:"bytes,11,rep,name=scheduled_commission_changes,json=scheduledCommissionChanges,proto3" json:"scheduled_commission_changes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -155,6 +158,13 @@ func (m *GenesisState) GetPendingConsensusKeyRotations() []PendingConsensusKeyRo
 	return nil
 }
 
+func (m *GenesisState) GetScheduledCommissionChanges() []CommissionChange {
+	if m != nil {
+		return m.ScheduledCommissionChanges
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -306,60 +316,62 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 835 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4d, 0x8f, 0xdb, 0x44,
-	0x18, 0xc7, 0xe3, 0xa6, 0xfb, 0x36, 0xd9, 0x45, 0xed, 0x34, 0xa9, 0x4c, 0x16, 0x9c, 0x6c, 0xd4,
-	0x43, 0x54, 0x88, 0x4d, 0x53, 0x4e, 0xdc, 0x36, 0xbc, 0xb5, 0xa2, 0x94, 0x28, 0xed, 0x72, 0x28,
-	0x07, 0x6b, 0x12, 0x0f, 0xce, 0x28, 0xf6, 0x8c, 0xe5, 0x19, 0x67, 0xd7, 0x67, 0x84, 0xc4, 0xb1,
-	0x1f, 0xa1, 0x17, 0x24, 0x8e, 0x20, 0xed, 0x87, 0xa8, 0x38, 0x55, 0x7b, 0x42, 0x1c, 0x16, 0xb4,
-	0x7b, 0x00, 0xbe, 0x05, 0xf2, 0x8c, 0xed, 0x75, 0x5e, 0xbc, 0x12, 0x12, 0x97, 0xdd, 0xd8, 0xcf,
-	0xff, 0xff, 0x7b, 0xfe, 0x33, 0x8f, 0x3d, 0x06, 0xf7, 0x26, 0x8c, 0xfb, 0x8c, 0x5b, 0x5c, 0xa0,
-	0x19, 0xa1, 0xae, 0x35, 0x7f, 0x30, 0xc6, 0x02, 0x3d, 0xb0, 0x5c, 0x4c, 0x31, 0x27, 0xdc, 0x0c,
-	0x42, 0x26, 0x18, 0xbc, 0xab, 0x54, 0x66, 0xaa, 0x32, 0x53, 0x55, 0xb3, 0xee, 0x32, 0x97, 0x49,
-	0x89, 0x95, 0xfc, 0x52, 0xea, 0x66, 0x19, 0x33, 0x73, 0x2b, 0xd5, 0xdb, 0x4a, 0x65, 0x2b, 0x7b,
-	0xda, 0x40, 0x95, 0x6e, 0x23, 0x9f, 0x50, 0x66, 0xc9, 0xbf, 0x99, 0xda, 0x65, 0xcc, 0xf5, 0xb0,
-	0x25, 0xaf, 0xc6, 0xd1, 0xb7, 0x16, 0xa2, 0x71, 0x5a, 0x6a, 0x2d, 0x97, 0x04, 0xf1, 0x31, 0x17,
-	0xc8, 0x0f, 0x94, 0xa0, 0xf3, 0xe3, 0x16, 0xd8, 0xfd, 0x5c, 0xad, 0xe7, 0x99, 0x40, 0x02, 0xc3,
-	0x43, 0xb0, 0x19, 0xa0, 0x10, 0xf9, 0x5c, 0xd7, 0xda, 0x5a, 0xb7, 0xd6, 0x37, 0xcc, 0xf5, 0xeb,
-	0x33, 0x87, 0x52, 0x35, 0xd8, 0x79, 0x7d, 0xde, 0xaa, 0xfc, 0xf4, 0xd7, 0xcf, 0xf7, 0xb5, 0x51,
-	0x6a, 0x84, 0x2f, 0xc0, 0x2d, 0x0f, 0x71, 0x61, 0x0b, 0x26, 0x90, 0x67, 0x07, 0xec, 0x18, 0x87,
-	0xfa, 0x8d, 0xb6, 0xd6, 0xdd, 0x1d, 0x7c, 0x90, 0x88, 0x7f, 0x3f, 0x6f, 0x35, 0x14, 0x93, 0x3b,
-	0x33, 0x93, 0x30, 0xcb, 0x47, 0x62, 0x6a, 0x3e, 0xa6, 0xe2, 0xec, 0xb4, 0x07, 0xd2, 0x66, 0x8f,
-	0xa9, 0x50, 0xcc, 0xb7, 0x12, 0xd2, 0xf3, 0x04, 0x34, 0x4c, 0x38, 0x90, 0x80, 0x86, 0x64, 0xcf,
-	0x91, 0x47, 0x1c, 0x24, 0x58, 0xa8, 0xf8, 0x5c, 0xaf, 0xb6, 0xab, 0xdd, 0x5a, 0xff, 0x7e, 0x59,
-	0xda, 0x27, 0x88, 0x8b, 0xaf, 0x33, 0x8f, 0x44, 0x15, 0x93, 0xdf, 0xf1, 0x56, 0xca, 0x1c, 0x3e,
-	0x01, 0x20, 0xef, 0xc2, 0xf5, 0x9b, 0x92, 0x7f, 0x50, 0xc6, 0xcf, 0xcd, 0x45, 0x6c, 0xc1, 0x0f,
-	0xbf, 0x02, 0x35, 0x07, 0x7b, 0xd8, 0x45, 0x82, 0x30, 0xca, 0xf5, 0x0d, 0x89, 0xeb, 0x94, 0xe1,
-	0x3e, 0xc9, 0xa5, 0x45, 0x5e, 0x91, 0x00, 0x67, 0xa0, 0x11, 0xd1, 0x31, 0xa3, 0x0e, 0xa1, 0xae,
-	0x5d, 0x44, 0x6f, 0x4a, 0xf4, 0x7b, 0x65, 0xe8, 0xa3, 0xcc, 0xb4, 0xbe, 0x47, 0x3d, 0x5a, 0xad,
-	0x73, 0x78, 0x04, 0xf6, 0x42, 0x5c, 0x6c, 0xb2, 0x25, 0x9b, 0xdc, 0x2b, 0x6b, 0x32, 0x2a, 0x88,
-	0x8b, 0xf4, 0x45, 0x0a, 0x6c, 0x82, 0x6d, 0x7c, 0x12, 0xb0, 0x50, 0x60, 0x47, 0xdf, 0x6e, 0x6b,
-	0xdd, 0xed, 0x51, 0x7e, 0x0d, 0xbf, 0xd3, 0x80, 0x31, 0x61, 0x94, 0x63, 0xca, 0x23, 0x6e, 0xcf,
-	0x70, 0x6c, 0x87, 0x4c, 0x48, 0x9f, 0x3d, 0x25, 0x5c, 0xb0, 0x30, 0xd6, 0x77, 0x64, 0x88, 0x87,
-	0x65, 0x21, 0x3e, 0xce, 0xdc, 0x5f, 0xe0, 0x78, 0x94, 0x7a, 0x1f, 0x29, 0x6b, 0x31, 0xd3, 0xfe,
-	0xa4, 0x5c, 0x07, 0xbf, 0xd7, 0x40, 0x2b, 0xc0, 0x6a, 0x93, 0xd7, 0xa7, 0xe1, 0x3a, 0xb8, 0x3e,
-	0xc6, 0x50, 0xd9, 0xd7, 0xa5, 0x29, 0xc6, 0x78, 0x27, 0x28, 0xd7, 0xf1, 0xce, 0x14, 0xc0, 0xd5,
-	0x47, 0x18, 0xf6, 0xc1, 0x16, 0x72, 0x9c, 0x10, 0x73, 0xf5, 0xb6, 0xee, 0x0c, 0xf4, 0xb3, 0xd3,
-	0x5e, 0x3d, 0xcd, 0x71, 0xa8, 0x2a, 0xcf, 0x44, 0x48, 0xa8, 0x3b, 0xca, 0x84, 0xb0, 0x0e, 0x36,
-	0xae, 0x5e, 0xc9, 0xea, 0x48, 0x5d, 0x7c, 0xb4, 0xfd, 0xc3, 0xab, 0x56, 0xe5, 0xef, 0x57, 0xad,
-	0x4a, 0xe7, 0x97, 0x2a, 0xd8, 0xbf, 0x66, 0xe7, 0xe0, 0x53, 0x70, 0xfb, 0xea, 0xe5, 0x5b, 0xec,
-	0x7e, 0x70, 0x76, 0xda, 0x7b, 0x37, 0xed, 0x9e, 0x27, 0x5d, 0x8c, 0x71, 0x6b, 0xbe, 0x74, 0x1f,
-	0x1e, 0x81, 0x06, 0xf3, 0x9c, 0xc2, 0xe6, 0x66, 0xcc, 0x1b, 0x2b, 0xcc, 0x3c, 0xd6, 0x22, 0xf3,
-	0x0e, 0xf3, 0x9c, 0xe5, 0x12, 0x7c, 0x0a, 0xf6, 0x7c, 0x24, 0xa2, 0x90, 0x88, 0xd8, 0x4e, 0x0e,
-	0x3d, 0xbd, 0x2a, 0x8f, 0xb3, 0xa6, 0xa9, 0x4e, 0x44, 0x33, 0x3b, 0x11, 0xcd, 0xe7, 0xd9, 0x89,
-	0x38, 0xd8, 0x4b, 0x86, 0xf1, 0xf2, 0x8f, 0x96, 0xa6, 0x06, 0xb2, 0x9b, 0xf9, 0x13, 0x05, 0xfc,
-	0x06, 0xd4, 0xf1, 0x9c, 0x38, 0x98, 0x4e, 0xb0, 0x8d, 0x4f, 0x02, 0x12, 0xa6, 0xd8, 0x9b, 0xff,
-	0x15, 0x0b, 0x33, 0xcc, 0xa7, 0x92, 0x22, 0xe1, 0x1f, 0x82, 0xbb, 0xcb, 0xf0, 0x29, 0x26, 0xee,
-	0x54, 0xe8, 0x1b, 0x72, 0x48, 0xf5, 0x45, 0xcf, 0x23, 0x59, 0x2b, 0xcc, 0xec, 0x1f, 0x0d, 0xec,
-	0x5f, 0xf3, 0x98, 0xfd, 0xef, 0x33, 0xfb, 0x12, 0x00, 0x8a, 0x8f, 0xed, 0x20, 0x1a, 0xcf, 0x70,
-	0x2c, 0x07, 0x55, 0xeb, 0xd7, 0x57, 0xb6, 0xe0, 0x90, 0xc6, 0x03, 0xfd, 0xd7, 0xab, 0x07, 0x72,
-	0x12, 0xc6, 0x81, 0x60, 0xe6, 0x30, 0x1a, 0x27, 0xd9, 0x76, 0x28, 0x3e, 0x1e, 0x4a, 0x00, 0x3c,
-	0x00, 0xbb, 0x28, 0x08, 0xbc, 0x7c, 0xd1, 0x55, 0xb9, 0xe8, 0x9a, 0xbc, 0xb7, 0xbc, 0xd6, 0xc1,
-	0x67, 0xaf, 0x2f, 0x0c, 0xed, 0xcd, 0x85, 0xa1, 0xfd, 0x79, 0x61, 0x68, 0x2f, 0x2f, 0x8d, 0xca,
-	0x9b, 0x4b, 0xa3, 0xf2, 0xdb, 0xa5, 0x51, 0x79, 0xf1, 0xbe, 0x4b, 0xc4, 0x34, 0x1a, 0x9b, 0x13,
-	0xe6, 0xa7, 0xdf, 0xcc, 0xf4, 0x5f, 0x8f, 0x3b, 0x33, 0xeb, 0x24, 0xff, 0xe6, 0x8a, 0x38, 0xc0,
-	0x7c, 0xbc, 0x29, 0x73, 0x3e, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x41, 0x05, 0xa0, 0xce, 0xe6,
-	0x07, 0x00, 0x00,
+	// 873 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x6f, 0xdc, 0x44,
+	0x18, 0xc6, 0xd7, 0xdd, 0x26, 0xdd, 0xcc, 0x26, 0xa8, 0x9d, 0x6e, 0x2a, 0xb3, 0x29, 0xde, 0x24,
+	0xea, 0x61, 0x55, 0x88, 0x4d, 0x53, 0x4e, 0xdc, 0xb2, 0xe5, 0x4f, 0x2b, 0x4a, 0x59, 0x6d, 0x1b,
+	0x0e, 0xe5, 0x60, 0xcd, 0xda, 0x83, 0x3d, 0x5a, 0x7b, 0xc6, 0xf2, 0x8c, 0x37, 0xf1, 0x19, 0x21,
+	0x71, 0xec, 0x07, 0xe0, 0xd0, 0x23, 0x47, 0x90, 0xf2, 0x21, 0x2a, 0x4e, 0x55, 0x4e, 0x88, 0x43,
+	0x40, 0xc9, 0x01, 0xf8, 0x16, 0xc8, 0x33, 0xb6, 0xe3, 0xfd, 0xe3, 0x48, 0x48, 0x5c, 0x92, 0xb5,
+	0xdf, 0xe7, 0xf9, 0xbd, 0xcf, 0xcc, 0x6b, 0x8f, 0xc1, 0x3d, 0x87, 0xf1, 0x90, 0x71, 0x8b, 0x0b,
+	0x34, 0x21, 0xd4, 0xb3, 0xa6, 0x0f, 0xc6, 0x58, 0xa0, 0x07, 0x96, 0x87, 0x29, 0xe6, 0x84, 0x9b,
+	0x51, 0xcc, 0x04, 0x83, 0x77, 0x94, 0xca, 0xcc, 0x55, 0x66, 0xae, 0xea, 0x76, 0x3c, 0xe6, 0x31,
+	0x29, 0xb1, 0xb2, 0x5f, 0x4a, 0xdd, 0xad, 0x63, 0x16, 0x6e, 0xa5, 0x7a, 0x57, 0xa9, 0x6c, 0x65,
+	0xcf, 0x1b, 0xa8, 0xd2, 0x2d, 0x14, 0x12, 0xca, 0x2c, 0xf9, 0xb7, 0x50, 0x7b, 0x8c, 0x79, 0x01,
+	0xb6, 0xe4, 0xd5, 0x38, 0xf9, 0xd6, 0x42, 0x34, 0xcd, 0x4b, 0xbd, 0xf9, 0x92, 0x20, 0x21, 0xe6,
+	0x02, 0x85, 0x91, 0x12, 0xec, 0xfe, 0xd8, 0x02, 0xeb, 0x9f, 0xab, 0xf5, 0x3c, 0x17, 0x48, 0x60,
+	0x78, 0x00, 0x56, 0x23, 0x14, 0xa3, 0x90, 0xeb, 0xda, 0xb6, 0xd6, 0x6f, 0xef, 0x1b, 0xe6, 0xf2,
+	0xf5, 0x99, 0x43, 0xa9, 0x1a, 0xac, 0xbd, 0x39, 0xeb, 0x35, 0x7e, 0xfa, 0xeb, 0xe7, 0xfb, 0xda,
+	0x28, 0x37, 0xc2, 0x97, 0xe0, 0x66, 0x80, 0xb8, 0xb0, 0x05, 0x13, 0x28, 0xb0, 0x23, 0x76, 0x84,
+	0x63, 0xfd, 0xda, 0xb6, 0xd6, 0x5f, 0x1f, 0x7c, 0x98, 0x89, 0x7f, 0x3f, 0xeb, 0x6d, 0x2a, 0x26,
+	0x77, 0x27, 0x26, 0x61, 0x56, 0x88, 0x84, 0x6f, 0x3e, 0xa1, 0xe2, 0xf4, 0x64, 0x0f, 0xe4, 0xcd,
+	0x9e, 0x50, 0xa1, 0x98, 0xef, 0x64, 0xa4, 0x17, 0x19, 0x68, 0x98, 0x71, 0x20, 0x01, 0x9b, 0x92,
+	0x3d, 0x45, 0x01, 0x71, 0x91, 0x60, 0xb1, 0xe2, 0x73, 0xbd, 0xb9, 0xdd, 0xec, 0xb7, 0xf7, 0xef,
+	0xd7, 0xa5, 0x7d, 0x8a, 0xb8, 0xf8, 0xba, 0xf0, 0x48, 0x54, 0x35, 0xf9, 0xed, 0x60, 0xa1, 0xcc,
+	0xe1, 0x53, 0x00, 0xca, 0x2e, 0x5c, 0xbf, 0x2e, 0xf9, 0x3b, 0x75, 0xfc, 0xd2, 0x5c, 0xc5, 0x56,
+	0xfc, 0xf0, 0x2b, 0xd0, 0x76, 0x71, 0x80, 0x3d, 0x24, 0x08, 0xa3, 0x5c, 0x5f, 0x91, 0xb8, 0xdd,
+	0x3a, 0xdc, 0x27, 0xa5, 0xb4, 0xca, 0xab, 0x12, 0xe0, 0x04, 0x6c, 0x26, 0x74, 0xcc, 0xa8, 0x4b,
+	0xa8, 0x67, 0x57, 0xd1, 0xab, 0x12, 0xfd, 0x7e, 0x1d, 0xfa, 0xb0, 0x30, 0x2d, 0xef, 0xd1, 0x49,
+	0x16, 0xeb, 0x1c, 0x1e, 0x82, 0x8d, 0x18, 0x57, 0x9b, 0xdc, 0x90, 0x4d, 0xee, 0xd5, 0x35, 0x19,
+	0x61, 0x77, 0x29, 0x7d, 0x96, 0x02, 0xbb, 0xa0, 0x85, 0x8f, 0x23, 0x16, 0x0b, 0xec, 0xea, 0xad,
+	0x6d, 0xad, 0xdf, 0x1a, 0x95, 0xd7, 0xf0, 0x3b, 0x0d, 0x18, 0x0e, 0xa3, 0x1c, 0x53, 0x9e, 0x70,
+	0x7b, 0x82, 0x53, 0x3b, 0x66, 0x42, 0xfa, 0x6c, 0x9f, 0x70, 0xc1, 0xe2, 0x54, 0x5f, 0x93, 0x21,
+	0x1e, 0xd6, 0x85, 0x78, 0x54, 0xb8, 0xbf, 0xc0, 0xe9, 0x28, 0xf7, 0x3e, 0x56, 0xd6, 0x6a, 0xa6,
+	0x2d, 0xa7, 0x5e, 0x07, 0xbf, 0xd7, 0x40, 0x2f, 0xc2, 0x6a, 0x93, 0x97, 0xa7, 0xe1, 0x3a, 0xb8,
+	0x3a, 0xc6, 0x50, 0xd9, 0x97, 0xa5, 0xa9, 0xc6, 0xb8, 0x1b, 0xd5, 0xeb, 0x38, 0x4c, 0xc0, 0x5d,
+	0xee, 0xf8, 0xd8, 0x4d, 0x02, 0xec, 0xda, 0x0e, 0x0b, 0x43, 0xc2, 0x79, 0xb6, 0x15, 0x8e, 0x8f,
+	0xa8, 0x87, 0xb9, 0xde, 0x96, 0x19, 0xfa, 0xf5, 0x5b, 0x51, 0x38, 0x1e, 0x49, 0x43, 0xb5, 0x71,
+	0xb7, 0x04, 0xcf, 0xab, 0xf8, 0xae, 0x0f, 0xe0, 0xe2, 0x9b, 0x03, 0xf7, 0xc1, 0x0d, 0xe4, 0xba,
+	0x31, 0xe6, 0xea, 0x90, 0x58, 0x1b, 0xe8, 0xa7, 0x27, 0x7b, 0x9d, 0xbc, 0xf5, 0x81, 0xaa, 0x3c,
+	0x17, 0x31, 0xa1, 0xde, 0xa8, 0x10, 0xc2, 0x0e, 0x58, 0xb9, 0x3c, 0x09, 0x9a, 0x23, 0x75, 0xf1,
+	0x71, 0xeb, 0x87, 0xd7, 0xbd, 0xc6, 0xdf, 0xaf, 0x7b, 0x8d, 0xdd, 0x5f, 0x9a, 0x60, 0xeb, 0x8a,
+	0x81, 0xc1, 0x67, 0xe0, 0xd6, 0xe5, 0x3b, 0x3f, 0xdb, 0x7d, 0xe7, 0xf4, 0x64, 0xef, 0xbd, 0xbc,
+	0x7b, 0x99, 0x74, 0x36, 0xc6, 0xcd, 0xe9, 0xdc, 0x7d, 0x78, 0x08, 0x36, 0x59, 0xe0, 0x56, 0x66,
+	0x5a, 0x30, 0xaf, 0x2d, 0x30, 0xcb, 0x58, 0xb3, 0xcc, 0xdb, 0x2c, 0x70, 0xe7, 0x4b, 0xf0, 0x19,
+	0xd8, 0x08, 0x91, 0x48, 0x62, 0x22, 0x52, 0x3b, 0x3b, 0x6b, 0xf5, 0xa6, 0x3c, 0x45, 0xbb, 0xa6,
+	0x3a, 0x88, 0xcd, 0xe2, 0x20, 0x36, 0x5f, 0x14, 0x07, 0xf1, 0x60, 0x23, 0x1b, 0xc5, 0xab, 0x3f,
+	0x7a, 0x9a, 0x1a, 0xc7, 0x7a, 0xe1, 0xcf, 0x14, 0xf0, 0x1b, 0xd0, 0xc1, 0x53, 0xe2, 0x62, 0xea,
+	0x60, 0x1b, 0x1f, 0x47, 0x24, 0xce, 0xb1, 0xd7, 0xff, 0x2b, 0x16, 0x16, 0x98, 0x4f, 0x25, 0x45,
+	0xc2, 0x3f, 0x02, 0x77, 0xe6, 0xe1, 0x3e, 0x26, 0x9e, 0x2f, 0xf4, 0x15, 0x39, 0xa4, 0xce, 0xac,
+	0xe7, 0xb1, 0xac, 0x55, 0x66, 0xf6, 0x8f, 0x06, 0xb6, 0xae, 0x78, 0xba, 0xff, 0xf7, 0x99, 0x7d,
+	0x09, 0x00, 0xc5, 0x47, 0x76, 0x94, 0x8c, 0x27, 0x38, 0x95, 0x83, 0x6a, 0xef, 0x77, 0x16, 0xb6,
+	0xe0, 0x80, 0xa6, 0x03, 0xfd, 0xd7, 0xcb, 0x07, 0xd2, 0x89, 0xd3, 0x48, 0x30, 0x73, 0x98, 0x8c,
+	0xb3, 0x6c, 0x6b, 0x14, 0x1f, 0x0d, 0x25, 0x00, 0xee, 0x80, 0x75, 0x14, 0x45, 0x41, 0xb9, 0xe8,
+	0xa6, 0x5c, 0x74, 0x5b, 0xde, 0x9b, 0x5f, 0xeb, 0xe0, 0xb3, 0x37, 0xe7, 0x86, 0xf6, 0xf6, 0xdc,
+	0xd0, 0xfe, 0x3c, 0x37, 0xb4, 0x57, 0x17, 0x46, 0xe3, 0xed, 0x85, 0xd1, 0xf8, 0xed, 0xc2, 0x68,
+	0xbc, 0xfc, 0xc0, 0x23, 0xc2, 0x4f, 0xc6, 0xa6, 0xc3, 0xc2, 0xfc, 0x53, 0x9d, 0xff, 0xdb, 0xe3,
+	0xee, 0xc4, 0x3a, 0x2e, 0x3f, 0xf5, 0x22, 0x8d, 0x30, 0x1f, 0xaf, 0xca, 0x9c, 0x0f, 0xff, 0x1d,
+	0x00, 0x36, 0x91, 0x2d, 0xa5, 0x5d, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -382,6 +394,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledCommissionChanges) > 0 {
+		for iNdEx := len(m.ScheduledCommissionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledCommissionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.PendingConsensusKeyRotations) > 0 {
 		for iNdEx := len(m.PendingConsensusKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -719,6 +745,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScheduledCommissionChanges) > 0 {
+		for _, e := range m.ScheduledCommissionChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1141,6 +1173,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCommissionChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledCommissionChanges = append(m.ScheduledCommissionChanges, CommissionChange{})
+			if err := m.ScheduledCommissionChanges[len(m.ScheduledCommissionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -110,6 +110,27 @@ var (
 	// Key format: 0x95 || FormatTimeBytes(expiryTime) || lengthPrefix(oldConsAddr)
 	// Value:      marshaled ConsKeyEvidenceExpiry
 	ConsKeyEvidenceExpiryQueueKey = []byte{0x95}
+
+	// TokenizedShareValidatorsKey is the prefix for the index of the
+	// validators having tokenized shares, whose delegation is held by their
+	// tokenized share custody address.
+	//
+	// Key format: 0xa1 || lengthPrefix(valAddr)
+	TokenizedShareValidatorsKey = []byte{0xa1}
+
+	// ValidatorCommissionChangeKey is the prefix for a validator's scheduled
+	// commission change.
+	//
+	// Key format: 0xa2 || lengthPrefix(valAddr)
+	// Value:      marshaled CommissionChange
+	ValidatorCommissionChangeKey = []byte{0xa2}
+
+	// CommissionChangeQueueKey is the prefix for the queue of scheduled
+	// commission changes, keyed by their effective time.
+	//
+	// Key format: 0xa3 || FormatTimeBytes(effectiveTime) || lengthPrefix(valAddr)
+	// Value:      empty
+	CommissionChangeQueueKey = []byte{0xa3}
 )
 
 // ConsensusUpdateDelay is CometBFT's two-block validator-update delay.
@@ -670,3 +691,56 @@ func ParseConsKeyRotationApplyQueueKey(bz []byte) (int64, sdk.ValAddress, error)
 	kv.AssertKeyAtLeastLength(bz, prefixLen+8+1+valAddrLen)
 	return applyHeight, sdk.ValAddress(bz[prefixLen+8+1 : prefixLen+8+1+valAddrLen]), nil
 }
+
+// GetTokenizedShareValidatorKey creates the key of a validator in the index of
+// the validators having tokenized shares.
+func GetTokenizedShareValidatorKey(valAddr sdk.ValAddress) []byte {
+	return append(TokenizedShareValidatorsKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorCommissionChangeKey returns the key for a validator's scheduled
+// commission change.
+func GetValidatorCommissionChangeKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorCommissionChangeKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetCommissionChangeQueueKey returns the queue key for a commission change
+// taking effect at the given time.
+func GetCommissionChangeQueueKey(effectiveTime time.Time, valAddr sdk.ValAddress) []byte {
+	timeBz := sdk.FormatTimeBytes(effectiveTime)
+	valBz := address.MustLengthPrefix(valAddr)
+
+	key := make([]byte, len(CommissionChangeQueueKey)+len(timeBz)+len(valBz))
+	copy(key, CommissionChangeQueueKey)
+	copy(key[len(CommissionChangeQueueKey):], timeBz)
+	copy(key[len(CommissionChangeQueueKey)+len(timeBz):], valBz)
+	return key
+}
+
+// GetCommissionChangeQueueTimePrefix returns the queue iteration prefix up to
+// the given time.
+func GetCommissionChangeQueueTimePrefix(effectiveTime time.Time) []byte {
+	return append(CommissionChangeQueueKey, sdk.FormatTimeBytes(effectiveTime)...)
+}
+
+// ParseCommissionChangeQueueKey extracts the effective time and validator
+// address from a queue key.
+func ParseCommissionChangeQueueKey(bz []byte) (time.Time, sdk.ValAddress, error) {
+	prefixLen := len(CommissionChangeQueueKey)
+	if prefix := bz[:prefixLen]; !bytes.Equal(prefix, CommissionChangeQueueKey) {
+		return time.Time{}, nil, fmt.Errorf("invalid prefix; expected: %X, got: %X", CommissionChangeQueueKey, prefix)
+	}
+
+	timeLen := len(sdk.SortableTimeFormat)
+	kv.AssertKeyAtLeastLength(bz, prefixLen+timeLen+1)
+
+	ts, err := sdk.ParseTimeBytes(bz[prefixLen : prefixLen+timeLen])
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	valAddrLen := int(bz[prefixLen+timeLen])
+	kv.AssertKeyAtLeastLength(bz, prefixLen+timeLen+1+valAddrLen)
+
+	return ts, sdk.ValAddress(bz[prefixLen+timeLen+1 : prefixLen+timeLen+1+valAddrLen]), nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"slices"
+	"time"
 
 	"cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
@@ -24,6 +25,10 @@ var (
 	_ sdk.Msg                            = &MsgUpdateParams{}
 	_ sdk.Msg                            = &MsgRotateConsPubKey{}
 	_ codectypes.UnpackInterfacesMessage = (*MsgRotateConsPubKey)(nil)
+	_ sdk.Msg                            = &MsgTokenizeShares{}
+	_ sdk.Msg                            = &MsgRedeemTokensForShares{}
+	_ sdk.Msg                            = &MsgScheduleCommissionChange{}
+	_ sdk.Msg                            = &MsgCancelCommissionChange{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -197,3 +202,36 @@ func NewMsgCancelUnbondingDelegation(delAddr, valAddr string, creationHeight int
 		CreationHeight:   creationHeight,
 	}
 }
+
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+func NewMsgTokenizeShares(delAddr, valAddr string, amount sdk.Coin) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+func NewMsgRedeemTokensForShares(delAddr string, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgScheduleCommissionChange creates a new MsgScheduleCommissionChange instance.
+func NewMsgScheduleCommissionChange(valAddr string, rate math.LegacyDec, effectiveTime time.Time) *MsgScheduleCommissionChange {
+	return &MsgScheduleCommissionChange{
+		ValidatorAddress: valAddr,
+		Rate:             rate,
+		EffectiveTime:    effectiveTime,
+	}
+}
+
+// NewMsgCancelCommissionChange creates a new MsgCancelCommissionChange instance.
+func NewMsgCancelCommissionChange(valAddr string) *MsgCancelCommissionChange {
+	return &MsgCancelCommissionChange{
+		ValidatorAddress: valAddr,
+	}
+}
@@ -29,6 +29,10 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultMinCommissionChangeNotice matches the unbonding time, so delegators
+	// can fully unbond before a scheduled commission change takes effect.
+	DefaultMinCommissionChangeNotice = DefaultUnbondingTime
 )
 
 var (
@@ -39,6 +43,12 @@ var (
 	// validator's consensus key. The fee denom is always the bond denom, so only
 	// the amount has a fixed default; callers pair it with the active bond denom.
 	DefaultKeyRotationFeeAmount = math.NewInt(1000000)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, that is no cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, that is no cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
 )
 
 // NewParams creates a new Params instance
@@ -48,15 +58,20 @@ func NewParams(
 	bondDenom string,
 	minCommissionRate math.LegacyDec,
 	keyRotationFee sdk.Coin,
+	globalLiquidStakingCap, validatorLiquidStakingCap math.LegacyDec,
+	minCommissionChangeNotice time.Duration,
 ) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
-		KeyRotationFee:    keyRotationFee,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		KeyRotationFee:            keyRotationFee,
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+		MinCommissionChangeNotice: minCommissionChangeNotice,
 	}
 }
 
@@ -73,6 +88,9 @@ func DefaultParams() Params {
 		// sdk.DefaultBondDenom is overridden (e.g. init --default-denom);
 		// Validate requires the two denoms to match.
 		sdk.NewCoin(sdk.DefaultBondDenom, DefaultKeyRotationFeeAmount),
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultMinCommissionChangeNotice,
 	)
 }
 
@@ -126,6 +144,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return fmt.Errorf("global liquid staking cap: %w", err)
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return fmt.Errorf("validator liquid staking cap: %w", err)
+	}
+
+	if err := validateMinCommissionChangeNotice(p.MinCommissionChangeNotice); err != nil {
+		return err
+	}
+
 	// The rotation fee is charged in this denom, so a validator can only pay it
 	// if it matches the staking denom they already hold.
 	if p.KeyRotationFee.Denom != p.BondDenom {
@@ -250,3 +280,35 @@ func validateKeyRotationFee(i any) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(i any) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinCommissionChangeNotice(i any) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("minimum commission change notice cannot be negative: %d", v)
+	}
+
+	return nil
+}
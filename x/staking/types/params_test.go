@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -60,4 +61,12 @@ func TestValidateParams(t *testing.T) {
 	params.BondDenom = "uatom"
 	params.KeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)
 	require.Error(t, params.Validate())
+
+	// a zero commission change notice is allowed, a negative one is not
+	params = types.DefaultParams()
+	params.MinCommissionChangeNotice = 0
+	require.NoError(t, params.Validate())
+
+	params.MinCommissionChangeNotice = -time.Second
+	require.Error(t, params.Validate())
 }
@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -1391,6 +1393,274 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryTokenizedSharesRequest is request type for the Query/TokenizedShares RPC method.
+type QueryTokenizedSharesRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryTokenizedSharesRequest) Reset()         { *m = QueryTokenizedSharesRequest{} }
+func (m *QueryTokenizedSharesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizedSharesRequest) ProtoMessage()    {}
+func (*QueryTokenizedSharesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{28}
+}
+func (m *QueryTokenizedSharesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizedSharesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizedSharesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizedSharesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizedSharesRequest.Merge(m, src)
+}
+func (m *QueryTokenizedSharesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizedSharesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizedSharesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizedSharesRequest proto.InternalMessageInfo
+
+func (m *QueryTokenizedSharesRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// QueryTokenizedSharesResponse is response type for the Query/TokenizedShares RPC method.
+type QueryTokenizedSharesResponse struct {
+	// supply is the supply of the share tokens of the validator.
+	Supply types.Coin `protobuf:"bytes,1,opt,name=supply,proto3" json:"supply"`
+	// shares is the amount of delegation shares backing the share tokens.
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+	// tokens is the amount of bonded tokens backing the share tokens.
+	Tokens cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=tokens,proto3,customtype=cosmossdk.io/math.Int" json:"tokens"`
+}
+
+func (m *QueryTokenizedSharesResponse) Reset()         { *m = QueryTokenizedSharesResponse{} }
+func (m *QueryTokenizedSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizedSharesResponse) ProtoMessage()    {}
+func (*QueryTokenizedSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{29}
+}
+func (m *QueryTokenizedSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizedSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizedSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizedSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizedSharesResponse.Merge(m, src)
+}
+func (m *QueryTokenizedSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizedSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizedSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizedSharesResponse proto.InternalMessageInfo
+
+func (m *QueryTokenizedSharesResponse) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+// QueryTotalLiquidStakedRequest is request type for the Query/TotalLiquidStaked RPC method.
+type QueryTotalLiquidStakedRequest struct {
+}
+
+func (m *QueryTotalLiquidStakedRequest) Reset()         { *m = QueryTotalLiquidStakedRequest{} }
+func (m *QueryTotalLiquidStakedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidStakedRequest) ProtoMessage()    {}
+func (*QueryTotalLiquidStakedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{30}
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidStakedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidStakedRequest.Merge(m, src)
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidStakedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalLiquidStakedRequest proto.InternalMessageInfo
+
+// QueryTotalLiquidStakedResponse is response type for the Query/TotalLiquidStaked RPC method.
+type QueryTotalLiquidStakedResponse struct {
+	// tokens is the amount of tokens tokenized into share tokens.
+	Tokens cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=tokens,proto3,customtype=cosmossdk.io/math.Int" json:"tokens"`
+}
+
+func (m *QueryTotalLiquidStakedResponse) Reset()         { *m = QueryTotalLiquidStakedResponse{} }
+func (m *QueryTotalLiquidStakedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidStakedResponse) ProtoMessage()    {}
+func (*QueryTotalLiquidStakedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{31}
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidStakedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidStakedResponse.Merge(m, src)
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidStakedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalLiquidStakedResponse proto.InternalMessageInfo
+
+// QueryScheduledCommissionChangeRequest is request type for the
+// Query/ScheduledCommissionChange RPC method.
+type QueryScheduledCommissionChangeRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryScheduledCommissionChangeRequest) Reset()         { *m = QueryScheduledCommissionChangeRequest{} }
+func (m *QueryScheduledCommissionChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCommissionChangeRequest) ProtoMessage()    {}
+func (*QueryScheduledCommissionChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{32}
+}
+func (m *QueryScheduledCommissionChangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCommissionChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCommissionChangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledCommissionChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCommissionChangeRequest.Merge(m, src)
+}
+func (m *QueryScheduledCommissionChangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCommissionChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCommissionChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCommissionChangeRequest proto.InternalMessageInfo
+
+func (m *QueryScheduledCommissionChangeRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// QueryScheduledCommissionChangeResponse is response type for the
+// Query/ScheduledCommissionChange RPC method.
+type QueryScheduledCommissionChangeResponse struct {
+	// change is the commission change scheduled by the validator, if any.
+	Change *CommissionChange `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+}
+
+func (m *QueryScheduledCommissionChangeResponse) Reset() {
+	*m = QueryScheduledCommissionChangeResponse{}
+}
+func (m *QueryScheduledCommissionChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCommissionChangeResponse) ProtoMessage()    {}
+func (*QueryScheduledCommissionChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{33}
+}
+func (m *QueryScheduledCommissionChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCommissionChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCommissionChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduledCommissionChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCommissionChangeResponse.Merge(m, src)
+}
+func (m *QueryScheduledCommissionChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCommissionChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCommissionChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCommissionChangeResponse proto.InternalMessageInfo
+
+func (m *QueryScheduledCommissionChangeResponse) GetChange() *CommissionChange {
+	if m != nil {
+		return m.Change
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorsRequest")
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorsResponse")
@@ -1420,6 +1690,12 @@ func init() {
 	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.staking.v1beta1.QueryPoolResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.staking.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.staking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryTokenizedSharesRequest)(nil), "cosmos.staking.v1beta1.QueryTokenizedSharesRequest")
+	proto.RegisterType((*QueryTokenizedSharesResponse)(nil), "cosmos.staking.v1beta1.QueryTokenizedSharesResponse")
+	proto.RegisterType((*QueryTotalLiquidStakedRequest)(nil), "cosmos.staking.v1beta1.QueryTotalLiquidStakedRequest")
+	proto.RegisterType((*QueryTotalLiquidStakedResponse)(nil), "cosmos.staking.v1beta1.QueryTotalLiquidStakedResponse")
+	proto.RegisterType((*QueryScheduledCommissionChangeRequest)(nil), "cosmos.staking.v1beta1.QueryScheduledCommissionChangeRequest")
+	proto.RegisterType((*QueryScheduledCommissionChangeResponse)(nil), "cosmos.staking.v1beta1.QueryScheduledCommissionChangeResponse")
 }
 
 func init() {
@@ -1427,94 +1703,114 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdb, 0x6b, 0x1c, 0x65,
-	0x1b, 0xdf, 0x37, 0xcd, 0x17, 0xbe, 0x3c, 0xa5, 0xa5, 0x7d, 0x77, 0x1b, 0xd3, 0x69, 0xba, 0xd9,
-	0x0c, 0xa5, 0xe6, 0xd0, 0xec, 0x98, 0xa4, 0xb6, 0xb1, 0xa2, 0xed, 0xc6, 0x52, 0x5b, 0x5b, 0xda,
-	0x74, 0xc5, 0xe0, 0x91, 0x30, 0xc9, 0x4c, 0x67, 0x87, 0x6e, 0x66, 0xb6, 0xf3, 0x4e, 0x42, 0x4b,
-	0x08, 0x82, 0x17, 0xd2, 0x2b, 0x11, 0xbc, 0x97, 0x5e, 0x8a, 0x28, 0xe4, 0x22, 0x15, 0xbd, 0xd0,
-	0x4b, 0xe9, 0x85, 0x48, 0xa8, 0x54, 0xf4, 0xa6, 0x4a, 0x22, 0xe8, 0x8d, 0xff, 0x81, 0x88, 0xec,
-	0xcc, 0x33, 0xa7, 0xcc, 0x61, 0x67, 0x37, 0x1b, 0x48, 0x6e, 0xda, 0xdd, 0x77, 0x9e, 0xc3, 0xef,
-	0xf7, 0x1c, 0xde, 0x79, 0x9e, 0x0d, 0xf0, 0xf3, 0x3a, 0x5b, 0xd0, 0x99, 0xc0, 0x4c, 0xf1, 0xb6,
-	0xaa, 0x29, 0xc2, 0xd2, 0xd8, 0x9c, 0x6c, 0x8a, 0x63, 0xc2, 0x9d, 0x45, 0xd9, 0xb8, 0x57, 0xac,
-	0x19, 0xba, 0xa9, 0xd3, 0x1e, 0x5b, 0xa6, 0x88, 0x32, 0x45, 0x94, 0xe1, 0x86, 0x51, 0x77, 0x4e,
-	0x64, 0xb2, 0xad, 0xe0, 0xaa, 0xd7, 0x44, 0x45, 0xd5, 0x44, 0x53, 0xd5, 0x35, 0xdb, 0x06, 0x97,
-	0x53, 0x74, 0x45, 0xb7, 0x3e, 0x0a, 0xf5, 0x4f, 0x78, 0xda, 0xa7, 0xe8, 0xba, 0x52, 0x95, 0x05,
-	0xb1, 0xa6, 0x0a, 0xa2, 0xa6, 0xe9, 0xa6, 0xa5, 0xc2, 0xf0, 0xe9, 0x89, 0x18, 0x6c, 0x0e, 0x0e,
-	0x5b, 0xea, 0xa8, 0x2d, 0x35, 0x6b, 0x1b, 0x47, 0xa8, 0xf6, 0xa3, 0x63, 0x68, 0xc0, 0xc1, 0xe6,
-	0x67, 0xc5, 0x1d, 0x16, 0x17, 0x54, 0x4d, 0x17, 0xac, 0x7f, 0xed, 0x23, 0xfe, 0x2e, 0xf4, 0xdc,
-	0xac, 0x4b, 0xcc, 0x88, 0x55, 0x55, 0x12, 0x4d, 0xdd, 0x60, 0x65, 0xf9, 0xce, 0xa2, 0xcc, 0x4c,
-	0xda, 0x03, 0x5d, 0xcc, 0x14, 0xcd, 0x45, 0xd6, 0x4b, 0x0a, 0x64, 0xb0, 0xbb, 0x8c, 0xdf, 0xe8,
-	0x25, 0x00, 0x8f, 0x6a, 0x6f, 0x47, 0x81, 0x0c, 0xee, 0x1f, 0x3f, 0x59, 0x44, 0x10, 0xf5, 0xb8,
-	0x14, 0x6d, 0x97, 0x08, 0xbd, 0x38, 0x2d, 0x2a, 0x32, 0xda, 0x2c, 0xfb, 0x34, 0xf9, 0x55, 0x02,
-	0xcf, 0x84, 0x5c, 0xb3, 0x9a, 0xae, 0x31, 0x99, 0x5e, 0x03, 0x58, 0x72, 0x4f, 0x7b, 0x49, 0x61,
-	0xdf, 0xe0, 0xfe, 0xf1, 0x81, 0x62, 0x74, 0x4e, 0x8a, 0xae, 0xfe, 0x54, 0xf7, 0xa3, 0xa7, 0xfd,
-	0x99, 0xcf, 0xfe, 0x5c, 0x1d, 0x26, 0x65, 0x9f, 0x3e, 0x7d, 0x35, 0x02, 0xf1, 0xb3, 0x0d, 0x11,
-	0xdb, 0x50, 0x02, 0x90, 0x45, 0x38, 0x12, 0x44, 0xec, 0xc4, 0xea, 0x32, 0x1c, 0x74, 0xfd, 0xcd,
-	0x8a, 0x92, 0x64, 0xd8, 0x31, 0x9b, 0x1a, 0x78, 0xbc, 0x36, 0x7a, 0x1c, 0x1d, 0xb9, 0x4a, 0x25,
-	0x49, 0x32, 0x64, 0xc6, 0x5e, 0x37, 0x0d, 0x55, 0x53, 0xca, 0x07, 0x96, 0xfc, 0xe7, 0xbc, 0xb4,
-	0x35, 0x1f, 0x6e, 0x4c, 0x5e, 0x83, 0x6e, 0x57, 0xd4, 0x32, 0xdf, 0x6c, 0x48, 0x3c, 0x75, 0x7e,
-	0x8d, 0x40, 0x21, 0xe8, 0xe6, 0xa2, 0x5c, 0x95, 0x15, 0xbb, 0x14, 0xdb, 0x4e, 0xaa, 0x6d, 0x25,
-	0xf3, 0x37, 0x81, 0x81, 0x04, 0xd8, 0x18, 0xa8, 0xf7, 0x21, 0x27, 0xb9, 0xc7, 0xb3, 0x06, 0x1e,
-	0x3b, 0x65, 0x34, 0x1c, 0x17, 0x33, 0xcf, 0x94, 0x63, 0x69, 0xaa, 0x50, 0x0f, 0xde, 0xe7, 0xbf,
-	0xf5, 0x67, 0xc3, 0xcf, 0x98, 0x1d, 0xd3, 0xac, 0x14, 0x7e, 0xd2, 0xbe, 0x7a, 0xfb, 0x96, 0xc0,
-	0x50, 0x90, 0xef, 0x1b, 0xda, 0x9c, 0xae, 0x49, 0xaa, 0xa6, 0xec, 0x89, 0x7c, 0x3d, 0x25, 0x30,
-	0x9c, 0x06, 0x3f, 0x26, 0x4e, 0x81, 0xec, 0xa2, 0xf3, 0x3c, 0x94, 0xb7, 0x91, 0xb8, 0xbc, 0x45,
-	0x98, 0xf4, 0x57, 0x3d, 0x75, 0x4d, 0xee, 0x40, 0x82, 0xbe, 0x24, 0xd8, 0xae, 0xfe, 0x02, 0xb1,
-	0xb3, 0x71, 0x1e, 0x0e, 0x62, 0x6d, 0x04, 0xb3, 0xd1, 0xfb, 0x78, 0x6d, 0x34, 0x87, 0xae, 0xb6,
-	0x24, 0xc1, 0x95, 0xb7, 0x92, 0x10, 0x4e, 0x67, 0x47, 0x6b, 0xe9, 0x3c, 0xf7, 0xff, 0xfb, 0x0f,
-	0xfa, 0x33, 0x7f, 0x3d, 0xe8, 0xcf, 0xf0, 0x4b, 0x78, 0xe5, 0x86, 0xeb, 0x99, 0xbe, 0x03, 0xd9,
-	0x88, 0xae, 0xc1, 0x8b, 0xa6, 0x89, 0xa6, 0x29, 0xd3, 0x70, 0x4b, 0xf0, 0x5f, 0x11, 0xe8, 0xb7,
-	0x1c, 0x47, 0x24, 0x6b, 0x57, 0x07, 0xcc, 0xc0, 0x7b, 0x32, 0x12, 0x37, 0x46, 0xee, 0x3a, 0x74,
-	0xd9, 0x35, 0x86, 0xc1, 0x6a, 0xb5, 0x52, 0xd1, 0x0a, 0xff, 0xd0, 0xb9, 0x9c, 0x2f, 0x3a, 0xf4,
-	0x22, 0x9a, 0x7d, 0xdb, 0xd1, 0x6a, 0x53, 0x8f, 0xfb, 0x62, 0xf5, 0xb3, 0x73, 0x3b, 0x47, 0xe3,
-	0xc6, 0x68, 0x55, 0xda, 0x76, 0x3b, 0xfb, 0x42, 0xb7, 0xb3, 0xd7, 0xf0, 0x77, 0xce, 0x35, 0xec,
-	0x12, 0x4b, 0xba, 0x86, 0x77, 0x61, 0x66, 0xdc, 0x7b, 0xb8, 0x01, 0x81, 0x3d, 0x7b, 0x0f, 0xaf,
-	0x77, 0xc0, 0x51, 0x8b, 0x60, 0x59, 0x96, 0x76, 0x20, 0x23, 0x37, 0x80, 0x32, 0x63, 0x7e, 0xb6,
-	0xd5, 0xdb, 0xe5, 0x10, 0x33, 0xe6, 0x03, 0x8f, 0xea, 0x06, 0x25, 0x66, 0x6e, 0x35, 0xb8, 0x2f,
-	0xb5, 0x41, 0x89, 0x99, 0x33, 0x09, 0x6f, 0xec, 0xce, 0x36, 0xd4, 0xcc, 0x13, 0x02, 0x5c, 0x54,
-	0x48, 0xb1, 0x46, 0x34, 0xe8, 0x31, 0xe4, 0x84, 0x46, 0x3e, 0x15, 0x57, 0x26, 0x7e, 0x73, 0x51,
-	0xad, 0x7c, 0xc4, 0x90, 0x77, 0xb4, 0x99, 0xd7, 0x9c, 0x57, 0x91, 0xdb, 0x0b, 0xe1, 0xd5, 0x67,
-	0x17, 0xb6, 0xf0, 0x37, 0xa1, 0x97, 0xc2, 0xde, 0x59, 0x9b, 0x1e, 0x12, 0xc8, 0xc7, 0x60, 0xdf,
-	0xd5, 0x2f, 0xff, 0x85, 0xd8, 0x4a, 0xd9, 0x91, 0xa5, 0xec, 0x34, 0x36, 0xdc, 0x65, 0x95, 0x99,
-	0xba, 0xa1, 0xce, 0x8b, 0xd5, 0x2b, 0xda, 0x2d, 0xdd, 0xb7, 0x8e, 0x57, 0x64, 0x55, 0xa9, 0x98,
-	0x96, 0x9b, 0x7d, 0x65, 0xfc, 0xc6, 0xbf, 0x05, 0xc7, 0x22, 0xb5, 0x10, 0xe0, 0x39, 0xe8, 0xac,
-	0xa8, 0xcc, 0x44, 0x6c, 0x27, 0xe3, 0xb0, 0x6d, 0xd1, 0xb6, 0x74, 0x78, 0x0a, 0x87, 0x2c, 0xd3,
-	0xd3, 0xba, 0x5e, 0x45, 0x18, 0xfc, 0x34, 0x1c, 0xf6, 0x9d, 0xa1, 0x93, 0x17, 0xa1, 0xb3, 0xa6,
-	0xeb, 0x55, 0x74, 0xd2, 0x17, 0xe7, 0xa4, 0xae, 0xe3, 0xe7, 0x6e, 0x29, 0xf1, 0x39, 0xa0, 0xb6,
-	0x45, 0xd1, 0x10, 0x17, 0x9c, 0x16, 0xe4, 0xdf, 0x84, 0x6c, 0xe0, 0x14, 0x3d, 0x95, 0xa0, 0xab,
-	0x66, 0x9d, 0xa0, 0xaf, 0x7c, 0xac, 0x2f, 0x4b, 0x2a, 0x30, 0x5e, 0xd9, 0x8a, 0xe3, 0xab, 0x3d,
-	0xf0, 0x3f, 0xcb, 0x34, 0xfd, 0x94, 0x00, 0x78, 0x5d, 0x44, 0x8b, 0x71, 0xb6, 0xa2, 0x7f, 0x20,
-	0xe1, 0x84, 0xd4, 0xf2, 0x38, 0x05, 0x0b, 0xf7, 0xeb, 0x40, 0x3e, 0xf8, 0xe9, 0x8f, 0x4f, 0x3a,
-	0x4e, 0x50, 0x5e, 0x88, 0xf9, 0xa9, 0xc7, 0xd7, 0x81, 0x5f, 0x10, 0xe8, 0x76, 0xed, 0xd0, 0xd1,
-	0x74, 0xfe, 0x1c, 0x78, 0xc5, 0xb4, 0xe2, 0x88, 0xee, 0x82, 0x87, 0xee, 0x79, 0x3a, 0xd1, 0x18,
-	0x9d, 0xb0, 0x1c, 0x6c, 0xb8, 0x15, 0xfa, 0x2b, 0x81, 0x5c, 0xd4, 0x66, 0x4e, 0x27, 0xd3, 0x41,
-	0x09, 0x0f, 0x53, 0xdc, 0x0b, 0x2d, 0x68, 0x22, 0x9f, 0x6b, 0x1e, 0x9f, 0x12, 0x3d, 0xdf, 0x02,
-	0x1f, 0xc1, 0xf7, 0xde, 0xa3, 0xff, 0x12, 0x38, 0x9e, 0xb8, 0xc5, 0xd2, 0x52, 0x3a, 0xa8, 0x09,
-	0xa3, 0x23, 0x37, 0xb5, 0x1d, 0x13, 0x48, 0x7b, 0xc6, 0xa3, 0x7d, 0x95, 0x5e, 0x69, 0x85, 0xb6,
-	0x37, 0xfb, 0xf9, 0x03, 0xf0, 0x03, 0x01, 0xf0, 0xfc, 0x35, 0x68, 0x96, 0xd0, 0x76, 0xd7, 0xa0,
-	0x59, 0xc2, 0xd3, 0x3d, 0xff, 0x9e, 0xc7, 0xa3, 0x4c, 0xa7, 0xb7, 0x99, 0x3e, 0x61, 0x39, 0xf8,
-	0x76, 0x59, 0xa1, 0xff, 0x10, 0xc8, 0x46, 0xc4, 0x91, 0x9e, 0x4d, 0xc4, 0x19, 0xbf, 0xbe, 0x72,
-	0x93, 0xcd, 0x2b, 0x22, 0x53, 0xc3, 0x63, 0xaa, 0x50, 0xb9, 0xdd, 0x4c, 0x23, 0xd3, 0x49, 0x7f,
-	0x24, 0x90, 0x8b, 0x5a, 0xd3, 0x1a, 0xb4, 0x6a, 0xc2, 0x46, 0xda, 0xa0, 0x55, 0x93, 0x76, 0x42,
-	0xbe, 0xe4, 0x45, 0xe0, 0x0c, 0x3d, 0x1d, 0x17, 0x81, 0xc4, 0x7c, 0xd6, 0xfb, 0x33, 0x71, 0xbb,
-	0x69, 0xd0, 0x9f, 0x69, 0x56, 0xbb, 0x06, 0xfd, 0x99, 0x6a, 0xb9, 0x4a, 0xd9, 0x9f, 0x2e, 0xbd,
-	0x94, 0x09, 0x65, 0xf4, 0x7b, 0x02, 0x07, 0x02, 0xa3, 0x3a, 0x1d, 0x4b, 0x44, 0x1b, 0xb5, 0x29,
-	0x71, 0xe3, 0xcd, 0xa8, 0x20, 0xa1, 0xeb, 0x1e, 0xa1, 0x57, 0x68, 0xa9, 0x15, 0x42, 0x46, 0x00,
-	0xf6, 0x13, 0x02, 0xd9, 0x88, 0x21, 0xb7, 0x41, 0x67, 0xc6, 0x4f, 0xf3, 0xdc, 0x64, 0xf3, 0x8a,
-	0x48, 0xed, 0xaa, 0x47, 0xed, 0x02, 0x7d, 0xb9, 0x15, 0x6a, 0xbe, 0x97, 0xf9, 0x26, 0x01, 0x1a,
-	0x76, 0x46, 0xcf, 0x34, 0x89, 0xce, 0x61, 0x75, 0xb6, 0x69, 0x3d, 0x24, 0xf5, 0xae, 0x47, 0xea,
-	0x26, 0xbd, 0xb1, 0x3d, 0x52, 0xe1, 0x19, 0xe0, 0x6b, 0x02, 0x07, 0x83, 0xc3, 0x24, 0x4d, 0x2e,
-	0xaa, 0xc8, 0x69, 0x97, 0x9b, 0x68, 0x4a, 0x07, 0x99, 0xbd, 0xe4, 0x31, 0x1b, 0xa7, 0xcf, 0xc5,
-	0x31, 0xab, 0xb8, 0xca, 0xb3, 0xaa, 0x76, 0x4b, 0x17, 0x96, 0xed, 0x41, 0x7a, 0x85, 0x7e, 0x48,
-	0xa0, 0xb3, 0x3e, 0xa2, 0xd2, 0xc1, 0x44, 0xe7, 0xbe, 0x69, 0x98, 0x1b, 0x4a, 0x21, 0x89, 0xe0,
-	0x86, 0x3c, 0x70, 0x79, 0xda, 0x17, 0x07, 0xae, 0x3e, 0x11, 0xd3, 0x8f, 0x08, 0x74, 0xd9, 0xf3,
-	0x2b, 0x1d, 0x4e, 0x76, 0xe0, 0x1f, 0x99, 0xb9, 0x91, 0x54, 0xb2, 0x08, 0x67, 0xc4, 0x83, 0x53,
-	0xa0, 0xf9, 0x58, 0x38, 0xf6, 0x14, 0x7d, 0xe9, 0xd1, 0x46, 0x9e, 0xac, 0x6f, 0xe4, 0xc9, 0xef,
-	0x1b, 0x79, 0xf2, 0xf1, 0x66, 0x3e, 0xb3, 0xbe, 0x99, 0xcf, 0xfc, 0xb2, 0x99, 0xcf, 0xbc, 0x7d,
-	0x4a, 0x51, 0xcd, 0xca, 0xe2, 0x5c, 0x71, 0x5e, 0x5f, 0x70, 0x6c, 0xd8, 0xff, 0x8d, 0x32, 0xe9,
-	0xb6, 0x70, 0xd7, 0x35, 0x68, 0xde, 0xab, 0xc9, 0x6c, 0xae, 0xcb, 0xfa, 0x9b, 0xe3, 0xc4, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x44, 0xdb, 0xeb, 0x04, 0x82, 0x1d, 0x00, 0x00,
+	// 1706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x49, 0x6c, 0x14, 0x57,
+	0x1a, 0xf6, 0x33, 0x9e, 0xd6, 0xf8, 0x47, 0x30, 0xf0, 0x6c, 0x8c, 0x5d, 0x98, 0xb6, 0x29, 0x31,
+	0x8c, 0xb1, 0x71, 0x17, 0x18, 0x30, 0x1e, 0x66, 0x58, 0xbc, 0x88, 0xc1, 0x83, 0x05, 0xa6, 0x3d,
+	0x83, 0x66, 0x55, 0xab, 0xdc, 0x55, 0x54, 0x17, 0xee, 0xae, 0x6a, 0xd7, 0xab, 0xb6, 0xf0, 0x20,
+	0x34, 0xd2, 0x1c, 0x46, 0x9c, 0xa2, 0x48, 0xb9, 0x47, 0x1c, 0xa3, 0x28, 0x91, 0x38, 0x98, 0x28,
+	0x91, 0x48, 0x8e, 0x11, 0x87, 0x28, 0x41, 0x44, 0x44, 0x49, 0x0e, 0x24, 0xc2, 0x91, 0x92, 0x4b,
+	0xae, 0x51, 0x0e, 0x51, 0x14, 0x75, 0xd5, 0x5f, 0x9b, 0x6b, 0xe9, 0xc5, 0x6d, 0xc9, 0x5c, 0xc0,
+	0x7e, 0xef, 0x5f, 0xbe, 0xef, 0x5f, 0xde, 0xab, 0xff, 0xc9, 0xc0, 0xe7, 0x75, 0x56, 0xd2, 0x99,
+	0xc0, 0x4c, 0x71, 0x49, 0xd5, 0x14, 0x61, 0xe5, 0xc4, 0xa2, 0x6c, 0x8a, 0x27, 0x84, 0xe5, 0x8a,
+	0x6c, 0xac, 0x66, 0xca, 0x86, 0x6e, 0xea, 0xb4, 0xc7, 0x96, 0xc9, 0xa0, 0x4c, 0x06, 0x65, 0xb8,
+	0x61, 0xd4, 0x5d, 0x14, 0x99, 0x6c, 0x2b, 0xb8, 0xea, 0x65, 0x51, 0x51, 0x35, 0xd1, 0x54, 0x75,
+	0xcd, 0xb6, 0xc1, 0xa5, 0xfd, 0xb2, 0x8e, 0x54, 0x5e, 0x57, 0x9d, 0xfd, 0x6e, 0x45, 0x57, 0x74,
+	0xeb, 0x47, 0xa1, 0xfa, 0x13, 0xae, 0xf6, 0x2b, 0xba, 0xae, 0x14, 0x65, 0x41, 0x2c, 0xab, 0x82,
+	0xa8, 0x69, 0xba, 0x69, 0x99, 0x64, 0xb8, 0x7b, 0x38, 0x06, 0xbb, 0x83, 0xd3, 0x96, 0xea, 0xb3,
+	0xa5, 0x72, 0xb6, 0x71, 0xa4, 0x62, 0x6f, 0x1d, 0x40, 0x03, 0x0e, 0x76, 0x3f, 0x6b, 0x6e, 0xaf,
+	0x58, 0x52, 0x35, 0x5d, 0xb0, 0xfe, 0xb5, 0x97, 0xf8, 0xdb, 0xd0, 0x73, 0xbd, 0x2a, 0x71, 0x43,
+	0x2c, 0xaa, 0x92, 0x68, 0xea, 0x06, 0xcb, 0xca, 0xcb, 0x15, 0x99, 0x99, 0xb4, 0x07, 0x52, 0xcc,
+	0x14, 0xcd, 0x0a, 0xeb, 0x25, 0x83, 0x64, 0xa8, 0x33, 0x8b, 0xbf, 0xd1, 0x4b, 0x00, 0x5e, 0x28,
+	0x7a, 0xdb, 0x07, 0xc9, 0xd0, 0xce, 0xb1, 0x23, 0x19, 0x04, 0x51, 0x8d, 0x45, 0xc6, 0x76, 0x89,
+	0xd0, 0x33, 0xf3, 0xa2, 0x22, 0xa3, 0xcd, 0xac, 0x4f, 0x93, 0x7f, 0x40, 0x60, 0x7f, 0xc8, 0x35,
+	0x2b, 0xeb, 0x1a, 0x93, 0xe9, 0x1c, 0xc0, 0x8a, 0xbb, 0xda, 0x4b, 0x06, 0x77, 0x0c, 0xed, 0x1c,
+	0x3b, 0x94, 0x89, 0xce, 0x59, 0xc6, 0xd5, 0x9f, 0xea, 0x7c, 0xfc, 0x7c, 0xa0, 0xed, 0x8d, 0x6f,
+	0x1f, 0x0c, 0x93, 0xac, 0x4f, 0x9f, 0xfe, 0x29, 0x02, 0xf1, 0xef, 0x6a, 0x22, 0xb6, 0xa1, 0x04,
+	0x20, 0x8b, 0xb0, 0x2f, 0x88, 0xd8, 0x89, 0xd5, 0x65, 0xd8, 0xed, 0xfa, 0xcb, 0x89, 0x92, 0x64,
+	0xd8, 0x31, 0x9b, 0x3a, 0xf4, 0x74, 0x6d, 0xf4, 0x20, 0x3a, 0x72, 0x95, 0x26, 0x25, 0xc9, 0x90,
+	0x19, 0x5b, 0x30, 0x0d, 0x55, 0x53, 0xb2, 0xbb, 0x56, 0xfc, 0xeb, 0xbc, 0xb4, 0x31, 0x1f, 0x6e,
+	0x4c, 0xfe, 0x0c, 0x9d, 0xae, 0xa8, 0x65, 0xbe, 0xd1, 0x90, 0x78, 0xea, 0xfc, 0x1a, 0x81, 0xc1,
+	0xa0, 0x9b, 0x19, 0xb9, 0x28, 0x2b, 0x76, 0x29, 0xb6, 0x9c, 0x54, 0xcb, 0x4a, 0xe6, 0x7b, 0x02,
+	0x87, 0x12, 0x60, 0x63, 0xa0, 0xfe, 0x0b, 0xdd, 0x92, 0xbb, 0x9c, 0x33, 0x70, 0xd9, 0x29, 0xa3,
+	0xe1, 0xb8, 0x98, 0x79, 0xa6, 0x1c, 0x4b, 0x53, 0x83, 0xd5, 0xe0, 0xbd, 0xf9, 0xd5, 0x40, 0x57,
+	0x78, 0x8f, 0xd9, 0x31, 0xed, 0x92, 0xc2, 0x3b, 0xad, 0xab, 0xb7, 0xf7, 0x09, 0x1c, 0x0d, 0xf2,
+	0xfd, 0xab, 0xb6, 0xa8, 0x6b, 0x92, 0xaa, 0x29, 0x2f, 0x45, 0xbe, 0x9e, 0x13, 0x18, 0xae, 0x07,
+	0x3f, 0x26, 0x4e, 0x81, 0xae, 0x8a, 0xb3, 0x1f, 0xca, 0xdb, 0x48, 0x5c, 0xde, 0x22, 0x4c, 0xfa,
+	0xab, 0x9e, 0xba, 0x26, 0xb7, 0x20, 0x41, 0x6f, 0x13, 0x6c, 0x57, 0x7f, 0x81, 0xd8, 0xd9, 0xb8,
+	0x00, 0xbb, 0xb1, 0x36, 0x82, 0xd9, 0xe8, 0x7d, 0xba, 0x36, 0xda, 0x8d, 0xae, 0x36, 0x24, 0xc1,
+	0x95, 0xb7, 0x92, 0x10, 0x4e, 0x67, 0x7b, 0x73, 0xe9, 0x3c, 0xfb, 0xeb, 0x7b, 0xf7, 0x07, 0xda,
+	0xbe, 0xbb, 0x3f, 0xd0, 0xc6, 0xaf, 0xc0, 0xfe, 0x10, 0x5c, 0x0c, 0xfe, 0x3f, 0xa1, 0x2b, 0xa2,
+	0x6b, 0xf0, 0xa0, 0x69, 0xa0, 0x69, 0xb2, 0x34, 0xdc, 0x12, 0xfc, 0x3b, 0x04, 0x06, 0x2c, 0xc7,
+	0x11, 0xc9, 0xda, 0xd6, 0x01, 0x33, 0x60, 0x30, 0x1e, 0x37, 0x46, 0xee, 0x2a, 0xa4, 0xec, 0x1a,
+	0xc3, 0x60, 0x35, 0x5b, 0xa9, 0x68, 0x85, 0x7f, 0xe8, 0x1c, 0xce, 0x33, 0x0e, 0xbd, 0x88, 0x66,
+	0xdf, 0x74, 0xb4, 0x5a, 0xd4, 0xe3, 0xbe, 0x58, 0x7d, 0xe6, 0x9c, 0xce, 0xd1, 0xb8, 0x31, 0x5a,
+	0x85, 0x96, 0x9d, 0xce, 0xbe, 0xd0, 0x6d, 0xed, 0x31, 0xfc, 0x81, 0x73, 0x0c, 0xbb, 0xc4, 0x92,
+	0x8e, 0xe1, 0x6d, 0x98, 0x19, 0xf7, 0x1c, 0xae, 0x41, 0xe0, 0xa5, 0x3d, 0x87, 0x9f, 0xb4, 0x43,
+	0x9f, 0x45, 0x30, 0x2b, 0x4b, 0x5b, 0x90, 0x91, 0x6b, 0x40, 0x99, 0x91, 0xcf, 0x35, 0x7b, 0xba,
+	0xec, 0x61, 0x46, 0x3e, 0xb0, 0x55, 0x35, 0x28, 0x31, 0x73, 0xa3, 0xc1, 0x1d, 0x75, 0x1b, 0x94,
+	0x98, 0x79, 0x23, 0xe1, 0xc6, 0xee, 0x68, 0x41, 0xcd, 0x3c, 0x23, 0xc0, 0x45, 0x85, 0x14, 0x6b,
+	0x44, 0x83, 0x1e, 0x43, 0x4e, 0x68, 0xe4, 0x63, 0x71, 0x65, 0xe2, 0x37, 0x17, 0xd5, 0xca, 0xfb,
+	0x0c, 0x79, 0x4b, 0x9b, 0x79, 0xcd, 0xb9, 0x8a, 0xdc, 0x5e, 0x08, 0x8f, 0x3e, 0xdb, 0xb0, 0x85,
+	0xdf, 0x0b, 0x5d, 0x0a, 0x2f, 0xcf, 0xd8, 0xf4, 0x90, 0x40, 0x3a, 0x06, 0xfb, 0xb6, 0xbe, 0xfc,
+	0x4b, 0xb1, 0x95, 0xb2, 0x25, 0x43, 0xd9, 0x29, 0x6c, 0xb8, 0xcb, 0x2a, 0x33, 0x75, 0x43, 0xcd,
+	0x8b, 0xc5, 0x59, 0xed, 0xa6, 0xee, 0x1b, 0xc7, 0x0b, 0xb2, 0xaa, 0x14, 0x4c, 0xcb, 0xcd, 0x8e,
+	0x2c, 0xfe, 0xc6, 0xff, 0x1d, 0x0e, 0x44, 0x6a, 0x21, 0xc0, 0xb3, 0xd0, 0x51, 0x50, 0x99, 0xd9,
+	0x4b, 0x82, 0x35, 0xb8, 0x11, 0xdb, 0x06, 0x6d, 0x4b, 0x87, 0xa7, 0xb0, 0xc7, 0x32, 0x3d, 0xaf,
+	0xeb, 0x45, 0x84, 0xc1, 0xcf, 0xc3, 0x5e, 0xdf, 0x1a, 0x3a, 0xf9, 0x03, 0x74, 0x94, 0x75, 0xbd,
+	0x88, 0x4e, 0xfa, 0xe3, 0x9c, 0x54, 0x75, 0xfc, 0xdc, 0x2d, 0x25, 0xbe, 0x1b, 0xa8, 0x6d, 0x51,
+	0x34, 0xc4, 0x92, 0xd3, 0x82, 0xfc, 0xdf, 0xa0, 0x2b, 0xb0, 0x8a, 0x9e, 0x26, 0x21, 0x55, 0xb6,
+	0x56, 0xd0, 0x57, 0x3a, 0xd6, 0x97, 0x25, 0x15, 0xf8, 0xbc, 0xb2, 0x15, 0x79, 0x05, 0x03, 0xf6,
+	0x17, 0x7d, 0x49, 0xd6, 0xd4, 0xff, 0xc8, 0xd2, 0x42, 0x41, 0x34, 0xe4, 0xd6, 0x4f, 0x51, 0xfc,
+	0x0f, 0x04, 0xfa, 0xa3, 0x3d, 0x21, 0x99, 0x3f, 0x42, 0x8a, 0x55, 0xca, 0xe5, 0xe2, 0x2a, 0x92,
+	0xe9, 0x0b, 0x34, 0x97, 0xc3, 0x64, 0x5a, 0x57, 0x83, 0x9f, 0x89, 0xb6, 0x4e, 0xf5, 0xb3, 0x93,
+	0x59, 0xf6, 0xb0, 0xd2, 0xc7, 0xab, 0x22, 0x5f, 0x3e, 0x1f, 0xc0, 0x17, 0x20, 0x26, 0x2d, 0x65,
+	0x54, 0x5d, 0x28, 0x89, 0x66, 0x21, 0x33, 0x27, 0x2b, 0x62, 0x7e, 0x75, 0x46, 0xce, 0x3f, 0x5d,
+	0x1b, 0x05, 0xf4, 0x31, 0x23, 0xe7, 0x1d, 0x7b, 0x96, 0x15, 0x7a, 0x19, 0x52, 0x66, 0x15, 0x28,
+	0xc3, 0x7b, 0xe8, 0x38, 0xda, 0xdb, 0x17, 0xb6, 0x37, 0xab, 0x99, 0x3e, 0x4b, 0xb3, 0x9a, 0x89,
+	0x96, 0x6c, 0x7d, 0x7e, 0x00, 0x0e, 0x22, 0x6f, 0x53, 0x2c, 0xce, 0xa9, 0xcb, 0x15, 0x55, 0x5a,
+	0x30, 0xc5, 0x25, 0x59, 0x72, 0x92, 0x7b, 0x0b, 0xd2, 0x71, 0x02, 0x18, 0x1a, 0x0f, 0x0c, 0xd9,
+	0x24, 0x98, 0x65, 0xf8, 0xad, 0xe5, 0x6b, 0x21, 0x5f, 0x90, 0xa5, 0x4a, 0x51, 0x96, 0xa6, 0xf5,
+	0x52, 0x49, 0x65, 0x4c, 0xd5, 0xb5, 0xe9, 0x82, 0xa8, 0x29, 0x72, 0xeb, 0x13, 0x7f, 0x0b, 0x8e,
+	0xd4, 0x72, 0x89, 0x34, 0x2f, 0x42, 0x2a, 0x6f, 0xad, 0x60, 0x05, 0x0c, 0xc5, 0x95, 0x73, 0xc8,
+	0x02, 0xea, 0x8d, 0x3d, 0x3a, 0x00, 0xbf, 0xb2, 0x9c, 0xd1, 0xd7, 0x09, 0x80, 0x77, 0x27, 0xd0,
+	0x4c, 0x9c, 0xa9, 0xe8, 0xe7, 0x3e, 0x4e, 0xa8, 0x5b, 0x1e, 0x67, 0x3a, 0xe1, 0x5e, 0x35, 0xce,
+	0xff, 0xfb, 0xf4, 0x9b, 0xd7, 0xda, 0x0f, 0x53, 0x5e, 0x88, 0x79, 0xb8, 0xf4, 0xdd, 0x27, 0x6f,
+	0x11, 0xe8, 0x74, 0xed, 0xd0, 0xd1, 0xfa, 0xfc, 0x39, 0xf0, 0x32, 0xf5, 0x8a, 0x23, 0xba, 0x8b,
+	0x1e, 0xba, 0xd3, 0xf4, 0x64, 0x6d, 0x74, 0xc2, 0x9d, 0x60, 0xf2, 0xef, 0xd2, 0x2f, 0x08, 0x74,
+	0x47, 0xbd, 0x33, 0xd1, 0x89, 0xfa, 0xa0, 0x84, 0x47, 0x03, 0xee, 0xf7, 0x4d, 0x68, 0x22, 0x9f,
+	0x39, 0x8f, 0xcf, 0x24, 0xbd, 0xd0, 0x04, 0x1f, 0xc1, 0xf7, 0x15, 0x47, 0x7f, 0x26, 0x70, 0x30,
+	0xf1, 0x4d, 0x86, 0x4e, 0xd6, 0x07, 0x35, 0x61, 0x10, 0xe2, 0xa6, 0x36, 0x63, 0x02, 0x69, 0xdf,
+	0xf0, 0x68, 0x5f, 0xa1, 0xb3, 0xcd, 0xd0, 0xf6, 0x26, 0x19, 0x7f, 0x00, 0x3e, 0x22, 0x00, 0x9e,
+	0xbf, 0x1a, 0xcd, 0x12, 0x7a, 0xab, 0xe0, 0x84, 0xba, 0xe5, 0x91, 0xc7, 0xbf, 0x3d, 0x1e, 0x59,
+	0x3a, 0xbf, 0xc9, 0xf4, 0x09, 0x77, 0x82, 0xdf, 0x4a, 0x77, 0xe9, 0x4f, 0x04, 0xba, 0x22, 0xe2,
+	0x48, 0xcf, 0x24, 0xe2, 0x8c, 0x7f, 0x8c, 0xe1, 0x26, 0x1a, 0x57, 0x44, 0xa6, 0x86, 0xc7, 0x54,
+	0xa1, 0x72, 0xab, 0x99, 0x46, 0xa6, 0x93, 0x7e, 0x4c, 0xa0, 0x3b, 0xea, 0xd1, 0xa1, 0x46, 0xab,
+	0x26, 0xbc, 0xaf, 0xd4, 0x68, 0xd5, 0xa4, 0x17, 0x0e, 0x7e, 0xd2, 0x8b, 0xc0, 0x38, 0x3d, 0x15,
+	0x17, 0x81, 0xc4, 0x7c, 0x56, 0xfb, 0x33, 0x71, 0x56, 0xaf, 0xd1, 0x9f, 0xf5, 0x3c, 0x54, 0xd4,
+	0xe8, 0xcf, 0xba, 0x9e, 0x0a, 0xea, 0xec, 0x4f, 0x97, 0x5e, 0x9d, 0x09, 0x65, 0xf4, 0x43, 0x02,
+	0xbb, 0x02, 0x83, 0x27, 0x3d, 0x91, 0x88, 0x36, 0x6a, 0xee, 0xe7, 0xc6, 0x1a, 0x51, 0x41, 0x42,
+	0x57, 0x3d, 0x42, 0xd3, 0x74, 0xb2, 0x19, 0x42, 0x46, 0x00, 0xf6, 0x33, 0x02, 0x5d, 0x11, 0x23,
+	0x5b, 0x8d, 0xce, 0x8c, 0x9f, 0x4d, 0xb9, 0x89, 0xc6, 0x15, 0x91, 0xda, 0x15, 0x8f, 0xda, 0x45,
+	0x7a, 0xbe, 0x19, 0x6a, 0xbe, 0xcb, 0x7c, 0x9d, 0x00, 0x0d, 0x3b, 0xa3, 0xe3, 0x0d, 0xa2, 0x73,
+	0x58, 0x9d, 0x69, 0x58, 0x0f, 0x49, 0xfd, 0xcb, 0x23, 0x75, 0x9d, 0x5e, 0xdb, 0x1c, 0xa9, 0xf0,
+	0x37, 0xc0, 0xbb, 0x04, 0x76, 0x07, 0x47, 0x23, 0x9a, 0x5c, 0x54, 0x91, 0xb3, 0x1b, 0x77, 0xb2,
+	0x21, 0x1d, 0x64, 0x76, 0xce, 0x63, 0x36, 0x46, 0x8f, 0xc7, 0x31, 0x2b, 0xb8, 0xca, 0x39, 0x55,
+	0xbb, 0xa9, 0x0b, 0x77, 0xec, 0xb1, 0xf0, 0x2e, 0xfd, 0x3f, 0x81, 0x8e, 0xea, 0xc0, 0x45, 0x87,
+	0x12, 0x9d, 0xfb, 0x66, 0x3b, 0xee, 0x68, 0x1d, 0x92, 0x08, 0xee, 0xa8, 0x07, 0x2e, 0x4d, 0xfb,
+	0xe3, 0xc0, 0x55, 0xe7, 0x3b, 0xfa, 0x0a, 0x81, 0x94, 0x3d, 0x8d, 0xd1, 0xe1, 0x64, 0x07, 0xfe,
+	0x01, 0x90, 0x1b, 0xa9, 0x4b, 0x16, 0xe1, 0x8c, 0x78, 0x70, 0x06, 0x69, 0x3a, 0x16, 0x8e, 0x8d,
+	0xe2, 0x13, 0x02, 0xbf, 0xd9, 0x30, 0x92, 0xd1, 0xe4, 0x0c, 0x45, 0x8f, 0x8a, 0xdc, 0xa9, 0xc6,
+	0x94, 0x10, 0xeb, 0x75, 0x0f, 0xeb, 0x25, 0x3a, 0xd3, 0xcc, 0x05, 0x69, 0x3a, 0x96, 0x73, 0x38,
+	0xba, 0x3d, 0x22, 0xb0, 0x37, 0x34, 0x4b, 0xd1, 0xd3, 0x35, 0xe0, 0x45, 0x0f, 0x67, 0xdc, 0x78,
+	0xa3, 0x6a, 0xc8, 0x6b, 0xc2, 0xe3, 0x35, 0x4a, 0x47, 0xe2, 0x78, 0x99, 0x55, 0xfd, 0x5c, 0xd1,
+	0x32, 0x90, 0x63, 0x36, 0xd0, 0x1f, 0x09, 0xf4, 0xc5, 0xce, 0x4a, 0xf4, 0x5c, 0x22, 0x9e, 0x5a,
+	0x63, 0x1d, 0x77, 0xbe, 0x59, 0xf5, 0x86, 0x0e, 0x98, 0xa4, 0x74, 0x31, 0xc7, 0x47, 0x2e, 0xef,
+	0x3a, 0xc9, 0xd9, 0xe3, 0xdb, 0xd4, 0xa5, 0xc7, 0x2f, 0xd2, 0xe4, 0xc9, 0x8b, 0x34, 0xf9, 0xfa,
+	0x45, 0x9a, 0xbc, 0xba, 0x9e, 0x6e, 0x7b, 0xb2, 0x9e, 0x6e, 0xfb, 0x7c, 0x3d, 0xdd, 0xf6, 0x8f,
+	0x63, 0x8a, 0x6a, 0x16, 0x2a, 0x8b, 0x99, 0xbc, 0x5e, 0x72, 0x9c, 0xda, 0xff, 0x8d, 0x32, 0x69,
+	0x49, 0xb8, 0xed, 0x22, 0x30, 0x57, 0xcb, 0x32, 0x5b, 0x4c, 0x59, 0x7f, 0xcd, 0x71, 0xf2, 0x97,
+	0x01, 0x00, 0x18, 0x51, 0xa8, 0xce, 0xfc, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1582,6 +1878,14 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TokenizedShares queries the share tokens of a validator.
+	TokenizedShares(ctx context.Context, in *QueryTokenizedSharesRequest, opts ...grpc.CallOption) (*QueryTokenizedSharesResponse, error)
+	// TotalLiquidStaked queries the amount of tokens tokenized into share tokens
+	// across all the validators.
+	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
+	// ScheduledCommissionChange queries the commission change scheduled by a
+	// validator.
+	ScheduledCommissionChange(ctx context.Context, in *QueryScheduledCommissionChangeRequest, opts ...grpc.CallOption) (*QueryScheduledCommissionChangeResponse, error)
 }
 
 type queryClient struct {
@@ -1718,6 +2022,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TokenizedShares(ctx context.Context, in *QueryTokenizedSharesRequest, opts ...grpc.CallOption) (*QueryTokenizedSharesResponse, error) {
+	out := new(QueryTokenizedSharesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/TokenizedShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error) {
+	out := new(QueryTotalLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/TotalLiquidStaked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledCommissionChange(ctx context.Context, in *QueryScheduledCommissionChangeRequest, opts ...grpc.CallOption) (*QueryScheduledCommissionChangeResponse, error) {
+	out := new(QueryScheduledCommissionChangeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ScheduledCommissionChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Validators queries all validators that match the given status.
@@ -1773,6 +2104,14 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TokenizedShares queries the share tokens of a validator.
+	TokenizedShares(context.Context, *QueryTokenizedSharesRequest) (*QueryTokenizedSharesResponse, error)
+	// TotalLiquidStaked queries the amount of tokens tokenized into share tokens
+	// across all the validators.
+	TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error)
+	// ScheduledCommissionChange queries the commission change scheduled by a
+	// validator.
+	ScheduledCommissionChange(context.Context, *QueryScheduledCommissionChangeRequest) (*QueryScheduledCommissionChangeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1821,6 +2160,15 @@ func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) TokenizedShares(ctx context.Context, req *QueryTokenizedSharesRequest) (*QueryTokenizedSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizedShares not implemented")
+}
+func (*UnimplementedQueryServer) TotalLiquidStaked(ctx context.Context, req *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidStaked not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledCommissionChange(ctx context.Context, req *QueryScheduledCommissionChangeRequest) (*QueryScheduledCommissionChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledCommissionChange not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2078,6 +2426,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenizedShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizedSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizedShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/TokenizedShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizedShares(ctx, req.(*QueryTokenizedSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/TotalLiquidStaked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, req.(*QueryTotalLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledCommissionChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCommissionChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledCommissionChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/ScheduledCommissionChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledCommissionChange(ctx, req.(*QueryScheduledCommissionChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Query",
@@ -2139,6 +2541,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TokenizedShares",
+			Handler:    _Query_TokenizedShares_Handler,
+		},
+		{
+			MethodName: "TotalLiquidStaked",
+			Handler:    _Query_TotalLiquidStaked_Handler,
+		},
+		{
+			MethodName: "ScheduledCommissionChange",
+			Handler:    _Query_ScheduledCommissionChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -3245,30 +3659,234 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTokenizedSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryTokenizedSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizedSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenizedSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizedSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizedSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidStakedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidStakedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidStakedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidStakedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidStakedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidStakedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCommissionChangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCommissionChangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCommissionChangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCommissionChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCommissionChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCommissionChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Change != nil {
+		{
+			size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -3691,6 +4309,80 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTokenizedSharesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenizedSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Tokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalLiquidStakedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalLiquidStakedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduledCommissionChangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledCommissionChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Change != nil {
+		l = m.Change.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6607,6 +7299,541 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTokenizedSharesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizedSharesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizedSharesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenizedSharesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizedSharesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizedSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalLiquidStakedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalLiquidStakedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCommissionChangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCommissionChangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCommissionChangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCommissionChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCommissionChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCommissionChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Change == nil {
+				m.Change = &CommissionChange{}
+			}
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -932,6 +932,15 @@ type Params struct {
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
 	// key_rotation_fee is the fee charged when rotating a validator's consensus key.
 	KeyRotationFee types1.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded tokens
+	// that can be tokenized into share tokens.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the tokens of a
+	// validator that can be tokenized into share tokens.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// min_commission_change_notice is the minimum time between scheduling a
+	// commission change and the time it takes effect.
+	MinCommissionChangeNotice time.Duration `protobuf:"bytes,10,opt,name=min_commission_change_notice,json=minCommissionChangeNotice,proto3,stdduration" json:"min_commission_change_notice"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1009,6 +1018,13 @@ func (m *Params) GetKeyRotationFee() types1.Coin {
 	return types1.Coin{}
 }
 
+func (m *Params) GetMinCommissionChangeNotice() time.Duration {
+	if m != nil {
+		return m.MinCommissionChangeNotice
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1318,6 +1334,64 @@ func (m *ConsKeyEvidenceExpiry) GetExpiryHeight() int64 {
 	return 0
 }
 
+// CommissionChange defines a commission rate change scheduled by a validator
+// to take effect at a given time.
+type CommissionChange struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rate is the commission rate the validator switches to.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// effective_time is the time from which the new rate applies.
+	EffectiveTime time.Time `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *CommissionChange) Reset()         { *m = CommissionChange{} }
+func (m *CommissionChange) String() string { return proto.CompactTextString(m) }
+func (*CommissionChange) ProtoMessage()    {}
+func (*CommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
+}
+func (m *CommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionChange.Merge(m, src)
+}
+func (m *CommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionChange proto.InternalMessageInfo
+
+func (m *CommissionChange) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *CommissionChange) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
@@ -1343,6 +1417,7 @@ func init() {
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 	proto.RegisterType((*ConsKeyEvidenceExpiry)(nil), "cosmos.staking.v1beta1.ConsKeyEvidenceExpiry")
+	proto.RegisterType((*CommissionChange)(nil), "cosmos.staking.v1beta1.CommissionChange")
 }
 
 func init() {
@@ -1350,133 +1425,140 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2008 bytes of a gzipped FileDescriptorProto
+	// 2126 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4f, 0x6c, 0x5b, 0x49,
-	0x19, 0xcf, 0x8b, 0xb3, 0x4e, 0xf2, 0x39, 0x89, 0x9d, 0xe9, 0x3f, 0xd7, 0x65, 0x13, 0xd7, 0x2d,
-	0x6c, 0xb7, 0xbb, 0x71, 0xb6, 0x05, 0xf5, 0x10, 0xd0, 0xa2, 0x3a, 0x76, 0xb7, 0xee, 0xee, 0xba,
-	0xe1, 0x39, 0x09, 0x2c, 0x02, 0x9e, 0xc6, 0xef, 0x8d, 0x9d, 0x21, 0xf6, 0x3c, 0xf3, 0x66, 0x5c,
-	0xe2, 0x3b, 0x87, 0x55, 0x11, 0xd2, 0x4a, 0x48, 0x08, 0x09, 0x55, 0x54, 0xe2, 0xb2, 0xdc, 0xf6,
-	0x50, 0xb8, 0x73, 0x5b, 0x90, 0x90, 0xaa, 0x9e, 0xd0, 0x4a, 0x14, 0xd4, 0x1e, 0x76, 0x05, 0x1c,
-	0x10, 0x27, 0x8e, 0x68, 0xfe, 0xbc, 0x3f, 0x8e, 0x93, 0xb6, 0xe9, 0xae, 0xd0, 0x0a, 0x2e, 0x91,
-	0x67, 0xe6, 0xfb, 0x7e, 0x6f, 0xbe, 0xdf, 0xf7, 0x67, 0xbe, 0x99, 0xc0, 0x79, 0xd7, 0xe7, 0x3d,
-	0x9f, 0xaf, 0x72, 0x81, 0x77, 0x29, 0xeb, 0xac, 0xde, 0xba, 0xd4, 0x22, 0x02, 0x5f, 0x0a, 0xc7,
-	0xe5, 0x7e, 0xe0, 0x0b, 0x1f, 0x9d, 0xd4, 0x52, 0xe5, 0x70, 0xd6, 0x48, 0x15, 0x8e, 0x77, 0xfc,
-	0x8e, 0xaf, 0x44, 0x56, 0xe5, 0x2f, 0x2d, 0x5d, 0x38, 0xdd, 0xf1, 0xfd, 0x4e, 0x97, 0xac, 0xaa,
-	0x51, 0x6b, 0xd0, 0x5e, 0xc5, 0x6c, 0x68, 0x96, 0x96, 0xf6, 0x2f, 0x79, 0x83, 0x00, 0x0b, 0xea,
-	0x33, 0xb3, 0xbe, 0xbc, 0x7f, 0x5d, 0xd0, 0x1e, 0xe1, 0x02, 0xf7, 0xfa, 0x21, 0xb6, 0xde, 0x89,
-	0xa3, 0x3f, 0x6a, 0xb6, 0x65, 0xb0, 0x8d, 0x29, 0x2d, 0xcc, 0x49, 0x64, 0x87, 0xeb, 0xd3, 0x10,
-	0x7b, 0x11, 0xf7, 0x28, 0xf3, 0x57, 0xd5, 0x5f, 0x33, 0xf5, 0x05, 0x41, 0x98, 0x47, 0x82, 0x1e,
-	0x65, 0x62, 0x55, 0x0c, 0xfb, 0x84, 0xeb, 0xbf, 0x66, 0xf5, 0x4c, 0x62, 0x15, 0xb7, 0x5c, 0x9a,
-	0x5c, 0x2c, 0xfd, 0xd4, 0x82, 0x85, 0xeb, 0x94, 0x0b, 0x3f, 0xa0, 0x2e, 0xee, 0xd6, 0x59, 0xdb,
-	0x47, 0x5f, 0x85, 0xf4, 0x0e, 0xc1, 0x1e, 0x09, 0xf2, 0x56, 0xd1, 0xba, 0x90, 0xb9, 0x9c, 0x2f,
-	0xc7, 0x00, 0x65, 0xad, 0x7b, 0x5d, 0xad, 0x57, 0x66, 0x3f, 0x7c, 0xb8, 0x3c, 0xf1, 0xfe, 0xc7,
-	0x1f, 0x5c, 0xb4, 0x6c, 0xa3, 0x82, 0xaa, 0x90, 0xbe, 0x85, 0xbb, 0x9c, 0x88, 0xfc, 0x64, 0x31,
-	0x75, 0x21, 0x73, 0xf9, 0x6c, 0xf9, 0x60, 0xce, 0xcb, 0xdb, 0xb8, 0x4b, 0x3d, 0x2c, 0xfc, 0x51,
-	0x14, 0xad, 0x5b, 0xfa, 0xd9, 0x24, 0x64, 0xd7, 0xfd, 0x5e, 0x8f, 0x72, 0x4e, 0x7d, 0x66, 0x63,
-	0x41, 0x38, 0xba, 0x01, 0x53, 0x01, 0x16, 0x44, 0x6d, 0x6a, 0xb6, 0x72, 0x45, 0x2a, 0x7d, 0xf4,
-	0x70, 0xf9, 0x8c, 0x86, 0xe7, 0xde, 0x6e, 0x99, 0xfa, 0xab, 0x3d, 0x2c, 0x76, 0xca, 0x6f, 0x91,
-	0x0e, 0x76, 0x87, 0x55, 0xe2, 0x3e, 0xb8, 0xb7, 0x02, 0xe6, 0xeb, 0x55, 0xe2, 0xea, 0x2f, 0x28,
-	0x0c, 0xf4, 0x0d, 0x98, 0xe9, 0xe1, 0x3d, 0x47, 0xe1, 0x4d, 0x7e, 0x2a, 0xbc, 0xe9, 0x1e, 0xde,
-	0x93, 0xfb, 0x43, 0xdf, 0x83, 0xac, 0x84, 0x74, 0x77, 0x30, 0xeb, 0x10, 0x8d, 0x9c, 0xfa, 0x54,
-	0xc8, 0xf3, 0x3d, 0xbc, 0xb7, 0xae, 0xd0, 0x24, 0xfe, 0xda, 0xd4, 0x27, 0x77, 0x97, 0xad, 0xd2,
-	0xef, 0x2c, 0x80, 0x98, 0x18, 0x84, 0x21, 0xe7, 0x46, 0x23, 0xf5, 0x51, 0x6e, 0x9c, 0xf6, 0xd2,
-	0x61, 0xbc, 0xef, 0xa3, 0xb5, 0x32, 0x2f, 0xb7, 0x77, 0xff, 0xe1, 0xb2, 0xa5, 0xbf, 0x9a, 0x75,
-	0xc7, 0x68, 0xcf, 0x0c, 0xfa, 0x1e, 0x16, 0xc4, 0x91, 0x31, 0xac, 0xd8, 0xca, 0x5c, 0x2e, 0x94,
-	0x75, 0x80, 0x97, 0xc3, 0x00, 0x2f, 0x6f, 0x86, 0x01, 0xae, 0x01, 0xdf, 0xfb, 0x4b, 0x08, 0x08,
-	0x5a, 0x5b, 0xae, 0x1b, 0x1b, 0xde, 0xb7, 0x20, 0x53, 0x25, 0xdc, 0x0d, 0x68, 0x5f, 0xa6, 0x0c,
-	0xca, 0xc3, 0x74, 0xcf, 0x67, 0x74, 0xd7, 0x04, 0xdc, 0xac, 0x1d, 0x0e, 0x51, 0x01, 0x66, 0xa8,
-	0x47, 0x98, 0xa0, 0x62, 0xa8, 0xdd, 0x64, 0x47, 0x63, 0xa9, 0xf5, 0x43, 0xd2, 0xe2, 0x34, 0xe4,
-	0xd9, 0x0e, 0x87, 0xe8, 0x65, 0xc8, 0x71, 0xe2, 0x0e, 0x02, 0x2a, 0x86, 0x8e, 0xeb, 0x33, 0x81,
-	0x5d, 0x91, 0x9f, 0x52, 0x22, 0xd9, 0x70, 0x7e, 0x5d, 0x4f, 0x4b, 0x10, 0x8f, 0x08, 0x4c, 0xbb,
-	0x3c, 0xff, 0x82, 0x06, 0x31, 0x43, 0xb3, 0xd5, 0x3b, 0xd3, 0x30, 0x1b, 0x05, 0x2a, 0x5a, 0x87,
-	0x9c, 0xdf, 0x27, 0x81, 0xfc, 0xed, 0x60, 0xcf, 0x0b, 0x08, 0xe7, 0x26, 0x1a, 0xf3, 0x0f, 0xee,
-	0xad, 0x1c, 0x37, 0x84, 0x5f, 0xd5, 0x2b, 0x4d, 0x11, 0x50, 0xd6, 0xb1, 0xb3, 0xa1, 0x86, 0x99,
-	0x46, 0xef, 0x48, 0x97, 0x31, 0x4e, 0x18, 0x1f, 0x70, 0xa7, 0x3f, 0x68, 0xed, 0x92, 0xa1, 0x21,
-	0xf5, 0xf8, 0x18, 0xa9, 0x57, 0xd9, 0xb0, 0x92, 0xff, 0x43, 0x0c, 0xed, 0x06, 0xc3, 0xbe, 0xf0,
-	0xcb, 0x1b, 0x83, 0xd6, 0x9b, 0x64, 0x28, 0x5d, 0x65, 0x70, 0x36, 0x14, 0x0c, 0x3a, 0x09, 0xe9,
-	0xef, 0x63, 0xda, 0x25, 0x9e, 0x62, 0x64, 0xc6, 0x36, 0x23, 0xb4, 0x06, 0x69, 0x2e, 0xb0, 0x18,
-	0x70, 0x45, 0xc3, 0xc2, 0xe5, 0xd2, 0x61, 0xb1, 0x51, 0xf1, 0x99, 0xd7, 0x54, 0x92, 0xb6, 0xd1,
-	0x40, 0xeb, 0x90, 0x16, 0xfe, 0x2e, 0x61, 0x86, 0xa0, 0xca, 0x2b, 0x26, 0x9a, 0x4f, 0x8c, 0x47,
-	0x73, 0x9d, 0x89, 0x44, 0x1c, 0xd7, 0x99, 0xb0, 0x8d, 0x2a, 0xfa, 0x0e, 0xe4, 0x3c, 0xd2, 0x25,
-	0x1d, 0xc5, 0x1c, 0xdf, 0xc1, 0x01, 0xe1, 0xf9, 0xb4, 0x82, 0xbb, 0x74, 0xe4, 0xe4, 0xb0, 0xb3,
-	0x11, 0x54, 0x53, 0x21, 0xa1, 0x0d, 0xc8, 0x78, 0x71, 0x38, 0xe5, 0xa7, 0x15, 0x99, 0xe7, 0x0e,
-	0xb3, 0x31, 0x11, 0x79, 0xc9, 0xca, 0x93, 0x84, 0x90, 0x11, 0x34, 0x60, 0x2d, 0x9f, 0x79, 0x94,
-	0x75, 0x9c, 0x1d, 0x42, 0x3b, 0x3b, 0x22, 0x3f, 0x53, 0xb4, 0x2e, 0xa4, 0xec, 0x6c, 0x34, 0x7f,
-	0x5d, 0x4d, 0xa3, 0x0d, 0x58, 0x88, 0x45, 0x55, 0x86, 0xcc, 0x1e, 0x35, 0x43, 0xe6, 0x23, 0x00,
-	0x29, 0x82, 0xde, 0x06, 0x88, 0x73, 0x30, 0x0f, 0x0a, 0xad, 0xf4, 0xf4, 0x6c, 0x4e, 0x1a, 0x93,
-	0x00, 0x40, 0x0c, 0x8e, 0xf5, 0x28, 0x73, 0x38, 0xe9, 0xb6, 0x1d, 0xc3, 0x9c, 0xc4, 0xcd, 0x28,
-	0xfa, 0x5f, 0x3f, 0x82, 0x37, 0x3f, 0xba, 0xb7, 0x92, 0xd5, 0xa3, 0x15, 0xee, 0xed, 0x16, 0x5f,
-	0x2b, 0x7f, 0xe5, 0x8a, 0xbd, 0xd8, 0xa3, 0xac, 0x49, 0xba, 0xed, 0x6a, 0x04, 0x8c, 0xbe, 0x06,
-	0x67, 0x62, 0x42, 0x7c, 0xe6, 0xec, 0xf8, 0x5d, 0xcf, 0x09, 0x48, 0xdb, 0x71, 0xfd, 0x01, 0x13,
-	0xf9, 0x39, 0x45, 0xe3, 0xa9, 0x48, 0xe4, 0x26, 0xbb, 0xee, 0x77, 0x3d, 0x9b, 0xb4, 0xd7, 0xe5,
-	0x32, 0x3a, 0x07, 0x31, 0x1b, 0x0e, 0xf5, 0x78, 0x7e, 0xbe, 0x98, 0xba, 0x30, 0x65, 0xcf, 0x45,
-	0x93, 0x75, 0x8f, 0xaf, 0xcd, 0xbc, 0x7b, 0x77, 0x79, 0xe2, 0x93, 0xbb, 0xcb, 0x13, 0xa5, 0x6b,
-	0x30, 0xb7, 0x8d, 0xbb, 0x26, 0xb5, 0x08, 0x47, 0x57, 0x60, 0x16, 0x87, 0x83, 0xbc, 0x55, 0x4c,
-	0x3d, 0x31, 0x35, 0x63, 0xd1, 0xd2, 0xaf, 0x2d, 0x48, 0x57, 0xb7, 0x37, 0x30, 0x0d, 0x50, 0x0d,
-	0x16, 0xe3, 0x58, 0x7d, 0xd6, 0x2c, 0x8f, 0xc3, 0x3b, 0x4c, 0xf3, 0x06, 0x2c, 0xde, 0x0a, 0x0b,
-	0x47, 0x04, 0xa3, 0x8f, 0x9a, 0xb3, 0x0f, 0xee, 0xad, 0xbc, 0x68, 0x60, 0xa2, 0xe2, 0xb2, 0x0f,
-	0xef, 0xd6, 0xbe, 0xf9, 0x84, 0xcd, 0x37, 0x60, 0x5a, 0x6f, 0x95, 0xa3, 0xaf, 0xc3, 0x0b, 0x7d,
-	0xf9, 0x43, 0x99, 0x9a, 0xb9, 0xbc, 0x74, 0x68, 0xcc, 0x2b, 0xf9, 0x64, 0x84, 0x68, 0xbd, 0xd2,
-	0x8f, 0x27, 0x01, 0xaa, 0xdb, 0xdb, 0x9b, 0x01, 0xed, 0x77, 0x89, 0xf8, 0xac, 0x6c, 0xdf, 0x82,
-	0x13, 0xb1, 0xed, 0x3c, 0x70, 0x8f, 0x6e, 0xff, 0xb1, 0x48, 0xbf, 0x19, 0xb8, 0x07, 0xc2, 0x7a,
-	0x5c, 0x44, 0xb0, 0xa9, 0xa3, 0xc3, 0x56, 0xb9, 0x18, 0x67, 0xf6, 0x5b, 0x90, 0x89, 0xc9, 0xe0,
-	0xa8, 0x0e, 0x33, 0xc2, 0xfc, 0x36, 0x04, 0x97, 0x0e, 0x27, 0x38, 0x54, 0x4b, 0x92, 0x1c, 0xa9,
-	0x97, 0xfe, 0x6d, 0x01, 0x24, 0x72, 0xe4, 0xf3, 0x19, 0x63, 0xa8, 0x0e, 0x69, 0x53, 0x9c, 0x53,
-	0xcf, 0x5b, 0x9c, 0x0d, 0x40, 0x82, 0xd4, 0x9f, 0x4c, 0xc2, 0xb1, 0xad, 0x30, 0x7b, 0x3f, 0xff,
-	0x1c, 0x6c, 0xc1, 0x34, 0x61, 0x22, 0xa0, 0x8a, 0x04, 0xe9, 0xf3, 0xd7, 0x0e, 0xf3, 0xf9, 0x01,
-	0x46, 0xd5, 0x98, 0x08, 0x86, 0xc9, 0x08, 0x08, 0xb1, 0x12, 0x7c, 0xfc, 0x22, 0x05, 0xf9, 0xc3,
-	0x54, 0xd1, 0x4b, 0x90, 0x75, 0x03, 0xa2, 0x26, 0xc2, 0x73, 0xc7, 0x52, 0x05, 0x73, 0x21, 0x9c,
-	0x36, 0xc7, 0x8e, 0x0d, 0xb2, 0x51, 0x93, 0xc1, 0x25, 0x45, 0x9f, 0xaf, 0x33, 0x5b, 0x88, 0x11,
-	0xd4, 0xc1, 0xb3, 0x09, 0x59, 0xca, 0xa8, 0xa0, 0xb8, 0xeb, 0xb4, 0x70, 0x17, 0x33, 0x37, 0xec,
-	0x60, 0x8f, 0x74, 0xe6, 0x2f, 0x18, 0x8c, 0x8a, 0x86, 0x40, 0x35, 0x98, 0x0e, 0xd1, 0xa6, 0x8e,
-	0x8e, 0x16, 0xea, 0xa2, 0xb3, 0x30, 0x97, 0x3c, 0x18, 0x54, 0x37, 0x32, 0x65, 0x67, 0x12, 0xe7,
-	0xc2, 0xd3, 0x4e, 0x9e, 0xf4, 0x13, 0x4f, 0x1e, 0xd3, 0xf0, 0xfd, 0x32, 0x05, 0x8b, 0x36, 0xf1,
-	0xfe, 0xf7, 0xdd, 0xb2, 0x01, 0xa0, 0x53, 0x55, 0x56, 0x52, 0xe3, 0x99, 0xe7, 0xc8, 0xf7, 0x59,
-	0x0d, 0x52, 0xe5, 0xe2, 0xbf, 0xe5, 0xa1, 0x3f, 0x4f, 0xc2, 0x5c, 0xd2, 0x43, 0xff, 0x97, 0x87,
-	0x16, 0x6a, 0xc4, 0x65, 0x6a, 0x4a, 0x95, 0xa9, 0x97, 0x0f, 0x2b, 0x53, 0x63, 0xd1, 0xfc, 0x94,
-	0xfa, 0xf4, 0x8f, 0x14, 0xa4, 0x37, 0x70, 0x80, 0x7b, 0x1c, 0xdd, 0x1c, 0xeb, 0x6d, 0xf5, 0xdd,
-	0xf2, 0xf4, 0x58, 0x30, 0x57, 0xcd, 0xf3, 0x87, 0x8e, 0xe5, 0x9f, 0x1f, 0xd6, 0xda, 0x7e, 0x11,
-	0x16, 0xe4, 0x1d, 0x39, 0x32, 0x48, 0x93, 0x3b, 0xaf, 0xae, 0xba, 0x91, 0xf5, 0x1c, 0x2d, 0x43,
-	0x46, 0x8a, 0xc5, 0x75, 0x58, 0xca, 0x40, 0x0f, 0xef, 0xd5, 0xf4, 0x0c, 0x5a, 0x01, 0xb4, 0x13,
-	0xbd, 0x59, 0x38, 0x31, 0x11, 0x52, 0x6e, 0x31, 0x5e, 0x09, 0xc5, 0x5f, 0x04, 0x90, 0xbb, 0x70,
-	0x3c, 0xc2, 0xfc, 0x9e, 0xb9, 0xe8, 0xcd, 0xca, 0x99, 0xaa, 0x9c, 0x40, 0x3f, 0xb2, 0x74, 0x8b,
-	0xbc, 0xef, 0x26, 0x6d, 0x6e, 0x28, 0x9b, 0xcf, 0x90, 0x14, 0xff, 0x7a, 0xb8, 0x5c, 0x18, 0xe2,
-	0x5e, 0x77, 0xad, 0x74, 0x00, 0x4e, 0xe9, 0xa0, 0xcb, 0xbd, 0x6c, 0x9c, 0x47, 0x6f, 0xe2, 0xa8,
-	0x01, 0xb9, 0x5d, 0x32, 0x74, 0x02, 0x5f, 0xe8, 0x42, 0xd3, 0x26, 0xc4, 0xdc, 0x65, 0x4e, 0x87,
-	0xbe, 0x6d, 0x61, 0x4e, 0x12, 0xad, 0x3f, 0x1d, 0x69, 0xfa, 0x17, 0x76, 0xc9, 0xd0, 0x36, 0xca,
-	0xd7, 0x08, 0x59, 0x3b, 0x2f, 0xd3, 0xe5, 0xf6, 0xc7, 0x1f, 0x5c, 0x3c, 0x13, 0x77, 0xed, 0xab,
-	0x7b, 0xd1, 0x0b, 0x99, 0xf6, 0xb1, 0xec, 0x7c, 0x51, 0x7c, 0x0a, 0xd9, 0x84, 0xf7, 0xe5, 0xa5,
-	0x52, 0x5e, 0x42, 0x12, 0x97, 0x05, 0xeb, 0xc9, 0x97, 0x90, 0x58, 0x7f, 0xe4, 0x12, 0x92, 0xc8,
-	0xd1, 0xd7, 0xe3, 0x43, 0x60, 0xf2, 0x08, 0x26, 0x85, 0x4a, 0x2a, 0xf5, 0x27, 0x4a, 0x7f, 0xb4,
-	0xe0, 0xf4, 0x58, 0x38, 0x47, 0x5b, 0x76, 0x01, 0x05, 0x89, 0x45, 0x15, 0x16, 0x43, 0xb3, 0xf5,
-	0xe7, 0xcb, 0x8e, 0xc5, 0x60, 0xec, 0x24, 0xf8, 0x6c, 0x4e, 0x33, 0x53, 0xca, 0x7e, 0x6f, 0xc1,
-	0xf1, 0xe4, 0x06, 0x22, 0x53, 0x9a, 0x30, 0x97, 0xfc, 0xb4, 0x31, 0xe2, 0xfc, 0xb3, 0x18, 0x91,
-	0xdc, 0xff, 0x08, 0x08, 0xda, 0x8e, 0x4b, 0x86, 0x7e, 0x9a, 0xbb, 0xf4, 0xcc, 0xa4, 0x84, 0x1b,
-	0x3b, 0xb0, 0x74, 0x68, 0xdf, 0xfc, 0xdd, 0x82, 0xa9, 0x0d, 0xdf, 0xef, 0xa2, 0x1f, 0xc0, 0x22,
-	0xf3, 0x85, 0x23, 0xd3, 0x8b, 0x78, 0x8e, 0x79, 0x3b, 0xd0, 0xe5, 0xb8, 0xf6, 0x44, 0xae, 0xfe,
-	0xf6, 0x70, 0x79, 0x5c, 0x73, 0x94, 0x40, 0xf3, 0x44, 0xc5, 0x7c, 0x51, 0x51, 0x42, 0x9b, 0xfa,
-	0x79, 0xa1, 0x0d, 0xf3, 0xa3, 0x9f, 0xd3, 0x25, 0xfb, 0xea, 0xd3, 0x3e, 0x37, 0xff, 0xd4, 0x4f,
-	0xcd, 0xb5, 0x12, 0xdf, 0x59, 0x9b, 0x91, 0x5e, 0xfb, 0xa7, 0xf4, 0xdc, 0x3b, 0x90, 0x8b, 0xea,
-	0xd5, 0x96, 0x7a, 0xdf, 0xe2, 0x32, 0x34, 0xf4, 0x53, 0x57, 0x78, 0x5b, 0x28, 0x26, 0xdf, 0x4d,
-	0x71, 0xcb, 0xa5, 0xe5, 0x7d, 0x3a, 0x23, 0x74, 0x1a, 0xdd, 0xd2, 0x6f, 0x2c, 0x38, 0xb1, 0xee,
-	0x33, 0xfe, 0x26, 0x19, 0xd6, 0x6e, 0x51, 0x8f, 0x30, 0x97, 0xd4, 0xf6, 0xfa, 0x34, 0x18, 0xa2,
-	0x57, 0x0e, 0x6a, 0x75, 0x25, 0xb3, 0x73, 0x07, 0xf4, 0xb1, 0x37, 0x20, 0x43, 0x94, 0xda, 0xf3,
-	0x3e, 0xdb, 0x69, 0x6d, 0x55, 0xb6, 0xcf, 0xc1, 0xbc, 0xc1, 0x32, 0xcd, 0x4f, 0x4a, 0x1d, 0xd4,
-	0x73, 0x7a, 0x52, 0xb7, 0x3e, 0x17, 0x7f, 0x6b, 0x01, 0xc4, 0xef, 0x47, 0xe8, 0x55, 0x38, 0x55,
-	0xb9, 0xd9, 0xa8, 0x3a, 0xcd, 0xcd, 0xab, 0x9b, 0x5b, 0x4d, 0x67, 0xab, 0xd1, 0xdc, 0xa8, 0xad,
-	0xd7, 0xaf, 0xd5, 0x6b, 0xd5, 0xdc, 0x44, 0x21, 0x7b, 0xfb, 0x4e, 0x31, 0xb3, 0xc5, 0x78, 0x9f,
-	0xb8, 0xb4, 0x4d, 0x89, 0x87, 0xbe, 0x04, 0xc7, 0x47, 0xa5, 0xe5, 0xa8, 0x56, 0xcd, 0x59, 0x85,
-	0xb9, 0xdb, 0x77, 0x8a, 0x33, 0xba, 0x5f, 0x26, 0x1e, 0xba, 0x00, 0x27, 0xc6, 0xe5, 0xea, 0x8d,
-	0x37, 0x72, 0x93, 0x85, 0xf9, 0xdb, 0x77, 0x8a, 0xb3, 0x51, 0x63, 0x8d, 0x4a, 0x80, 0x92, 0x92,
-	0x06, 0x2f, 0x55, 0x80, 0xdb, 0x77, 0x8a, 0x69, 0x1d, 0x3d, 0x85, 0xa9, 0x77, 0x7f, 0xb5, 0x34,
-	0x71, 0xf1, 0xbb, 0x00, 0x75, 0xd6, 0x0e, 0xb0, 0xab, 0xb2, 0xa4, 0x00, 0x27, 0xeb, 0x8d, 0x6b,
-	0xf6, 0xd5, 0xf5, 0xcd, 0xfa, 0xcd, 0xc6, 0xe8, 0xb6, 0xf7, 0xad, 0x55, 0x6f, 0x6e, 0x55, 0xde,
-	0xaa, 0x39, 0xcd, 0xfa, 0x1b, 0x8d, 0x9c, 0x85, 0x4e, 0xc1, 0xb1, 0x91, 0xb5, 0x6f, 0x36, 0x36,
-	0xeb, 0x6f, 0xd7, 0x72, 0x93, 0x95, 0x6b, 0x1f, 0x3e, 0x5a, 0xb2, 0xee, 0x3f, 0x5a, 0xb2, 0xfe,
-	0xfa, 0x68, 0xc9, 0x7a, 0xef, 0xf1, 0xd2, 0xc4, 0xfd, 0xc7, 0x4b, 0x13, 0x7f, 0x7a, 0xbc, 0x34,
-	0xf1, 0xed, 0x57, 0x3b, 0x54, 0xec, 0x0c, 0x5a, 0x65, 0xd7, 0xef, 0x99, 0xff, 0x00, 0xac, 0x1e,
-	0x58, 0xa9, 0xd5, 0x93, 0x7b, 0x2b, 0xad, 0x7c, 0xf6, 0xe5, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x03, 0x6f, 0x2e, 0xf4, 0xea, 0x18, 0x00, 0x00,
+	0x19, 0xcf, 0x8b, 0xb3, 0x4e, 0xf2, 0x39, 0x89, 0x9d, 0xe9, 0x9f, 0x75, 0xdc, 0x6e, 0xe2, 0xba,
+	0x85, 0xed, 0x76, 0x37, 0xce, 0xb6, 0xa0, 0x1e, 0x02, 0x5a, 0x54, 0xc7, 0xee, 0xd6, 0xdd, 0xae,
+	0x1b, 0x9e, 0x93, 0xc0, 0x22, 0xe0, 0x69, 0xfc, 0xde, 0xd8, 0x19, 0x62, 0xbf, 0xe7, 0xbe, 0x19,
+	0x67, 0xe3, 0x1b, 0x07, 0x0e, 0xab, 0x22, 0xa4, 0x95, 0x90, 0x10, 0x12, 0xaa, 0xa8, 0xc4, 0x65,
+	0xb9, 0xed, 0xa1, 0x70, 0x47, 0x5c, 0x16, 0x24, 0xa4, 0xaa, 0x27, 0xb4, 0x12, 0x05, 0xb5, 0x87,
+	0x5d, 0xc1, 0x05, 0x71, 0xe2, 0x88, 0xe6, 0xcf, 0xfb, 0x63, 0x3b, 0x69, 0x9b, 0xb4, 0x42, 0x2b,
+	0xb8, 0x58, 0x6f, 0x66, 0xbe, 0xef, 0x37, 0x33, 0xbf, 0xf9, 0xe6, 0xfb, 0xe6, 0xfb, 0x0c, 0xe7,
+	0x6c, 0x8f, 0x75, 0x3c, 0xb6, 0xc2, 0x38, 0xde, 0xa1, 0x6e, 0x6b, 0x65, 0xf7, 0x62, 0x83, 0x70,
+	0x7c, 0x31, 0x68, 0x17, 0xbb, 0xbe, 0xc7, 0x3d, 0x74, 0x52, 0x49, 0x15, 0x83, 0x5e, 0x2d, 0x95,
+	0x3b, 0xde, 0xf2, 0x5a, 0x9e, 0x14, 0x59, 0x11, 0x5f, 0x4a, 0x3a, 0xb7, 0xd0, 0xf2, 0xbc, 0x56,
+	0x9b, 0xac, 0xc8, 0x56, 0xa3, 0xd7, 0x5c, 0xc1, 0x6e, 0x5f, 0x0f, 0x2d, 0x0e, 0x0f, 0x39, 0x3d,
+	0x1f, 0x73, 0xea, 0xb9, 0x7a, 0x7c, 0x69, 0x78, 0x9c, 0xd3, 0x0e, 0x61, 0x1c, 0x77, 0xba, 0x01,
+	0xb6, 0x5a, 0x89, 0xa5, 0x26, 0xd5, 0xcb, 0xd2, 0xd8, 0x7a, 0x2b, 0x0d, 0xcc, 0x48, 0xb8, 0x0f,
+	0xdb, 0xa3, 0x01, 0xf6, 0x3c, 0xee, 0x50, 0xd7, 0x5b, 0x91, 0xbf, 0xba, 0xeb, 0x34, 0x27, 0xae,
+	0x43, 0xfc, 0x0e, 0x75, 0xf9, 0x0a, 0xef, 0x77, 0x09, 0x53, 0xbf, 0x7a, 0xf4, 0x54, 0x6c, 0x14,
+	0x37, 0x6c, 0x1a, 0x1f, 0x2c, 0xfc, 0xd4, 0x80, 0xb9, 0x6b, 0x94, 0x71, 0xcf, 0xa7, 0x36, 0x6e,
+	0x57, 0xdd, 0xa6, 0x87, 0xbe, 0x06, 0xc9, 0x6d, 0x82, 0x1d, 0xe2, 0x67, 0x8d, 0xbc, 0x71, 0x3e,
+	0x75, 0x29, 0x5b, 0x8c, 0x00, 0x8a, 0x4a, 0xf7, 0x9a, 0x1c, 0x2f, 0x4d, 0x7f, 0xf2, 0x70, 0x69,
+	0xec, 0xa3, 0xcf, 0x3e, 0xbe, 0x60, 0x98, 0x5a, 0x05, 0x95, 0x21, 0xb9, 0x8b, 0xdb, 0x8c, 0xf0,
+	0xec, 0x78, 0x3e, 0x71, 0x3e, 0x75, 0xe9, 0x4c, 0x71, 0x7f, 0xce, 0x8b, 0x5b, 0xb8, 0x4d, 0x1d,
+	0xcc, 0xbd, 0x41, 0x14, 0xa5, 0x5b, 0xf8, 0xd9, 0x38, 0xa4, 0xd7, 0xbc, 0x4e, 0x87, 0x32, 0x46,
+	0x3d, 0xd7, 0xc4, 0x9c, 0x30, 0x74, 0x1d, 0x26, 0x7c, 0xcc, 0x89, 0x5c, 0xd4, 0x74, 0xe9, 0xb2,
+	0x50, 0xfa, 0xf4, 0xe1, 0xd2, 0x29, 0x05, 0xcf, 0x9c, 0x9d, 0x22, 0xf5, 0x56, 0x3a, 0x98, 0x6f,
+	0x17, 0x6f, 0x90, 0x16, 0xb6, 0xfb, 0x65, 0x62, 0x3f, 0xb8, 0xb7, 0x0c, 0x7a, 0xf6, 0x32, 0xb1,
+	0xd5, 0x0c, 0x12, 0x03, 0x7d, 0x13, 0xa6, 0x3a, 0x78, 0xcf, 0x92, 0x78, 0xe3, 0xcf, 0x85, 0x37,
+	0xd9, 0xc1, 0x7b, 0x62, 0x7d, 0xe8, 0xfb, 0x90, 0x16, 0x90, 0xf6, 0x36, 0x76, 0x5b, 0x44, 0x21,
+	0x27, 0x9e, 0x0b, 0x79, 0xb6, 0x83, 0xf7, 0xd6, 0x24, 0x9a, 0xc0, 0x5f, 0x9d, 0xf8, 0xfc, 0xee,
+	0x92, 0x51, 0xf8, 0x9d, 0x01, 0x10, 0x11, 0x83, 0x30, 0x64, 0xec, 0xb0, 0x25, 0x27, 0x65, 0xfa,
+	0xd0, 0x5e, 0x3d, 0x88, 0xf7, 0x21, 0x5a, 0x4b, 0xb3, 0x62, 0x79, 0xf7, 0x1f, 0x2e, 0x19, 0x6a,
+	0xd6, 0xb4, 0x3d, 0x42, 0x7b, 0xaa, 0xd7, 0x75, 0x30, 0x27, 0x96, 0xb0, 0x61, 0xc9, 0x56, 0xea,
+	0x52, 0xae, 0xa8, 0x0c, 0xbc, 0x18, 0x18, 0x78, 0x71, 0x23, 0x30, 0x70, 0x05, 0xf8, 0xe1, 0x5f,
+	0x03, 0x40, 0x50, 0xda, 0x62, 0x5c, 0xef, 0xe1, 0x23, 0x03, 0x52, 0x65, 0xc2, 0x6c, 0x9f, 0x76,
+	0xc5, 0x95, 0x41, 0x59, 0x98, 0xec, 0x78, 0x2e, 0xdd, 0xd1, 0x06, 0x37, 0x6d, 0x06, 0x4d, 0x94,
+	0x83, 0x29, 0xea, 0x10, 0x97, 0x53, 0xde, 0x57, 0xc7, 0x64, 0x86, 0x6d, 0xa1, 0xf5, 0x3e, 0x69,
+	0x30, 0x1a, 0xf0, 0x6c, 0x06, 0x4d, 0xf4, 0x1a, 0x64, 0x18, 0xb1, 0x7b, 0x3e, 0xe5, 0x7d, 0xcb,
+	0xf6, 0x5c, 0x8e, 0x6d, 0x9e, 0x9d, 0x90, 0x22, 0xe9, 0xa0, 0x7f, 0x4d, 0x75, 0x0b, 0x10, 0x87,
+	0x70, 0x4c, 0xdb, 0x2c, 0xfb, 0x92, 0x02, 0xd1, 0x4d, 0xbd, 0xd4, 0x3b, 0x93, 0x30, 0x1d, 0x1a,
+	0x2a, 0x5a, 0x83, 0x8c, 0xd7, 0x25, 0xbe, 0xf8, 0xb6, 0xb0, 0xe3, 0xf8, 0x84, 0x31, 0x6d, 0x8d,
+	0xd9, 0x07, 0xf7, 0x96, 0x8f, 0x6b, 0xc2, 0xaf, 0xa8, 0x91, 0x3a, 0xf7, 0xa9, 0xdb, 0x32, 0xd3,
+	0x81, 0x86, 0xee, 0x46, 0xef, 0x89, 0x23, 0x73, 0x19, 0x71, 0x59, 0x8f, 0x59, 0xdd, 0x5e, 0x63,
+	0x87, 0xf4, 0x35, 0xa9, 0xc7, 0x47, 0x48, 0xbd, 0xe2, 0xf6, 0x4b, 0xd9, 0x3f, 0x46, 0xd0, 0xb6,
+	0xdf, 0xef, 0x72, 0xaf, 0xb8, 0xde, 0x6b, 0xbc, 0x43, 0xfa, 0x66, 0x3a, 0xc4, 0x59, 0x97, 0x30,
+	0xe8, 0x24, 0x24, 0x7f, 0x80, 0x69, 0x9b, 0x38, 0x92, 0x91, 0x29, 0x53, 0xb7, 0xd0, 0x2a, 0x24,
+	0x19, 0xc7, 0xbc, 0xc7, 0x24, 0x0d, 0x73, 0x97, 0x0a, 0x07, 0xd9, 0x46, 0xc9, 0x73, 0x9d, 0xba,
+	0x94, 0x34, 0xb5, 0x06, 0x5a, 0x83, 0x24, 0xf7, 0x76, 0x88, 0xab, 0x09, 0x2a, 0xbd, 0xae, 0xad,
+	0xf9, 0xc4, 0xa8, 0x35, 0x57, 0x5d, 0x1e, 0xb3, 0xe3, 0xaa, 0xcb, 0x4d, 0xad, 0x8a, 0xbe, 0x0b,
+	0x19, 0x87, 0xb4, 0x49, 0x4b, 0x32, 0xc7, 0xb6, 0xb1, 0x4f, 0x58, 0x36, 0x29, 0xe1, 0x2e, 0x1e,
+	0xfa, 0x72, 0x98, 0xe9, 0x10, 0xaa, 0x2e, 0x91, 0xd0, 0x3a, 0xa4, 0x9c, 0xc8, 0x9c, 0xb2, 0x93,
+	0x92, 0xcc, 0xb3, 0x07, 0xed, 0x31, 0x66, 0x79, 0x71, 0xcf, 0x13, 0x87, 0x10, 0x16, 0xd4, 0x73,
+	0x1b, 0x9e, 0xeb, 0x50, 0xb7, 0x65, 0x6d, 0x13, 0xda, 0xda, 0xe6, 0xd9, 0xa9, 0xbc, 0x71, 0x3e,
+	0x61, 0xa6, 0xc3, 0xfe, 0x6b, 0xb2, 0x1b, 0xad, 0xc3, 0x5c, 0x24, 0x2a, 0x6f, 0xc8, 0xf4, 0x61,
+	0x6f, 0xc8, 0x6c, 0x08, 0x20, 0x44, 0xd0, 0xbb, 0x00, 0xd1, 0x1d, 0xcc, 0x82, 0x44, 0x2b, 0x3c,
+	0xfd, 0x36, 0xc7, 0x37, 0x13, 0x03, 0x40, 0x2e, 0x1c, 0xeb, 0x50, 0xd7, 0x62, 0xa4, 0xdd, 0xb4,
+	0x34, 0x73, 0x02, 0x37, 0x25, 0xe9, 0x7f, 0xeb, 0x10, 0xa7, 0xf9, 0xe9, 0xbd, 0xe5, 0xb4, 0x6a,
+	0x2d, 0x33, 0x67, 0x27, 0xff, 0x66, 0xf1, 0xab, 0x97, 0xcd, 0xf9, 0x0e, 0x75, 0xeb, 0xa4, 0xdd,
+	0x2c, 0x87, 0xc0, 0xe8, 0xeb, 0x70, 0x2a, 0x22, 0xc4, 0x73, 0xad, 0x6d, 0xaf, 0xed, 0x58, 0x3e,
+	0x69, 0x5a, 0xb6, 0xd7, 0x73, 0x79, 0x76, 0x46, 0xd2, 0xf8, 0x72, 0x28, 0x72, 0xd3, 0xbd, 0xe6,
+	0xb5, 0x1d, 0x93, 0x34, 0xd7, 0xc4, 0x30, 0x3a, 0x0b, 0x11, 0x1b, 0x16, 0x75, 0x58, 0x76, 0x36,
+	0x9f, 0x38, 0x3f, 0x61, 0xce, 0x84, 0x9d, 0x55, 0x87, 0xad, 0x4e, 0x7d, 0x70, 0x77, 0x69, 0xec,
+	0xf3, 0xbb, 0x4b, 0x63, 0x85, 0xab, 0x30, 0xb3, 0x85, 0xdb, 0xfa, 0x6a, 0x11, 0x86, 0x2e, 0xc3,
+	0x34, 0x0e, 0x1a, 0x59, 0x23, 0x9f, 0x78, 0xe2, 0xd5, 0x8c, 0x44, 0x0b, 0xbf, 0x36, 0x20, 0x59,
+	0xde, 0x5a, 0xc7, 0xd4, 0x47, 0x15, 0x98, 0x8f, 0x6c, 0xf5, 0x59, 0x6f, 0x79, 0x64, 0xde, 0xc1,
+	0x35, 0xaf, 0xc1, 0xfc, 0x6e, 0xe0, 0x38, 0x42, 0x18, 0x15, 0x6a, 0xce, 0x3c, 0xb8, 0xb7, 0xfc,
+	0x8a, 0x86, 0x09, 0x9d, 0xcb, 0x10, 0xde, 0xee, 0x50, 0x7f, 0x6c, 0xcf, 0xd7, 0x61, 0x52, 0x2d,
+	0x95, 0xa1, 0x6f, 0xc0, 0x4b, 0x5d, 0xf1, 0x21, 0xb7, 0x9a, 0xba, 0xb4, 0x78, 0xa0, 0xcd, 0x4b,
+	0xf9, 0xb8, 0x85, 0x28, 0xbd, 0xc2, 0x8f, 0xc7, 0x01, 0xca, 0x5b, 0x5b, 0x1b, 0x3e, 0xed, 0xb6,
+	0x09, 0x7f, 0x51, 0x7b, 0xdf, 0x84, 0x13, 0xd1, 0xde, 0x99, 0x6f, 0x1f, 0x7e, 0xff, 0xc7, 0x42,
+	0xfd, 0xba, 0x6f, 0xef, 0x0b, 0xeb, 0x30, 0x1e, 0xc2, 0x26, 0x0e, 0x0f, 0x5b, 0x66, 0x7c, 0x94,
+	0xd9, 0x6f, 0x43, 0x2a, 0x22, 0x83, 0xa1, 0x2a, 0x4c, 0x71, 0xfd, 0xad, 0x09, 0x2e, 0x1c, 0x4c,
+	0x70, 0xa0, 0x16, 0x27, 0x39, 0x54, 0x2f, 0xfc, 0xdb, 0x00, 0x88, 0xdd, 0x91, 0x2f, 0xa6, 0x8d,
+	0xa1, 0x2a, 0x24, 0xb5, 0x73, 0x4e, 0x1c, 0xd5, 0x39, 0x6b, 0x80, 0x18, 0xa9, 0x3f, 0x19, 0x87,
+	0x63, 0x9b, 0xc1, 0xed, 0xfd, 0xe2, 0x73, 0xb0, 0x09, 0x93, 0xc4, 0xe5, 0x3e, 0x95, 0x24, 0x88,
+	0x33, 0x7f, 0xf3, 0xa0, 0x33, 0xdf, 0x67, 0x53, 0x15, 0x97, 0xfb, 0xfd, 0xb8, 0x05, 0x04, 0x58,
+	0x31, 0x3e, 0x7e, 0x91, 0x80, 0xec, 0x41, 0xaa, 0xe8, 0x55, 0x48, 0xdb, 0x3e, 0x91, 0x1d, 0x41,
+	0xdc, 0x31, 0xa4, 0xc3, 0x9c, 0x0b, 0xba, 0x75, 0xd8, 0x31, 0x41, 0x3c, 0xd4, 0x84, 0x71, 0x09,
+	0xd1, 0xa3, 0xbd, 0xcc, 0xe6, 0x22, 0x04, 0x19, 0x78, 0x36, 0x20, 0x4d, 0x5d, 0xca, 0x29, 0x6e,
+	0x5b, 0x0d, 0xdc, 0xc6, 0xae, 0x1d, 0xbc, 0x60, 0x0f, 0x15, 0xf3, 0xe7, 0x34, 0x46, 0x49, 0x41,
+	0xa0, 0x0a, 0x4c, 0x06, 0x68, 0x13, 0x87, 0x47, 0x0b, 0x74, 0xd1, 0x19, 0x98, 0x89, 0x07, 0x06,
+	0xf9, 0x1a, 0x99, 0x30, 0x53, 0xb1, 0xb8, 0xf0, 0xb4, 0xc8, 0x93, 0x7c, 0x62, 0xe4, 0xd1, 0x0f,
+	0xbe, 0x5f, 0x26, 0x60, 0xde, 0x24, 0xce, 0xff, 0xfe, 0xb1, 0xac, 0x03, 0xa8, 0xab, 0x2a, 0x3c,
+	0x69, 0x76, 0xe2, 0xa8, 0xf7, 0x7d, 0x5a, 0x81, 0x94, 0x19, 0xff, 0x6f, 0x9d, 0xd0, 0x5f, 0xc6,
+	0x61, 0x26, 0x7e, 0x42, 0xff, 0x97, 0x41, 0x0b, 0xd5, 0x22, 0x37, 0x35, 0x21, 0xdd, 0xd4, 0x6b,
+	0x07, 0xb9, 0xa9, 0x11, 0x6b, 0x7e, 0x8a, 0x7f, 0xfa, 0x7d, 0x12, 0x92, 0xeb, 0xd8, 0xc7, 0x1d,
+	0x86, 0x6e, 0x8e, 0xbc, 0x6d, 0x55, 0x6e, 0xb9, 0x30, 0x62, 0xcc, 0x65, 0x5d, 0xfe, 0x50, 0xb6,
+	0xfc, 0xf3, 0x83, 0x9e, 0xb6, 0x5f, 0x82, 0x39, 0x91, 0x23, 0x87, 0x1b, 0x52, 0xe4, 0xce, 0xca,
+	0x54, 0x37, 0xdc, 0x3d, 0x43, 0x4b, 0x90, 0x12, 0x62, 0x91, 0x1f, 0x16, 0x32, 0xd0, 0xc1, 0x7b,
+	0x15, 0xd5, 0x83, 0x96, 0x01, 0x6d, 0x87, 0x35, 0x0b, 0x2b, 0x22, 0x42, 0xc8, 0xcd, 0x47, 0x23,
+	0x81, 0xf8, 0x2b, 0x00, 0x62, 0x15, 0x96, 0x43, 0x5c, 0xaf, 0xa3, 0x13, 0xbd, 0x69, 0xd1, 0x53,
+	0x16, 0x1d, 0xe8, 0x47, 0x86, 0x7a, 0x22, 0x0f, 0x65, 0xd2, 0x3a, 0x43, 0xd9, 0x78, 0x86, 0x4b,
+	0xf1, 0xaf, 0x87, 0x4b, 0xb9, 0x3e, 0xee, 0xb4, 0x57, 0x0b, 0xfb, 0xe0, 0x14, 0xf6, 0x4b, 0xee,
+	0xc5, 0xc3, 0x79, 0x30, 0x13, 0x47, 0x35, 0xc8, 0xec, 0x90, 0xbe, 0xe5, 0x7b, 0x5c, 0x39, 0x9a,
+	0x26, 0x21, 0x3a, 0x97, 0x59, 0x08, 0xce, 0x56, 0x94, 0x84, 0x62, 0x4f, 0x7f, 0x3a, 0xf0, 0xe8,
+	0x9f, 0xdb, 0x21, 0x7d, 0x53, 0x2b, 0x5f, 0x25, 0x04, 0xdd, 0x82, 0x85, 0x56, 0xdb, 0x6b, 0xe0,
+	0xb6, 0xd5, 0xa6, 0xb7, 0x7a, 0xd4, 0xb1, 0xb4, 0x65, 0x58, 0x36, 0xee, 0x66, 0xa7, 0x9e, 0xab,
+	0x34, 0x71, 0x52, 0x01, 0xdf, 0x90, 0xb8, 0x75, 0x05, 0xbb, 0x86, 0xbb, 0xe8, 0x7d, 0x38, 0x1d,
+	0x19, 0xfb, 0x3e, 0xb3, 0x4e, 0x3f, 0xd7, 0xac, 0x0b, 0x21, 0xf6, 0xc8, 0xc4, 0x14, 0x4e, 0x0f,
+	0x31, 0xaf, 0xeb, 0x30, 0xae, 0xc7, 0xa9, 0x4d, 0xb2, 0x70, 0x48, 0xbb, 0x5d, 0x18, 0x38, 0x1e,
+	0x55, 0x85, 0xa9, 0x49, 0xa8, 0xd5, 0x73, 0xc2, 0x0b, 0xdd, 0xfe, 0xec, 0xe3, 0x0b, 0xa7, 0xa2,
+	0x64, 0x68, 0x65, 0x2f, 0x2c, 0x3c, 0xaa, 0xab, 0x23, 0x12, 0x0a, 0x14, 0x05, 0x77, 0x93, 0xb0,
+	0xae, 0xe7, 0x32, 0x99, 0xdb, 0xc5, 0x72, 0x30, 0xe3, 0xc9, 0xb9, 0x5d, 0xa4, 0x3f, 0x90, 0xdb,
+	0xc5, 0x5c, 0xdf, 0x5b, 0x51, 0x6c, 0x1d, 0x3f, 0x84, 0xa5, 0x04, 0x4a, 0xd2, 0xa3, 0x8e, 0x15,
+	0xfe, 0x64, 0xc0, 0xc2, 0x88, 0x97, 0x08, 0x97, 0x6c, 0x03, 0xf2, 0x63, 0x83, 0xf2, 0xb6, 0xf5,
+	0xf5, 0xd2, 0x8f, 0xe6, 0x74, 0xe6, 0xfd, 0xe1, 0xd1, 0x17, 0xf4, 0x48, 0xd0, 0x11, 0xe2, 0x0f,
+	0x06, 0x1c, 0x8f, 0x2f, 0x20, 0xdc, 0x4a, 0x1d, 0x66, 0xe2, 0x53, 0xeb, 0x4d, 0x9c, 0x7b, 0x96,
+	0x4d, 0xc4, 0xd7, 0x3f, 0x00, 0x82, 0xb6, 0x22, 0x4f, 0xac, 0x2a, 0x9e, 0x17, 0x9f, 0x99, 0x94,
+	0x60, 0x61, 0xfb, 0x7a, 0x64, 0x75, 0x36, 0xff, 0x30, 0x60, 0x62, 0xdd, 0xf3, 0xda, 0xe8, 0x16,
+	0xcc, 0xbb, 0x1e, 0xb7, 0x84, 0xd7, 0x22, 0x8e, 0xa5, 0x4b, 0x32, 0x2a, 0xca, 0x55, 0x9e, 0xc8,
+	0xd5, 0xdf, 0x1f, 0x2e, 0x8d, 0x6a, 0x0e, 0x12, 0xa8, 0x2b, 0x7f, 0xae, 0xc7, 0x4b, 0x52, 0x68,
+	0x43, 0xca, 0xa0, 0x26, 0xcc, 0x0e, 0x4e, 0xa7, 0x22, 0xe1, 0x95, 0xa7, 0x4d, 0x37, 0xfb, 0xd4,
+	0xa9, 0x66, 0x1a, 0xb1, 0x79, 0x56, 0xa7, 0xc4, 0xa9, 0xfd, 0x53, 0x9c, 0xdc, 0x7b, 0x90, 0x09,
+	0xc3, 0xc0, 0xa6, 0x2c, 0x1b, 0x32, 0x61, 0x1a, 0xaa, 0x82, 0x18, 0x24, 0x61, 0xf9, 0x78, 0x39,
+	0x5a, 0xd4, 0xb3, 0x8b, 0x43, 0x3a, 0x03, 0x74, 0x6a, 0xdd, 0xc2, 0x6f, 0x0c, 0x38, 0xb1, 0xe6,
+	0xb9, 0xec, 0x1d, 0xd2, 0xaf, 0xec, 0x8a, 0x22, 0xa2, 0x4d, 0x2a, 0x7b, 0x5d, 0xea, 0xf7, 0xd1,
+	0xeb, 0xfb, 0x65, 0x10, 0x82, 0xd9, 0x99, 0x7d, 0xd2, 0x83, 0xeb, 0x90, 0x22, 0x52, 0xed, 0xa8,
+	0xd5, 0x50, 0xa5, 0x2d, 0xa3, 0xe1, 0x59, 0x98, 0xd5, 0x58, 0xfa, 0x4d, 0x99, 0x90, 0xef, 0x9f,
+	0x19, 0xd5, 0xa9, 0x5e, 0x94, 0x85, 0x1f, 0x8e, 0x43, 0x66, 0xd8, 0x13, 0xa1, 0xda, 0x41, 0x4b,
+	0x3e, 0x62, 0xd2, 0x13, 0x94, 0xd6, 0xc7, 0x5f, 0x40, 0x69, 0x7d, 0x1d, 0xe6, 0x48, 0xb3, 0x49,
+	0x6c, 0x4e, 0x77, 0x75, 0xc9, 0x38, 0x71, 0xe8, 0x82, 0x58, 0x08, 0x20, 0x44, 0x2e, 0xfc, 0xd6,
+	0x00, 0x88, 0x2a, 0x93, 0xe8, 0x0d, 0x78, 0xb9, 0x74, 0xb3, 0x56, 0xb6, 0xea, 0x1b, 0x57, 0x36,
+	0x36, 0xeb, 0xd6, 0x66, 0xad, 0xbe, 0x5e, 0x59, 0xab, 0x5e, 0xad, 0x56, 0xca, 0x99, 0xb1, 0x5c,
+	0xfa, 0xf6, 0x9d, 0x7c, 0x6a, 0xd3, 0x65, 0x5d, 0x62, 0xd3, 0x26, 0x25, 0x0e, 0xfa, 0x32, 0x1c,
+	0x1f, 0x94, 0x16, 0xad, 0x4a, 0x39, 0x63, 0xe4, 0x66, 0x6e, 0xdf, 0xc9, 0x4f, 0xa9, 0x4c, 0x8c,
+	0x38, 0xe8, 0x3c, 0x9c, 0x18, 0x95, 0xab, 0xd6, 0xde, 0xce, 0x8c, 0xe7, 0x66, 0x6f, 0xdf, 0xc9,
+	0x4f, 0x87, 0x29, 0x1b, 0x2a, 0x00, 0x8a, 0x4b, 0x6a, 0xbc, 0x44, 0x0e, 0x6e, 0xdf, 0xc9, 0x27,
+	0xd5, 0x05, 0xca, 0x4d, 0x7c, 0xf0, 0xab, 0xc5, 0xb1, 0x0b, 0xdf, 0x03, 0xa8, 0xba, 0x4d, 0x1f,
+	0xdb, 0xd2, 0x51, 0xe4, 0xe0, 0x64, 0xb5, 0x76, 0xd5, 0xbc, 0xb2, 0xb6, 0x51, 0xbd, 0x59, 0x1b,
+	0x5c, 0xf6, 0xd0, 0x58, 0xf9, 0xe6, 0x66, 0xe9, 0x46, 0xc5, 0xaa, 0x57, 0xdf, 0xae, 0x65, 0x0c,
+	0xf4, 0x32, 0x1c, 0x1b, 0x18, 0xfb, 0x56, 0x6d, 0xa3, 0xfa, 0x6e, 0x25, 0x33, 0x5e, 0xba, 0xfa,
+	0xc9, 0xa3, 0x45, 0xe3, 0xfe, 0xa3, 0x45, 0xe3, 0x6f, 0x8f, 0x16, 0x8d, 0x0f, 0x1f, 0x2f, 0x8e,
+	0xdd, 0x7f, 0xbc, 0x38, 0xf6, 0xe7, 0xc7, 0x8b, 0x63, 0xdf, 0x79, 0xa3, 0x45, 0xf9, 0x76, 0xaf,
+	0x51, 0xb4, 0xbd, 0x8e, 0xfe, 0x6f, 0x69, 0x65, 0xdf, 0x60, 0x25, 0xff, 0xcc, 0x69, 0x24, 0xe5,
+	0x89, 0x7c, 0xe5, 0x3f, 0x03, 0x00, 0x76, 0x22, 0xf5, 0x8c, 0x44, 0x1b, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1485,829 +1567,801 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 13140 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x69, 0x90, 0x5c, 0xd7,
-		0x75, 0x18, 0x3c, 0xaf, 0xf7, 0x3e, 0xbd, 0xbd, 0xb9, 0x33, 0x00, 0x1a, 0x0d, 0x12, 0x33, 0x6c,
-		0x8a, 0x24, 0x08, 0x92, 0x03, 0x62, 0x48, 0x80, 0xc4, 0x40, 0x12, 0xbf, 0xde, 0x00, 0x34, 0x38,
-		0xd3, 0x3d, 0x7a, 0xdd, 0x03, 0x12, 0xb4, 0xad, 0xe7, 0x37, 0xdd, 0x77, 0x66, 0x1e, 0xd1, 0xfd,
-		0x5e, 0xab, 0xdf, 0x6b, 0x70, 0x86, 0x5f, 0x95, 0x4b, 0xb2, 0x64, 0x47, 0xa6, 0x2c, 0x5b, 0x8e,
-		0x5d, 0x36, 0x6d, 0x19, 0xb2, 0x24, 0xc7, 0x8a, 0xbc, 0x25, 0xb6, 0x25, 0x6f, 0x49, 0xc5, 0x4b,
-		0x12, 0x27, 0xb6, 0xe2, 0xa4, 0x64, 0x57, 0x25, 0x71, 0x5c, 0x65, 0x25, 0x96, 0x5d, 0x16, 0xb5,
-		0x38, 0xde, 0xe4, 0xc4, 0x29, 0x25, 0xa5, 0xd4, 0xdd, 0xde, 0xd2, 0xcb, 0x74, 0x0f, 0xb4, 0x84,
-		0xb1, 0xf3, 0x83, 0xc4, 0xbc, 0x7b, 0xcf, 0x39, 0xf7, 0xdc, 0x73, 0xcf, 0x3d, 0xf7, 0x9c, 0x73,
-		0x97, 0x86, 0xf7, 0xde, 0x82, 0xe5, 0x5d, 0xd3, 0xdc, 0xed, 0xe0, 0x73, 0xbd, 0xbe, 0x69, 0x9b,
-		0xdb, 0x83, 0x9d, 0x73, 0x6d, 0x6c, 0xb5, 0xfa, 0x7a, 0xcf, 0x36, 0xfb, 0x2b, 0xb4, 0x0c, 0x65,
-		0x18, 0xc4, 0x8a, 0x80, 0xc8, 0x7f, 0x42, 0x82, 0xf9, 0x2b, 0x7a, 0x07, 0x97, 0x1d, 0xc8, 0x06,
-		0xb6, 0xd1, 0xd3, 0x10, 0xda, 0xd1, 0x3b, 0x38, 0x2b, 0x2d, 0x07, 0xcf, 0x24, 0x56, 0xdf, 0xb0,
-		0x32, 0x84, 0xb5, 0xe2, 0xc7, 0xd8, 0x24, 0xc5, 0x0a, 0xc5, 0x38, 0xfb, 0xff, 0xc7, 0xde, 0xfe,
-		0x85, 0x4f, 0x7c, 0x59, 0x92, 0xdf, 0x41, 0xfe, 0x9f, 0xeb, 0xa2, 0x5b, 0xec, 0x1b, 0x3d, 0xb9,
-		0x42, 0xf0, 0x3c, 0xfc, 0xdc, 0x3e, 0x4f, 0x4a, 0x54, 0x82, 0xa4, 0xba, 0xc5, 0xaa, 0x85, 0x6d,
-		0x15, 0xef, 0xdb, 0xd8, 0xb0, 0x74, 0xd3, 0xc8, 0x3d, 0x36, 0x06, 0x6b, 0x84, 0xdb, 0x8a, 0x00,
-		0xcf, 0xff, 0x46, 0x18, 0x16, 0xc6, 0xb0, 0x86, 0x10, 0x84, 0x0c, 0xad, 0x4b, 0xba, 0x23, 0x9d,
-		0x89, 0x2b, 0xf4, 0x6f, 0x94, 0x85, 0x68, 0x4f, 0x6b, 0xdd, 0xd2, 0x76, 0x71, 0x36, 0x40, 0x8b,
-		0xc5, 0x27, 0x3a, 0x0d, 0xd0, 0xc6, 0x3d, 0x6c, 0xb4, 0xb1, 0xd1, 0x3a, 0xc8, 0x06, 0x97, 0x83,
-		0x67, 0xe2, 0x8a, 0xa7, 0x04, 0x3d, 0x02, 0xf3, 0xbd, 0xc1, 0x76, 0x47, 0x6f, 0xa9, 0x1e, 0x30,
-		0x58, 0x0e, 0x9e, 0x09, 0x2b, 0x32, 0xab, 0x28, 0xbb, 0xc0, 0x0f, 0x41, 0xe6, 0x25, 0xac, 0xdd,
-		0xf2, 0x82, 0x26, 0x28, 0x68, 0x9a, 0x14, 0x97, 0x7d, 0x54, 0xcd, 0x9e, 0xad, 0x9b, 0x86, 0x17,
-		0x34, 0x43, 0x1b, 0x97, 0x59, 0x85, 0x07, 0xb8, 0x04, 0xc9, 0x2e, 0xb6, 0x2c, 0x6d, 0x17, 0xab,
-		0xf6, 0x41, 0x0f, 0x67, 0x43, 0x74, 0x9c, 0x96, 0x47, 0xc6, 0x69, 0x78, 0x8c, 0x12, 0x1c, 0xab,
-		0x79, 0xd0, 0xc3, 0xa8, 0x00, 0x71, 0x6c, 0x0c, 0xba, 0x8c, 0x42, 0x78, 0xc2, 0x48, 0x57, 0x8c,
-		0x41, 0x77, 0x98, 0x4a, 0x8c, 0xa0, 0x71, 0x12, 0x51, 0x0b, 0xf7, 0x6f, 0xeb, 0x2d, 0x9c, 0x8d,
-		0x50, 0x02, 0x0f, 0x8d, 0x10, 0x68, 0xb0, 0xfa, 0x61, 0x1a, 0x02, 0x0f, 0x95, 0x20, 0xee, 0x8c,
-		0x77, 0x36, 0x4a, 0x89, 0x3c, 0x30, 0x46, 0xdf, 0x70, 0xa7, 0x3d, 0x4c, 0xc2, 0xc5, 0x43, 0x17,
-		0x21, 0xca, 0x64, 0x64, 0x65, 0x63, 0xcb, 0xd2, 0x99, 0xc4, 0xea, 0x3d, 0x63, 0x55, 0xb6, 0xce,
-		0x60, 0x14, 0x01, 0x8c, 0xaa, 0x20, 0x5b, 0xe6, 0xa0, 0xdf, 0xc2, 0x6a, 0xcb, 0x6c, 0x63, 0x55,
-		0x37, 0x76, 0xcc, 0x6c, 0x9c, 0x12, 0x58, 0x1a, 0xed, 0x08, 0x05, 0x2c, 0x99, 0x6d, 0x5c, 0x35,
-		0x76, 0x4c, 0x25, 0x6d, 0xf9, 0xbe, 0xd1, 0x71, 0x88, 0x58, 0x07, 0x86, 0xad, 0xed, 0x67, 0x93,
-		0x54, 0x9d, 0xf8, 0x17, 0x5a, 0x85, 0x28, 0x6e, 0xeb, 0xa4, 0xb9, 0x6c, 0x7a, 0x59, 0x3a, 0x93,
-		0x5e, 0xcd, 0x8e, 0xca, 0x98, 0xd5, 0x2b, 0x02, 0x30, 0xff, 0x3f, 0x23, 0x90, 0x99, 0x45, 0x87,
-		0x2f, 0x43, 0x78, 0x87, 0x48, 0x26, 0x1b, 0x38, 0x8a, 0xdc, 0x18, 0x8e, 0x5f, 0xf0, 0x91, 0xbb,
-		0x14, 0x7c, 0x01, 0x12, 0x06, 0xb6, 0x6c, 0xdc, 0x66, 0x5a, 0x14, 0x9c, 0x51, 0x0f, 0x81, 0x21,
-		0x8d, 0xaa, 0x61, 0xe8, 0xae, 0xd4, 0xf0, 0x79, 0xc8, 0x38, 0x2c, 0xa9, 0x7d, 0xcd, 0xd8, 0x15,
-		0xfa, 0x7c, 0x6e, 0x1a, 0x27, 0x2b, 0x8e, 0xf1, 0x50, 0x08, 0x9a, 0x92, 0xc6, 0xbe, 0x6f, 0x54,
-		0x06, 0x30, 0x0d, 0x6c, 0xee, 0xa8, 0x6d, 0xdc, 0xea, 0x64, 0x63, 0x13, 0xa4, 0x54, 0x27, 0x20,
-		0x23, 0x52, 0x32, 0x59, 0x69, 0xab, 0x83, 0x2e, 0xb9, 0xea, 0x19, 0x9d, 0xa0, 0x5d, 0x1b, 0x6c,
-		0x62, 0x8e, 0x68, 0xe8, 0x16, 0xa4, 0xfb, 0x98, 0xcc, 0x15, 0xdc, 0xe6, 0x3d, 0x8b, 0x53, 0x26,
-		0x56, 0xa6, 0xf6, 0x4c, 0xe1, 0x68, 0xac, 0x63, 0xa9, 0xbe, 0xf7, 0x13, 0xdd, 0x0f, 0x4e, 0x81,
-		0x4a, 0xd5, 0x0a, 0xa8, 0xa5, 0x49, 0x8a, 0xc2, 0x1a, 0x51, 0xaf, 0x02, 0xc0, 0x6d, 0xdd, 0xd2,
-		0xb7, 0xf5, 0x8e, 0x6e, 0x13, 0xb3, 0x45, 0xb4, 0xf7, 0xbe, 0xd1, 0x79, 0x71, 0xd0, 0xdd, 0x36,
-		0x3b, 0x37, 0x1c, 0x40, 0xc5, 0x83, 0x94, 0x7b, 0x19, 0xd2, 0x7e, 0x09, 0xa3, 0x45, 0x08, 0x5b,
-		0xb6, 0xd6, 0xb7, 0xa9, 0x22, 0x87, 0x15, 0xf6, 0x81, 0x64, 0x08, 0x62, 0xa3, 0x4d, 0x2d, 0x71,
-		0x58, 0x21, 0x7f, 0xa2, 0xff, 0xcf, 0x95, 0x59, 0x90, 0xca, 0xec, 0xc1, 0x51, 0xa5, 0xf0, 0x51,
-		0x1e, 0x16, 0x5d, 0xee, 0x29, 0x48, 0xf9, 0x64, 0x30, 0x6b, 0xd3, 0xf9, 0xdf, 0x0e, 0xc1, 0xb1,
-		0xb1, 0xb4, 0xd1, 0xf3, 0xb0, 0x38, 0x30, 0x74, 0xc3, 0xc6, 0xfd, 0x5e, 0x1f, 0x13, 0xad, 0x67,
-		0x6d, 0x65, 0x3f, 0x13, 0x9d, 0xa0, 0xb7, 0x5b, 0x5e, 0x68, 0x46, 0x45, 0x59, 0x18, 0x8c, 0x16,
-		0xa2, 0x9b, 0x90, 0x20, 0x2a, 0xa6, 0xf5, 0x35, 0x4a, 0x90, 0x4d, 0xe8, 0xd5, 0xd9, 0xba, 0xbc,
-		0x52, 0x76, 0x31, 0x8b, 0xc1, 0x77, 0x4b, 0x01, 0xc5, 0x4b, 0x0b, 0x3d, 0x05, 0xb1, 0x1d, 0xac,
-		0xd9, 0x83, 0x3e, 0xb6, 0xb2, 0xab, 0x54, 0x94, 0xa7, 0x46, 0xe7, 0x39, 0x03, 0x68, 0x60, 0x5b,
-		0x71, 0x80, 0x51, 0x17, 0x92, 0xb7, 0x71, 0x5f, 0xdf, 0xd1, 0x5b, 0x8c, 0xa9, 0x20, 0xd5, 0x80,
-		0xa7, 0x67, 0x64, 0xea, 0x86, 0x07, 0xb5, 0x61, 0x6b, 0x36, 0x5e, 0x83, 0xad, 0xda, 0x8d, 0x8a,
-		0x52, 0xbd, 0x52, 0xad, 0x94, 0x19, 0x9b, 0x3e, 0xf2, 0xb9, 0xef, 0x93, 0x20, 0xe1, 0xe9, 0x09,
-		0xb1, 0xa8, 0xc6, 0xa0, 0xbb, 0x8d, 0xfb, 0x7c, 0xbc, 0xf8, 0x17, 0x3a, 0x05, 0xf1, 0x9d, 0x41,
-		0xa7, 0xc3, 0xf4, 0x96, 0xad, 0xdd, 0x31, 0x52, 0x40, 0x75, 0x16, 0x41, 0x88, 0x5b, 0x22, 0x6a,
-		0x26, 0xc9, 0xdf, 0x28, 0x07, 0x31, 0xa1, 0xd7, 0xd9, 0xf0, 0xb2, 0x74, 0x26, 0xa6, 0x38, 0xdf,
-		0xac, 0xae, 0x87, 0x35, 0x1b, 0xb7, 0xb3, 0x11, 0x51, 0xc7, 0xbe, 0xaf, 0x87, 0x62, 0x21, 0x39,
-		0x9c, 0x7f, 0x12, 0xe6, 0x47, 0xba, 0x82, 0x32, 0x90, 0x28, 0x57, 0x4a, 0xeb, 0x05, 0xa5, 0xd0,
-		0xac, 0xd6, 0x6b, 0xf2, 0x1c, 0x4a, 0x83, 0xa7, 0x77, 0xb2, 0x74, 0x36, 0x1e, 0x7b, 0x2d, 0x2a,
-		0xbf, 0xfd, 0xed, 0x6f, 0x7f, 0x7b, 0x20, 0xff, 0xeb, 0x11, 0x58, 0x1c, 0x67, 0x47, 0xc7, 0x9a,
-		0x74, 0xb7, 0xd3, 0x41, 0x5f, 0xa7, 0x0b, 0x10, 0xee, 0x68, 0xdb, 0xb8, 0x93, 0x0d, 0xd1, 0x41,
-		0x78, 0x64, 0x26, 0x4b, 0xbd, 0xb2, 0x4e, 0x50, 0x14, 0x86, 0x89, 0xde, 0xcc, 0x45, 0x13, 0xa6,
-		0x14, 0xce, 0xce, 0x46, 0x81, 0xd8, 0x57, 0x2e, 0xc6, 0x53, 0x10, 0x27, 0xff, 0x32, 0xb9, 0x47,
-		0x98, 0xdc, 0x49, 0x01, 0x95, 0x7b, 0x0e, 0x62, 0xd4, 0x74, 0xb6, 0xb1, 0x33, 0x26, 0xe2, 0x9b,
-		0x18, 0x9b, 0x36, 0xde, 0xd1, 0x06, 0x1d, 0x5b, 0xbd, 0xad, 0x75, 0x06, 0x98, 0x1a, 0xc1, 0xb8,
-		0x92, 0xe4, 0x85, 0x37, 0x48, 0x19, 0x5a, 0x82, 0x04, 0xb3, 0xb4, 0xba, 0xd1, 0xc6, 0xfb, 0x74,
-		0x15, 0x0e, 0x2b, 0xcc, 0xf8, 0x56, 0x49, 0x09, 0x69, 0xfe, 0x45, 0xcb, 0x34, 0x84, 0xb9, 0xa2,
-		0x4d, 0x90, 0x02, 0xda, 0xfc, 0x53, 0xc3, 0x0e, 0xc0, 0xbd, 0xe3, 0xbb, 0x37, 0x62, 0x5f, 0x1f,
-		0x82, 0x0c, 0x85, 0x78, 0x82, 0x4f, 0x65, 0xad, 0x93, 0x9d, 0xa7, 0x6a, 0x90, 0x66, 0xc5, 0x75,
-		0x5e, 0x9a, 0xff, 0x85, 0x00, 0x84, 0xe8, 0x62, 0x93, 0x81, 0x44, 0xf3, 0xe6, 0x66, 0x45, 0x2d,
-		0xd7, 0xb7, 0x8a, 0xeb, 0x15, 0x59, 0x22, 0x43, 0x4f, 0x0b, 0xae, 0xac, 0xd7, 0x0b, 0x4d, 0x39,
-		0xe0, 0x7c, 0x57, 0x6b, 0xcd, 0x8b, 0x4f, 0xca, 0x41, 0x07, 0x61, 0x8b, 0x15, 0x84, 0xbc, 0x00,
-		0x4f, 0xac, 0xca, 0x61, 0x24, 0x43, 0x92, 0x11, 0xa8, 0x3e, 0x5f, 0x29, 0x5f, 0x7c, 0x52, 0x8e,
-		0xf8, 0x4b, 0x9e, 0x58, 0x95, 0xa3, 0x28, 0x05, 0x71, 0x5a, 0x52, 0xac, 0xd7, 0xd7, 0xe5, 0x98,
-		0x43, 0xb3, 0xd1, 0x54, 0xaa, 0xb5, 0xab, 0x72, 0xdc, 0xa1, 0x79, 0x55, 0xa9, 0x6f, 0x6d, 0xca,
-		0xe0, 0x50, 0xd8, 0xa8, 0x34, 0x1a, 0x85, 0xab, 0x15, 0x39, 0xe1, 0x40, 0x14, 0x6f, 0x36, 0x2b,
-		0x0d, 0x39, 0xe9, 0x63, 0xeb, 0x89, 0x55, 0x39, 0xe5, 0x34, 0x51, 0xa9, 0x6d, 0x6d, 0xc8, 0x69,
-		0x34, 0x0f, 0x29, 0xd6, 0x84, 0x60, 0x22, 0x33, 0x54, 0x74, 0xf1, 0x49, 0x59, 0x76, 0x19, 0x61,
-		0x54, 0xe6, 0x7d, 0x05, 0x17, 0x9f, 0x94, 0x51, 0xbe, 0x04, 0x61, 0xaa, 0x86, 0x08, 0x41, 0x7a,
-		0xbd, 0x50, 0xac, 0xac, 0xab, 0xf5, 0x4d, 0x32, 0x69, 0x0a, 0xeb, 0xb2, 0xe4, 0x96, 0x29, 0x95,
-		0xcd, 0x4a, 0xa1, 0x59, 0x29, 0xcb, 0x41, 0x6f, 0xd9, 0x5b, 0xb6, 0xaa, 0x4a, 0xa5, 0x2c, 0x07,
-		0xf2, 0x2d, 0x58, 0x1c, 0xb7, 0xc8, 0x8e, 0x9d, 0x42, 0x1e, 0x5d, 0x08, 0x4c, 0xd0, 0x05, 0x4a,
-		0x6b, 0x58, 0x17, 0xf2, 0x1f, 0x09, 0xc2, 0xc2, 0x18, 0x47, 0x63, 0x6c, 0x23, 0xcf, 0x40, 0x98,
-		0xe9, 0x32, 0xb3, 0xd4, 0x0f, 0x8f, 0xf5, 0x58, 0xa8, 0x66, 0x8f, 0xb8, 0x5f, 0x14, 0xcf, 0xeb,
-		0xb2, 0x06, 0x27, 0xb8, 0xac, 0x84, 0xc4, 0x88, 0xc2, 0x7e, 0xd3, 0x88, 0x43, 0xc0, 0x7c, 0xa6,
-		0x8b, 0xb3, 0xf8, 0x4c, 0xb4, 0xec, 0x68, 0x8e, 0x41, 0x78, 0xaa, 0x63, 0x10, 0xb9, 0x1b, 0xc7,
-		0xe0, 0x32, 0xcc, 0x8f, 0xf0, 0x32, 0xf3, 0x02, 0xfd, 0x4e, 0x09, 0xb2, 0x93, 0xe4, 0x3b, 0xc5,
-		0xaa, 0x06, 0x7c, 0x56, 0xf5, 0xf2, 0xf0, 0x20, 0xdc, 0x37, 0x79, 0x1c, 0x47, 0xd4, 0xe5, 0xe7,
-		0x25, 0x38, 0x3e, 0x3e, 0xba, 0x19, 0xcb, 0xc3, 0x9b, 0x21, 0xd2, 0xc5, 0xf6, 0x9e, 0x29, 0xbc,
-		0xf5, 0x07, 0xc7, 0xf8, 0x80, 0xa4, 0x7a, 0x58, 0x5f, 0x38, 0x96, 0xd7, 0x89, 0x0c, 0x4e, 0x0a,
-		0x51, 0x18, 0x37, 0xc3, 0x9c, 0xb2, 0x85, 0x4c, 0x89, 0x58, 0x76, 0x1f, 0x6b, 0xdd, 0xfc, 0x77,
-		0x04, 0xe0, 0xd8, 0xd8, 0xa6, 0xc6, 0xb2, 0x7d, 0x2f, 0x80, 0x6e, 0xf4, 0x06, 0x36, 0xf3, 0xcf,
-		0x99, 0x69, 0x8f, 0xd3, 0x12, 0x6a, 0x0d, 0x89, 0xd9, 0x1e, 0xd8, 0x4e, 0x3d, 0x5b, 0x76, 0x81,
-		0x15, 0x51, 0x80, 0xa7, 0x5d, 0xb6, 0x43, 0x94, 0xed, 0xd3, 0x13, 0xfa, 0x3d, 0xa2, 0xe9, 0x8f,
-		0x83, 0xdc, 0xea, 0xe8, 0xd8, 0xb0, 0x55, 0xc6, 0xb8, 0x6e, 0xec, 0xb2, 0xe5, 0x7b, 0x2d, 0xbc,
-		0xa3, 0x75, 0x2c, 0xac, 0x64, 0x58, 0x75, 0x43, 0xd4, 0x12, 0x0c, 0xaa, 0x4e, 0x7d, 0x0f, 0x46,
-		0xc4, 0x87, 0xc1, 0xaa, 0x1d, 0x8c, 0xfc, 0x4f, 0xc7, 0x21, 0xe1, 0x89, 0x0c, 0xd1, 0x7d, 0x90,
-		0x7c, 0x51, 0xbb, 0xad, 0xa9, 0x22, 0x35, 0xc0, 0x24, 0x91, 0x20, 0x65, 0x9b, 0x3c, 0x3d, 0xf0,
-		0x38, 0x2c, 0x52, 0x10, 0x73, 0x60, 0xe3, 0xbe, 0xda, 0xea, 0x68, 0x96, 0x45, 0x85, 0x16, 0xa3,
-		0xa0, 0x88, 0xd4, 0xd5, 0x49, 0x55, 0x49, 0xd4, 0xa0, 0x0b, 0xb0, 0x40, 0x31, 0xba, 0x83, 0x8e,
-		0xad, 0xf7, 0x3a, 0x98, 0x26, 0x3d, 0x2c, 0xba, 0x86, 0x39, 0x9c, 0xcd, 0x13, 0x88, 0x0d, 0x0e,
-		0x40, 0x38, 0xb2, 0x50, 0x19, 0xee, 0xa5, 0x68, 0xbb, 0xd8, 0xc0, 0x7d, 0xcd, 0xc6, 0x2a, 0x7e,
-		0xdb, 0x40, 0xeb, 0x58, 0xaa, 0x66, 0xb4, 0xd5, 0x3d, 0xcd, 0xda, 0xcb, 0x2e, 0x12, 0x02, 0xc5,
-		0x40, 0x56, 0x52, 0x4e, 0x12, 0xc0, 0xab, 0x1c, 0xae, 0x42, 0xc1, 0x0a, 0x46, 0xfb, 0x9a, 0x66,
-		0xed, 0xa1, 0x35, 0x38, 0x4e, 0xa9, 0x58, 0x76, 0x5f, 0x37, 0x76, 0xd5, 0xd6, 0x1e, 0x6e, 0xdd,
-		0x52, 0x07, 0xf6, 0xce, 0xd3, 0xd9, 0x53, 0xde, 0xf6, 0x29, 0x87, 0x0d, 0x0a, 0x53, 0x22, 0x20,
-		0x5b, 0xf6, 0xce, 0xd3, 0xa8, 0x01, 0x49, 0x32, 0x18, 0x5d, 0xfd, 0x65, 0xac, 0xee, 0x98, 0x7d,
-		0xba, 0x28, 0xa7, 0xc7, 0xd8, 0x3a, 0x8f, 0x04, 0x57, 0xea, 0x1c, 0x61, 0xc3, 0x6c, 0xe3, 0xb5,
-		0x70, 0x63, 0xb3, 0x52, 0x29, 0x2b, 0x09, 0x41, 0xe5, 0x8a, 0xd9, 0x27, 0x0a, 0xb5, 0x6b, 0x3a,
-		0x02, 0x4e, 0x30, 0x85, 0xda, 0x35, 0x85, 0x78, 0x2f, 0xc0, 0x42, 0xab, 0xc5, 0xfa, 0xac, 0xb7,
-		0x54, 0x9e, 0x25, 0xb0, 0xb2, 0xb2, 0x4f, 0x58, 0xad, 0xd6, 0x55, 0x06, 0xc0, 0x35, 0xde, 0x42,
-		0x97, 0xe0, 0x98, 0x2b, 0x2c, 0x2f, 0xe2, 0xfc, 0x48, 0x2f, 0x87, 0x51, 0x2f, 0xc0, 0x42, 0xef,
-		0x60, 0x14, 0x11, 0xf9, 0x5a, 0xec, 0x1d, 0x0c, 0xa3, 0x3d, 0x40, 0xd3, 0x44, 0x7d, 0xdc, 0xa2,
-		0xbe, 0xe3, 0x09, 0x2f, 0xb4, 0xa7, 0x02, 0xad, 0x80, 0xdc, 0x6a, 0xa9, 0xd8, 0xd0, 0xb6, 0x3b,
-		0x58, 0xd5, 0xfa, 0xd8, 0xd0, 0xac, 0xec, 0x12, 0x05, 0x0e, 0xd9, 0xfd, 0x01, 0x56, 0xd2, 0xad,
-		0x56, 0x85, 0x56, 0x16, 0x68, 0x1d, 0x3a, 0x0b, 0xf3, 0xe6, 0xf6, 0x8b, 0x2d, 0xa6, 0x58, 0x6a,
-		0xaf, 0x8f, 0x77, 0xf4, 0xfd, 0xec, 0x1b, 0xa8, 0x94, 0x32, 0xa4, 0x82, 0xaa, 0xd5, 0x26, 0x2d,
-		0x46, 0x0f, 0x83, 0xdc, 0xb2, 0xf6, 0xb4, 0x7e, 0x8f, 0x9a, 0x6a, 0xab, 0xa7, 0xb5, 0x70, 0xf6,
-		0x01, 0x06, 0xca, 0xca, 0x6b, 0xa2, 0x98, 0x28, 0xb6, 0xf5, 0x92, 0xbe, 0x63, 0x0b, 0x8a, 0x0f,
-		0x31, 0xc5, 0xa6, 0x65, 0x9c, 0xda, 0x19, 0x90, 0x7b, 0x7b, 0x3d, 0x7f, 0xc3, 0x67, 0x28, 0x58,
-		0xba, 0xb7, 0xd7, 0xf3, 0xb6, 0x7b, 0x3f, 0xa4, 0x08, 0xa4, 0xdb, 0xe8, 0xc3, 0xcc, 0xa1, 0xeb,
-		0xed, 0x79, 0x5a, 0x7c, 0x12, 0x8e, 0x13, 0xa0, 0x2e, 0xb6, 0xb5, 0xb6, 0x66, 0x6b, 0x1e, 0xe8,
-		0x47, 0x29, 0xf4, 0x62, 0x6f, 0xaf, 0xb7, 0xc1, 0x2b, 0x7d, 0x7c, 0xf6, 0x07, 0xdb, 0x07, 0x8e,
-		0x7e, 0x3c, 0xc6, 0xf8, 0x24, 0x65, 0x42, 0x43, 0xee, 0x3a, 0x9e, 0xf9, 0x9a, 0x45, 0x6f, 0xf9,
-		0x35, 0x48, 0x7a, 0xf5, 0x1e, 0xc5, 0x81, 0x69, 0xbe, 0x2c, 0x11, 0xaf, 0xaa, 0x54, 0x2f, 0x13,
-		0x7f, 0xe8, 0x85, 0x8a, 0x1c, 0x20, 0x7e, 0xd9, 0x7a, 0xb5, 0x59, 0x51, 0x95, 0xad, 0x5a, 0xb3,
-		0xba, 0x51, 0x91, 0x83, 0x9e, 0x48, 0xe1, 0x7a, 0x28, 0x76, 0x56, 0x7e, 0xe4, 0x7a, 0x28, 0xf6,
-		0xa0, 0xfc, 0x10, 0x15, 0xcf, 0x88, 0x52, 0xe6, 0xbf, 0x18, 0x84, 0xb4, 0x3f, 0x55, 0x80, 0xde,
-		0x08, 0x27, 0x44, 0x2e, 0xd0, 0xc2, 0xb6, 0xfa, 0x92, 0xde, 0xa7, 0x93, 0xb5, 0xab, 0xb1, 0x65,
-		0xd4, 0x51, 0xca, 0x45, 0x0e, 0xd5, 0xc0, 0xf6, 0x73, 0x7a, 0x9f, 0x4c, 0xc5, 0xae, 0x66, 0xa3,
-		0x75, 0x58, 0x32, 0x4c, 0xd5, 0xb2, 0x35, 0xa3, 0xad, 0xf5, 0xdb, 0xde, 0x4c, 0xac, 0xd6, 0x6a,
-		0x61, 0xcb, 0x32, 0xd9, 0x92, 0xe9, 0x50, 0xb9, 0xc7, 0x30, 0x1b, 0x1c, 0xd8, 0x5d, 0x3d, 0x0a,
-		0x1c, 0x74, 0x68, 0x4e, 0x04, 0x27, 0xcd, 0x89, 0x53, 0x10, 0xef, 0x6a, 0x3d, 0x15, 0x1b, 0x76,
-		0xff, 0x80, 0x06, 0x03, 0x31, 0x25, 0xd6, 0xd5, 0x7a, 0x15, 0xf2, 0x8d, 0x6e, 0xc0, 0x83, 0x2e,
-		0xa8, 0xda, 0xc1, 0xbb, 0x5a, 0xeb, 0x40, 0xa5, 0x9e, 0x3f, 0xcd, 0x5b, 0xa9, 0x2d, 0xd3, 0xd8,
-		0xe9, 0xe8, 0x2d, 0xdb, 0xa2, 0xb6, 0x83, 0xd9, 0xbf, 0xbc, 0x8b, 0xb1, 0x4e, 0x11, 0xae, 0x5b,
-		0xa6, 0x41, 0x1d, 0xfe, 0x92, 0x80, 0xf6, 0xa9, 0x4d, 0xf2, 0x75, 0xa1, 0x36, 0xfe, 0xa1, 0x0f,
-		0xc9, 0xe1, 0xeb, 0xa1, 0x58, 0x58, 0x8e, 0x5c, 0x0f, 0xc5, 0x22, 0x72, 0xf4, 0x7a, 0x28, 0x16,
-		0x93, 0xe3, 0xd7, 0x43, 0xb1, 0xb8, 0x0c, 0xf9, 0x0f, 0xa5, 0x20, 0xe9, 0x8d, 0x5f, 0x48, 0x38,
-		0xd8, 0xa2, 0x0b, 0xae, 0x44, 0x4d, 0xf2, 0xfd, 0x87, 0x46, 0x3b, 0x2b, 0x25, 0xb2, 0x12, 0xaf,
-		0x45, 0x58, 0xb0, 0xa0, 0x30, 0x4c, 0xe2, 0x13, 0x91, 0x49, 0x86, 0x99, 0x67, 0x15, 0x53, 0xf8,
-		0x17, 0xba, 0x0a, 0x91, 0x17, 0x2d, 0x4a, 0x9b, 0x39, 0x76, 0x6f, 0x38, 0x9c, 0xf6, 0xf5, 0x06,
-		0x25, 0x1e, 0xbf, 0xde, 0x50, 0x6b, 0x75, 0x65, 0xa3, 0xb0, 0xae, 0x70, 0x74, 0x74, 0x12, 0x42,
-		0x1d, 0xed, 0xe5, 0x03, 0xff, 0x9a, 0x4d, 0x8b, 0xd0, 0x0a, 0x64, 0x06, 0x06, 0x0b, 0xfe, 0xc9,
-		0x18, 0x13, 0xa8, 0x8c, 0x17, 0x2a, 0xed, 0xd6, 0xae, 0x13, 0xf8, 0x19, 0xf5, 0xea, 0x5e, 0x08,
-		0xbd, 0x84, 0xb5, 0x5b, 0xbe, 0x95, 0x95, 0xea, 0x07, 0x2d, 0x46, 0x67, 0x20, 0xd9, 0xc6, 0xdb,
-		0x83, 0x5d, 0xb5, 0x8f, 0xdb, 0x5a, 0xcb, 0xf6, 0xaf, 0x29, 0x09, 0x5a, 0xa5, 0xd0, 0x1a, 0xf4,
-		0x2c, 0xc4, 0xc9, 0x38, 0x19, 0x74, 0x9c, 0xe7, 0xa9, 0x18, 0x1e, 0x3b, 0x5c, 0x0c, 0x7c, 0x98,
-		0x05, 0x92, 0xe2, 0xe2, 0xa3, 0x6b, 0x10, 0xb5, 0xb5, 0xfe, 0x2e, 0xb6, 0xad, 0xec, 0xc2, 0x72,
-		0xf0, 0x4c, 0x7a, 0x4c, 0xee, 0x6e, 0x0c, 0xa9, 0x26, 0x45, 0xa1, 0xe1, 0xb7, 0x40, 0x47, 0xcf,
-		0x81, 0xcc, 0x53, 0xc4, 0x2a, 0x8f, 0x9d, 0xad, 0xec, 0x22, 0x55, 0xc2, 0x47, 0x0f, 0x27, 0xc9,
-		0x33, 0xcc, 0x65, 0x86, 0xa4, 0x64, 0xb0, 0xef, 0xdb, 0x3f, 0x37, 0x8e, 0x1d, 0x65, 0x6e, 0x6c,
-		0x41, 0x86, 0xff, 0xad, 0x5a, 0x83, 0x5e, 0xcf, 0xec, 0xdb, 0xd9, 0xe3, 0x14, 0x7f, 0x0a, 0x43,
-		0x82, 0x18, 0xc3, 0x51, 0xd2, 0x3b, 0xbe, 0xef, 0xaf, 0xdd, 0x94, 0xcb, 0xbd, 0x00, 0x69, 0xbf,
-		0x30, 0xbc, 0x09, 0xfa, 0xe0, 0x8c, 0x09, 0x7a, 0x12, 0xa8, 0x88, 0xe8, 0x8f, 0x2c, 0x4f, 0xec,
-		0x23, 0xf7, 0xfd, 0x01, 0x48, 0xfb, 0x3b, 0x86, 0xae, 0x02, 0x12, 0x23, 0xa6, 0x1b, 0x76, 0xdf,
-		0x6c, 0x0f, 0x5a, 0xb8, 0xcd, 0x27, 0xed, 0xe4, 0x76, 0xe6, 0x39, 0x4e, 0xd5, 0x41, 0xf1, 0x12,
-		0xf2, 0xcc, 0x84, 0xc0, 0x8c, 0x84, 0xca, 0xee, 0x1c, 0x39, 0x07, 0x0b, 0x82, 0x00, 0x21, 0xf6,
-		0x92, 0xd6, 0x37, 0x88, 0x9b, 0xcc, 0x1c, 0x77, 0xe4, 0xa9, 0x7a, 0x8e, 0xd5, 0xa0, 0x02, 0x08,
-		0x75, 0x51, 0xfb, 0xb8, 0x6b, 0xde, 0xc6, 0x6d, 0x9e, 0x83, 0x9a, 0xdc, 0x6c, 0x9a, 0x23, 0x28,
-		0x0c, 0x3e, 0x7f, 0x0e, 0xc2, 0xd4, 0x04, 0x21, 0x00, 0x6e, 0x84, 0xe4, 0x39, 0x14, 0x83, 0x50,
-		0xa9, 0xae, 0x90, 0x25, 0x52, 0x86, 0x24, 0x2b, 0x55, 0x37, 0xab, 0x95, 0x52, 0x45, 0x0e, 0xe4,
-		0x2f, 0x40, 0x84, 0xd9, 0x15, 0xb2, 0x7c, 0x3a, 0x96, 0x45, 0x9e, 0xe3, 0x9f, 0x9c, 0x86, 0x24,
-		0x6a, 0xb7, 0x36, 0x8a, 0x15, 0x45, 0x0e, 0xe4, 0xb7, 0x20, 0x33, 0x34, 0x0f, 0xd1, 0x31, 0x98,
-		0x57, 0x2a, 0xcd, 0x4a, 0xad, 0x59, 0xad, 0xd7, 0xd4, 0xad, 0xda, 0xb3, 0xb5, 0xfa, 0x73, 0x35,
-		0x79, 0xce, 0x5f, 0x2c, 0xd6, 0x62, 0x09, 0x2d, 0x82, 0xec, 0x16, 0x37, 0xea, 0x5b, 0x0a, 0xe5,
-		0xe6, 0x3b, 0x03, 0x20, 0x0f, 0x4f, 0x4a, 0x74, 0x02, 0x16, 0x9a, 0x05, 0xe5, 0x6a, 0xa5, 0xa9,
-		0xb2, 0x2c, 0x8a, 0x43, 0x7a, 0x11, 0x64, 0x6f, 0xc5, 0x95, 0x2a, 0x4d, 0x12, 0x2d, 0xc1, 0x29,
-		0x6f, 0x69, 0xe5, 0xf9, 0x66, 0xa5, 0xd6, 0xa0, 0x8d, 0x17, 0x6a, 0x57, 0x89, 0x63, 0x30, 0x44,
-		0x4f, 0xe4, 0x6d, 0x82, 0x84, 0x55, 0x3f, 0xbd, 0xca, 0x7a, 0x59, 0x0e, 0x0d, 0x17, 0xd7, 0x6b,
-		0x95, 0xfa, 0x15, 0x39, 0x3c, 0xdc, 0x3a, 0xcd, 0xe5, 0x44, 0x50, 0x0e, 0x8e, 0x0f, 0x97, 0xaa,
-		0x95, 0x5a, 0x53, 0xb9, 0x29, 0x47, 0x87, 0x1b, 0x6e, 0x54, 0x94, 0x1b, 0xd5, 0x52, 0x45, 0x8e,
-		0xa1, 0xe3, 0x80, 0xfc, 0x1c, 0x35, 0xaf, 0xd5, 0xcb, 0x72, 0x7c, 0xdc, 0xaa, 0x85, 0xe4, 0x85,
-		0xfc, 0x4f, 0x49, 0x90, 0xf4, 0xe6, 0x55, 0x7c, 0x46, 0x45, 0x7a, 0xbd, 0x2d, 0xb8, 0xf9, 0xdf,
-		0x09, 0x40, 0xc2, 0x93, 0x60, 0x21, 0x81, 0xac, 0xd6, 0xe9, 0x98, 0x2f, 0xa9, 0x5a, 0x47, 0xd7,
-		0x2c, 0xbe, 0x26, 0x02, 0x2d, 0x2a, 0x90, 0x92, 0x59, 0xd7, 0xa0, 0xd9, 0xdd, 0x97, 0xc8, 0x5d,
-		0xbb, 0x2f, 0xd1, 0xd7, 0xa1, 0xfb, 0x12, 0x96, 0x23, 0xf9, 0xdf, 0x0b, 0x80, 0x3c, 0x9c, 0x2f,
-		0x19, 0x92, 0x9b, 0x34, 0x49, 0x6e, 0xde, 0xfe, 0x05, 0x8e, 0xd2, 0xbf, 0xe1, 0x55, 0x3d, 0x38,
-		0x71, 0x55, 0x1f, 0xb3, 0x58, 0x85, 0x5e, 0xcf, 0x8b, 0x95, 0x57, 0x5d, 0xff, 0xbd, 0x04, 0x69,
-		0x7f, 0x7a, 0xc7, 0x27, 0xb1, 0xfc, 0x51, 0x24, 0xe6, 0x1f, 0x91, 0xfb, 0x26, 0x8d, 0xc8, 0xd7,
-		0xa5, 0x5f, 0x3f, 0x18, 0x84, 0x94, 0x2f, 0xff, 0x33, 0x2b, 0x77, 0x6f, 0x83, 0x79, 0xbd, 0x8d,
-		0xbb, 0x3d, 0xd3, 0xc6, 0x46, 0xeb, 0x40, 0xed, 0xe0, 0xdb, 0xb8, 0x43, 0xc5, 0x90, 0x1e, 0xb3,
-		0xeb, 0xeb, 0x6b, 0x61, 0xa5, 0xea, 0xe2, 0xad, 0x13, 0xb4, 0xb5, 0x85, 0x6a, 0xb9, 0xb2, 0xb1,
-		0x59, 0x6f, 0x56, 0x6a, 0xa5, 0x9b, 0xc2, 0x92, 0x2b, 0xb2, 0x3e, 0x04, 0xe6, 0x13, 0xf8, 0xfd,
-		0xaf, 0x8f, 0xc0, 0x73, 0x13, 0xe4, 0xe1, 0xde, 0x10, 0x83, 0x3e, 0xa6, 0x3f, 0xf2, 0x1c, 0x5a,
-		0x80, 0x4c, 0xad, 0xae, 0x36, 0xaa, 0xe5, 0x8a, 0x5a, 0xb9, 0x72, 0xa5, 0x52, 0x6a, 0x36, 0xd8,
-		0xee, 0x85, 0x03, 0xdd, 0x94, 0x03, 0xde, 0xb1, 0xf9, 0xa1, 0x20, 0x2c, 0x8c, 0xe1, 0x04, 0x15,
-		0x78, 0x9a, 0x90, 0xe5, 0x31, 0x1f, 0x9b, 0x85, 0xfb, 0x15, 0x12, 0xe1, 0x6f, 0x6a, 0x7d, 0x9b,
-		0x67, 0x15, 0x1f, 0x06, 0x22, 0x5e, 0xc3, 0x26, 0x2e, 0x7e, 0x9f, 0xef, 0x0a, 0x31, 0x17, 0x24,
-		0xe3, 0x96, 0xb3, 0x8d, 0xa1, 0x47, 0x01, 0xf5, 0x4c, 0x4b, 0xb7, 0xf5, 0xdb, 0x98, 0xf8, 0x50,
-		0x1c, 0x98, 0x4c, 0xdc, 0x90, 0x22, 0x8b, 0x9a, 0xaa, 0x61, 0x3b, 0xd0, 0x06, 0xde, 0xd5, 0x86,
-		0xa0, 0x49, 0x08, 0x12, 0x54, 0x64, 0x51, 0xe3, 0x40, 0xdf, 0x07, 0xc9, 0xb6, 0x39, 0xd8, 0xee,
-		0x60, 0x0e, 0x47, 0x4c, 0xb2, 0xa4, 0x24, 0x58, 0x99, 0x03, 0xc2, 0x53, 0x67, 0xee, 0xde, 0x55,
-		0x52, 0x49, 0xb0, 0x32, 0x06, 0xf2, 0x10, 0x64, 0xb4, 0xdd, 0xdd, 0x3e, 0x21, 0x2e, 0x08, 0xb1,
-		0x64, 0x60, 0xda, 0x29, 0xa6, 0x80, 0xb9, 0xeb, 0x10, 0x13, 0x72, 0x20, 0x31, 0x30, 0x91, 0x84,
-		0xda, 0x63, 0xf9, 0xee, 0xc0, 0x99, 0xb8, 0x12, 0x33, 0x44, 0xe5, 0x7d, 0x90, 0xd4, 0x2d, 0xf7,
-		0x1c, 0x54, 0x36, 0xb0, 0x1c, 0x38, 0x13, 0x53, 0x12, 0xba, 0xe5, 0x9e, 0x75, 0xfa, 0xe0, 0x3c,
-		0x80, 0xab, 0x6c, 0xe8, 0x7b, 0x24, 0x48, 0xb3, 0x05, 0xa6, 0xd7, 0xc7, 0x16, 0x36, 0x5a, 0x22,
-		0x34, 0x7c, 0xf8, 0x10, 0x15, 0x65, 0x66, 0x6e, 0x93, 0x23, 0x14, 0x9f, 0x79, 0xb7, 0x24, 0xbd,
-		0x2a, 0x85, 0x5e, 0x95, 0xa4, 0x0f, 0x4b, 0x29, 0x14, 0xab, 0x3c, 0xbf, 0xb9, 0x5e, 0x2d, 0x55,
-		0x9b, 0xd9, 0x77, 0x45, 0xe9, 0x77, 0x75, 0x83, 0x7f, 0x7f, 0x26, 0xea, 0xaf, 0x7f, 0x2d, 0xfa,
-		0x73, 0x52, 0x30, 0xf6, 0x5a, 0x54, 0x49, 0xed, 0x78, 0xe9, 0xa1, 0x8e, 0xf7, 0x64, 0x47, 0x60,
-		0x52, 0x30, 0xe9, 0x72, 0x53, 0xe1, 0xe7, 0x39, 0x8a, 0x0f, 0x53, 0x46, 0x22, 0x94, 0x91, 0x04,
-		0x8a, 0x94, 0xd6, 0xeb, 0x8d, 0x4a, 0x99, 0xb2, 0x11, 0x47, 0xa1, 0xfa, 0x66, 0xa5, 0x96, 0xfd,
-		0x8c, 0x68, 0xd2, 0x3d, 0x04, 0xf2, 0xaa, 0x04, 0x27, 0xc4, 0xd6, 0x2d, 0x5f, 0x6b, 0xb1, 0xd1,
-		0x32, 0xdb, 0xc2, 0xbb, 0x4d, 0xaf, 0x9e, 0x3f, 0xac, 0x71, 0x85, 0xa3, 0x52, 0x91, 0x54, 0x38,
-		0x62, 0xf1, 0xb1, 0x11, 0x91, 0x14, 0x6a, 0x65, 0xce, 0x4b, 0x02, 0x45, 0x36, 0x0b, 0xa5, 0x67,
-		0x2b, 0x65, 0x97, 0x9b, 0x63, 0xfd, 0x71, 0x54, 0xd0, 0xb7, 0x40, 0x66, 0x60, 0xef, 0x3c, 0x4d,
-		0x74, 0x43, 0x6f, 0xb3, 0xbd, 0xf4, 0xd0, 0xa4, 0x4d, 0x58, 0x97, 0xa3, 0x2d, 0x7b, 0xe7, 0xe9,
-		0x1b, 0x0e, 0x06, 0x17, 0x0a, 0x63, 0x25, 0x8e, 0x42, 0xb5, 0x7a, 0xad, 0x22, 0xd8, 0xa0, 0xfb,
-		0xce, 0x37, 0x5d, 0x36, 0xd2, 0x03, 0x1f, 0x2a, 0xfa, 0x16, 0x90, 0x45, 0x8a, 0xc8, 0x11, 0x49,
-		0x78, 0xd2, 0x3e, 0xb2, 0xcb, 0x00, 0x4f, 0x34, 0x39, 0xc2, 0x78, 0xd0, 0xc3, 0xc1, 0x22, 0xca,
-		0xac, 0x57, 0x6a, 0x57, 0x9b, 0xd7, 0xd4, 0x4d, 0xa5, 0x42, 0xb7, 0x03, 0xb3, 0xef, 0x12, 0xcd,
-		0x67, 0xba, 0x7e, 0x44, 0xf4, 0xad, 0x12, 0x24, 0x98, 0x0b, 0xc4, 0xf2, 0x52, 0x2c, 0xb1, 0xf0,
-		0xe0, 0x61, 0x6d, 0x53, 0x0f, 0x88, 0x42, 0x17, 0x2f, 0xd1, 0x66, 0x83, 0x42, 0x21, 0x4e, 0x20,
-		0xb4, 0x5e, 0xb9, 0x5a, 0x28, 0xdd, 0x54, 0x8b, 0x95, 0x46, 0x93, 0x58, 0xb2, 0xba, 0xc2, 0x74,
-		0x14, 0x50, 0xb8, 0xb0, 0xbe, 0x5e, 0x7f, 0xce, 0x15, 0x04, 0xbc, 0xe8, 0x90, 0x41, 0x3f, 0x29,
-		0xc1, 0x22, 0x36, 0x76, 0xcc, 0x7e, 0x8b, 0x6e, 0x61, 0x93, 0x19, 0x6d, 0xd9, 0x07, 0x1d, 0x36,
-		0xa3, 0xc7, 0x06, 0xe5, 0x5e, 0xcd, 0xa4, 0x78, 0x35, 0x8a, 0xd6, 0x20, 0x58, 0xc5, 0xea, 0xbb,
-		0xa5, 0xc0, 0xab, 0x84, 0xb1, 0x00, 0xe5, 0x2d, 0xf4, 0xaa, 0x14, 0xa6, 0x1c, 0x46, 0x5f, 0x95,
-		0x62, 0xaf, 0x4a, 0xf1, 0x0f, 0x4b, 0xf3, 0x28, 0xd9, 0x68, 0xde, 0x5c, 0xaf, 0xa8, 0x8c, 0x5b,
-		0xca, 0x61, 0x1a, 0xc5, 0x69, 0xd9, 0xea, 0xe3, 0xab, 0x4f, 0x66, 0x3f, 0x4b, 0xb9, 0xfc, 0x6c,
-		0x54, 0x41, 0x78, 0x84, 0x3c, 0xfa, 0x65, 0x09, 0x4e, 0x8a, 0x4d, 0x73, 0x8b, 0x6e, 0xa4, 0xa9,
-		0x9e, 0x2d, 0xb7, 0x18, 0x65, 0xb9, 0x72, 0x18, 0xcb, 0xee, 0xbe, 0x1b, 0x2f, 0x5c, 0xe1, 0x01,
-		0xef, 0xf0, 0xb6, 0x5c, 0xf1, 0x22, 0xeb, 0xc9, 0x87, 0xa5, 0x0c, 0x82, 0xca, 0xf3, 0x9b, 0x75,
-		0xa5, 0xa9, 0x16, 0xd6, 0xd7, 0x29, 0xbf, 0xc7, 0x90, 0xcc, 0x4b, 0x9a, 0xf5, 0x4d, 0x75, 0xbd,
-		0x72, 0xa3, 0xb2, 0xee, 0xb2, 0x7d, 0xa2, 0x3d, 0x9e, 0x60, 0xee, 0x43, 0x12, 0xcc, 0x8f, 0x34,
-		0x9f, 0x7f, 0x87, 0x04, 0x27, 0x26, 0xb0, 0x80, 0x1e, 0x80, 0xfb, 0xca, 0x95, 0x2b, 0x85, 0xad,
-		0xf5, 0xa6, 0xda, 0xb8, 0xb9, 0x51, 0xac, 0xaf, 0xab, 0x37, 0xaa, 0x8d, 0x6a, 0xb1, 0xba, 0x5e,
-		0x6d, 0x7a, 0x17, 0xb0, 0x34, 0x78, 0x18, 0x64, 0xe1, 0xda, 0x30, 0x7b, 0x72, 0x80, 0x04, 0x85,
-		0xeb, 0xf5, 0x52, 0x61, 0x9d, 0x02, 0x05, 0x45, 0xcc, 0x59, 0x6a, 0xca, 0xa1, 0xeb, 0xb1, 0x98,
-		0xc4, 0xd7, 0xb6, 0x6f, 0x84, 0x94, 0xcf, 0xf8, 0x91, 0x10, 0x89, 0x86, 0x56, 0x44, 0x9f, 0x1b,
-		0x95, 0x5a, 0xc9, 0x1b, 0xd2, 0x25, 0xc1, 0x31, 0x76, 0xb2, 0x44, 0xbe, 0x84, 0x29, 0x94, 0x03,
-		0x64, 0x51, 0xe5, 0xea, 0xe8, 0x6c, 0x57, 0x07, 0xf3, 0x4f, 0x41, 0x4c, 0x18, 0x33, 0x12, 0xa8,
-		0xd1, 0x78, 0x6b, 0x28, 0x4c, 0x8c, 0x01, 0xb5, 0x64, 0xb2, 0x44, 0x18, 0x64, 0x16, 0x4e, 0x0e,
-		0xe4, 0x6f, 0xc0, 0xb1, 0xb1, 0x86, 0x08, 0xdd, 0x0f, 0x4b, 0x62, 0x8b, 0x9c, 0x85, 0x80, 0x6a,
-		0xa5, 0x56, 0xaa, 0x97, 0x49, 0xd0, 0xec, 0xd2, 0x04, 0xe0, 0x16, 0x89, 0x71, 0x29, 0xac, 0x95,
-		0x1c, 0xc8, 0x57, 0x21, 0xed, 0x37, 0x27, 0xe8, 0x14, 0x9c, 0xd8, 0x6a, 0x5e, 0x79, 0x5a, 0xbd,
-		0x51, 0x58, 0xaf, 0x96, 0x0b, 0x43, 0xe1, 0x31, 0x00, 0xb7, 0x29, 0x72, 0x80, 0x30, 0x4a, 0x6c,
-		0x8d, 0x1c, 0xcc, 0x87, 0x62, 0x92, 0x2c, 0xe5, 0x1b, 0x90, 0x19, 0x32, 0x0c, 0xe8, 0x1e, 0xc8,
-		0xf2, 0x78, 0x75, 0x1c, 0x57, 0x54, 0x42, 0x3e, 0x53, 0xc1, 0x22, 0xf7, 0x72, 0x65, 0xbd, 0xba,
-		0x51, 0x6d, 0x52, 0xfe, 0xae, 0x01, 0xb8, 0x33, 0x9e, 0x78, 0x30, 0xd7, 0x1b, 0xf5, 0x9a, 0x7a,
-		0x85, 0x84, 0xfd, 0x4d, 0x0f, 0xa9, 0x38, 0xb0, 0x19, 0x2e, 0x4b, 0x24, 0x3a, 0x1d, 0x35, 0x03,
-		0x72, 0x20, 0xff, 0x1c, 0xa0, 0xd1, 0xd9, 0x8a, 0x96, 0xe1, 0x9e, 0x4a, 0xed, 0x4a, 0x5d, 0x29,
-		0x55, 0xd4, 0x5a, 0x61, 0x83, 0xf0, 0xc7, 0xe6, 0xa6, 0x4b, 0x3a, 0x05, 0xee, 0xd4, 0x14, 0x39,
-		0x09, 0x77, 0xf6, 0xca, 0x81, 0xb3, 0x3f, 0x10, 0x20, 0x9e, 0xd1, 0x7b, 0x6a, 0xb9, 0xef, 0x0a,
-		0xa0, 0x7b, 0x63, 0xaf, 0x45, 0x51, 0x74, 0xa5, 0xb7, 0xbd, 0xd2, 0xea, 0xf5, 0x72, 0x19, 0xf2,
-		0x47, 0xa9, 0xd7, 0xbb, 0x22, 0xfc, 0xbd, 0xa5, 0xd8, 0x67, 0xa3, 0x28, 0x46, 0x4a, 0x5f, 0xd4,
-		0x6e, 0x6b, 0x39, 0x99, 0xfc, 0x75, 0x5d, 0xbb, 0xad, 0x39, 0x00, 0xa7, 0x62, 0x9f, 0x8b, 0xa2,
-		0x08, 0x29, 0xde, 0x35, 0x73, 0x69, 0xf2, 0xef, 0x55, 0xd3, 0xa9, 0xbc, 0x3f, 0xf6, 0xf9, 0x28,
-		0x02, 0x52, 0xd8, 0x3b, 0xb0, 0xf7, 0x4c, 0x23, 0x87, 0xc8, 0xdf, 0x9b, 0xf4, 0x6f, 0x07, 0xe8,
-		0x42, 0xec, 0xb7, 0x63, 0x28, 0xbb, 0xa2, 0x77, 0x7b, 0xe4, 0xbf, 0x3e, 0xb6, 0x2c, 0xd5, 0x89,
-		0x6d, 0xb0, 0x9d, 0x3b, 0x4e, 0x6b, 0xaa, 0xac, 0xc6, 0xe3, 0x26, 0xac, 0xc4, 0xbe, 0xad, 0x86,
-		0x64, 0xc1, 0x99, 0xda, 0x1d, 0xd8, 0xda, 0x76, 0x07, 0xe7, 0x4e, 0x08, 0x0e, 0x37, 0x58, 0x81,
-		0x87, 0x97, 0x6f, 0xaf, 0x71, 0x5e, 0x88, 0x8d, 0x39, 0xcf, 0x79, 0xa1, 0x7f, 0x0b, 0xa0, 0xb3,
-		0x91, 0xd8, 0x7b, 0x6a, 0xf2, 0xfb, 0x6a, 0x67, 0x23, 0xb1, 0xf7, 0xd5, 0xe4, 0xef, 0xa9, 0x5d,
-		0x8f, 0xc4, 0x3e, 0x13, 0x95, 0x5f, 0x8b, 0xe6, 0xff, 0x2c, 0x08, 0xc8, 0x6d, 0xdb, 0x49, 0x36,
-		0x3e, 0x0f, 0x31, 0x27, 0x7b, 0xc9, 0x0e, 0x98, 0xbf, 0xf1, 0x10, 0x43, 0x26, 0xd0, 0x3c, 0x45,
-		0x43, 0xd9, 0x4c, 0x87, 0x1a, 0x2a, 0x40, 0xa6, 0xab, 0x1b, 0x7a, 0x77, 0xd0, 0x55, 0x45, 0x4a,
-		0x6f, 0x6a, 0xaa, 0x8a, 0x23, 0xf0, 0x6f, 0x4a, 0x42, 0xdb, 0xf7, 0x91, 0x08, 0x4f, 0x25, 0xc1,
-		0x10, 0xf8, 0x77, 0xee, 0x6f, 0x24, 0xc8, 0x4e, 0x62, 0xf6, 0xae, 0xb2, 0x8d, 0x35, 0x58, 0x34,
-		0x6f, 0xe3, 0x7e, 0x5f, 0x6f, 0xd3, 0x4d, 0x44, 0x27, 0x06, 0x09, 0x4d, 0x8f, 0x41, 0x16, 0x3c,
-		0x88, 0xce, 0xa0, 0x16, 0x89, 0xab, 0xb8, 0x4f, 0xbc, 0x24, 0x41, 0x29, 0x3c, 0x9d, 0x52, 0x8a,
-		0xa2, 0x08, 0x1a, 0xd7, 0x89, 0x2d, 0x20, 0x61, 0x7f, 0x40, 0x0e, 0xba, 0x81, 0x4e, 0xfe, 0x03,
-		0x41, 0x48, 0xfb, 0xcf, 0x49, 0xa3, 0x32, 0xc4, 0x3a, 0x26, 0x3f, 0x40, 0xc8, 0x46, 0xfb, 0xcc,
-		0x94, 0xa3, 0xd5, 0x2b, 0xeb, 0x1c, 0x5e, 0x71, 0x30, 0x73, 0xff, 0x4e, 0x82, 0x98, 0x28, 0x46,
-		0xc7, 0x21, 0xd4, 0xd3, 0xec, 0x3d, 0x4a, 0x2e, 0x5c, 0x0c, 0xc8, 0x92, 0x42, 0xbf, 0x49, 0xb9,
-		0xd5, 0xd3, 0xd8, 0xe1, 0x49, 0x5e, 0x4e, 0xbe, 0x49, 0xb0, 0xd1, 0xc1, 0x5a, 0x9b, 0x6e, 0x7f,
-		0x9b, 0xdd, 0x2e, 0x36, 0x6c, 0x4b, 0x04, 0x1b, 0xbc, 0xbc, 0xc4, 0x8b, 0xd1, 0x23, 0x30, 0x6f,
-		0xf7, 0x35, 0xbd, 0xe3, 0x83, 0x0d, 0x51, 0x58, 0x59, 0x54, 0x38, 0xc0, 0x6b, 0x70, 0x52, 0xd0,
-		0x6d, 0x63, 0x5b, 0x6b, 0xed, 0xe1, 0xb6, 0x8b, 0x14, 0xa1, 0xe7, 0x66, 0x4e, 0x70, 0x80, 0x32,
-		0xaf, 0x17, 0xb8, 0x67, 0x07, 0xbe, 0x7b, 0x12, 0xbb, 0x08, 0xf3, 0x7b, 0x12, 0xe7, 0x27, 0xdc,
-		0x93, 0x18, 0x3e, 0xa6, 0xee, 0xb9, 0x24, 0x71, 0x76, 0x0c, 0x8a, 0x5f, 0xa2, 0x6e, 0xd4, 0xf0,
-		0xc9, 0x00, 0xcc, 0x8b, 0x73, 0x02, 0x6d, 0x67, 0x8c, 0x36, 0x00, 0x34, 0xc3, 0x30, 0x6d, 0xef,
-		0x28, 0x8d, 0x86, 0x75, 0x23, 0x78, 0x2b, 0x05, 0x07, 0x49, 0xf1, 0x10, 0xc8, 0x7d, 0x5e, 0x02,
-		0x70, 0xab, 0x26, 0x0e, 0xd7, 0x12, 0x24, 0x78, 0xaf, 0xe8, 0x5d, 0x13, 0x96, 0x4a, 0x07, 0x56,
-		0x74, 0x45, 0xef, 0xd0, 0xe3, 0x40, 0xdb, 0x78, 0x57, 0x37, 0xf8, 0x51, 0x48, 0xf6, 0x21, 0x8e,
-		0x03, 0x85, 0xdc, 0xa3, 0xc2, 0x0a, 0xc4, 0x2c, 0xdc, 0xd5, 0x0c, 0x5b, 0x6f, 0xf1, 0xc9, 0x7a,
-		0xf1, 0x48, 0xcc, 0xaf, 0x34, 0x38, 0xb6, 0xe2, 0xd0, 0xc9, 0x9f, 0x81, 0x98, 0x28, 0x75, 0x56,
-		0xc0, 0x39, 0x14, 0x85, 0x60, 0xa3, 0x42, 0x7c, 0x00, 0xba, 0x10, 0x55, 0x0b, 0x0d, 0x39, 0x70,
-		0xf6, 0x57, 0x02, 0x10, 0x15, 0xd6, 0x63, 0x01, 0x32, 0x95, 0x72, 0x75, 0x68, 0x31, 0x5d, 0x80,
-		0xb4, 0x28, 0xe4, 0x8b, 0xc9, 0xbb, 0xa2, 0xde, 0xc2, 0x4d, 0xa5, 0xde, 0xac, 0xaf, 0xca, 0x7f,
-		0x32, 0x5a, 0xf8, 0x84, 0xfc, 0x99, 0x28, 0x9a, 0x87, 0xa4, 0x28, 0x5c, 0x7d, 0x7c, 0xf5, 0x09,
-		0xf9, 0xb5, 0xe1, 0xa2, 0x27, 0xe5, 0xcf, 0x46, 0xd1, 0x31, 0x90, 0xdd, 0x96, 0x1b, 0xcd, 0x42,
-		0x71, 0xbd, 0x22, 0x7f, 0x77, 0x8d, 0xac, 0xa4, 0xa2, 0xf8, 0xbc, 0xda, 0x24, 0x0b, 0x65, 0xbd,
-		0xb6, 0x7e, 0x53, 0x96, 0xbc, 0x15, 0xab, 0x9e, 0x8a, 0x00, 0xba, 0x17, 0x4e, 0x88, 0x8a, 0x4b,
-		0x97, 0x2e, 0x5d, 0x7a, 0xca, 0x53, 0x79, 0xe7, 0xbd, 0x91, 0xe1, 0xea, 0xa7, 0x3d, 0xd5, 0x1f,
-		0x18, 0xad, 0xbe, 0xe4, 0xa9, 0xfe, 0x91, 0xf7, 0x46, 0xd0, 0x02, 0x24, 0x44, 0xf5, 0x46, 0xe1,
-		0x79, 0xf9, 0xcb, 0x5f, 0xfe, 0xf2, 0x97, 0xa3, 0x67, 0xb7, 0x40, 0x1e, 0x71, 0x08, 0x17, 0x41,
-		0xf6, 0x79, 0x80, 0x44, 0xea, 0x73, 0x43, 0xa5, 0xd4, 0xc9, 0x93, 0x25, 0xe2, 0x60, 0x79, 0x4a,
-		0x99, 0x43, 0x28, 0x07, 0x8a, 0xdf, 0x02, 0x0b, 0x2d, 0xb3, 0x3b, 0xac, 0x08, 0x45, 0x79, 0xe8,
-		0xd0, 0x93, 0x75, 0x4d, 0x7a, 0xe1, 0x31, 0x0e, 0xb4, 0x6b, 0x76, 0x34, 0x63, 0x77, 0xc5, 0xec,
-		0xef, 0xba, 0x57, 0xa8, 0x48, 0xf0, 0x6a, 0x79, 0x2e, 0x52, 0xf5, 0xb6, 0xff, 0x46, 0x92, 0x3e,
-		0x1c, 0x08, 0x5e, 0xdd, 0x2c, 0xfe, 0x44, 0x20, 0x77, 0x95, 0x21, 0x6e, 0x0a, 0x35, 0x53, 0xf0,
-		0x4e, 0x07, 0xb7, 0x88, 0x2e, 0xc0, 0x9f, 0x3e, 0x02, 0x8b, 0xbb, 0xe6, 0xae, 0x49, 0x29, 0x9d,
-		0x23, 0x7f, 0xf1, 0x3b, 0x58, 0x71, 0xa7, 0x34, 0x37, 0xf5, 0xc2, 0xd6, 0x5a, 0x0d, 0x16, 0x38,
-		0xb0, 0x4a, 0x63, 0x69, 0x76, 0x2e, 0x03, 0x1d, 0x7a, 0x58, 0x30, 0xfb, 0xb3, 0x7f, 0x4c, 0x93,
-		0xa0, 0xca, 0x3c, 0x47, 0x25, 0x75, 0xec, 0xe8, 0xc6, 0x9a, 0x02, 0xc7, 0x7c, 0xf4, 0x58, 0x1e,
-		0x03, 0xf7, 0xa7, 0x50, 0xfc, 0x0d, 0x4e, 0x71, 0xc1, 0x43, 0xb1, 0xc1, 0x51, 0xd7, 0x4a, 0x90,
-		0x3a, 0x0a, 0xad, 0x7f, 0xc5, 0x69, 0x25, 0xb1, 0x97, 0xc8, 0x55, 0xc8, 0x50, 0x22, 0xad, 0x81,
-		0x65, 0x9b, 0x5d, 0x9a, 0x24, 0x3a, 0x9c, 0xcc, 0xbf, 0xfe, 0x63, 0x66, 0xc3, 0xd3, 0x04, 0xad,
-		0xe4, 0x60, 0xad, 0xad, 0x01, 0xcd, 0x09, 0xb4, 0x71, 0xab, 0x33, 0x85, 0xc2, 0x6f, 0x72, 0x46,
-		0x1c, 0xf8, 0xb5, 0x1b, 0x24, 0x44, 0x1c, 0x74, 0x69, 0x0e, 0xc7, 0xcb, 0xc9, 0xf4, 0x63, 0x81,
-		0xd9, 0xdf, 0x79, 0x27, 0x5b, 0x26, 0x16, 0x1c, 0x02, 0x1e, 0x9e, 0x3c, 0xa3, 0xb8, 0x8b, 0x6d,
-		0x1b, 0xf7, 0x2d, 0x55, 0xeb, 0x8c, 0x63, 0xcf, 0x73, 0x92, 0x2a, 0xfb, 0x83, 0x5f, 0xf0, 0x8f,
-		0xe2, 0x55, 0x86, 0x59, 0xe8, 0x74, 0xd6, 0xb6, 0xe0, 0xc4, 0x18, 0xad, 0x98, 0x81, 0xe6, 0x0f,
-		0x71, 0x9a, 0x8b, 0x23, 0x9a, 0x41, 0xc8, 0x6e, 0x82, 0x28, 0x77, 0xc6, 0x72, 0x06, 0x9a, 0xef,
-		0xe7, 0x34, 0x11, 0xc7, 0x15, 0x43, 0x4a, 0x28, 0x5e, 0x87, 0xf9, 0xdb, 0xb8, 0xbf, 0x6d, 0x5a,
-		0xfc, 0xf4, 0xda, 0x0c, 0xe4, 0x7e, 0x98, 0x93, 0xcb, 0x70, 0x44, 0x7a, 0x9c, 0x8d, 0xd0, 0xba,
-		0x04, 0xb1, 0x1d, 0xad, 0x85, 0x67, 0x20, 0x71, 0x87, 0x93, 0x88, 0x12, 0x78, 0x82, 0x5a, 0x80,
-		0xe4, 0xae, 0xc9, 0xd3, 0x78, 0xd3, 0xd1, 0x3f, 0xc0, 0xd1, 0x13, 0x02, 0x87, 0x93, 0xe8, 0x99,
-		0xbd, 0x41, 0x47, 0xb3, 0x67, 0xe1, 0xe0, 0x47, 0x04, 0x09, 0x81, 0xc3, 0x49, 0x1c, 0x41, 0xac,
-		0x1f, 0x14, 0x24, 0x2c, 0x8f, 0x3c, 0x9f, 0x81, 0x84, 0x69, 0x74, 0x0e, 0x4c, 0x63, 0x16, 0x26,
-		0x3e, 0xc4, 0x29, 0x00, 0x47, 0x21, 0x04, 0x2e, 0x43, 0x7c, 0xd6, 0x81, 0xf8, 0xb1, 0x2f, 0x88,
-		0xe9, 0x21, 0x46, 0xe0, 0x2a, 0x64, 0x84, 0x81, 0xd2, 0x4d, 0x63, 0x06, 0x12, 0x1f, 0xe1, 0x24,
-		0xd2, 0x1e, 0x34, 0xde, 0x0d, 0x1b, 0x5b, 0xf6, 0x2e, 0x9e, 0x85, 0xc8, 0x3f, 0x14, 0xdd, 0xe0,
-		0x28, 0x5c, 0x94, 0xdb, 0xd8, 0x68, 0xed, 0xcd, 0x46, 0xe1, 0xa3, 0x42, 0x94, 0x02, 0x87, 0x90,
-		0x28, 0x41, 0xaa, 0xab, 0xf5, 0xad, 0x3d, 0xad, 0x33, 0xd3, 0x70, 0xfc, 0x38, 0xa7, 0x91, 0x74,
-		0x90, 0xb8, 0x44, 0x06, 0xc6, 0x51, 0xc8, 0xfc, 0x84, 0x90, 0x88, 0x07, 0x8d, 0x4f, 0x3d, 0x8b,
-		0x86, 0x5e, 0xea, 0x51, 0xa8, 0xfd, 0xa4, 0x98, 0x7a, 0x0c, 0x77, 0xc3, 0x4b, 0xf1, 0x32, 0xc4,
-		0x2d, 0xfd, 0xe5, 0x99, 0xc8, 0xfc, 0x94, 0x18, 0x69, 0x8a, 0x40, 0x90, 0x6f, 0xc2, 0xc9, 0xb1,
-		0xcb, 0xc4, 0x0c, 0xc4, 0x7e, 0x9a, 0x13, 0x3b, 0x3e, 0x66, 0xa9, 0xe0, 0x26, 0xe1, 0xa8, 0x24,
-		0xff, 0x91, 0x30, 0x09, 0x78, 0x88, 0xd6, 0x26, 0x2c, 0x0e, 0x0c, 0x4b, 0xdb, 0x39, 0x9a, 0xd4,
-		0xfe, 0xb1, 0x90, 0x1a, 0xc3, 0xf5, 0x49, 0xad, 0x09, 0xc7, 0x39, 0xc5, 0xa3, 0x8d, 0xeb, 0xcf,
-		0x08, 0xc3, 0xca, 0xb0, 0xb7, 0xfc, 0xa3, 0xfb, 0x0d, 0x90, 0x73, 0xc4, 0x29, 0x7c, 0x71, 0x4b,
-		0xed, 0x6a, 0xbd, 0x19, 0x28, 0xff, 0x2c, 0xa7, 0x2c, 0x2c, 0xbe, 0xe3, 0xcc, 0x5b, 0x1b, 0x5a,
-		0x8f, 0x10, 0x7f, 0x1e, 0xb2, 0x82, 0xf8, 0xc0, 0xe8, 0xe3, 0x96, 0xb9, 0x6b, 0xe8, 0x2f, 0xe3,
-		0xf6, 0x0c, 0xa4, 0x7f, 0x6e, 0x68, 0xa8, 0xb6, 0x3c, 0xe8, 0x84, 0x72, 0x15, 0x64, 0xc7, 0x57,
-		0x51, 0xf5, 0x2e, 0xdd, 0xed, 0x3c, 0x9c, 0xe2, 0xc7, 0xc4, 0x48, 0x39, 0x78, 0x55, 0x8a, 0xb6,
-		0x56, 0x01, 0x76, 0xa1, 0x66, 0x56, 0x95, 0xfc, 0x38, 0x27, 0x94, 0x72, 0xb1, 0xb8, 0xe1, 0x68,
-		0x99, 0xdd, 0x9e, 0xd6, 0x9f, 0xc5, 0xfe, 0xfd, 0xbc, 0x30, 0x1c, 0x1c, 0x85, 0x1b, 0x0e, 0xe2,
-		0xd1, 0x91, 0xd5, 0x7e, 0x06, 0x0a, 0xbf, 0x20, 0x0c, 0x87, 0xc0, 0xe1, 0x24, 0x84, 0xc3, 0x30,
-		0x03, 0x89, 0x5f, 0x14, 0x24, 0x04, 0x0e, 0x21, 0xf1, 0x16, 0x77, 0xa1, 0xed, 0xe3, 0x5d, 0xdd,
-		0xb2, 0xf9, 0x95, 0xb7, 0xc3, 0x49, 0xfd, 0xd2, 0x17, 0xfc, 0x4e, 0x98, 0xe2, 0x41, 0x25, 0x96,
-		0x88, 0xa7, 0xdd, 0xe9, 0xb6, 0xd2, 0x74, 0xc6, 0x7e, 0x59, 0x58, 0x22, 0x0f, 0x1a, 0xe1, 0xcd,
-		0xe3, 0x21, 0x12, 0xb1, 0xb7, 0x48, 0xdc, 0x3a, 0x03, 0xb9, 0x7f, 0x32, 0xc4, 0x5c, 0x43, 0xe0,
-		0x12, 0x9a, 0x1e, 0xff, 0x67, 0x60, 0xdc, 0xc2, 0x07, 0x33, 0x69, 0xe7, 0x3f, 0x1d, 0xf2, 0x7f,
-		0xb6, 0x18, 0x26, 0xb3, 0x21, 0x99, 0x21, 0x7f, 0x0a, 0x4d, 0xbb, 0x52, 0x9b, 0x7d, 0xc7, 0x17,
-		0x79, 0x7f, 0xfd, 0xee, 0xd4, 0xda, 0x3a, 0x51, 0x72, 0xbf, 0xd3, 0x33, 0x9d, 0xd8, 0x3b, 0xbf,
-		0xe8, 0xe8, 0xb9, 0xcf, 0xe7, 0x59, 0xbb, 0x02, 0x29, 0x9f, 0xc3, 0x33, 0x9d, 0xd4, 0xbb, 0x38,
-		0xa9, 0xa4, 0xd7, 0xdf, 0x59, 0xbb, 0x00, 0x21, 0xe2, 0xbc, 0x4c, 0x47, 0xff, 0x36, 0x8e, 0x4e,
-		0xc1, 0xd7, 0xde, 0x04, 0x31, 0xe1, 0xb4, 0x4c, 0x47, 0xfd, 0x76, 0x8e, 0xea, 0xa0, 0x10, 0x74,
-		0xe1, 0xb0, 0x4c, 0x47, 0xff, 0x7b, 0x02, 0x5d, 0xa0, 0x10, 0xf4, 0xd9, 0x45, 0xf8, 0x6b, 0xef,
-		0x09, 0xf1, 0x45, 0x47, 0xc8, 0xee, 0x32, 0x44, 0xb9, 0xa7, 0x32, 0x1d, 0xfb, 0x3b, 0x78, 0xe3,
-		0x02, 0x63, 0xed, 0x29, 0x08, 0xcf, 0x28, 0xf0, 0xf7, 0x72, 0x54, 0x06, 0xbf, 0x56, 0x82, 0x84,
-		0xc7, 0x3b, 0x99, 0x8e, 0xfe, 0x5d, 0x1c, 0xdd, 0x8b, 0x45, 0x58, 0xe7, 0xde, 0xc9, 0x74, 0x02,
-		0xdf, 0x2d, 0x58, 0xe7, 0x18, 0x44, 0x6c, 0xc2, 0x31, 0x99, 0x8e, 0xfd, 0x3e, 0x21, 0x75, 0x81,
-		0xb2, 0xf6, 0x0c, 0xc4, 0x9d, 0xc5, 0x66, 0x3a, 0xfe, 0xf7, 0x70, 0x7c, 0x17, 0x87, 0x48, 0xc0,
-		0xb3, 0xd8, 0x4d, 0x27, 0xf1, 0xf7, 0x85, 0x04, 0x3c, 0x58, 0x64, 0x1a, 0x0d, 0x3b, 0x30, 0xd3,
-		0x29, 0x7d, 0xaf, 0x98, 0x46, 0x43, 0xfe, 0x0b, 0x19, 0x4d, 0x6a, 0xf3, 0xa7, 0x93, 0xf8, 0x3e,
-		0x31, 0x9a, 0x14, 0x9e, 0xb0, 0x31, 0xec, 0x11, 0x4c, 0xa7, 0xf1, 0x03, 0x82, 0x8d, 0x21, 0x87,
-		0x60, 0x6d, 0x13, 0xd0, 0xa8, 0x37, 0x30, 0x9d, 0xde, 0xab, 0x9c, 0xde, 0xfc, 0x88, 0x33, 0xb0,
-		0xf6, 0x1c, 0x1c, 0x1f, 0xef, 0x09, 0x4c, 0xa7, 0xfa, 0x83, 0x5f, 0x1c, 0x8a, 0xdd, 0xbc, 0x8e,
-		0xc0, 0x5a, 0xd3, 0x5d, 0x52, 0xbc, 0x5e, 0xc0, 0x74, 0xb2, 0x3f, 0xf4, 0x45, 0xbf, 0xe1, 0xf6,
-		0x3a, 0x01, 0x6b, 0x05, 0x00, 0x77, 0x01, 0x9e, 0x4e, 0xeb, 0x87, 0x39, 0x2d, 0x0f, 0x12, 0x99,
-		0x1a, 0x7c, 0xfd, 0x9d, 0x8e, 0x7f, 0x47, 0x4c, 0x0d, 0x8e, 0x41, 0xa6, 0x86, 0x58, 0x7a, 0xa7,
-		0x63, 0x7f, 0x40, 0x4c, 0x0d, 0x81, 0x42, 0x34, 0xdb, 0xb3, 0xba, 0x4d, 0xa7, 0xf0, 0x21, 0xa1,
-		0xd9, 0x1e, 0xac, 0xb5, 0x1a, 0xcc, 0x8f, 0x2c, 0x88, 0xd3, 0x49, 0x7d, 0x98, 0x93, 0x92, 0x87,
-		0xd7, 0x43, 0xef, 0xe2, 0xc5, 0x17, 0xc3, 0xe9, 0xd4, 0x7e, 0x74, 0x68, 0xf1, 0xe2, 0x6b, 0xe1,
-		0xda, 0x65, 0x88, 0x19, 0x83, 0x4e, 0x87, 0x4c, 0x1e, 0x74, 0xf8, 0x95, 0xe7, 0xec, 0x67, 0xbf,
-		0xc4, 0xa5, 0x23, 0x10, 0xd6, 0x2e, 0x40, 0x18, 0x77, 0xb7, 0x71, 0x7b, 0x1a, 0xe6, 0xe7, 0xbe,
-		0x24, 0x0c, 0x26, 0x81, 0x5e, 0x7b, 0x06, 0x80, 0xa5, 0x46, 0xe8, 0x29, 0xff, 0x29, 0xb8, 0x9f,
-		0xff, 0x12, 0xbf, 0x12, 0xe8, 0xa2, 0xb8, 0x04, 0xd8, 0x05, 0xc3, 0xc3, 0x09, 0x7c, 0xc1, 0x4f,
-		0x80, 0x8e, 0xc8, 0x25, 0x88, 0xbe, 0x68, 0x99, 0x86, 0xad, 0xed, 0x4e, 0xc3, 0xfe, 0x53, 0x8e,
-		0x2d, 0xe0, 0x89, 0xc0, 0xba, 0x66, 0x1f, 0xdb, 0xda, 0xae, 0x35, 0x0d, 0xf7, 0xbf, 0x72, 0x5c,
-		0x07, 0x81, 0x20, 0xb7, 0x34, 0xcb, 0x9e, 0xa5, 0xdf, 0x7f, 0x26, 0x90, 0x05, 0x02, 0x61, 0x9a,
-		0xfc, 0x7d, 0x0b, 0x1f, 0x4c, 0xc3, 0xfd, 0x73, 0xc1, 0x34, 0x87, 0x5f, 0x7b, 0x13, 0xc4, 0xc9,
-		0x9f, 0xec, 0xe2, 0xf0, 0x14, 0xe4, 0xbf, 0xe0, 0xc8, 0x2e, 0x06, 0x69, 0xd9, 0xb2, 0xdb, 0xb6,
-		0x3e, 0x5d, 0xd8, 0x7f, 0xc9, 0x47, 0x5a, 0xc0, 0xaf, 0x15, 0x20, 0x61, 0xd9, 0xed, 0xf6, 0x80,
-		0xfb, 0xa7, 0x53, 0xd0, 0xff, 0xea, 0x4b, 0x4e, 0xca, 0xc2, 0xc1, 0x21, 0xa3, 0xfd, 0xd2, 0x2d,
-		0xbb, 0x67, 0xd2, 0x33, 0x61, 0xd3, 0x28, 0x7c, 0x91, 0x53, 0xf0, 0xa0, 0xac, 0x95, 0x20, 0x49,
-		0xfa, 0x22, 0x8e, 0xd6, 0x4c, 0x23, 0xf1, 0xd7, 0x5c, 0x00, 0x3e, 0xa4, 0xe2, 0x37, 0xff, 0xe6,
-		0xa7, 0x4f, 0x4b, 0x9f, 0xfc, 0xf4, 0x69, 0xe9, 0xbf, 0x7c, 0xfa, 0xb4, 0xf4, 0xbe, 0x3f, 0x3a,
-		0x3d, 0xf7, 0xc9, 0x3f, 0x3a, 0x3d, 0xf7, 0x7b, 0x7f, 0x74, 0x7a, 0x6e, 0x7c, 0x96, 0x18, 0xae,
-		0x9a, 0x57, 0x4d, 0x96, 0x1f, 0x7e, 0xe1, 0x81, 0x5d, 0xdd, 0xde, 0x1b, 0x6c, 0xaf, 0xb4, 0xcc,
-		0xee, 0xb9, 0x96, 0x69, 0x75, 0x4d, 0xeb, 0x9c, 0x3f, 0xaf, 0x4b, 0xff, 0x82, 0xff, 0x25, 0x91,
-		0x98, 0xd9, 0x9f, 0xce, 0xd5, 0x8c, 0x83, 0x49, 0x0f, 0x6f, 0x5d, 0x84, 0x60, 0xc1, 0x38, 0x40,
-		0x27, 0x99, 0x81, 0x53, 0x07, 0xfd, 0x0e, 0xbf, 0x6c, 0x1a, 0x25, 0xdf, 0x5b, 0xfd, 0x8e, 0xff,
-		0x92, 0x41, 0x92, 0x5f, 0x32, 0x28, 0x7e, 0x97, 0x74, 0xb4, 0x9e, 0xc4, 0x0a, 0xc6, 0x01, 0xed,
-		0xc8, 0xa6, 0xf4, 0xc2, 0xa3, 0x53, 0xf3, 0xdc, 0xb7, 0x0c, 0xf3, 0x25, 0x83, 0xb0, 0xdd, 0xdb,
-		0x16, 0x39, 0xee, 0xd3, 0xc3, 0x39, 0xee, 0xe7, 0x70, 0xa7, 0xf3, 0x2c, 0x81, 0x6b, 0x12, 0x94,
-		0xed, 0x08, 0x7b, 0x28, 0x01, 0xbe, 0x37, 0x00, 0xa7, 0x47, 0xd2, 0xd9, 0x5c, 0x09, 0x26, 0x09,
-		0x61, 0x0d, 0x62, 0x65, 0xa1, 0x5b, 0x59, 0x88, 0x5a, 0xb8, 0x65, 0x1a, 0x6d, 0x76, 0x5a, 0x3c,
-		0xa8, 0x88, 0x4f, 0x22, 0x08, 0x43, 0x33, 0x4c, 0x8b, 0x5f, 0xde, 0x66, 0x1f, 0xc5, 0xf7, 0x1f,
-		0x51, 0x10, 0x29, 0xd1, 0x92, 0x90, 0xc6, 0xf9, 0x19, 0xa5, 0x21, 0x3a, 0xe1, 0xcb, 0xfc, 0xcf,
-		0x2a, 0x95, 0x1f, 0x08, 0xc0, 0xd2, 0xb0, 0x54, 0xc8, 0xcc, 0xb2, 0x6c, 0xad, 0xdb, 0x9b, 0x24,
-		0x96, 0xcb, 0x10, 0x6f, 0x0a, 0x98, 0x23, 0xcb, 0xe5, 0xce, 0x11, 0xe5, 0x92, 0x76, 0x9a, 0x12,
-		0x82, 0x59, 0x9d, 0x51, 0x30, 0x4e, 0x3f, 0xee, 0x4a, 0x32, 0xef, 0x8f, 0xc3, 0x49, 0x36, 0x9d,
-		0x54, 0x36, 0x95, 0xd8, 0x07, 0x97, 0x49, 0xd2, 0x5b, 0x35, 0x7d, 0x9f, 0x24, 0xff, 0x2c, 0x2c,
-		0x54, 0x89, 0xb5, 0x20, 0x51, 0x90, 0xbb, 0xc3, 0x33, 0xf6, 0x46, 0xfb, 0xb2, 0xcf, 0xe1, 0xe7,
-		0xfb, 0x8e, 0xde, 0xa2, 0xfc, 0x3b, 0x24, 0x90, 0x1b, 0x2d, 0xad, 0xa3, 0xf5, 0xbf, 0x52, 0x52,
-		0xe8, 0x29, 0x00, 0x76, 0x16, 0xd1, 0x79, 0x1f, 0x2b, 0xbd, 0x9a, 0x5d, 0xf1, 0x76, 0x6e, 0x85,
-		0xb5, 0x44, 0x6f, 0x7a, 0xc5, 0x29, 0x2c, 0xf9, 0xf3, 0xec, 0xf3, 0x00, 0x6e, 0x05, 0x3a, 0x05,
-		0x27, 0x1a, 0xa5, 0xc2, 0x7a, 0x41, 0x11, 0xc7, 0x88, 0x1a, 0x9b, 0x95, 0x12, 0x7b, 0x6b, 0x66,
-		0x0e, 0x1d, 0x07, 0xe4, 0xad, 0x74, 0x6e, 0xc7, 0x1c, 0x83, 0x79, 0x6f, 0x39, 0x7b, 0xf8, 0x23,
-		0xb0, 0x76, 0x0d, 0x32, 0xec, 0x49, 0x01, 0x55, 0x6b, 0xb7, 0x71, 0x5b, 0xd5, 0x0d, 0x34, 0xe5,
-		0x4e, 0x7e, 0xf6, 0xb7, 0xfe, 0x43, 0x98, 0x76, 0x2d, 0xc5, 0x10, 0x0b, 0x04, 0xaf, 0x6a, 0x10,
-		0x9f, 0x53, 0xef, 0xf6, 0x3a, 0x98, 0x6e, 0x69, 0xab, 0xba, 0x90, 0xff, 0x74, 0x77, 0x86, 0xd0,
-		0x0b, 0x9e, 0x89, 0x2b, 0x0b, 0x2e, 0xba, 0x33, 0x7a, 0x6b, 0xcf, 0xba, 0xa7, 0x15, 0x1d, 0x06,
-		0xa7, 0x52, 0xfc, 0x04, 0xe7, 0x50, 0x64, 0x33, 0x04, 0x8b, 0xeb, 0x30, 0xaf, 0xb5, 0x5a, 0xb8,
-		0xe7, 0xe3, 0x6f, 0xca, 0x0a, 0x22, 0x7a, 0x2b, 0x73, 0x4c, 0x97, 0xb5, 0xa7, 0x20, 0x62, 0xd1,
-		0x41, 0x99, 0x46, 0x42, 0xb0, 0xc3, 0xc1, 0xd7, 0x2a, 0xe2, 0x74, 0xae, 0xd3, 0xa3, 0x29, 0x04,
-		0xfe, 0x0d, 0x27, 0x90, 0xa4, 0x68, 0xa2, 0x37, 0x06, 0xcc, 0xb3, 0x97, 0x9d, 0xb0, 0xa7, 0x37,
-		0x87, 0x67, 0x51, 0xfe, 0xd9, 0xc7, 0x1e, 0xa7, 0xfb, 0xf9, 0xf7, 0xf9, 0x95, 0x6e, 0xcc, 0x64,
-		0x51, 0x64, 0x4e, 0xdb, 0xed, 0x2f, 0x86, 0xb4, 0x68, 0x8f, 0xf7, 0xfb, 0xf0, 0xc6, 0x7e, 0x85,
-		0x37, 0x76, 0x7a, 0x9c, 0x86, 0x7b, 0x5a, 0x4a, 0x71, 0xaa, 0xac, 0x62, 0xad, 0x08, 0x29, 0xfa,
-		0x0e, 0xa4, 0x23, 0x9c, 0xc3, 0x5b, 0xf9, 0xd5, 0x8f, 0x3d, 0xce, 0x26, 0x1a, 0x41, 0xe2, 0xa2,
-		0x29, 0x56, 0x26, 0x59, 0xbd, 0x17, 0x1e, 0x19, 0x5d, 0xbf, 0xd9, 0x3f, 0x8f, 0x51, 0xea, 0x97,
-		0xbd, 0xac, 0xba, 0xd6, 0x29, 0x04, 0xf3, 0x5a, 0x57, 0x37, 0xcc, 0x73, 0xf4, 0xff, 0xdc, 0x2a,
-		0x85, 0xe9, 0xc7, 0x0c, 0xdb, 0xb6, 0x17, 0x99, 0xb1, 0x98, 0xae, 0xb7, 0x7f, 0xf1, 0x9d, 0x1f,
-		0x09, 0xbb, 0x06, 0x65, 0x6d, 0x63, 0xf4, 0xa4, 0xee, 0x74, 0x1a, 0x7f, 0x29, 0x68, 0x0c, 0x1f,
-		0xbc, 0x5d, 0x7b, 0x23, 0xc4, 0x1c, 0x32, 0xd3, 0x7c, 0x37, 0x41, 0xc4, 0xc1, 0x20, 0x9e, 0x1b,
-		0x53, 0xda, 0x59, 0xfc, 0xf4, 0x2f, 0x0a, 0x7c, 0x66, 0xc3, 0x6a, 0xa4, 0x37, 0x57, 0x21, 0xdd,
-		0x36, 0x0d, 0x5b, 0x35, 0xbb, 0xba, 0x8d, 0xbb, 0x3d, 0x7b, 0xaa, 0xe7, 0xfb, 0xd7, 0x8c, 0x48,
-		0x4c, 0x49, 0x11, 0xbc, 0xba, 0x40, 0x23, 0x9c, 0xb0, 0xc7, 0xa1, 0x66, 0xe1, 0xe4, 0xbf, 0x39,
-		0x9c, 0x50, 0x1c, 0xc2, 0xc9, 0x5d, 0x69, 0x87, 0xd5, 0xbe, 0xc5, 0x97, 0x3b, 0x7b, 0x9f, 0x69,
-		0x81, 0xa3, 0x1d, 0xff, 0x22, 0x04, 0xa7, 0x39, 0xf0, 0xb6, 0x66, 0xe1, 0x73, 0xb7, 0xcf, 0x6f,
-		0x63, 0x5b, 0x3b, 0x7f, 0xae, 0x65, 0xea, 0xc2, 0xd7, 0x59, 0xe0, 0xcb, 0x19, 0xa9, 0x5f, 0xe1,
-		0xf5, 0xb9, 0xb1, 0x07, 0x02, 0x72, 0x93, 0x97, 0xc1, 0xdc, 0xa8, 0x0e, 0xe6, 0x3b, 0x10, 0x2a,
-		0x99, 0x3a, 0xbd, 0x83, 0xda, 0xc6, 0x86, 0xd9, 0xe5, 0x0b, 0x12, 0xfb, 0x40, 0xd7, 0x20, 0xa2,
-		0x75, 0xcd, 0x81, 0x61, 0xb3, 0xc5, 0xa8, 0xf8, 0xf8, 0x6f, 0x7e, 0x6a, 0x69, 0xee, 0xf7, 0x3f,
-		0xb5, 0x74, 0x8c, 0x91, 0xb5, 0xda, 0xb7, 0x56, 0x74, 0xf3, 0x5c, 0x57, 0xb3, 0xf7, 0x88, 0x09,
-		0xf8, 0xdd, 0x8f, 0x3f, 0x06, 0xbc, 0xbd, 0xaa, 0x61, 0x7f, 0xf4, 0x33, 0x3f, 0x73, 0x56, 0x52,
-		0x38, 0xfe, 0x5a, 0xe8, 0xb5, 0x0f, 0x2e, 0x49, 0xf9, 0x1e, 0x44, 0xcb, 0xb8, 0x75, 0x48, 0x83,
-		0xd5, 0xa1, 0x06, 0xcf, 0xf3, 0x06, 0x4f, 0x8d, 0x36, 0xc8, 0xee, 0xb6, 0x95, 0x71, 0xcb, 0xd3,
-		0x6c, 0x19, 0xb7, 0x86, 0x5a, 0xac, 0x42, 0xac, 0x6a, 0xd8, 0xec, 0x41, 0x9a, 0x37, 0x41, 0x50,
-		0x37, 0xd8, 0x3b, 0x06, 0xf1, 0xe2, 0x23, 0x47, 0xe8, 0x8a, 0x42, 0xf0, 0xf2, 0x75, 0x88, 0x95,
-		0x71, 0x8b, 0x91, 0x2a, 0x41, 0xb0, 0x8d, 0x5b, 0x9c, 0xd4, 0x5d, 0x30, 0x49, 0xb0, 0x8b, 0xe5,
-		0xdf, 0xfb, 0xc3, 0xd3, 0x73, 0x6f, 0xff, 0xf4, 0xe9, 0xb9, 0x89, 0xea, 0x94, 0x9f, 0xae, 0x4e,
-		0x8e, 0x16, 0x7d, 0x24, 0x04, 0xf7, 0xd2, 0x27, 0xd2, 0xfa, 0x5d, 0xdd, 0xb0, 0xcf, 0xb5, 0xfa,
-		0x07, 0x3d, 0xdb, 0x24, 0x46, 0xc5, 0xdc, 0xe1, 0x4a, 0x34, 0xef, 0x56, 0xaf, 0xb0, 0xea, 0xf1,
-		0x2a, 0x94, 0xdf, 0x81, 0xf0, 0x26, 0xc1, 0x23, 0x83, 0x64, 0x9b, 0xb6, 0xd6, 0xe1, 0xbe, 0x22,
-		0xfb, 0x20, 0xa5, 0xec, 0x59, 0xb5, 0x00, 0x2b, 0xd5, 0xc5, 0x8b, 0x6a, 0x1d, 0xac, 0xed, 0xb0,
-		0xc7, 0x64, 0x82, 0x34, 0xc8, 0x88, 0x91, 0x02, 0xfa, 0x6e, 0xcc, 0x22, 0x84, 0xb5, 0x01, 0x3b,
-		0xfd, 0x16, 0x24, 0xd1, 0x07, 0xfd, 0xc8, 0x3f, 0x0b, 0x51, 0x7e, 0xea, 0x01, 0xc9, 0x10, 0xbc,
-		0x85, 0x0f, 0x68, 0x3b, 0x49, 0x85, 0xfc, 0x89, 0x56, 0x20, 0x4c, 0x99, 0xe7, 0xf7, 0xf7, 0xb2,
-		0x2b, 0x23, 0xdc, 0xaf, 0x50, 0x26, 0x15, 0x06, 0x96, 0xbf, 0x0e, 0xb1, 0xb2, 0x49, 0x34, 0xdb,
-		0x4f, 0x2d, 0xce, 0xa8, 0x51, 0x9e, 0x7b, 0x03, 0x5b, 0xdc, 0xb1, 0xa6, 0x1f, 0xe8, 0x38, 0x44,
-		0xd8, 0xe3, 0x42, 0xfc, 0x04, 0x1f, 0xff, 0xca, 0x97, 0x20, 0x4a, 0x69, 0xd7, 0x7b, 0xce, 0x13,
-		0x80, 0x92, 0xe7, 0x09, 0x40, 0x4e, 0x3e, 0xe0, 0x32, 0x8b, 0x20, 0xd4, 0xd6, 0x6c, 0x8d, 0xf7,
-		0x9b, 0xfe, 0x9d, 0x7f, 0x33, 0xc4, 0x38, 0x11, 0x0b, 0xad, 0x42, 0xd0, 0xec, 0x89, 0x03, 0xaa,
-		0xb9, 0x49, 0x5d, 0xa9, 0xf7, 0x8a, 0x21, 0xa2, 0x4b, 0x0a, 0x01, 0x2e, 0xd6, 0x26, 0xaa, 0xc5,
-		0x93, 0x3e, 0xb5, 0xe8, 0x62, 0x7b, 0x7b, 0xc7, 0x76, 0xff, 0x60, 0xc3, 0x39, 0xa2, 0x0a, 0x8e,
-		0xa2, 0xdc, 0x09, 0xc0, 0x69, 0x4f, 0xed, 0x6d, 0xdc, 0xb7, 0x74, 0xd3, 0x60, 0xda, 0xc4, 0x35,
-		0x05, 0x79, 0x18, 0xe4, 0xf5, 0x13, 0x54, 0xe5, 0x4d, 0x10, 0x2c, 0xf4, 0x7a, 0x28, 0x07, 0x31,
-		0xfa, 0xdd, 0x32, 0x99, 0xae, 0x84, 0x14, 0xe7, 0x9b, 0xd4, 0x59, 0xe6, 0x8e, 0xfd, 0x92, 0xd6,
-		0x77, 0x1e, 0xf3, 0x13, 0xdf, 0xf9, 0x4b, 0x10, 0x2f, 0x99, 0x86, 0x85, 0x0d, 0x6b, 0x40, 0x23,
-		0x90, 0xed, 0x8e, 0xd9, 0xba, 0xc5, 0x29, 0xb0, 0x0f, 0x22, 0x6c, 0xad, 0xd7, 0xa3, 0x98, 0x21,
-		0x85, 0xfc, 0xc9, 0x66, 0x76, 0xb1, 0x3e, 0x51, 0x3c, 0x17, 0x8e, 0x26, 0x1e, 0xde, 0x41, 0x47,
-		0x3e, 0xff, 0x43, 0x82, 0x7b, 0x46, 0x27, 0xd2, 0x2d, 0x7c, 0x60, 0x1d, 0x75, 0x1e, 0x3d, 0x0f,
-		0xf1, 0x4d, 0xfa, 0x84, 0xf3, 0xb3, 0xf8, 0x00, 0xe5, 0x20, 0x8a, 0xdb, 0xab, 0x17, 0x2e, 0x9c,
-		0xbf, 0xc4, 0xb4, 0xfc, 0xda, 0x9c, 0x22, 0x0a, 0xd0, 0x69, 0x88, 0x5b, 0xb8, 0xd5, 0x5b, 0xbd,
-		0x70, 0xf1, 0xd6, 0x79, 0xa6, 0x56, 0xd7, 0xe6, 0x14, 0xb7, 0x68, 0x2d, 0x46, 0x7a, 0xfc, 0xda,
-		0x87, 0x96, 0xa4, 0x62, 0x18, 0x82, 0xd6, 0xa0, 0xfb, 0x35, 0xd3, 0x8d, 0x3f, 0x8f, 0xc0, 0xb2,
-		0xa7, 0x96, 0x2d, 0x58, 0xfc, 0xa2, 0x91, 0xf3, 0xec, 0xb7, 0xec, 0xe9, 0x3f, 0x85, 0x98, 0xb0,
-		0x12, 0x1d, 0x2a, 0xc5, 0xfc, 0xcf, 0x49, 0x90, 0xbc, 0x21, 0x28, 0x37, 0xb0, 0x8d, 0x2e, 0x03,
-		0x38, 0x2d, 0x89, 0xa9, 0x72, 0x6a, 0x65, 0xb8, 0xad, 0x15, 0x07, 0x47, 0xf1, 0x80, 0xa3, 0xa7,
-		0xa8, 0x02, 0xf6, 0x4c, 0x8b, 0xbf, 0xca, 0x36, 0x05, 0xd5, 0x01, 0x46, 0x8f, 0x02, 0xa2, 0x56,
-		0x4d, 0xbd, 0x6d, 0xda, 0xba, 0xb1, 0xab, 0xf6, 0xcc, 0x97, 0xf8, 0x73, 0x99, 0x41, 0x45, 0xa6,
-		0x35, 0x37, 0x68, 0xc5, 0x26, 0x29, 0x27, 0x4c, 0xc7, 0x1d, 0x2a, 0x24, 0x98, 0xd6, 0xda, 0xed,
-		0x3e, 0xb6, 0x2c, 0x6e, 0xb8, 0xc4, 0x27, 0xba, 0x0c, 0xd1, 0xde, 0x60, 0x5b, 0x15, 0x56, 0x22,
-		0xb1, 0x7a, 0xcf, 0xb8, 0x39, 0x2f, 0x74, 0x83, 0xcf, 0xfa, 0x48, 0x6f, 0xb0, 0x4d, 0x34, 0xe5,
-		0x3e, 0x48, 0x8e, 0x61, 0x26, 0x71, 0xdb, 0xe5, 0x83, 0xbe, 0x1a, 0xce, 0x7b, 0xa0, 0xf6, 0xfa,
-		0xba, 0xd9, 0xd7, 0xed, 0x03, 0x7a, 0x88, 0x35, 0xa8, 0xc8, 0xa2, 0x62, 0x93, 0x97, 0xe7, 0x6f,
-		0x41, 0xa6, 0x41, 0x43, 0x23, 0x97, 0xf3, 0x0b, 0x2e, 0x7f, 0xd2, 0x74, 0xfe, 0x26, 0x72, 0x16,
-		0x18, 0xe1, 0xec, 0xec, 0x7f, 0x94, 0x20, 0x51, 0x24, 0x13, 0xb7, 0x5a, 0xbe, 0xd2, 0xd1, 0x76,
-		0xd1, 0x79, 0x38, 0x56, 0x5c, 0xaf, 0x97, 0x9e, 0x55, 0xab, 0x65, 0xf5, 0xca, 0x7a, 0xc1, 0x73,
-		0x95, 0x23, 0x77, 0xfc, 0x95, 0x3b, 0xcb, 0xc8, 0x03, 0xbb, 0x65, 0xd0, 0x4c, 0x00, 0x3a, 0x07,
-		0x8b, 0x7e, 0x94, 0x42, 0xb1, 0x51, 0xa9, 0x35, 0x65, 0x29, 0x77, 0xec, 0x95, 0x3b, 0xcb, 0xf3,
-		0x1e, 0x8c, 0xc2, 0xb6, 0x85, 0x0d, 0x7b, 0x14, 0xa1, 0x54, 0xdf, 0xd8, 0xa8, 0x36, 0xe5, 0xc0,
-		0x08, 0x42, 0xc9, 0xec, 0x76, 0x75, 0x1b, 0x3d, 0x0c, 0xf3, 0x7e, 0x84, 0x5a, 0x75, 0x5d, 0x0e,
-		0xe6, 0xd0, 0x2b, 0x77, 0x96, 0xd3, 0x1e, 0xe8, 0x9a, 0xde, 0xc9, 0xc5, 0xde, 0xfd, 0xa3, 0xa7,
-		0xe7, 0x3e, 0xfa, 0x0f, 0x4e, 0x4b, 0xc5, 0x8d, 0x89, 0x73, 0xee, 0x89, 0xa3, 0xcd, 0x39, 0xff,
-		0xba, 0xfd, 0xa5, 0x7b, 0x7c, 0xe6, 0x86, 0xfb, 0x88, 0x1e, 0x63, 0x3c, 0xeb, 0x74, 0x9b, 0x96,
-		0x19, 0xca, 0x1d, 0xee, 0x1e, 0xe4, 0xa6, 0x2c, 0x0a, 0xb9, 0xa9, 0x86, 0x21, 0x7f, 0x09, 0x52,
-		0x9b, 0x5a, 0xdf, 0x6e, 0x60, 0xfb, 0x1a, 0xd6, 0xda, 0xb8, 0xef, 0xf7, 0x1f, 0x52, 0xc2, 0x7f,
-		0x40, 0x10, 0xa2, 0x4e, 0x02, 0x5b, 0x3f, 0xe9, 0xdf, 0xf9, 0x3d, 0x08, 0xd1, 0xbb, 0xaa, 0x8e,
-		0x6f, 0xc1, 0x31, 0x98, 0x6f, 0x41, 0x56, 0x86, 0x03, 0x9b, 0xdf, 0xe5, 0x4f, 0x2a, 0xec, 0x03,
-		0x3d, 0x29, 0x3c, 0x84, 0xe0, 0xe1, 0x1e, 0x02, 0x9f, 0x5e, 0xdc, 0x4f, 0xe8, 0x40, 0x94, 0x0f,
-		0xb1, 0xc3, 0x88, 0xe4, 0x32, 0x82, 0x36, 0x20, 0xd3, 0xd3, 0xfa, 0x36, 0x7d, 0xfd, 0x6a, 0x8f,
-		0xf6, 0x82, 0xcf, 0xe0, 0xa5, 0x51, 0x7b, 0xe2, 0xeb, 0x2c, 0x6f, 0x25, 0xd5, 0xf3, 0x16, 0xe6,
-		0xff, 0x24, 0x04, 0x11, 0x2e, 0x8c, 0x37, 0x41, 0x94, 0x8b, 0x95, 0xcf, 0xb9, 0x7b, 0x57, 0x46,
-		0x97, 0xd9, 0x15, 0x67, 0x39, 0xe4, 0xf4, 0x04, 0x0e, 0x7a, 0x10, 0x62, 0xad, 0x3d, 0x4d, 0x37,
-		0x54, 0xbd, 0xcd, 0x9d, 0xe3, 0xc4, 0xa7, 0x3f, 0xb5, 0x14, 0x2d, 0x91, 0xb2, 0x6a, 0x59, 0x89,
-		0xd2, 0xca, 0x6a, 0x9b, 0xf8, 0x34, 0x7b, 0x58, 0xdf, 0xdd, 0xb3, 0xb9, 0xdd, 0xe0, 0x5f, 0xe8,
-		0x69, 0x08, 0x11, 0x85, 0xe0, 0xf7, 0x3c, 0x72, 0x23, 0x51, 0x8f, 0x93, 0xb8, 0x2b, 0xc6, 0x48,
-		0xc3, 0xef, 0xfb, 0xcf, 0x4b, 0x92, 0x42, 0x31, 0x50, 0x09, 0x52, 0x1d, 0xcd, 0xb2, 0x55, 0xba,
-		0x1e, 0x93, 0xe6, 0xd9, 0x05, 0x8f, 0x93, 0xa3, 0x02, 0xe1, 0x82, 0xe5, 0xac, 0x27, 0x08, 0x16,
-		0x2b, 0x6a, 0xa3, 0x33, 0x20, 0x53, 0x22, 0x2d, 0x3a, 0x03, 0x99, 0x97, 0x18, 0xa1, 0x72, 0x4f,
-		0x93, 0x72, 0x36, 0x31, 0xa9, 0xaf, 0x78, 0x0a, 0xe2, 0xf4, 0x89, 0x37, 0x0a, 0xc2, 0x2e, 0x48,
-		0xc7, 0x48, 0x01, 0xad, 0x7c, 0x08, 0x32, 0xae, 0xd5, 0x67, 0x20, 0x31, 0x46, 0xc5, 0x2d, 0xa6,
-		0x80, 0x8f, 0xc3, 0xa2, 0x81, 0xf7, 0x6d, 0x75, 0x18, 0x3a, 0x4e, 0xa1, 0x11, 0xa9, 0xbb, 0xe1,
-		0xc7, 0x78, 0x00, 0xd2, 0x2d, 0x21, 0x7c, 0x06, 0x0b, 0x14, 0x36, 0xe5, 0x94, 0x52, 0xb0, 0x93,
-		0x10, 0xd3, 0x7a, 0x3d, 0x06, 0x90, 0xe0, 0x56, 0xbf, 0xd7, 0xa3, 0x55, 0x67, 0x61, 0x9e, 0xf6,
-		0xb1, 0x8f, 0xad, 0x41, 0xc7, 0xe6, 0x44, 0x92, 0x14, 0x26, 0x43, 0x2a, 0x14, 0x56, 0x4e, 0x61,
-		0xef, 0x87, 0x14, 0xbe, 0xad, 0xb7, 0xb1, 0xd1, 0xc2, 0x0c, 0x2e, 0x45, 0xe1, 0x92, 0xa2, 0x90,
-		0x02, 0x3d, 0x0c, 0x8e, 0x35, 0x57, 0xc5, 0x4a, 0x93, 0x66, 0xf4, 0x44, 0x79, 0x81, 0x15, 0xe7,
-		0xb3, 0x10, 0x2a, 0x6b, 0xb6, 0x46, 0xdc, 0x25, 0x7b, 0x9f, 0x2d, 0x9f, 0x49, 0x85, 0xfc, 0x99,
-		0xff, 0xc5, 0x20, 0x84, 0x6e, 0x98, 0x36, 0x46, 0x4f, 0x78, 0x5c, 0xd9, 0xf4, 0x38, 0x7d, 0x6e,
-		0xe8, 0xbb, 0x06, 0x6e, 0x6f, 0x58, 0xbb, 0x9e, 0x77, 0x9a, 0x5d, 0x75, 0x0a, 0xf8, 0xd4, 0x69,
-		0x11, 0xc2, 0x7d, 0x73, 0x60, 0xb4, 0xc5, 0x75, 0x0a, 0xfa, 0x81, 0x2a, 0x10, 0x73, 0xb4, 0x24,
-		0x34, 0x4d, 0x4b, 0x32, 0x44, 0x4b, 0x88, 0x0e, 0xf3, 0x02, 0x25, 0xba, 0xcd, 0x95, 0xa5, 0x08,
-		0x71, 0xc7, 0x78, 0x71, 0x6d, 0x9b, 0x4d, 0x61, 0x5d, 0x34, 0xb2, 0x44, 0x3a, 0x63, 0xef, 0x08,
-		0x8f, 0x69, 0x9c, 0xec, 0x54, 0x70, 0xe9, 0xf9, 0xd4, 0x8a, 0xbf, 0x19, 0x1d, 0xa5, 0xfd, 0x72,
-		0xd5, 0x8a, 0xbd, 0x1b, 0x7d, 0x0f, 0xc4, 0x2d, 0x7d, 0xd7, 0xa0, 0x37, 0x94, 0xb8, 0xe6, 0xb9,
-		0x05, 0xa4, 0xd6, 0xbd, 0x66, 0xcf, 0x34, 0xcd, 0xf3, 0xf3, 0x06, 0xe7, 0x60, 0xc1, 0xfd, 0x61,
-		0x01, 0x97, 0x0a, 0xd3, 0x32, 0xe4, 0x54, 0x35, 0x44, 0x4d, 0xfe, 0xd7, 0x24, 0x88, 0xf0, 0x15,
-		0xcb, 0x1d, 0x06, 0x69, 0xfc, 0x30, 0x04, 0x26, 0x0d, 0x43, 0xf0, 0xee, 0x87, 0xa1, 0x00, 0xe0,
-		0xb0, 0x69, 0xf1, 0x97, 0x81, 0xc7, 0xb8, 0x55, 0x8c, 0xc5, 0x86, 0xbe, 0xcb, 0xe7, 0xbd, 0x07,
-		0x29, 0xff, 0x07, 0x12, 0xf1, 0xf0, 0x79, 0x3d, 0x2a, 0x40, 0x4a, 0xf0, 0xa5, 0xee, 0x74, 0xb4,
-		0x5d, 0xae, 0x8a, 0xf7, 0x4e, 0x64, 0x8e, 0xac, 0xc2, 0x4a, 0x82, 0xf3, 0x43, 0xfd, 0x89, 0xb1,
-		0xc3, 0x1a, 0x98, 0x30, 0xac, 0x3e, 0x3d, 0x0a, 0xde, 0x9d, 0x1e, 0xf9, 0x46, 0x3c, 0x34, 0x34,
-		0xe2, 0xf9, 0x3f, 0x94, 0xf8, 0x6f, 0x12, 0xb4, 0xd9, 0x75, 0xac, 0xff, 0x53, 0x43, 0xf5, 0x02,
-		0xd7, 0xad, 0x36, 0x6e, 0xab, 0x23, 0x63, 0x76, 0xff, 0x28, 0x45, 0x3f, 0xcf, 0xee, 0xd8, 0x21,
-		0x41, 0xa5, 0xe1, 0x8e, 0xe1, 0xc7, 0x03, 0x30, 0x3f, 0x02, 0xff, 0xb7, 0x6f, 0x2c, 0xfd, 0xb3,
-		0x37, 0x3c, 0xe3, 0xec, 0x8d, 0x4c, 0x9c, 0xbd, 0x1f, 0x0b, 0xd0, 0x04, 0x40, 0xcf, 0xb4, 0xb4,
-		0xce, 0xd7, 0xc3, 0xf6, 0x9e, 0x82, 0x78, 0xcf, 0xec, 0xa8, 0xac, 0x86, 0x5d, 0x68, 0x8b, 0xf5,
-		0xcc, 0x8e, 0x32, 0xa2, 0x66, 0xe1, 0xaf, 0x92, 0x61, 0x8e, 0x7c, 0x15, 0x06, 0x21, 0x3a, 0x3c,
-		0xa1, 0xfa, 0x90, 0x64, 0xa2, 0xe0, 0x5e, 0xd3, 0xe3, 0x44, 0x06, 0xd4, 0x0d, 0x93, 0x46, 0xbd,
-		0x3c, 0xc6, 0x36, 0x83, 0x54, 0x38, 0x1c, 0xc1, 0x60, 0x4e, 0xc6, 0xb8, 0xcc, 0x91, 0xd7, 0x62,
-		0x29, 0x1c, 0x2e, 0xff, 0xfd, 0x12, 0xc0, 0x3a, 0x91, 0x2c, 0xed, 0x2f, 0xf1, 0x77, 0x2c, 0xca,
-		0x82, 0xea, 0x6b, 0xf9, 0xf4, 0xa4, 0x41, 0xe3, 0xed, 0x27, 0x2d, 0x2f, 0xdf, 0x25, 0x48, 0xb9,
-		0xba, 0x6d, 0x61, 0xc1, 0xcc, 0xe9, 0x43, 0xa2, 0xd2, 0x06, 0xb6, 0x95, 0xe4, 0x6d, 0xcf, 0x57,
-		0xfe, 0x9f, 0x4b, 0x10, 0xa7, 0x3c, 0x6d, 0x60, 0x5b, 0xf3, 0x8d, 0xa1, 0x74, 0xf7, 0x63, 0x78,
-		0x2f, 0x00, 0x23, 0x63, 0xe9, 0x2f, 0x63, 0xae, 0x59, 0x71, 0x5a, 0xd2, 0xd0, 0x5f, 0xc6, 0xe8,
-		0xa2, 0x23, 0xf0, 0xe0, 0xe1, 0x02, 0x17, 0x51, 0x2b, 0x17, 0xfb, 0x09, 0x88, 0xd2, 0xe7, 0x59,
-		0xf6, 0x2d, 0x1e, 0x88, 0x46, 0x8c, 0x41, 0xb7, 0xb9, 0x6f, 0xe5, 0x5f, 0x84, 0x68, 0x73, 0x9f,
-		0xe5, 0x13, 0x4f, 0x41, 0xbc, 0x6f, 0x9a, 0xdc, 0xfb, 0x63, 0x5e, 0x77, 0x8c, 0x14, 0x50, 0x67,
-		0x47, 0xe4, 0xd0, 0x02, 0x6e, 0x0e, 0xcd, 0x4d, 0x02, 0x06, 0x67, 0x4a, 0x02, 0x92, 0xe8, 0x33,
-		0xe5, 0x9b, 0x49, 0xe8, 0x51, 0x38, 0xd1, 0xa8, 0x5e, 0xad, 0x55, 0xca, 0xea, 0x46, 0xe3, 0xea,
-		0xd0, 0xb3, 0x09, 0xb9, 0xcc, 0x2b, 0x77, 0x96, 0x13, 0x3c, 0xec, 0x9c, 0x04, 0xbd, 0xa9, 0x54,
-		0x6e, 0xd4, 0x9b, 0x15, 0x59, 0x62, 0xd0, 0x9b, 0x7d, 0x7c, 0xdb, 0xb4, 0xd9, 0x6f, 0x5e, 0x3d,
-		0x0e, 0x27, 0xc7, 0x40, 0x3b, 0xc1, 0xe7, 0xfc, 0x2b, 0x77, 0x96, 0x53, 0x9b, 0x7d, 0xcc, 0xb4,
-		0x8c, 0x62, 0xac, 0x40, 0x76, 0x14, 0xa3, 0xbe, 0x59, 0x6f, 0x14, 0xd6, 0xe5, 0xe5, 0x9c, 0xfc,
-		0xca, 0x9d, 0xe5, 0xa4, 0x30, 0x19, 0x04, 0xfe, 0xeb, 0x10, 0x7d, 0xc6, 0x7c, 0x59, 0x63, 0x16,
-		0xd7, 0xf5, 0xb4, 0xbe, 0xd6, 0x3d, 0x6a, 0xf8, 0x39, 0xe5, 0xb8, 0x46, 0xfe, 0xaf, 0x02, 0x90,
-		0x71, 0x82, 0x9b, 0x4d, 0xda, 0x02, 0x7a, 0xc2, 0x9b, 0xf1, 0x4b, 0x4c, 0x5c, 0x3b, 0x18, 0xb4,
-		0x48, 0x08, 0xbe, 0x11, 0x62, 0xc2, 0x49, 0xe6, 0x93, 0x6a, 0x79, 0xcc, 0xfa, 0xc6, 0x21, 0x38,
-		0xaa, 0x83, 0x81, 0x9e, 0x81, 0xb8, 0x33, 0xc5, 0x9c, 0x67, 0xfa, 0x27, 0xcf, 0x49, 0x8e, 0xef,
-		0xe2, 0xa0, 0x4b, 0x6e, 0x18, 0x17, 0x9a, 0x14, 0x18, 0xde, 0x60, 0x00, 0x1c, 0xd9, 0x09, 0xe1,
-		0x1e, 0x87, 0x90, 0xb6, 0xdd, 0xd2, 0xb9, 0x01, 0xbe, 0x67, 0x14, 0xaf, 0x50, 0x2c, 0x55, 0x39,
-		0x12, 0x85, 0x24, 0xdc, 0x6a, 0x03, 0x7b, 0x8f, 0xe5, 0x77, 0x22, 0x93, 0xb8, 0x2d, 0x08, 0x10,
-		0xc1, 0xad, 0x83, 0x93, 0xaf, 0xf2, 0x6c, 0x0c, 0x17, 0x38, 0x7d, 0x2b, 0x79, 0x5f, 0x65, 0xc1,
-		0x34, 0x73, 0x4f, 0x62, 0x5d, 0x6d, 0xbf, 0x48, 0xe3, 0xe9, 0x13, 0x10, 0x25, 0x95, 0xbb, 0xfc,
-		0xc1, 0xc2, 0xa0, 0x12, 0xe9, 0x6a, 0xfb, 0x57, 0x35, 0xeb, 0x7a, 0x28, 0x16, 0x94, 0x43, 0xf9,
-		0x1f, 0x27, 0xae, 0x8e, 0x4f, 0xac, 0xe8, 0x11, 0x40, 0x04, 0x43, 0xdb, 0xc5, 0x2a, 0x99, 0xfb,
-		0x74, 0x7c, 0x04, 0xdd, 0x4c, 0x57, 0xdb, 0x2f, 0xec, 0xe2, 0xda, 0xa0, 0x4b, 0x19, 0xb0, 0xd0,
-		0x06, 0xc8, 0x02, 0x58, 0xa8, 0x06, 0x1f, 0xbf, 0x93, 0xa3, 0x3f, 0x3f, 0xc5, 0x01, 0xd8, 0x0a,
-		0xf2, 0x2a, 0x59, 0x41, 0xd2, 0x8c, 0x9e, 0x73, 0xc2, 0xc7, 0xd7, 0x95, 0xa0, 0xbf, 0x2b, 0xf9,
-		0x67, 0x20, 0x33, 0x34, 0x84, 0x28, 0x0f, 0x29, 0x9e, 0xf2, 0xa2, 0xa7, 0x27, 0x58, 0x88, 0x14,
-		0x57, 0x12, 0x2c, 0xb5, 0x45, 0x4f, 0x93, 0xac, 0xc5, 0x7e, 0xe9, 0x83, 0x4b, 0x12, 0xdd, 0x37,
-		0x7a, 0x04, 0x52, 0xbe, 0x41, 0x14, 0x69, 0x68, 0xc9, 0x4d, 0x43, 0xbb, 0xc0, 0x2f, 0x40, 0x92,
-		0x58, 0x30, 0xdc, 0xe6, 0xb0, 0x0f, 0x42, 0x86, 0x59, 0xd8, 0x61, 0x59, 0x33, 0x8f, 0x69, 0x43,
-		0x08, 0x3c, 0x2f, 0x5c, 0x28, 0xbf, 0xd8, 0x13, 0x02, 0xea, 0xaa, 0x66, 0xe5, 0xeb, 0x00, 0xae,
-		0x56, 0xa0, 0x02, 0xdc, 0x4b, 0xac, 0x8f, 0xf7, 0xb4, 0x2f, 0x7f, 0x0c, 0xde, 0xe7, 0x72, 0xe6,
-		0x08, 0x90, 0x7b, 0xa2, 0x97, 0x3d, 0x09, 0x7f, 0x8d, 0x42, 0xe4, 0xcf, 0x41, 0x66, 0x48, 0x5f,
-		0xc8, 0x8a, 0xec, 0x6a, 0x19, 0xdb, 0xe8, 0x70, 0x0b, 0x8a, 0x6f, 0xf9, 0xe8, 0xa7, 0x4f, 0x4b,
-		0x5f, 0x1b, 0xeb, 0xf3, 0x9f, 0x6e, 0xc2, 0x29, 0x4f, 0x25, 0x51, 0x79, 0x5f, 0xea, 0x2b, 0xe3,
-		0x51, 0x75, 0x52, 0x39, 0x2d, 0x85, 0x75, 0x68, 0xc6, 0x39, 0x77, 0xb8, 0xa1, 0x9b, 0x9e, 0xdf,
-		0x9a, 0x9e, 0x61, 0x1b, 0xbf, 0x0d, 0xf0, 0xab, 0x71, 0x88, 0x2a, 0xf8, 0x6d, 0x03, 0x6c, 0xd9,
-		0x68, 0x15, 0x42, 0xb8, 0xb5, 0x67, 0x8e, 0xcb, 0xba, 0x92, 0x0e, 0xae, 0x70, 0xb8, 0x4a, 0x6b,
-		0xcf, 0xbc, 0x36, 0xa7, 0x50, 0x58, 0x74, 0x01, 0xc2, 0x3b, 0x9d, 0x01, 0x4f, 0x98, 0x0d, 0x59,
-		0x49, 0x2f, 0xd2, 0x15, 0x02, 0x74, 0x6d, 0x4e, 0x61, 0xd0, 0xa4, 0x29, 0xfa, 0x13, 0x84, 0xc1,
-		0xc3, 0x9b, 0xaa, 0x1a, 0x3b, 0xb4, 0x29, 0x02, 0x8b, 0x8a, 0x00, 0xba, 0xa1, 0xdb, 0x2a, 0x4d,
-		0x26, 0x71, 0x3b, 0x75, 0xdf, 0x64, 0x4c, 0xdd, 0xa6, 0xe9, 0xa7, 0x6b, 0x73, 0x4a, 0x5c, 0x17,
-		0x1f, 0x84, 0xdd, 0xb7, 0x0d, 0x70, 0x5f, 0xd8, 0xab, 0x89, 0xec, 0xbe, 0x85, 0x00, 0x11, 0x76,
-		0x29, 0x34, 0x31, 0xeb, 0xec, 0xe7, 0x24, 0xec, 0x7d, 0xfe, 0xab, 0x4b, 0x4b, 0x93, 0x30, 0xe9,
-		0x6f, 0x4a, 0x34, 0xf7, 0xaf, 0xcd, 0x29, 0xd1, 0x16, 0xfb, 0x13, 0x3d, 0xed, 0x38, 0x7d, 0x89,
-		0x51, 0x3f, 0xcb, 0x87, 0xcb, 0x12, 0x4d, 0x73, 0xc2, 0xf9, 0x43, 0x35, 0x48, 0x77, 0x74, 0xcb,
-		0x56, 0x2d, 0x43, 0xeb, 0x59, 0x7b, 0xa6, 0x2d, 0xde, 0x73, 0x7f, 0x60, 0x12, 0x85, 0x75, 0xdd,
-		0xb2, 0x1b, 0x02, 0xf8, 0xda, 0x9c, 0x92, 0xea, 0x78, 0x0b, 0x08, 0x3d, 0x73, 0x67, 0x07, 0xf7,
-		0x1d, 0x82, 0x34, 0xb3, 0x73, 0x08, 0xbd, 0x3a, 0x81, 0x16, 0xf8, 0x84, 0x9e, 0xe9, 0x2d, 0x40,
-		0xdf, 0x00, 0x0b, 0x1d, 0x53, 0x6b, 0x3b, 0xe4, 0xd4, 0xd6, 0xde, 0xc0, 0xb8, 0x45, 0xd3, 0x40,
-		0x89, 0xd5, 0x87, 0x27, 0x32, 0x69, 0x6a, 0x6d, 0x41, 0xa2, 0x44, 0x10, 0xae, 0xcd, 0x29, 0xf3,
-		0x9d, 0xe1, 0x42, 0xf4, 0x56, 0x58, 0xd4, 0x7a, 0xbd, 0xce, 0xc1, 0x30, 0xf5, 0x0c, 0xa5, 0x7e,
-		0x76, 0x12, 0xf5, 0x02, 0xc1, 0x19, 0x26, 0x8f, 0xb4, 0x91, 0x52, 0xd4, 0x04, 0xb9, 0xd7, 0xc7,
-		0xf4, 0x86, 0x5d, 0x8f, 0xfb, 0x35, 0xf4, 0xa1, 0xf4, 0xc4, 0xea, 0x43, 0x93, 0x68, 0x6f, 0x32,
-		0x78, 0xe1, 0x06, 0x5d, 0x9b, 0x53, 0x32, 0x3d, 0x7f, 0x11, 0xa3, 0x6a, 0xb6, 0x30, 0xfd, 0x65,
-		0x09, 0x4e, 0x75, 0x7e, 0x1a, 0x55, 0x0a, 0xef, 0xa7, 0xea, 0x2b, 0x42, 0x15, 0x48, 0xb0, 0xe0,
-		0x57, 0x25, 0xd6, 0x93, 0xfe, 0x62, 0x47, 0x62, 0x35, 0x3f, 0x71, 0x86, 0x52, 0xd0, 0x1b, 0xa6,
-		0x8d, 0xaf, 0xcd, 0x29, 0x80, 0x9d, 0x2f, 0xa4, 0xc1, 0x31, 0xfa, 0xda, 0xfc, 0x81, 0xea, 0xb7,
-		0xd4, 0xd9, 0x05, 0x4a, 0xf0, 0x91, 0x49, 0x04, 0xe9, 0xcf, 0xc2, 0x1d, 0xdc, 0xf0, 0x1a, 0xee,
-		0x6b, 0x73, 0xca, 0xc2, 0xed, 0xd1, 0x62, 0xa2, 0x62, 0x3b, 0xba, 0xa1, 0x75, 0xf4, 0x97, 0x31,
-		0x5b, 0x73, 0xe9, 0x8f, 0xb7, 0x1c, 0xa2, 0x62, 0x57, 0x38, 0x34, 0x5d, 0x89, 0x89, 0x8a, 0xed,
-		0x78, 0x0b, 0x8a, 0x51, 0x7e, 0x36, 0xd8, 0x79, 0x81, 0x39, 0x2a, 0xc7, 0xd8, 0x6f, 0x05, 0x5c,
-		0x0f, 0xc5, 0x40, 0x4e, 0xe4, 0x1f, 0x82, 0x84, 0xc7, 0x30, 0xa1, 0x2c, 0x44, 0xf9, 0xd9, 0x20,
-		0x71, 0xca, 0x98, 0x7f, 0xe6, 0xd3, 0x90, 0xf4, 0x1a, 0xa3, 0xfc, 0xfb, 0x24, 0x07, 0x93, 0xbe,
-		0x0d, 0x93, 0xf5, 0xe7, 0xc0, 0xe3, 0xae, 0x6f, 0x74, 0xbf, 0x58, 0x0b, 0x45, 0x3d, 0xdb, 0xf0,
-		0x4d, 0xd2, 0x42, 0xbe, 0x14, 0xa3, 0x25, 0x48, 0xf4, 0x56, 0x7b, 0x0e, 0x48, 0x90, 0x82, 0x40,
-		0x6f, 0xb5, 0x27, 0x00, 0xee, 0x83, 0x24, 0xe9, 0xb7, 0xea, 0xf5, 0xd0, 0xe2, 0x4a, 0x82, 0x94,
-		0x71, 0x90, 0xfc, 0x6f, 0x07, 0x40, 0x1e, 0x36, 0x60, 0x4e, 0x72, 0x5c, 0x3a, 0x72, 0x72, 0xfc,
-		0xe4, 0x70, 0x5a, 0xde, 0xcd, 0xc4, 0xaf, 0x83, 0xec, 0x26, 0x94, 0xd9, 0x52, 0x33, 0xd9, 0xe3,
-		0x1c, 0x72, 0x8d, 0x95, 0x4c, 0x6b, 0xc8, 0x57, 0xbe, 0xe2, 0xdb, 0x1e, 0x15, 0xbf, 0xd1, 0x3b,
-		0x3c, 0xe0, 0x8e, 0xd7, 0xb3, 0xd5, 0x6b, 0x6b, 0x36, 0x16, 0x19, 0x39, 0xcf, 0x4e, 0xe9, 0x83,
-		0x90, 0xd1, 0x7a, 0x3d, 0xd5, 0xb2, 0x35, 0x1b, 0x73, 0xe7, 0x84, 0xe5, 0x3a, 0x52, 0x5a, 0xaf,
-		0x47, 0x7f, 0x8f, 0x90, 0x39, 0x27, 0x0f, 0x40, 0x9a, 0xd8, 0x74, 0x5d, 0xeb, 0x08, 0xdf, 0x22,
-		0xc2, 0x7c, 0x18, 0x5e, 0xca, 0xdd, 0x89, 0xb6, 0x33, 0xe2, 0xd4, 0x9e, 0x3b, 0x51, 0x9c, 0xe4,
-		0x89, 0xe2, 0x10, 0x7f, 0xb3, 0x87, 0xc9, 0x47, 0x3c, 0xaf, 0x34, 0x7e, 0x9b, 0x62, 0x91, 0x46,
-		0x7c, 0xb7, 0x59, 0x7a, 0x26, 0xa6, 0xb0, 0x8f, 0xbc, 0x02, 0x69, 0xbf, 0xed, 0x47, 0x69, 0x08,
-		0xd8, 0xfb, 0xbc, 0x95, 0x80, 0xbd, 0x4f, 0x7c, 0x6b, 0xcf, 0xcb, 0xa0, 0xa3, 0xab, 0x1d, 0xc7,
-		0x73, 0xb3, 0x2b, 0xf9, 0x0c, 0xa4, 0x7c, 0x6b, 0x42, 0xfe, 0x38, 0x2c, 0x8e, 0x33, 0xf1, 0xf9,
-		0x3d, 0xa7, 0xdc, 0x67, 0xaa, 0xd1, 0x05, 0x88, 0x39, 0x36, 0x7e, 0x4c, 0x3c, 0x4e, 0x9b, 0x15,
-		0xc0, 0x8a, 0x03, 0xea, 0xdb, 0x40, 0x08, 0xf8, 0x36, 0x10, 0xf2, 0xdf, 0x0c, 0xd9, 0x49, 0xf6,
-		0x7b, 0x28, 0xad, 0x18, 0x72, 0x04, 0x76, 0x1c, 0x22, 0xfc, 0x21, 0xcc, 0x00, 0xdd, 0x32, 0xe3,
-		0x5f, 0x44, 0x90, 0xcc, 0x96, 0x07, 0xd9, 0x4e, 0x1a, 0xfd, 0xc8, 0xab, 0x70, 0x72, 0xa2, 0x0d,
-		0x9f, 0xbc, 0xf9, 0xc6, 0x08, 0xf1, 0xcd, 0xb7, 0x96, 0x60, 0xc7, 0xa2, 0x7d, 0x15, 0x47, 0x67,
-		0xd8, 0x57, 0xfe, 0xd5, 0x20, 0x1c, 0x1f, 0x6f, 0xc9, 0xd1, 0x32, 0x24, 0x89, 0xa3, 0x6b, 0xfb,
-		0x7d, 0x62, 0xe8, 0x6a, 0xfb, 0x4d, 0xee, 0x10, 0xf3, 0xcd, 0x8b, 0x80, 0xb3, 0x79, 0x81, 0xb6,
-		0x60, 0xbe, 0x63, 0xb6, 0xb4, 0x8e, 0xea, 0xd9, 0x3c, 0xe2, 0x93, 0xe8, 0xfe, 0x11, 0x61, 0xfb,
-		0x93, 0x94, 0xc4, 0xe0, 0x70, 0xfd, 0xcf, 0x50, 0x1a, 0xeb, 0xce, 0x3e, 0x13, 0x2a, 0x43, 0xa2,
-		0xab, 0x5b, 0xdb, 0x78, 0x4f, 0xbb, 0xad, 0x9b, 0x7d, 0x3e, 0x9b, 0x46, 0x95, 0x66, 0xc3, 0x85,
-		0x11, 0x7b, 0x5a, 0x1e, 0x34, 0xcf, 0x90, 0x84, 0xc7, 0x6e, 0xb5, 0x45, 0x8e, 0x6c, 0x4d, 0x26,
-		0xed, 0x5a, 0x45, 0x27, 0xee, 0x5a, 0x8d, 0xdb, 0x22, 0x8a, 0x8d, 0xdf, 0x22, 0x7a, 0xb7, 0x77,
-		0x68, 0xfc, 0x6b, 0xdf, 0xc8, 0xae, 0x11, 0x6a, 0xc0, 0x22, 0xc7, 0x6f, 0xfb, 0x64, 0x3f, 0xe6,
-		0x70, 0x05, 0x9b, 0x5f, 0xc3, 0x32, 0x47, 0x02, 0x7d, 0xb2, 0xd8, 0x83, 0x77, 0x27, 0x76, 0xb1,
-		0x6d, 0x1b, 0xf2, 0x6c, 0xdb, 0xfe, 0x5f, 0x36, 0x14, 0xef, 0x0c, 0xc2, 0xfc, 0x88, 0x23, 0x31,
-		0x76, 0x3f, 0x7a, 0x52, 0x0e, 0x58, 0x74, 0x2c, 0x78, 0xe4, 0x8e, 0xf1, 0xb1, 0x0e, 0x4d, 0x1f,
-		0xeb, 0xf0, 0x57, 0x71, 0xac, 0x23, 0x77, 0x37, 0xd6, 0x5f, 0xd3, 0x51, 0xf8, 0x61, 0x09, 0x72,
-		0x93, 0xbd, 0xaf, 0xb1, 0xc3, 0x71, 0xa4, 0x2d, 0x8b, 0x49, 0x6b, 0xdc, 0x03, 0x90, 0x1e, 0xf2,
-		0x0d, 0x99, 0x2a, 0xa7, 0x7c, 0x61, 0x7b, 0xfe, 0x5d, 0x41, 0x67, 0xe1, 0xf1, 0x39, 0x70, 0x63,
-		0x66, 0xeb, 0x5b, 0x60, 0xa1, 0x8d, 0x5b, 0x7a, 0xfb, 0x6e, 0x27, 0xeb, 0x3c, 0xc7, 0xfe, 0x7f,
-		0x73, 0x75, 0x54, 0x4b, 0xbe, 0x0f, 0x20, 0xa6, 0x60, 0xab, 0x47, 0xfc, 0x31, 0x54, 0x84, 0x38,
-		0xde, 0x6f, 0xe1, 0x9e, 0xed, 0x1e, 0xe3, 0x18, 0x17, 0x22, 0x30, 0xe8, 0x8a, 0x80, 0x24, 0x01,
-		0xb2, 0x83, 0x86, 0x9e, 0xe0, 0x39, 0x80, 0xc9, 0xe1, 0x3c, 0x47, 0xf7, 0x26, 0x01, 0x2e, 0x8a,
-		0x24, 0x40, 0x70, 0x62, 0x7c, 0xcb, 0xb0, 0x86, 0xb2, 0x00, 0x4f, 0xf0, 0x2c, 0x40, 0x68, 0x4a,
-		0x63, 0xbe, 0x34, 0x40, 0xc9, 0x97, 0x06, 0x88, 0x4c, 0xe9, 0xe6, 0x84, 0x3c, 0xc0, 0x45, 0x91,
-		0x07, 0x88, 0x4e, 0xe1, 0x78, 0x28, 0x11, 0xf0, 0x26, 0x4f, 0x22, 0x20, 0x3e, 0x9a, 0xdf, 0xf5,
-		0xa1, 0x8e, 0xc9, 0x04, 0x5c, 0x72, 0x32, 0x01, 0xc9, 0x89, 0x59, 0x04, 0x8e, 0x3c, 0x9c, 0x0a,
-		0xa8, 0x8f, 0xa4, 0x02, 0x52, 0xfc, 0xc7, 0xe2, 0x27, 0x91, 0x98, 0x92, 0x0b, 0xa8, 0x8f, 0xe4,
-		0x02, 0xd2, 0x53, 0x08, 0x4e, 0x49, 0x06, 0x7c, 0xe3, 0xf8, 0x64, 0xc0, 0xe4, 0x70, 0x9d, 0xb3,
-		0x39, 0x5b, 0x36, 0x40, 0x9d, 0x90, 0x0d, 0x90, 0x27, 0x46, 0xae, 0x8c, 0xfc, 0xcc, 0xe9, 0x80,
-		0xad, 0x31, 0xe9, 0x00, 0x16, 0xb8, 0x9f, 0x99, 0x48, 0x7c, 0x86, 0x7c, 0xc0, 0xd6, 0x98, 0x7c,
-		0x00, 0x9a, 0x4a, 0x76, 0x6a, 0x42, 0xe0, 0x8a, 0x3f, 0x21, 0xb0, 0x30, 0xc1, 0xeb, 0x74, 0x67,
-		0xfb, 0x84, 0x8c, 0xc0, 0xf6, 0xa4, 0x8c, 0xc0, 0x22, 0xff, 0xbd, 0x98, 0x49, 0x14, 0x8f, 0x90,
-		0x12, 0xa8, 0x8f, 0xa4, 0x04, 0x8e, 0x4d, 0xd1, 0xb4, 0xd9, 0x73, 0x02, 0xec, 0x57, 0x04, 0xd9,
-		0xef, 0x07, 0x82, 0x9c, 0xb8, 0x1e, 0x8a, 0x25, 0xe4, 0x64, 0xfe, 0x61, 0xe2, 0xc1, 0x0c, 0xd9,
-		0x39, 0x12, 0x2b, 0xe0, 0x7e, 0xdf, 0xec, 0x8b, 0x5b, 0x1d, 0xf4, 0x23, 0x7f, 0x86, 0xc4, 0x88,
-		0xae, 0x4d, 0x3b, 0x24, 0x7f, 0x40, 0x63, 0x32, 0x8f, 0x1d, 0xcb, 0xff, 0x92, 0xe4, 0xe2, 0xd2,
-		0x0c, 0x82, 0x37, 0xbe, 0x8c, 0xf3, 0xf8, 0xd2, 0x93, 0x55, 0x08, 0xf8, 0xb3, 0x0a, 0x4b, 0x90,
-		0x20, 0xb1, 0xd6, 0x50, 0xc2, 0x40, 0xeb, 0x39, 0x09, 0x03, 0x71, 0x64, 0x8b, 0xe5, 0x1e, 0xf8,
-		0xb2, 0xc4, 0xf6, 0x2f, 0x33, 0xce, 0xf1, 0x35, 0x16, 0xea, 0xa2, 0xc7, 0x60, 0xc1, 0x03, 0xeb,
-		0xc4, 0x70, 0x2c, 0x7a, 0x96, 0x1d, 0xe8, 0x02, 0x0f, 0xe6, 0xfe, 0xa5, 0xe4, 0x4a, 0xc8, 0xcd,
-		0x34, 0x8c, 0x4b, 0x0a, 0x48, 0x5f, 0xa5, 0xa4, 0x40, 0xe0, 0xae, 0x93, 0x02, 0xde, 0x98, 0x34,
-		0xe8, 0x8f, 0x49, 0xff, 0xbb, 0xe4, 0x8e, 0x89, 0x13, 0xe2, 0xb7, 0xcc, 0x36, 0xe6, 0x51, 0x22,
-		0xfd, 0x9b, 0xb8, 0x24, 0x1d, 0x53, 0xfc, 0xf0, 0x1b, 0xf9, 0x93, 0x40, 0x39, 0x0b, 0x4f, 0x9c,
-		0xaf, 0x2b, 0x4e, 0x80, 0x19, 0xf6, 0xde, 0x1c, 0xe1, 0xd7, 0x29, 0x22, 0xee, 0x75, 0x0a, 0xe7,
-		0xb2, 0x7a, 0xd4, 0x73, 0x59, 0x1d, 0x3d, 0x0d, 0x71, 0x9a, 0xec, 0x57, 0xcd, 0x9e, 0xf8, 0x61,
-		0xfe, 0x53, 0x93, 0xaf, 0x52, 0x58, 0xf4, 0x90, 0x37, 0xbb, 0x7e, 0xe1, 0x7a, 0x1c, 0x71, 0x9f,
-		0xc7, 0x71, 0x0f, 0xc4, 0x09, 0xf7, 0xec, 0x87, 0x64, 0x81, 0xbf, 0x74, 0x20, 0x0a, 0xf2, 0x3f,
-		0x11, 0x80, 0xcc, 0xd0, 0x42, 0x33, 0xb6, 0xef, 0xe3, 0x36, 0xae, 0x67, 0x93, 0xc7, 0x69, 0x80,
-		0x5d, 0xcd, 0x52, 0x5f, 0xd2, 0x0c, 0x1b, 0xb7, 0xb9, 0x50, 0x3c, 0x25, 0x28, 0x07, 0x31, 0xf2,
-		0x35, 0xb0, 0x70, 0x9b, 0x67, 0x5f, 0x9c, 0x6f, 0x74, 0x0d, 0x22, 0xf8, 0x36, 0x7d, 0x1c, 0x9a,
-		0xfd, 0xee, 0xcf, 0xf1, 0xd1, 0x70, 0x98, 0x54, 0x17, 0xb3, 0x64, 0xb0, 0x3f, 0xf7, 0xa9, 0x25,
-		0x99, 0x41, 0x3f, 0xea, 0x5c, 0x9f, 0x53, 0x38, 0xbe, 0x5f, 0x0a, 0xb1, 0x21, 0x29, 0xd0, 0x3c,
-		0x60, 0x52, 0x84, 0xf7, 0x44, 0xa6, 0xec, 0x54, 0xb9, 0x92, 0xea, 0xe2, 0x6e, 0xcf, 0x34, 0x3b,
-		0x2a, 0x9b, 0xe3, 0x05, 0x48, 0xfb, 0xd7, 0x55, 0xf6, 0xe3, 0xef, 0xb6, 0xa6, 0x1b, 0xaa, 0xcf,
-		0x09, 0x4e, 0xb2, 0x42, 0x36, 0xa7, 0xbc, 0x2f, 0x7f, 0xe7, 0x37, 0xe1, 0xd8, 0xd8, 0x75, 0x15,
-		0x3d, 0x05, 0x71, 0x77, 0x49, 0x66, 0x17, 0x03, 0x0e, 0xc9, 0xb4, 0xb8, 0xb0, 0xf9, 0x5f, 0x91,
-		0x5c, 0x92, 0xfe, 0xdc, 0x4d, 0x05, 0x22, 0xec, 0x94, 0x26, 0x3f, 0x91, 0xf3, 0xd8, 0x6c, 0x2b,
-		0xf2, 0x0a, 0x3b, 0xc2, 0xa9, 0x70, 0xe4, 0xfc, 0x5b, 0x21, 0xc2, 0x4a, 0x50, 0x02, 0xa2, 0xbe,
-		0x5f, 0x3c, 0x28, 0x94, 0x4a, 0x95, 0x4d, 0xf1, 0xb8, 0x73, 0x91, 0x3e, 0x22, 0x4c, 0x8a, 0x95,
-		0xca, 0xf5, 0x4a, 0xa9, 0x29, 0x07, 0xd1, 0x3c, 0xa4, 0xd8, 0xdf, 0xfc, 0x77, 0x09, 0xe4, 0x90,
-		0xa7, 0xa8, 0x51, 0xa9, 0x95, 0x2b, 0x8a, 0x1c, 0xce, 0x9f, 0x87, 0x93, 0x13, 0xd7, 0x70, 0x37,
-		0x31, 0x23, 0x79, 0x12, 0x33, 0xf9, 0x57, 0x03, 0x24, 0xa8, 0x99, 0xb4, 0x30, 0xa3, 0xeb, 0x43,
-		0x1d, 0x5f, 0x3d, 0xc2, 0xaa, 0x3e, 0xd4, 0x7b, 0x12, 0xc7, 0xf4, 0xf1, 0x0e, 0xb6, 0x5b, 0x7b,
-		0xcc, 0x51, 0x60, 0x16, 0x28, 0xa5, 0xa4, 0x78, 0x29, 0x45, 0xb2, 0x18, 0xd8, 0x8b, 0xb8, 0x65,
-		0xab, 0x4c, 0x89, 0x2c, 0x1a, 0x4c, 0xc4, 0x09, 0x18, 0x29, 0x6d, 0xb0, 0xc2, 0xfc, 0x37, 0x1f,
-		0x49, 0x96, 0x71, 0x08, 0x2b, 0x95, 0xa6, 0x72, 0x53, 0x0e, 0x22, 0x04, 0x69, 0xfa, 0xa7, 0xda,
-		0xa8, 0x15, 0x36, 0x1b, 0xd7, 0xea, 0x44, 0x96, 0x0b, 0x90, 0x11, 0xb2, 0x14, 0x85, 0xe1, 0xfc,
-		0x23, 0x70, 0x62, 0x82, 0x57, 0x31, 0xe6, 0xd8, 0xec, 0x87, 0x24, 0x2f, 0xb4, 0xdf, 0x33, 0xa8,
-		0x43, 0xc4, 0xb2, 0x35, 0x7b, 0x60, 0x71, 0x21, 0x3e, 0x35, 0xab, 0x9b, 0xb1, 0x22, 0xfe, 0x68,
-		0x50, 0x74, 0x85, 0x93, 0xc9, 0x5f, 0x80, 0xb4, 0xbf, 0x66, 0xb2, 0x0c, 0x5c, 0x25, 0x0a, 0xe4,
-		0x2f, 0x03, 0x1a, 0xf5, 0x3e, 0xc6, 0x84, 0x97, 0xd2, 0xb8, 0xf0, 0xf2, 0xc7, 0x24, 0x38, 0x75,
-		0x88, 0xa7, 0x81, 0xde, 0x32, 0xd4, 0xc9, 0x4b, 0x47, 0xf1, 0x53, 0x56, 0x58, 0xd9, 0x50, 0x37,
-		0x9f, 0x80, 0xa4, 0xb7, 0x7c, 0xb6, 0x4e, 0x7e, 0x2e, 0xe0, 0x4e, 0x62, 0x7f, 0x1c, 0xec, 0x9a,
-		0x40, 0xe9, 0x2b, 0x34, 0x81, 0x6f, 0x04, 0xb0, 0xf7, 0xc5, 0xb9, 0x6d, 0xbe, 0x8e, 0xde, 0x3b,
-		0x26, 0xbf, 0x88, 0x5b, 0xcd, 0x7d, 0x3e, 0x09, 0xe2, 0x36, 0xff, 0xcb, 0x42, 0x0d, 0x6f, 0x52,
-		0x60, 0x40, 0xd7, 0x58, 0x8b, 0x07, 0xcc, 0xb3, 0x2e, 0xc6, 0x6e, 0xf2, 0x80, 0x15, 0x5b, 0xe8,
-		0x26, 0x9c, 0x18, 0x72, 0x14, 0x1c, 0xd2, 0xa1, 0x59, 0xfd, 0x85, 0x63, 0x7e, 0x7f, 0x41, 0x90,
-		0xf6, 0xae, 0xf6, 0x61, 0xff, 0x6a, 0x7f, 0x13, 0xc0, 0x4d, 0x0e, 0xb8, 0x07, 0x0d, 0x25, 0xef,
-		0x41, 0xc3, 0x0b, 0x10, 0x26, 0x9a, 0x24, 0xe4, 0x34, 0x6a, 0x8a, 0x89, 0x26, 0x78, 0x92, 0x0b,
-		0x0c, 0x3a, 0xaf, 0x03, 0x1a, 0x4d, 0xd0, 0x4e, 0x68, 0xe2, 0x4d, 0xfe, 0x26, 0xee, 0x9b, 0x98,
-		0xea, 0x1d, 0xdf, 0xd4, 0xcb, 0x10, 0xa6, 0x23, 0x3f, 0xf6, 0xf6, 0xe6, 0x37, 0x01, 0x68, 0xb6,
-		0xdd, 0xd7, 0xb7, 0x07, 0x6e, 0x03, 0x4b, 0xe3, 0x35, 0xa7, 0x20, 0xe0, 0x8a, 0xf7, 0x70, 0x15,
-		0x5a, 0x74, 0x51, 0x3d, 0x6a, 0xe4, 0x21, 0x98, 0xaf, 0x41, 0xda, 0x8f, 0x3b, 0xfe, 0x36, 0xea,
-		0xe8, 0x2f, 0xfe, 0xba, 0xde, 0x11, 0x7f, 0x20, 0x8b, 0x7e, 0xe4, 0xdf, 0x1e, 0x80, 0xa4, 0x57,
-		0xf1, 0xfe, 0xee, 0xb9, 0x20, 0xf9, 0x6f, 0x93, 0x20, 0xe6, 0x74, 0xff, 0x90, 0xe3, 0xd5, 0xee,
-		0xad, 0x64, 0x67, 0xf3, 0x82, 0x6d, 0x13, 0x05, 0x9d, 0x6d, 0xa2, 0xcb, 0xce, 0xf2, 0x37, 0x29,
-		0x21, 0xe2, 0x95, 0xb5, 0x38, 0xe2, 0xc8, 0x57, 0xfb, 0xcb, 0xb3, 0x5d, 0xfe, 0x5b, 0x84, 0xb0,
-		0xf7, 0xe2, 0x1e, 0xfb, 0xc8, 0xb7, 0x3d, 0x47, 0x92, 0xd8, 0x44, 0xf4, 0xde, 0x12, 0x94, 0x8e,
-		0x7c, 0x4b, 0xd0, 0x69, 0x25, 0xe0, 0x6d, 0xe5, 0x7b, 0x25, 0x88, 0x89, 0x39, 0x81, 0xde, 0xec,
-		0x3d, 0xeb, 0x26, 0xb6, 0x36, 0x27, 0xda, 0x23, 0x4e, 0xdf, 0x73, 0xd4, 0x6d, 0xe4, 0x88, 0x77,
-		0xf0, 0xa8, 0x47, 0xbc, 0xb9, 0x67, 0xf7, 0x57, 0x12, 0xc8, 0xc3, 0x33, 0xf6, 0x2b, 0xe6, 0x6e,
-		0x74, 0x99, 0x0b, 0x8e, 0x59, 0xe6, 0x26, 0x9d, 0xdb, 0x0e, 0x4d, 0x3a, 0xb7, 0x3d, 0xda, 0xeb,
-		0xf0, 0x5d, 0xf6, 0xfa, 0x9d, 0x01, 0x48, 0x78, 0xd2, 0xa3, 0xe8, 0x49, 0xdf, 0x19, 0xf0, 0xe5,
-		0xc3, 0x52, 0xa9, 0x9e, 0x43, 0xe0, 0x3e, 0x31, 0x05, 0x8e, 0x2e, 0xa6, 0xaf, 0xfe, 0x7d, 0xb0,
-		0xf1, 0x57, 0x66, 0xc3, 0x13, 0xae, 0xcc, 0x7e, 0xab, 0x04, 0x31, 0xc7, 0xed, 0x3e, 0xea, 0x26,
-		0xe6, 0x71, 0x88, 0x70, 0xcf, 0x92, 0xed, 0x62, 0xf2, 0xaf, 0xb1, 0x69, 0xe5, 0x1c, 0xc4, 0xba,
-		0xd8, 0xd6, 0xa8, 0x1d, 0x64, 0xab, 0x9a, 0xf3, 0x7d, 0xf6, 0x12, 0x24, 0x3c, 0x1b, 0xc0, 0xc4,
-		0x34, 0xd6, 0x2a, 0xcf, 0xc9, 0x73, 0xb9, 0xe8, 0x2b, 0x77, 0x96, 0x83, 0x35, 0xfc, 0x12, 0x99,
-		0xcd, 0x4a, 0xa5, 0x74, 0xad, 0x52, 0x7a, 0x56, 0x96, 0x72, 0x89, 0x57, 0xee, 0x2c, 0x47, 0x15,
-		0x4c, 0x33, 0x8a, 0x67, 0x9f, 0x85, 0xcc, 0xd0, 0xc0, 0xf8, 0xdd, 0x16, 0x04, 0xe9, 0xf2, 0xd6,
-		0xe6, 0x7a, 0xb5, 0x54, 0x68, 0x56, 0x54, 0x76, 0x52, 0x18, 0x9d, 0x80, 0x85, 0xf5, 0xea, 0xd5,
-		0x6b, 0x4d, 0xb5, 0xb4, 0x5e, 0xad, 0xd4, 0x9a, 0x6a, 0xa1, 0xd9, 0x2c, 0x94, 0x9e, 0x95, 0x03,
-		0xab, 0x77, 0x12, 0x10, 0x2a, 0x14, 0x4b, 0x55, 0x54, 0x82, 0x10, 0x4d, 0x85, 0x1c, 0x7a, 0x02,
-		0x2c, 0x77, 0x78, 0x6e, 0x18, 0x5d, 0x81, 0x30, 0xcd, 0x92, 0xa0, 0xc3, 0x8f, 0x84, 0xe5, 0xa6,
-		0x24, 0x8b, 0x09, 0x33, 0x74, 0x46, 0x1e, 0x7a, 0x46, 0x2c, 0x77, 0x78, 0xee, 0x18, 0xad, 0x43,
-		0x54, 0x04, 0xc9, 0xd3, 0x0e, 0x6e, 0xe5, 0xa6, 0x26, 0x74, 0x49, 0xd7, 0x58, 0xb2, 0xe1, 0xf0,
-		0xe3, 0x63, 0xb9, 0x29, 0x59, 0x65, 0x54, 0x75, 0x6e, 0x50, 0x4d, 0x39, 0x11, 0x96, 0x9b, 0x96,
-		0x27, 0x46, 0x0a, 0xc4, 0xdd, 0x34, 0xce, 0xf4, 0x43, 0x71, 0xb9, 0x19, 0x12, 0xe6, 0xe8, 0xad,
-		0x90, 0xf2, 0x87, 0xba, 0xb3, 0x9d, 0x3a, 0xcb, 0xcd, 0x98, 0x91, 0x26, 0xf4, 0xfd, 0x71, 0xef,
-		0x6c, 0xa7, 0xd0, 0x72, 0x33, 0x26, 0xa8, 0xd1, 0x8b, 0x30, 0x3f, 0x1a, 0x97, 0xce, 0x7e, 0x28,
-		0x2d, 0x77, 0x84, 0x94, 0x35, 0xea, 0x02, 0x1a, 0x13, 0xcf, 0x1e, 0xe1, 0x8c, 0x5a, 0xee, 0x28,
-		0x19, 0x6c, 0xd4, 0x86, 0xcc, 0x70, 0x90, 0x38, 0xeb, 0x99, 0xb5, 0xdc, 0xcc, 0xd9, 0x6c, 0xd6,
-		0x8a, 0x3f, 0xb8, 0x9c, 0xf5, 0x0c, 0x5b, 0x6e, 0xe6, 0xe4, 0x36, 0xda, 0x02, 0xf0, 0xc4, 0x87,
-		0x33, 0x9c, 0x69, 0xcb, 0xcd, 0x92, 0xe6, 0x46, 0x3d, 0x58, 0x18, 0x17, 0x38, 0x1e, 0xe5, 0x88,
-		0x5b, 0xee, 0x48, 0xd9, 0x6f, 0xa2, 0xcf, 0xfe, 0x10, 0x70, 0xb6, 0x23, 0x6f, 0xb9, 0x19, 0xd3,
-		0xe0, 0xc5, 0xc2, 0xc4, 0x73, 0xce, 0x0f, 0x1d, 0x7a, 0xce, 0xd9, 0x3d, 0xb9, 0xec, 0x9c, 0x6d,
-		0xfe, 0xdc, 0x79, 0x78, 0x03, 0x7f, 0xb3, 0xc7, 0xb2, 0xb5, 0x5b, 0xba, 0xb1, 0xeb, 0x3c, 0xec,
-		0xc4, 0xbf, 0xf9, 0x21, 0xe7, 0xe3, 0xfc, 0x5d, 0x20, 0x51, 0x3a, 0xe5, 0x79, 0xa7, 0x89, 0xaf,
-		0x82, 0x4e, 0xbb, 0x81, 0x31, 0xfd, 0xf8, 0xf2, 0x21, 0x4f, 0x47, 0x4d, 0x79, 0xa0, 0x6a, 0xcc,
-		0xd3, 0x52, 0xb9, 0x43, 0x5f, 0x35, 0xc8, 0x1d, 0x76, 0xee, 0x9b, 0x78, 0xb1, 0xe9, 0x6b, 0xba,
-		0x65, 0x9b, 0x7d, 0xbd, 0xa5, 0x75, 0xe8, 0xf2, 0x72, 0x79, 0xd6, 0x7b, 0x60, 0xc5, 0x38, 0x71,
-		0x57, 0xf8, 0xeb, 0x53, 0xfc, 0x6e, 0x52, 0x19, 0x22, 0xb7, 0xb5, 0x0e, 0xbb, 0x85, 0xe5, 0x7d,
-		0xbe, 0x6e, 0x58, 0xe6, 0x1e, 0x3f, 0xca, 0x4b, 0x85, 0xe1, 0xe6, 0xbf, 0x9f, 0xde, 0x60, 0xe9,
-		0x76, 0x75, 0x8b, 0xe8, 0xa2, 0x42, 0x43, 0xe9, 0xeb, 0x10, 0xea, 0x6b, 0x36, 0x0f, 0x30, 0x8b,
-		0x17, 0x8f, 0xfc, 0x12, 0x14, 0x6b, 0x81, 0xd2, 0x40, 0x6f, 0x81, 0x58, 0x57, 0xdb, 0x57, 0x29,
-		0xbd, 0xc0, 0x57, 0x44, 0x2f, 0xda, 0xd5, 0xf6, 0x09, 0x7f, 0xe8, 0xad, 0xf4, 0x07, 0x28, 0xd5,
-		0xd6, 0x9e, 0x66, 0xec, 0x62, 0x46, 0x39, 0xf8, 0x15, 0x51, 0x4e, 0x75, 0xb5, 0xfd, 0x12, 0xa5,
-		0x46, 0xe8, 0xf3, 0x47, 0xb6, 0x7e, 0x5d, 0xe2, 0x59, 0x03, 0x2a, 0x18, 0xa4, 0x81, 0xdc, 0x72,
-		0xbe, 0x68, 0xa3, 0x62, 0x8b, 0xe3, 0xa1, 0x49, 0x72, 0x1f, 0x12, 0x6b, 0x31, 0x45, 0xd8, 0xfb,
-		0xe4, 0xa7, 0x96, 0x24, 0xd6, 0x6a, 0xa6, 0x35, 0x22, 0xf6, 0x04, 0x4b, 0x86, 0xa8, 0xd4, 0x87,
-		0x0d, 0x4c, 0xf5, 0x61, 0x53, 0xc2, 0x87, 0x65, 0x04, 0x81, 0x61, 0x93, 0x7a, 0xde, 0x87, 0x8f,
-		0x4a, 0x90, 0x28, 0x7b, 0x9e, 0xda, 0xcc, 0x42, 0xb4, 0x6b, 0x1a, 0xfa, 0x2d, 0xdc, 0x77, 0xf6,
-		0xa8, 0xd8, 0x27, 0xf1, 0x33, 0xd9, 0xaf, 0xca, 0xdb, 0x07, 0xe2, 0x3d, 0x23, 0xf1, 0x4d, 0xb0,
-		0x5e, 0xc2, 0xdb, 0x96, 0x2e, 0xe4, 0xac, 0x88, 0x4f, 0xf4, 0x30, 0xc8, 0x16, 0x6e, 0x0d, 0xfa,
-		0xba, 0x7d, 0xa0, 0xb6, 0x4c, 0xc3, 0xd6, 0x5a, 0x36, 0x8f, 0xc3, 0x33, 0xa2, 0xbc, 0xc4, 0x8a,
-		0x09, 0x91, 0x36, 0xb6, 0x35, 0xbd, 0xc3, 0x4e, 0x66, 0xc6, 0x15, 0xf1, 0xc9, 0x59, 0xbd, 0x13,
-		0xf5, 0xc6, 0xa1, 0x25, 0x90, 0xcd, 0x1e, 0xee, 0xfb, 0x0e, 0xa4, 0x30, 0x6d, 0xcc, 0xfe, 0xee,
-		0xc7, 0x1f, 0x5b, 0xe4, 0x02, 0xe7, 0x87, 0x19, 0xd8, 0x2f, 0x4b, 0x28, 0x19, 0x81, 0x21, 0x4e,
-		0xaa, 0xdc, 0xf4, 0xed, 0x4a, 0x0d, 0xb6, 0xdd, 0x87, 0x6b, 0x16, 0x47, 0x84, 0x5a, 0x30, 0x0e,
-		0x8a, 0xd9, 0x4f, 0xb8, 0xa4, 0xdd, 0x38, 0xf5, 0x59, 0x7c, 0xe0, 0xdd, 0xa2, 0xa2, 0x64, 0x88,
-		0x0b, 0xff, 0xa2, 0xa6, 0x77, 0x70, 0x9b, 0xa7, 0x35, 0xf8, 0x17, 0x5a, 0x73, 0xd2, 0x8b, 0xec,
-		0x57, 0x55, 0xf3, 0x93, 0x74, 0xa3, 0x68, 0x1a, 0x6d, 0x7f, 0x1e, 0x11, 0x95, 0x20, 0x62, 0x9b,
-		0xb7, 0xb0, 0xc1, 0x05, 0x74, 0xb4, 0xc7, 0xdc, 0x38, 0x2a, 0xfa, 0x46, 0x90, 0xdb, 0xb8, 0x83,
-		0x77, 0xd9, 0x0d, 0xcd, 0x3d, 0xad, 0x8f, 0xd9, 0xfb, 0x00, 0x77, 0xf5, 0xa0, 0x5b, 0xc6, 0x21,
-		0xd5, 0xa0, 0x94, 0xd0, 0xa6, 0xff, 0x31, 0xd7, 0x28, 0xdf, 0x3c, 0x9e, 0xd0, 0x47, 0x8f, 0xe6,
-		0x79, 0x2d, 0x8f, 0xef, 0xf1, 0xd7, 0x87, 0x41, 0x1e, 0x18, 0xdb, 0xa6, 0x41, 0x7f, 0x22, 0x94,
-		0x47, 0x51, 0x31, 0xb6, 0x53, 0xe9, 0x94, 0xf3, 0x9d, 0xca, 0x4d, 0x48, 0xbb, 0xa0, 0x74, 0x86,
-		0xc4, 0x8f, 0x3a, 0x43, 0x52, 0x0e, 0x01, 0x02, 0x82, 0x36, 0x00, 0xdc, 0x39, 0x48, 0xb7, 0xca,
-		0x12, 0x93, 0x47, 0xcc, 0x9d, 0xcd, 0xde, 0xce, 0x78, 0x08, 0x20, 0x03, 0x16, 0xba, 0xba, 0xa1,
-		0x5a, 0xb8, 0xb3, 0xa3, 0x72, 0xc9, 0x11, 0xba, 0x09, 0x2a, 0xfe, 0x37, 0x1f, 0x61, 0x34, 0x7f,
-		0xff, 0xe3, 0x8f, 0x65, 0xdc, 0x27, 0xf1, 0x96, 0x1f, 0x5f, 0x79, 0xf2, 0xa2, 0x32, 0xdf, 0xd5,
-		0x8d, 0x06, 0xee, 0xec, 0x94, 0x1d, 0xc2, 0xe8, 0x8d, 0x70, 0xca, 0x15, 0x88, 0x69, 0xa8, 0x7b,
-		0x66, 0xa7, 0xad, 0xf6, 0xf1, 0x8e, 0xda, 0xa2, 0x8f, 0x0d, 0x26, 0xa9, 0x18, 0x4f, 0x38, 0x20,
-		0x75, 0xe3, 0x9a, 0xd9, 0x69, 0x2b, 0x78, 0xa7, 0x44, 0xaa, 0xd1, 0xfd, 0xe0, 0x4a, 0x43, 0xd5,
-		0xdb, 0x56, 0x36, 0xb5, 0x1c, 0x3c, 0x13, 0x52, 0x92, 0x4e, 0x61, 0xb5, 0x6d, 0xad, 0xc5, 0xde,
-		0xfd, 0xc1, 0xa5, 0xb9, 0xd7, 0x3e, 0xb8, 0x34, 0x97, 0xbf, 0x42, 0x1f, 0xb6, 0xe2, 0x53, 0x0b,
-		0x5b, 0xe8, 0x22, 0xc4, 0x35, 0xf1, 0xc1, 0x6e, 0x9d, 0x1d, 0x32, 0x35, 0x5d, 0xd0, 0xfc, 0x8f,
-		0x4b, 0x10, 0x29, 0xdf, 0xd8, 0xd4, 0xf4, 0x3e, 0xaa, 0xc0, 0xbc, 0xab, 0xab, 0xb3, 0xce, 0x72,
-		0x57, 0xbd, 0xc5, 0x34, 0xaf, 0x4d, 0x3a, 0xbd, 0x16, 0x2f, 0xde, 0xf7, 0xbb, 0x1f, 0x7f, 0xec,
-		0x5e, 0x4e, 0xe6, 0xc6, 0xd0, 0x41, 0x36, 0x41, 0x6f, 0xf8, 0x80, 0x9b, 0xa7, 0xcf, 0xd7, 0x21,
-		0xca, 0x58, 0xb5, 0xd0, 0x33, 0x10, 0xee, 0x91, 0x3f, 0x78, 0x52, 0xfe, 0xf4, 0x44, 0x9d, 0xa7,
-		0xf0, 0x5e, 0x0d, 0x61, 0x78, 0xf9, 0xf7, 0x04, 0x00, 0xca, 0x37, 0x6e, 0x34, 0xfb, 0x7a, 0xaf,
-		0x83, 0xed, 0xaf, 0x56, 0xdf, 0xb7, 0xe0, 0x98, 0xe7, 0x42, 0x76, 0xbf, 0x75, 0xf4, 0xfe, 0x2f,
-		0xb8, 0x77, 0xb3, 0xfb, 0xad, 0xb1, 0x64, 0xdb, 0x96, 0xed, 0x90, 0x0d, 0x1e, 0x9d, 0x6c, 0xd9,
-		0xb2, 0x47, 0x25, 0xfb, 0x3c, 0x24, 0x5c, 0x61, 0x58, 0xa8, 0x0a, 0x31, 0x9b, 0xff, 0xcd, 0x05,
-		0x9c, 0x9f, 0x2c, 0x60, 0x81, 0xe6, 0x15, 0xb2, 0x83, 0x9e, 0xff, 0x1b, 0x09, 0xc0, 0x33, 0x47,
-		0x5e, 0x9f, 0x3a, 0x46, 0x22, 0x78, 0x6e, 0x9c, 0x83, 0x77, 0xfd, 0x24, 0x28, 0x23, 0xe0, 0x11,
-		0xea, 0x7b, 0x03, 0xb0, 0xb0, 0x25, 0x66, 0xef, 0xeb, 0x5f, 0x06, 0x5b, 0x10, 0xc5, 0x86, 0xdd,
-		0xd7, 0x9d, 0x6d, 0xa5, 0xc7, 0x27, 0x8d, 0xf9, 0x98, 0x4e, 0x55, 0x0c, 0xbb, 0x7f, 0xe0, 0xd5,
-		0x00, 0x41, 0xcb, 0x23, 0x8f, 0xf7, 0x07, 0x21, 0x3b, 0x09, 0x15, 0x3d, 0x04, 0x99, 0x56, 0x1f,
-		0xd3, 0x02, 0xff, 0x35, 0xd3, 0xb4, 0x28, 0xe6, 0xcb, 0x8e, 0x02, 0xc4, 0x51, 0x23, 0xca, 0x45,
-		0x40, 0xef, 0xce, 0x33, 0x4b, 0xbb, 0x14, 0xe8, 0xc2, 0xd3, 0x84, 0x8c, 0xb8, 0x86, 0xb2, 0xad,
-		0x75, 0x34, 0xa3, 0x25, 0x3c, 0xd8, 0x23, 0xad, 0xf9, 0xe2, 0x2a, 0x4b, 0x91, 0x91, 0x40, 0x15,
-		0x88, 0x0a, 0x6a, 0xa1, 0xa3, 0x53, 0x13, 0xb8, 0xe8, 0x3e, 0x48, 0x7a, 0x17, 0x06, 0xea, 0x8d,
-		0x84, 0x94, 0x84, 0x67, 0x5d, 0x98, 0xb6, 0xf2, 0x44, 0x0e, 0x5d, 0x79, 0xb8, 0xc3, 0xf7, 0x23,
-		0xf4, 0xb4, 0x78, 0xfb, 0x6f, 0xff, 0xb0, 0x6c, 0x02, 0xb0, 0xa9, 0x4a, 0x2c, 0x29, 0x1f, 0x99,
-		0xbb, 0x98, 0xef, 0x71, 0x46, 0xa4, 0x6c, 0xd9, 0x5f, 0xaf, 0x11, 0xfa, 0x83, 0x00, 0x24, 0xbd,
-		0x23, 0xf4, 0x77, 0x72, 0xd1, 0x42, 0x35, 0xd7, 0x4c, 0xb1, 0x1b, 0x35, 0x0f, 0x4f, 0x32, 0x53,
-		0x23, 0xda, 0x3c, 0xc5, 0x3e, 0x7d, 0x21, 0x08, 0x11, 0x7e, 0xe4, 0xad, 0x3e, 0xe2, 0xdb, 0x4a,
-		0xd3, 0x1e, 0x11, 0x48, 0x89, 0x47, 0x04, 0xc6, 0xba, 0xb6, 0x0f, 0x40, 0x9a, 0xc4, 0xc8, 0xbe,
-		0x73, 0x74, 0xd2, 0x99, 0x14, 0x0d, 0x75, 0xdd, 0x53, 0xe3, 0x68, 0x09, 0x12, 0x04, 0xcc, 0xb5,
-		0xc3, 0x04, 0x06, 0xba, 0xda, 0x7e, 0x85, 0x95, 0xa0, 0xc7, 0x00, 0xed, 0x39, 0x39, 0x0b, 0xd5,
-		0x15, 0x04, 0x81, 0x9b, 0x77, 0x6b, 0x04, 0xf8, 0xbd, 0x00, 0x84, 0x0b, 0x95, 0xbd, 0x82, 0xcd,
-		0xdf, 0x18, 0x27, 0x25, 0x65, 0xfa, 0x12, 0xf6, 0xbb, 0x24, 0xe6, 0x22, 0x0f, 0x45, 0xd2, 0x3c,
-		0x42, 0x69, 0xce, 0x30, 0x29, 0xfe, 0xf2, 0x53, 0x4b, 0xb9, 0x03, 0xad, 0xdb, 0x59, 0xcb, 0x8f,
-		0xa1, 0x93, 0x1f, 0x17, 0xdc, 0x13, 0xc7, 0xd9, 0x1f, 0x89, 0xa3, 0x1a, 0xc8, 0xb7, 0xf0, 0x81,
-		0xda, 0xe7, 0x3f, 0x6e, 0xaf, 0xee, 0x60, 0xcc, 0x63, 0x99, 0x93, 0x2b, 0x63, 0xde, 0x24, 0x5f,
-		0x29, 0x99, 0xba, 0xcf, 0xe9, 0x4f, 0xdf, 0xc2, 0x07, 0x0a, 0x47, 0xbe, 0x82, 0xf1, 0xda, 0x1b,
-		0xc8, 0x74, 0x79, 0xe5, 0x33, 0x3f, 0x73, 0xf6, 0x94, 0xe7, 0x21, 0xeb, 0x7d, 0x27, 0x43, 0xc6,
-		0xc6, 0x98, 0x78, 0xbe, 0xc8, 0x5d, 0x85, 0x9c, 0xc3, 0xf7, 0x1b, 0x00, 0x9e, 0x60, 0x41, 0x3a,
-		0x3c, 0x08, 0x71, 0xf1, 0x7d, 0x41, 0x88, 0x67, 0x8e, 0xbe, 0xd9, 0x5d, 0x04, 0x02, 0x47, 0xe8,
-		0x92, 0x40, 0xa2, 0x53, 0x7f, 0x2e, 0xff, 0x6f, 0x25, 0x38, 0x39, 0xa2, 0xce, 0x0e, 0xcb, 0x2d,
-		0x40, 0x7d, 0x4f, 0x25, 0x55, 0x0b, 0xb1, 0xdb, 0x7b, 0x77, 0xb3, 0x63, 0xbe, 0x3f, 0xb2, 0x12,
-		0x7c, 0x75, 0x56, 0x33, 0x6e, 0xca, 0x7e, 0x4b, 0x82, 0x45, 0x2f, 0x03, 0x4e, 0x57, 0x1a, 0x90,
-		0xf4, 0x36, 0xcd, 0x3b, 0xf1, 0x86, 0x59, 0x3a, 0xe1, 0xe5, 0xdf, 0x47, 0x04, 0xdd, 0x70, 0x4d,
-		0x06, 0x4b, 0xcd, 0x9d, 0x9f, 0x59, 0x28, 0x82, 0xb1, 0xb1, 0xa6, 0x83, 0x8d, 0xcd, 0xe7, 0x25,
-		0x08, 0x6d, 0x9a, 0x66, 0x07, 0xbd, 0x0d, 0xe6, 0x0d, 0xd3, 0x56, 0xc9, 0xf4, 0xc2, 0x6d, 0x95,
-		0xe7, 0x0e, 0x98, 0x39, 0xae, 0x1c, 0x2a, 0xab, 0xcf, 0x7d, 0x6a, 0x69, 0x14, 0x73, 0xdc, 0x43,
-		0xf7, 0x19, 0xc3, 0xb4, 0x8b, 0x14, 0xa8, 0xc9, 0xd2, 0x0b, 0x3b, 0x90, 0xf2, 0x37, 0xc7, 0x4c,
-		0x76, 0x61, 0x5a, 0x73, 0xa9, 0xa9, 0x4d, 0x25, 0xb7, 0x3d, 0xed, 0xb0, 0xb7, 0xa1, 0xff, 0x9c,
-		0x8c, 0xdc, 0x4d, 0x90, 0x6f, 0x0c, 0x9f, 0x22, 0xaa, 0x40, 0x54, 0x9c, 0x1a, 0x92, 0x66, 0x3c,
-		0x90, 0xe4, 0x15, 0x27, 0xc7, 0xcd, 0xff, 0xbc, 0x04, 0xc7, 0x4a, 0xa6, 0x61, 0x3d, 0x8b, 0x0f,
-		0xc4, 0x13, 0x30, 0x95, 0xfd, 0x9e, 0xde, 0x3f, 0x18, 0x7f, 0x21, 0x4a, 0x9a, 0x70, 0x21, 0xea,
-		0x3a, 0x24, 0x30, 0x45, 0xbb, 0xdb, 0xb4, 0x1d, 0xc3, 0xa6, 0x66, 0xfb, 0x7e, 0x48, 0x71, 0x5a,
-		0xfe, 0xe3, 0xa5, 0xac, 0x90, 0xb9, 0x3e, 0x67, 0x7f, 0x41, 0x02, 0x70, 0xf3, 0x47, 0xe8, 0x51,
-		0x38, 0x51, 0xac, 0xd7, 0xca, 0x6a, 0xa3, 0x59, 0x68, 0x6e, 0x35, 0xfc, 0x3f, 0x7c, 0x23, 0x1e,
-		0x83, 0xb2, 0x7a, 0xb8, 0xa5, 0xef, 0xe8, 0xb8, 0x8d, 0x1e, 0x84, 0x45, 0x3f, 0x34, 0xf9, 0xaa,
-		0x94, 0x65, 0x29, 0x97, 0x7c, 0xe5, 0xce, 0x72, 0x8c, 0xf9, 0xcb, 0xb8, 0x8d, 0xce, 0xc0, 0xb1,
-		0x51, 0xb8, 0x6a, 0xed, 0xaa, 0x1c, 0xc8, 0xa5, 0x5e, 0xb9, 0xb3, 0x1c, 0x77, 0x1c, 0x6b, 0x94,
-		0x07, 0xe4, 0x85, 0xe4, 0xf4, 0x82, 0x39, 0x78, 0xe5, 0xce, 0x72, 0x84, 0x69, 0x4f, 0x2e, 0xf4,
-		0xee, 0x1f, 0x3d, 0x3d, 0x77, 0xf6, 0x9b, 0x00, 0xaa, 0xc6, 0x4e, 0x5f, 0x6b, 0xd1, 0x59, 0x92,
-		0x83, 0xe3, 0xd5, 0xda, 0x15, 0xa5, 0x50, 0x6a, 0x56, 0xeb, 0xb5, 0xa1, 0xdf, 0xeb, 0xf1, 0xd7,
-		0x95, 0xeb, 0x5b, 0xc5, 0xf5, 0x8a, 0xda, 0xa8, 0x5e, 0xad, 0xb1, 0x7d, 0x68, 0x5f, 0xdd, 0x73,
-		0xb5, 0x66, 0x75, 0xa3, 0x22, 0x07, 0x8a, 0x57, 0x26, 0xee, 0x73, 0x3c, 0x7a, 0xe8, 0x4f, 0x0e,
-		0xb8, 0x96, 0xda, 0xb7, 0xd9, 0xf1, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x63, 0xc2, 0x8a, 0xa4,
-		0x2f, 0xb8, 0x00, 0x00,
+		// 12692 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x90, 0x64, 0xc7,
+		0x55, 0x20, 0xdc, 0xb7, 0xde, 0x75, 0xea, 0x75, 0x3b, 0xbb, 0x67, 0xa6, 0xa6, 0x46, 0x9a, 0x69,
+		0x95, 0x2c, 0x69, 0x34, 0x92, 0x7a, 0xa4, 0x91, 0x66, 0xa4, 0x69, 0x59, 0xd6, 0x57, 0xaf, 0x99,
+		0xa9, 0x51, 0x77, 0x55, 0xeb, 0x56, 0xf7, 0x48, 0x23, 0xc0, 0xd7, 0xb7, 0xab, 0x6e, 0x77, 0x97,
+		0xa6, 0xea, 0xde, 0x52, 0xdd, 0x5b, 0x33, 0xd3, 0xfe, 0x41, 0x08, 0x6c, 0xf8, 0x8c, 0x8c, 0xf9,
+		0xcc, 0x07, 0x01, 0x02, 0x33, 0x60, 0xc3, 0x07, 0x36, 0x7c, 0xb0, 0x0b, 0xd8, 0xbc, 0x76, 0x63,
+		0x79, 0x2c, 0xec, 0x2e, 0xb0, 0xec, 0x86, 0x21, 0x62, 0x77, 0x59, 0x22, 0xd0, 0x2e, 0x36, 0x61,
+		0x09, 0x63, 0x16, 0x30, 0x62, 0x97, 0x0d, 0xc7, 0x86, 0x37, 0x4e, 0x3e, 0xee, 0xa3, 0x1e, 0x5d,
+		0xd5, 0x23, 0xd9, 0xab, 0x85, 0xfd, 0xd3, 0x5d, 0x99, 0x79, 0xce, 0xc9, 0xcc, 0x93, 0x27, 0x4f,
+		0x9e, 0x3c, 0x79, 0x32, 0x2f, 0x7c, 0xf9, 0x7d, 0xb0, 0xb4, 0x63, 0x9a, 0x3b, 0x1d, 0xfd, 0x74,
+		0xaf, 0x6f, 0xda, 0xe6, 0xd6, 0x60, 0xfb, 0x74, 0x4b, 0xb7, 0x9a, 0xfd, 0x76, 0xcf, 0x36, 0xfb,
+		0xcb, 0x34, 0x8f, 0x64, 0x18, 0xc4, 0xb2, 0x80, 0xc8, 0xaf, 0xc1, 0xfc, 0x85, 0x76, 0x47, 0x2f,
+		0x3b, 0x80, 0x0d, 0xdd, 0x26, 0x4f, 0x40, 0x68, 0xbb, 0xdd, 0xd1, 0xb3, 0xd2, 0x52, 0xf0, 0x64,
+		0xe2, 0xcc, 0xbb, 0x96, 0x87, 0x90, 0x96, 0xfd, 0x18, 0xeb, 0x98, 0xad, 0x50, 0x8c, 0xfc, 0xab,
+		0x61, 0x58, 0x18, 0x53, 0x4a, 0x08, 0x84, 0x0c, 0xad, 0x8b, 0x14, 0xa5, 0x93, 0x71, 0x85, 0xfe,
+		0x26, 0x59, 0x88, 0xf6, 0xb4, 0xe6, 0x35, 0x6d, 0x47, 0xcf, 0x06, 0x68, 0xb6, 0x48, 0x92, 0xe3,
+		0x00, 0x2d, 0xbd, 0xa7, 0x1b, 0x2d, 0xdd, 0x68, 0xee, 0x65, 0x83, 0x4b, 0xc1, 0x93, 0x71, 0xc5,
+		0x93, 0x43, 0x1e, 0x80, 0xf9, 0xde, 0x60, 0xab, 0xd3, 0x6e, 0xaa, 0x1e, 0x30, 0x58, 0x0a, 0x9e,
+		0x0c, 0x2b, 0x32, 0x2b, 0x28, 0xbb, 0xc0, 0xf7, 0x41, 0xe6, 0x86, 0xae, 0x5d, 0xf3, 0x82, 0x26,
+		0x28, 0x68, 0x1a, 0xb3, 0x3d, 0x80, 0x25, 0x48, 0x76, 0x75, 0xcb, 0xd2, 0x76, 0x74, 0xd5, 0xde,
+		0xeb, 0xe9, 0xd9, 0x10, 0xed, 0xfd, 0xd2, 0x48, 0xef, 0x87, 0x7b, 0x9e, 0xe0, 0x58, 0x1b, 0x7b,
+		0x3d, 0x9d, 0x14, 0x20, 0xae, 0x1b, 0x83, 0x2e, 0xa3, 0x10, 0x9e, 0xc0, 0xbf, 0x8a, 0x31, 0xe8,
+		0x0e, 0x53, 0x89, 0x21, 0x1a, 0x27, 0x11, 0xb5, 0xf4, 0xfe, 0xf5, 0x76, 0x53, 0xcf, 0x46, 0x28,
+		0x81, 0xfb, 0x46, 0x08, 0x34, 0x58, 0xf9, 0x30, 0x0d, 0x81, 0x47, 0x4a, 0x10, 0xd7, 0x6f, 0xda,
+		0xba, 0x61, 0xb5, 0x4d, 0x23, 0x1b, 0xa5, 0x44, 0xee, 0x19, 0x33, 0x8a, 0x7a, 0xa7, 0x35, 0x4c,
+		0xc2, 0xc5, 0x23, 0xe7, 0x20, 0x6a, 0xf6, 0xec, 0xb6, 0x69, 0x58, 0xd9, 0xd8, 0x92, 0x74, 0x32,
+		0x71, 0xe6, 0x8e, 0xb1, 0x82, 0x50, 0x67, 0x30, 0x8a, 0x00, 0x26, 0x55, 0x90, 0x2d, 0x73, 0xd0,
+		0x6f, 0xea, 0x6a, 0xd3, 0x6c, 0xe9, 0x6a, 0xdb, 0xd8, 0x36, 0xb3, 0x71, 0x4a, 0xe0, 0xc4, 0x68,
+		0x47, 0x28, 0x60, 0xc9, 0x6c, 0xe9, 0x55, 0x63, 0xdb, 0x54, 0xd2, 0x96, 0x2f, 0x4d, 0x0e, 0x43,
+		0xc4, 0xda, 0x33, 0x6c, 0xed, 0x66, 0x36, 0x49, 0x25, 0x84, 0xa7, 0xc8, 0x19, 0x88, 0xea, 0xad,
+		0x36, 0x56, 0x97, 0x4d, 0x2f, 0x49, 0x27, 0xd3, 0x67, 0xb2, 0xa3, 0x3c, 0x66, 0xe5, 0x8a, 0x00,
+		0xcc, 0xff, 0x4a, 0x04, 0x32, 0xb3, 0x88, 0xe5, 0x93, 0x10, 0xde, 0x46, 0xce, 0x64, 0x03, 0x07,
+		0xe1, 0x1b, 0xc3, 0xf1, 0x33, 0x3e, 0x72, 0x9b, 0x8c, 0x2f, 0x40, 0xc2, 0xd0, 0x2d, 0x5b, 0x6f,
+		0x31, 0x29, 0x0a, 0xce, 0x28, 0x87, 0xc0, 0x90, 0x46, 0xc5, 0x30, 0x74, 0x5b, 0x62, 0xf8, 0x3c,
+		0x64, 0x9c, 0x26, 0xa9, 0x7d, 0xcd, 0xd8, 0x11, 0xf2, 0x7c, 0x7a, 0x5a, 0x4b, 0x96, 0x2b, 0x02,
+		0x4f, 0x41, 0x34, 0x25, 0xad, 0xfb, 0xd2, 0xa4, 0x0c, 0x60, 0x1a, 0xba, 0xb9, 0xad, 0xb6, 0xf4,
+		0x66, 0x27, 0x1b, 0x9b, 0xc0, 0xa5, 0x3a, 0x82, 0x8c, 0x70, 0xc9, 0x64, 0xb9, 0xcd, 0x0e, 0x39,
+		0xef, 0x8a, 0x67, 0x74, 0x82, 0x74, 0xad, 0xb1, 0x89, 0x39, 0x22, 0xa1, 0x9b, 0x90, 0xee, 0xeb,
+		0x38, 0x57, 0xf4, 0x16, 0xef, 0x59, 0x9c, 0x36, 0x62, 0x79, 0x6a, 0xcf, 0x14, 0x8e, 0xc6, 0x3a,
+		0x96, 0xea, 0x7b, 0x93, 0xe4, 0x6e, 0x70, 0x32, 0x54, 0x2a, 0x56, 0x40, 0x35, 0x57, 0x52, 0x64,
+		0xd6, 0xb4, 0xae, 0x9e, 0x7b, 0x3f, 0xa4, 0xfd, 0xec, 0x21, 0x8b, 0x10, 0xb6, 0x6c, 0xad, 0x6f,
+		0x53, 0x29, 0x0c, 0x2b, 0x2c, 0x41, 0x64, 0x08, 0xea, 0x46, 0x8b, 0x6a, 0xc6, 0xb0, 0x82, 0x3f,
+		0xc9, 0xff, 0xe5, 0x76, 0x38, 0x48, 0x3b, 0x7c, 0xef, 0xe8, 0x88, 0xfa, 0x28, 0x0f, 0xf7, 0x3b,
+		0xf7, 0x38, 0xa4, 0x7c, 0x1d, 0x98, 0xb5, 0xea, 0xfc, 0xef, 0x85, 0xe0, 0xd0, 0x58, 0xda, 0xe4,
+		0x79, 0x58, 0x1c, 0x18, 0x6d, 0xc3, 0xd6, 0xfb, 0xbd, 0xbe, 0x8e, 0x22, 0xcb, 0xea, 0xca, 0xbe,
+		0x1e, 0x9d, 0x20, 0x74, 0x9b, 0x5e, 0x68, 0x46, 0x45, 0x59, 0x18, 0x8c, 0x66, 0x92, 0xab, 0x90,
+		0x40, 0xf9, 0xd0, 0xfa, 0x1a, 0x25, 0xc8, 0x66, 0xe3, 0x99, 0xd9, 0xba, 0xbc, 0x5c, 0x76, 0x31,
+		0x8b, 0xc1, 0x0f, 0x49, 0x01, 0xc5, 0x4b, 0x8b, 0x3c, 0x0e, 0xb1, 0x6d, 0x5d, 0xb3, 0x07, 0x7d,
+		0xdd, 0xca, 0x9e, 0xa1, 0xac, 0x3c, 0x36, 0x3a, 0x49, 0x19, 0x40, 0x43, 0xb7, 0x15, 0x07, 0x98,
+		0x74, 0x21, 0x79, 0x5d, 0xef, 0xb7, 0xb7, 0xdb, 0x4d, 0xd6, 0xa8, 0x20, 0x55, 0x3e, 0x4f, 0xcc,
+		0xd8, 0xa8, 0x2b, 0x1e, 0xd4, 0x86, 0xad, 0xd9, 0xfa, 0x0a, 0x6c, 0xd6, 0xae, 0x54, 0x94, 0xea,
+		0x85, 0x6a, 0xa5, 0xcc, 0x9a, 0xe9, 0x23, 0x9f, 0xfb, 0x5e, 0x09, 0x12, 0x9e, 0x9e, 0xa0, 0x3a,
+		0x34, 0x06, 0xdd, 0x2d, 0xbd, 0xcf, 0xc7, 0x8b, 0xa7, 0xc8, 0x31, 0x88, 0x6f, 0x0f, 0x3a, 0x1d,
+		0x26, 0x74, 0x6c, 0x2d, 0x8d, 0x61, 0x06, 0x0a, 0x1c, 0xea, 0x38, 0xae, 0x46, 0xa8, 0x8e, 0xc3,
+		0xdf, 0x24, 0x07, 0x31, 0x21, 0x94, 0xd9, 0xf0, 0x92, 0x74, 0x32, 0xa6, 0x38, 0x69, 0x56, 0xd6,
+		0xd3, 0x35, 0x5b, 0x6f, 0x65, 0x23, 0xa2, 0x8c, 0xa5, 0x2f, 0x87, 0x62, 0x21, 0x39, 0x9c, 0x7f,
+		0x0c, 0xe6, 0x47, 0xba, 0x42, 0x32, 0x90, 0x28, 0x57, 0x4a, 0xab, 0x05, 0xa5, 0xb0, 0x51, 0xad,
+		0xd7, 0xe4, 0x39, 0x92, 0x06, 0x4f, 0xef, 0x64, 0xe9, 0x54, 0x3c, 0xf6, 0x46, 0x54, 0x7e, 0xf9,
+		0xe5, 0x97, 0x5f, 0x0e, 0xe4, 0x7f, 0x23, 0x02, 0x8b, 0xe3, 0x94, 0xe0, 0x58, 0x7d, 0xec, 0x76,
+		0x3a, 0xe8, 0xeb, 0x74, 0x01, 0xc2, 0x1d, 0x6d, 0x4b, 0xef, 0x64, 0x43, 0x74, 0x10, 0x1e, 0x98,
+		0x49, 0xcd, 0x2e, 0xaf, 0x22, 0x8a, 0xc2, 0x30, 0xc9, 0x7b, 0x38, 0x6b, 0xc2, 0x94, 0xc2, 0xa9,
+		0xd9, 0x28, 0xa0, 0x72, 0xe4, 0x6c, 0x3c, 0x06, 0x71, 0xfc, 0xcf, 0xf8, 0x1e, 0x61, 0x7c, 0xc7,
+		0x0c, 0xca, 0xf7, 0x1c, 0xc4, 0xa8, 0xde, 0x6b, 0xe9, 0xce, 0x98, 0x88, 0x34, 0x6a, 0x8a, 0x96,
+		0xbe, 0xad, 0x0d, 0x3a, 0xb6, 0x7a, 0x5d, 0xeb, 0x0c, 0x74, 0xaa, 0xc1, 0xe2, 0x4a, 0x92, 0x67,
+		0x5e, 0xc1, 0x3c, 0x72, 0x02, 0x12, 0x4c, 0x4d, 0xb6, 0x8d, 0x96, 0x7e, 0x93, 0x2e, 0xa1, 0x61,
+		0x85, 0x69, 0xce, 0x2a, 0xe6, 0x60, 0xf5, 0x2f, 0x5a, 0xa6, 0x21, 0x74, 0x0d, 0xad, 0x02, 0x33,
+		0x68, 0xf5, 0x8f, 0x0f, 0xaf, 0xde, 0x77, 0x8e, 0xef, 0xde, 0x88, 0x72, 0xbc, 0x0f, 0x32, 0x14,
+		0xe2, 0x51, 0x3e, 0x95, 0xb5, 0x4e, 0x76, 0x9e, 0x8a, 0x41, 0x9a, 0x65, 0xd7, 0x79, 0x6e, 0xfe,
+		0x17, 0x03, 0x10, 0xa2, 0x2b, 0x45, 0x06, 0x12, 0x1b, 0x57, 0xd7, 0x2b, 0x6a, 0xb9, 0xbe, 0x59,
+		0x5c, 0xad, 0xc8, 0x12, 0x0e, 0x3d, 0xcd, 0xb8, 0xb0, 0x5a, 0x2f, 0x6c, 0xc8, 0x01, 0x27, 0x5d,
+		0xad, 0x6d, 0x9c, 0x7b, 0x4c, 0x0e, 0x3a, 0x08, 0x9b, 0x2c, 0x23, 0xe4, 0x05, 0x78, 0xf4, 0x8c,
+		0x1c, 0x26, 0x32, 0x24, 0x19, 0x81, 0xea, 0xf3, 0x95, 0xf2, 0xb9, 0xc7, 0xe4, 0x88, 0x3f, 0xe7,
+		0xd1, 0x33, 0x72, 0x94, 0xa4, 0x20, 0x4e, 0x73, 0x8a, 0xf5, 0xfa, 0xaa, 0x1c, 0x73, 0x68, 0x36,
+		0x36, 0x94, 0x6a, 0xed, 0xa2, 0x1c, 0x77, 0x68, 0x5e, 0x54, 0xea, 0x9b, 0xeb, 0x32, 0x38, 0x14,
+		0xd6, 0x2a, 0x8d, 0x46, 0xe1, 0x62, 0x45, 0x4e, 0x38, 0x10, 0xc5, 0xab, 0x1b, 0x95, 0x86, 0x9c,
+		0xf4, 0x35, 0xeb, 0xd1, 0x33, 0x72, 0xca, 0xa9, 0xa2, 0x52, 0xdb, 0x5c, 0x93, 0xd3, 0x64, 0x1e,
+		0x52, 0xac, 0x0a, 0xd1, 0x88, 0xcc, 0x50, 0xd6, 0xb9, 0xc7, 0x64, 0xd9, 0x6d, 0x08, 0xa3, 0x32,
+		0xef, 0xcb, 0x38, 0xf7, 0x98, 0x4c, 0xf2, 0x25, 0x08, 0x53, 0x31, 0x24, 0x04, 0xd2, 0xab, 0x85,
+		0x62, 0x65, 0x55, 0xad, 0xaf, 0xe3, 0xa4, 0x29, 0xac, 0xca, 0x92, 0x9b, 0xa7, 0x54, 0xd6, 0x2b,
+		0x85, 0x8d, 0x4a, 0x59, 0x0e, 0x7a, 0xf3, 0x9e, 0xdd, 0xac, 0x2a, 0x95, 0xb2, 0x1c, 0xc8, 0x37,
+		0x61, 0x71, 0xdc, 0x0a, 0x39, 0x76, 0x0a, 0x79, 0x64, 0x21, 0x30, 0x41, 0x16, 0x28, 0xad, 0x61,
+		0x59, 0xc8, 0x7f, 0x3e, 0x00, 0x0b, 0x63, 0xac, 0x84, 0xb1, 0x95, 0x3c, 0x0d, 0x61, 0x26, 0xcb,
+		0x4c, 0x53, 0xdf, 0x3f, 0xd6, 0xdc, 0xa0, 0x92, 0x3d, 0x62, 0x3b, 0x51, 0x3c, 0xaf, 0xbd, 0x19,
+		0x9c, 0x60, 0x6f, 0x22, 0x89, 0x11, 0x81, 0xfd, 0xa6, 0x91, 0xd5, 0x9c, 0x19, 0x3c, 0xe7, 0x66,
+		0x31, 0x78, 0x68, 0xde, 0xc1, 0x56, 0xf5, 0xf0, 0x98, 0x55, 0xfd, 0x49, 0x98, 0x1f, 0x21, 0x34,
+		0xf3, 0xea, 0xfa, 0x01, 0x09, 0xb2, 0x93, 0x98, 0x33, 0x45, 0x25, 0x06, 0x7c, 0x2a, 0xf1, 0xc9,
+		0x61, 0x0e, 0xde, 0x35, 0x79, 0x10, 0x46, 0xc6, 0xfa, 0x93, 0x12, 0x1c, 0x1e, 0xbf, 0xaf, 0x18,
+		0xdb, 0x86, 0xf7, 0x40, 0xa4, 0xab, 0xdb, 0xbb, 0xa6, 0xb0, 0x93, 0xef, 0x1d, 0x63, 0x7d, 0x61,
+		0xf1, 0xf0, 0x60, 0x73, 0x2c, 0x72, 0x7e, 0xb8, 0xad, 0x27, 0x26, 0xed, 0x72, 0x46, 0x5a, 0xfa,
+		0x1d, 0x01, 0x38, 0x34, 0x96, 0xf8, 0xd8, 0x86, 0xde, 0x09, 0xd0, 0x36, 0x7a, 0x03, 0x9b, 0xd9,
+		0xc2, 0x4c, 0x13, 0xc7, 0x69, 0x0e, 0x55, 0x5e, 0xa8, 0x65, 0x07, 0xb6, 0x53, 0xce, 0x56, 0x49,
+		0x60, 0x59, 0x14, 0xe0, 0x09, 0xb7, 0xa1, 0x21, 0xda, 0xd0, 0xe3, 0x13, 0x7a, 0x3a, 0x22, 0x98,
+		0x0f, 0x83, 0xdc, 0xec, 0xb4, 0x75, 0xc3, 0x56, 0x2d, 0xbb, 0xaf, 0x6b, 0xdd, 0xb6, 0xb1, 0xc3,
+		0x56, 0xdb, 0x95, 0xf0, 0xb6, 0xd6, 0xb1, 0x74, 0x25, 0xc3, 0x8a, 0x1b, 0xa2, 0x14, 0x31, 0xa8,
+		0x00, 0xf5, 0x3d, 0x18, 0x11, 0x1f, 0x06, 0x2b, 0x76, 0x30, 0xf2, 0x3f, 0x13, 0x87, 0x84, 0x67,
+		0x17, 0x46, 0xee, 0x82, 0xe4, 0x8b, 0xda, 0x75, 0x4d, 0x15, 0x3b, 0x6b, 0xc6, 0x89, 0x04, 0xe6,
+		0xad, 0xb3, 0x2c, 0xf2, 0x30, 0x2c, 0x52, 0x10, 0x73, 0x60, 0xeb, 0x7d, 0xb5, 0xd9, 0xd1, 0x2c,
+		0x8b, 0x32, 0x2d, 0x46, 0x41, 0x09, 0x96, 0xd5, 0xb1, 0xa8, 0x24, 0x4a, 0xc8, 0x59, 0x58, 0xa0,
+		0x18, 0xdd, 0x41, 0xc7, 0x6e, 0xf7, 0x3a, 0xba, 0x8a, 0x7b, 0x7d, 0x2b, 0x0b, 0xde, 0x96, 0xcd,
+		0x23, 0xc4, 0x1a, 0x07, 0xc0, 0x16, 0x59, 0xa4, 0x0c, 0x77, 0x52, 0xb4, 0x1d, 0xdd, 0xd0, 0xfb,
+		0x9a, 0xad, 0xab, 0xfa, 0x4b, 0x03, 0xad, 0x63, 0xa9, 0x9a, 0xd1, 0x52, 0x77, 0x35, 0x6b, 0x37,
+		0xbb, 0x88, 0x04, 0x8a, 0x81, 0xac, 0xa4, 0x1c, 0x45, 0xc0, 0x8b, 0x1c, 0xae, 0x42, 0xc1, 0x0a,
+		0x46, 0xeb, 0x92, 0x66, 0xed, 0x92, 0x15, 0x38, 0x4c, 0xa9, 0x58, 0x76, 0xbf, 0x6d, 0xec, 0xa8,
+		0xcd, 0x5d, 0xbd, 0x79, 0x4d, 0x1d, 0xd8, 0xdb, 0x4f, 0x64, 0x8f, 0x79, 0xeb, 0xa7, 0x2d, 0x6c,
+		0x50, 0x98, 0x12, 0x82, 0x6c, 0xda, 0xdb, 0x4f, 0x90, 0x06, 0x24, 0x71, 0x30, 0xba, 0xed, 0xf7,
+		0xeb, 0xea, 0xb6, 0xd9, 0xa7, 0x6b, 0x68, 0x7a, 0x8c, 0x6a, 0xf2, 0x70, 0x70, 0xb9, 0xce, 0x11,
+		0xd6, 0xcc, 0x96, 0xbe, 0x12, 0x6e, 0xac, 0x57, 0x2a, 0x65, 0x25, 0x21, 0xa8, 0x5c, 0x30, 0xfb,
+		0x28, 0x50, 0x3b, 0xa6, 0xc3, 0xe0, 0x04, 0x13, 0xa8, 0x1d, 0x53, 0xb0, 0xf7, 0x2c, 0x2c, 0x34,
+		0x9b, 0xac, 0xcf, 0xed, 0xa6, 0xca, 0x77, 0xe4, 0x56, 0x56, 0xf6, 0x31, 0xab, 0xd9, 0xbc, 0xc8,
+		0x00, 0xb8, 0x8c, 0x5b, 0xe4, 0x3c, 0x1c, 0x72, 0x99, 0xe5, 0x45, 0x9c, 0x1f, 0xe9, 0xe5, 0x30,
+		0xea, 0x59, 0x58, 0xe8, 0xed, 0x8d, 0x22, 0x12, 0x5f, 0x8d, 0xbd, 0xbd, 0x61, 0xb4, 0x7b, 0xa8,
+		0x97, 0xa5, 0xaf, 0x37, 0xa9, 0xa9, 0x77, 0xc4, 0x0b, 0xed, 0x29, 0x20, 0xcb, 0x20, 0x37, 0x9b,
+		0xaa, 0x6e, 0x68, 0x5b, 0x1d, 0x5d, 0xd5, 0xfa, 0xba, 0xa1, 0x59, 0xd9, 0x13, 0x14, 0x38, 0x64,
+		0xf7, 0x07, 0xba, 0x92, 0x6e, 0x36, 0x2b, 0xb4, 0xb0, 0x40, 0xcb, 0xc8, 0x29, 0x98, 0x37, 0xb7,
+		0x5e, 0x6c, 0x32, 0xc1, 0x52, 0x7b, 0x7d, 0x7d, 0xbb, 0x7d, 0x33, 0xfb, 0x2e, 0xca, 0xa5, 0x0c,
+		0x16, 0x50, 0xb1, 0x5a, 0xa7, 0xd9, 0xe4, 0x7e, 0x90, 0x9b, 0xd6, 0xae, 0xd6, 0xef, 0x51, 0xcd,
+		0x6a, 0xf5, 0xb4, 0xa6, 0x9e, 0xbd, 0x87, 0x81, 0xb2, 0xfc, 0x9a, 0xc8, 0x46, 0xc1, 0xb6, 0x6e,
+		0xb4, 0xb7, 0x6d, 0x41, 0xf1, 0x3e, 0x26, 0xd8, 0x34, 0x8f, 0x53, 0x3b, 0x09, 0x72, 0x6f, 0xb7,
+		0xe7, 0xaf, 0xf8, 0x24, 0x05, 0x4b, 0xf7, 0x76, 0x7b, 0xde, 0x7a, 0xef, 0x86, 0x54, 0x6f, 0xd7,
+		0x5b, 0xe9, 0xfd, 0xcc, 0xfe, 0xea, 0xed, 0x7a, 0x6a, 0x7c, 0x0c, 0x0e, 0x23, 0x50, 0x57, 0xb7,
+		0xb5, 0x96, 0x66, 0x6b, 0x1e, 0xe8, 0x07, 0x29, 0xf4, 0x62, 0x6f, 0xb7, 0xb7, 0xc6, 0x0b, 0x7d,
+		0xed, 0xec, 0x0f, 0xb6, 0xf6, 0x1c, 0xf9, 0x78, 0x88, 0xb5, 0x13, 0xf3, 0x84, 0x84, 0xdc, 0xf6,
+		0xf6, 0xe3, 0x6b, 0xb6, 0xd9, 0xca, 0xaf, 0x40, 0xd2, 0x2b, 0xf7, 0x24, 0x0e, 0x4c, 0xf2, 0x65,
+		0x09, 0x8d, 0xa0, 0x52, 0xbd, 0x8c, 0xe6, 0xcb, 0x0b, 0x15, 0x39, 0x80, 0x66, 0xd4, 0x6a, 0x75,
+		0xa3, 0xa2, 0x2a, 0x9b, 0xb5, 0x8d, 0xea, 0x5a, 0x45, 0x0e, 0x7a, 0x0c, 0xfb, 0xcb, 0xa1, 0xd8,
+		0x29, 0xf9, 0x81, 0xcb, 0xa1, 0xd8, 0xbd, 0xf2, 0x7d, 0x94, 0x3d, 0x23, 0x42, 0x99, 0x7f, 0x33,
+		0x08, 0x69, 0xff, 0xb6, 0x9c, 0xbc, 0x1b, 0x8e, 0x08, 0xbf, 0x9b, 0xa5, 0xdb, 0xea, 0x8d, 0x76,
+		0x9f, 0x4e, 0xd6, 0xae, 0xc6, 0x16, 0x4e, 0x47, 0x28, 0x17, 0x39, 0x54, 0x43, 0xb7, 0x9f, 0x6b,
+		0xf7, 0x71, 0x2a, 0x76, 0x35, 0x9b, 0xac, 0xc2, 0x09, 0xc3, 0x54, 0x2d, 0x5b, 0x33, 0x5a, 0x5a,
+		0xbf, 0xa5, 0xba, 0x1e, 0x4f, 0x55, 0x6b, 0x36, 0x75, 0xcb, 0x32, 0xd9, 0x22, 0xe9, 0x50, 0xb9,
+		0xc3, 0x30, 0x1b, 0x1c, 0xd8, 0x5d, 0x3d, 0x0a, 0x1c, 0x74, 0x68, 0x4e, 0x04, 0x27, 0xcd, 0x89,
+		0x63, 0x10, 0xef, 0x6a, 0x3d, 0x55, 0x37, 0xec, 0xfe, 0x1e, 0xb5, 0xdd, 0x63, 0x4a, 0xac, 0xab,
+		0xf5, 0x2a, 0x98, 0x26, 0x57, 0xe0, 0x5e, 0x17, 0x54, 0xed, 0xe8, 0x3b, 0x5a, 0x73, 0x4f, 0xa5,
+		0x86, 0x3a, 0xf5, 0x11, 0xa9, 0x4d, 0xd3, 0xd8, 0xee, 0xb4, 0x9b, 0xb6, 0x95, 0x4d, 0x38, 0xfa,
+		0x2f, 0xef, 0x62, 0xac, 0x52, 0x84, 0xcb, 0x96, 0x69, 0x50, 0xfb, 0xbc, 0x24, 0xa0, 0x7d, 0x62,
+		0x93, 0x7c, 0x47, 0x88, 0x8d, 0x7f, 0xe8, 0x43, 0x72, 0xf8, 0x72, 0x28, 0x16, 0x96, 0x23, 0x97,
+		0x43, 0xb1, 0x88, 0x1c, 0xbd, 0x1c, 0x8a, 0xc5, 0xe4, 0xf8, 0xe5, 0x50, 0x2c, 0x2e, 0x43, 0xfe,
+		0x56, 0x0a, 0x92, 0xde, 0xed, 0x06, 0xee, 0xde, 0x9a, 0x74, 0xc1, 0x95, 0xa8, 0x4a, 0xbe, 0x7b,
+		0xdf, 0xcd, 0xc9, 0x72, 0x09, 0x57, 0xe2, 0x95, 0x08, 0xb3, 0xed, 0x15, 0x86, 0x89, 0x56, 0x10,
+		0x4e, 0x32, 0x9d, 0xd9, 0x52, 0x31, 0x85, 0xa7, 0xc8, 0x45, 0x88, 0xbc, 0x68, 0x51, 0xda, 0x11,
+		0x4a, 0xfb, 0x5d, 0xfb, 0xd3, 0xbe, 0xdc, 0xa0, 0xc4, 0xe3, 0x97, 0x1b, 0x6a, 0xad, 0xae, 0xac,
+		0x15, 0x56, 0x15, 0x8e, 0x4e, 0x8e, 0x42, 0xa8, 0xa3, 0xbd, 0x7f, 0xcf, 0xbf, 0x66, 0xd3, 0x2c,
+		0xb2, 0x0c, 0x99, 0x81, 0xc1, 0xf6, 0xea, 0x38, 0xc6, 0x08, 0x95, 0xf1, 0x42, 0xa5, 0xdd, 0xd2,
+		0x55, 0x84, 0x9f, 0x51, 0xae, 0x8e, 0x42, 0x08, 0x9d, 0xd2, 0xfe, 0x95, 0x95, 0x66, 0x91, 0x93,
+		0x90, 0x6c, 0xe9, 0x5b, 0x83, 0x1d, 0xb5, 0xaf, 0xb7, 0xb4, 0xa6, 0xed, 0x5f, 0x4f, 0x12, 0xb4,
+		0x48, 0xa1, 0x25, 0xe4, 0x19, 0x88, 0xe3, 0x18, 0x19, 0x74, 0x8c, 0xe7, 0x29, 0x0b, 0x1e, 0xda,
+		0x9f, 0x05, 0x7c, 0x88, 0x05, 0x92, 0xe2, 0xe2, 0x93, 0x4b, 0x10, 0xb5, 0xb5, 0xfe, 0x8e, 0x6e,
+		0x5b, 0xd9, 0x85, 0xa5, 0xe0, 0xc9, 0xf4, 0x99, 0xe5, 0x59, 0x48, 0x6d, 0x50, 0x14, 0xba, 0x53,
+		0x16, 0xe8, 0xe4, 0x39, 0x90, 0xb9, 0x2b, 0x56, 0xe5, 0xdb, 0x5c, 0x2b, 0xbb, 0x48, 0x05, 0xf0,
+		0xc1, 0xfd, 0x49, 0x72, 0x4f, 0x6e, 0x99, 0x21, 0x29, 0x19, 0xdd, 0x97, 0xf6, 0xcf, 0x8b, 0x43,
+		0x07, 0x99, 0x17, 0x9b, 0x90, 0xe1, 0xbf, 0x55, 0x6b, 0xd0, 0xeb, 0x99, 0x7d, 0x3b, 0x7b, 0x78,
+		0x49, 0x9a, 0xde, 0x20, 0x41, 0x8c, 0xe1, 0x28, 0xe9, 0x6d, 0x5f, 0xfa, 0x6b, 0x37, 0xdd, 0x72,
+		0x2f, 0x40, 0xda, 0xcf, 0x0c, 0xaf, 0x23, 0x3c, 0x38, 0xa3, 0x23, 0x1c, 0xb7, 0x25, 0x62, 0xa3,
+		0x86, 0x4b, 0x13, 0x4b, 0xe4, 0xbe, 0x2f, 0x00, 0x69, 0x7f, 0xc7, 0xc8, 0x45, 0x20, 0x62, 0xc4,
+		0xda, 0x86, 0xdd, 0x37, 0x5b, 0x83, 0xa6, 0xde, 0xca, 0x4a, 0x53, 0xea, 0x99, 0xe7, 0x38, 0x55,
+		0x07, 0xc5, 0x4b, 0xc8, 0x33, 0x0b, 0x02, 0x33, 0x12, 0x2a, 0xbb, 0xf3, 0xe3, 0x34, 0x2c, 0x08,
+		0x02, 0x48, 0xec, 0x86, 0xd6, 0x37, 0xd0, 0x44, 0x66, 0x46, 0x3b, 0xf1, 0x14, 0x3d, 0xc7, 0x4a,
+		0x48, 0x01, 0x84, 0xb8, 0xa8, 0x7d, 0xbd, 0x6b, 0xa2, 0xbf, 0x2b, 0x34, 0xa5, 0xda, 0x34, 0x47,
+		0x50, 0x18, 0x7c, 0xfe, 0x34, 0x84, 0xa9, 0xfa, 0x21, 0x00, 0x5c, 0x01, 0xc9, 0x73, 0x24, 0x06,
+		0xa1, 0x52, 0x5d, 0xc1, 0xe5, 0x51, 0x86, 0x24, 0xcb, 0x55, 0xd7, 0xab, 0x95, 0x52, 0x45, 0x0e,
+		0xe4, 0xcf, 0x42, 0x84, 0xe9, 0x14, 0x5c, 0x3a, 0x1d, 0xad, 0x22, 0xcf, 0xf1, 0x24, 0xa7, 0x21,
+		0x89, 0xd2, 0xcd, 0xb5, 0x62, 0x45, 0x91, 0x03, 0xf9, 0x4d, 0xc8, 0x0c, 0xcd, 0x43, 0x72, 0x08,
+		0xe6, 0x95, 0xca, 0x46, 0xa5, 0x86, 0xce, 0x01, 0x75, 0xb3, 0xf6, 0x4c, 0xad, 0xfe, 0x1c, 0x7a,
+		0xd6, 0x7c, 0xd9, 0x62, 0x1d, 0x96, 0xc8, 0x22, 0xc8, 0x6e, 0x76, 0xa3, 0xbe, 0xa9, 0xd0, 0xd6,
+		0x7c, 0x67, 0x00, 0xe4, 0xe1, 0x49, 0x49, 0x8e, 0xc0, 0xc2, 0x46, 0x41, 0xb9, 0x58, 0xd9, 0x50,
+		0x99, 0xc3, 0xc3, 0x21, 0xbd, 0x08, 0xb2, 0xb7, 0xe0, 0x42, 0x95, 0xfa, 0x73, 0x4e, 0xc0, 0x31,
+		0x6f, 0x6e, 0xe5, 0xf9, 0x8d, 0x4a, 0xad, 0x41, 0x2b, 0x2f, 0xd4, 0x2e, 0xa2, 0x51, 0x30, 0x44,
+		0x4f, 0xb8, 0x58, 0x82, 0xd8, 0x54, 0x3f, 0xbd, 0xca, 0x6a, 0x59, 0x0e, 0x0d, 0x67, 0xd7, 0x6b,
+		0x95, 0xfa, 0x05, 0x39, 0x3c, 0x5c, 0x3b, 0x75, 0xbb, 0x44, 0x48, 0x0e, 0x0e, 0x0f, 0xe7, 0xaa,
+		0x95, 0xda, 0x86, 0x72, 0x55, 0x8e, 0x0e, 0x57, 0xdc, 0xa8, 0x28, 0x57, 0xaa, 0xa5, 0x8a, 0x1c,
+		0x23, 0x87, 0x81, 0xf8, 0x5b, 0xb4, 0x71, 0xa9, 0x5e, 0x96, 0xe3, 0xe3, 0x56, 0x2c, 0x22, 0x2f,
+		0xe4, 0x7f, 0x5a, 0x82, 0xa4, 0xd7, 0x05, 0xe2, 0x53, 0x2a, 0xd2, 0x3b, 0x6d, 0xb1, 0xcd, 0xff,
+		0x7e, 0x00, 0x12, 0x1e, 0x5f, 0x08, 0x6e, 0x62, 0xb5, 0x4e, 0xc7, 0xbc, 0xa1, 0x6a, 0x9d, 0xb6,
+		0x66, 0xf1, 0xf5, 0x10, 0x68, 0x56, 0x01, 0x73, 0x66, 0x5d, 0x7f, 0x66, 0x37, 0x5d, 0x22, 0xb7,
+		0x6d, 0xba, 0x44, 0xdf, 0x81, 0xa6, 0x4b, 0x58, 0x8e, 0xe4, 0xff, 0x30, 0x00, 0xf2, 0xb0, 0x77,
+		0x64, 0x88, 0x6f, 0xd2, 0x24, 0xbe, 0x79, 0xfb, 0x17, 0x38, 0x48, 0xff, 0x86, 0x57, 0xf5, 0xe0,
+		0xc4, 0x55, 0x7d, 0xcc, 0x62, 0x15, 0x7a, 0x27, 0x2f, 0x56, 0x5e, 0x71, 0xfd, 0x77, 0x12, 0xa4,
+		0xfd, 0xce, 0x1c, 0x1f, 0xc7, 0xf2, 0x07, 0xe1, 0x98, 0x7f, 0x44, 0xee, 0x9a, 0x34, 0x22, 0x5f,
+		0x97, 0x7e, 0xfd, 0x40, 0x10, 0x52, 0x3e, 0xdf, 0xcf, 0xac, 0xad, 0x7b, 0x09, 0xe6, 0xdb, 0x2d,
+		0xbd, 0xdb, 0x33, 0x6d, 0x8c, 0x3c, 0x50, 0x3b, 0xfa, 0x75, 0xbd, 0x43, 0xd9, 0x90, 0x1e, 0x73,
+		0xba, 0xea, 0xab, 0x61, 0xb9, 0xea, 0xe2, 0xad, 0x22, 0xda, 0xca, 0x42, 0xb5, 0x5c, 0x59, 0x5b,
+		0xaf, 0x6f, 0x54, 0x6a, 0xa5, 0xab, 0x42, 0x93, 0x2b, 0x72, 0x7b, 0x08, 0xcc, 0xc7, 0xf0, 0xbb,
+		0xdf, 0x19, 0x9b, 0xce, 0x75, 0x90, 0x87, 0x7b, 0x83, 0x0a, 0x7d, 0x4c, 0x7f, 0xe4, 0x39, 0xb2,
+		0x00, 0x99, 0x5a, 0x5d, 0x6d, 0x54, 0xcb, 0x15, 0xb5, 0x72, 0xe1, 0x42, 0xa5, 0xb4, 0xd1, 0x60,
+		0x07, 0x0d, 0x0e, 0xf4, 0x86, 0x1c, 0xf0, 0x8e, 0xcd, 0x0f, 0x06, 0x61, 0x61, 0x4c, 0x4b, 0x48,
+		0x81, 0xbb, 0x08, 0x99, 0xd7, 0xf2, 0xa1, 0x59, 0x5a, 0xbf, 0x8c, 0xbb, 0xfb, 0x75, 0xad, 0x6f,
+		0x73, 0x8f, 0xe2, 0xfd, 0x80, 0xec, 0x35, 0x6c, 0x34, 0xef, 0xfb, 0xfc, 0x00, 0x87, 0x99, 0x20,
+		0x19, 0x37, 0x9f, 0x9d, 0xe1, 0x3c, 0x08, 0xa4, 0x67, 0x5a, 0x6d, 0xbb, 0x7d, 0x1d, 0x03, 0x21,
+		0xc4, 0x69, 0x0f, 0x4e, 0xdc, 0x90, 0x22, 0x8b, 0x92, 0xaa, 0x61, 0x3b, 0xd0, 0x86, 0xbe, 0xa3,
+		0x0d, 0x41, 0xe3, 0xf6, 0x23, 0xa8, 0xc8, 0xa2, 0xc4, 0x81, 0xbe, 0x0b, 0x92, 0x2d, 0x73, 0x80,
+		0x5e, 0x19, 0x06, 0x87, 0x2a, 0x59, 0x52, 0x12, 0x2c, 0xcf, 0x01, 0xe1, 0x6e, 0x33, 0xf7, 0x98,
+		0x29, 0xa9, 0x24, 0x58, 0x1e, 0x03, 0xb9, 0x0f, 0x32, 0xda, 0xce, 0x4e, 0x1f, 0x89, 0x0b, 0x42,
+		0xcc, 0x11, 0x98, 0x76, 0xb2, 0x29, 0x60, 0xee, 0x32, 0xc4, 0x04, 0x1f, 0x70, 0xff, 0x8b, 0x9c,
+		0x50, 0x7b, 0xcc, 0xbb, 0x1d, 0xc0, 0x93, 0x27, 0x43, 0x14, 0xde, 0x05, 0xc9, 0xb6, 0xa5, 0xba,
+		0x61, 0x10, 0x81, 0xa5, 0xc0, 0xc9, 0x98, 0x92, 0x68, 0x5b, 0xce, 0xa9, 0x68, 0xfe, 0x67, 0x12,
+		0x00, 0xae, 0xb0, 0x91, 0xef, 0x96, 0x20, 0xcd, 0x16, 0x98, 0x5e, 0x5f, 0xb7, 0x74, 0xa3, 0x29,
+		0xb6, 0x85, 0xf7, 0xef, 0x23, 0xa2, 0x4c, 0xcd, 0xad, 0x73, 0x84, 0xe2, 0xd3, 0x1f, 0x92, 0xa4,
+		0x57, 0xa5, 0xd0, 0xab, 0x92, 0xf4, 0xa3, 0x52, 0x8a, 0xc4, 0x2a, 0xcf, 0xaf, 0xaf, 0x56, 0x4b,
+		0xd5, 0x8d, 0xec, 0x17, 0xa2, 0x34, 0x5d, 0x5d, 0xe3, 0xe9, 0xd7, 0xa3, 0xfe, 0xf2, 0x37, 0xa2,
+		0x3f, 0x2f, 0x05, 0x63, 0x6f, 0x44, 0x95, 0xd4, 0xb6, 0x97, 0x1e, 0xe9, 0x78, 0x23, 0x28, 0x02,
+		0x93, 0x36, 0x92, 0x6e, 0x6b, 0x2a, 0x3c, 0x6e, 0xa2, 0x78, 0x3f, 0x6d, 0x48, 0x84, 0x36, 0x24,
+		0x41, 0x22, 0xa5, 0xd5, 0x7a, 0xa3, 0x52, 0xa6, 0xcd, 0x88, 0x93, 0x50, 0x7d, 0xbd, 0x52, 0xcb,
+		0xbe, 0x2e, 0xaa, 0x74, 0x83, 0x2d, 0x5e, 0x95, 0xe0, 0x88, 0x38, 0x65, 0xe5, 0x6b, 0xad, 0x6e,
+		0x34, 0xcd, 0x96, 0xb0, 0x6e, 0xd3, 0x67, 0x1e, 0xd9, 0xaf, 0x72, 0x85, 0xa3, 0x52, 0x96, 0x54,
+		0x38, 0x62, 0xf1, 0xa1, 0x11, 0x96, 0x14, 0x6a, 0x65, 0xde, 0x96, 0x04, 0x89, 0xac, 0x17, 0x4a,
+		0xcf, 0x54, 0xca, 0x6e, 0x6b, 0x0e, 0xf5, 0xc7, 0x51, 0x21, 0xdf, 0x0c, 0x19, 0xf4, 0xb6, 0xa2,
+		0x6c, 0xb4, 0x5b, 0xec, 0xd8, 0x3b, 0x34, 0xe9, 0xbc, 0xd4, 0x6d, 0x11, 0xba, 0x5f, 0xaf, 0x38,
+		0x18, 0xc5, 0xfb, 0x3d, 0x4d, 0x89, 0x93, 0x50, 0xad, 0x5e, 0xab, 0x88, 0x66, 0xd0, 0x23, 0xe2,
+		0xab, 0x6e, 0x33, 0xd2, 0x03, 0x1f, 0x2a, 0xf9, 0x66, 0x90, 0x85, 0x7b, 0xc8, 0x61, 0x49, 0x78,
+		0xd2, 0x91, 0xaf, 0xdb, 0x00, 0xee, 0x64, 0x72, 0x98, 0x71, 0xaf, 0xa7, 0x05, 0x8b, 0x24, 0xb3,
+		0x5a, 0xa9, 0x5d, 0xdc, 0xb8, 0xa4, 0xae, 0x2b, 0x15, 0x7a, 0x72, 0x97, 0xfd, 0x82, 0xa8, 0x3e,
+		0xd3, 0xf5, 0x23, 0x92, 0x6f, 0x95, 0x20, 0xc1, 0x4c, 0x20, 0xe6, 0x93, 0x62, 0x4e, 0x85, 0x7b,
+		0xf7, 0xab, 0x9b, 0x5a, 0x40, 0x14, 0xba, 0x78, 0x9e, 0x56, 0x1b, 0x14, 0x02, 0x71, 0x84, 0x90,
+		0xd5, 0xca, 0xc5, 0x42, 0xe9, 0xaa, 0x5a, 0xac, 0x34, 0x36, 0x50, 0x93, 0xd5, 0x15, 0x26, 0xa3,
+		0x40, 0xc2, 0x85, 0xd5, 0xd5, 0xfa, 0x73, 0x2e, 0x23, 0xe0, 0x45, 0x87, 0x4c, 0xfe, 0x1b, 0x21,
+		0xe5, 0x13, 0x77, 0x34, 0x8a, 0xa9, 0x31, 0x8d, 0x3d, 0x68, 0x54, 0x6a, 0x25, 0xaf, 0x11, 0x9f,
+		0x04, 0x47, 0xbc, 0x65, 0x09, 0x53, 0x42, 0xf8, 0xe5, 0x00, 0xaa, 0x51, 0xde, 0x00, 0xe7, 0x2c,
+		0x31, 0x98, 0x7f, 0x1c, 0x62, 0x42, 0x7c, 0xd1, 0x34, 0xa7, 0x16, 0xf6, 0xd0, 0xc6, 0x20, 0x06,
+		0x54, 0x76, 0x65, 0x09, 0xb7, 0x41, 0x4c, 0xa6, 0xe5, 0x40, 0xfe, 0x0a, 0x1c, 0x1a, 0x2b, 0x7a,
+		0xe4, 0x6e, 0x38, 0x21, 0xce, 0x2f, 0x99, 0xd1, 0xaf, 0x56, 0x6a, 0xa5, 0x7a, 0x19, 0xb7, 0x49,
+		0x2e, 0x4d, 0x00, 0x2e, 0x83, 0xac, 0x95, 0x42, 0x3e, 0xe5, 0x40, 0xbe, 0x0a, 0x69, 0xbf, 0x00,
+		0x91, 0x63, 0x70, 0x64, 0x73, 0xe3, 0xc2, 0x13, 0xea, 0x95, 0xc2, 0x6a, 0xb5, 0x5c, 0x18, 0xda,
+		0x10, 0x01, 0x70, 0x29, 0x92, 0x03, 0xd8, 0x50, 0x94, 0x2e, 0x39, 0x98, 0x0f, 0xc5, 0x24, 0x59,
+		0xca, 0x37, 0x20, 0x33, 0x24, 0x0a, 0xe4, 0x0e, 0xc8, 0xf2, 0x1d, 0xca, 0xb8, 0x56, 0x2d, 0xc0,
+		0xb0, 0x70, 0xb0, 0xbd, 0x5a, 0xb9, 0xb2, 0x5a, 0x5d, 0xab, 0x6e, 0xd0, 0xf6, 0x5d, 0x02, 0x70,
+		0xc7, 0x18, 0xd7, 0xac, 0xcb, 0x8d, 0x7a, 0x4d, 0xbd, 0x80, 0x1b, 0xbd, 0x0d, 0x0f, 0xa9, 0x38,
+		0xb0, 0x31, 0x95, 0x25, 0xdc, 0x8f, 0x8c, 0x0e, 0xbc, 0x1c, 0x38, 0xf5, 0x61, 0x09, 0x97, 0xac,
+		0x0f, 0xd7, 0x72, 0xdf, 0x2a, 0x91, 0x3b, 0x63, 0x6f, 0x44, 0x49, 0x74, 0xb9, 0xb7, 0xb5, 0xdc,
+		0xec, 0xf5, 0x72, 0x19, 0xfc, 0x51, 0xea, 0xf5, 0x2e, 0x88, 0x85, 0xf8, 0x44, 0xec, 0xcf, 0xa2,
+		0x24, 0x86, 0xb9, 0x78, 0x08, 0x90, 0x93, 0xf1, 0xd7, 0x65, 0xed, 0xba, 0xe6, 0x00, 0x1c, 0x8b,
+		0x7d, 0x31, 0x4a, 0x22, 0x98, 0xbd, 0x63, 0xe6, 0xd2, 0xf8, 0xff, 0xa2, 0xe9, 0x14, 0xde, 0x1d,
+		0xfb, 0xf6, 0x1a, 0x01, 0xcc, 0xa4, 0x12, 0xfb, 0x48, 0x8e, 0xe0, 0x6f, 0x7a, 0x6c, 0xf6, 0x88,
+		0x00, 0x3a, 0x15, 0x89, 0x7d, 0xb8, 0x26, 0x7f, 0xb4, 0x76, 0x2a, 0x12, 0xfb, 0x68, 0x4d, 0xfe,
+		0xee, 0xda, 0xe5, 0x48, 0xec, 0xf5, 0xa8, 0xfc, 0x46, 0x34, 0xff, 0x97, 0x41, 0x20, 0xae, 0x7c,
+		0x3b, 0x9e, 0x97, 0xe7, 0x21, 0xe6, 0xb8, 0x72, 0x58, 0xac, 0xe8, 0xbb, 0xf7, 0x99, 0x16, 0x02,
+		0xcd, 0x93, 0x35, 0xe4, 0xda, 0x71, 0xa8, 0xe1, 0xbe, 0xbd, 0xdb, 0x36, 0xda, 0xdd, 0x41, 0x57,
+		0x15, 0xfe, 0x8d, 0xa9, 0xfb, 0x76, 0x8e, 0xc0, 0xd3, 0x94, 0x84, 0x76, 0xd3, 0x47, 0x22, 0x3c,
+		0x95, 0x04, 0x43, 0xe0, 0xe9, 0xdc, 0xdf, 0x49, 0x90, 0x9d, 0xd4, 0xd8, 0xdb, 0x72, 0xbd, 0xd4,
+		0x60, 0xd1, 0xbc, 0xae, 0xf7, 0xfb, 0xed, 0x16, 0x3d, 0x4d, 0x71, 0x0c, 0xb2, 0xd0, 0x74, 0x83,
+		0x6c, 0xc1, 0x83, 0xe8, 0x0c, 0x6a, 0x11, 0xd7, 0xcd, 0x9b, 0xb8, 0x64, 0x08, 0x4a, 0xe1, 0xe9,
+		0x94, 0x52, 0x14, 0x45, 0xd0, 0xb8, 0x8c, 0xd3, 0x04, 0xf7, 0x40, 0x01, 0x39, 0xe8, 0x5a, 0x7d,
+		0xf9, 0x4f, 0x06, 0x20, 0xed, 0x0f, 0xce, 0x24, 0x65, 0x88, 0x75, 0x4c, 0x1e, 0xf8, 0xc4, 0x46,
+		0xfb, 0xe4, 0x94, 0x78, 0xce, 0xe5, 0x55, 0x0e, 0xaf, 0x38, 0x98, 0xb9, 0x7f, 0x2b, 0x41, 0x4c,
+		0x64, 0x93, 0xc3, 0x10, 0xea, 0x69, 0xf6, 0x2e, 0x25, 0x17, 0x2e, 0x06, 0x64, 0x49, 0xa1, 0x69,
+		0xcc, 0xb7, 0x7a, 0x1a, 0x0b, 0xfa, 0xe2, 0xf9, 0x98, 0x46, 0xcb, 0xab, 0xa3, 0x6b, 0x2d, 0x7a,
+		0x0e, 0x68, 0x76, 0xbb, 0xba, 0x61, 0x5b, 0xc2, 0xf2, 0xe2, 0xf9, 0x25, 0x9e, 0x8d, 0x31, 0xc2,
+		0x76, 0x5f, 0x6b, 0x77, 0x7c, 0xb0, 0x21, 0x0a, 0x2b, 0x8b, 0x02, 0x07, 0x78, 0x05, 0x8e, 0x0a,
+		0xba, 0x2d, 0xdd, 0xd6, 0x9a, 0xbb, 0x7a, 0xcb, 0x45, 0x8a, 0xd0, 0xf3, 0xfe, 0x23, 0x1c, 0xa0,
+		0xcc, 0xcb, 0x05, 0x6e, 0xfe, 0xb3, 0x01, 0x98, 0x17, 0x27, 0x97, 0x2d, 0x87, 0x59, 0x6b, 0x00,
+		0x9a, 0x61, 0x98, 0xb6, 0x97, 0x5d, 0xa3, 0xc6, 0xe6, 0x08, 0xde, 0x72, 0xc1, 0x41, 0x52, 0x3c,
+		0x04, 0x72, 0x7f, 0x2e, 0x01, 0xb8, 0x45, 0x13, 0xf9, 0x76, 0x02, 0x12, 0x3c, 0xf4, 0x96, 0xc6,
+		0x6f, 0x33, 0x07, 0x1f, 0xb0, 0x2c, 0x3c, 0xe3, 0x44, 0xdf, 0xdf, 0x96, 0xbe, 0xd3, 0x36, 0x78,
+		0x2c, 0x15, 0x4b, 0x88, 0x90, 0x84, 0x90, 0x1b, 0x6b, 0xa8, 0x40, 0xcc, 0xd2, 0xbb, 0x9a, 0x61,
+		0xb7, 0x9b, 0x7c, 0xd6, 0x9c, 0x3b, 0x50, 0xe3, 0x97, 0x1b, 0x1c, 0x5b, 0x71, 0xe8, 0xe4, 0x4f,
+		0x42, 0x4c, 0xe4, 0x3a, 0x5a, 0x7a, 0x8e, 0x44, 0x21, 0xd8, 0xa8, 0xe0, 0x3a, 0x45, 0x95, 0x65,
+		0xb5, 0xd0, 0x90, 0x03, 0xa7, 0x3e, 0x19, 0x80, 0xa8, 0x98, 0xc6, 0x0b, 0x90, 0xa9, 0x94, 0xab,
+		0x43, 0x0a, 0x7f, 0x01, 0xd2, 0x22, 0x93, 0x69, 0x55, 0xf9, 0x83, 0x51, 0x6f, 0xe6, 0xba, 0x52,
+		0xdf, 0xa8, 0x9f, 0x91, 0xbf, 0x30, 0x9a, 0xf9, 0xa8, 0xfc, 0x7a, 0x94, 0xcc, 0x43, 0x52, 0x64,
+		0x9e, 0x79, 0xf8, 0xcc, 0xa3, 0xf2, 0x1b, 0xc3, 0x59, 0x8f, 0xc9, 0x7f, 0x46, 0x7d, 0x4b, 0x22,
+		0xeb, 0x11, 0x75, 0x03, 0xb5, 0x76, 0xbd, 0xb6, 0x7a, 0x55, 0x96, 0xbc, 0x05, 0x67, 0x3c, 0x05,
+		0x01, 0x72, 0x27, 0x1c, 0x11, 0x05, 0xe7, 0xcf, 0x9f, 0x3f, 0xff, 0xb8, 0xa7, 0xf0, 0xd6, 0x47,
+		0x22, 0xc3, 0xc5, 0x4f, 0x78, 0x8a, 0x7f, 0x78, 0xb4, 0xf8, 0xbc, 0xa7, 0xf8, 0x47, 0x3e, 0x12,
+		0x21, 0x0b, 0x90, 0x10, 0xc5, 0x6b, 0x85, 0xe7, 0xe5, 0xaf, 0x7e, 0xf5, 0xab, 0x5f, 0x8d, 0x16,
+		0xbf, 0x19, 0x16, 0x9a, 0x66, 0x77, 0x78, 0x68, 0x8a, 0xf2, 0x50, 0x60, 0x84, 0x75, 0x49, 0x7a,
+		0xe1, 0x21, 0x0e, 0xb4, 0x63, 0x76, 0x34, 0x63, 0x67, 0xd9, 0xec, 0xef, 0xb8, 0xf7, 0x04, 0xd0,
+		0xc8, 0xb5, 0x3c, 0xb7, 0x05, 0x7a, 0x5b, 0x7f, 0x27, 0x49, 0x3f, 0x1a, 0x08, 0x5e, 0x5c, 0x2f,
+		0xfe, 0x54, 0x20, 0x77, 0x91, 0x21, 0xae, 0x8b, 0x81, 0x57, 0xf4, 0xed, 0x8e, 0xde, 0xc4, 0xd1,
+		0x81, 0xbf, 0x78, 0x00, 0x16, 0x77, 0xcc, 0x1d, 0x93, 0x52, 0x3a, 0x8d, 0xbf, 0x58, 0x23, 0x48,
+		0xdc, 0xc9, 0xcd, 0x4d, 0xbd, 0x95, 0xb0, 0x52, 0x83, 0x05, 0x0e, 0xac, 0x52, 0x9b, 0x9b, 0x9d,
+		0xdd, 0x92, 0x7d, 0xe3, 0x7f, 0xb2, 0x3f, 0xf7, 0xa7, 0xd4, 0x59, 0xa2, 0xcc, 0x73, 0x54, 0x2c,
+		0x63, 0xc7, 0xbb, 0x2b, 0x0a, 0x1c, 0xf2, 0xd1, 0x63, 0xfb, 0x1d, 0xbd, 0x3f, 0x85, 0xe2, 0xbf,
+		0xe0, 0x14, 0x17, 0x3c, 0x14, 0x1b, 0x1c, 0x75, 0xa5, 0x04, 0xa9, 0x83, 0xd0, 0xfa, 0x97, 0x9c,
+		0x56, 0x52, 0xf7, 0x12, 0xb9, 0x08, 0x19, 0x4a, 0xa4, 0x39, 0xb0, 0x6c, 0xb3, 0x4b, 0x37, 0x93,
+		0xfb, 0x93, 0xf9, 0x57, 0x7f, 0xca, 0xd4, 0x5b, 0x1a, 0xd1, 0x4a, 0x0e, 0xd6, 0xca, 0x0a, 0xd0,
+		0xbd, 0x03, 0x06, 0xb5, 0x4e, 0xa1, 0xf0, 0xdb, 0xbc, 0x21, 0x0e, 0xfc, 0xca, 0x15, 0x58, 0xc4,
+		0xdf, 0x74, 0xaf, 0xe7, 0x6d, 0xc9, 0xf4, 0x60, 0xa1, 0xec, 0xef, 0x7f, 0x80, 0x69, 0xd0, 0x05,
+		0x87, 0x80, 0xa7, 0x4d, 0x9e, 0x51, 0xdc, 0xd1, 0x6d, 0x5b, 0xef, 0x5b, 0xaa, 0xd6, 0x19, 0xd7,
+		0x3c, 0x4f, 0xb4, 0x45, 0xf6, 0x07, 0xbe, 0xe4, 0x1f, 0xc5, 0x8b, 0x0c, 0xb3, 0xd0, 0xe9, 0xac,
+		0x6c, 0xc2, 0x91, 0x31, 0x52, 0x31, 0x03, 0xcd, 0x1f, 0xe4, 0x34, 0x17, 0x47, 0x24, 0x03, 0xc9,
+		0xae, 0x83, 0xc8, 0x77, 0xc6, 0x72, 0x06, 0x9a, 0x1f, 0xe3, 0x34, 0x09, 0xc7, 0x15, 0x43, 0x8a,
+		0x14, 0x2f, 0xc3, 0xfc, 0x75, 0xbd, 0xbf, 0x65, 0x5a, 0x3c, 0xc2, 0x65, 0x06, 0x72, 0x3f, 0xc4,
+		0xc9, 0x65, 0x38, 0x22, 0x0d, 0x79, 0x41, 0x5a, 0xe7, 0x21, 0xb6, 0xad, 0x35, 0xf5, 0x19, 0x48,
+		0xdc, 0xe2, 0x24, 0xa2, 0x08, 0x8f, 0xa8, 0x05, 0x48, 0xee, 0x98, 0x7c, 0xbb, 0x3f, 0x1d, 0xfd,
+		0x87, 0x39, 0x7a, 0x42, 0xe0, 0x70, 0x12, 0x3d, 0xb3, 0x37, 0xe8, 0xa0, 0x2f, 0x60, 0x3a, 0x89,
+		0x1f, 0x11, 0x24, 0x04, 0x0e, 0x27, 0x71, 0x00, 0xb6, 0x7e, 0x5c, 0x90, 0xb0, 0x3c, 0xfc, 0x7c,
+		0x1a, 0x03, 0x5f, 0x3b, 0x7b, 0xa6, 0x31, 0x4b, 0x23, 0x3e, 0xc1, 0x29, 0x00, 0x47, 0x41, 0x02,
+		0x4f, 0x42, 0x7c, 0xd6, 0x81, 0xf8, 0xf1, 0x2f, 0x89, 0xe9, 0x21, 0x46, 0xe0, 0x22, 0x64, 0x84,
+		0x82, 0xc2, 0x63, 0xa3, 0xe9, 0x24, 0x7e, 0x82, 0x93, 0x48, 0x7b, 0xd0, 0x78, 0x37, 0x6c, 0xdd,
+		0xb2, 0x77, 0xf4, 0x59, 0x88, 0x7c, 0x52, 0x74, 0x83, 0xa3, 0x70, 0x56, 0x6e, 0xe9, 0x46, 0x73,
+		0x77, 0x36, 0x0a, 0x9f, 0x12, 0xac, 0x14, 0x38, 0x48, 0xa2, 0x04, 0xa9, 0xae, 0xd6, 0xb7, 0x76,
+		0xb5, 0xce, 0x4c, 0xc3, 0xf1, 0x93, 0x9c, 0x46, 0xd2, 0x41, 0xe2, 0x1c, 0x19, 0x18, 0x07, 0x21,
+		0xf3, 0x53, 0x82, 0x23, 0x03, 0xc3, 0x47, 0x68, 0x1d, 0x16, 0x2d, 0x9b, 0x5a, 0xbe, 0x07, 0xa1,
+		0xf6, 0xff, 0x8b, 0xa9, 0xc7, 0x70, 0xd7, 0xbc, 0x14, 0x9f, 0x84, 0xb8, 0xd5, 0x7e, 0xff, 0x4c,
+		0x64, 0x7e, 0x5a, 0x8c, 0x34, 0x45, 0x40, 0xe4, 0xab, 0x70, 0x74, 0xec, 0x32, 0x31, 0x03, 0xb1,
+		0x9f, 0xe1, 0xc4, 0x0e, 0x8f, 0x59, 0x2a, 0xb8, 0x4a, 0x38, 0x28, 0xc9, 0x7f, 0x24, 0x54, 0x82,
+		0x3e, 0x44, 0x6b, 0x1d, 0x1d, 0xb0, 0x96, 0xb6, 0x7d, 0x30, 0xae, 0xfd, 0x63, 0xc1, 0x35, 0x86,
+		0xeb, 0xe3, 0xda, 0x06, 0x1c, 0xe6, 0x14, 0x0f, 0x36, 0xae, 0x3f, 0x2b, 0x14, 0x2b, 0xc3, 0xde,
+		0xf4, 0x8f, 0xee, 0x37, 0x40, 0xce, 0x61, 0xa7, 0xf0, 0xf4, 0x59, 0x2a, 0xc6, 0xc9, 0x4c, 0xa7,
+		0xfc, 0x73, 0x9c, 0xb2, 0xd0, 0xf8, 0x8e, 0xab, 0xd0, 0x5a, 0xd3, 0x7a, 0x48, 0xfc, 0x79, 0xc8,
+		0x0a, 0xe2, 0x03, 0xa3, 0xaf, 0x37, 0xcd, 0x1d, 0xa3, 0xfd, 0x7e, 0xbd, 0x35, 0x03, 0xe9, 0x9f,
+		0x1f, 0x1a, 0xaa, 0x4d, 0x0f, 0x3a, 0x52, 0xae, 0x82, 0xec, 0xd8, 0x2a, 0x6a, 0xbb, 0x4b, 0x4f,
+		0x45, 0xf6, 0xa7, 0xf8, 0x69, 0x31, 0x52, 0x0e, 0x5e, 0x95, 0xa2, 0xad, 0x54, 0x80, 0xc5, 0xc8,
+		0xcf, 0x2a, 0x92, 0x9f, 0xe1, 0x84, 0x52, 0x2e, 0x16, 0x57, 0x1c, 0x4d, 0xb3, 0xdb, 0xd3, 0xfa,
+		0xb3, 0xe8, 0xbf, 0x5f, 0x10, 0x8a, 0x83, 0xa3, 0x70, 0xc5, 0x81, 0x16, 0x1d, 0xae, 0xf6, 0x33,
+		0x50, 0xf8, 0x45, 0xa1, 0x38, 0x04, 0x0e, 0x27, 0x21, 0x0c, 0x86, 0x19, 0x48, 0xfc, 0x92, 0x20,
+		0x21, 0x70, 0x90, 0xc4, 0xb3, 0xee, 0x42, 0xdb, 0xd7, 0x77, 0xda, 0x96, 0xcd, 0x6f, 0xb1, 0xec,
+		0x4f, 0xea, 0x97, 0xbf, 0xe4, 0x37, 0xc2, 0x14, 0x0f, 0x2a, 0x6a, 0x22, 0xee, 0x9e, 0xa3, 0xee,
+		0xe7, 0xe9, 0x0d, 0xfb, 0x15, 0xa1, 0x89, 0x3c, 0x68, 0xd8, 0x36, 0x8f, 0x85, 0x88, 0x6c, 0x6f,
+		0xe2, 0x96, 0x6e, 0x06, 0x72, 0xff, 0x64, 0xa8, 0x71, 0x0d, 0x81, 0x8b, 0x34, 0x3d, 0xf6, 0xcf,
+		0xc0, 0xb8, 0xa6, 0xef, 0xcd, 0x24, 0x9d, 0xff, 0x74, 0xc8, 0xfe, 0xd9, 0x64, 0x98, 0x4c, 0x87,
+		0x64, 0x86, 0xec, 0x29, 0x32, 0xed, 0x8a, 0x5b, 0xf6, 0x5b, 0xde, 0xe4, 0xfd, 0xf5, 0x9b, 0x53,
+		0x2b, 0xab, 0x20, 0xf3, 0x1c, 0xd7, 0x80, 0x9d, 0x4a, 0xec, 0x03, 0x6f, 0x3a, 0x72, 0xee, 0xb3,
+		0x79, 0x56, 0x2e, 0x40, 0xca, 0x67, 0xf0, 0x4c, 0x27, 0xf5, 0x41, 0x4e, 0x2a, 0xe9, 0xb5, 0x77,
+		0x56, 0xce, 0x42, 0x08, 0x8d, 0x97, 0xe9, 0xe8, 0xdf, 0xc6, 0xd1, 0x29, 0xf8, 0xca, 0x53, 0x10,
+		0x13, 0x46, 0xcb, 0x74, 0xd4, 0x6f, 0xe7, 0xa8, 0x0e, 0x0a, 0xa2, 0x0b, 0x83, 0x65, 0x3a, 0xfa,
+		0xff, 0x2d, 0xd0, 0x05, 0x0a, 0xa2, 0xcf, 0xce, 0xc2, 0x5f, 0xff, 0x70, 0x88, 0xa1, 0x0b, 0x94,
+		0x15, 0x8c, 0xd1, 0x67, 0x96, 0xca, 0x74, 0xec, 0xef, 0xe0, 0x95, 0x0b, 0x8c, 0x95, 0xc7, 0x21,
+		0x3c, 0x23, 0xc3, 0x3f, 0xc2, 0x51, 0x19, 0xfc, 0x4a, 0x09, 0x12, 0x1e, 0xeb, 0x64, 0x3a, 0xfa,
+		0x77, 0x71, 0x74, 0x2f, 0x16, 0x36, 0x9d, 0x5b, 0x27, 0xd3, 0x09, 0xfc, 0x3f, 0xa2, 0xe9, 0x1c,
+		0x03, 0xd9, 0x26, 0x0c, 0x93, 0xe9, 0xd8, 0x1f, 0x15, 0x5c, 0x17, 0x28, 0x2b, 0x4f, 0x43, 0xdc,
+		0x59, 0x6c, 0xa6, 0xe3, 0x7f, 0x37, 0xc7, 0x77, 0x71, 0x90, 0x03, 0x03, 0xe3, 0x00, 0x24, 0xfe,
+		0x5f, 0xc1, 0x01, 0x0f, 0x16, 0x4e, 0xa3, 0x61, 0x03, 0x66, 0x3a, 0xa5, 0xef, 0x11, 0xd3, 0x68,
+		0xc8, 0x7e, 0xc1, 0xd1, 0xa4, 0x3a, 0x7f, 0x3a, 0x89, 0xef, 0x15, 0xa3, 0x49, 0xe1, 0xb1, 0x19,
+		0xc3, 0x16, 0xc1, 0x74, 0x1a, 0xdf, 0x2f, 0x9a, 0x31, 0x64, 0x10, 0xac, 0xac, 0x03, 0x19, 0xb5,
+		0x06, 0xa6, 0xd3, 0x7b, 0x95, 0xd3, 0x9b, 0x1f, 0x31, 0x06, 0x56, 0x9e, 0x83, 0xc3, 0xe3, 0x2d,
+		0x81, 0xe9, 0x54, 0x7f, 0xe0, 0xcd, 0xa1, 0xbd, 0x9b, 0xd7, 0x10, 0x58, 0xd9, 0x80, 0xc5, 0x71,
+		0x56, 0xc0, 0x74, 0xb2, 0x3f, 0xf8, 0xa6, 0x5f, 0x71, 0x7b, 0x8d, 0x80, 0x95, 0x02, 0x80, 0xbb,
+		0x00, 0x4f, 0xa7, 0xf5, 0x43, 0x9c, 0x96, 0x07, 0x09, 0xa7, 0x06, 0x5f, 0x7f, 0xa7, 0xe3, 0xdf,
+		0x12, 0x53, 0x83, 0x63, 0xe0, 0xd4, 0x10, 0x4b, 0xef, 0x74, 0xec, 0x1f, 0x16, 0x53, 0x43, 0xa0,
+		0xa0, 0x64, 0x7b, 0x56, 0xb7, 0xe9, 0x14, 0x3e, 0x21, 0x24, 0xdb, 0x83, 0xb5, 0x52, 0x83, 0xf9,
+		0x91, 0x05, 0x71, 0x3a, 0xa9, 0x1f, 0xe5, 0xa4, 0xe4, 0xe1, 0xf5, 0xd0, 0xbb, 0x78, 0xf1, 0xc5,
+		0x70, 0x3a, 0xb5, 0x1f, 0x1b, 0x5a, 0xbc, 0xf8, 0x5a, 0xb8, 0xf2, 0x24, 0xc4, 0x8c, 0x41, 0xa7,
+		0x83, 0x93, 0x87, 0xec, 0x7f, 0x8b, 0x31, 0xfb, 0x67, 0x5f, 0xe1, 0xdc, 0x11, 0x08, 0x2b, 0x67,
+		0x21, 0xac, 0x77, 0xb7, 0xf4, 0xd6, 0x34, 0xcc, 0x2f, 0x7e, 0x45, 0x28, 0x4c, 0x84, 0x5e, 0x79,
+		0x1a, 0x80, 0xb9, 0x46, 0x68, 0x24, 0xf0, 0x14, 0xdc, 0x3f, 0xff, 0x0a, 0xbf, 0x36, 0xe4, 0xa2,
+		0xb8, 0x04, 0xd8, 0x25, 0xa4, 0xfd, 0x09, 0x7c, 0xc9, 0x4f, 0x80, 0x8e, 0xc8, 0x79, 0x88, 0xe2,
+		0x01, 0xa0, 0xad, 0xed, 0x4c, 0xc3, 0xfe, 0x0b, 0x8e, 0x2d, 0xe0, 0x91, 0x61, 0x5d, 0xb3, 0xaf,
+		0xdb, 0xda, 0x8e, 0x35, 0x0d, 0xf7, 0xbf, 0x70, 0x5c, 0x07, 0x01, 0x91, 0x9b, 0x9a, 0x65, 0xcf,
+		0xd2, 0xef, 0xbf, 0x14, 0xc8, 0x02, 0x01, 0x1b, 0x8d, 0xbf, 0xaf, 0xe9, 0x7b, 0xd3, 0x70, 0xff,
+		0x4a, 0x34, 0x9a, 0xc3, 0xaf, 0x3c, 0x05, 0x71, 0xfc, 0xc9, 0xee, 0x02, 0x4e, 0x41, 0xfe, 0x6b,
+		0x8e, 0xec, 0x62, 0x60, 0xcd, 0x96, 0xdd, 0xb2, 0xdb, 0xd3, 0x99, 0xfd, 0x65, 0x3e, 0xd2, 0x02,
+		0x7e, 0xa5, 0x00, 0x09, 0xcb, 0x6e, 0xb5, 0x06, 0xdc, 0x3e, 0x9d, 0x82, 0xfe, 0x37, 0x5f, 0x71,
+		0x5c, 0x16, 0x0e, 0x0e, 0x8e, 0xf6, 0x8d, 0x6b, 0x76, 0xcf, 0xa4, 0xb1, 0x23, 0xd3, 0x28, 0xbc,
+		0xc9, 0x29, 0x78, 0x50, 0x56, 0x4a, 0x90, 0xc4, 0xbe, 0x88, 0x23, 0xf8, 0x69, 0x24, 0xfe, 0x96,
+		0x33, 0xc0, 0x87, 0x54, 0x7c, 0xdf, 0x6f, 0x7f, 0xee, 0xb8, 0xf4, 0xd9, 0xcf, 0x1d, 0x97, 0xfe,
+		0xf3, 0xe7, 0x8e, 0x4b, 0x1f, 0xfd, 0xfc, 0xf1, 0xb9, 0xcf, 0x7e, 0xfe, 0xf8, 0xdc, 0x1f, 0x7e,
+		0xfe, 0xf8, 0xdc, 0x78, 0x2f, 0x31, 0x5c, 0x34, 0x2f, 0x9a, 0xcc, 0x3f, 0xfc, 0xc2, 0x3d, 0x3b,
+		0x6d, 0x7b, 0x77, 0xb0, 0xb5, 0xdc, 0x34, 0xbb, 0xa7, 0x9b, 0xa6, 0xd5, 0x35, 0xad, 0xd3, 0x7e,
+		0xbf, 0x2e, 0xfd, 0x05, 0xff, 0x43, 0x82, 0xa3, 0x8c, 0x8c, 0xeb, 0xce, 0xd5, 0x8c, 0xbd, 0x49,
+		0xaf, 0xcb, 0x9c, 0x83, 0x60, 0xc1, 0xd8, 0x23, 0x47, 0x99, 0x82, 0x53, 0x07, 0xfd, 0x0e, 0xbf,
+		0x90, 0x16, 0xc5, 0xf4, 0x66, 0xbf, 0xe3, 0x0f, 0x46, 0x4e, 0xf2, 0x60, 0xe4, 0xe2, 0x77, 0x49,
+		0x07, 0xeb, 0x49, 0xac, 0x60, 0xec, 0xd1, 0x8e, 0xac, 0x4b, 0x2f, 0x3c, 0x38, 0xd5, 0xcf, 0x7d,
+		0xcd, 0x30, 0x6f, 0x18, 0xd8, 0xec, 0xde, 0x96, 0xf0, 0x71, 0x1f, 0x1f, 0xf6, 0x71, 0x3f, 0xa7,
+		0x77, 0x3a, 0xcf, 0x20, 0x1c, 0x1e, 0x8a, 0x5b, 0x5b, 0x11, 0x76, 0xf7, 0x19, 0xbe, 0x27, 0x00,
+		0xc7, 0x47, 0xdc, 0xd9, 0x5c, 0x08, 0x26, 0x31, 0x61, 0x05, 0x62, 0x65, 0x21, 0x5b, 0x59, 0x7c,
+		0xdb, 0xa5, 0x69, 0x1a, 0x2d, 0x16, 0x55, 0x1a, 0x54, 0x44, 0x12, 0x19, 0x61, 0x68, 0x86, 0x69,
+		0xf1, 0x2b, 0x9d, 0x2c, 0x51, 0xfc, 0xd8, 0x01, 0x19, 0x91, 0x12, 0x35, 0x09, 0x6e, 0x3c, 0x32,
+		0x23, 0x37, 0x44, 0x27, 0x7c, 0x9e, 0xff, 0x59, 0xb9, 0xf2, 0xfd, 0x01, 0x38, 0x31, 0xcc, 0x15,
+		0x9c, 0x59, 0x96, 0xad, 0x75, 0x7b, 0x93, 0xd8, 0xf2, 0x24, 0xc4, 0x37, 0x04, 0xcc, 0x81, 0xf9,
+		0x72, 0xeb, 0x80, 0x7c, 0x49, 0x3b, 0x55, 0x09, 0xc6, 0x9c, 0x99, 0x91, 0x31, 0x4e, 0x3f, 0x6e,
+		0x8b, 0x33, 0x1f, 0x8b, 0xc3, 0x51, 0x36, 0x9d, 0x54, 0x36, 0x95, 0x58, 0x82, 0xf3, 0x24, 0xe9,
+		0x2d, 0x9a, 0x7e, 0x4e, 0x92, 0x7f, 0x06, 0x16, 0xaa, 0xa8, 0x2d, 0x70, 0x17, 0xe4, 0x9e, 0xf0,
+		0x8c, 0xbd, 0xf5, 0xba, 0xe4, 0x33, 0xf8, 0xf9, 0x49, 0xa0, 0x37, 0x2b, 0xff, 0x2d, 0x12, 0xc8,
+		0x8d, 0xa6, 0xd6, 0xd1, 0xfa, 0x6f, 0x95, 0x14, 0x79, 0x1c, 0x80, 0xc5, 0x2c, 0x39, 0xef, 0xd5,
+		0xe0, 0x69, 0xb8, 0xb7, 0x73, 0xcb, 0xac, 0x26, 0x7a, 0x23, 0x24, 0x4e, 0x61, 0xf1, 0xe7, 0xa9,
+		0xe7, 0x01, 0xdc, 0x02, 0x8c, 0xf1, 0x68, 0x94, 0x0a, 0xab, 0x05, 0x45, 0x04, 0x9f, 0x34, 0xd6,
+		0x2b, 0x25, 0xf6, 0x7c, 0xc4, 0x1c, 0x06, 0x50, 0x78, 0x0b, 0x9d, 0x28, 0xfa, 0x43, 0x30, 0xef,
+		0xcd, 0x67, 0x77, 0xf9, 0x03, 0x2b, 0x97, 0xd0, 0x53, 0x80, 0x71, 0x93, 0xaa, 0xd6, 0x6a, 0xe9,
+		0x2d, 0xb5, 0x6d, 0x90, 0x29, 0xf7, 0x76, 0xb3, 0xbf, 0xf3, 0xef, 0xc3, 0xb4, 0x6b, 0x29, 0x86,
+		0x58, 0x40, 0xbc, 0xaa, 0x81, 0x36, 0x67, 0xbb, 0xdb, 0xeb, 0xe8, 0xf4, 0xb4, 0x57, 0x6d, 0x0b,
+		0xfe, 0x4f, 0x37, 0x67, 0x90, 0x1e, 0x9e, 0x1c, 0x2f, 0xb8, 0xe8, 0xce, 0xe8, 0xad, 0x3c, 0xe3,
+		0x46, 0x35, 0x39, 0x0d, 0x9c, 0x4a, 0xf1, 0x77, 0x79, 0x0b, 0x85, 0x37, 0x43, 0x34, 0x71, 0x15,
+		0xe6, 0xf1, 0xb2, 0x5b, 0xcf, 0xd7, 0xbe, 0x29, 0x2b, 0x88, 0xe8, 0xad, 0xcc, 0x31, 0xdd, 0xa6,
+		0x3d, 0x0e, 0x11, 0x8b, 0x0e, 0xca, 0x34, 0x12, 0xa2, 0x39, 0x1c, 0x1c, 0xdd, 0x5f, 0x4c, 0x0c,
+		0x9c, 0x1e, 0x4d, 0x21, 0xf0, 0xaf, 0x39, 0x81, 0x24, 0x45, 0x13, 0xbd, 0x31, 0x60, 0x9e, 0x3d,
+		0xd6, 0xa2, 0x7b, 0x7a, 0xb3, 0xbf, 0x17, 0xe5, 0x9f, 0x7d, 0xfa, 0x61, 0x7a, 0xc2, 0x7e, 0x97,
+		0x5f, 0xe8, 0xc6, 0x4c, 0x16, 0x45, 0xe6, 0xb4, 0xdd, 0xfe, 0xea, 0x90, 0x16, 0xf5, 0xf1, 0x7e,
+		0xef, 0x5f, 0xd9, 0xaf, 0xf2, 0xca, 0x8e, 0x8f, 0x93, 0x70, 0x4f, 0x4d, 0x29, 0x4e, 0x95, 0x15,
+		0xac, 0x14, 0x21, 0x85, 0x87, 0xf2, 0x2e, 0x73, 0xf6, 0xaf, 0xe5, 0xd7, 0x3e, 0xfd, 0x30, 0x9b,
+		0x68, 0x88, 0xc4, 0x59, 0x53, 0xac, 0x4c, 0xd2, 0x7a, 0x2f, 0x3c, 0x30, 0xba, 0x7e, 0xb3, 0x7f,
+		0x0f, 0x51, 0xea, 0x4f, 0x7a, 0x9b, 0xea, 0x6a, 0xa7, 0x10, 0xcc, 0xe3, 0x35, 0x72, 0xf3, 0x34,
+		0xfd, 0xcb, 0xb5, 0x52, 0x98, 0x26, 0x66, 0x38, 0xb6, 0x3d, 0xc7, 0x94, 0xc5, 0x74, 0xb9, 0xfd,
+		0xeb, 0xef, 0xfc, 0x89, 0xb0, 0xab, 0x50, 0x56, 0xd6, 0x46, 0x23, 0xfa, 0xa6, 0xd3, 0xf8, 0xb2,
+		0xa0, 0x31, 0x1c, 0xa0, 0xb7, 0xf2, 0x6e, 0x3c, 0x0b, 0xe5, 0x64, 0xa6, 0xd9, 0x6e, 0x82, 0x88,
+		0x83, 0x81, 0x96, 0x1b, 0x13, 0xda, 0x59, 0xec, 0xf4, 0x37, 0x05, 0x3e, 0xd3, 0x61, 0x18, 0x0f,
+		0xbb, 0x72, 0x11, 0xd2, 0x2d, 0xd3, 0xb0, 0x55, 0xb3, 0xdb, 0xb6, 0xf5, 0x6e, 0xcf, 0x9e, 0x6a,
+		0xf9, 0xfe, 0x2d, 0x23, 0x12, 0x53, 0x52, 0x88, 0x57, 0x17, 0x68, 0xd8, 0x12, 0xf6, 0xde, 0xcb,
+		0x2c, 0x2d, 0xf9, 0xaf, 0x4e, 0x4b, 0x28, 0x0e, 0xb6, 0xe4, 0xb6, 0xa4, 0xc3, 0x6a, 0x5d, 0xe3,
+		0xcb, 0x9d, 0x7d, 0x93, 0x49, 0x81, 0x23, 0x1d, 0xbf, 0x19, 0x82, 0xe3, 0x1c, 0x78, 0x4b, 0xb3,
+		0xf4, 0xd3, 0xd7, 0x1f, 0xd9, 0xd2, 0x6d, 0xed, 0x91, 0xd3, 0x4d, 0xb3, 0x2d, 0x6c, 0x9d, 0x05,
+		0x56, 0xbe, 0x8c, 0xe5, 0xcb, 0xbc, 0x3c, 0x37, 0x36, 0x20, 0x20, 0x37, 0x79, 0x19, 0xcc, 0x8d,
+		0xca, 0x60, 0xbe, 0x03, 0xa1, 0x92, 0xd9, 0xa6, 0x77, 0xd5, 0x5a, 0xba, 0x61, 0x76, 0xf9, 0x82,
+		0xc4, 0x12, 0xe4, 0x12, 0x44, 0xb4, 0xae, 0x39, 0x30, 0x6c, 0xb6, 0x18, 0x15, 0x1f, 0xfe, 0xed,
+		0xd7, 0x4e, 0xcc, 0xfd, 0xd1, 0x6b, 0x27, 0x0e, 0x31, 0xb2, 0x56, 0xeb, 0xda, 0x72, 0xdb, 0x3c,
+		0xdd, 0xd5, 0xec, 0x5d, 0x54, 0x01, 0x7f, 0xf0, 0x99, 0x87, 0x80, 0xd7, 0x57, 0x35, 0xec, 0x4f,
+		0xbd, 0xfe, 0xb3, 0xa7, 0x24, 0x85, 0xe3, 0xaf, 0x84, 0xde, 0xf8, 0xf8, 0x09, 0x29, 0xdf, 0x83,
+		0x68, 0x59, 0x6f, 0xee, 0x53, 0x61, 0x75, 0xa8, 0xc2, 0x47, 0x78, 0x85, 0xc7, 0x46, 0x2b, 0x64,
+		0x77, 0x60, 0xca, 0x7a, 0xd3, 0x53, 0x6d, 0x59, 0x6f, 0x0e, 0xd5, 0x58, 0x85, 0x58, 0xd5, 0xb0,
+		0xd9, 0xa3, 0x15, 0x4f, 0x41, 0xb0, 0x6d, 0xb0, 0xbb, 0xce, 0xf1, 0xe2, 0x03, 0x07, 0xe8, 0x8a,
+		0x82, 0x78, 0xf9, 0x3a, 0xc4, 0xca, 0x7a, 0x93, 0x91, 0x2a, 0x41, 0xb0, 0xa5, 0x37, 0xb3, 0xd2,
+		0xed, 0x36, 0x12, 0xb1, 0x8b, 0xe5, 0x3f, 0xfc, 0x93, 0xe3, 0x73, 0x2f, 0x7f, 0xee, 0xf8, 0xdc,
+		0x44, 0x71, 0xca, 0x4f, 0x17, 0x27, 0x47, 0x8a, 0x7e, 0x22, 0x04, 0x77, 0xd2, 0x57, 0x8f, 0xfa,
+		0xdd, 0xb6, 0x61, 0x9f, 0x6e, 0xf6, 0xf7, 0x7a, 0xb6, 0x89, 0x4a, 0xc5, 0xdc, 0xe6, 0x42, 0x34,
+		0xef, 0x16, 0x2f, 0xb3, 0xe2, 0xf1, 0x22, 0x94, 0xdf, 0x86, 0xf0, 0x3a, 0xe2, 0xe1, 0x20, 0xd9,
+		0xa6, 0xad, 0x75, 0xb8, 0xad, 0xc8, 0x12, 0x98, 0xcb, 0x5e, 0x4a, 0x0a, 0xb0, 0xdc, 0xb6, 0x78,
+		0x24, 0xa9, 0xa3, 0x6b, 0xdb, 0xec, 0xc1, 0x89, 0x20, 0xdd, 0x64, 0xc4, 0x30, 0x83, 0xbe, 0x2d,
+		0xb1, 0x08, 0x61, 0x6d, 0xc0, 0x02, 0xc3, 0x82, 0xb8, 0xfb, 0xa0, 0x89, 0xfc, 0x33, 0x10, 0xe5,
+		0x51, 0x0f, 0x18, 0x19, 0x75, 0x4d, 0xdf, 0xa3, 0xf5, 0x24, 0x15, 0xfc, 0x49, 0x96, 0x21, 0x4c,
+		0x1b, 0xcf, 0xef, 0xf9, 0x64, 0x97, 0x47, 0x5a, 0xbf, 0x4c, 0x1b, 0xa9, 0x30, 0xb0, 0xfc, 0x65,
+		0x88, 0x95, 0x4d, 0x94, 0x6c, 0x3f, 0xb5, 0x38, 0xa3, 0x46, 0xdb, 0xdc, 0x1b, 0xd8, 0xe2, 0x2e,
+		0x26, 0x4d, 0xe0, 0xcd, 0x66, 0xf6, 0x00, 0x09, 0x0f, 0x6e, 0xe3, 0xa9, 0x7c, 0x09, 0xa2, 0x94,
+		0x76, 0xbd, 0xe7, 0xbc, 0xea, 0x25, 0x79, 0x5e, 0xf5, 0xe2, 0xe4, 0x03, 0x6e, 0x63, 0x09, 0x84,
+		0x5a, 0x9a, 0xad, 0xf1, 0x7e, 0xd3, 0xdf, 0xf9, 0xf7, 0x40, 0x8c, 0x13, 0xb1, 0xc8, 0x19, 0x08,
+		0x9a, 0x3d, 0x11, 0xbb, 0x99, 0x9b, 0xd4, 0x95, 0x7a, 0xaf, 0x18, 0x42, 0x59, 0x52, 0x10, 0xb8,
+		0x58, 0x9b, 0x28, 0x16, 0x8f, 0xf9, 0xc4, 0xa2, 0xab, 0xdb, 0x5b, 0xdb, 0xb6, 0xfb, 0x83, 0x0d,
+		0xe7, 0x88, 0x28, 0x38, 0x82, 0x72, 0x2b, 0x00, 0xc7, 0x3d, 0xa5, 0xd7, 0xf5, 0x3e, 0xba, 0xfd,
+		0x98, 0x34, 0x71, 0x49, 0x21, 0x9e, 0x06, 0xf2, 0xf2, 0x09, 0xa2, 0xf2, 0x14, 0x04, 0x0b, 0xbd,
+		0x1e, 0xbe, 0xc1, 0x45, 0xd3, 0x4d, 0x93, 0xc9, 0x4a, 0x48, 0x71, 0xd2, 0x58, 0x66, 0x99, 0xdb,
+		0xf6, 0x0d, 0xad, 0xef, 0xbc, 0xcf, 0x25, 0xd2, 0xf9, 0xf3, 0x10, 0x2f, 0x99, 0x86, 0xa5, 0x1b,
+		0xd6, 0x80, 0xee, 0x40, 0xb6, 0x3a, 0x66, 0xf3, 0x1a, 0xa7, 0xc0, 0x12, 0xc8, 0x6c, 0xad, 0xd7,
+		0xa3, 0x98, 0x21, 0x05, 0x7f, 0xb2, 0x99, 0x5d, 0xac, 0x4f, 0x64, 0xcf, 0xd9, 0x83, 0xb1, 0x87,
+		0x77, 0xd0, 0xe1, 0xcf, 0x7f, 0x97, 0xe0, 0x8e, 0xd1, 0x89, 0x74, 0x4d, 0xdf, 0xb3, 0x0e, 0x3a,
+		0x8f, 0x9e, 0x87, 0xf8, 0x3a, 0x7d, 0x25, 0xf5, 0x19, 0x7d, 0x8f, 0xe4, 0x30, 0x8c, 0xf5, 0xcc,
+		0xd9, 0xb3, 0x8f, 0x9c, 0x67, 0x52, 0x7e, 0x69, 0x4e, 0x11, 0x19, 0xe4, 0x38, 0xc4, 0x2d, 0xbd,
+		0xd9, 0x3b, 0x73, 0xf6, 0xdc, 0xb5, 0x47, 0x98, 0x58, 0x5d, 0x9a, 0x53, 0xdc, 0xac, 0x95, 0x18,
+		0xf6, 0xf8, 0x8d, 0x4f, 0x9c, 0x90, 0x8a, 0x61, 0x08, 0x5a, 0x83, 0xee, 0xd7, 0x4c, 0x36, 0xfe,
+		0x2a, 0x02, 0x4b, 0x9e, 0x52, 0xb6, 0x60, 0xf1, 0x0b, 0x09, 0xce, 0xdb, 0xb6, 0xb2, 0xa7, 0xff,
+		0x14, 0x62, 0xc2, 0x4a, 0xb4, 0x2f, 0x17, 0xf3, 0x3f, 0x2f, 0x41, 0xf2, 0x8a, 0xa0, 0x8c, 0x97,
+		0x53, 0x9e, 0x04, 0x70, 0x6a, 0x12, 0x53, 0xe5, 0xd8, 0xf2, 0x70, 0x5d, 0xcb, 0x0e, 0x8e, 0xe2,
+		0x01, 0xc7, 0x5b, 0x57, 0xbd, 0xbe, 0xd9, 0x33, 0x2d, 0xfe, 0x56, 0xd3, 0x14, 0x54, 0x07, 0x18,
+		0xaf, 0x02, 0x51, 0xad, 0xa6, 0x5e, 0x37, 0x6d, 0x0c, 0xe8, 0xe9, 0x99, 0x37, 0xf8, 0x0b, 0x78,
+		0x41, 0x45, 0xa6, 0x25, 0x57, 0x68, 0xc1, 0x3a, 0xe6, 0x63, 0xa3, 0xe3, 0x0e, 0x15, 0xdc, 0x4c,
+		0x6b, 0xad, 0x56, 0x5f, 0xb7, 0x2c, 0xae, 0xb8, 0x44, 0x12, 0x1f, 0x88, 0xea, 0x0d, 0xb6, 0x54,
+		0xa1, 0x25, 0xf0, 0x89, 0xad, 0x31, 0x73, 0x5e, 0xc8, 0x06, 0x9f, 0xf5, 0x91, 0xde, 0x60, 0x0b,
+		0x25, 0xe5, 0x2e, 0x48, 0x8e, 0x69, 0x4c, 0xe2, 0xba, 0xdb, 0x0e, 0xfa, 0x30, 0x2f, 0xef, 0x81,
+		0xda, 0xeb, 0xb7, 0xcd, 0x7e, 0xdb, 0xde, 0xa3, 0x61, 0xa5, 0x41, 0x45, 0x16, 0x05, 0xeb, 0x3c,
+		0x3f, 0x7f, 0x0d, 0x32, 0x0d, 0xba, 0x35, 0x72, 0x5b, 0x7e, 0xd6, 0x6d, 0x9f, 0x34, 0xbd, 0x7d,
+		0x13, 0x5b, 0x16, 0x18, 0x69, 0xd9, 0xa9, 0xff, 0x20, 0x41, 0xa2, 0x88, 0x13, 0xb7, 0x5a, 0xbe,
+		0xd0, 0xd1, 0x76, 0xc8, 0x23, 0x70, 0xa8, 0xb8, 0x5a, 0x2f, 0x3d, 0xa3, 0x56, 0xcb, 0xea, 0x85,
+		0xd5, 0x82, 0xe7, 0x02, 0x40, 0xee, 0xf0, 0x2b, 0xb7, 0x96, 0x88, 0x07, 0x76, 0xd3, 0xa0, 0x9e,
+		0x00, 0x72, 0x1a, 0x16, 0xfd, 0x28, 0x85, 0x62, 0x03, 0xaf, 0x9d, 0x49, 0xb9, 0x43, 0xaf, 0xdc,
+		0x5a, 0x9a, 0xf7, 0x60, 0x14, 0xb6, 0x2c, 0xdd, 0xb0, 0x47, 0x11, 0x4a, 0xf5, 0xb5, 0x35, 0xbc,
+		0x85, 0x31, 0x82, 0x80, 0xc1, 0xc4, 0x6d, 0x9b, 0xdc, 0x0f, 0xf3, 0x7e, 0x84, 0x5a, 0x75, 0x55,
+		0x0e, 0xe6, 0xc8, 0x2b, 0xb7, 0x96, 0xd2, 0x1e, 0xe8, 0x5a, 0xbb, 0x93, 0x8b, 0x7d, 0xe8, 0xc7,
+		0x8e, 0xcf, 0x7d, 0xea, 0xff, 0x3b, 0x2e, 0x15, 0xd7, 0x26, 0xce, 0xb9, 0x47, 0x0f, 0x36, 0xe7,
+		0xfc, 0xeb, 0xf6, 0x57, 0xee, 0x80, 0x3b, 0x86, 0x0b, 0x7d, 0xca, 0x78, 0xd6, 0xe9, 0x36, 0xcd,
+		0x33, 0x94, 0xdb, 0xdf, 0x3c, 0xc8, 0x4d, 0x59, 0x14, 0x72, 0x53, 0x15, 0x43, 0xfe, 0x3c, 0xa4,
+		0xf0, 0x62, 0x5a, 0x43, 0xb7, 0x2f, 0xe9, 0x5a, 0x4b, 0xef, 0xfb, 0xed, 0x87, 0x94, 0xb0, 0x1f,
+		0x08, 0x84, 0xa8, 0x91, 0xc0, 0xd6, 0x4f, 0xfa, 0x3b, 0xbf, 0x0b, 0x21, 0x44, 0x75, 0x6d, 0x0b,
+		0x8e, 0x41, 0x13, 0x98, 0xbb, 0xb5, 0x67, 0xf3, 0x3b, 0xbf, 0x49, 0x85, 0x25, 0xc8, 0x63, 0xc2,
+		0x42, 0x08, 0xee, 0x6f, 0x21, 0xf0, 0xe9, 0xc5, 0xed, 0x84, 0x0e, 0x44, 0xf9, 0x10, 0x3b, 0x0d,
+		0x91, 0xdc, 0x86, 0x90, 0x35, 0xc8, 0xf4, 0xb4, 0xbe, 0x4d, 0x5f, 0xc8, 0xd9, 0xa5, 0xbd, 0xe0,
+		0x33, 0xf8, 0xc4, 0xa8, 0x3e, 0xf1, 0x75, 0x96, 0xd7, 0x92, 0xea, 0x79, 0x33, 0xf3, 0x5f, 0x08,
+		0x41, 0x84, 0x33, 0xe3, 0x29, 0x88, 0x72, 0xb6, 0xf2, 0x39, 0x77, 0xe7, 0xf2, 0xe8, 0x32, 0xbb,
+		0xec, 0x2c, 0x87, 0x9c, 0x9e, 0xc0, 0x21, 0xf7, 0x42, 0xac, 0xb9, 0xab, 0xb5, 0x0d, 0xb5, 0xdd,
+		0xe2, 0xc6, 0x71, 0xe2, 0x73, 0xaf, 0x9d, 0x88, 0x96, 0x30, 0xaf, 0x5a, 0x56, 0xa2, 0xb4, 0xb0,
+		0xda, 0x42, 0x9b, 0x66, 0x57, 0x6f, 0xef, 0xec, 0xda, 0x5c, 0x6f, 0xf0, 0x14, 0xbe, 0x35, 0x8e,
+		0x02, 0xc1, 0xaf, 0x40, 0xe4, 0x46, 0x76, 0x3d, 0x8e, 0xe3, 0xae, 0x18, 0xc3, 0x8a, 0x3f, 0xfa,
+		0x9f, 0x4e, 0x48, 0x0a, 0xc5, 0x20, 0x25, 0x48, 0x75, 0x34, 0xcb, 0x56, 0xe9, 0x7a, 0x8c, 0xd5,
+		0xb3, 0xbb, 0x0f, 0x47, 0x47, 0x19, 0xc2, 0x19, 0xcb, 0x9b, 0x9e, 0x40, 0x2c, 0x96, 0xd5, 0xc2,
+		0x37, 0xa3, 0x28, 0x91, 0x26, 0x9d, 0x81, 0xcc, 0x4a, 0x8c, 0x50, 0xbe, 0xa7, 0x31, 0x9f, 0x4d,
+		0x4c, 0x6a, 0x2b, 0x1e, 0x83, 0x38, 0x7d, 0x06, 0x8a, 0x82, 0xb0, 0x8b, 0x94, 0x31, 0xcc, 0xa0,
+		0x85, 0xf7, 0x41, 0xc6, 0xd5, 0xfa, 0x0c, 0x24, 0xc6, 0xa8, 0xb8, 0xd9, 0x14, 0xf0, 0x61, 0x58,
+		0x34, 0xf4, 0x9b, 0xb6, 0x3a, 0x0c, 0x1d, 0xa7, 0xd0, 0x04, 0xcb, 0xae, 0xf8, 0x31, 0xee, 0x81,
+		0x74, 0x53, 0x30, 0x9f, 0xc1, 0x02, 0x85, 0x4d, 0x39, 0xb9, 0x14, 0xec, 0x28, 0xc4, 0xb4, 0x5e,
+		0x8f, 0x01, 0x24, 0xb8, 0xd6, 0xef, 0xf5, 0x68, 0xd1, 0x29, 0x98, 0xa7, 0x7d, 0xec, 0xeb, 0x16,
+		0x5e, 0xae, 0x61, 0x30, 0x49, 0x0a, 0x93, 0xc1, 0x02, 0x85, 0xe5, 0x53, 0xd8, 0xbb, 0x21, 0xa5,
+		0x5f, 0xc7, 0x5b, 0xac, 0x4d, 0x9d, 0xc1, 0xa5, 0x28, 0x5c, 0x52, 0x64, 0x52, 0xa0, 0xfb, 0xc1,
+		0xd1, 0xe6, 0xaa, 0x58, 0x69, 0xd2, 0x8c, 0x9e, 0xc8, 0x2f, 0xb0, 0xec, 0x7c, 0x16, 0x42, 0x65,
+		0xcd, 0xd6, 0xd0, 0x5c, 0xb2, 0x6f, 0xb2, 0xe5, 0x33, 0xa9, 0xe0, 0xcf, 0xfc, 0x2f, 0x05, 0x21,
+		0x74, 0xc5, 0xb4, 0x75, 0xf2, 0xa8, 0xc7, 0x94, 0x4d, 0x8f, 0x93, 0xe7, 0x46, 0x7b, 0xc7, 0xd0,
+		0x5b, 0x6b, 0xd6, 0x8e, 0xe7, 0xe9, 0x55, 0x57, 0x9c, 0x02, 0x3e, 0x71, 0x5a, 0x84, 0x70, 0xdf,
+		0x1c, 0x18, 0x2d, 0x71, 0xc1, 0x81, 0x26, 0x48, 0x05, 0x62, 0x8e, 0x94, 0x84, 0xa6, 0x49, 0x49,
+		0x06, 0xa5, 0x04, 0x65, 0x98, 0x67, 0x28, 0xd1, 0x2d, 0x2e, 0x2c, 0x45, 0x88, 0x3b, 0xca, 0x2b,
+		0x1b, 0x3e, 0x80, 0xc0, 0xba, 0x68, 0xb8, 0x44, 0x3a, 0x63, 0xef, 0x30, 0x8f, 0x49, 0x9c, 0xec,
+		0x14, 0x70, 0xee, 0xf9, 0xc4, 0x8a, 0x3f, 0x03, 0x1b, 0xa5, 0xfd, 0x72, 0xc5, 0x8a, 0x3d, 0x05,
+		0x7b, 0x07, 0xc6, 0x41, 0xee, 0x18, 0xf4, 0xf2, 0x0e, 0x97, 0x3c, 0x37, 0x03, 0x4b, 0xdd, 0xeb,
+		0xb8, 0x4c, 0xd2, 0xdc, 0x0c, 0x7c, 0x54, 0xc5, 0x49, 0xa8, 0x2e, 0x15, 0x26, 0x65, 0xc4, 0x29,
+		0x6a, 0x88, 0x92, 0xfc, 0xaf, 0x4b, 0x10, 0xe1, 0x2b, 0x96, 0x3b, 0x0c, 0xd2, 0xf8, 0x61, 0x08,
+		0x4c, 0x1a, 0x86, 0xe0, 0xed, 0x0f, 0x43, 0x01, 0xc0, 0x69, 0xa6, 0xc5, 0x1f, 0xfb, 0x1c, 0x63,
+		0x56, 0xb1, 0x26, 0x36, 0xda, 0x3b, 0x7c, 0xde, 0x7b, 0x90, 0xf2, 0x7f, 0x2c, 0x41, 0xdc, 0x29,
+		0x27, 0x05, 0x48, 0x89, 0x76, 0xa9, 0xdb, 0x1d, 0x6d, 0x87, 0x8b, 0xe2, 0x9d, 0x13, 0x1b, 0x87,
+		0xab, 0xb0, 0x92, 0xe0, 0xed, 0xc1, 0xc4, 0xf8, 0x61, 0x0d, 0x4c, 0x18, 0x56, 0x9f, 0x1c, 0x05,
+		0x6f, 0x4f, 0x8e, 0x7c, 0x23, 0x1e, 0x1a, 0x1a, 0xf1, 0xfc, 0x9f, 0x48, 0xfc, 0x99, 0xf1, 0x96,
+		0xde, 0xfa, 0x5f, 0x39, 0x54, 0x2f, 0x70, 0xd9, 0x42, 0x97, 0xe7, 0xc8, 0x98, 0xdd, 0x3d, 0x4a,
+		0xd1, 0xdf, 0x66, 0x77, 0xec, 0x88, 0xa0, 0xd2, 0x70, 0xc7, 0xf0, 0x33, 0x01, 0x98, 0x1f, 0x81,
+		0xff, 0xfb, 0x37, 0x96, 0xfe, 0xd9, 0x1b, 0x9e, 0x71, 0xf6, 0x46, 0x26, 0xce, 0xde, 0x4f, 0x07,
+		0xa8, 0x03, 0xa0, 0x67, 0x5a, 0x5a, 0xe7, 0xeb, 0xa1, 0x7b, 0x8f, 0x41, 0xbc, 0x67, 0x76, 0x54,
+		0x56, 0xc2, 0xae, 0x98, 0xc5, 0x7a, 0x66, 0x47, 0x19, 0x11, 0xb3, 0xf0, 0xdb, 0xa4, 0x98, 0x23,
+		0x6f, 0xc3, 0x20, 0x44, 0x87, 0x27, 0x54, 0x1f, 0x92, 0x8c, 0x15, 0xdc, 0x6a, 0x7a, 0x18, 0x79,
+		0x80, 0xbf, 0xb2, 0xd2, 0xa8, 0x95, 0xc7, 0x9a, 0xcd, 0x20, 0x95, 0xc8, 0xae, 0x83, 0xc1, 0x8c,
+		0x8c, 0x6c, 0x60, 0x12, 0x06, 0x93, 0x62, 0x85, 0xc3, 0xe5, 0xbf, 0x4f, 0x02, 0x58, 0x45, 0xce,
+		0xd2, 0xfe, 0xa2, 0xbd, 0x63, 0xd1, 0x26, 0xa8, 0xbe, 0x9a, 0x8f, 0x4f, 0x1a, 0x34, 0x5e, 0x7f,
+		0xd2, 0xf2, 0xb6, 0xbb, 0x04, 0x29, 0x57, 0xb6, 0x2d, 0x5d, 0x34, 0xe6, 0xf8, 0x3e, 0xbb, 0x52,
+		0xbc, 0x33, 0x9a, 0xbc, 0xee, 0x49, 0xe5, 0xff, 0xb9, 0x04, 0x71, 0xda, 0x26, 0x7c, 0xfe, 0xd2,
+		0x37, 0x86, 0xd2, 0xed, 0x8f, 0xe1, 0x9d, 0x00, 0x8c, 0x0c, 0xc6, 0x1f, 0x71, 0xc9, 0x8a, 0xd3,
+		0x1c, 0x8c, 0x2a, 0x22, 0xe7, 0x1c, 0x86, 0x07, 0xf7, 0x67, 0xb8, 0xd8, 0xb5, 0x72, 0xb6, 0x1f,
+		0x81, 0x28, 0x7d, 0xc6, 0xe1, 0xa6, 0xc5, 0x37, 0xa2, 0xf8, 0x58, 0xf2, 0xc6, 0x4d, 0x2b, 0xff,
+		0x22, 0x44, 0x37, 0x6e, 0x32, 0x7f, 0xe2, 0x31, 0x88, 0xf7, 0x4d, 0x93, 0x5b, 0x7f, 0xcc, 0xea,
+		0x8e, 0x61, 0x06, 0x35, 0x76, 0x84, 0x0f, 0x2d, 0xe0, 0xfa, 0xd0, 0x5c, 0x27, 0x60, 0x70, 0x26,
+		0x27, 0x20, 0xee, 0x3e, 0x53, 0xbe, 0x99, 0x44, 0x1e, 0x84, 0x23, 0x8d, 0xea, 0xc5, 0x5a, 0xa5,
+		0xac, 0xae, 0x35, 0x2e, 0x0e, 0x5d, 0xb6, 0xcf, 0x65, 0x5e, 0xb9, 0xb5, 0x94, 0xe0, 0xdb, 0xce,
+		0x49, 0xd0, 0xeb, 0x4a, 0xe5, 0x4a, 0x7d, 0xa3, 0x22, 0x4b, 0x0c, 0x7a, 0xbd, 0xaf, 0x5f, 0x37,
+		0x6d, 0xf6, 0x0d, 0x9a, 0x87, 0xe1, 0xe8, 0x18, 0x68, 0x67, 0xf3, 0x39, 0xff, 0xca, 0xad, 0xa5,
+		0xd4, 0x7a, 0x5f, 0x67, 0x52, 0x46, 0x31, 0x96, 0x21, 0x3b, 0x8a, 0x51, 0x5f, 0xaf, 0x37, 0x0a,
+		0xab, 0xf2, 0x52, 0x4e, 0x7e, 0xe5, 0xd6, 0x52, 0x52, 0xa8, 0x0c, 0x84, 0xff, 0x3a, 0xec, 0x3e,
+		0x63, 0x70, 0xe7, 0x70, 0xe1, 0xe9, 0x9e, 0xd6, 0xd7, 0xba, 0x07, 0xdd, 0x7e, 0x4e, 0x09, 0xd7,
+		0xc8, 0xff, 0x4d, 0x00, 0x32, 0xce, 0xe6, 0x66, 0x9d, 0xd6, 0x40, 0x1e, 0xf5, 0x7a, 0xfc, 0x12,
+		0x13, 0xd7, 0x0e, 0x06, 0x2d, 0x1c, 0x82, 0x78, 0x0c, 0xc5, 0x8d, 0x64, 0x3e, 0xa9, 0x96, 0xc6,
+		0xac, 0x6f, 0x1c, 0x82, 0xa3, 0x3a, 0x18, 0xe4, 0x69, 0x88, 0x3b, 0x53, 0xcc, 0x79, 0xbc, 0x7b,
+		0xf2, 0x9c, 0xe4, 0xf8, 0x2e, 0x0e, 0xbe, 0xa7, 0x2d, 0xb6, 0x71, 0xa1, 0x49, 0x1b, 0xc3, 0x2b,
+		0x0c, 0x80, 0x23, 0x0b, 0x78, 0xf2, 0x30, 0x84, 0xb4, 0xad, 0x66, 0x3b, 0x1b, 0x1e, 0x75, 0xb9,
+		0x30, 0xbc, 0x42, 0xb1, 0x54, 0xe5, 0x48, 0x14, 0x12, 0x5b, 0xab, 0x0d, 0xec, 0x5d, 0xe6, 0xdf,
+		0x89, 0x4c, 0x6a, 0x6d, 0x41, 0x80, 0x88, 0xd6, 0x3a, 0x38, 0xf9, 0x2a, 0xf7, 0xc6, 0x70, 0x86,
+		0xd3, 0xf7, 0x54, 0x6f, 0xaa, 0x6c, 0x33, 0xcd, 0xcc, 0x93, 0x58, 0x57, 0xbb, 0x59, 0xc4, 0x34,
+		0xce, 0x60, 0x2c, 0xdc, 0xe1, 0x0f, 0x9b, 0x05, 0x95, 0x48, 0x57, 0xbb, 0x79, 0x51, 0xc3, 0x9b,
+		0xeb, 0x41, 0x39, 0x94, 0xff, 0x49, 0x34, 0x75, 0x7c, 0x6c, 0x25, 0x0f, 0x00, 0x41, 0x0c, 0x3c,
+		0x60, 0xc4, 0xb9, 0x4f, 0xc7, 0x47, 0xd0, 0xc5, 0x47, 0x01, 0x0a, 0x3b, 0x7a, 0x6d, 0xd0, 0xa5,
+		0x0d, 0xb0, 0x08, 0x9e, 0x46, 0x72, 0x60, 0x21, 0x1a, 0x7c, 0xfc, 0x8e, 0x8e, 0x7e, 0x0e, 0x86,
+		0x03, 0xb0, 0x15, 0xe4, 0x55, 0x5c, 0x41, 0xd2, 0x8c, 0x9e, 0x28, 0xf1, 0x77, 0x25, 0xe8, 0xef,
+		0x4a, 0xfe, 0x69, 0xc8, 0x0c, 0x0d, 0x21, 0xc9, 0x43, 0x8a, 0xbb, 0xbc, 0x68, 0xf4, 0x04, 0xdb,
+		0x22, 0xc5, 0x95, 0x04, 0x73, 0x6d, 0xd1, 0x68, 0x92, 0x95, 0xd8, 0x2f, 0xa3, 0x9f, 0x15, 0xcf,
+		0x8d, 0x1e, 0x80, 0x94, 0x6f, 0x10, 0x85, 0x1b, 0x5a, 0x72, 0xdd, 0xd0, 0x2e, 0xf0, 0x0b, 0x90,
+		0x44, 0x0d, 0xa6, 0xb7, 0x38, 0xec, 0xbd, 0x90, 0x61, 0x1a, 0x76, 0x98, 0xd7, 0xcc, 0x62, 0x5a,
+		0x13, 0x0c, 0xcf, 0x43, 0xca, 0x85, 0x73, 0xd9, 0x9e, 0x10, 0x50, 0x17, 0x35, 0x2b, 0x5f, 0x07,
+		0x70, 0xa5, 0x82, 0x14, 0xe0, 0x4e, 0xd4, 0x3e, 0xde, 0x68, 0x5f, 0xfe, 0x60, 0xb4, 0xcf, 0xe4,
+		0xcc, 0x21, 0x90, 0x1b, 0xd1, 0xcb, 0x9e, 0x8d, 0xbe, 0x44, 0x21, 0xf2, 0xa7, 0x21, 0x33, 0x24,
+		0x2f, 0xb8, 0x22, 0xbb, 0x52, 0xc6, 0x0e, 0x3a, 0xdc, 0x8c, 0xe2, 0xb3, 0x9f, 0xfa, 0xdc, 0x71,
+		0xe9, 0x6b, 0xa3, 0x7d, 0xfe, 0xe3, 0x55, 0x38, 0xe6, 0x29, 0x44, 0x91, 0xf7, 0xb9, 0xbe, 0x32,
+		0x6e, 0xe1, 0x32, 0x16, 0x4e, 0x73, 0x61, 0xed, 0xeb, 0x71, 0xce, 0xed, 0xaf, 0xe8, 0xa6, 0xfb,
+		0xb7, 0xa6, 0x7b, 0xd8, 0xc6, 0x1f, 0x03, 0xfc, 0x5a, 0x1c, 0xa2, 0x8a, 0xfe, 0xd2, 0x40, 0xb7,
+		0xf0, 0x31, 0x8b, 0x90, 0xde, 0xdc, 0x35, 0xc7, 0x79, 0x5d, 0xb1, 0x83, 0xcb, 0x1c, 0xae, 0xd2,
+		0xdc, 0x35, 0x2f, 0xcd, 0x29, 0x14, 0x96, 0x9c, 0x85, 0xf0, 0x76, 0x67, 0xc0, 0x1d, 0x66, 0x43,
+		0x5a, 0xd2, 0x8b, 0x74, 0x01, 0x81, 0x2e, 0xcd, 0x29, 0x0c, 0x1a, 0xab, 0xa2, 0x9f, 0x04, 0x0b,
+		0xee, 0x5f, 0x15, 0x3e, 0x28, 0x80, 0x55, 0x21, 0x2c, 0x29, 0x02, 0xb4, 0x8d, 0xb6, 0xad, 0x52,
+		0x67, 0x52, 0x36, 0x3c, 0xaa, 0x70, 0xfc, 0x98, 0x6d, 0x9b, 0xba, 0x9f, 0xf0, 0xb0, 0xa2, 0x2d,
+		0x12, 0xd8, 0xdc, 0x97, 0x06, 0x7a, 0x5f, 0xe8, 0xab, 0x89, 0xcd, 0x7d, 0x16, 0x81, 0xb0, 0xb9,
+		0x14, 0x1a, 0xd5, 0x3a, 0x7b, 0x72, 0xde, 0xbe, 0x99, 0x8d, 0x8d, 0x2a, 0x56, 0x2f, 0x26, 0x7d,
+		0x77, 0x7e, 0xe3, 0x26, 0x9e, 0xa0, 0x34, 0xd9, 0x4f, 0xf2, 0x84, 0x63, 0xf4, 0x25, 0x46, 0xed,
+		0x2c, 0x1f, 0x2e, 0x73, 0x34, 0xcd, 0x09, 0xe3, 0x8f, 0xd4, 0x20, 0xdd, 0x69, 0x5b, 0xb6, 0x6a,
+		0x19, 0x5a, 0xcf, 0xda, 0x35, 0x6d, 0xf1, 0xe6, 0xf3, 0x3d, 0x93, 0x28, 0xac, 0xb6, 0x2d, 0xbb,
+		0x21, 0x80, 0x2f, 0xcd, 0x29, 0xa9, 0x8e, 0x37, 0x03, 0xe9, 0x99, 0xdb, 0xdb, 0x7a, 0xdf, 0x21,
+		0x98, 0x4d, 0xed, 0x4f, 0xaf, 0x8e, 0xd0, 0x02, 0x1f, 0xe9, 0x99, 0xde, 0x0c, 0xf2, 0x0d, 0xb0,
+		0xd0, 0x31, 0xb5, 0x96, 0x43, 0x4e, 0x6d, 0xee, 0x0e, 0x8c, 0x6b, 0xd4, 0x0d, 0x84, 0x1f, 0xff,
+		0x98, 0xd4, 0x48, 0x53, 0x6b, 0x09, 0x12, 0x25, 0x44, 0xb8, 0x34, 0xa7, 0xcc, 0x77, 0x86, 0x33,
+		0xc9, 0x7b, 0x61, 0x51, 0xeb, 0xf5, 0x3a, 0x7b, 0xc3, 0xd4, 0x33, 0x94, 0xfa, 0xa9, 0x49, 0xd4,
+		0x0b, 0x88, 0x33, 0x4c, 0x9e, 0x68, 0x23, 0xb9, 0x64, 0x03, 0x1d, 0x58, 0x3a, 0xbd, 0x61, 0xd7,
+		0xe3, 0x76, 0x0d, 0x7d, 0x50, 0x19, 0xbf, 0xb5, 0x37, 0x81, 0xf6, 0x3a, 0x83, 0x17, 0x66, 0xd0,
+		0xa5, 0x39, 0xf4, 0x75, 0xf9, 0xb2, 0x18, 0x55, 0xb3, 0xa9, 0xd3, 0xd7, 0xe7, 0x39, 0xd5, 0xf9,
+		0x69, 0x54, 0x29, 0xbc, 0x9f, 0xaa, 0x2f, 0x8b, 0x54, 0x20, 0xc1, 0x36, 0xbf, 0x78, 0x14, 0xa4,
+		0xd3, 0x57, 0xfd, 0x13, 0x67, 0xf2, 0x13, 0x67, 0x28, 0x05, 0x45, 0xbf, 0xda, 0xa5, 0x39, 0x05,
+		0x74, 0x27, 0x45, 0x34, 0x38, 0x44, 0x5f, 0xa4, 0xde, 0x53, 0xfd, 0x9a, 0x3a, 0xbb, 0x40, 0x09,
+		0x3e, 0x30, 0x89, 0x20, 0xfd, 0xd2, 0xd3, 0xde, 0x15, 0xaf, 0xe2, 0xbe, 0x34, 0xa7, 0x2c, 0x5c,
+		0x1f, 0xcd, 0x46, 0x11, 0xdb, 0x6e, 0x1b, 0x5a, 0x07, 0xbf, 0xb6, 0xc0, 0xec, 0xa7, 0xc5, 0xfd,
+		0x45, 0xec, 0x02, 0x87, 0xa6, 0x2b, 0x31, 0x8a, 0xd8, 0xb6, 0x37, 0xa3, 0x18, 0xe5, 0xb1, 0xc1,
+		0xce, 0x4b, 0xad, 0x51, 0x39, 0xc6, 0xde, 0x13, 0xbf, 0x1c, 0x8a, 0x81, 0x9c, 0xc8, 0xdf, 0x07,
+		0x09, 0x8f, 0x62, 0xc2, 0x73, 0x2f, 0x1e, 0x1b, 0x24, 0xa2, 0x8c, 0x79, 0x32, 0x9f, 0x86, 0xa4,
+		0x57, 0x19, 0xe5, 0x3f, 0x2a, 0x41, 0xc2, 0xa3, 0x67, 0x10, 0xd3, 0xeb, 0x03, 0x8f, 0xbb, 0xb6,
+		0xd1, 0xdd, 0x62, 0x2d, 0x14, 0xe5, 0xec, 0xc0, 0x37, 0x49, 0x33, 0xf9, 0x52, 0x8c, 0xcf, 0xae,
+		0xf4, 0xce, 0xf4, 0x1c, 0x90, 0x20, 0x05, 0x81, 0xde, 0x99, 0x9e, 0x00, 0xb8, 0x0b, 0x92, 0xd8,
+		0x6f, 0xd5, 0x6b, 0xa1, 0xc5, 0x95, 0x04, 0xe6, 0x71, 0x90, 0xfc, 0xef, 0x05, 0x40, 0x1e, 0x56,
+		0x60, 0x8e, 0x73, 0x5c, 0x3a, 0xb0, 0x73, 0xfc, 0xe8, 0xb0, 0x5b, 0xde, 0xf5, 0xc4, 0xaf, 0x82,
+		0xec, 0x3a, 0x94, 0xd9, 0x52, 0x33, 0xd9, 0xe2, 0x1c, 0x32, 0x8d, 0x95, 0x4c, 0xd3, 0x9f, 0x41,
+		0x2e, 0xf8, 0x8e, 0x47, 0xc5, 0x37, 0x33, 0x87, 0x07, 0xdc, 0xb1, 0x7a, 0x36, 0x7b, 0x2d, 0xcd,
+		0xd6, 0x85, 0x47, 0xce, 0xc5, 0x44, 0xe3, 0x04, 0xfd, 0xd7, 0x96, 0xad, 0xd9, 0x3a, 0x37, 0x4e,
+		0x98, 0xaf, 0x23, 0xa5, 0xf5, 0x7a, 0xf4, 0x13, 0x63, 0xcc, 0x38, 0xb9, 0x07, 0xd2, 0xa8, 0xd3,
+		0xdb, 0x5a, 0x47, 0xd8, 0x16, 0x11, 0x66, 0xc3, 0xf0, 0x5c, 0x6e, 0x4e, 0xb4, 0x20, 0xe9, 0xd5,
+		0xe7, 0xce, 0x2e, 0x4e, 0xf2, 0xec, 0xe2, 0x08, 0x7f, 0x45, 0x87, 0xf1, 0x47, 0xbc, 0x3c, 0x34,
+		0xfe, 0x98, 0x62, 0x91, 0xee, 0xf8, 0xae, 0x33, 0xf7, 0x4c, 0x4c, 0x61, 0x89, 0xbc, 0x02, 0x69,
+		0xbf, 0xee, 0x27, 0x69, 0x08, 0xd8, 0x37, 0x79, 0x2d, 0x01, 0xfb, 0x26, 0xda, 0xd6, 0x9e, 0x17,
+		0x04, 0x47, 0x57, 0x3b, 0x8e, 0xe7, 0x7a, 0x57, 0xf2, 0x19, 0x48, 0x09, 0x9a, 0xcc, 0x0d, 0x70,
+		0x18, 0x16, 0xc7, 0xa9, 0xf8, 0xfc, 0x2e, 0x2c, 0x8e, 0x53, 0xd5, 0xe4, 0x2c, 0xc4, 0x1c, 0x1d,
+		0x3f, 0x66, 0x3f, 0x4e, 0xab, 0x15, 0xc0, 0x8a, 0x03, 0xea, 0x3b, 0x40, 0x08, 0xf8, 0x0e, 0x10,
+		0xf2, 0xef, 0x83, 0xec, 0x24, 0xfd, 0x3d, 0xe4, 0x56, 0x0c, 0x39, 0x0c, 0x3b, 0x0c, 0x11, 0xfe,
+		0x60, 0x5e, 0x80, 0x1e, 0x99, 0xf1, 0x14, 0x32, 0x92, 0xe9, 0xf2, 0x20, 0xcd, 0x66, 0x89, 0xbc,
+		0x0a, 0x47, 0x27, 0xea, 0xf0, 0xc9, 0x87, 0x6f, 0x8c, 0x10, 0x3f, 0x7c, 0x6b, 0x8a, 0xe6, 0x58,
+		0xb4, 0xaf, 0x22, 0x74, 0x86, 0xa5, 0xf2, 0xaf, 0x06, 0xe1, 0xf0, 0x78, 0x4d, 0x4e, 0x96, 0x20,
+		0x89, 0x86, 0xae, 0xed, 0xb7, 0x89, 0xa1, 0xab, 0xdd, 0xdc, 0xe0, 0x06, 0x31, 0x3f, 0xbc, 0x08,
+		0x38, 0x87, 0x17, 0x64, 0x13, 0xe6, 0x3b, 0x66, 0x53, 0xeb, 0xa8, 0x9e, 0xc3, 0x23, 0x3e, 0x89,
+		0xee, 0x1e, 0x61, 0xb6, 0xdf, 0x49, 0x89, 0x0a, 0x87, 0xcb, 0x7f, 0x86, 0xd2, 0x58, 0x75, 0xce,
+		0x99, 0x48, 0x19, 0x12, 0xdd, 0xb6, 0xb5, 0xa5, 0xef, 0x6a, 0xd7, 0xdb, 0x66, 0x9f, 0xcf, 0xa6,
+		0x51, 0xa1, 0x59, 0x73, 0x61, 0xc4, 0x99, 0x96, 0x07, 0xcd, 0x33, 0x24, 0xe1, 0xb1, 0x47, 0x6d,
+		0x91, 0x03, 0x6b, 0x93, 0x49, 0xa7, 0x56, 0xd1, 0x89, 0xa7, 0x56, 0xe3, 0x8e, 0x88, 0x62, 0xe3,
+		0x8f, 0x88, 0x3e, 0xe4, 0x1d, 0x1a, 0xff, 0xda, 0x37, 0x72, 0x6a, 0x44, 0x1a, 0xb0, 0xc8, 0xf1,
+		0x5b, 0x3e, 0xde, 0x8f, 0x09, 0xae, 0x60, 0xf3, 0x6b, 0x98, 0xe7, 0x44, 0xa0, 0x4f, 0x66, 0x7b,
+		0xf0, 0xf6, 0xd8, 0x2e, 0x8e, 0x6d, 0x43, 0x9e, 0x63, 0xdb, 0xff, 0xcd, 0x86, 0xe2, 0x03, 0x41,
+		0x98, 0x1f, 0x31, 0x24, 0xc6, 0x9e, 0x47, 0x4f, 0xf2, 0x01, 0x8b, 0x8e, 0x05, 0x0f, 0xdc, 0x31,
+		0x3e, 0xd6, 0xa1, 0xe9, 0x63, 0x1d, 0x7e, 0x1b, 0xc7, 0x3a, 0x72, 0x7b, 0x63, 0xfd, 0x35, 0x1d,
+		0x85, 0x1f, 0x92, 0x20, 0x37, 0xd9, 0xfa, 0x1a, 0x3b, 0x1c, 0x07, 0x3a, 0xb2, 0x98, 0xb4, 0xc6,
+		0xdd, 0x03, 0xe9, 0x21, 0xdb, 0x90, 0x89, 0x72, 0xca, 0xb7, 0x6d, 0xcf, 0x7f, 0x30, 0x08, 0x8b,
+		0xe3, 0x0c, 0xb8, 0x31, 0xb3, 0xf5, 0x59, 0xfc, 0x5e, 0x43, 0xb3, 0xdd, 0xba, 0xdd, 0xc9, 0x3a,
+		0xcf, 0xb1, 0xff, 0xcf, 0x5c, 0x1d, 0x95, 0x92, 0xef, 0x05, 0x88, 0x29, 0xba, 0xd5, 0x43, 0x7b,
+		0x0c, 0x0f, 0x40, 0xf4, 0x9b, 0x4d, 0xbd, 0x67, 0xbb, 0x61, 0x1c, 0xe3, 0xb6, 0x08, 0x0c, 0xba,
+		0x22, 0x20, 0x71, 0x83, 0xec, 0xa0, 0xe1, 0x59, 0x10, 0xf5, 0x01, 0x4c, 0xde, 0xce, 0x73, 0x74,
+		0xaf, 0x13, 0xe0, 0x9c, 0x70, 0x02, 0x04, 0x27, 0xee, 0x6f, 0x19, 0xd6, 0x90, 0x17, 0xe0, 0x51,
+		0xee, 0x05, 0x08, 0x4d, 0xa9, 0xcc, 0xe7, 0x06, 0x28, 0xf9, 0xdc, 0x00, 0x91, 0x29, 0xdd, 0x9c,
+		0xe0, 0x07, 0x38, 0x27, 0xfc, 0x00, 0xd1, 0x29, 0x2d, 0x1e, 0x72, 0x04, 0x3c, 0xe5, 0x71, 0x04,
+		0xc4, 0x47, 0xfd, 0xbb, 0x3e, 0xd4, 0x31, 0x9e, 0x80, 0xf3, 0x8e, 0x27, 0x20, 0x39, 0xd1, 0x8b,
+		0xc0, 0x91, 0x87, 0x5d, 0x01, 0xf5, 0x11, 0x57, 0x40, 0x8a, 0x7f, 0xff, 0x79, 0x12, 0x89, 0x29,
+		0xbe, 0x80, 0xfa, 0x88, 0x2f, 0x20, 0x3d, 0x85, 0xe0, 0x14, 0x67, 0xc0, 0x37, 0x8e, 0x77, 0x06,
+		0x4c, 0xde, 0xae, 0xf3, 0x66, 0xce, 0xe6, 0x0d, 0x50, 0x27, 0x78, 0x03, 0xe4, 0x89, 0x3b, 0x57,
+		0x46, 0x7e, 0x66, 0x77, 0xc0, 0xe6, 0x18, 0x77, 0x00, 0xdb, 0xb8, 0x9f, 0x9c, 0x48, 0x7c, 0x06,
+		0x7f, 0xc0, 0xe6, 0x18, 0x7f, 0x00, 0x99, 0x4a, 0x76, 0xaa, 0x43, 0xe0, 0x82, 0xdf, 0x21, 0xb0,
+		0x30, 0xc1, 0xea, 0x74, 0x67, 0xfb, 0x04, 0x8f, 0xc0, 0xd6, 0x24, 0x8f, 0xc0, 0x22, 0xff, 0xae,
+		0xc4, 0x24, 0x8a, 0x07, 0x70, 0x09, 0xd4, 0x47, 0x5c, 0x02, 0x87, 0xa6, 0x48, 0xda, 0xec, 0x3e,
+		0x01, 0xf6, 0xa5, 0x31, 0xf6, 0x8d, 0x31, 0x90, 0x13, 0x97, 0x43, 0xb1, 0x84, 0x9c, 0xcc, 0xdf,
+		0x0f, 0xf3, 0x82, 0x94, 0xa3, 0xe7, 0x70, 0xaf, 0xa0, 0xf7, 0xfb, 0x66, 0x5f, 0xdc, 0xea, 0xa0,
+		0x89, 0xfc, 0x49, 0x48, 0x3a, 0xa0, 0xfb, 0xfb, 0x0f, 0xe8, 0x9e, 0xcc, 0xa3, 0xc7, 0xf2, 0xbf,
+		0x2c, 0x41, 0xd2, 0xab, 0xa2, 0x7c, 0xfb, 0xcb, 0x38, 0xdf, 0x5f, 0x7a, 0xbc, 0x0a, 0x01, 0xbf,
+		0x57, 0x01, 0xbf, 0xd7, 0xd2, 0x1b, 0x71, 0x18, 0x68, 0x3d, 0xc7, 0x61, 0x20, 0x42, 0xb6, 0x98,
+		0xef, 0x81, 0x2f, 0x4b, 0xec, 0xfc, 0x32, 0xe3, 0x84, 0xaf, 0xb1, 0xad, 0x2e, 0x79, 0x08, 0x16,
+		0x3c, 0xb0, 0xce, 0x1e, 0x8e, 0xed, 0x9e, 0x65, 0x07, 0xba, 0xc0, 0x37, 0x73, 0xbf, 0x25, 0xc1,
+		0xfc, 0x88, 0x8a, 0x1c, 0xeb, 0x14, 0x90, 0xde, 0x26, 0xa7, 0x40, 0xe0, 0xb6, 0x9d, 0x02, 0xde,
+		0x3d, 0x69, 0xd0, 0xbf, 0x27, 0xfd, 0x6f, 0x12, 0xa4, 0x7c, 0x9a, 0x1a, 0x87, 0xa0, 0x69, 0xb6,
+		0x74, 0xbe, 0x4b, 0xa4, 0xbf, 0xd1, 0x24, 0xe9, 0x98, 0xe2, 0x03, 0x51, 0xf8, 0x13, 0xa1, 0x9c,
+		0x85, 0x27, 0xce, 0xd7, 0x15, 0x67, 0x83, 0x19, 0xf6, 0xde, 0x1c, 0xe1, 0xd7, 0x29, 0x22, 0xee,
+		0x75, 0x0a, 0xe7, 0xb2, 0x7a, 0xd4, 0x73, 0x59, 0x9d, 0x3c, 0x01, 0x71, 0xea, 0xec, 0x57, 0xcd,
+		0x1e, 0x5b, 0xac, 0x87, 0x4c, 0x1b, 0xff, 0x55, 0x0a, 0x8b, 0x06, 0x79, 0xd3, 0x5f, 0x1e, 0x8b,
+		0x23, 0xee, 0xb3, 0x38, 0xee, 0x80, 0x38, 0xb6, 0x9e, 0x7d, 0x6c, 0x12, 0xf8, 0x4b, 0x07, 0x22,
+		0x23, 0xff, 0x53, 0x01, 0xc8, 0x0c, 0x2d, 0x34, 0x63, 0xfb, 0x3e, 0xee, 0xe0, 0x7a, 0x36, 0x7e,
+		0x1c, 0x07, 0xd8, 0xd1, 0x2c, 0xf5, 0x86, 0x66, 0xd8, 0xfc, 0x03, 0xf1, 0x41, 0xc5, 0x93, 0x83,
+		0x57, 0x27, 0x30, 0x35, 0xb0, 0xf8, 0x27, 0xe2, 0x83, 0x8a, 0x93, 0xc6, 0xbb, 0x59, 0xfa, 0x75,
+		0xfa, 0x6e, 0x32, 0xfb, 0x3e, 0xc8, 0xe1, 0xd1, 0xed, 0x30, 0x16, 0x17, 0xb3, 0x38, 0xd8, 0x5f,
+		0x7c, 0xed, 0x84, 0xcc, 0xa0, 0x1f, 0x74, 0xae, 0xcf, 0x29, 0x1c, 0xdf, 0xcf, 0x85, 0xd8, 0x10,
+		0x17, 0xa8, 0x1f, 0x30, 0x29, 0xb6, 0xf7, 0xc8, 0x53, 0x16, 0x55, 0x8e, 0xb7, 0x77, 0xbb, 0x3d,
+		0xd3, 0xec, 0xa8, 0x6c, 0x8e, 0x17, 0x20, 0xed, 0xf0, 0x8a, 0xad, 0xa6, 0xf4, 0x7b, 0xce, 0x36,
+		0xba, 0xc6, 0x7c, 0x46, 0x70, 0x92, 0x65, 0xb2, 0x39, 0xe5, 0x7d, 0x14, 0x3b, 0xbf, 0x0e, 0x87,
+		0x04, 0x09, 0xdf, 0xba, 0x4a, 0x1e, 0x87, 0xb8, 0xbb, 0x24, 0xb3, 0x8b, 0x01, 0xfb, 0x78, 0x5a,
+		0x5c, 0xd8, 0xfc, 0xaf, 0x4a, 0x70, 0x68, 0xec, 0xca, 0x4a, 0x2a, 0x10, 0x61, 0x51, 0x9a, 0x3c,
+		0x22, 0xe7, 0xa1, 0xd9, 0x56, 0xe4, 0x65, 0x16, 0xc2, 0xa9, 0x70, 0xe4, 0xfc, 0x7b, 0x21, 0xc2,
+		0x72, 0x48, 0x02, 0xa2, 0xbe, 0x77, 0xf2, 0x0b, 0xa5, 0x52, 0x65, 0x5d, 0x3c, 0xb7, 0x5c, 0xa4,
+		0x6f, 0xd0, 0x63, 0xb6, 0x52, 0xb9, 0x5c, 0x29, 0x6d, 0xc8, 0x41, 0xfc, 0xa0, 0x39, 0xfb, 0xcd,
+		0x5f, 0xb3, 0x97, 0x43, 0x9e, 0xac, 0x46, 0xa5, 0x56, 0xae, 0x28, 0x72, 0x38, 0xff, 0x08, 0x1c,
+		0x15, 0xed, 0x18, 0xf5, 0x08, 0x39, 0x8e, 0x19, 0xc9, 0xe3, 0x98, 0xc9, 0xbf, 0x1a, 0x80, 0x9c,
+		0xc0, 0x19, 0xe3, 0xe3, 0xb9, 0x3c, 0xd4, 0xf1, 0x33, 0x07, 0x58, 0xd5, 0x87, 0x7a, 0x8f, 0xfb,
+		0x98, 0xbe, 0xbe, 0xad, 0xdb, 0xcd, 0x5d, 0x66, 0x28, 0x30, 0x0d, 0x94, 0x52, 0x52, 0x3c, 0x97,
+		0x22, 0x59, 0x0c, 0xec, 0x45, 0xbd, 0x69, 0xab, 0x4c, 0x88, 0x2c, 0xba, 0x99, 0x88, 0x2b, 0x29,
+		0x96, 0xdb, 0x60, 0x99, 0xf9, 0xf7, 0x1d, 0x88, 0x97, 0x71, 0x08, 0x2b, 0x15, 0xfc, 0x36, 0x19,
+		0xfd, 0x6a, 0x3b, 0xfd, 0xa9, 0x36, 0x6a, 0x85, 0xf5, 0xc6, 0xa5, 0x3a, 0xf2, 0x72, 0x01, 0x32,
+		0x82, 0x97, 0x22, 0x33, 0x9c, 0x7f, 0x00, 0x8e, 0x4c, 0xb0, 0x2a, 0xc6, 0x84, 0xcd, 0x7e, 0x42,
+		0xf2, 0x42, 0xfb, 0x2d, 0x83, 0x3a, 0x44, 0x2c, 0x5b, 0xb3, 0x07, 0x16, 0x67, 0xe2, 0xe3, 0xb3,
+		0x9a, 0x19, 0xcb, 0xe2, 0x47, 0x83, 0xa2, 0x2b, 0x9c, 0x4c, 0xfe, 0x2c, 0xa4, 0xfd, 0x25, 0x93,
+		0x79, 0xe0, 0x0a, 0x51, 0x20, 0xff, 0x24, 0x90, 0x51, 0xeb, 0x63, 0xcc, 0xf6, 0x52, 0x1a, 0xb7,
+		0xbd, 0xfc, 0x71, 0x09, 0x8e, 0xed, 0x63, 0x69, 0x90, 0x67, 0x87, 0x3a, 0x79, 0xfe, 0x20, 0x76,
+		0xca, 0x32, 0xcb, 0x1b, 0xea, 0xe6, 0xa3, 0x90, 0xf4, 0xe6, 0xcf, 0xd6, 0xc9, 0x2f, 0x06, 0xe0,
+		0xd0, 0x58, 0xa3, 0xc5, 0xa3, 0x02, 0xa5, 0xb7, 0xa8, 0x02, 0xdf, 0x0d, 0x60, 0xdf, 0x14, 0x71,
+		0xdb, 0x7c, 0x1d, 0xbd, 0x73, 0x8c, 0x7f, 0x51, 0x6f, 0x6e, 0xdc, 0xe4, 0x93, 0x20, 0x6e, 0xf3,
+		0x5f, 0xe8, 0x3f, 0xf1, 0x38, 0x05, 0x06, 0x74, 0x8d, 0xb5, 0xb2, 0xc1, 0x03, 0x2d, 0xc6, 0xf2,
+		0x75, 0x7f, 0xb6, 0x45, 0xae, 0xc2, 0x91, 0x21, 0x43, 0xc1, 0x21, 0x1d, 0x9a, 0xd5, 0x5e, 0x38,
+		0xe4, 0xb7, 0x17, 0x04, 0x69, 0xef, 0x6a, 0x1f, 0xf6, 0xaf, 0xf6, 0x57, 0x01, 0x5c, 0xe7, 0x80,
+		0x1b, 0x68, 0x28, 0x79, 0x03, 0x0d, 0xcf, 0x42, 0x18, 0x25, 0x49, 0xf0, 0x69, 0x54, 0x15, 0xa3,
+		0x24, 0x78, 0x9c, 0x0b, 0x0c, 0x3a, 0xdf, 0x06, 0x32, 0xea, 0xa0, 0x9d, 0x50, 0xc5, 0x53, 0xfe,
+		0x2a, 0xee, 0x9a, 0xe8, 0xea, 0x1d, 0x5f, 0xd5, 0xfb, 0x21, 0x4c, 0x47, 0x7e, 0xec, 0xed, 0xcd,
+		0x6f, 0x02, 0xd0, 0x6c, 0xbb, 0xdf, 0xde, 0x1a, 0xb8, 0x15, 0x9c, 0x18, 0x2f, 0x39, 0x05, 0x01,
+		0x57, 0xbc, 0x83, 0x8b, 0xd0, 0xa2, 0x8b, 0xea, 0x11, 0x23, 0x0f, 0xc1, 0x7c, 0x0d, 0xd2, 0x7e,
+		0xdc, 0xf1, 0xb7, 0x51, 0x47, 0xbf, 0x0c, 0xea, 0x5a, 0x47, 0xfc, 0x81, 0x2c, 0x9a, 0xc8, 0xbf,
+		0x1c, 0x80, 0xa4, 0x57, 0xf0, 0xfe, 0xe1, 0x99, 0x20, 0xf9, 0x6f, 0x93, 0x20, 0xe6, 0x74, 0x7f,
+		0x9f, 0xf0, 0x6a, 0xf7, 0x56, 0xb2, 0x73, 0x78, 0xc1, 0x8e, 0x89, 0x82, 0xce, 0x31, 0xd1, 0x93,
+		0xce, 0xf2, 0x37, 0xc9, 0x21, 0xe2, 0xe5, 0xb5, 0x08, 0x71, 0xe4, 0xab, 0xfd, 0x93, 0xb3, 0x5d,
+		0xfe, 0xc3, 0x23, 0x2c, 0xcf, 0xc5, 0x3d, 0x96, 0xc8, 0xb7, 0x3c, 0x21, 0x49, 0x6c, 0x22, 0x7a,
+		0x6f, 0x09, 0x4a, 0x07, 0xbe, 0x25, 0xe8, 0xd4, 0x12, 0xf0, 0xd6, 0xf2, 0x3d, 0x12, 0xc4, 0xc4,
+		0x9c, 0x20, 0xef, 0xf1, 0xc6, 0xba, 0x89, 0xa3, 0xcd, 0x89, 0xfa, 0x88, 0xd3, 0x77, 0x51, 0x46,
+		0x43, 0xbc, 0x83, 0x07, 0x0d, 0xf1, 0xe6, 0x96, 0xdd, 0xdf, 0x48, 0x20, 0x0f, 0xcf, 0xd8, 0xb7,
+		0xdc, 0xba, 0xd1, 0x65, 0x2e, 0x38, 0x66, 0x99, 0x9b, 0x14, 0xb7, 0x1d, 0x9a, 0x14, 0xb7, 0x3d,
+		0xda, 0xeb, 0xf0, 0x6d, 0xf6, 0xfa, 0x03, 0x01, 0x48, 0x78, 0xdc, 0xa3, 0xe4, 0x31, 0x5f, 0x0c,
+		0xf8, 0xd2, 0x7e, 0xae, 0x54, 0x4f, 0x10, 0xb8, 0x8f, 0x4d, 0x81, 0x83, 0xb3, 0xe9, 0xed, 0xbf,
+		0x0f, 0x36, 0xfe, 0xca, 0x6c, 0x78, 0xc2, 0x95, 0xd9, 0x6f, 0x95, 0x20, 0xe6, 0x98, 0xdd, 0x07,
+		0x3d, 0xc4, 0x3c, 0x0c, 0x11, 0x6e, 0x59, 0xb2, 0x53, 0x4c, 0x9e, 0x1a, 0xeb, 0x56, 0xce, 0x41,
+		0xac, 0xab, 0xdb, 0x1a, 0xd5, 0x83, 0x6c, 0x55, 0x73, 0xd2, 0xa7, 0xce, 0x43, 0xc2, 0x73, 0x00,
+		0x8c, 0xaa, 0xb1, 0x56, 0x79, 0x4e, 0x9e, 0xcb, 0x45, 0x5f, 0xb9, 0xb5, 0x14, 0xac, 0xe9, 0x37,
+		0x70, 0x36, 0x2b, 0x95, 0xd2, 0xa5, 0x4a, 0xe9, 0x19, 0x59, 0xca, 0x25, 0x5e, 0xb9, 0xb5, 0x14,
+		0x55, 0x74, 0xea, 0x51, 0x3c, 0xf5, 0x0c, 0x64, 0x86, 0x06, 0xc6, 0x6f, 0xb6, 0x10, 0x48, 0x97,
+		0x37, 0xf1, 0xb3, 0x5f, 0x85, 0x8d, 0x8a, 0xca, 0x22, 0x85, 0xf1, 0xc3, 0x25, 0xab, 0xd5, 0x8b,
+		0x97, 0x36, 0xd4, 0xd2, 0x6a, 0xb5, 0x52, 0xdb, 0x50, 0x0b, 0x1b, 0x1b, 0x85, 0xd2, 0x33, 0x72,
+		0xe0, 0xcc, 0xad, 0x04, 0x84, 0x30, 0x9c, 0x8f, 0x94, 0x20, 0x44, 0x5d, 0x21, 0xfb, 0x46, 0x80,
+		0xe5, 0xf6, 0xf7, 0x0d, 0x93, 0x0b, 0x10, 0xa6, 0x5e, 0x12, 0xb2, 0x7f, 0x48, 0x58, 0x6e, 0x8a,
+		0xb3, 0x18, 0x1b, 0x43, 0x67, 0xe4, 0xbe, 0x31, 0x62, 0xb9, 0xfd, 0x7d, 0xc7, 0x64, 0x15, 0xa2,
+		0x62, 0x93, 0x3c, 0x2d, 0x70, 0x2b, 0x37, 0xd5, 0xa1, 0x8b, 0x5d, 0x63, 0xce, 0x86, 0xfd, 0xc3,
+		0xc7, 0x72, 0x53, 0xbc, 0xca, 0xf8, 0xa0, 0x08, 0xdf, 0x8e, 0x4e, 0x89, 0x08, 0xcb, 0x4d, 0xf3,
+		0x13, 0x13, 0x05, 0xe2, 0xae, 0x1b, 0x67, 0x7a, 0x50, 0x5c, 0x6e, 0x06, 0x87, 0x39, 0x79, 0x2f,
+		0xa4, 0xfc, 0x5b, 0xdd, 0xd9, 0xa2, 0xce, 0x72, 0x33, 0x7a, 0xa4, 0x91, 0xbe, 0x7f, 0xdf, 0x3b,
+		0x5b, 0x14, 0x5a, 0x6e, 0x46, 0x07, 0x35, 0x79, 0x11, 0xe6, 0x47, 0xf7, 0xa5, 0xb3, 0x07, 0xa5,
+		0xe5, 0x0e, 0xe0, 0xb2, 0x26, 0x5d, 0x20, 0x63, 0xf6, 0xb3, 0x07, 0x88, 0x51, 0xcb, 0x1d, 0xc4,
+		0x83, 0x4d, 0x5a, 0x90, 0x19, 0xde, 0x24, 0xce, 0x1a, 0xb3, 0x96, 0x9b, 0xd9, 0x9b, 0xcd, 0x6a,
+		0xf1, 0x6f, 0x2e, 0x67, 0x8d, 0x61, 0xcb, 0xcd, 0xec, 0xdc, 0x26, 0x9b, 0x00, 0x9e, 0xfd, 0xe1,
+		0x0c, 0x31, 0x6d, 0xb9, 0x59, 0xdc, 0xdc, 0xa4, 0x07, 0x0b, 0xe3, 0x36, 0x8e, 0x07, 0x09, 0x71,
+		0xcb, 0x1d, 0xc8, 0xfb, 0x8d, 0xf2, 0xec, 0xdf, 0x02, 0xce, 0x16, 0xf2, 0x96, 0x9b, 0xd1, 0x0d,
+		0x5e, 0x2c, 0x4c, 0x8c, 0x73, 0xbe, 0x6f, 0xdf, 0x38, 0x67, 0x37, 0x72, 0xd9, 0x89, 0x6d, 0xfe,
+		0xad, 0x73, 0xf0, 0x2e, 0xfe, 0x66, 0x8f, 0x65, 0x6b, 0xd7, 0xda, 0xc6, 0x8e, 0xf3, 0xb0, 0x13,
+		0x4f, 0xf3, 0x20, 0xe7, 0xc3, 0x0c, 0x6a, 0x59, 0xe4, 0x4e, 0x79, 0xde, 0x69, 0xe2, 0xab, 0xa0,
+		0xd3, 0x6e, 0x60, 0x4c, 0x0f, 0x5f, 0xde, 0xe7, 0xe9, 0xa8, 0x29, 0x0f, 0x54, 0x8d, 0x79, 0x5a,
+		0x2a, 0xb7, 0xef, 0xab, 0x06, 0xb9, 0xfd, 0xe2, 0xbe, 0xd1, 0x8a, 0x4d, 0x5f, 0x6a, 0x5b, 0xb6,
+		0xd9, 0x6f, 0x37, 0xb5, 0x0e, 0x5d, 0x5e, 0x9e, 0x9c, 0xf5, 0x1e, 0x58, 0x31, 0x8e, 0xe6, 0x0a,
+		0x7f, 0x7d, 0x8a, 0xa1, 0x90, 0x32, 0x44, 0xae, 0x6b, 0x1d, 0x76, 0x0b, 0xcb, 0xfb, 0x7c, 0xdd,
+		0x30, 0xcf, 0x3d, 0x76, 0x94, 0x97, 0x0a, 0xc3, 0xcd, 0x7f, 0x1f, 0xbd, 0xc1, 0xd2, 0xed, 0xb6,
+		0x2d, 0x94, 0x45, 0x85, 0x6e, 0xa5, 0x2f, 0x43, 0xa8, 0xaf, 0xd9, 0x7c, 0x83, 0x59, 0x3c, 0x77,
+		0xe0, 0x97, 0xa0, 0x58, 0x0d, 0x94, 0x06, 0x79, 0x16, 0xf0, 0x02, 0x83, 0x4a, 0xe9, 0x05, 0xde,
+		0x12, 0x3d, 0xbc, 0xc7, 0x81, 0xed, 0x23, 0xef, 0xa5, 0xdf, 0x66, 0xc4, 0x73, 0x5c, 0x63, 0x47,
+		0x67, 0x94, 0x83, 0x6f, 0x89, 0x72, 0xaa, 0xab, 0xdd, 0x2c, 0x51, 0x6a, 0x48, 0x9f, 0x3f, 0xb2,
+		0xf5, 0x1b, 0x12, 0x80, 0xcb, 0x18, 0xa2, 0xe1, 0x11, 0x87, 0x48, 0xd1, 0x4a, 0xc5, 0x11, 0xc7,
+		0x7d, 0x93, 0xf8, 0x3e, 0xc4, 0xd6, 0x62, 0x0a, 0x9b, 0xf7, 0xd9, 0xd7, 0x4e, 0x48, 0xac, 0xd6,
+		0x4c, 0x73, 0x84, 0xed, 0x09, 0xe6, 0x0c, 0x51, 0xa9, 0x0d, 0x1b, 0x98, 0x6a, 0xc3, 0xa6, 0x84,
+		0x0d, 0xcb, 0x08, 0x02, 0xc3, 0xc6, 0x72, 0xde, 0x87, 0x4f, 0x49, 0x90, 0x28, 0x7b, 0x9e, 0xda,
+		0xc4, 0x33, 0x2a, 0xd3, 0x68, 0x5f, 0xd3, 0xfb, 0xce, 0x19, 0x15, 0x4b, 0xa2, 0x9d, 0xc9, 0xbe,
+		0x3e, 0x6d, 0xef, 0x89, 0xf7, 0x8c, 0x44, 0x1a, 0xb1, 0x6e, 0xe8, 0x5b, 0x56, 0x5b, 0xf0, 0x59,
+		0x11, 0x49, 0x0c, 0x38, 0xb0, 0xf4, 0xe6, 0x00, 0x5d, 0xe7, 0xf8, 0x75, 0x7f, 0x1b, 0x3f, 0x42,
+		0xcf, 0xf6, 0xe1, 0x19, 0x91, 0x5f, 0x62, 0xd9, 0x48, 0xa4, 0xa5, 0xdb, 0x5a, 0xbb, 0xc3, 0x22,
+		0x33, 0xe3, 0x8a, 0x48, 0xf2, 0xa6, 0xde, 0x8a, 0x7a, 0xf7, 0xa1, 0x25, 0x90, 0xcd, 0x9e, 0xde,
+		0xf7, 0x05, 0xa4, 0x30, 0x69, 0xcc, 0xfe, 0xc1, 0x67, 0x1e, 0x5a, 0xe4, 0x0c, 0xe7, 0xc1, 0x0c,
+		0xec, 0xcb, 0x12, 0x4a, 0x46, 0x60, 0xf0, 0x6c, 0x72, 0xd5, 0x77, 0x2a, 0x35, 0xd8, 0x72, 0x1f,
+		0xae, 0x59, 0x1c, 0x61, 0x6a, 0xc1, 0xd8, 0x2b, 0x66, 0x7f, 0xd7, 0x25, 0xed, 0xee, 0x53, 0xf1,
+		0xa9, 0x18, 0xcf, 0x11, 0x15, 0x25, 0x83, 0x26, 0xfc, 0x8b, 0x5a, 0xbb, 0xa3, 0xb7, 0xb8, 0x5b,
+		0x83, 0xa7, 0xc8, 0x8a, 0xe3, 0x5e, 0x64, 0x1f, 0x1c, 0xcd, 0x4f, 0x92, 0x8d, 0xa2, 0x69, 0xb4,
+		0xfc, 0x7e, 0x44, 0x52, 0x82, 0x88, 0x6d, 0x5e, 0xd3, 0x0d, 0xce, 0xa0, 0x83, 0x3d, 0xe6, 0xc6,
+		0x51, 0xc9, 0x37, 0x82, 0xdc, 0xd2, 0x3b, 0xfa, 0x0e, 0xe5, 0x9c, 0xb5, 0xab, 0xe1, 0x7d, 0xe9,
+		0xc8, 0xed, 0x3e, 0xe8, 0x96, 0x71, 0x48, 0x35, 0x28, 0x25, 0xb2, 0xee, 0x7f, 0xcc, 0x35, 0xca,
+		0x0f, 0x8f, 0x27, 0xf4, 0xd1, 0x23, 0x79, 0x5e, 0xcd, 0xe3, 0x25, 0x81, 0x12, 0x34, 0x30, 0xb6,
+		0x4c, 0x83, 0x7e, 0x3d, 0x93, 0xef, 0xa2, 0x62, 0xec, 0xa4, 0xd2, 0xc9, 0xe7, 0x27, 0x95, 0xeb,
+		0x90, 0x76, 0x41, 0xe9, 0x0c, 0x89, 0x1f, 0x74, 0x86, 0xa4, 0x1c, 0x02, 0x08, 0x82, 0x9f, 0xd9,
+		0x74, 0xe7, 0x60, 0x16, 0x78, 0x44, 0xc8, 0xd4, 0xd9, 0xec, 0xed, 0x8c, 0x87, 0x00, 0x31, 0x60,
+		0xa1, 0xdb, 0x36, 0x54, 0x4b, 0xef, 0x6c, 0xab, 0x9c, 0x73, 0x48, 0x37, 0x41, 0xd9, 0xff, 0x9e,
+		0x03, 0x8c, 0xe6, 0x1f, 0x7d, 0xe6, 0xa1, 0x8c, 0xfb, 0x24, 0xde, 0xd2, 0xc3, 0xcb, 0x8f, 0x9d,
+		0x53, 0xe6, 0xbb, 0x6d, 0xa3, 0xa1, 0x77, 0xb6, 0xcb, 0x0e, 0x61, 0xf2, 0x6e, 0x38, 0xe6, 0x32,
+		0xc4, 0x34, 0xd4, 0x5d, 0xb3, 0xd3, 0x52, 0xfb, 0xfa, 0xb6, 0xda, 0xa4, 0x8f, 0x0d, 0x26, 0x29,
+		0x1b, 0x8f, 0x38, 0x20, 0x75, 0xe3, 0x92, 0xd9, 0x69, 0x29, 0xfa, 0x76, 0x09, 0x8b, 0xf1, 0x24,
+		0xcb, 0xc5, 0x6e, 0xb7, 0x30, 0x2c, 0x24, 0x88, 0xb1, 0xe9, 0x4e, 0x66, 0xb5, 0x65, 0xad, 0xc4,
+		0x3e, 0xf4, 0xf1, 0x13, 0x73, 0x6f, 0x7c, 0xfc, 0xc4, 0x5c, 0xfe, 0x02, 0x7d, 0xd8, 0x8a, 0x4f,
+		0x2d, 0xdd, 0x22, 0xe7, 0x20, 0xae, 0x89, 0x04, 0xbb, 0x75, 0xb6, 0xcf, 0xd4, 0x74, 0x41, 0xf1,
+		0xc2, 0x5d, 0xa4, 0x7c, 0x65, 0x5d, 0x6b, 0xf7, 0x49, 0x05, 0x9f, 0x6a, 0x15, 0xb2, 0x3a, 0xeb,
+		0x2c, 0x77, 0xc5, 0x5b, 0x4c, 0xf3, 0xda, 0xa4, 0xe8, 0xb5, 0x78, 0xf1, 0xae, 0x3f, 0xf8, 0xcc,
+		0x43, 0x77, 0x72, 0x32, 0x57, 0x86, 0x02, 0xd9, 0x04, 0xbd, 0xe1, 0x00, 0x37, 0x4f, 0x9f, 0x2f,
+		0x43, 0x94, 0x35, 0xd5, 0x22, 0x4f, 0x43, 0xb8, 0x87, 0x3f, 0xb2, 0x92, 0xef, 0xf5, 0xd6, 0x51,
+		0x99, 0xa7, 0xf0, 0x5e, 0x09, 0x61, 0x78, 0xf9, 0x0f, 0x07, 0x00, 0xca, 0x57, 0xae, 0x6c, 0xf4,
+		0xdb, 0xbd, 0x8e, 0x6e, 0xbf, 0x5d, 0x7d, 0xdf, 0x84, 0x43, 0x6e, 0xdf, 0xad, 0x7e, 0xf3, 0xe0,
+		0xfd, 0x5f, 0x70, 0xef, 0x66, 0xf7, 0x9b, 0x63, 0xc9, 0xb6, 0x2c, 0xdb, 0x21, 0x1b, 0x3c, 0x38,
+		0xd9, 0xb2, 0x65, 0x8f, 0x72, 0xf6, 0x79, 0x48, 0xb8, 0xcc, 0xb0, 0x48, 0x15, 0x62, 0x36, 0xff,
+		0xcd, 0x19, 0x9c, 0x9f, 0xcc, 0x60, 0x81, 0xe6, 0x65, 0xb2, 0x83, 0x9e, 0xff, 0x3b, 0x09, 0xc0,
+		0x33, 0x47, 0xde, 0x99, 0x32, 0x86, 0x3b, 0x78, 0xae, 0x9c, 0x83, 0xb7, 0xfd, 0x24, 0x28, 0x23,
+		0xe0, 0x61, 0xea, 0x47, 0x02, 0xb0, 0xb0, 0x29, 0x66, 0xef, 0x3b, 0x9f, 0x07, 0x9b, 0x10, 0xd5,
+		0x0d, 0xbb, 0xdf, 0x76, 0x8e, 0x95, 0x1e, 0x9e, 0x34, 0xe6, 0x63, 0x3a, 0x55, 0x31, 0xec, 0xfe,
+		0x9e, 0x57, 0x02, 0x04, 0x2d, 0x0f, 0x3f, 0x3e, 0x16, 0x84, 0xec, 0x24, 0x54, 0x7c, 0x1c, 0xa7,
+		0xd9, 0xd7, 0x69, 0x86, 0xff, 0x9a, 0x69, 0x5a, 0x64, 0xf3, 0x65, 0x47, 0x01, 0x34, 0xd4, 0x50,
+		0xb8, 0x10, 0xf4, 0xf6, 0x2c, 0xb3, 0xb4, 0x4b, 0x81, 0x2e, 0x3c, 0x1b, 0x90, 0x11, 0xd7, 0x50,
+		0xb6, 0xb4, 0x8e, 0x86, 0x97, 0xbe, 0x83, 0x07, 0x5f, 0xf3, 0xc5, 0x55, 0x96, 0x22, 0x23, 0x41,
+		0x2a, 0x10, 0x15, 0xd4, 0x42, 0x07, 0xa7, 0x26, 0x70, 0xf1, 0xba, 0x91, 0x77, 0x61, 0xa0, 0xd6,
+		0x48, 0x48, 0x49, 0x38, 0x79, 0xd5, 0xd6, 0xb4, 0x95, 0x27, 0xb2, 0xef, 0xca, 0xc3, 0x0d, 0xbe,
+		0x1f, 0xa1, 0xd1, 0xe2, 0xad, 0xbf, 0xff, 0xc3, 0xb2, 0x0e, 0xc0, 0xa6, 0x2a, 0x6a, 0xd2, 0x6c,
+		0xe8, 0x76, 0xe7, 0x7b, 0x9c, 0x11, 0x29, 0x5b, 0xf6, 0xd7, 0x6b, 0x84, 0xfe, 0x38, 0x00, 0x49,
+		0xef, 0x08, 0xfd, 0x83, 0x5c, 0xb4, 0x48, 0xcd, 0x55, 0x53, 0xec, 0x46, 0xcd, 0xfd, 0x93, 0xd4,
+		0xd4, 0x88, 0x34, 0x4f, 0xd1, 0x4f, 0xbf, 0x19, 0x81, 0x08, 0x0f, 0x79, 0xab, 0x8f, 0xd8, 0xb6,
+		0xd2, 0xb4, 0x47, 0x04, 0x52, 0xe2, 0x11, 0x81, 0xb1, 0xa6, 0xed, 0x3d, 0x80, 0x4f, 0x0b, 0xa8,
+		0xbe, 0x38, 0x3a, 0x3c, 0x6b, 0xc0, 0xad, 0xae, 0xd3, 0x7b, 0x0b, 0x43, 0x09, 0x11, 0xcc, 0xd5,
+		0xc3, 0x08, 0x83, 0x97, 0x97, 0x2a, 0x2c, 0x87, 0x3c, 0x04, 0x64, 0xd7, 0xf1, 0x59, 0xa8, 0x2e,
+		0x23, 0x10, 0x6e, 0xde, 0x2d, 0x11, 0xe0, 0xf8, 0x0c, 0x8b, 0x69, 0xb4, 0x54, 0xf6, 0x0a, 0x36,
+		0x7f, 0x63, 0x1c, 0x73, 0xca, 0x98, 0x41, 0x3e, 0x28, 0x31, 0x13, 0x79, 0x68, 0x27, 0xcd, 0x77,
+		0x28, 0x1b, 0x33, 0x4c, 0x8a, 0x2f, 0xbf, 0x76, 0x22, 0xb7, 0xa7, 0x75, 0x3b, 0x2b, 0xf9, 0x31,
+		0x74, 0xf2, 0xe3, 0x36, 0xf7, 0x68, 0x38, 0xfb, 0x77, 0xe2, 0xa4, 0x06, 0x32, 0xbe, 0x98, 0xd0,
+		0xe7, 0x9f, 0x9b, 0x57, 0xb7, 0x75, 0x9d, 0xef, 0x65, 0x8e, 0x2e, 0x8f, 0x79, 0x93, 0x7c, 0x19,
+		0xdf, 0xf6, 0xf6, 0x8e, 0x65, 0xfa, 0x9a, 0xbe, 0xa7, 0x70, 0xe4, 0x0b, 0xba, 0x4e, 0x5e, 0x82,
+		0xa3, 0x3b, 0x1d, 0x73, 0x0b, 0x2f, 0x74, 0xb5, 0x5f, 0x1a, 0xb4, 0x5b, 0x2a, 0x97, 0x0c, 0xb5,
+		0xa9, 0xf5, 0xb2, 0xb1, 0xb7, 0xe4, 0x9a, 0x38, 0xcc, 0x08, 0xaf, 0x52, 0xba, 0x0d, 0x46, 0xb6,
+		0xa4, 0xf5, 0xc8, 0x0d, 0xb8, 0xc3, 0x15, 0xf6, 0x31, 0xb5, 0xc6, 0xdf, 0x52, 0xad, 0x47, 0x1d,
+		0xda, 0x23, 0x15, 0xb7, 0xe1, 0x8e, 0x21, 0xce, 0x73, 0x3f, 0x8c, 0x61, 0xda, 0x6d, 0x1e, 0x70,
+		0x78, 0x10, 0xb9, 0x3d, 0xea, 0x1b, 0x1e, 0xe6, 0x85, 0xa9, 0x51, 0x52, 0x2b, 0xef, 0x42, 0x2d,
+		0xf4, 0xca, 0xeb, 0x3f, 0x7b, 0xea, 0x98, 0xe7, 0x7d, 0xf0, 0x9b, 0x8e, 0xe3, 0x91, 0x4d, 0x1d,
+		0xdc, 0x50, 0x10, 0x77, 0x71, 0x77, 0xee, 0x34, 0xac, 0x01, 0x78, 0xf6, 0x60, 0xd2, 0xfe, 0x7b,
+		0x3b, 0x17, 0xdf, 0xb7, 0xb7, 0xf3, 0xa8, 0xbe, 0xf7, 0xb8, 0x6b, 0x6b, 0xe0, 0x00, 0x92, 0x22,
+		0x90, 0xa8, 0x46, 0x9d, 0xcb, 0xff, 0x1b, 0x09, 0x43, 0xdf, 0x86, 0xb4, 0x84, 0xd3, 0xe4, 0x26,
+		0x90, 0xbe, 0xa7, 0x90, 0xce, 0x36, 0x71, 0x88, 0x7e, 0x7b, 0x4a, 0x67, 0xbe, 0x3f, 0x5c, 0xfa,
+		0x36, 0x19, 0x09, 0x7c, 0x85, 0xf8, 0x1d, 0x09, 0x2f, 0xf3, 0xb4, 0x46, 0xb9, 0xdf, 0x80, 0xa4,
+		0xb7, 0x6a, 0xde, 0x89, 0x77, 0xcd, 0xd2, 0x09, 0x6f, 0xfb, 0x7d, 0x44, 0xc8, 0x15, 0x57, 0x13,
+		0x33, 0x8f, 0xe7, 0x23, 0x33, 0x33, 0x45, 0x34, 0x6c, 0xac, 0x46, 0x66, 0x63, 0xf3, 0xe7, 0x12,
+		0x84, 0xd6, 0x4d, 0xb3, 0x43, 0x5e, 0x82, 0x79, 0xc3, 0xb4, 0x55, 0xd4, 0x5a, 0x7a, 0x4b, 0xe5,
+		0x2e, 0x19, 0xb6, 0xca, 0x55, 0xf6, 0xe5, 0xd5, 0x17, 0x5f, 0x3b, 0x31, 0x8a, 0x39, 0xee, 0xfb,
+		0x01, 0x19, 0xc3, 0xb4, 0x8b, 0x14, 0x68, 0x83, 0xc2, 0x90, 0x6d, 0x48, 0xf9, 0xab, 0x63, 0x2b,
+		0x61, 0x61, 0x5a, 0x75, 0xa9, 0xa9, 0x55, 0x25, 0xb7, 0x3c, 0xf5, 0xb0, 0x27, 0xb7, 0xff, 0x0a,
+		0x47, 0xee, 0x2a, 0xc8, 0x57, 0x86, 0x83, 0xb3, 0x2a, 0x10, 0x15, 0xc1, 0x58, 0xd2, 0x8c, 0x71,
+		0x5e, 0x5e, 0x76, 0x72, 0xdc, 0xfc, 0x2f, 0x48, 0x70, 0x08, 0x63, 0xb6, 0x9e, 0xd1, 0xf7, 0xc4,
+		0xcb, 0x3a, 0x95, 0x9b, 0xbd, 0x76, 0x7f, 0x6f, 0xfc, 0x3d, 0x33, 0x69, 0xc2, 0x3d, 0xb3, 0xcb,
+		0x78, 0x51, 0x01, 0xd1, 0x6e, 0xd7, 0x1b, 0xca, 0xb0, 0xe9, 0x6a, 0x88, 0xef, 0x92, 0x32, 0x5a,
+		0xfe, 0xa8, 0x5d, 0x96, 0xc9, 0x2f, 0x7d, 0xbf, 0x1c, 0x00, 0x79, 0x58, 0x13, 0x91, 0xda, 0xa4,
+		0x26, 0xdf, 0xe6, 0xa6, 0x47, 0xb8, 0xd6, 0x03, 0x6f, 0x83, 0x6b, 0x7d, 0x1d, 0xd2, 0xfa, 0xf6,
+		0xb6, 0xde, 0xb4, 0xdb, 0xd7, 0xb9, 0xcb, 0x38, 0x78, 0x60, 0x87, 0x98, 0x43, 0x00, 0x41, 0x4e,
+		0xfd, 0xa2, 0x04, 0xe0, 0x7a, 0x26, 0xf1, 0xe1, 0xb0, 0x62, 0xbd, 0x56, 0x56, 0x1b, 0x1b, 0x85,
+		0x8d, 0xcd, 0x86, 0xff, 0x93, 0x4a, 0xe2, 0x99, 0x31, 0xab, 0xa7, 0x37, 0xdb, 0xdb, 0x6d, 0xbd,
+		0x45, 0xee, 0x85, 0x45, 0x3f, 0x34, 0xa6, 0x2a, 0x65, 0x59, 0xca, 0x25, 0x5f, 0xb9, 0xb5, 0x14,
+		0x63, 0x3b, 0x31, 0x1d, 0x1f, 0xcd, 0x3d, 0x34, 0x0a, 0x87, 0x9f, 0x63, 0x0a, 0xe4, 0x52, 0xaf,
+		0xdc, 0x5a, 0x8a, 0x3b, 0x5b, 0x36, 0x92, 0x07, 0xe2, 0x85, 0xe4, 0xf4, 0x82, 0x39, 0x78, 0xe5,
+		0xd6, 0x52, 0x84, 0x4d, 0xa0, 0x5c, 0x08, 0x1f, 0x13, 0x3b, 0xf5, 0x4d, 0x00, 0x55, 0x63, 0xbb,
+		0xaf, 0x35, 0xa9, 0xa2, 0xc8, 0xc1, 0xe1, 0x6a, 0xed, 0x82, 0x52, 0x28, 0x6d, 0x54, 0xeb, 0x35,
+		0x7f, 0xb3, 0x87, 0xca, 0xca, 0xf5, 0xcd, 0xe2, 0x6a, 0x45, 0xc5, 0xe7, 0xcb, 0x58, 0x84, 0x83,
+		0xaf, 0xec, 0xb9, 0xda, 0x46, 0x75, 0xad, 0x22, 0x07, 0x8a, 0x17, 0x26, 0x9e, 0xa0, 0x3d, 0xb8,
+		0xef, 0xc7, 0x2c, 0xdc, 0xc5, 0xca, 0x77, 0x8c, 0xf6, 0x3f, 0x07, 0x00, 0x64, 0x8c, 0xfb, 0x05,
+		0x6e, 0xb5, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2534,6 +2588,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if this.MinCommissionChangeNotice != that1.MinCommissionChangeNotice {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3427,6 +3490,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinCommissionChangeNotice, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinCommissionChangeNotice):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3469,12 +3560,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err13 != nil {
-		return 0, err13
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n13
-	i = encodeVarintStaking(dAtA, i, uint64(n13))
+	i -= n14
+	i = encodeVarintStaking(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3718,12 +3809,60 @@ func (m *ConsKeyEvidenceExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
-	if err18 != nil {
-		return 0, err18
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintStaking(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintStaking(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	i -= n18
-	i = encodeVarintStaking(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0x12
 	if len(m.ValidatorAddress) > 0 {
@@ -4094,6 +4233,12 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinCommissionChangeNotice)
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4186,6 +4331,23 @@ func (m *ConsKeyEvidenceExpiry) Size() (n int) {
 	return n
 }
 
+func (m *CommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6879,6 +7041,107 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionChangeNotice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinCommissionChangeNotice, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -7588,6 +7851,155 @@ func (m *ConsKeyEvidenceExpiry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommissionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
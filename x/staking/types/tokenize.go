@@ -0,0 +1,34 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TokenizedShareDenomPrefix is the prefix of the share token denoms.
+const TokenizedShareDenomPrefix = "share"
+
+// TokenizedShareDenom returns the denom of the share tokens of a validator,
+// of the form share/{validator address}.
+func TokenizedShareDenom(valAddr string) string {
+	return TokenizedShareDenomPrefix + "/" + valAddr
+}
+
+// ParseTokenizedShareDenom returns the validator address of a share token
+// denom.
+func ParseTokenizedShareDenom(denom string) (string, error) {
+	valAddr, found := strings.CutPrefix(denom, TokenizedShareDenomPrefix+"/")
+	if !found || valAddr == "" {
+		return "", ErrInvalidShareDenom.Wrapf("denom %s is not of the form %s/{validator}", denom, TokenizedShareDenomPrefix)
+	}
+
+	return valAddr, nil
+}
+
+// TokenizedShareCustodyAddress returns the address holding the delegation
+// backing the share tokens of a validator. No key controls this address.
+func TokenizedShareCustodyAddress(valAddr sdk.ValAddress) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("tokenized_shares"), valAddr)
+}
@@ -0,0 +1,91 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	tokenfactoryv1 "cosmossdk.io/api/cosmos/tokenfactory/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: tokenfactoryv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current tokenfactory parameters",
+				},
+				{
+					RpcMethod:      "DenomAuthorityMetadata",
+					Use:            "denom-authority-metadata [denom]",
+					Short:          "Query the admin and the send hook of a factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsFromCreator",
+					Use:            "denoms-from-creator [creator]",
+					Short:          "Query the factory denoms created by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: tokenfactoryv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreateDenom",
+					Use:            "create-denom [subdenom]",
+					Short:          "Create the denom factory/{sender}/{subdenom}, paying the denom creation fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subdenom"}},
+				},
+				{
+					RpcMethod:      "Mint",
+					Use:            "mint [amount] [mint-to-address]",
+					Short:          "Mint tokens of a factory denom, the sender must be the denom admin",
+					Long:           "Mint tokens of a factory denom, the sender must be the denom admin. The tokens are minted to the sender if no mint-to-address is provided.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}, {ProtoField: "mint_to_address", Optional: true}},
+				},
+				{
+					RpcMethod:      "Burn",
+					Use:            "burn [amount]",
+					Short:          "Burn tokens of a factory denom held by the sender, the sender must be the denom admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ChangeAdmin",
+					Use:            "change-admin [denom] [new-admin]",
+					Short:          "Change the admin of a factory denom, the sender must be the denom admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "new_admin"}},
+				},
+				{
+					RpcMethod:      "SetDenomMetadata",
+					Use:            "set-denom-metadata [metadata]",
+					Short:          "Set the bank metadata of a factory denom, the sender must be the denom admin",
+					Example:        fmt.Sprintf(`%s tx tokenfactory set-denom-metadata '{"base":"factory/cosmos1.../mytoken","display":"mytoken","name":"My Token","symbol":"MTK","denom_units":[...]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+				},
+				{
+					RpcMethod:      "SetSendHook",
+					Use:            "set-send-hook [denom] [send-hook]",
+					Short:          "Set the send hook of a factory denom, the sender must be the denom admin",
+					Long:           "Set the send hook of a factory denom, the sender must be the denom admin. The send hook is one of the send hooks registered by the app, an empty send hook removes the send hook of the denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "send_hook"}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params-proposal [params]",
+					Short:          "Submit a proposal to update tokenfactory module params. Note: the entire params must be provided.",
+					Long:           fmt.Sprintf("Submit a proposal to update tokenfactory module params. Note: the entire params must be provided.\n See the fields to fill in by running `%s query tokenfactory params --output json`", version.AppName),
+					Example:        fmt.Sprintf(`%s tx tokenfactory update-params-proposal '{ "denom_creation_fee": [{"denom":"stake","amount":"10000000"}] }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
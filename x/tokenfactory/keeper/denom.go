@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// CreateDenom creates the denom factory/{creator}/{subdenom}, whose admin is
+// the creator, and charges the denom creation fee to the creator.
+func (k Keeper) CreateDenom(ctx context.Context, creator, subdenom string) (string, error) {
+	creatorAddr, err := k.addressCodec.StringToBytes(creator)
+	if err != nil {
+		return "", sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	denom, err := types.GetTokenDenom(creator, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	has, err := k.DenomAuthorityMetadata.Has(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+	if has {
+		return "", types.ErrDenomExists.Wrap(denom)
+	}
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found || k.bankKeeper.HasSupply(ctx, denom) {
+		return "", types.ErrDenomExists.Wrap(denom)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if !params.DenomCreationFee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, k.feeCollectorName, params.DenomCreationFee); err != nil {
+			return "", errorsmod.Wrap(err, "failed to pay the denom creation fee")
+		}
+	}
+
+	if err := k.setDenom(ctx, denom, types.DenomAuthorityMetadata{Admin: creator}); err != nil {
+		return "", err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:       denom,
+		Display:    denom,
+		Name:       denom,
+		Symbol:     denom,
+	})
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventDenomCreated{
+		Denom:   denom,
+		Creator: creator,
+	}); err != nil {
+		return "", err
+	}
+
+	return denom, nil
+}
+
+// setDenom sets the authority metadata of a factory denom and indexes it by
+// creator.
+func (k Keeper) setDenom(ctx context.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	creator, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	creatorAddr, err := k.addressCodec.StringToBytes(creator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+
+	if err := k.DenomAuthorityMetadata.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return k.CreatorDenoms.Set(ctx, collections.Join(sdk.AccAddress(creatorAddr), denom))
+}
+
+// getAuthorizedDenom returns the authority metadata of a factory denom, and
+// checks that the sender is its admin.
+func (k Keeper) getAuthorizedDenom(ctx context.Context, denom, sender string) (types.DenomAuthorityMetadata, error) {
+	metadata, err := k.DenomAuthorityMetadata.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DenomAuthorityMetadata{}, types.ErrInvalidDenom.Wrapf("%s is not a factory denom", denom)
+	} else if err != nil {
+		return types.DenomAuthorityMetadata{}, err
+	}
+
+	if metadata.Admin == "" || metadata.Admin != sender {
+		return types.DenomAuthorityMetadata{}, types.ErrUnauthorized.Wrapf("%s is not the admin of %s", sender, denom)
+	}
+
+	return metadata, nil
+}
+
+// Mint mints tokens of a factory denom to the given address, the sender must
+// be the denom admin.
+func (k Keeper) Mint(ctx context.Context, sender string, amount sdk.Coin, mintTo string) error {
+	if _, err := k.getAuthorizedDenom(ctx, amount.Denom, sender); err != nil {
+		return err
+	}
+
+	if !amount.IsValid() || !amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(amount.String())
+	}
+
+	if mintTo == "" {
+		mintTo = sender
+	}
+	mintToAddr, err := k.addressCodec.StringToBytes(mintTo)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid mint to address: %s", err)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mintToAddr, coins); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMint{
+		Admin:         sender,
+		Amount:        amount.String(),
+		MintToAddress: mintTo,
+	})
+}
+
+// Burn burns tokens of a factory denom held by the sender, the sender must be
+// the denom admin.
+func (k Keeper) Burn(ctx context.Context, sender string, amount sdk.Coin) error {
+	if _, err := k.getAuthorizedDenom(ctx, amount.Denom, sender); err != nil {
+		return err
+	}
+
+	if !amount.IsValid() || !amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(amount.String())
+	}
+
+	senderAddr, err := k.addressCodec.StringToBytes(sender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBurn{
+		Admin:  sender,
+		Amount: amount.String(),
+	})
+}
+
+// ChangeAdmin changes the admin of a factory denom, the sender must be the
+// current admin. An empty new admin renounces the management of the denom.
+func (k Keeper) ChangeAdmin(ctx context.Context, sender, denom, newAdmin string) error {
+	metadata, err := k.getAuthorizedDenom(ctx, denom, sender)
+	if err != nil {
+		return err
+	}
+
+	if newAdmin != "" {
+		if _, err := k.addressCodec.StringToBytes(newAdmin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	metadata.Admin = newAdmin
+	if err := k.DenomAuthorityMetadata.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAdminChanged{
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	})
+}
+
+// SetDenomMetadata sets the bank metadata of a factory denom, the sender must
+// be the denom admin.
+func (k Keeper) SetDenomMetadata(ctx context.Context, sender string, metadata banktypes.Metadata) error {
+	if _, err := k.getAuthorizedDenom(ctx, metadata.Base, sender); err != nil {
+		return err
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return types.ErrInvalidMetadata.Wrap(err.Error())
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventDenomMetadataSet{
+		Denom: metadata.Base,
+	})
+}
+
+// SetSendHook sets the send hook of a factory denom, the sender must be the
+// denom admin. An empty send hook removes the send hook of the denom.
+func (k Keeper) SetSendHook(ctx context.Context, sender, denom, sendHook string) error {
+	metadata, err := k.getAuthorizedDenom(ctx, denom, sender)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := k.sendHooks[sendHook]; sendHook != "" && !ok {
+		return types.ErrUnknownSendHook.Wrap(sendHook)
+	}
+
+	metadata.SendHook = sendHook
+	if err := k.DenomAuthorityMetadata.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventSendHookSet{
+		Denom:    denom,
+		SendHook: sendHook,
+	})
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// InitGenesis initializes the tokenfactory module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	for _, denom := range gs.FactoryDenoms {
+		if err := k.setDenom(ctx, denom.Denom, denom.AuthorityMetadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the tokenfactory module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := []types.GenesisDenom{}
+	err = k.DenomAuthorityMetadata.Walk(ctx, nil, func(denom string, metadata types.DenomAuthorityMetadata) (bool, error) {
+		denoms = append(denoms, types.GenesisDenom{Denom: denom, AuthorityMetadata: metadata})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{
+		Params:        params,
+		FactoryDenoms: denoms,
+	}, nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	corestoretypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	cdc              codec.BinaryCodec
+	storeService     corestoretypes.KVStoreService
+	addressCodec     address.Codec
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+
+	// sendHooks holds the send hooks registered by the app, by name. The map is
+	// shared by the copies of the keeper.
+	sendHooks map[string]types.SendHook
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// DenomAuthorityMetadata holds the authority metadata of the factory
+	// denoms, by denom.
+	DenomAuthorityMetadata collections.Map[string, types.DenomAuthorityMetadata]
+	// CreatorDenoms indexes the factory denoms by creator.
+	CreatorDenoms collections.KeySet[collections.Pair[sdk.AccAddress, string]]
+}
+
+// NewKeeper creates a new tokenfactory Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService corestoretypes.KVStoreService,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	feeCollectorName string,
+	authority string,
+) Keeper {
+	// ensure tokenfactory module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:                    cdc,
+		storeService:           storeService,
+		addressCodec:           ak.AddressCodec(),
+		bankKeeper:             bk,
+		feeCollectorName:       feeCollectorName,
+		sendHooks:              make(map[string]types.SendHook),
+		authority:              authority,
+		Params:                 collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		DenomAuthorityMetadata: collections.NewMap(sb, types.DenomAuthorityMetadataKey, "denom_authority_metadata", collections.StringKey, codec.CollValue[types.DenomAuthorityMetadata](cdc)),
+		CreatorDenoms:          collections.NewKeySet(sb, types.CreatorDenomsKey, "creator_denoms", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the x/tokenfactory module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// RegisterSendHook registers a send hook which denom admins can select by its
+// name. It must be called when the app is wired, before any block is
+// processed, and panics if the name is empty or already registered.
+func (k Keeper) RegisterSendHook(name string, hook types.SendHook) {
+	if name == "" {
+		panic("send hook name cannot be empty")
+	}
+	if _, ok := k.sendHooks[name]; ok {
+		panic(fmt.Sprintf("send hook %s already registered", name))
+	}
+
+	k.sendHooks[name] = hook
+}
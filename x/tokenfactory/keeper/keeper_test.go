@@ -0,0 +1,294 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	tokenfactorytestutil "github.com/cosmos/cosmos-sdk/x/tokenfactory/testutil"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+var (
+	creator = sdk.AccAddress("creator_____________")
+	other   = sdk.AccAddress("other_______________")
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryServer types.QueryServer
+	bankKeeper  *tokenfactorytestutil.MockBankKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(tokenfactory.AppModule{})
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := tokenfactorytestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName))
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	s.bankKeeper = tokenfactorytestutil.NewMockBankKeeper(ctrl)
+
+	s.keeper = keeper.NewKeeper(
+		encCfg.Codec,
+		runtime.NewKVStoreService(key),
+		accountKeeper,
+		s.bankKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.keeper)
+
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, *types.DefaultGenesis()))
+}
+
+// createDenom creates the factory denom of the creator with the given
+// subdenom.
+func (s *KeeperTestSuite) createDenom(subdenom string) string {
+	denom := "factory/" + creator.String() + "/" + subdenom
+	s.bankKeeper.EXPECT().GetDenomMetaData(gomock.Any(), denom).Return(banktypes.Metadata{}, false)
+	s.bankKeeper.EXPECT().HasSupply(gomock.Any(), denom).Return(false)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), creator, authtypes.FeeCollectorName, types.DefaultParams().DenomCreationFee).Return(nil)
+	s.bankKeeper.EXPECT().SetDenomMetaData(gomock.Any(), gomock.Any())
+
+	res, err := s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: creator.String(), Subdenom: subdenom})
+	s.Require().NoError(err)
+	s.Require().Equal(denom, res.NewTokenDenom)
+
+	return denom
+}
+
+func (s *KeeperTestSuite) TestCreateDenom() {
+	denom := s.createDenom("bitcoin")
+
+	res, err := s.queryServer.DenomAuthorityMetadata(s.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(creator.String(), res.AuthorityMetadata.Admin)
+
+	// the denom already exists
+	_, err = s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: creator.String(), Subdenom: "bitcoin"})
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	// the denom has a supply or metadata without being a factory denom
+	existing := "factory/" + creator.String() + "/existing"
+	s.bankKeeper.EXPECT().GetDenomMetaData(gomock.Any(), existing).Return(banktypes.Metadata{}, false)
+	s.bankKeeper.EXPECT().HasSupply(gomock.Any(), existing).Return(true)
+	_, err = s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: creator.String(), Subdenom: "existing"})
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	// invalid subdenom
+	_, err = s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: creator.String(), Subdenom: "!nvalid"})
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	// the creator cannot pay the fee
+	poor := "factory/" + creator.String() + "/poor"
+	s.bankKeeper.EXPECT().GetDenomMetaData(gomock.Any(), poor).Return(banktypes.Metadata{}, false)
+	s.bankKeeper.EXPECT().HasSupply(gomock.Any(), poor).Return(false)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), creator, authtypes.FeeCollectorName, gomock.Any()).Return(sdkerrors.ErrInsufficientFunds)
+	_, err = s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: creator.String(), Subdenom: "poor"})
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	s.createDenom("ether")
+	denoms, err := s.queryServer.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: creator.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denom, "factory/" + creator.String() + "/ether"}, denoms.Denoms)
+
+	denoms, err = s.queryServer.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: other.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(denoms.Denoms)
+}
+
+func (s *KeeperTestSuite) TestMintBurn() {
+	denom := s.createDenom("bitcoin")
+	amount := sdk.NewInt64Coin(denom, 100)
+
+	_, err := s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: other.String(), Amount: amount})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: creator.String(), Amount: sdk.NewInt64Coin("stake", 100)})
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: creator.String(), Amount: sdk.NewInt64Coin(denom, 0)})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidCoins)
+
+	// mint to the sender by default
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(amount)).Return(nil).Times(2)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, creator, sdk.NewCoins(amount)).Return(nil)
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: creator.String(), Amount: amount})
+	s.Require().NoError(err)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, other, sdk.NewCoins(amount)).Return(nil)
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: creator.String(), Amount: amount, MintToAddress: other.String()})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Burn(s.ctx, &types.MsgBurn{Sender: other.String(), Amount: amount})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), creator, types.ModuleName, sdk.NewCoins(amount)).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(amount)).Return(nil)
+	_, err = s.msgServer.Burn(s.ctx, &types.MsgBurn{Sender: creator.String(), Amount: amount})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestChangeAdmin() {
+	denom := s.createDenom("bitcoin")
+
+	_, err := s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: other.String(), Denom: denom, NewAdmin: other.String()})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: creator.String(), Denom: denom, NewAdmin: "invalid"})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidAddress)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: creator.String(), Denom: denom, NewAdmin: other.String()})
+	s.Require().NoError(err)
+
+	// the former admin cannot manage the denom anymore
+	_, err = s.msgServer.Mint(s.ctx, &types.MsgMint{Sender: creator.String(), Amount: sdk.NewInt64Coin(denom, 1)})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// renounce the management of the denom
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: other.String(), Denom: denom})
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.DenomAuthorityMetadata(s.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Empty(res.AuthorityMetadata.Admin)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: "", Denom: denom, NewAdmin: other.String()})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestSetDenomMetadata() {
+	denom := s.createDenom("bitcoin")
+	metadata := banktypes.Metadata{
+		Description: "Bitcoin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "btc", Exponent: 8},
+		},
+		Base:    denom,
+		Display: "btc",
+		Name:    "Bitcoin",
+		Symbol:  "BTC",
+	}
+
+	_, err := s.msgServer.SetDenomMetadata(s.ctx, &types.MsgSetDenomMetadata{Sender: other.String(), Metadata: metadata})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	invalid := metadata
+	invalid.Name = ""
+	_, err = s.msgServer.SetDenomMetadata(s.ctx, &types.MsgSetDenomMetadata{Sender: creator.String(), Metadata: invalid})
+	s.Require().ErrorIs(err, types.ErrInvalidMetadata)
+
+	s.bankKeeper.EXPECT().SetDenomMetaData(gomock.Any(), metadata)
+	_, err = s.msgServer.SetDenomMetadata(s.ctx, &types.MsgSetDenomMetadata{Sender: creator.String(), Metadata: metadata})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestSendHook() {
+	errFrozen := errors.New("frozen")
+	s.keeper.RegisterSendHook("frozen", func(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coin) error {
+		return errFrozen
+	})
+	s.Require().Panics(func() {
+		s.keeper.RegisterSendHook("frozen", nil)
+	})
+
+	denom := s.createDenom("bitcoin")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10), sdk.NewInt64Coin("stake", 10))
+
+	_, err := s.msgServer.SetSendHook(s.ctx, &types.MsgSetSendHook{Sender: other.String(), Denom: denom, SendHook: "frozen"})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.SetSendHook(s.ctx, &types.MsgSetSendHook{Sender: creator.String(), Denom: denom, SendHook: "unknown"})
+	s.Require().ErrorIs(err, types.ErrUnknownSendHook)
+
+	toAddr, err := s.keeper.SendRestriction(s.ctx, creator, other, coins)
+	s.Require().NoError(err)
+	s.Require().Equal(other, toAddr)
+
+	_, err = s.msgServer.SetSendHook(s.ctx, &types.MsgSetSendHook{Sender: creator.String(), Denom: denom, SendHook: "frozen"})
+	s.Require().NoError(err)
+
+	_, err = s.keeper.SendRestriction(s.ctx, creator, other, coins)
+	s.Require().ErrorIs(err, types.ErrSendHookRejection)
+	s.Require().ErrorContains(err, errFrozen.Error())
+
+	// other denoms are not affected
+	_, err = s.keeper.SendRestriction(s.ctx, creator, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SetSendHook(s.ctx, &types.MsgSetSendHook{Sender: creator.String(), Denom: denom})
+	s.Require().NoError(err)
+
+	_, err = s.keeper.SendRestriction(s.ctx, creator, other, coins)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.Params{DenomCreationFee: sdk.NewCoins()}
+
+	_, err := s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: other.String(), Params: params})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: s.keeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+
+	// no fee is charged anymore
+	denom := "factory/" + creator.String() + "/free"
+	s.bankKeeper.EXPECT().GetDenomMetaData(gomock.Any(), denom).Return(banktypes.Metadata{}, false)
+	s.bankKeeper.EXPECT().HasSupply(gomock.Any(), denom).Return(false)
+	s.bankKeeper.EXPECT().SetDenomMetaData(gomock.Any(), gomock.Any())
+	_, err = s.msgServer.CreateDenom(s.ctx, &types.MsgCreateDenom{Sender: creator.String(), Subdenom: "free"})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	denom := s.createDenom("bitcoin")
+	_, err := s.msgServer.ChangeAdmin(s.ctx, &types.MsgChangeAdmin{Sender: creator.String(), Denom: denom, NewAdmin: other.String()})
+	s.Require().NoError(err)
+
+	gs, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().NoError(gs.Validate())
+	s.Require().Equal([]types.GenesisDenom{{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: other.String()}}}, gs.FactoryDenoms)
+
+	s.SetupTest()
+	s.Require().NoError(s.keeper.InitGenesis(s.ctx, *gs))
+
+	exported, err := s.keeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs, exported)
+
+	denoms, err := s.queryServer.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: creator.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denom}, denoms.Denoms)
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+var _ banktypes.SendRestrictionFn = Keeper{}.SendRestriction
+
+// SendRestriction implements banktypes.SendRestrictionFn. It runs the send
+// hooks of the factory denoms being sent, and must be appended to the bank
+// keeper send restrictions for the send hooks to be enforced:
+//
+//	app.BankKeeper.AppendSendRestriction(app.TokenFactoryKeeper.SendRestriction)
+//
+// Mints and burns go through the module account, so they are seen by the send
+// hooks as sends from and to the module account.
+func (k Keeper) SendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	for _, coin := range amt {
+		if !types.IsFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		metadata, err := k.DenomAuthorityMetadata.Get(ctx, coin.Denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if metadata.SendHook == "" {
+			continue
+		}
+
+		hook, ok := k.sendHooks[metadata.SendHook]
+		if !ok {
+			return nil, types.ErrUnknownSendHook.Wrapf("%s, set on %s", metadata.SendHook, coin.Denom)
+		}
+
+		if err := hook(ctx, fromAddr, toAddr, coin); err != nil {
+			return nil, errorsmod.Wrap(types.ErrSendHookRejection, err.Error())
+		}
+	}
+
+	return toAddr, nil
+}
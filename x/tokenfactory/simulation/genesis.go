@@ -0,0 +1,18 @@
+package simulation
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+// RandomizedGenState generates a random GenesisState for tokenfactory
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+	params.DenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin(simState.BondDenom, simState.Rand.Int63n(10_000_000)))
+
+	tokenfactoryGenesis := types.DefaultGenesis()
+	tokenfactoryGenesis.Params = params
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(tokenfactoryGenesis)
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DenomPrefix is the prefix of the denoms created by the module.
+	DenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+	// MaxCreatorLength is the maximum length of the creator address of a
+	// denom.
+	MaxCreatorLength = 75
+)
+
+// GetTokenDenom returns the denom factory/{creator}/{subdenom}, and checks
+// that it is valid.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", ErrInvalidDenom.Wrapf("subdenom too long, max length is %d bytes", MaxSubdenomLength)
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", ErrInvalidDenom.Wrapf("creator too long, max length is %d bytes", MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", ErrInvalidDenom.Wrapf("creator %s cannot contain '/'", creator)
+	}
+
+	denom := strings.Join([]string{DenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and the subdenom of a factory denom.
+// The creator address is not checked to be valid.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 || parts[0] != DenomPrefix {
+		return "", "", ErrInvalidDenom.Wrapf("denom %s is not of the form %s/{creator}/{subdenom}", denom, DenomPrefix)
+	}
+
+	if parts[1] == "" {
+		return "", "", ErrInvalidDenom.Wrapf("denom %s has an empty creator", denom)
+	}
+
+	return parts[1], parts[2], nil
+}
+
+// IsFactoryDenom returns true if the denom has the factory denom prefix.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, fmt.Sprintf("%s/", DenomPrefix))
+}
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestDenoms(t *testing.T) {
+	creator := "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du"
+
+	testCases := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expDenom string
+		expErr   bool
+	}{
+		{
+			name:     "valid",
+			creator:  creator,
+			subdenom: "bitcoin",
+			expDenom: "factory/" + creator + "/bitcoin",
+		},
+		{
+			name:     "subdenom with slashes",
+			creator:  creator,
+			subdenom: "bit/coin",
+			expDenom: "factory/" + creator + "/bit/coin",
+		},
+		{
+			name:     "empty subdenom",
+			creator:  creator,
+			subdenom: "",
+			expDenom: "factory/" + creator + "/",
+		},
+		{
+			name:     "subdenom too long",
+			creator:  creator,
+			subdenom: strings.Repeat("a", types.MaxSubdenomLength+1),
+			expErr:   true,
+		},
+		{
+			name:     "creator too long",
+			creator:  strings.Repeat("a", types.MaxCreatorLength+1),
+			subdenom: "bitcoin",
+			expErr:   true,
+		},
+		{
+			name:     "creator with slashes",
+			creator:  "cosmos1/abc",
+			subdenom: "bitcoin",
+			expErr:   true,
+		},
+		{
+			name:     "invalid characters",
+			creator:  creator,
+			subdenom: "bit coin",
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := types.GetTokenDenom(tc.creator, tc.subdenom)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidDenom)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expDenom, denom)
+			require.True(t, types.IsFactoryDenom(denom))
+
+			gotCreator, gotSubdenom, err := types.DeconstructDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, tc.creator, gotCreator)
+			require.Equal(t, tc.subdenom, gotSubdenom)
+		})
+	}
+
+	_, _, err := types.DeconstructDenom("stake")
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+	_, _, err = types.DeconstructDenom("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+	require.False(t, types.IsFactoryDenom("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"))
+}
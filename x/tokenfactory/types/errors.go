@@ -0,0 +1,13 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrDenomExists       = errors.Register(ModuleName, 2, "denom already exists")
+	ErrInvalidDenom      = errors.Register(ModuleName, 3, "invalid denom")
+	ErrUnauthorized      = errors.Register(ModuleName, 4, "unauthorized account")
+	ErrInvalidMetadata   = errors.Register(ModuleName, 5, "invalid denom metadata")
+	ErrUnknownSendHook   = errors.Register(ModuleName, 6, "unknown send hook")
+	ErrSendHookRejection = errors.Register(ModuleName, 7, "send rejected by the denom send hook")
+)
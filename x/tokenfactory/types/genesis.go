@@ -0,0 +1,34 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default genesis state of the x/tokenfactory
+// module.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:        DefaultParams(),
+		FactoryDenoms: []GenesisDenom{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.FactoryDenoms))
+	for _, denom := range gs.FactoryDenoms {
+		if seen[denom.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", denom.Denom)
+		}
+		seen[denom.Denom] = true
+
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
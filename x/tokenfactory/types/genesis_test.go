@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	denom := "factory/cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpjnp7du/bitcoin"
+
+	testCases := []struct {
+		name     string
+		malleate func(gs *types.GenesisState)
+		expErr   string
+	}{
+		{
+			name:     "valid",
+			malleate: func(*types.GenesisState) {},
+		},
+		{
+			name: "invalid denom creation fee",
+			malleate: func(gs *types.GenesisState) {
+				gs.Params.DenomCreationFee = sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}
+			},
+			expErr: "invalid denom creation fee",
+		},
+		{
+			name: "duplicate denom",
+			malleate: func(gs *types.GenesisState) {
+				gs.FactoryDenoms = append(gs.FactoryDenoms, gs.FactoryDenoms[0])
+			},
+			expErr: "duplicate factory denom",
+		},
+		{
+			name: "not a factory denom",
+			malleate: func(gs *types.GenesisState) {
+				gs.FactoryDenoms[0].Denom = "stake"
+			},
+			expErr: "invalid denom",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesis()
+			gs.FactoryDenoms = []types.GenesisDenom{{Denom: denom}}
+			tc.malleate(gs)
+
+			err := gs.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "tokenfactory"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+var (
+	ParamsKey                 = collections.NewPrefix(0)
+	DenomAuthorityMetadataKey = collections.NewPrefix(1)
+	CreatorDenomsKey          = collections.NewPrefix(2)
+)
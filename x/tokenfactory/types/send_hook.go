@@ -0,0 +1,15 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendHook is run when a factory denom whose send hook is set is sent. It is
+// called with the amount of the denom being sent, and rejects the send by
+// returning an error.
+//
+// Send hooks are registered by the app with a name, see
+// Keeper.RegisterSendHook, and the admin of a denom selects one by its name.
+type SendHook func(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amount sdk.Coin) error
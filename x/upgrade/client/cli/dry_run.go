@@ -0,0 +1,349 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log/v2"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// DryRunApplication is an application whose upgrades can be dry-run.
+type DryRunApplication interface {
+	servertypes.Application
+
+	// GetUpgradeKeeper returns the upgrade keeper holding the upgrade handlers.
+	GetUpgradeKeeper() *keeper.Keeper
+}
+
+// stateDiff is the size of the state changes of an upgrade step. A key
+// written several times is counted once.
+type stateDiff struct {
+	Writes  int `json:"writes"`
+	Deletes int `json:"deletes"`
+	Bytes   int `json:"bytes"`
+}
+
+// migrationReport is the report of the migration of a single module.
+type migrationReport struct {
+	Module      string        `json:"module"`
+	FromVersion uint64        `json:"from_version"`
+	ToVersion   uint64        `json:"to_version"`
+	Duration    time.Duration `json:"duration"`
+	GasUsed     uint64        `json:"gas_used"`
+	StateDiff   stateDiff     `json:"state_diff"`
+	Error       string        `json:"error,omitempty"`
+}
+
+// dryRunReport is the report of an upgrade dry-run.
+type dryRunReport struct {
+	Plan       types.Plan        `json:"plan"`
+	Migrations []migrationReport `json:"migrations"`
+	Duration   time.Duration     `json:"duration"`
+	StateDiff  stateDiff         `json:"state_diff"`
+	Error      string            `json:"error,omitempty"`
+}
+
+// UpgradeCmd returns the upgrade commands run against the node state.
+func UpgradeCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Upgrade subcommands run against the node state",
+	}
+
+	cmd.AddCommand(DryRunCmd(appCreator, defaultNodeHome))
+
+	return cmd
+}
+
+// DryRunCmd returns a command applying an upgrade to a copy of the latest
+// state of the node.
+func DryRunCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run [plan-name]",
+		Short: "Apply an upgrade to a copy of the latest state",
+		Long: `Apply an upgrade to a copy of the latest application state, as if the upgrade height was the next block.
+The command must be run with the binary of the upgrade. The application database is copied to a temporary
+directory, the store upgrades are applied when loading it and the upgrade handler is run, along with the
+module migrations. The duration, gas and state diff size of each migration are reported, along with any
+error or panic. The node should be stopped while the database is copied.`,
+		Example:      fmt.Sprintf("%s dry-run v2 --home ~/.simapp", types.ModuleName),
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			vp := serverCtx.Viper
+
+			home, _ := cmd.Flags().GetString(flags.FlagHome)
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+
+			tmpHome, err := os.MkdirTemp("", "upgrade-dry-run-")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(tmpHome)
+
+			db, err := copyDB(home, tmpHome, server.GetAppDBBackend(vp))
+			if err != nil {
+				return err
+			}
+
+			latestHeight := rootmulti.GetLatestVersion(db)
+			if latestHeight <= 0 {
+				db.Close()
+				return fmt.Errorf("the database has no valid heights, the latest height: %v", latestHeight)
+			}
+
+			// the application sets the store loader of the upgrade from the
+			// upgrade info file, as after halting at the upgrade height
+			plan := types.Plan{Name: args[0], Height: latestHeight + 1}
+			if err := writeUpgradeInfo(tmpHome, plan); err != nil {
+				db.Close()
+				return err
+			}
+
+			vp.Set(flags.FlagHome, tmpHome)
+			app, err := createApp(appCreator, serverCtx.Logger, db, vp)
+			if err != nil {
+				db.Close()
+				return err
+			}
+			defer app.Close()
+
+			upgradeApp, ok := app.(DryRunApplication)
+			if !ok {
+				return fmt.Errorf("application does not implement %T", (*DryRunApplication)(nil))
+			}
+
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("currently only support the dry-run of rootmulti.Store type")
+			}
+
+			k := upgradeApp.GetUpgradeKeeper()
+			if !k.HasHandler(plan.Name) {
+				return fmt.Errorf("no upgrade handler registered for %q", plan.Name)
+			}
+
+			now := time.Now().UTC()
+			ctx := sdk.NewContext(cms, cmtproto.Header{Height: plan.Height, Time: now}, false, serverCtx.Logger).
+				WithHeaderInfo(header.Info{Height: plan.Height, Time: now})
+
+			// use the info of the scheduled plan, if any
+			if scheduled, err := k.GetUpgradePlan(ctx); err == nil && scheduled.Name == plan.Name {
+				plan.Info = scheduled.Info
+			}
+
+			report := dryRunUpgrade(ctx, cms, k, plan)
+			if err := printReport(cmd.OutOrStdout(), report, output); err != nil {
+				return err
+			}
+
+			if report.Error != "" {
+				return fmt.Errorf("upgrade %q failed: %s", plan.Name, report.Error)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+// copyDB copies the application database of home to tmpHome and opens the
+// copy. The configuration directory is linked, so that the application reads
+// the genesis of the node.
+func copyDB(home, tmpHome string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(tmpHome, "data")
+	if err := os.MkdirAll(dataDir, 0o700); err != nil {
+		return nil, err
+	}
+
+	src := filepath.Join(home, "data", "application.db")
+	if _, err := os.Stat(src); err != nil {
+		return nil, fmt.Errorf("failed to find the application database: %w", err)
+	}
+
+	if err := os.CopyFS(filepath.Join(dataDir, "application.db"), os.DirFS(src)); err != nil {
+		return nil, fmt.Errorf("failed to copy the application database: %w", err)
+	}
+
+	if err := os.Symlink(filepath.Join(home, "config"), filepath.Join(tmpHome, "config")); err != nil {
+		return nil, err
+	}
+
+	return dbm.NewDB("application", backendType, dataDir)
+}
+
+// writeUpgradeInfo writes the upgrade info file of the plan to home.
+func writeUpgradeInfo(home string, plan types.Plan) error {
+	bz, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(home, "data", types.UpgradeInfoFilename), bz, 0o600)
+}
+
+// createApp creates the application, returning loading panics as errors, e.g.
+// failing store upgrades.
+func createApp(appCreator servertypes.AppCreator, logger log.Logger, db dbm.DB, appOpts servertypes.AppOptions) (app servertypes.Application, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to load the application: %v", r)
+		}
+	}()
+
+	return appCreator(logger, db, appOpts), nil
+}
+
+// dryRunUpgrade applies the upgrade of the plan and reports its module
+// migrations. The state changes are written to cms, which must be a copy.
+func dryRunUpgrade(ctx sdk.Context, cms *rootmulti.Store, k *keeper.Keeper, plan types.Plan) (report dryRunReport) {
+	report.Plan = plan
+
+	var keys []storetypes.StoreKey
+	for _, key := range cms.StoreKeysByName() {
+		if _, ok := key.(*storetypes.KVStoreKey); ok {
+			keys = append(keys, key)
+		}
+	}
+	cms.AddListeners(keys)
+
+	// discard the changes made while loading
+	cms.PopStateCache()
+
+	var changes []*storetypes.StoreKVPair
+	wrapper := func(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64, migrate func(sdk.Context) error) (err error) {
+		changes = append(changes, cms.PopStateCache()...)
+
+		migration := migrationReport{Module: moduleName, FromVersion: fromVersion, ToVersion: toVersion}
+		gasMeter := storetypes.NewInfiniteGasMeter()
+		start := time.Now()
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+			if err != nil {
+				migration.Error = err.Error()
+			}
+
+			migrationChanges := cms.PopStateCache()
+			changes = append(changes, migrationChanges...)
+
+			migration.Duration = time.Since(start)
+			migration.GasUsed = gasMeter.GasConsumed()
+			migration.StateDiff = newStateDiff(migrationChanges)
+			report.Migrations = append(report.Migrations, migration)
+		}()
+
+		return migrate(ctx.WithGasMeter(gasMeter))
+	}
+
+	start := time.Now()
+	err := applyUpgrade(module.WithMigrationWrapper(ctx, wrapper), k, plan)
+	if err != nil {
+		report.Error = err.Error()
+	}
+
+	report.Duration = time.Since(start)
+	report.StateDiff = newStateDiff(append(changes, cms.PopStateCache()...))
+
+	return report
+}
+
+// applyUpgrade applies the upgrade of the plan, returning panics as errors.
+func applyUpgrade(ctx sdk.Context, k *keeper.Keeper, plan types.Plan) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return k.ApplyUpgrade(ctx, plan)
+}
+
+// newStateDiff returns the size of the given state changes, counting the last
+// change of each key.
+func newStateDiff(changes []*storetypes.StoreKVPair) stateDiff {
+	last := make(map[string]*storetypes.StoreKVPair, len(changes))
+	for _, change := range changes {
+		last[change.StoreKey+"/"+string(change.Key)] = change
+	}
+
+	var diff stateDiff
+	for _, change := range last {
+		if change.Delete {
+			diff.Deletes++
+			diff.Bytes += len(change.Key)
+			continue
+		}
+
+		diff.Writes++
+		diff.Bytes += len(change.Key) + len(change.Value)
+	}
+
+	return diff
+}
+
+// printReport prints the report in the given output format.
+func printReport(w io.Writer, report dryRunReport, output string) error {
+	switch output {
+	case flags.OutputFormatJSON:
+		bz, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(bz))
+		return err
+
+	case flags.OutputFormatText:
+		if _, err := fmt.Fprintf(w, "upgrade %q at height %d\n", report.Plan.Name, report.Plan.Height); err != nil {
+			return err
+		}
+
+		for _, m := range report.Migrations {
+			line := fmt.Sprintf("  %s v%d -> v%d: %s, %d gas, %d writes, %d deletes, %d bytes",
+				m.Module, m.FromVersion, m.ToVersion, m.Duration, m.GasUsed, m.StateDiff.Writes, m.StateDiff.Deletes, m.StateDiff.Bytes)
+			if m.Error != "" {
+				line += ", error: " + m.Error
+			}
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+
+		status := "succeeded"
+		if report.Error != "" {
+			status = "failed: " + report.Error
+		}
+		_, err := fmt.Fprintf(w, "total: %s, %d writes, %d deletes, %d bytes\n%s\n",
+			report.Duration, report.StateDiff.Writes, report.StateDiff.Deletes, report.StateDiff.Bytes, status)
+		return err
+
+	default:
+		return errors.New("unsupported output format: " + output)
+	}
+}
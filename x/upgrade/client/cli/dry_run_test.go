@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/store/v2/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// versionedModule is a module at consensus version 2.
+type versionedModule struct{}
+
+func (versionedModule) IsAppModule()             {}
+func (versionedModule) IsOnePerModuleType()      {}
+func (versionedModule) ConsensusVersion() uint64 { return 2 }
+
+func TestDryRunUpgrade(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	cms := testCtx.CMS.(*rootmulti.Store)
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 10})
+
+	k := keeper.NewKeeper(map[int64]bool{}, runtime.NewKVStoreService(key), encCfg.Codec,
+		filepath.Join(t.TempDir(), "home"), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	require.NoError(t, k.SetModuleVersionMap(ctx, module.VersionMap{"migrated": 1, "unchanged": 2}))
+
+	mm := module.NewManagerFromMap(map[string]appmodule.AppModule{
+		"migrated":  versionedModule{},
+		"unchanged": versionedModule{},
+		"added":     versionedModule{},
+	})
+	cfg := module.NewConfigurator(encCfg.Codec, nil, nil)
+	require.NoError(t, cfg.RegisterMigration("migrated", 1, func(ctx sdk.Context) error {
+		store := ctx.KVStore(key)
+		store.Set([]byte("a"), []byte("value"))
+		store.Set([]byte("a"), []byte("value"))
+		store.Set([]byte("b"), []byte("value"))
+		store.Delete([]byte("c"))
+		ctx.GasMeter().ConsumeGas(1000, "migration")
+		return nil
+	}))
+
+	k.SetUpgradeHandler("good", func(ctx context.Context, _ types.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return mm.RunMigrations(ctx, cfg, fromVM)
+	})
+	k.SetUpgradeHandler("panic", func(ctx context.Context, _ types.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		panic("bad handler")
+	})
+
+	report := dryRunUpgrade(ctx, cms, k, types.Plan{Name: "good", Height: 10})
+	require.Empty(t, report.Error)
+	require.Len(t, report.Migrations, 2)
+
+	// the added module is initialized from its default genesis
+	require.Equal(t, migrationReport{Module: "added", ToVersion: 2, Duration: report.Migrations[0].Duration}, report.Migrations[0])
+
+	migration := report.Migrations[1]
+	require.Equal(t, "migrated", migration.Module)
+	require.Equal(t, uint64(1), migration.FromVersion)
+	require.Equal(t, uint64(2), migration.ToVersion)
+	require.GreaterOrEqual(t, migration.GasUsed, uint64(1000))
+	require.Equal(t, stateDiff{Writes: 2, Deletes: 1, Bytes: 13}, migration.StateDiff)
+
+	// the upgrade also updates the version map and marks the plan as done
+	require.Greater(t, report.StateDiff.Writes, migration.StateDiff.Writes)
+
+	var out bytes.Buffer
+	require.NoError(t, printReport(&out, report, flags.OutputFormatText))
+	require.Contains(t, out.String(), "migrated v1 -> v2")
+	require.Contains(t, out.String(), "succeeded")
+
+	report = dryRunUpgrade(ctx, cms, k, types.Plan{Name: "panic", Height: 10})
+	require.Equal(t, "panic: bad handler", report.Error)
+	require.Empty(t, report.Migrations)
+}